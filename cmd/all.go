@@ -1,19 +1,24 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/spf13/cobra"
 
-	"github.com/bfirestone/speed-checker/internal/config"
+	"github.com/bfirestone/speed-checker/internal/daemon"
 	"github.com/bfirestone/speed-checker/internal/database"
-	"github.com/bfirestone/speed-checker/internal/handlers"
 	"github.com/bfirestone/speed-checker/internal/services"
 )
 
@@ -30,19 +35,29 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 	return nil
 }
 
+// inProcessAPIURL is the base URL of the in-process daemon's API client.
+// Requests never leave the process, so the host is only a placeholder.
+const inProcessAPIURL = "http://in-process/api/v1"
+
+// allShutdownTimeout bounds closing the HTTP server once the in-process
+// daemon has drained
+const allShutdownTimeout = 10 * time.Second
+
 // allCmd represents the all command (current monolithic behavior)
 var allCmd = &cobra.Command{
 	Use:   "all",
-	Short: "Run both API server and testing daemon (current behavior)",
-	Long: `Runs the complete speed checker application including:
-
-• HTTP API server with web dashboard
-• Background speed testing daemon
-• Background iperf testing daemon
-• Automatic scheduling of tests
-
-This preserves the original monolithic behavior where everything
-runs in a single process.`,
+	Short: "Run both API server and testing daemon in one process",
+	Long: `Runs the complete speed checker application in a single process:
+
+• HTTP API server with web dashboard (OpenAPI v1 at /api/v1,
+  legacy routes at /api/v1/legacy), exactly as the api command
+• The server-side job and mesh schedulers when enabled
+• An in-process API daemon running the same code as the daemon
+  command, submitting its results through the API handlers
+  without a network round trip
+
+Daemons on other machines can report to an all instance just as
+they report to the api command.`,
 	RunE: runAll,
 }
 
@@ -60,102 +75,153 @@ func runAll(cmd *cobra.Command, args []string) error {
 	}
 	defer client.Close()
 
-	// Initialize services
-	speedTestService := services.NewSpeedTestService(client)
-	iperfService := services.NewIperfService(client)
+	server, err := newAPIServer(cfg, client)
+	if err != nil {
+		return err
+	}
+
+	// Setup graceful shutdown
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
 
-	// Initialize handlers
-	apiHandler := handlers.NewAPIHandler(speedTestService, iperfService)
+	server.startSchedulers(ctx, cfg)
 
-	// Initialize Echo
-	e := echo.New()
+	daemonCfg := *cfg
+	if cfg.Auth.Enabled && cfg.Daemon.APIKey == "" {
+		keys := services.NewAPIKeyService(client)
+		id, secret, err := builtInDaemonKey(ctx, keys)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := keys.Revoke(context.Background(), id); err != nil {
+				log.Printf("Failed to revoke the in-process daemon's API key: %v", err)
+			}
+		}()
+		daemonCfg.Daemon.APIKey = secret
+	}
 
-	// Set custom validator
-	e.Validator = &CustomValidator{validator: validator.New()}
+	// The in-process daemon calls the router directly through echo's
+	// ServeHTTP, so it needs neither a listening socket nor the server's TLS
+	// settings and client certificates
+	daemonClient := daemon.NewAPIClientWithTransport(inProcessAPIURL, &daemonCfg, Version, handlerTransport{handler: server.echo})
+	daemonDone := make(chan error, 1)
+	go func() {
+		daemonDone <- runDaemonClient(ctx, daemonClient, cfg, "in-process")
+	}()
 
-	// Middleware
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
+	// Start server
+	log.Printf("Starting server on %s:%s", cfg.Server.Host, cfg.Server.Port)
+	logServerURLs(cfg)
+	serverDone := make(chan error, 1)
+	go func() {
+		serverDone <- startServer(server.echo, cfg)
+	}()
 
-	// API routes
-	api := e.Group("/api/v1")
+	select {
+	case err := <-serverDone:
+		cancel()
+		<-daemonDone
+		return err
 
-	// Speed test routes
-	api.GET("/speedtest", apiHandler.GetSpeedTests)
-	api.GET("/speedtest/range", apiHandler.GetSpeedTestsInRange)
-	api.POST("/speedtest/run", apiHandler.RunSpeedTest)
+	case <-ctx.Done():
+		log.Println("Received interrupt signal, shutting down gracefully...")
+	}
 
-	// Iperf test routes
-	api.GET("/iperf", apiHandler.GetIperfTests)
-	api.POST("/iperf/run", apiHandler.RunIperfTests)
+	// Let the daemon finish in-flight tests and flush its spool while the
+	// server can still accept its submissions
+	daemonErr := <-daemonDone
 
-	// Host management routes
-	api.GET("/hosts", apiHandler.GetHosts)
-	api.POST("/hosts", apiHandler.AddHost)
-	api.PUT("/hosts/:id", apiHandler.UpdateHost)
-	api.DELETE("/hosts/:id", apiHandler.DeleteHost)
+	// Event streams never end on their own, close them so they do not hold
+	// up the shutdown
+	server.events.Close()
 
-	// Dashboard route
-	api.GET("/dashboard", apiHandler.GetDashboard)
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), allShutdownTimeout)
+	defer cancelShutdown()
+	if err := server.echo.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down server: %w", err)
+	}
+	if err := <-serverDone; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
 
-	// Static files (for SvelteKit frontend)
-	e.Static("/", "frontend/build")
+	return daemonErr
+}
 
-	// Start background testing goroutines
-	go startBackgroundTesting(speedTestService, iperfService, cfg)
+// builtInDaemonKeyName names the key issued to the in-process daemon
+const builtInDaemonKeyName = "all: in-process daemon"
 
-	// Start server
-	log.Printf("Starting server on %s:%s", cfg.Server.Host, cfg.Server.Port)
-	return e.Start(":" + cfg.Server.Port)
+// builtInDaemonKey issues the in-process daemon a key for this run when
+// authentication is enabled and daemon.api_key is unset. Keys left active by
+// an earlier run that did not shut down cleanly are revoked first.
+func builtInDaemonKey(ctx context.Context, keys *services.APIKeyService) (int, string, error) {
+	existing, err := keys.List(ctx)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to list API keys: %w", err)
+	}
+	for _, key := range existing {
+		if key.Name == builtInDaemonKeyName && key.RevokedAt == nil {
+			if err := keys.Revoke(ctx, key.ID); err != nil {
+				return 0, "", err
+			}
+		}
+	}
+
+	created, secret, err := keys.Create(ctx, builtInDaemonKeyName, []string{services.ScopeSubmit, services.ScopeRead}, "")
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to issue the in-process daemon's API key: %w", err)
+	}
+	return created.ID, secret, nil
 }
 
-func startBackgroundTesting(speedTestService *services.SpeedTestService, iperfService *services.IperfService, cfg *config.Config) {
-	// Speed test ticker
-	speedTestTicker := time.NewTicker(cfg.Testing.SpeedTestInterval)
-	defer speedTestTicker.Stop()
+// handlerTransport serves HTTP requests with a handler in the same process.
+// Responses are buffered in full, which suits the daemon's request and
+// response calls but not streaming endpoints.
+type handlerTransport struct {
+	handler http.Handler
+}
 
-	// Iperf test ticker
-	iperfTestTicker := time.NewTicker(cfg.Testing.IperfTestInterval)
-	defer iperfTestTicker.Stop()
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := &bufferedResponse{header: http.Header{}}
+	t.handler.ServeHTTP(w, req)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
 
-	// Run initial tests
-	go func() {
-		ctx := context.Background()
-		log.Println("Running initial speed test...")
-		if _, err := speedTestService.RunTest(ctx); err != nil {
-			log.Printf("Initial speed test failed: %v", err)
-		}
-	}()
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", w.status, http.StatusText(w.status)),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
 
-	go func() {
-		ctx := context.Background()
-		log.Println("Running initial iperf tests...")
-		if err := iperfService.RunRandomTests(ctx, cfg.Testing.IperfTestDuration); err != nil {
-			log.Printf("Initial iperf tests failed: %v", err)
-		}
-	}()
+// bufferedResponse is the http.ResponseWriter handlerTransport serves into
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
 
-	// Handle scheduled tests
-	for {
-		select {
-		case <-speedTestTicker.C:
-			go func() {
-				ctx := context.Background()
-				log.Println("Running scheduled speed test...")
-				if _, err := speedTestService.RunTest(ctx); err != nil {
-					log.Printf("Scheduled speed test failed: %v", err)
-				}
-			}()
-
-		case <-iperfTestTicker.C:
-			go func() {
-				ctx := context.Background()
-				log.Println("Running scheduled iperf tests...")
-				if err := iperfService.RunRandomTests(ctx, cfg.Testing.IperfTestDuration); err != nil {
-					log.Printf("Scheduled iperf tests failed: %v", err)
-				}
-			}()
-		}
+func (w *bufferedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponse) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
 	}
 }
+
+func (w *bufferedResponse) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
+
+// Flush does nothing, the response is only read once the handler returns
+func (w *bufferedResponse) Flush() {}
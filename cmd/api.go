@@ -1,16 +1,24 @@
 package cmd
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/spf13/cobra"
 
+	"github.com/bfirestone/speed-checker/ent"
 	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/config"
 	"github.com/bfirestone/speed-checker/internal/database"
 	"github.com/bfirestone/speed-checker/internal/handlers"
+	"github.com/bfirestone/speed-checker/internal/pki"
 	"github.com/bfirestone/speed-checker/internal/services"
 )
 
@@ -40,13 +48,60 @@ func runAPI(cmd *cobra.Command, args []string) error {
 	}
 	defer client.Close()
 
-	// Initialize services
-	speedTestService := services.NewSpeedTestService(client)
-	iperfService := services.NewIperfService(client)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := requireAPIKeys(ctx, cfg, services.NewAPIKeyService(client)); err != nil {
+		return err
+	}
+
+	server, err := newAPIServer(cfg, client)
+	if err != nil {
+		return err
+	}
+
+	server.startSchedulers(ctx, cfg)
+
+	// Start server
+	logServerURLs(cfg)
+	return startServer(server.echo, cfg)
+}
+
+// apiServer is the HTTP API shared by the api and all commands
+type apiServer struct {
+	echo          *echo.Echo
+	jobs          *services.JobService
+	mesh          *services.MeshService
+	daemons       *services.DaemonService
+	daemonConfigs *services.DaemonConfigService
+	events        *services.EventBus
+}
+
+// newAPIServer initializes the services and registers the OpenAPI v1 routes
+// under /api/v1, the legacy routes under /api/v1/legacy and the frontend
+func newAPIServer(cfg *config.Config, client *ent.Client) (*apiServer, error) {
+	clockPolicy, err := services.NewClockPolicy(cfg.Clock)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize services. Results, host changes and heartbeats are
+	// published on the event bus for GET /events/stream.
+	events := services.NewEventBus()
+	speedTestService := services.NewSpeedTestService(client, events)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, events)
+	jobService := services.NewJobService(client, cfg.Scheduler.LeaseDuration)
+	testRunService := services.NewTestRunService(client)
+	daemonService := services.NewDaemonService(client, cfg.Registry.StaleAfter, cfg.Registry.DeadAfter, events)
+	resultService := services.NewResultService(client, clockPolicy, events)
+	daemonConfigService := services.NewDaemonConfigService(client)
+	meshService := services.NewMeshService(client, jobService, daemonService, cfg.Mesh)
+	campaignService := services.NewCampaignService(client, jobService, daemonService)
+	statsService := services.NewStatsService(client)
 
 	// Initialize handlers
 	legacyHandler := handlers.NewAPIHandler(speedTestService, iperfService)
-	openAPIHandler := handlers.NewOpenAPIHandler(speedTestService, iperfService)
+	openAPIHandler := handlers.NewOpenAPIHandler(speedTestService, iperfService, jobService, testRunService, daemonService, resultService, daemonConfigService, meshService, campaignService, statsService, events, clockPolicy)
 
 	// Initialize Echo
 	e := echo.New()
@@ -57,10 +112,17 @@ func runAPI(cmd *cobra.Command, args []string) error {
 	// Middleware
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		// Let browser clients page through hosts
+		ExposeHeaders: []string{"X-Next-Cursor"},
+	}))
+	e.Use(handlers.ServerTime())
+
+	// API key enforcement applies to API routes only, the frontend stays public
+	authMiddleware := apiAuthMiddleware(cfg, services.NewAPIKeyService(client))
 
 	// Legacy API routes (preserved for compatibility)
-	legacyAPI := e.Group("/api/v1/legacy")
+	legacyAPI := e.Group("/api/v1/legacy", authMiddleware...)
 	legacyAPI.GET("/speedtest", legacyHandler.GetSpeedTests)
 	legacyAPI.GET("/speedtest/range", legacyHandler.GetSpeedTestsInRange)
 	legacyAPI.POST("/speedtest/run", legacyHandler.RunSpeedTest)
@@ -73,16 +135,113 @@ func runAPI(cmd *cobra.Command, args []string) error {
 	legacyAPI.GET("/dashboard", legacyHandler.GetDashboard)
 
 	// New OpenAPI v1 routes (contract-first design)
-	apiV1 := e.Group("/api/v1")
+	apiV1 := e.Group("/api/v1", authMiddleware...)
 	api.RegisterHandlers(apiV1, openAPIHandler)
 
 	// Static files (for SvelteKit frontend)
 	e.Static("/", "frontend/build")
 
-	// Start server
+	return &apiServer{
+		echo:          e,
+		jobs:          jobService,
+		mesh:          meshService,
+		daemons:       daemonService,
+		daemonConfigs: daemonConfigService,
+		events:        events,
+	}, nil
+}
+
+// startSchedulers starts the enabled server-side schedulers, which run until
+// the context is cancelled
+func (s *apiServer) startSchedulers(ctx context.Context, cfg *config.Config) {
+	// Server-owned schedule: enqueue jobs pinned to each daemon for it to
+	// lease, on the daemon's remote configuration or the testing intervals
+	if cfg.Scheduler.Enabled {
+		log.Printf("Job scheduler enabled - Speed: %v, Iperf: %v, Lease: %v",
+			cfg.Testing.SpeedTestInterval, cfg.Testing.IperfTestInterval, cfg.Scheduler.LeaseDuration)
+		go s.jobs.RunScheduler(ctx, s.daemons, s.daemonConfigs, services.JobSchedule{
+			SpeedTestInterval: cfg.Testing.SpeedTestInterval,
+			IperfInterval:     cfg.Testing.IperfTestInterval,
+			IperfDuration:     cfg.Testing.IperfTestDuration,
+		})
+	}
+
+	// Daemon-to-daemon mesh tests, pinned to their source daemons
+	if cfg.Mesh.Enabled {
+		log.Printf("Mesh scheduler enabled - Interval: %v, Duration: %ds, Max concurrent: %d",
+			cfg.Mesh.Interval, cfg.Mesh.Duration, cfg.Mesh.MaxConcurrent)
+		go s.mesh.RunScheduler(ctx)
+	}
+}
+
+func logServerURLs(cfg *config.Config) {
 	log.Printf("API server ready:")
 	log.Printf("  Legacy API: http://%s:%s/api/v1/legacy/", cfg.Server.Host, cfg.Server.Port)
 	log.Printf("  OpenAPI v1: http://%s:%s/api/v1/", cfg.Server.Host, cfg.Server.Port)
 	log.Printf("  Frontend:   http://%s:%s/", cfg.Server.Host, cfg.Server.Port)
-	return e.Start(":" + cfg.Server.Port)
+}
+
+// apiAuthMiddleware returns the middleware binding client certificates to
+// daemon IDs and, when enabled, enforcing the X-API-Key security scheme
+func apiAuthMiddleware(cfg *config.Config, apiKeyService *services.APIKeyService) []echo.MiddlewareFunc {
+	authMiddleware := []echo.MiddlewareFunc{handlers.ClientCertIdentity()}
+
+	if !cfg.Auth.Enabled {
+		log.Println("⚠️  API authentication is disabled, anyone who can reach the server can submit and delete data. Set auth.enabled to require API keys.")
+		return authMiddleware
+	}
+
+	log.Printf("API authentication enabled (anonymous read: %v)", cfg.Auth.AnonymousRead)
+	return append(authMiddleware, handlers.APIKeyAuth(apiKeyService, cfg.Auth.AnonymousRead))
+}
+
+// requireAPIKeys refuses to start an API server that enforces API keys but
+// has none that daemons could use. Authentication is on by default, so
+// deployments upgrading with keyless daemons stop here instead of having every
+// submission rejected. Daemons authenticating with client certificates need
+// no keys.
+func requireAPIKeys(ctx context.Context, cfg *config.Config, apiKeyService *services.APIKeyService) error {
+	if !cfg.Auth.Enabled || cfg.Server.TLS.ClientCAFile != "" {
+		return nil
+	}
+
+	keys, err := apiKeyService.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list API keys: %w", err)
+	}
+	for _, key := range keys {
+		// Keys of the all command's in-process daemon only last one run
+		if key.RevokedAt == nil && key.Name != builtInDaemonKeyName {
+			return nil
+		}
+	}
+
+	return errors.New("API authentication is enabled but no active API keys exist, so every daemon would be rejected. " +
+		"Create keys with 'speed-checker keys create' and give them to your daemons, " +
+		"or set auth.enabled to false (SPEED_CHECKER_AUTH_ENABLED=false) to keep running without authentication")
+}
+
+// startServer serves on the configured port, over HTTPS when server.tls is
+// configured
+func startServer(e *echo.Echo, cfg *config.Config) error {
+	tlsConfig, err := pki.ServerTLSConfig(cfg.Server.TLS)
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+	if tlsConfig == nil {
+		return e.Start(":" + cfg.Server.Port)
+	}
+
+	switch tlsConfig.ClientAuth {
+	case tls.RequireAndVerifyClientCert:
+		log.Println("TLS enabled, client certificates required")
+	case tls.VerifyClientCertIfGiven:
+		log.Println("TLS enabled, client certificates verified when presented")
+	default:
+		log.Println("TLS enabled")
+	}
+	return e.StartServer(&http.Server{
+		Addr:      ":" + cfg.Server.Port,
+		TLSConfig: tlsConfig,
+	})
 }
@@ -65,7 +65,7 @@ func runDaemon(cmd *cobra.Command, args []string) error {
 func runAPIDaemon(cfg *config.Config) error {
 	// Create API client
 	apiBaseURL := fmt.Sprintf("%s/api/v1", apiEndpoint)
-	daemonClient := daemon.NewAPIClient(apiBaseURL, cfg)
+	daemonClient := daemon.NewAPIClient(apiBaseURL, cfg, Version)
 
 	// Setup graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
@@ -81,8 +81,33 @@ func runAPIDaemon(cfg *config.Config) error {
 		cancel()
 	}()
 
+	return runDaemonClient(ctx, daemonClient, cfg, apiBaseURL)
+}
+
+// runDaemonClient runs an API daemon until the context is cancelled, leasing
+// jobs or running its own tickers depending on daemon.use_job_queue
+func runDaemonClient(ctx context.Context, daemonClient *daemon.APIClient, cfg *config.Config, endpoint string) error {
+	// Serve local health, status and metrics endpoints when configured. The
+	// listener stays up while the daemon drains so its progress is visible.
+	statusCtx, stopStatus := context.WithCancel(context.Background())
+	defer stopStatus()
+	if cfg.Daemon.StatusAddr != "" {
+		go func() {
+			if err := daemonClient.ServeStatus(statusCtx, cfg.Daemon.StatusAddr); err != nil {
+				log.Printf("Daemon status listener failed: %v", err)
+			}
+		}()
+	}
+
+	// Lease work from the server-side job queue when enabled
+	if cfg.Daemon.UseJobQueue {
+		log.Printf("API daemon started in job-queue mode - Endpoint: %s, Poll interval: %v",
+			endpoint, cfg.Daemon.PollInterval)
+		return daemonClient.StartJobProcessing(ctx)
+	}
+
 	// Start background testing
-	log.Printf("API daemon started - Endpoint: %s", apiBaseURL)
+	log.Printf("API daemon started - Endpoint: %s", endpoint)
 	log.Printf("Test intervals - Speed: %v, Iperf: %v",
 		cfg.Testing.SpeedTestInterval, cfg.Testing.IperfTestInterval)
 
@@ -99,8 +124,8 @@ func runLegacyDaemon(cfg *config.Config) error {
 	defer client.Close()
 
 	// Initialize services
-	speedTestService := services.NewSpeedTestService(client)
-	iperfService := services.NewIperfService(client)
+	speedTestService := services.NewSpeedTestService(client, nil)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, nil)
 
 	// Setup graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
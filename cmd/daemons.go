@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bfirestone/speed-checker/internal/database"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// daemonsCmd represents the daemons command
+var daemonsCmd = &cobra.Command{
+	Use:   "daemons",
+	Short: "Manage registered testing daemons",
+	Long: `Manage the daemons that submit results to the API server.
+
+Daemons identify themselves with a UUID stored in their state file.
+Older daemons used a new daemon-<hostname>-<pid> ID on every restart;
+use the merge command to fold those IDs into the stable identity.`,
+}
+
+// daemonsMergeCmd represents the daemons merge command
+var daemonsMergeCmd = &cobra.Command{
+	Use:   "merge <target_id> [source_id...]",
+	Short: "Re-point results from old daemon IDs to a stable daemon ID",
+	Long: `Re-point speed tests, iperf tests, mesh tests, runs and jobs recorded
+under the source daemon IDs to the target daemon ID, along with host
+assignments, daemon config overrides and API key bindings, and remove the
+source daemons from the registry.
+
+Sources can be listed explicitly, or collected with --hostname, which
+matches every legacy daemon-<hostname>-<pid> ID.
+
+Examples:
+  speed-checker daemons merge 0b6f...e21 --hostname office-pi --dry-run
+  speed-checker daemons merge 0b6f...e21 daemon-office-pi-4242 daemon-office-pi-5150`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: mergeDaemons,
+}
+
+var (
+	mergeHostname string
+	mergeDryRun   bool
+)
+
+func init() {
+	rootCmd.AddCommand(daemonsCmd)
+	daemonsCmd.AddCommand(daemonsMergeCmd)
+
+	// Flags for merge command
+	daemonsMergeCmd.Flags().StringVar(&mergeHostname, "hostname", "", "Merge every legacy daemon-<hostname>-<pid> ID")
+	daemonsMergeCmd.Flags().BoolVar(&mergeDryRun, "dry-run", false, "Show what would be merged without changing anything")
+}
+
+func mergeDaemons(cmd *cobra.Command, args []string) error {
+	cfg := GetConfig()
+	ctx := context.Background()
+
+	// Initialize database client
+	client, err := database.InitializeDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Initialize service
+	daemonService := services.NewDaemonService(client, cfg.Registry.StaleAfter, cfg.Registry.DeadAfter, nil)
+
+	target := args[0]
+	sources := args[1:]
+	if mergeHostname != "" {
+		legacy, err := daemonService.LegacyDaemonIDs(ctx, mergeHostname)
+		if err != nil {
+			return fmt.Errorf("failed to find legacy daemon IDs: %w", err)
+		}
+		sources = append(sources, legacy...)
+	}
+
+	sources = slices.DeleteFunc(sources, func(id string) bool { return id == target })
+	if len(sources) == 0 {
+		fmt.Println("No daemon IDs to merge")
+		return nil
+	}
+
+	result, err := daemonService.Merge(ctx, target, sources, mergeDryRun)
+	if err != nil {
+		return fmt.Errorf("failed to merge daemons: %w", err)
+	}
+
+	if mergeDryRun {
+		fmt.Printf("🔍 Dry run - merging %d daemon IDs into %s would update:\n", len(sources), target)
+	} else {
+		fmt.Printf("✅ Merged %d daemon IDs into %s:\n", len(sources), target)
+	}
+	for _, source := range sources {
+		fmt.Printf("   - %s\n", source)
+	}
+	fmt.Printf("   Speed tests:    %d\n", result.SpeedTests)
+	fmt.Printf("   Iperf tests:    %d\n", result.IperfTests)
+	fmt.Printf("   Mesh tests:     %d\n", result.MeshTests)
+	fmt.Printf("   Runs:           %d\n", result.TestRuns)
+	fmt.Printf("   Jobs:           %d\n", result.Jobs)
+	fmt.Printf("   Hosts:          %d\n", result.Hosts)
+	fmt.Printf("   Daemon configs: %d\n", result.DaemonConfigs)
+	fmt.Printf("   API keys:       %d\n", result.APIKeys)
+	fmt.Printf("   Daemons:        %d removed\n", result.Daemons)
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bfirestone/speed-checker/internal/database"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export <speed|iperf>",
+	Short: "Export test results as CSV or NDJSON",
+	Long: `Export test results for analysis in spreadsheets, pandas and similar
+tools, as CSV with a header row or NDJSON with one JSON object per line.
+
+Results are read from the database in batches, so exports of any size use
+constant memory. They are written oldest first unless --sort says otherwise.
+
+Examples:
+  speed-checker export speed > speedtests.csv
+  speed-checker export iperf --format ndjson -o iperf.ndjson
+  speed-checker export speed --daemon office-1 --start 2025-01-01T00:00:00Z
+  speed-checker export iperf --host-type lan --sort -received_mbps --limit 500`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"speed", "iperf"},
+	RunE:      runExport,
+}
+
+var (
+	exportFormat      string
+	exportOutput      string
+	exportStart       string
+	exportEnd         string
+	exportDaemonID    string
+	exportServerName  string
+	exportHostID      int
+	exportHostName    string
+	exportHostType    string
+	exportQuarantined bool
+	exportSort        string
+	exportLimit       int
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", "csv", "Output format: csv or ndjson")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "File to write (default stdout)")
+	exportCmd.Flags().StringVar(&exportStart, "start", "", "Only results after this time (RFC3339)")
+	exportCmd.Flags().StringVar(&exportEnd, "end", "", "Only results before this time (RFC3339)")
+	exportCmd.Flags().StringVar(&exportDaemonID, "daemon", "", "Only results from this daemon ID")
+	exportCmd.Flags().StringVar(&exportServerName, "server", "", "Only speed tests against servers matching this name")
+	exportCmd.Flags().IntVar(&exportHostID, "host-id", 0, "Only iperf tests against this host ID")
+	exportCmd.Flags().StringVar(&exportHostName, "host-name", "", "Only iperf tests against hosts matching this name")
+	exportCmd.Flags().StringVar(&exportHostType, "host-type", "", "Only iperf tests against this host type (lan, vpn, remote)")
+	exportCmd.Flags().BoolVar(&exportQuarantined, "quarantined", false, "Export quarantined results instead of the regular ones")
+	exportCmd.Flags().StringVar(&exportSort, "sort", "", "Column to sort by, prefixed with - for descending order (default timestamp)")
+	exportCmd.Flags().IntVar(&exportLimit, "limit", 0, "Maximum number of results (default all)")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	format, err := services.ParseExportFormat(exportFormat)
+	if err != nil {
+		return err
+	}
+
+	start, err := parseExportTime("--start", exportStart)
+	if err != nil {
+		return err
+	}
+	end, err := parseExportTime("--end", exportEnd)
+	if err != nil {
+		return err
+	}
+
+	cfg := GetConfig()
+
+	// Initialize database client
+	client, err := database.InitializeDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var out io.Writer = os.Stdout
+	if exportOutput != "" {
+		file, err := os.Create(exportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", exportOutput, err)
+		}
+		defer file.Close()
+		out = file
+	}
+	buffered := bufio.NewWriter(out)
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "speed":
+		err = services.NewSpeedTestService(client, nil).Export(ctx, services.SpeedTestFilter{
+			StartTime:   start,
+			EndTime:     end,
+			DaemonID:    exportDaemonID,
+			ServerName:  exportServerName,
+			Quarantined: exportQuarantined,
+			Sort:        exportSort,
+			Limit:       exportLimit,
+		}, format, buffered)
+
+	case "iperf":
+		filter := services.IperfTestFilter{
+			StartTime:   start,
+			EndTime:     end,
+			DaemonID:    exportDaemonID,
+			HostName:    exportHostName,
+			HostType:    exportHostType,
+			Quarantined: exportQuarantined,
+			Sort:        exportSort,
+			Limit:       exportLimit,
+		}
+		if exportHostID != 0 {
+			filter.HostID = &exportHostID
+		}
+		err = services.NewIperfService(client, cfg.Testing.Dependencies, nil).Export(ctx, filter, format, buffered)
+
+	default:
+		return fmt.Errorf("unknown result type %q, use speed or iperf", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	return buffered.Flush()
+}
+
+// parseExportTime parses an optional RFC3339 time flag
+func parseExportTime(flag, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s time %q, use RFC3339 (e.g. 2025-01-01T00:00:00Z): %w", flag, value, err)
+	}
+	return &t, nil
+}
@@ -3,10 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/bfirestone/speed-checker/ent"
 	"github.com/bfirestone/speed-checker/internal/database"
 	"github.com/bfirestone/speed-checker/internal/services"
 )
@@ -42,10 +45,15 @@ var hostsAddCmd = &cobra.Command{
 
 Type must be one of: lan, vpn, remote
 
+Hosts only reachable from some daemons can be scoped with --selector
+(daemons carrying all of these labels) and/or --daemons (daemon IDs).
+Unscoped hosts are tested by every daemon.
+
 Examples:
   speed-checker hosts add --name "Local Server" --hostname 192.168.1.100 --type lan --description "Main server"
   speed-checker hosts add --name "VPN Gateway" --hostname vpn.example.com --type vpn --port 5202
-  speed-checker hosts add --name "Remote Test" --hostname remote.example.com --type remote`,
+  speed-checker hosts add --name "Remote Test" --hostname remote.example.com --type remote
+  speed-checker hosts add --name "Denver NAS" --hostname 10.20.0.5 --type lan --selector site=denver`,
 	RunE: addHost,
 }
 
@@ -64,6 +72,8 @@ var (
 	hostHostname    string
 	hostType        string
 	hostDescription string
+	hostSelector    map[string]string
+	hostDaemonIDs   []string
 )
 
 func init() {
@@ -78,6 +88,8 @@ func init() {
 	hostsAddCmd.Flags().StringVarP(&hostType, "type", "t", "", "Host type: lan, vpn, or remote (required)")
 	hostsAddCmd.Flags().StringVarP(&hostDescription, "description", "d", "", "Host description (optional)")
 	hostsAddCmd.Flags().IntVarP(&hostPort, "port", "p", 5201, "Host port")
+	hostsAddCmd.Flags().StringToStringVar(&hostSelector, "selector", nil, "Only daemons with these labels test the host, e.g. site=denver (optional)")
+	hostsAddCmd.Flags().StringSliceVar(&hostDaemonIDs, "daemons", nil, "Daemon IDs that test the host regardless of labels (optional)")
 
 	// Mark required flags
 	hostsAddCmd.MarkFlagRequired("name")
@@ -96,7 +108,7 @@ func listHosts(cmd *cobra.Command, args []string) error {
 	defer client.Close()
 
 	// Initialize service
-	iperfService := services.NewIperfService(client)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, nil)
 
 	hosts, err := iperfService.GetHosts(context.Background())
 	if err != nil {
@@ -109,9 +121,9 @@ func listHosts(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("\n🏠 Configured Hosts (%d total):\n", len(hosts))
-	fmt.Printf("%-4s %-20s %-25s %-8s %-6s %-8s %s\n",
-		"ID", "Name", "Hostname", "Type", "Port", "Active", "Description")
-	fmt.Println("────────────────────────────────────────────────────────────────────────────────")
+	fmt.Printf("%-4s %-20s %-25s %-8s %-6s %-8s %-24s %s\n",
+		"ID", "Name", "Hostname", "Type", "Port", "Active", "Daemons", "Description")
+	fmt.Println("─────────────────────────────────────────────────────────────────────────────────────────────────────────")
 
 	for _, host := range hosts {
 		activeStatus := "✓"
@@ -124,8 +136,8 @@ func listHosts(cmd *cobra.Command, args []string) error {
 			description = "-"
 		}
 
-		fmt.Printf("%-4d %-20s %-25s %-8s %-6d %-8s %s\n",
-			host.ID, host.Name, host.Hostname, host.Type, host.Port, activeStatus, description)
+		fmt.Printf("%-4d %-20s %-25s %-8s %-6d %-8s %-24s %s\n",
+			host.ID, host.Name, host.Hostname, host.Type, host.Port, activeStatus, formatHostScope(host), description)
 	}
 
 	return nil
@@ -147,9 +159,10 @@ func addHost(cmd *cobra.Command, args []string) error {
 	defer client.Close()
 
 	// Initialize service
-	iperfService := services.NewIperfService(client)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, nil)
 
-	host, err := iperfService.AddHost(context.Background(), hostName, hostHostname, hostType, hostDescription, hostPort)
+	scope := &services.HostScope{DaemonSelector: hostSelector, DaemonIDs: hostDaemonIDs}
+	host, err := iperfService.AddHost(context.Background(), hostName, hostHostname, hostType, hostDescription, hostPort, scope)
 	if err != nil {
 		return fmt.Errorf("failed to add host: %w", err)
 	}
@@ -161,6 +174,7 @@ func addHost(cmd *cobra.Command, args []string) error {
 	fmt.Printf("   Type:        %s\n", host.Type)
 	fmt.Printf("   Port:        %d\n", host.Port)
 	fmt.Printf("   Description: %s\n", host.Description)
+	fmt.Printf("   Daemons:     %s\n", formatHostScope(host))
 
 	return nil
 }
@@ -181,7 +195,7 @@ func deleteHost(cmd *cobra.Command, args []string) error {
 	defer client.Close()
 
 	// Initialize service
-	iperfService := services.NewIperfService(client)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, nil)
 
 	err = iperfService.DeleteHost(context.Background(), hostID)
 	if err != nil {
@@ -192,3 +206,19 @@ func deleteHost(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// formatHostScope describes which daemons test a host, e.g.
+// "site=denver,pi-01", or "all" for unscoped hosts
+func formatHostScope(host *ent.Host) string {
+	var parts []string
+	for key, value := range host.DaemonSelector {
+		parts = append(parts, key+"="+value)
+	}
+	sort.Strings(parts)
+	parts = append(parts, host.DaemonIds...)
+
+	if len(parts) == 0 {
+		return "all"
+	}
+	return strings.Join(parts, ",")
+}
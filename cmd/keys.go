@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bfirestone/speed-checker/internal/database"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// keysCmd represents the keys command
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Manage API keys",
+	Long: `Manage the API keys clients send in the X-API-Key header:
+
+• Create keys with submit, read and/or admin scopes
+• List keys with their scopes and last use
+• Revoke keys that are no longer needed
+
+Keys are only enforced when auth.enabled is set. Only a hash of each
+key is stored, so the key is shown once when it is created.`,
+}
+
+// keysCreateCmd represents the keys create command
+var keysCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new API key",
+	Long: `Create a new API key and print it once.
+
+Scopes:
+  submit  Submit results and runs, register, heartbeat and lease jobs
+  read    Read results, hosts, jobs, runs and daemons
+  admin   Everything, including managing hosts and deleting results
+
+A key bound to a daemon with --daemon can only submit as that daemon.
+
+Examples:
+  speed-checker keys create --name office-pi --scopes submit,read --daemon 0b6f...e21
+  speed-checker keys create --name dashboard --scopes read
+  speed-checker keys create --name ops --scopes admin`,
+	RunE: createKey,
+}
+
+// keysListCmd represents the keys list command
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API keys",
+	Long:  `List all API keys with their scopes, daemon binding and last use.`,
+	RunE:  listKeys,
+}
+
+// keysRevokeCmd represents the keys revoke command
+var keysRevokeCmd = &cobra.Command{
+	Use:   "revoke <key_id>",
+	Short: "Revoke an API key by ID",
+	Long:  `Revoke an API key so requests using it are rejected.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  revokeKey,
+}
+
+var (
+	keyName     string
+	keyScopes   []string
+	keyDaemonID string
+)
+
+func init() {
+	rootCmd.AddCommand(keysCmd)
+	keysCmd.AddCommand(keysCreateCmd)
+	keysCmd.AddCommand(keysListCmd)
+	keysCmd.AddCommand(keysRevokeCmd)
+
+	// Flags for create command
+	keysCreateCmd.Flags().StringVarP(&keyName, "name", "n", "", "Key name (required)")
+	keysCreateCmd.Flags().StringSliceVarP(&keyScopes, "scopes", "s", []string{services.ScopeSubmit, services.ScopeRead}, "Scopes: submit, read, admin")
+	keysCreateCmd.Flags().StringVarP(&keyDaemonID, "daemon", "d", "", "Bind the key to a daemon ID (optional)")
+
+	// Mark required flags
+	keysCreateCmd.MarkFlagRequired("name")
+}
+
+func createKey(cmd *cobra.Command, args []string) error {
+	cfg := GetConfig()
+
+	// Initialize database client
+	client, err := database.InitializeDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Initialize service
+	apiKeyService := services.NewAPIKeyService(client)
+
+	key, secret, err := apiKeyService.Create(context.Background(), keyName, keyScopes, keyDaemonID)
+	if err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	fmt.Printf("✅ API key created successfully:\n")
+	fmt.Printf("   ID:     %d\n", key.ID)
+	fmt.Printf("   Name:   %s\n", key.Name)
+	fmt.Printf("   Scopes: %s\n", strings.Join(key.Scopes, ","))
+	if key.DaemonID != nil {
+		fmt.Printf("   Daemon: %s\n", *key.DaemonID)
+	}
+	fmt.Printf("\n   Key:    %s\n", secret)
+	fmt.Printf("\n⚠️  Store this key now, it cannot be shown again.\n")
+
+	return nil
+}
+
+func listKeys(cmd *cobra.Command, args []string) error {
+	cfg := GetConfig()
+
+	// Initialize database client
+	client, err := database.InitializeDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Initialize service
+	apiKeyService := services.NewAPIKeyService(client)
+
+	keys, err := apiKeyService.List(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	if len(keys) == 0 {
+		fmt.Println("No API keys configured")
+		return nil
+	}
+
+	fmt.Printf("\n🔑 API Keys (%d total):\n", len(keys))
+	fmt.Printf("%-4s %-20s %-14s %-18s %-10s %-17s %s\n",
+		"ID", "Name", "Prefix", "Scopes", "Status", "Last Used", "Daemon")
+	fmt.Println("────────────────────────────────────────────────────────────────────────────────────────────────")
+
+	for _, key := range keys {
+		status := "active"
+		if key.RevokedAt != nil {
+			status = "revoked"
+		}
+
+		lastUsed := "never"
+		if key.LastUsedAt != nil {
+			lastUsed = key.LastUsedAt.Format("2006-01-02 15:04")
+		}
+
+		daemonID := "-"
+		if key.DaemonID != nil {
+			daemonID = *key.DaemonID
+		}
+
+		fmt.Printf("%-4d %-20s %-14s %-18s %-10s %-17s %s\n",
+			key.ID, key.Name, key.Prefix, strings.Join(key.Scopes, ","), status, lastUsed, daemonID)
+	}
+
+	return nil
+}
+
+func revokeKey(cmd *cobra.Command, args []string) error {
+	cfg := GetConfig()
+
+	keyID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid key ID: %s", args[0])
+	}
+
+	// Initialize database client
+	client, err := database.InitializeDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Initialize service
+	apiKeyService := services.NewAPIKeyService(client)
+
+	err = apiKeyService.Revoke(context.Background(), keyID)
+	if errors.Is(err, services.ErrAPIKeyNotFound) {
+		return fmt.Errorf("API key ID %d not found", keyID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	fmt.Printf("✅ API key ID %d revoked successfully\n", keyID)
+
+	return nil
+}
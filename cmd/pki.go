@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bfirestone/speed-checker/internal/pki"
+)
+
+// pkiCmd represents the pki command
+var pkiCmd = &cobra.Command{
+	Use:   "pki",
+	Short: "Manage certificates for mutual TLS",
+	Long: `Manage a local certificate authority for mutual TLS between
+daemons and the API server:
+
+• Create the certificate authority
+• Issue a server certificate for the API server
+• Issue client certificates for daemons
+
+A daemon's client certificate carries its daemon ID as the common name.
+The API server only accepts results from that daemon ID over a
+connection authenticated with the certificate.`,
+}
+
+// pkiInitCmd represents the pki init command
+var pkiInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create a local certificate authority",
+	Long: `Create a certificate authority (ca.crt and ca.key) in the PKI directory.
+Existing CA files are never overwritten.
+
+Examples:
+  speed-checker pki init
+  speed-checker pki init --dir /etc/speed-checker/pki --name "Home Lab CA"`,
+	RunE: initPKI,
+}
+
+// pkiIssueCmd represents the pki issue command
+var pkiIssueCmd = &cobra.Command{
+	Use:   "issue <common_name>",
+	Short: "Issue a daemon or server certificate",
+	Long: `Issue a certificate signed by the local certificate authority.
+
+Daemon certificates use the daemon ID as the common name. Reuse the ID
+from the daemon's state file to keep its history, or pick a new one.
+Server certificates need --server and the names clients connect to.
+
+Examples:
+  speed-checker pki issue 0b6f2c1e-6a55-4f4e-9f0e-3c1d2a7b8e21
+  speed-checker pki issue office-pi
+  speed-checker pki issue speed-checker-api --server --hosts api.example.com,10.0.0.5`,
+	Args: cobra.ExactArgs(1),
+	RunE: issueCertificate,
+}
+
+var (
+	pkiDir    string
+	pkiCAName string
+	pkiDays   int
+	pkiServer bool
+	pkiHosts  []string
+	pkiCADays int
+)
+
+func init() {
+	rootCmd.AddCommand(pkiCmd)
+	pkiCmd.AddCommand(pkiInitCmd)
+	pkiCmd.AddCommand(pkiIssueCmd)
+
+	pkiCmd.PersistentFlags().StringVar(&pkiDir, "dir", "./pki", "Directory holding the CA and issued certificates")
+
+	// Flags for init command
+	pkiInitCmd.Flags().StringVar(&pkiCAName, "name", "speed-checker CA", "Common name of the certificate authority")
+	pkiInitCmd.Flags().IntVar(&pkiCADays, "days", 3650, "Validity of the CA certificate in days")
+
+	// Flags for issue command
+	pkiIssueCmd.Flags().IntVar(&pkiDays, "days", 825, "Validity of the certificate in days")
+	pkiIssueCmd.Flags().BoolVar(&pkiServer, "server", false, "Issue a server certificate instead of a daemon certificate")
+	pkiIssueCmd.Flags().StringSliceVar(&pkiHosts, "hosts", nil, "DNS names and IP addresses for a server certificate")
+}
+
+func initPKI(cmd *cobra.Command, args []string) error {
+	certPath, err := pki.InitCA(pkiDir, pkiCAName, time.Duration(pkiCADays)*24*time.Hour)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate authority: %w", err)
+	}
+
+	fmt.Printf("✅ Certificate authority created:\n")
+	fmt.Printf("   Certificate: %s\n", certPath)
+	fmt.Printf("   Key:         %s\n", filepath.Join(pkiDir, pki.CAKeyFile))
+	fmt.Printf("\n⚠️  Keep the CA key private, anyone holding it can issue daemon certificates.\n")
+
+	return nil
+}
+
+func issueCertificate(cmd *cobra.Command, args []string) error {
+	if pkiServer && len(pkiHosts) == 0 {
+		return fmt.Errorf("--hosts is required for a server certificate")
+	}
+
+	certPath, keyPath, err := pki.Issue(pkiDir, args[0], pkiServer, pkiHosts, time.Duration(pkiDays)*24*time.Hour)
+	if err != nil {
+		return fmt.Errorf("failed to issue certificate: %w", err)
+	}
+
+	kind := "Daemon"
+	if pkiServer {
+		kind = "Server"
+	}
+
+	fmt.Printf("✅ %s certificate issued:\n", kind)
+	fmt.Printf("   Common name: %s\n", args[0])
+	fmt.Printf("   Certificate: %s\n", certPath)
+	fmt.Printf("   Key:         %s\n", keyPath)
+	fmt.Printf("   CA:          %s\n", filepath.Join(pkiDir, pki.CACertFile))
+
+	return nil
+}
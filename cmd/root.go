@@ -15,10 +15,15 @@ var (
 	cfg     *config.Config
 )
 
+// Version is the speed-checker version, overridden at build time with
+// -ldflags "-X github.com/bfirestone/speed-checker/cmd.Version=..."
+var Version = "dev"
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
-	Use:   "speed-checker",
-	Short: "A network speed and performance testing tool",
+	Use:     "speed-checker",
+	Short:   "A network speed and performance testing tool",
+	Version: Version,
 	Long: `Speed Checker is a comprehensive network testing tool that performs:
 
 • Automated internet speed tests using Ookla Speedtest CLI
@@ -52,15 +52,24 @@ var testListCmd = &cobra.Command{
 	Long: `List recent test results from the database.
 
 Examples:
-  speed-checker test list           # List both speed and iperf tests
-  speed-checker test list speed     # List only speed tests
-  speed-checker test list iperf     # List only iperf tests`,
+  speed-checker test list                            # List both speed and iperf tests
+  speed-checker test list speed                      # List only speed tests
+  speed-checker test list iperf                      # List only iperf tests
+  speed-checker test list speed --sort download_mbps # Slowest downloads first
+  speed-checker test list iperf --cursor <cursor>    # Next page of a listing
+
+--sort takes a column name, prefixed with - for descending order (default
+-timestamp). Speed tests sort by timestamp, download_mbps, upload_mbps,
+ping_ms and jitter_ms; iperf tests by timestamp, sent_mbps, received_mbps,
+mean_rtt_ms, retransmits and duration_seconds.`,
 	RunE: listTests,
 }
 
 var (
 	iperfDuration time.Duration
 	resultCount   int
+	resultSort    string
+	resultCursor  string
 )
 
 func init() {
@@ -74,6 +83,8 @@ func init() {
 
 	// Flags for list command
 	testListCmd.Flags().IntVarP(&resultCount, "count", "c", 10, "Number of results to show")
+	testListCmd.Flags().StringVar(&resultSort, "sort", "", "Column to sort by, prefixed with - for descending order")
+	testListCmd.Flags().StringVar(&resultCursor, "cursor", "", "Continue after the page that printed this cursor")
 }
 
 func runSpeedTest(cmd *cobra.Command, args []string) error {
@@ -87,7 +98,7 @@ func runSpeedTest(cmd *cobra.Command, args []string) error {
 	defer client.Close()
 
 	// Initialize service
-	speedTestService := services.NewSpeedTestService(client)
+	speedTestService := services.NewSpeedTestService(client, nil)
 
 	result, err := speedTestService.RunTest(context.Background())
 	if err != nil {
@@ -117,7 +128,7 @@ func runIperfTest(cmd *cobra.Command, args []string) error {
 	defer client.Close()
 
 	// Initialize service
-	iperfService := services.NewIperfService(client)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, nil)
 
 	if len(args) > 0 {
 		// TODO: Implement RunTestByHostID method or simplify approach
@@ -146,38 +157,50 @@ func listTests(cmd *cobra.Command, args []string) error {
 	defer client.Close()
 
 	// Initialize services
-	speedTestService := services.NewSpeedTestService(client)
-	iperfService := services.NewIperfService(client)
+	speedTestService := services.NewSpeedTestService(client, nil)
+	iperfService := services.NewIperfService(client, cfg.Testing.Dependencies, nil)
 
 	testType := "all"
 	if len(args) > 0 {
 		testType = args[0]
 	}
+	if testType == "all" && (resultSort != "" || resultCursor != "") {
+		return fmt.Errorf("--sort and --cursor need a test type, speed or iperf")
+	}
 
 	ctx := context.Background()
 
 	switch testType {
 	case "speed":
-		tests, err := speedTestService.GetRecentTests(ctx, resultCount)
+		page, err := speedTestService.Query(ctx, services.SpeedTestFilter{
+			Sort:   resultSort,
+			Cursor: resultCursor,
+			Limit:  resultCount,
+		})
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("\n🚀 Recent Speed Tests (%d results):\n", len(tests))
-		for _, test := range tests {
+		fmt.Printf("\n🚀 Speed Tests (%d of %d results):\n", len(page.Tests), page.Total)
+		for _, test := range page.Tests {
 			fmt.Printf("  %s | ↓%.1f ↑%.1f Mbps | %s\n",
 				test.Timestamp.Format("01-02 15:04"),
 				test.DownloadMbps, test.UploadMbps, test.ServerName)
 		}
+		printNextCursor(page.NextCursor)
 
 	case "iperf":
-		tests, err := iperfService.GetRecentTests(ctx, resultCount)
+		page, err := iperfService.Query(ctx, services.IperfTestFilter{
+			Sort:   resultSort,
+			Cursor: resultCursor,
+			Limit:  resultCount,
+		})
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("\n⚡ Recent Iperf Tests (%d results):\n", len(tests))
-		for _, test := range tests {
+		fmt.Printf("\n⚡ Iperf Tests (%d of %d results):\n", len(page.Tests), page.Total)
+		for _, test := range page.Tests {
 			hostName := "Unknown"
 			if test.Edges.Host != nil {
 				hostName = test.Edges.Host.Name
@@ -186,6 +209,7 @@ func listTests(cmd *cobra.Command, args []string) error {
 				test.Timestamp.Format("01-02 15:04"),
 				test.Protocol, test.ReceivedMbps, test.SentMbps, hostName)
 		}
+		printNextCursor(page.NextCursor)
 
 	default:
 		// Show both
@@ -222,3 +246,10 @@ func listTests(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// printNextCursor shows how to list the next page, if there is one
+func printNextCursor(cursor string) {
+	if cursor != "" {
+		fmt.Printf("\nMore results: --cursor %s\n", cursor)
+	}
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+)
+
+// Campaign is the model entity for the Campaign schema.
+type Campaign struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Human-friendly campaign name
+	Name string `json:"name,omitempty"`
+	// Why the campaign was run, e.g. the maintenance it follows
+	Description string `json:"description,omitempty"`
+	// Whether every daemon runs a speed test
+	Speedtest bool `json:"speedtest,omitempty"`
+	// Hosts every daemon runs an iperf test against
+	HostIds []int `json:"host_ids,omitempty"`
+	// Daemons carrying all of these labels take part
+	DaemonSelector map[string]string `json:"daemon_selector,omitempty"`
+	// Daemons that take part regardless of their labels
+	DaemonIds []string `json:"daemon_ids,omitempty"`
+	// iperf test duration in seconds; daemon default when unset
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// Time the daemons start the campaign's tests
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CampaignQuery when eager-loading is set.
+	Edges        CampaignEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CampaignEdges holds the relations/edges for other nodes in the graph.
+type CampaignEdges struct {
+	// Jobs enqueued for the campaign, one per daemon and test
+	Jobs []*Job `json:"jobs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e CampaignEdges) JobsOrErr() ([]*Job, error) {
+	if e.loadedTypes[0] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Campaign) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case campaign.FieldHostIds, campaign.FieldDaemonSelector, campaign.FieldDaemonIds:
+			values[i] = new([]byte)
+		case campaign.FieldSpeedtest:
+			values[i] = new(sql.NullBool)
+		case campaign.FieldID, campaign.FieldDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case campaign.FieldName, campaign.FieldDescription:
+			values[i] = new(sql.NullString)
+		case campaign.FieldScheduledAt, campaign.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Campaign fields.
+func (c *Campaign) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case campaign.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case campaign.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case campaign.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				c.Description = value.String
+			}
+		case campaign.FieldSpeedtest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field speedtest", values[i])
+			} else if value.Valid {
+				c.Speedtest = value.Bool
+			}
+		case campaign.FieldHostIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.HostIds); err != nil {
+					return fmt.Errorf("unmarshal field host_ids: %w", err)
+				}
+			}
+		case campaign.FieldDaemonSelector:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_selector", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.DaemonSelector); err != nil {
+					return fmt.Errorf("unmarshal field daemon_selector: %w", err)
+				}
+			}
+		case campaign.FieldDaemonIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.DaemonIds); err != nil {
+					return fmt.Errorf("unmarshal field daemon_ids: %w", err)
+				}
+			}
+		case campaign.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				c.DurationSeconds = int(value.Int64)
+			}
+		case campaign.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				c.ScheduledAt = value.Time
+			}
+		case campaign.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Campaign.
+// This includes values selected through modifiers, order, etc.
+func (c *Campaign) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryJobs queries the "jobs" edge of the Campaign entity.
+func (c *Campaign) QueryJobs() *JobQuery {
+	return NewCampaignClient(c.config).QueryJobs(c)
+}
+
+// Update returns a builder for updating this Campaign.
+// Note that you need to call Campaign.Unwrap() before calling this method if this Campaign
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Campaign) Update() *CampaignUpdateOne {
+	return NewCampaignClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Campaign entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Campaign) Unwrap() *Campaign {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Campaign is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Campaign) String() string {
+	var builder strings.Builder
+	builder.WriteString("Campaign(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(c.Description)
+	builder.WriteString(", ")
+	builder.WriteString("speedtest=")
+	builder.WriteString(fmt.Sprintf("%v", c.Speedtest))
+	builder.WriteString(", ")
+	builder.WriteString("host_ids=")
+	builder.WriteString(fmt.Sprintf("%v", c.HostIds))
+	builder.WriteString(", ")
+	builder.WriteString("daemon_selector=")
+	builder.WriteString(fmt.Sprintf("%v", c.DaemonSelector))
+	builder.WriteString(", ")
+	builder.WriteString("daemon_ids=")
+	builder.WriteString(fmt.Sprintf("%v", c.DaemonIds))
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", c.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(c.ScheduledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Campaigns is a parsable slice of Campaign.
+type Campaigns []*Campaign
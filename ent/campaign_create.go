@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/job"
+)
+
+// CampaignCreate is the builder for creating a Campaign entity.
+type CampaignCreate struct {
+	config
+	mutation *CampaignMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (cc *CampaignCreate) SetName(s string) *CampaignCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetDescription sets the "description" field.
+func (cc *CampaignCreate) SetDescription(s string) *CampaignCreate {
+	cc.mutation.SetDescription(s)
+	return cc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableDescription(s *string) *CampaignCreate {
+	if s != nil {
+		cc.SetDescription(*s)
+	}
+	return cc
+}
+
+// SetSpeedtest sets the "speedtest" field.
+func (cc *CampaignCreate) SetSpeedtest(b bool) *CampaignCreate {
+	cc.mutation.SetSpeedtest(b)
+	return cc
+}
+
+// SetNillableSpeedtest sets the "speedtest" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableSpeedtest(b *bool) *CampaignCreate {
+	if b != nil {
+		cc.SetSpeedtest(*b)
+	}
+	return cc
+}
+
+// SetHostIds sets the "host_ids" field.
+func (cc *CampaignCreate) SetHostIds(i []int) *CampaignCreate {
+	cc.mutation.SetHostIds(i)
+	return cc
+}
+
+// SetDaemonSelector sets the "daemon_selector" field.
+func (cc *CampaignCreate) SetDaemonSelector(m map[string]string) *CampaignCreate {
+	cc.mutation.SetDaemonSelector(m)
+	return cc
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (cc *CampaignCreate) SetDaemonIds(s []string) *CampaignCreate {
+	cc.mutation.SetDaemonIds(s)
+	return cc
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (cc *CampaignCreate) SetDurationSeconds(i int) *CampaignCreate {
+	cc.mutation.SetDurationSeconds(i)
+	return cc
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableDurationSeconds(i *int) *CampaignCreate {
+	if i != nil {
+		cc.SetDurationSeconds(*i)
+	}
+	return cc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (cc *CampaignCreate) SetScheduledAt(t time.Time) *CampaignCreate {
+	cc.mutation.SetScheduledAt(t)
+	return cc
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableScheduledAt(t *time.Time) *CampaignCreate {
+	if t != nil {
+		cc.SetScheduledAt(*t)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CampaignCreate) SetCreatedAt(t time.Time) *CampaignCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CampaignCreate) SetNillableCreatedAt(t *time.Time) *CampaignCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (cc *CampaignCreate) AddJobIDs(ids ...int) *CampaignCreate {
+	cc.mutation.AddJobIDs(ids...)
+	return cc
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (cc *CampaignCreate) AddJobs(j ...*Job) *CampaignCreate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cc.AddJobIDs(ids...)
+}
+
+// Mutation returns the CampaignMutation object of the builder.
+func (cc *CampaignCreate) Mutation() *CampaignMutation {
+	return cc.mutation
+}
+
+// Save creates the Campaign in the database.
+func (cc *CampaignCreate) Save(ctx context.Context) (*Campaign, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CampaignCreate) SaveX(ctx context.Context) *Campaign {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CampaignCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CampaignCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CampaignCreate) defaults() {
+	if _, ok := cc.mutation.Speedtest(); !ok {
+		v := campaign.DefaultSpeedtest
+		cc.mutation.SetSpeedtest(v)
+	}
+	if _, ok := cc.mutation.ScheduledAt(); !ok {
+		v := campaign.DefaultScheduledAt()
+		cc.mutation.SetScheduledAt(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := campaign.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CampaignCreate) check() error {
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Campaign.name"`)}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := campaign.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Campaign.name": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Speedtest(); !ok {
+		return &ValidationError{Name: "speedtest", err: errors.New(`ent: missing required field "Campaign.speedtest"`)}
+	}
+	if _, ok := cc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "Campaign.scheduled_at"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Campaign.created_at"`)}
+	}
+	return nil
+}
+
+func (cc *CampaignCreate) sqlSave(ctx context.Context) (*Campaign, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CampaignCreate) createSpec() (*Campaign, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Campaign{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(campaign.Table, sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(campaign.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.Description(); ok {
+		_spec.SetField(campaign.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := cc.mutation.Speedtest(); ok {
+		_spec.SetField(campaign.FieldSpeedtest, field.TypeBool, value)
+		_node.Speedtest = value
+	}
+	if value, ok := cc.mutation.HostIds(); ok {
+		_spec.SetField(campaign.FieldHostIds, field.TypeJSON, value)
+		_node.HostIds = value
+	}
+	if value, ok := cc.mutation.DaemonSelector(); ok {
+		_spec.SetField(campaign.FieldDaemonSelector, field.TypeJSON, value)
+		_node.DaemonSelector = value
+	}
+	if value, ok := cc.mutation.DaemonIds(); ok {
+		_spec.SetField(campaign.FieldDaemonIds, field.TypeJSON, value)
+		_node.DaemonIds = value
+	}
+	if value, ok := cc.mutation.DurationSeconds(); ok {
+		_spec.SetField(campaign.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := cc.mutation.ScheduledAt(); ok {
+		_spec.SetField(campaign.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(campaign.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CampaignCreateBulk is the builder for creating many Campaign entities in bulk.
+type CampaignCreateBulk struct {
+	config
+	err      error
+	builders []*CampaignCreate
+}
+
+// Save creates the Campaign entities in the database.
+func (ccb *CampaignCreateBulk) Save(ctx context.Context) ([]*Campaign, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Campaign, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CampaignMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CampaignCreateBulk) SaveX(ctx context.Context) []*Campaign {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CampaignCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CampaignCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
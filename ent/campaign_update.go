@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// CampaignUpdate is the builder for updating Campaign entities.
+type CampaignUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CampaignUpdate builder.
+func (cu *CampaignUpdate) Where(ps ...predicate.Campaign) *CampaignUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CampaignUpdate) SetName(s string) *CampaignUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableName(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetDescription sets the "description" field.
+func (cu *CampaignUpdate) SetDescription(s string) *CampaignUpdate {
+	cu.mutation.SetDescription(s)
+	return cu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableDescription(s *string) *CampaignUpdate {
+	if s != nil {
+		cu.SetDescription(*s)
+	}
+	return cu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cu *CampaignUpdate) ClearDescription() *CampaignUpdate {
+	cu.mutation.ClearDescription()
+	return cu
+}
+
+// SetSpeedtest sets the "speedtest" field.
+func (cu *CampaignUpdate) SetSpeedtest(b bool) *CampaignUpdate {
+	cu.mutation.SetSpeedtest(b)
+	return cu
+}
+
+// SetNillableSpeedtest sets the "speedtest" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableSpeedtest(b *bool) *CampaignUpdate {
+	if b != nil {
+		cu.SetSpeedtest(*b)
+	}
+	return cu
+}
+
+// SetHostIds sets the "host_ids" field.
+func (cu *CampaignUpdate) SetHostIds(i []int) *CampaignUpdate {
+	cu.mutation.SetHostIds(i)
+	return cu
+}
+
+// AppendHostIds appends i to the "host_ids" field.
+func (cu *CampaignUpdate) AppendHostIds(i []int) *CampaignUpdate {
+	cu.mutation.AppendHostIds(i)
+	return cu
+}
+
+// ClearHostIds clears the value of the "host_ids" field.
+func (cu *CampaignUpdate) ClearHostIds() *CampaignUpdate {
+	cu.mutation.ClearHostIds()
+	return cu
+}
+
+// SetDaemonSelector sets the "daemon_selector" field.
+func (cu *CampaignUpdate) SetDaemonSelector(m map[string]string) *CampaignUpdate {
+	cu.mutation.SetDaemonSelector(m)
+	return cu
+}
+
+// ClearDaemonSelector clears the value of the "daemon_selector" field.
+func (cu *CampaignUpdate) ClearDaemonSelector() *CampaignUpdate {
+	cu.mutation.ClearDaemonSelector()
+	return cu
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (cu *CampaignUpdate) SetDaemonIds(s []string) *CampaignUpdate {
+	cu.mutation.SetDaemonIds(s)
+	return cu
+}
+
+// AppendDaemonIds appends s to the "daemon_ids" field.
+func (cu *CampaignUpdate) AppendDaemonIds(s []string) *CampaignUpdate {
+	cu.mutation.AppendDaemonIds(s)
+	return cu
+}
+
+// ClearDaemonIds clears the value of the "daemon_ids" field.
+func (cu *CampaignUpdate) ClearDaemonIds() *CampaignUpdate {
+	cu.mutation.ClearDaemonIds()
+	return cu
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (cu *CampaignUpdate) SetDurationSeconds(i int) *CampaignUpdate {
+	cu.mutation.ResetDurationSeconds()
+	cu.mutation.SetDurationSeconds(i)
+	return cu
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (cu *CampaignUpdate) SetNillableDurationSeconds(i *int) *CampaignUpdate {
+	if i != nil {
+		cu.SetDurationSeconds(*i)
+	}
+	return cu
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (cu *CampaignUpdate) AddDurationSeconds(i int) *CampaignUpdate {
+	cu.mutation.AddDurationSeconds(i)
+	return cu
+}
+
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (cu *CampaignUpdate) ClearDurationSeconds() *CampaignUpdate {
+	cu.mutation.ClearDurationSeconds()
+	return cu
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (cu *CampaignUpdate) AddJobIDs(ids ...int) *CampaignUpdate {
+	cu.mutation.AddJobIDs(ids...)
+	return cu
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (cu *CampaignUpdate) AddJobs(j ...*Job) *CampaignUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cu.AddJobIDs(ids...)
+}
+
+// Mutation returns the CampaignMutation object of the builder.
+func (cu *CampaignUpdate) Mutation() *CampaignMutation {
+	return cu.mutation
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (cu *CampaignUpdate) ClearJobs() *CampaignUpdate {
+	cu.mutation.ClearJobs()
+	return cu
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (cu *CampaignUpdate) RemoveJobIDs(ids ...int) *CampaignUpdate {
+	cu.mutation.RemoveJobIDs(ids...)
+	return cu
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (cu *CampaignUpdate) RemoveJobs(j ...*Job) *CampaignUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cu.RemoveJobIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CampaignUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CampaignUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CampaignUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CampaignUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CampaignUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := campaign.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Campaign.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CampaignUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CampaignUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CampaignUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(campaign.Table, campaign.Columns, sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(campaign.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Description(); ok {
+		_spec.SetField(campaign.FieldDescription, field.TypeString, value)
+	}
+	if cu.mutation.DescriptionCleared() {
+		_spec.ClearField(campaign.FieldDescription, field.TypeString)
+	}
+	if value, ok := cu.mutation.Speedtest(); ok {
+		_spec.SetField(campaign.FieldSpeedtest, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.HostIds(); ok {
+		_spec.SetField(campaign.FieldHostIds, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedHostIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, campaign.FieldHostIds, value)
+		})
+	}
+	if cu.mutation.HostIdsCleared() {
+		_spec.ClearField(campaign.FieldHostIds, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.DaemonSelector(); ok {
+		_spec.SetField(campaign.FieldDaemonSelector, field.TypeJSON, value)
+	}
+	if cu.mutation.DaemonSelectorCleared() {
+		_spec.ClearField(campaign.FieldDaemonSelector, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.DaemonIds(); ok {
+		_spec.SetField(campaign.FieldDaemonIds, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedDaemonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, campaign.FieldDaemonIds, value)
+		})
+	}
+	if cu.mutation.DaemonIdsCleared() {
+		_spec.ClearField(campaign.FieldDaemonIds, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.DurationSeconds(); ok {
+		_spec.SetField(campaign.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(campaign.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if cu.mutation.DurationSecondsCleared() {
+		_spec.ClearField(campaign.FieldDurationSeconds, field.TypeInt)
+	}
+	if cu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedJobsIDs(); len(nodes) > 0 && !cu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{campaign.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CampaignUpdateOne is the builder for updating a single Campaign entity.
+type CampaignUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (cuo *CampaignUpdateOne) SetName(s string) *CampaignUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableName(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetDescription sets the "description" field.
+func (cuo *CampaignUpdateOne) SetDescription(s string) *CampaignUpdateOne {
+	cuo.mutation.SetDescription(s)
+	return cuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableDescription(s *string) *CampaignUpdateOne {
+	if s != nil {
+		cuo.SetDescription(*s)
+	}
+	return cuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cuo *CampaignUpdateOne) ClearDescription() *CampaignUpdateOne {
+	cuo.mutation.ClearDescription()
+	return cuo
+}
+
+// SetSpeedtest sets the "speedtest" field.
+func (cuo *CampaignUpdateOne) SetSpeedtest(b bool) *CampaignUpdateOne {
+	cuo.mutation.SetSpeedtest(b)
+	return cuo
+}
+
+// SetNillableSpeedtest sets the "speedtest" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableSpeedtest(b *bool) *CampaignUpdateOne {
+	if b != nil {
+		cuo.SetSpeedtest(*b)
+	}
+	return cuo
+}
+
+// SetHostIds sets the "host_ids" field.
+func (cuo *CampaignUpdateOne) SetHostIds(i []int) *CampaignUpdateOne {
+	cuo.mutation.SetHostIds(i)
+	return cuo
+}
+
+// AppendHostIds appends i to the "host_ids" field.
+func (cuo *CampaignUpdateOne) AppendHostIds(i []int) *CampaignUpdateOne {
+	cuo.mutation.AppendHostIds(i)
+	return cuo
+}
+
+// ClearHostIds clears the value of the "host_ids" field.
+func (cuo *CampaignUpdateOne) ClearHostIds() *CampaignUpdateOne {
+	cuo.mutation.ClearHostIds()
+	return cuo
+}
+
+// SetDaemonSelector sets the "daemon_selector" field.
+func (cuo *CampaignUpdateOne) SetDaemonSelector(m map[string]string) *CampaignUpdateOne {
+	cuo.mutation.SetDaemonSelector(m)
+	return cuo
+}
+
+// ClearDaemonSelector clears the value of the "daemon_selector" field.
+func (cuo *CampaignUpdateOne) ClearDaemonSelector() *CampaignUpdateOne {
+	cuo.mutation.ClearDaemonSelector()
+	return cuo
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (cuo *CampaignUpdateOne) SetDaemonIds(s []string) *CampaignUpdateOne {
+	cuo.mutation.SetDaemonIds(s)
+	return cuo
+}
+
+// AppendDaemonIds appends s to the "daemon_ids" field.
+func (cuo *CampaignUpdateOne) AppendDaemonIds(s []string) *CampaignUpdateOne {
+	cuo.mutation.AppendDaemonIds(s)
+	return cuo
+}
+
+// ClearDaemonIds clears the value of the "daemon_ids" field.
+func (cuo *CampaignUpdateOne) ClearDaemonIds() *CampaignUpdateOne {
+	cuo.mutation.ClearDaemonIds()
+	return cuo
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (cuo *CampaignUpdateOne) SetDurationSeconds(i int) *CampaignUpdateOne {
+	cuo.mutation.ResetDurationSeconds()
+	cuo.mutation.SetDurationSeconds(i)
+	return cuo
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (cuo *CampaignUpdateOne) SetNillableDurationSeconds(i *int) *CampaignUpdateOne {
+	if i != nil {
+		cuo.SetDurationSeconds(*i)
+	}
+	return cuo
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (cuo *CampaignUpdateOne) AddDurationSeconds(i int) *CampaignUpdateOne {
+	cuo.mutation.AddDurationSeconds(i)
+	return cuo
+}
+
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (cuo *CampaignUpdateOne) ClearDurationSeconds() *CampaignUpdateOne {
+	cuo.mutation.ClearDurationSeconds()
+	return cuo
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (cuo *CampaignUpdateOne) AddJobIDs(ids ...int) *CampaignUpdateOne {
+	cuo.mutation.AddJobIDs(ids...)
+	return cuo
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (cuo *CampaignUpdateOne) AddJobs(j ...*Job) *CampaignUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cuo.AddJobIDs(ids...)
+}
+
+// Mutation returns the CampaignMutation object of the builder.
+func (cuo *CampaignUpdateOne) Mutation() *CampaignMutation {
+	return cuo.mutation
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (cuo *CampaignUpdateOne) ClearJobs() *CampaignUpdateOne {
+	cuo.mutation.ClearJobs()
+	return cuo
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (cuo *CampaignUpdateOne) RemoveJobIDs(ids ...int) *CampaignUpdateOne {
+	cuo.mutation.RemoveJobIDs(ids...)
+	return cuo
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (cuo *CampaignUpdateOne) RemoveJobs(j ...*Job) *CampaignUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return cuo.RemoveJobIDs(ids...)
+}
+
+// Where appends a list predicates to the CampaignUpdate builder.
+func (cuo *CampaignUpdateOne) Where(ps ...predicate.Campaign) *CampaignUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CampaignUpdateOne) Select(field string, fields ...string) *CampaignUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Campaign entity.
+func (cuo *CampaignUpdateOne) Save(ctx context.Context) (*Campaign, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CampaignUpdateOne) SaveX(ctx context.Context) *Campaign {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CampaignUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CampaignUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CampaignUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := campaign.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Campaign.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CampaignUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CampaignUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CampaignUpdateOne) sqlSave(ctx context.Context) (_node *Campaign, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(campaign.Table, campaign.Columns, sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Campaign.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, campaign.FieldID)
+		for _, f := range fields {
+			if !campaign.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != campaign.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(campaign.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Description(); ok {
+		_spec.SetField(campaign.FieldDescription, field.TypeString, value)
+	}
+	if cuo.mutation.DescriptionCleared() {
+		_spec.ClearField(campaign.FieldDescription, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Speedtest(); ok {
+		_spec.SetField(campaign.FieldSpeedtest, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.HostIds(); ok {
+		_spec.SetField(campaign.FieldHostIds, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedHostIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, campaign.FieldHostIds, value)
+		})
+	}
+	if cuo.mutation.HostIdsCleared() {
+		_spec.ClearField(campaign.FieldHostIds, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.DaemonSelector(); ok {
+		_spec.SetField(campaign.FieldDaemonSelector, field.TypeJSON, value)
+	}
+	if cuo.mutation.DaemonSelectorCleared() {
+		_spec.ClearField(campaign.FieldDaemonSelector, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.DaemonIds(); ok {
+		_spec.SetField(campaign.FieldDaemonIds, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedDaemonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, campaign.FieldDaemonIds, value)
+		})
+	}
+	if cuo.mutation.DaemonIdsCleared() {
+		_spec.ClearField(campaign.FieldDaemonIds, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.DurationSeconds(); ok {
+		_spec.SetField(campaign.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(campaign.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if cuo.mutation.DurationSecondsCleared() {
+		_spec.ClearField(campaign.FieldDurationSeconds, field.TypeInt)
+	}
+	if cuo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedJobsIDs(); len(nodes) > 0 && !cuo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   campaign.JobsTable,
+			Columns: []string{campaign.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Campaign{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{campaign.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
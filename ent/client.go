@@ -15,9 +15,18 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bfirestone/speed-checker/ent/apikey"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +34,26 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// Campaign is the client for interacting with the Campaign builders.
+	Campaign *CampaignClient
+	// Daemon is the client for interacting with the Daemon builders.
+	Daemon *DaemonClient
+	// DaemonConfig is the client for interacting with the DaemonConfig builders.
+	DaemonConfig *DaemonConfigClient
 	// Host is the client for interacting with the Host builders.
 	Host *HostClient
 	// IperfTest is the client for interacting with the IperfTest builders.
 	IperfTest *IperfTestClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// MeshTest is the client for interacting with the MeshTest builders.
+	MeshTest *MeshTestClient
 	// SpeedTest is the client for interacting with the SpeedTest builders.
 	SpeedTest *SpeedTestClient
+	// TestRun is the client for interacting with the TestRun builders.
+	TestRun *TestRunClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +65,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
+	c.Campaign = NewCampaignClient(c.config)
+	c.Daemon = NewDaemonClient(c.config)
+	c.DaemonConfig = NewDaemonConfigClient(c.config)
 	c.Host = NewHostClient(c.config)
 	c.IperfTest = NewIperfTestClient(c.config)
+	c.Job = NewJobClient(c.config)
+	c.MeshTest = NewMeshTestClient(c.config)
 	c.SpeedTest = NewSpeedTestClient(c.config)
+	c.TestRun = NewTestRunClient(c.config)
 }
 
 type (
@@ -135,11 +165,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Host:      NewHostClient(cfg),
-		IperfTest: NewIperfTestClient(cfg),
-		SpeedTest: NewSpeedTestClient(cfg),
+		ctx:          ctx,
+		config:       cfg,
+		APIKey:       NewAPIKeyClient(cfg),
+		Campaign:     NewCampaignClient(cfg),
+		Daemon:       NewDaemonClient(cfg),
+		DaemonConfig: NewDaemonConfigClient(cfg),
+		Host:         NewHostClient(cfg),
+		IperfTest:    NewIperfTestClient(cfg),
+		Job:          NewJobClient(cfg),
+		MeshTest:     NewMeshTestClient(cfg),
+		SpeedTest:    NewSpeedTestClient(cfg),
+		TestRun:      NewTestRunClient(cfg),
 	}, nil
 }
 
@@ -157,18 +194,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Host:      NewHostClient(cfg),
-		IperfTest: NewIperfTestClient(cfg),
-		SpeedTest: NewSpeedTestClient(cfg),
+		ctx:          ctx,
+		config:       cfg,
+		APIKey:       NewAPIKeyClient(cfg),
+		Campaign:     NewCampaignClient(cfg),
+		Daemon:       NewDaemonClient(cfg),
+		DaemonConfig: NewDaemonConfigClient(cfg),
+		Host:         NewHostClient(cfg),
+		IperfTest:    NewIperfTestClient(cfg),
+		Job:          NewJobClient(cfg),
+		MeshTest:     NewMeshTestClient(cfg),
+		SpeedTest:    NewSpeedTestClient(cfg),
+		TestRun:      NewTestRunClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Host.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,134 +234,154 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Host.Use(hooks...)
-	c.IperfTest.Use(hooks...)
-	c.SpeedTest.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.APIKey, c.Campaign, c.Daemon, c.DaemonConfig, c.Host, c.IperfTest, c.Job,
+		c.MeshTest, c.SpeedTest, c.TestRun,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Host.Intercept(interceptors...)
-	c.IperfTest.Intercept(interceptors...)
-	c.SpeedTest.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.APIKey, c.Campaign, c.Daemon, c.DaemonConfig, c.Host, c.IperfTest, c.Job,
+		c.MeshTest, c.SpeedTest, c.TestRun,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
+	case *CampaignMutation:
+		return c.Campaign.mutate(ctx, m)
+	case *DaemonMutation:
+		return c.Daemon.mutate(ctx, m)
+	case *DaemonConfigMutation:
+		return c.DaemonConfig.mutate(ctx, m)
 	case *HostMutation:
 		return c.Host.mutate(ctx, m)
 	case *IperfTestMutation:
 		return c.IperfTest.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
+	case *MeshTestMutation:
+		return c.MeshTest.mutate(ctx, m)
 	case *SpeedTestMutation:
 		return c.SpeedTest.mutate(ctx, m)
+	case *TestRunMutation:
+		return c.TestRun.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// HostClient is a client for the Host schema.
-type HostClient struct {
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
 	config
 }
 
-// NewHostClient returns a client for the Host from the given config.
-func NewHostClient(c config) *HostClient {
-	return &HostClient{config: c}
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `host.Hooks(f(g(h())))`.
-func (c *HostClient) Use(hooks ...Hook) {
-	c.hooks.Host = append(c.hooks.Host, hooks...)
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `host.Intercept(f(g(h())))`.
-func (c *HostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Host = append(c.inters.Host, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
 }
 
-// Create returns a builder for creating a Host entity.
-func (c *HostClient) Create() *HostCreate {
-	mutation := newHostMutation(c.config, OpCreate)
-	return &HostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Host entities.
-func (c *HostClient) CreateBulk(builders ...*HostCreate) *HostCreateBulk {
-	return &HostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HostClient) MapCreateBulk(slice any, setFunc func(*HostCreate, int)) *HostCreateBulk {
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HostCreateBulk{err: fmt.Errorf("calling to HostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HostCreate, rv.Len())
+	builders := make([]*APIKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HostCreateBulk{config: c.config, builders: builders}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Host.
-func (c *HostClient) Update() *HostUpdate {
-	mutation := newHostMutation(c.config, OpUpdate)
-	return &HostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HostClient) UpdateOne(h *Host) *HostUpdateOne {
-	mutation := newHostMutation(c.config, OpUpdateOne, withHost(h))
-	return &HostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIKeyClient) UpdateOne(ak *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(ak))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HostClient) UpdateOneID(id int) *HostUpdateOne {
-	mutation := newHostMutation(c.config, OpUpdateOne, withHostID(id))
-	return &HostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIKeyClient) UpdateOneID(id int) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Host.
-func (c *HostClient) Delete() *HostDelete {
-	mutation := newHostMutation(c.config, OpDelete)
-	return &HostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HostClient) DeleteOne(h *Host) *HostDeleteOne {
-	return c.DeleteOneID(h.ID)
+func (c *APIKeyClient) DeleteOne(ak *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HostClient) DeleteOneID(id int) *HostDeleteOne {
-	builder := c.Delete().Where(host.ID(id))
+func (c *APIKeyClient) DeleteOneID(id int) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HostDeleteOne{builder}
+	return &APIKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Host.
-func (c *HostClient) Query() *HostQuery {
-	return &HostQuery{
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHost},
+		ctx:    &QueryContext{Type: TypeAPIKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Host entity by its id.
-func (c *HostClient) Get(ctx context.Context, id int) (*Host, error) {
-	return c.Query().Where(host.ID(id)).Only(ctx)
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id int) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HostClient) GetX(ctx context.Context, id int) *Host {
+func (c *APIKeyClient) GetX(ctx context.Context, id int) *APIKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -325,148 +389,132 @@ func (c *HostClient) GetX(ctx context.Context, id int) *Host {
 	return obj
 }
 
-// QueryIperfTests queries the iperf_tests edge of a Host.
-func (c *HostClient) QueryIperfTests(h *Host) *IperfTestQuery {
-	query := (&IperfTestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := h.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(host.Table, host.FieldID, id),
-			sqlgraph.To(iperftest.Table, iperftest.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, host.IperfTestsTable, host.IperfTestsColumn),
-		)
-		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *HostClient) Hooks() []Hook {
-	return c.hooks.Host
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *HostClient) Interceptors() []Interceptor {
-	return c.inters.Host
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
 }
 
-func (c *HostClient) mutate(ctx context.Context, m *HostMutation) (Value, error) {
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Host mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown APIKey mutation op: %q", m.Op())
 	}
 }
 
-// IperfTestClient is a client for the IperfTest schema.
-type IperfTestClient struct {
+// CampaignClient is a client for the Campaign schema.
+type CampaignClient struct {
 	config
 }
 
-// NewIperfTestClient returns a client for the IperfTest from the given config.
-func NewIperfTestClient(c config) *IperfTestClient {
-	return &IperfTestClient{config: c}
+// NewCampaignClient returns a client for the Campaign from the given config.
+func NewCampaignClient(c config) *CampaignClient {
+	return &CampaignClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `iperftest.Hooks(f(g(h())))`.
-func (c *IperfTestClient) Use(hooks ...Hook) {
-	c.hooks.IperfTest = append(c.hooks.IperfTest, hooks...)
+// A call to `Use(f, g, h)` equals to `campaign.Hooks(f(g(h())))`.
+func (c *CampaignClient) Use(hooks ...Hook) {
+	c.hooks.Campaign = append(c.hooks.Campaign, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `iperftest.Intercept(f(g(h())))`.
-func (c *IperfTestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IperfTest = append(c.inters.IperfTest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `campaign.Intercept(f(g(h())))`.
+func (c *CampaignClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Campaign = append(c.inters.Campaign, interceptors...)
 }
 
-// Create returns a builder for creating a IperfTest entity.
-func (c *IperfTestClient) Create() *IperfTestCreate {
-	mutation := newIperfTestMutation(c.config, OpCreate)
-	return &IperfTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Campaign entity.
+func (c *CampaignClient) Create() *CampaignCreate {
+	mutation := newCampaignMutation(c.config, OpCreate)
+	return &CampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IperfTest entities.
-func (c *IperfTestClient) CreateBulk(builders ...*IperfTestCreate) *IperfTestCreateBulk {
-	return &IperfTestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Campaign entities.
+func (c *CampaignClient) CreateBulk(builders ...*CampaignCreate) *CampaignCreateBulk {
+	return &CampaignCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IperfTestClient) MapCreateBulk(slice any, setFunc func(*IperfTestCreate, int)) *IperfTestCreateBulk {
+func (c *CampaignClient) MapCreateBulk(slice any, setFunc func(*CampaignCreate, int)) *CampaignCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IperfTestCreateBulk{err: fmt.Errorf("calling to IperfTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CampaignCreateBulk{err: fmt.Errorf("calling to CampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IperfTestCreate, rv.Len())
+	builders := make([]*CampaignCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IperfTestCreateBulk{config: c.config, builders: builders}
+	return &CampaignCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IperfTest.
-func (c *IperfTestClient) Update() *IperfTestUpdate {
-	mutation := newIperfTestMutation(c.config, OpUpdate)
-	return &IperfTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Campaign.
+func (c *CampaignClient) Update() *CampaignUpdate {
+	mutation := newCampaignMutation(c.config, OpUpdate)
+	return &CampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IperfTestClient) UpdateOne(it *IperfTest) *IperfTestUpdateOne {
-	mutation := newIperfTestMutation(c.config, OpUpdateOne, withIperfTest(it))
-	return &IperfTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CampaignClient) UpdateOne(ca *Campaign) *CampaignUpdateOne {
+	mutation := newCampaignMutation(c.config, OpUpdateOne, withCampaign(ca))
+	return &CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IperfTestClient) UpdateOneID(id int) *IperfTestUpdateOne {
-	mutation := newIperfTestMutation(c.config, OpUpdateOne, withIperfTestID(id))
-	return &IperfTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CampaignClient) UpdateOneID(id int) *CampaignUpdateOne {
+	mutation := newCampaignMutation(c.config, OpUpdateOne, withCampaignID(id))
+	return &CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IperfTest.
-func (c *IperfTestClient) Delete() *IperfTestDelete {
-	mutation := newIperfTestMutation(c.config, OpDelete)
-	return &IperfTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Campaign.
+func (c *CampaignClient) Delete() *CampaignDelete {
+	mutation := newCampaignMutation(c.config, OpDelete)
+	return &CampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IperfTestClient) DeleteOne(it *IperfTest) *IperfTestDeleteOne {
-	return c.DeleteOneID(it.ID)
+func (c *CampaignClient) DeleteOne(ca *Campaign) *CampaignDeleteOne {
+	return c.DeleteOneID(ca.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IperfTestClient) DeleteOneID(id int) *IperfTestDeleteOne {
-	builder := c.Delete().Where(iperftest.ID(id))
+func (c *CampaignClient) DeleteOneID(id int) *CampaignDeleteOne {
+	builder := c.Delete().Where(campaign.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IperfTestDeleteOne{builder}
+	return &CampaignDeleteOne{builder}
 }
 
-// Query returns a query builder for IperfTest.
-func (c *IperfTestClient) Query() *IperfTestQuery {
-	return &IperfTestQuery{
+// Query returns a query builder for Campaign.
+func (c *CampaignClient) Query() *CampaignQuery {
+	return &CampaignQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIperfTest},
+		ctx:    &QueryContext{Type: TypeCampaign},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IperfTest entity by its id.
-func (c *IperfTestClient) Get(ctx context.Context, id int) (*IperfTest, error) {
-	return c.Query().Where(iperftest.ID(id)).Only(ctx)
+// Get returns a Campaign entity by its id.
+func (c *CampaignClient) Get(ctx context.Context, id int) (*Campaign, error) {
+	return c.Query().Where(campaign.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IperfTestClient) GetX(ctx context.Context, id int) *IperfTest {
+func (c *CampaignClient) GetX(ctx context.Context, id int) *Campaign {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -474,148 +522,148 @@ func (c *IperfTestClient) GetX(ctx context.Context, id int) *IperfTest {
 	return obj
 }
 
-// QueryHost queries the host edge of a IperfTest.
-func (c *IperfTestClient) QueryHost(it *IperfTest) *HostQuery {
-	query := (&HostClient{config: c.config}).Query()
+// QueryJobs queries the jobs edge of a Campaign.
+func (c *CampaignClient) QueryJobs(ca *Campaign) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := it.ID
+		id := ca.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(iperftest.Table, iperftest.FieldID, id),
-			sqlgraph.To(host.Table, host.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, iperftest.HostTable, iperftest.HostColumn),
+			sqlgraph.From(campaign.Table, campaign.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, campaign.JobsTable, campaign.JobsColumn),
 		)
-		fromV = sqlgraph.Neighbors(it.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *IperfTestClient) Hooks() []Hook {
-	return c.hooks.IperfTest
+func (c *CampaignClient) Hooks() []Hook {
+	return c.hooks.Campaign
 }
 
 // Interceptors returns the client interceptors.
-func (c *IperfTestClient) Interceptors() []Interceptor {
-	return c.inters.IperfTest
+func (c *CampaignClient) Interceptors() []Interceptor {
+	return c.inters.Campaign
 }
 
-func (c *IperfTestClient) mutate(ctx context.Context, m *IperfTestMutation) (Value, error) {
+func (c *CampaignClient) mutate(ctx context.Context, m *CampaignMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IperfTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IperfTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IperfTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IperfTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IperfTest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Campaign mutation op: %q", m.Op())
 	}
 }
 
-// SpeedTestClient is a client for the SpeedTest schema.
-type SpeedTestClient struct {
+// DaemonClient is a client for the Daemon schema.
+type DaemonClient struct {
 	config
 }
 
-// NewSpeedTestClient returns a client for the SpeedTest from the given config.
-func NewSpeedTestClient(c config) *SpeedTestClient {
-	return &SpeedTestClient{config: c}
+// NewDaemonClient returns a client for the Daemon from the given config.
+func NewDaemonClient(c config) *DaemonClient {
+	return &DaemonClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `speedtest.Hooks(f(g(h())))`.
-func (c *SpeedTestClient) Use(hooks ...Hook) {
-	c.hooks.SpeedTest = append(c.hooks.SpeedTest, hooks...)
+// A call to `Use(f, g, h)` equals to `daemon.Hooks(f(g(h())))`.
+func (c *DaemonClient) Use(hooks ...Hook) {
+	c.hooks.Daemon = append(c.hooks.Daemon, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `speedtest.Intercept(f(g(h())))`.
-func (c *SpeedTestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SpeedTest = append(c.inters.SpeedTest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `daemon.Intercept(f(g(h())))`.
+func (c *DaemonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Daemon = append(c.inters.Daemon, interceptors...)
 }
 
-// Create returns a builder for creating a SpeedTest entity.
-func (c *SpeedTestClient) Create() *SpeedTestCreate {
-	mutation := newSpeedTestMutation(c.config, OpCreate)
-	return &SpeedTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Daemon entity.
+func (c *DaemonClient) Create() *DaemonCreate {
+	mutation := newDaemonMutation(c.config, OpCreate)
+	return &DaemonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SpeedTest entities.
-func (c *SpeedTestClient) CreateBulk(builders ...*SpeedTestCreate) *SpeedTestCreateBulk {
-	return &SpeedTestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Daemon entities.
+func (c *DaemonClient) CreateBulk(builders ...*DaemonCreate) *DaemonCreateBulk {
+	return &DaemonCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SpeedTestClient) MapCreateBulk(slice any, setFunc func(*SpeedTestCreate, int)) *SpeedTestCreateBulk {
+func (c *DaemonClient) MapCreateBulk(slice any, setFunc func(*DaemonCreate, int)) *DaemonCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SpeedTestCreateBulk{err: fmt.Errorf("calling to SpeedTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DaemonCreateBulk{err: fmt.Errorf("calling to DaemonClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SpeedTestCreate, rv.Len())
+	builders := make([]*DaemonCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SpeedTestCreateBulk{config: c.config, builders: builders}
+	return &DaemonCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SpeedTest.
-func (c *SpeedTestClient) Update() *SpeedTestUpdate {
-	mutation := newSpeedTestMutation(c.config, OpUpdate)
-	return &SpeedTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Daemon.
+func (c *DaemonClient) Update() *DaemonUpdate {
+	mutation := newDaemonMutation(c.config, OpUpdate)
+	return &DaemonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SpeedTestClient) UpdateOne(st *SpeedTest) *SpeedTestUpdateOne {
-	mutation := newSpeedTestMutation(c.config, OpUpdateOne, withSpeedTest(st))
-	return &SpeedTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DaemonClient) UpdateOne(d *Daemon) *DaemonUpdateOne {
+	mutation := newDaemonMutation(c.config, OpUpdateOne, withDaemon(d))
+	return &DaemonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SpeedTestClient) UpdateOneID(id int) *SpeedTestUpdateOne {
-	mutation := newSpeedTestMutation(c.config, OpUpdateOne, withSpeedTestID(id))
-	return &SpeedTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DaemonClient) UpdateOneID(id string) *DaemonUpdateOne {
+	mutation := newDaemonMutation(c.config, OpUpdateOne, withDaemonID(id))
+	return &DaemonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SpeedTest.
-func (c *SpeedTestClient) Delete() *SpeedTestDelete {
-	mutation := newSpeedTestMutation(c.config, OpDelete)
-	return &SpeedTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Daemon.
+func (c *DaemonClient) Delete() *DaemonDelete {
+	mutation := newDaemonMutation(c.config, OpDelete)
+	return &DaemonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SpeedTestClient) DeleteOne(st *SpeedTest) *SpeedTestDeleteOne {
-	return c.DeleteOneID(st.ID)
+func (c *DaemonClient) DeleteOne(d *Daemon) *DaemonDeleteOne {
+	return c.DeleteOneID(d.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SpeedTestClient) DeleteOneID(id int) *SpeedTestDeleteOne {
-	builder := c.Delete().Where(speedtest.ID(id))
+func (c *DaemonClient) DeleteOneID(id string) *DaemonDeleteOne {
+	builder := c.Delete().Where(daemon.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SpeedTestDeleteOne{builder}
+	return &DaemonDeleteOne{builder}
 }
 
-// Query returns a query builder for SpeedTest.
-func (c *SpeedTestClient) Query() *SpeedTestQuery {
-	return &SpeedTestQuery{
+// Query returns a query builder for Daemon.
+func (c *DaemonClient) Query() *DaemonQuery {
+	return &DaemonQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSpeedTest},
+		ctx:    &QueryContext{Type: TypeDaemon},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SpeedTest entity by its id.
-func (c *SpeedTestClient) Get(ctx context.Context, id int) (*SpeedTest, error) {
-	return c.Query().Where(speedtest.ID(id)).Only(ctx)
+// Get returns a Daemon entity by its id.
+func (c *DaemonClient) Get(ctx context.Context, id string) (*Daemon, error) {
+	return c.Query().Where(daemon.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SpeedTestClient) GetX(ctx context.Context, id int) *SpeedTest {
+func (c *DaemonClient) GetX(ctx context.Context, id string) *Daemon {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -624,36 +672,1153 @@ func (c *SpeedTestClient) GetX(ctx context.Context, id int) *SpeedTest {
 }
 
 // Hooks returns the client hooks.
-func (c *SpeedTestClient) Hooks() []Hook {
-	return c.hooks.SpeedTest
+func (c *DaemonClient) Hooks() []Hook {
+	return c.hooks.Daemon
 }
 
 // Interceptors returns the client interceptors.
-func (c *SpeedTestClient) Interceptors() []Interceptor {
-	return c.inters.SpeedTest
+func (c *DaemonClient) Interceptors() []Interceptor {
+	return c.inters.Daemon
 }
 
-func (c *SpeedTestClient) mutate(ctx context.Context, m *SpeedTestMutation) (Value, error) {
+func (c *DaemonClient) mutate(ctx context.Context, m *DaemonMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SpeedTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DaemonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SpeedTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DaemonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SpeedTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DaemonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SpeedTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DaemonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SpeedTest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Daemon mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Host, IperfTest, SpeedTest []ent.Hook
+// DaemonConfigClient is a client for the DaemonConfig schema.
+type DaemonConfigClient struct {
+	config
+}
+
+// NewDaemonConfigClient returns a client for the DaemonConfig from the given config.
+func NewDaemonConfigClient(c config) *DaemonConfigClient {
+	return &DaemonConfigClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `daemonconfig.Hooks(f(g(h())))`.
+func (c *DaemonConfigClient) Use(hooks ...Hook) {
+	c.hooks.DaemonConfig = append(c.hooks.DaemonConfig, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `daemonconfig.Intercept(f(g(h())))`.
+func (c *DaemonConfigClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DaemonConfig = append(c.inters.DaemonConfig, interceptors...)
+}
+
+// Create returns a builder for creating a DaemonConfig entity.
+func (c *DaemonConfigClient) Create() *DaemonConfigCreate {
+	mutation := newDaemonConfigMutation(c.config, OpCreate)
+	return &DaemonConfigCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DaemonConfig entities.
+func (c *DaemonConfigClient) CreateBulk(builders ...*DaemonConfigCreate) *DaemonConfigCreateBulk {
+	return &DaemonConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DaemonConfigClient) MapCreateBulk(slice any, setFunc func(*DaemonConfigCreate, int)) *DaemonConfigCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DaemonConfigCreateBulk{err: fmt.Errorf("calling to DaemonConfigClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		Host, IperfTest, SpeedTest []ent.Interceptor
+	builders := make([]*DaemonConfigCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &DaemonConfigCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DaemonConfig.
+func (c *DaemonConfigClient) Update() *DaemonConfigUpdate {
+	mutation := newDaemonConfigMutation(c.config, OpUpdate)
+	return &DaemonConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DaemonConfigClient) UpdateOne(dc *DaemonConfig) *DaemonConfigUpdateOne {
+	mutation := newDaemonConfigMutation(c.config, OpUpdateOne, withDaemonConfig(dc))
+	return &DaemonConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DaemonConfigClient) UpdateOneID(id int) *DaemonConfigUpdateOne {
+	mutation := newDaemonConfigMutation(c.config, OpUpdateOne, withDaemonConfigID(id))
+	return &DaemonConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DaemonConfig.
+func (c *DaemonConfigClient) Delete() *DaemonConfigDelete {
+	mutation := newDaemonConfigMutation(c.config, OpDelete)
+	return &DaemonConfigDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DaemonConfigClient) DeleteOne(dc *DaemonConfig) *DaemonConfigDeleteOne {
+	return c.DeleteOneID(dc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DaemonConfigClient) DeleteOneID(id int) *DaemonConfigDeleteOne {
+	builder := c.Delete().Where(daemonconfig.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DaemonConfigDeleteOne{builder}
+}
+
+// Query returns a query builder for DaemonConfig.
+func (c *DaemonConfigClient) Query() *DaemonConfigQuery {
+	return &DaemonConfigQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDaemonConfig},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DaemonConfig entity by its id.
+func (c *DaemonConfigClient) Get(ctx context.Context, id int) (*DaemonConfig, error) {
+	return c.Query().Where(daemonconfig.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DaemonConfigClient) GetX(ctx context.Context, id int) *DaemonConfig {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DaemonConfigClient) Hooks() []Hook {
+	return c.hooks.DaemonConfig
+}
+
+// Interceptors returns the client interceptors.
+func (c *DaemonConfigClient) Interceptors() []Interceptor {
+	return c.inters.DaemonConfig
+}
+
+func (c *DaemonConfigClient) mutate(ctx context.Context, m *DaemonConfigMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DaemonConfigCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DaemonConfigUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DaemonConfigUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DaemonConfigDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DaemonConfig mutation op: %q", m.Op())
+	}
+}
+
+// HostClient is a client for the Host schema.
+type HostClient struct {
+	config
+}
+
+// NewHostClient returns a client for the Host from the given config.
+func NewHostClient(c config) *HostClient {
+	return &HostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `host.Hooks(f(g(h())))`.
+func (c *HostClient) Use(hooks ...Hook) {
+	c.hooks.Host = append(c.hooks.Host, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `host.Intercept(f(g(h())))`.
+func (c *HostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Host = append(c.inters.Host, interceptors...)
+}
+
+// Create returns a builder for creating a Host entity.
+func (c *HostClient) Create() *HostCreate {
+	mutation := newHostMutation(c.config, OpCreate)
+	return &HostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Host entities.
+func (c *HostClient) CreateBulk(builders ...*HostCreate) *HostCreateBulk {
+	return &HostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HostClient) MapCreateBulk(slice any, setFunc func(*HostCreate, int)) *HostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HostCreateBulk{err: fmt.Errorf("calling to HostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Host.
+func (c *HostClient) Update() *HostUpdate {
+	mutation := newHostMutation(c.config, OpUpdate)
+	return &HostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HostClient) UpdateOne(h *Host) *HostUpdateOne {
+	mutation := newHostMutation(c.config, OpUpdateOne, withHost(h))
+	return &HostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HostClient) UpdateOneID(id int) *HostUpdateOne {
+	mutation := newHostMutation(c.config, OpUpdateOne, withHostID(id))
+	return &HostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Host.
+func (c *HostClient) Delete() *HostDelete {
+	mutation := newHostMutation(c.config, OpDelete)
+	return &HostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HostClient) DeleteOne(h *Host) *HostDeleteOne {
+	return c.DeleteOneID(h.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HostClient) DeleteOneID(id int) *HostDeleteOne {
+	builder := c.Delete().Where(host.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HostDeleteOne{builder}
+}
+
+// Query returns a query builder for Host.
+func (c *HostClient) Query() *HostQuery {
+	return &HostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Host entity by its id.
+func (c *HostClient) Get(ctx context.Context, id int) (*Host, error) {
+	return c.Query().Where(host.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HostClient) GetX(ctx context.Context, id int) *Host {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryIperfTests queries the iperf_tests edge of a Host.
+func (c *HostClient) QueryIperfTests(h *Host) *IperfTestQuery {
+	query := (&IperfTestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(host.Table, host.FieldID, id),
+			sqlgraph.To(iperftest.Table, iperftest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, host.IperfTestsTable, host.IperfTestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a Host.
+func (c *HostClient) QueryJobs(h *Host) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(host.Table, host.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, host.JobsTable, host.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTestRuns queries the test_runs edge of a Host.
+func (c *HostClient) QueryTestRuns(h *Host) *TestRunQuery {
+	query := (&TestRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(host.Table, host.FieldID, id),
+			sqlgraph.To(testrun.Table, testrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, host.TestRunsTable, host.TestRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HostClient) Hooks() []Hook {
+	return c.hooks.Host
+}
+
+// Interceptors returns the client interceptors.
+func (c *HostClient) Interceptors() []Interceptor {
+	return c.inters.Host
+}
+
+func (c *HostClient) mutate(ctx context.Context, m *HostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Host mutation op: %q", m.Op())
+	}
+}
+
+// IperfTestClient is a client for the IperfTest schema.
+type IperfTestClient struct {
+	config
+}
+
+// NewIperfTestClient returns a client for the IperfTest from the given config.
+func NewIperfTestClient(c config) *IperfTestClient {
+	return &IperfTestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `iperftest.Hooks(f(g(h())))`.
+func (c *IperfTestClient) Use(hooks ...Hook) {
+	c.hooks.IperfTest = append(c.hooks.IperfTest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `iperftest.Intercept(f(g(h())))`.
+func (c *IperfTestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IperfTest = append(c.inters.IperfTest, interceptors...)
+}
+
+// Create returns a builder for creating a IperfTest entity.
+func (c *IperfTestClient) Create() *IperfTestCreate {
+	mutation := newIperfTestMutation(c.config, OpCreate)
+	return &IperfTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IperfTest entities.
+func (c *IperfTestClient) CreateBulk(builders ...*IperfTestCreate) *IperfTestCreateBulk {
+	return &IperfTestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IperfTestClient) MapCreateBulk(slice any, setFunc func(*IperfTestCreate, int)) *IperfTestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IperfTestCreateBulk{err: fmt.Errorf("calling to IperfTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IperfTestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IperfTestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IperfTest.
+func (c *IperfTestClient) Update() *IperfTestUpdate {
+	mutation := newIperfTestMutation(c.config, OpUpdate)
+	return &IperfTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IperfTestClient) UpdateOne(it *IperfTest) *IperfTestUpdateOne {
+	mutation := newIperfTestMutation(c.config, OpUpdateOne, withIperfTest(it))
+	return &IperfTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IperfTestClient) UpdateOneID(id int) *IperfTestUpdateOne {
+	mutation := newIperfTestMutation(c.config, OpUpdateOne, withIperfTestID(id))
+	return &IperfTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IperfTest.
+func (c *IperfTestClient) Delete() *IperfTestDelete {
+	mutation := newIperfTestMutation(c.config, OpDelete)
+	return &IperfTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IperfTestClient) DeleteOne(it *IperfTest) *IperfTestDeleteOne {
+	return c.DeleteOneID(it.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IperfTestClient) DeleteOneID(id int) *IperfTestDeleteOne {
+	builder := c.Delete().Where(iperftest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IperfTestDeleteOne{builder}
+}
+
+// Query returns a query builder for IperfTest.
+func (c *IperfTestClient) Query() *IperfTestQuery {
+	return &IperfTestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIperfTest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IperfTest entity by its id.
+func (c *IperfTestClient) Get(ctx context.Context, id int) (*IperfTest, error) {
+	return c.Query().Where(iperftest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IperfTestClient) GetX(ctx context.Context, id int) *IperfTest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHost queries the host edge of a IperfTest.
+func (c *IperfTestClient) QueryHost(it *IperfTest) *HostQuery {
+	query := (&HostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := it.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iperftest.Table, iperftest.FieldID, id),
+			sqlgraph.To(host.Table, host.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, iperftest.HostTable, iperftest.HostColumn),
+		)
+		fromV = sqlgraph.Neighbors(it.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IperfTestClient) Hooks() []Hook {
+	return c.hooks.IperfTest
+}
+
+// Interceptors returns the client interceptors.
+func (c *IperfTestClient) Interceptors() []Interceptor {
+	return c.inters.IperfTest
+}
+
+func (c *IperfTestClient) mutate(ctx context.Context, m *IperfTestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IperfTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IperfTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IperfTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IperfTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IperfTest mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(j *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(j))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id int) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(j *Job) *JobDeleteOne {
+	return c.DeleteOneID(j.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id int) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id int) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id int) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHost queries the host edge of a Job.
+func (c *JobClient) QueryHost(j *Job) *HostQuery {
+	query := (&HostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := j.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(host.Table, host.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.HostTable, job.HostColumn),
+		)
+		fromV = sqlgraph.Neighbors(j.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCampaign queries the campaign edge of a Job.
+func (c *JobClient) QueryCampaign(j *Job) *CampaignQuery {
+	query := (&CampaignClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := j.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(campaign.Table, campaign.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.CampaignTable, job.CampaignColumn),
+		)
+		fromV = sqlgraph.Neighbors(j.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// MeshTestClient is a client for the MeshTest schema.
+type MeshTestClient struct {
+	config
+}
+
+// NewMeshTestClient returns a client for the MeshTest from the given config.
+func NewMeshTestClient(c config) *MeshTestClient {
+	return &MeshTestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `meshtest.Hooks(f(g(h())))`.
+func (c *MeshTestClient) Use(hooks ...Hook) {
+	c.hooks.MeshTest = append(c.hooks.MeshTest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `meshtest.Intercept(f(g(h())))`.
+func (c *MeshTestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MeshTest = append(c.inters.MeshTest, interceptors...)
+}
+
+// Create returns a builder for creating a MeshTest entity.
+func (c *MeshTestClient) Create() *MeshTestCreate {
+	mutation := newMeshTestMutation(c.config, OpCreate)
+	return &MeshTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MeshTest entities.
+func (c *MeshTestClient) CreateBulk(builders ...*MeshTestCreate) *MeshTestCreateBulk {
+	return &MeshTestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MeshTestClient) MapCreateBulk(slice any, setFunc func(*MeshTestCreate, int)) *MeshTestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MeshTestCreateBulk{err: fmt.Errorf("calling to MeshTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MeshTestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MeshTestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MeshTest.
+func (c *MeshTestClient) Update() *MeshTestUpdate {
+	mutation := newMeshTestMutation(c.config, OpUpdate)
+	return &MeshTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MeshTestClient) UpdateOne(mt *MeshTest) *MeshTestUpdateOne {
+	mutation := newMeshTestMutation(c.config, OpUpdateOne, withMeshTest(mt))
+	return &MeshTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MeshTestClient) UpdateOneID(id int) *MeshTestUpdateOne {
+	mutation := newMeshTestMutation(c.config, OpUpdateOne, withMeshTestID(id))
+	return &MeshTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MeshTest.
+func (c *MeshTestClient) Delete() *MeshTestDelete {
+	mutation := newMeshTestMutation(c.config, OpDelete)
+	return &MeshTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MeshTestClient) DeleteOne(mt *MeshTest) *MeshTestDeleteOne {
+	return c.DeleteOneID(mt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MeshTestClient) DeleteOneID(id int) *MeshTestDeleteOne {
+	builder := c.Delete().Where(meshtest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MeshTestDeleteOne{builder}
+}
+
+// Query returns a query builder for MeshTest.
+func (c *MeshTestClient) Query() *MeshTestQuery {
+	return &MeshTestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMeshTest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MeshTest entity by its id.
+func (c *MeshTestClient) Get(ctx context.Context, id int) (*MeshTest, error) {
+	return c.Query().Where(meshtest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MeshTestClient) GetX(ctx context.Context, id int) *MeshTest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MeshTestClient) Hooks() []Hook {
+	return c.hooks.MeshTest
+}
+
+// Interceptors returns the client interceptors.
+func (c *MeshTestClient) Interceptors() []Interceptor {
+	return c.inters.MeshTest
+}
+
+func (c *MeshTestClient) mutate(ctx context.Context, m *MeshTestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MeshTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MeshTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MeshTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MeshTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MeshTest mutation op: %q", m.Op())
+	}
+}
+
+// SpeedTestClient is a client for the SpeedTest schema.
+type SpeedTestClient struct {
+	config
+}
+
+// NewSpeedTestClient returns a client for the SpeedTest from the given config.
+func NewSpeedTestClient(c config) *SpeedTestClient {
+	return &SpeedTestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `speedtest.Hooks(f(g(h())))`.
+func (c *SpeedTestClient) Use(hooks ...Hook) {
+	c.hooks.SpeedTest = append(c.hooks.SpeedTest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `speedtest.Intercept(f(g(h())))`.
+func (c *SpeedTestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpeedTest = append(c.inters.SpeedTest, interceptors...)
+}
+
+// Create returns a builder for creating a SpeedTest entity.
+func (c *SpeedTestClient) Create() *SpeedTestCreate {
+	mutation := newSpeedTestMutation(c.config, OpCreate)
+	return &SpeedTestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpeedTest entities.
+func (c *SpeedTestClient) CreateBulk(builders ...*SpeedTestCreate) *SpeedTestCreateBulk {
+	return &SpeedTestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpeedTestClient) MapCreateBulk(slice any, setFunc func(*SpeedTestCreate, int)) *SpeedTestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpeedTestCreateBulk{err: fmt.Errorf("calling to SpeedTestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpeedTestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpeedTestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SpeedTest.
+func (c *SpeedTestClient) Update() *SpeedTestUpdate {
+	mutation := newSpeedTestMutation(c.config, OpUpdate)
+	return &SpeedTestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpeedTestClient) UpdateOne(st *SpeedTest) *SpeedTestUpdateOne {
+	mutation := newSpeedTestMutation(c.config, OpUpdateOne, withSpeedTest(st))
+	return &SpeedTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpeedTestClient) UpdateOneID(id int) *SpeedTestUpdateOne {
+	mutation := newSpeedTestMutation(c.config, OpUpdateOne, withSpeedTestID(id))
+	return &SpeedTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpeedTest.
+func (c *SpeedTestClient) Delete() *SpeedTestDelete {
+	mutation := newSpeedTestMutation(c.config, OpDelete)
+	return &SpeedTestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpeedTestClient) DeleteOne(st *SpeedTest) *SpeedTestDeleteOne {
+	return c.DeleteOneID(st.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpeedTestClient) DeleteOneID(id int) *SpeedTestDeleteOne {
+	builder := c.Delete().Where(speedtest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpeedTestDeleteOne{builder}
+}
+
+// Query returns a query builder for SpeedTest.
+func (c *SpeedTestClient) Query() *SpeedTestQuery {
+	return &SpeedTestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpeedTest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SpeedTest entity by its id.
+func (c *SpeedTestClient) Get(ctx context.Context, id int) (*SpeedTest, error) {
+	return c.Query().Where(speedtest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpeedTestClient) GetX(ctx context.Context, id int) *SpeedTest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SpeedTestClient) Hooks() []Hook {
+	return c.hooks.SpeedTest
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpeedTestClient) Interceptors() []Interceptor {
+	return c.inters.SpeedTest
+}
+
+func (c *SpeedTestClient) mutate(ctx context.Context, m *SpeedTestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpeedTestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpeedTestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpeedTestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpeedTestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SpeedTest mutation op: %q", m.Op())
+	}
+}
+
+// TestRunClient is a client for the TestRun schema.
+type TestRunClient struct {
+	config
+}
+
+// NewTestRunClient returns a client for the TestRun from the given config.
+func NewTestRunClient(c config) *TestRunClient {
+	return &TestRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testrun.Hooks(f(g(h())))`.
+func (c *TestRunClient) Use(hooks ...Hook) {
+	c.hooks.TestRun = append(c.hooks.TestRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testrun.Intercept(f(g(h())))`.
+func (c *TestRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestRun = append(c.inters.TestRun, interceptors...)
+}
+
+// Create returns a builder for creating a TestRun entity.
+func (c *TestRunClient) Create() *TestRunCreate {
+	mutation := newTestRunMutation(c.config, OpCreate)
+	return &TestRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestRun entities.
+func (c *TestRunClient) CreateBulk(builders ...*TestRunCreate) *TestRunCreateBulk {
+	return &TestRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestRunClient) MapCreateBulk(slice any, setFunc func(*TestRunCreate, int)) *TestRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestRunCreateBulk{err: fmt.Errorf("calling to TestRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestRun.
+func (c *TestRunClient) Update() *TestRunUpdate {
+	mutation := newTestRunMutation(c.config, OpUpdate)
+	return &TestRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestRunClient) UpdateOne(tr *TestRun) *TestRunUpdateOne {
+	mutation := newTestRunMutation(c.config, OpUpdateOne, withTestRun(tr))
+	return &TestRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestRunClient) UpdateOneID(id int) *TestRunUpdateOne {
+	mutation := newTestRunMutation(c.config, OpUpdateOne, withTestRunID(id))
+	return &TestRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestRun.
+func (c *TestRunClient) Delete() *TestRunDelete {
+	mutation := newTestRunMutation(c.config, OpDelete)
+	return &TestRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestRunClient) DeleteOne(tr *TestRun) *TestRunDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestRunClient) DeleteOneID(id int) *TestRunDeleteOne {
+	builder := c.Delete().Where(testrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TestRun.
+func (c *TestRunClient) Query() *TestRunQuery {
+	return &TestRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestRun entity by its id.
+func (c *TestRunClient) Get(ctx context.Context, id int) (*TestRun, error) {
+	return c.Query().Where(testrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestRunClient) GetX(ctx context.Context, id int) *TestRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHost queries the host edge of a TestRun.
+func (c *TestRunClient) QueryHost(tr *TestRun) *HostQuery {
+	query := (&HostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, id),
+			sqlgraph.To(host.Table, host.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testrun.HostTable, testrun.HostColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySpeedTest queries the speed_test edge of a TestRun.
+func (c *TestRunClient) QuerySpeedTest(tr *TestRun) *SpeedTestQuery {
+	query := (&SpeedTestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, id),
+			sqlgraph.To(speedtest.Table, speedtest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testrun.SpeedTestTable, testrun.SpeedTestColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIperfTest queries the iperf_test edge of a TestRun.
+func (c *TestRunClient) QueryIperfTest(tr *TestRun) *IperfTestQuery {
+	query := (&IperfTestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, id),
+			sqlgraph.To(iperftest.Table, iperftest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testrun.IperfTestTable, testrun.IperfTestColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMeshTest queries the mesh_test edge of a TestRun.
+func (c *TestRunClient) QueryMeshTest(tr *TestRun) *MeshTestQuery {
+	query := (&MeshTestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, id),
+			sqlgraph.To(meshtest.Table, meshtest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testrun.MeshTestTable, testrun.MeshTestColumn),
+		)
+		fromV = sqlgraph.Neighbors(tr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestRunClient) Hooks() []Hook {
+	return c.hooks.TestRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestRunClient) Interceptors() []Interceptor {
+	return c.inters.TestRun
+}
+
+func (c *TestRunClient) mutate(ctx context.Context, m *TestRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestRun mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		APIKey, Campaign, Daemon, DaemonConfig, Host, IperfTest, Job, MeshTest,
+		SpeedTest, TestRun []ent.Hook
+	}
+	inters struct {
+		APIKey, Campaign, Daemon, DaemonConfig, Host, IperfTest, Job, MeshTest,
+		SpeedTest, TestRun []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
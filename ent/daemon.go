@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+)
+
+// Daemon is the model entity for the Daemon schema.
+type Daemon struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Daemon identifier, matches daemon_id on results
+	ID string `json:"id,omitempty"`
+	// Human-friendly name from the daemon configuration
+	Name string `json:"name,omitempty"`
+	// Hostname of the machine running the daemon
+	Hostname string `json:"hostname,omitempty"`
+	// speed-checker version the daemon runs
+	Version string `json:"version,omitempty"`
+	// Operating system, e.g. linux
+	Os string `json:"os,omitempty"`
+	// CPU architecture, e.g. amd64
+	Arch string `json:"arch,omitempty"`
+	// Free-form labels describing the daemon
+	Labels map[string]string `json:"labels,omitempty"`
+	// Whether iperf3 is installed
+	HasIperf3 bool `json:"has_iperf3,omitempty"`
+	// Whether the Ookla speedtest CLI is installed
+	HasSpeedtest bool `json:"has_speedtest,omitempty"`
+	// host:port of the daemon's iperf3 server for mesh tests; empty when it does not take part
+	MeshAddress string `json:"mesh_address,omitempty"`
+	// Results waiting in the daemon's offline spool at the last heartbeat
+	SpoolDepth int `json:"spool_depth,omitempty"`
+	// RegisteredAt holds the value of the "registered_at" field.
+	RegisteredAt time.Time `json:"registered_at,omitempty"`
+	// Last registration or heartbeat
+	LastSeenAt   time.Time `json:"last_seen_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Daemon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case daemon.FieldLabels:
+			values[i] = new([]byte)
+		case daemon.FieldHasIperf3, daemon.FieldHasSpeedtest:
+			values[i] = new(sql.NullBool)
+		case daemon.FieldSpoolDepth:
+			values[i] = new(sql.NullInt64)
+		case daemon.FieldID, daemon.FieldName, daemon.FieldHostname, daemon.FieldVersion, daemon.FieldOs, daemon.FieldArch, daemon.FieldMeshAddress:
+			values[i] = new(sql.NullString)
+		case daemon.FieldRegisteredAt, daemon.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Daemon fields.
+func (d *Daemon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case daemon.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				d.ID = value.String
+			}
+		case daemon.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		case daemon.FieldHostname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hostname", values[i])
+			} else if value.Valid {
+				d.Hostname = value.String
+			}
+		case daemon.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				d.Version = value.String
+			}
+		case daemon.FieldOs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os", values[i])
+			} else if value.Valid {
+				d.Os = value.String
+			}
+		case daemon.FieldArch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arch", values[i])
+			} else if value.Valid {
+				d.Arch = value.String
+			}
+		case daemon.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case daemon.FieldHasIperf3:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_iperf3", values[i])
+			} else if value.Valid {
+				d.HasIperf3 = value.Bool
+			}
+		case daemon.FieldHasSpeedtest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_speedtest", values[i])
+			} else if value.Valid {
+				d.HasSpeedtest = value.Bool
+			}
+		case daemon.FieldMeshAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mesh_address", values[i])
+			} else if value.Valid {
+				d.MeshAddress = value.String
+			}
+		case daemon.FieldSpoolDepth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spool_depth", values[i])
+			} else if value.Valid {
+				d.SpoolDepth = int(value.Int64)
+			}
+		case daemon.FieldRegisteredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field registered_at", values[i])
+			} else if value.Valid {
+				d.RegisteredAt = value.Time
+			}
+		case daemon.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				d.LastSeenAt = value.Time
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Daemon.
+// This includes values selected through modifiers, order, etc.
+func (d *Daemon) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Daemon.
+// Note that you need to call Daemon.Unwrap() before calling this method if this Daemon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Daemon) Update() *DaemonUpdateOne {
+	return NewDaemonClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Daemon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Daemon) Unwrap() *Daemon {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Daemon is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Daemon) String() string {
+	var builder strings.Builder
+	builder.WriteString("Daemon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("name=")
+	builder.WriteString(d.Name)
+	builder.WriteString(", ")
+	builder.WriteString("hostname=")
+	builder.WriteString(d.Hostname)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(d.Version)
+	builder.WriteString(", ")
+	builder.WriteString("os=")
+	builder.WriteString(d.Os)
+	builder.WriteString(", ")
+	builder.WriteString("arch=")
+	builder.WriteString(d.Arch)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", d.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("has_iperf3=")
+	builder.WriteString(fmt.Sprintf("%v", d.HasIperf3))
+	builder.WriteString(", ")
+	builder.WriteString("has_speedtest=")
+	builder.WriteString(fmt.Sprintf("%v", d.HasSpeedtest))
+	builder.WriteString(", ")
+	builder.WriteString("mesh_address=")
+	builder.WriteString(d.MeshAddress)
+	builder.WriteString(", ")
+	builder.WriteString("spool_depth=")
+	builder.WriteString(fmt.Sprintf("%v", d.SpoolDepth))
+	builder.WriteString(", ")
+	builder.WriteString("registered_at=")
+	builder.WriteString(d.RegisteredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(d.LastSeenAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Daemons is a parsable slice of Daemon.
+type Daemons []*Daemon
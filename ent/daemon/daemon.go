@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package daemon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the daemon type in the database.
+	Label = "daemon"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldHostname holds the string denoting the hostname field in the database.
+	FieldHostname = "hostname"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldOs holds the string denoting the os field in the database.
+	FieldOs = "os"
+	// FieldArch holds the string denoting the arch field in the database.
+	FieldArch = "arch"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldHasIperf3 holds the string denoting the has_iperf3 field in the database.
+	FieldHasIperf3 = "has_iperf3"
+	// FieldHasSpeedtest holds the string denoting the has_speedtest field in the database.
+	FieldHasSpeedtest = "has_speedtest"
+	// FieldMeshAddress holds the string denoting the mesh_address field in the database.
+	FieldMeshAddress = "mesh_address"
+	// FieldSpoolDepth holds the string denoting the spool_depth field in the database.
+	FieldSpoolDepth = "spool_depth"
+	// FieldRegisteredAt holds the string denoting the registered_at field in the database.
+	FieldRegisteredAt = "registered_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// Table holds the table name of the daemon in the database.
+	Table = "daemons"
+)
+
+// Columns holds all SQL columns for daemon fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldHostname,
+	FieldVersion,
+	FieldOs,
+	FieldArch,
+	FieldLabels,
+	FieldHasIperf3,
+	FieldHasSpeedtest,
+	FieldMeshAddress,
+	FieldSpoolDepth,
+	FieldRegisteredAt,
+	FieldLastSeenAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultHasIperf3 holds the default value on creation for the "has_iperf3" field.
+	DefaultHasIperf3 bool
+	// DefaultHasSpeedtest holds the default value on creation for the "has_speedtest" field.
+	DefaultHasSpeedtest bool
+	// DefaultSpoolDepth holds the default value on creation for the "spool_depth" field.
+	DefaultSpoolDepth int
+	// DefaultRegisteredAt holds the default value on creation for the "registered_at" field.
+	DefaultRegisteredAt func() time.Time
+	// DefaultLastSeenAt holds the default value on creation for the "last_seen_at" field.
+	DefaultLastSeenAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Daemon queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByHostname orders the results by the hostname field.
+func ByHostname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostname, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByOs orders the results by the os field.
+func ByOs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOs, opts...).ToFunc()
+}
+
+// ByArch orders the results by the arch field.
+func ByArch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArch, opts...).ToFunc()
+}
+
+// ByHasIperf3 orders the results by the has_iperf3 field.
+func ByHasIperf3(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasIperf3, opts...).ToFunc()
+}
+
+// ByHasSpeedtest orders the results by the has_speedtest field.
+func ByHasSpeedtest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasSpeedtest, opts...).ToFunc()
+}
+
+// ByMeshAddress orders the results by the mesh_address field.
+func ByMeshAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeshAddress, opts...).ToFunc()
+}
+
+// BySpoolDepth orders the results by the spool_depth field.
+func BySpoolDepth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpoolDepth, opts...).ToFunc()
+}
+
+// ByRegisteredAt orders the results by the registered_at field.
+func ByRegisteredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegisteredAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package daemon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldName, v))
+}
+
+// Hostname applies equality check predicate on the "hostname" field. It's identical to HostnameEQ.
+func Hostname(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldHostname, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldVersion, v))
+}
+
+// Os applies equality check predicate on the "os" field. It's identical to OsEQ.
+func Os(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldOs, v))
+}
+
+// Arch applies equality check predicate on the "arch" field. It's identical to ArchEQ.
+func Arch(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldArch, v))
+}
+
+// HasIperf3 applies equality check predicate on the "has_iperf3" field. It's identical to HasIperf3EQ.
+func HasIperf3(v bool) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldHasIperf3, v))
+}
+
+// HasSpeedtest applies equality check predicate on the "has_speedtest" field. It's identical to HasSpeedtestEQ.
+func HasSpeedtest(v bool) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldHasSpeedtest, v))
+}
+
+// MeshAddress applies equality check predicate on the "mesh_address" field. It's identical to MeshAddressEQ.
+func MeshAddress(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldMeshAddress, v))
+}
+
+// SpoolDepth applies equality check predicate on the "spool_depth" field. It's identical to SpoolDepthEQ.
+func SpoolDepth(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldSpoolDepth, v))
+}
+
+// RegisteredAt applies equality check predicate on the "registered_at" field. It's identical to RegisteredAtEQ.
+func RegisteredAt(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldRegisteredAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldName, v))
+}
+
+// HostnameEQ applies the EQ predicate on the "hostname" field.
+func HostnameEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldHostname, v))
+}
+
+// HostnameNEQ applies the NEQ predicate on the "hostname" field.
+func HostnameNEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldHostname, v))
+}
+
+// HostnameIn applies the In predicate on the "hostname" field.
+func HostnameIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldHostname, vs...))
+}
+
+// HostnameNotIn applies the NotIn predicate on the "hostname" field.
+func HostnameNotIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldHostname, vs...))
+}
+
+// HostnameGT applies the GT predicate on the "hostname" field.
+func HostnameGT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldHostname, v))
+}
+
+// HostnameGTE applies the GTE predicate on the "hostname" field.
+func HostnameGTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldHostname, v))
+}
+
+// HostnameLT applies the LT predicate on the "hostname" field.
+func HostnameLT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldHostname, v))
+}
+
+// HostnameLTE applies the LTE predicate on the "hostname" field.
+func HostnameLTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldHostname, v))
+}
+
+// HostnameContains applies the Contains predicate on the "hostname" field.
+func HostnameContains(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContains(FieldHostname, v))
+}
+
+// HostnameHasPrefix applies the HasPrefix predicate on the "hostname" field.
+func HostnameHasPrefix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasPrefix(FieldHostname, v))
+}
+
+// HostnameHasSuffix applies the HasSuffix predicate on the "hostname" field.
+func HostnameHasSuffix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasSuffix(FieldHostname, v))
+}
+
+// HostnameIsNil applies the IsNil predicate on the "hostname" field.
+func HostnameIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldHostname))
+}
+
+// HostnameNotNil applies the NotNil predicate on the "hostname" field.
+func HostnameNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldHostname))
+}
+
+// HostnameEqualFold applies the EqualFold predicate on the "hostname" field.
+func HostnameEqualFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldHostname, v))
+}
+
+// HostnameContainsFold applies the ContainsFold predicate on the "hostname" field.
+func HostnameContainsFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldHostname, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldVersion))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// OsEQ applies the EQ predicate on the "os" field.
+func OsEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldOs, v))
+}
+
+// OsNEQ applies the NEQ predicate on the "os" field.
+func OsNEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldOs, v))
+}
+
+// OsIn applies the In predicate on the "os" field.
+func OsIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldOs, vs...))
+}
+
+// OsNotIn applies the NotIn predicate on the "os" field.
+func OsNotIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldOs, vs...))
+}
+
+// OsGT applies the GT predicate on the "os" field.
+func OsGT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldOs, v))
+}
+
+// OsGTE applies the GTE predicate on the "os" field.
+func OsGTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldOs, v))
+}
+
+// OsLT applies the LT predicate on the "os" field.
+func OsLT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldOs, v))
+}
+
+// OsLTE applies the LTE predicate on the "os" field.
+func OsLTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldOs, v))
+}
+
+// OsContains applies the Contains predicate on the "os" field.
+func OsContains(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContains(FieldOs, v))
+}
+
+// OsHasPrefix applies the HasPrefix predicate on the "os" field.
+func OsHasPrefix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasPrefix(FieldOs, v))
+}
+
+// OsHasSuffix applies the HasSuffix predicate on the "os" field.
+func OsHasSuffix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasSuffix(FieldOs, v))
+}
+
+// OsIsNil applies the IsNil predicate on the "os" field.
+func OsIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldOs))
+}
+
+// OsNotNil applies the NotNil predicate on the "os" field.
+func OsNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldOs))
+}
+
+// OsEqualFold applies the EqualFold predicate on the "os" field.
+func OsEqualFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldOs, v))
+}
+
+// OsContainsFold applies the ContainsFold predicate on the "os" field.
+func OsContainsFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldOs, v))
+}
+
+// ArchEQ applies the EQ predicate on the "arch" field.
+func ArchEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldArch, v))
+}
+
+// ArchNEQ applies the NEQ predicate on the "arch" field.
+func ArchNEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldArch, v))
+}
+
+// ArchIn applies the In predicate on the "arch" field.
+func ArchIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldArch, vs...))
+}
+
+// ArchNotIn applies the NotIn predicate on the "arch" field.
+func ArchNotIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldArch, vs...))
+}
+
+// ArchGT applies the GT predicate on the "arch" field.
+func ArchGT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldArch, v))
+}
+
+// ArchGTE applies the GTE predicate on the "arch" field.
+func ArchGTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldArch, v))
+}
+
+// ArchLT applies the LT predicate on the "arch" field.
+func ArchLT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldArch, v))
+}
+
+// ArchLTE applies the LTE predicate on the "arch" field.
+func ArchLTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldArch, v))
+}
+
+// ArchContains applies the Contains predicate on the "arch" field.
+func ArchContains(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContains(FieldArch, v))
+}
+
+// ArchHasPrefix applies the HasPrefix predicate on the "arch" field.
+func ArchHasPrefix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasPrefix(FieldArch, v))
+}
+
+// ArchHasSuffix applies the HasSuffix predicate on the "arch" field.
+func ArchHasSuffix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasSuffix(FieldArch, v))
+}
+
+// ArchIsNil applies the IsNil predicate on the "arch" field.
+func ArchIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldArch))
+}
+
+// ArchNotNil applies the NotNil predicate on the "arch" field.
+func ArchNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldArch))
+}
+
+// ArchEqualFold applies the EqualFold predicate on the "arch" field.
+func ArchEqualFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldArch, v))
+}
+
+// ArchContainsFold applies the ContainsFold predicate on the "arch" field.
+func ArchContainsFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldArch, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldLabels))
+}
+
+// HasIperf3EQ applies the EQ predicate on the "has_iperf3" field.
+func HasIperf3EQ(v bool) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldHasIperf3, v))
+}
+
+// HasIperf3NEQ applies the NEQ predicate on the "has_iperf3" field.
+func HasIperf3NEQ(v bool) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldHasIperf3, v))
+}
+
+// HasSpeedtestEQ applies the EQ predicate on the "has_speedtest" field.
+func HasSpeedtestEQ(v bool) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldHasSpeedtest, v))
+}
+
+// HasSpeedtestNEQ applies the NEQ predicate on the "has_speedtest" field.
+func HasSpeedtestNEQ(v bool) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldHasSpeedtest, v))
+}
+
+// MeshAddressEQ applies the EQ predicate on the "mesh_address" field.
+func MeshAddressEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldMeshAddress, v))
+}
+
+// MeshAddressNEQ applies the NEQ predicate on the "mesh_address" field.
+func MeshAddressNEQ(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldMeshAddress, v))
+}
+
+// MeshAddressIn applies the In predicate on the "mesh_address" field.
+func MeshAddressIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldMeshAddress, vs...))
+}
+
+// MeshAddressNotIn applies the NotIn predicate on the "mesh_address" field.
+func MeshAddressNotIn(vs ...string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldMeshAddress, vs...))
+}
+
+// MeshAddressGT applies the GT predicate on the "mesh_address" field.
+func MeshAddressGT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldMeshAddress, v))
+}
+
+// MeshAddressGTE applies the GTE predicate on the "mesh_address" field.
+func MeshAddressGTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldMeshAddress, v))
+}
+
+// MeshAddressLT applies the LT predicate on the "mesh_address" field.
+func MeshAddressLT(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldMeshAddress, v))
+}
+
+// MeshAddressLTE applies the LTE predicate on the "mesh_address" field.
+func MeshAddressLTE(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldMeshAddress, v))
+}
+
+// MeshAddressContains applies the Contains predicate on the "mesh_address" field.
+func MeshAddressContains(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContains(FieldMeshAddress, v))
+}
+
+// MeshAddressHasPrefix applies the HasPrefix predicate on the "mesh_address" field.
+func MeshAddressHasPrefix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasPrefix(FieldMeshAddress, v))
+}
+
+// MeshAddressHasSuffix applies the HasSuffix predicate on the "mesh_address" field.
+func MeshAddressHasSuffix(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldHasSuffix(FieldMeshAddress, v))
+}
+
+// MeshAddressIsNil applies the IsNil predicate on the "mesh_address" field.
+func MeshAddressIsNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldIsNull(FieldMeshAddress))
+}
+
+// MeshAddressNotNil applies the NotNil predicate on the "mesh_address" field.
+func MeshAddressNotNil() predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotNull(FieldMeshAddress))
+}
+
+// MeshAddressEqualFold applies the EqualFold predicate on the "mesh_address" field.
+func MeshAddressEqualFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEqualFold(FieldMeshAddress, v))
+}
+
+// MeshAddressContainsFold applies the ContainsFold predicate on the "mesh_address" field.
+func MeshAddressContainsFold(v string) predicate.Daemon {
+	return predicate.Daemon(sql.FieldContainsFold(FieldMeshAddress, v))
+}
+
+// SpoolDepthEQ applies the EQ predicate on the "spool_depth" field.
+func SpoolDepthEQ(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldSpoolDepth, v))
+}
+
+// SpoolDepthNEQ applies the NEQ predicate on the "spool_depth" field.
+func SpoolDepthNEQ(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldSpoolDepth, v))
+}
+
+// SpoolDepthIn applies the In predicate on the "spool_depth" field.
+func SpoolDepthIn(vs ...int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldSpoolDepth, vs...))
+}
+
+// SpoolDepthNotIn applies the NotIn predicate on the "spool_depth" field.
+func SpoolDepthNotIn(vs ...int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldSpoolDepth, vs...))
+}
+
+// SpoolDepthGT applies the GT predicate on the "spool_depth" field.
+func SpoolDepthGT(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldSpoolDepth, v))
+}
+
+// SpoolDepthGTE applies the GTE predicate on the "spool_depth" field.
+func SpoolDepthGTE(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldSpoolDepth, v))
+}
+
+// SpoolDepthLT applies the LT predicate on the "spool_depth" field.
+func SpoolDepthLT(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldSpoolDepth, v))
+}
+
+// SpoolDepthLTE applies the LTE predicate on the "spool_depth" field.
+func SpoolDepthLTE(v int) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldSpoolDepth, v))
+}
+
+// RegisteredAtEQ applies the EQ predicate on the "registered_at" field.
+func RegisteredAtEQ(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldRegisteredAt, v))
+}
+
+// RegisteredAtNEQ applies the NEQ predicate on the "registered_at" field.
+func RegisteredAtNEQ(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldRegisteredAt, v))
+}
+
+// RegisteredAtIn applies the In predicate on the "registered_at" field.
+func RegisteredAtIn(vs ...time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldRegisteredAt, vs...))
+}
+
+// RegisteredAtNotIn applies the NotIn predicate on the "registered_at" field.
+func RegisteredAtNotIn(vs ...time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldRegisteredAt, vs...))
+}
+
+// RegisteredAtGT applies the GT predicate on the "registered_at" field.
+func RegisteredAtGT(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldRegisteredAt, v))
+}
+
+// RegisteredAtGTE applies the GTE predicate on the "registered_at" field.
+func RegisteredAtGTE(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldRegisteredAt, v))
+}
+
+// RegisteredAtLT applies the LT predicate on the "registered_at" field.
+func RegisteredAtLT(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldRegisteredAt, v))
+}
+
+// RegisteredAtLTE applies the LTE predicate on the "registered_at" field.
+func RegisteredAtLTE(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldRegisteredAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Daemon {
+	return predicate.Daemon(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Daemon) predicate.Daemon {
+	return predicate.Daemon(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Daemon) predicate.Daemon {
+	return predicate.Daemon(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Daemon) predicate.Daemon {
+	return predicate.Daemon(sql.NotPredicates(p))
+}
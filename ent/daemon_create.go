@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+)
+
+// DaemonCreate is the builder for creating a Daemon entity.
+type DaemonCreate struct {
+	config
+	mutation *DaemonMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (dc *DaemonCreate) SetName(s string) *DaemonCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableName(s *string) *DaemonCreate {
+	if s != nil {
+		dc.SetName(*s)
+	}
+	return dc
+}
+
+// SetHostname sets the "hostname" field.
+func (dc *DaemonCreate) SetHostname(s string) *DaemonCreate {
+	dc.mutation.SetHostname(s)
+	return dc
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableHostname(s *string) *DaemonCreate {
+	if s != nil {
+		dc.SetHostname(*s)
+	}
+	return dc
+}
+
+// SetVersion sets the "version" field.
+func (dc *DaemonCreate) SetVersion(s string) *DaemonCreate {
+	dc.mutation.SetVersion(s)
+	return dc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableVersion(s *string) *DaemonCreate {
+	if s != nil {
+		dc.SetVersion(*s)
+	}
+	return dc
+}
+
+// SetOs sets the "os" field.
+func (dc *DaemonCreate) SetOs(s string) *DaemonCreate {
+	dc.mutation.SetOs(s)
+	return dc
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableOs(s *string) *DaemonCreate {
+	if s != nil {
+		dc.SetOs(*s)
+	}
+	return dc
+}
+
+// SetArch sets the "arch" field.
+func (dc *DaemonCreate) SetArch(s string) *DaemonCreate {
+	dc.mutation.SetArch(s)
+	return dc
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableArch(s *string) *DaemonCreate {
+	if s != nil {
+		dc.SetArch(*s)
+	}
+	return dc
+}
+
+// SetLabels sets the "labels" field.
+func (dc *DaemonCreate) SetLabels(m map[string]string) *DaemonCreate {
+	dc.mutation.SetLabels(m)
+	return dc
+}
+
+// SetHasIperf3 sets the "has_iperf3" field.
+func (dc *DaemonCreate) SetHasIperf3(b bool) *DaemonCreate {
+	dc.mutation.SetHasIperf3(b)
+	return dc
+}
+
+// SetNillableHasIperf3 sets the "has_iperf3" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableHasIperf3(b *bool) *DaemonCreate {
+	if b != nil {
+		dc.SetHasIperf3(*b)
+	}
+	return dc
+}
+
+// SetHasSpeedtest sets the "has_speedtest" field.
+func (dc *DaemonCreate) SetHasSpeedtest(b bool) *DaemonCreate {
+	dc.mutation.SetHasSpeedtest(b)
+	return dc
+}
+
+// SetNillableHasSpeedtest sets the "has_speedtest" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableHasSpeedtest(b *bool) *DaemonCreate {
+	if b != nil {
+		dc.SetHasSpeedtest(*b)
+	}
+	return dc
+}
+
+// SetMeshAddress sets the "mesh_address" field.
+func (dc *DaemonCreate) SetMeshAddress(s string) *DaemonCreate {
+	dc.mutation.SetMeshAddress(s)
+	return dc
+}
+
+// SetNillableMeshAddress sets the "mesh_address" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableMeshAddress(s *string) *DaemonCreate {
+	if s != nil {
+		dc.SetMeshAddress(*s)
+	}
+	return dc
+}
+
+// SetSpoolDepth sets the "spool_depth" field.
+func (dc *DaemonCreate) SetSpoolDepth(i int) *DaemonCreate {
+	dc.mutation.SetSpoolDepth(i)
+	return dc
+}
+
+// SetNillableSpoolDepth sets the "spool_depth" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableSpoolDepth(i *int) *DaemonCreate {
+	if i != nil {
+		dc.SetSpoolDepth(*i)
+	}
+	return dc
+}
+
+// SetRegisteredAt sets the "registered_at" field.
+func (dc *DaemonCreate) SetRegisteredAt(t time.Time) *DaemonCreate {
+	dc.mutation.SetRegisteredAt(t)
+	return dc
+}
+
+// SetNillableRegisteredAt sets the "registered_at" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableRegisteredAt(t *time.Time) *DaemonCreate {
+	if t != nil {
+		dc.SetRegisteredAt(*t)
+	}
+	return dc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (dc *DaemonCreate) SetLastSeenAt(t time.Time) *DaemonCreate {
+	dc.mutation.SetLastSeenAt(t)
+	return dc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (dc *DaemonCreate) SetNillableLastSeenAt(t *time.Time) *DaemonCreate {
+	if t != nil {
+		dc.SetLastSeenAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DaemonCreate) SetID(s string) *DaemonCreate {
+	dc.mutation.SetID(s)
+	return dc
+}
+
+// Mutation returns the DaemonMutation object of the builder.
+func (dc *DaemonCreate) Mutation() *DaemonMutation {
+	return dc.mutation
+}
+
+// Save creates the Daemon in the database.
+func (dc *DaemonCreate) Save(ctx context.Context) (*Daemon, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DaemonCreate) SaveX(ctx context.Context) *Daemon {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DaemonCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DaemonCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DaemonCreate) defaults() {
+	if _, ok := dc.mutation.HasIperf3(); !ok {
+		v := daemon.DefaultHasIperf3
+		dc.mutation.SetHasIperf3(v)
+	}
+	if _, ok := dc.mutation.HasSpeedtest(); !ok {
+		v := daemon.DefaultHasSpeedtest
+		dc.mutation.SetHasSpeedtest(v)
+	}
+	if _, ok := dc.mutation.SpoolDepth(); !ok {
+		v := daemon.DefaultSpoolDepth
+		dc.mutation.SetSpoolDepth(v)
+	}
+	if _, ok := dc.mutation.RegisteredAt(); !ok {
+		v := daemon.DefaultRegisteredAt()
+		dc.mutation.SetRegisteredAt(v)
+	}
+	if _, ok := dc.mutation.LastSeenAt(); !ok {
+		v := daemon.DefaultLastSeenAt()
+		dc.mutation.SetLastSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DaemonCreate) check() error {
+	if _, ok := dc.mutation.HasIperf3(); !ok {
+		return &ValidationError{Name: "has_iperf3", err: errors.New(`ent: missing required field "Daemon.has_iperf3"`)}
+	}
+	if _, ok := dc.mutation.HasSpeedtest(); !ok {
+		return &ValidationError{Name: "has_speedtest", err: errors.New(`ent: missing required field "Daemon.has_speedtest"`)}
+	}
+	if _, ok := dc.mutation.SpoolDepth(); !ok {
+		return &ValidationError{Name: "spool_depth", err: errors.New(`ent: missing required field "Daemon.spool_depth"`)}
+	}
+	if _, ok := dc.mutation.RegisteredAt(); !ok {
+		return &ValidationError{Name: "registered_at", err: errors.New(`ent: missing required field "Daemon.registered_at"`)}
+	}
+	if _, ok := dc.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "Daemon.last_seen_at"`)}
+	}
+	if v, ok := dc.mutation.ID(); ok {
+		if err := daemon.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Daemon.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dc *DaemonCreate) sqlSave(ctx context.Context) (*Daemon, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Daemon.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DaemonCreate) createSpec() (*Daemon, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Daemon{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(daemon.Table, sqlgraph.NewFieldSpec(daemon.FieldID, field.TypeString))
+	)
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.SetField(daemon.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dc.mutation.Hostname(); ok {
+		_spec.SetField(daemon.FieldHostname, field.TypeString, value)
+		_node.Hostname = value
+	}
+	if value, ok := dc.mutation.Version(); ok {
+		_spec.SetField(daemon.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := dc.mutation.Os(); ok {
+		_spec.SetField(daemon.FieldOs, field.TypeString, value)
+		_node.Os = value
+	}
+	if value, ok := dc.mutation.Arch(); ok {
+		_spec.SetField(daemon.FieldArch, field.TypeString, value)
+		_node.Arch = value
+	}
+	if value, ok := dc.mutation.Labels(); ok {
+		_spec.SetField(daemon.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := dc.mutation.HasIperf3(); ok {
+		_spec.SetField(daemon.FieldHasIperf3, field.TypeBool, value)
+		_node.HasIperf3 = value
+	}
+	if value, ok := dc.mutation.HasSpeedtest(); ok {
+		_spec.SetField(daemon.FieldHasSpeedtest, field.TypeBool, value)
+		_node.HasSpeedtest = value
+	}
+	if value, ok := dc.mutation.MeshAddress(); ok {
+		_spec.SetField(daemon.FieldMeshAddress, field.TypeString, value)
+		_node.MeshAddress = value
+	}
+	if value, ok := dc.mutation.SpoolDepth(); ok {
+		_spec.SetField(daemon.FieldSpoolDepth, field.TypeInt, value)
+		_node.SpoolDepth = value
+	}
+	if value, ok := dc.mutation.RegisteredAt(); ok {
+		_spec.SetField(daemon.FieldRegisteredAt, field.TypeTime, value)
+		_node.RegisteredAt = value
+	}
+	if value, ok := dc.mutation.LastSeenAt(); ok {
+		_spec.SetField(daemon.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	return _node, _spec
+}
+
+// DaemonCreateBulk is the builder for creating many Daemon entities in bulk.
+type DaemonCreateBulk struct {
+	config
+	err      error
+	builders []*DaemonCreate
+}
+
+// Save creates the Daemon entities in the database.
+func (dcb *DaemonCreateBulk) Save(ctx context.Context) ([]*Daemon, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Daemon, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DaemonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DaemonCreateBulk) SaveX(ctx context.Context) []*Daemon {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DaemonCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DaemonCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
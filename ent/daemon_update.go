@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// DaemonUpdate is the builder for updating Daemon entities.
+type DaemonUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DaemonMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DaemonUpdate builder.
+func (du *DaemonUpdate) Where(ps ...predicate.Daemon) *DaemonUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DaemonUpdate) SetName(s string) *DaemonUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableName(s *string) *DaemonUpdate {
+	if s != nil {
+		du.SetName(*s)
+	}
+	return du
+}
+
+// ClearName clears the value of the "name" field.
+func (du *DaemonUpdate) ClearName() *DaemonUpdate {
+	du.mutation.ClearName()
+	return du
+}
+
+// SetHostname sets the "hostname" field.
+func (du *DaemonUpdate) SetHostname(s string) *DaemonUpdate {
+	du.mutation.SetHostname(s)
+	return du
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableHostname(s *string) *DaemonUpdate {
+	if s != nil {
+		du.SetHostname(*s)
+	}
+	return du
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (du *DaemonUpdate) ClearHostname() *DaemonUpdate {
+	du.mutation.ClearHostname()
+	return du
+}
+
+// SetVersion sets the "version" field.
+func (du *DaemonUpdate) SetVersion(s string) *DaemonUpdate {
+	du.mutation.SetVersion(s)
+	return du
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableVersion(s *string) *DaemonUpdate {
+	if s != nil {
+		du.SetVersion(*s)
+	}
+	return du
+}
+
+// ClearVersion clears the value of the "version" field.
+func (du *DaemonUpdate) ClearVersion() *DaemonUpdate {
+	du.mutation.ClearVersion()
+	return du
+}
+
+// SetOs sets the "os" field.
+func (du *DaemonUpdate) SetOs(s string) *DaemonUpdate {
+	du.mutation.SetOs(s)
+	return du
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableOs(s *string) *DaemonUpdate {
+	if s != nil {
+		du.SetOs(*s)
+	}
+	return du
+}
+
+// ClearOs clears the value of the "os" field.
+func (du *DaemonUpdate) ClearOs() *DaemonUpdate {
+	du.mutation.ClearOs()
+	return du
+}
+
+// SetArch sets the "arch" field.
+func (du *DaemonUpdate) SetArch(s string) *DaemonUpdate {
+	du.mutation.SetArch(s)
+	return du
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableArch(s *string) *DaemonUpdate {
+	if s != nil {
+		du.SetArch(*s)
+	}
+	return du
+}
+
+// ClearArch clears the value of the "arch" field.
+func (du *DaemonUpdate) ClearArch() *DaemonUpdate {
+	du.mutation.ClearArch()
+	return du
+}
+
+// SetLabels sets the "labels" field.
+func (du *DaemonUpdate) SetLabels(m map[string]string) *DaemonUpdate {
+	du.mutation.SetLabels(m)
+	return du
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (du *DaemonUpdate) ClearLabels() *DaemonUpdate {
+	du.mutation.ClearLabels()
+	return du
+}
+
+// SetHasIperf3 sets the "has_iperf3" field.
+func (du *DaemonUpdate) SetHasIperf3(b bool) *DaemonUpdate {
+	du.mutation.SetHasIperf3(b)
+	return du
+}
+
+// SetNillableHasIperf3 sets the "has_iperf3" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableHasIperf3(b *bool) *DaemonUpdate {
+	if b != nil {
+		du.SetHasIperf3(*b)
+	}
+	return du
+}
+
+// SetHasSpeedtest sets the "has_speedtest" field.
+func (du *DaemonUpdate) SetHasSpeedtest(b bool) *DaemonUpdate {
+	du.mutation.SetHasSpeedtest(b)
+	return du
+}
+
+// SetNillableHasSpeedtest sets the "has_speedtest" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableHasSpeedtest(b *bool) *DaemonUpdate {
+	if b != nil {
+		du.SetHasSpeedtest(*b)
+	}
+	return du
+}
+
+// SetMeshAddress sets the "mesh_address" field.
+func (du *DaemonUpdate) SetMeshAddress(s string) *DaemonUpdate {
+	du.mutation.SetMeshAddress(s)
+	return du
+}
+
+// SetNillableMeshAddress sets the "mesh_address" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableMeshAddress(s *string) *DaemonUpdate {
+	if s != nil {
+		du.SetMeshAddress(*s)
+	}
+	return du
+}
+
+// ClearMeshAddress clears the value of the "mesh_address" field.
+func (du *DaemonUpdate) ClearMeshAddress() *DaemonUpdate {
+	du.mutation.ClearMeshAddress()
+	return du
+}
+
+// SetSpoolDepth sets the "spool_depth" field.
+func (du *DaemonUpdate) SetSpoolDepth(i int) *DaemonUpdate {
+	du.mutation.ResetSpoolDepth()
+	du.mutation.SetSpoolDepth(i)
+	return du
+}
+
+// SetNillableSpoolDepth sets the "spool_depth" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableSpoolDepth(i *int) *DaemonUpdate {
+	if i != nil {
+		du.SetSpoolDepth(*i)
+	}
+	return du
+}
+
+// AddSpoolDepth adds i to the "spool_depth" field.
+func (du *DaemonUpdate) AddSpoolDepth(i int) *DaemonUpdate {
+	du.mutation.AddSpoolDepth(i)
+	return du
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (du *DaemonUpdate) SetLastSeenAt(t time.Time) *DaemonUpdate {
+	du.mutation.SetLastSeenAt(t)
+	return du
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (du *DaemonUpdate) SetNillableLastSeenAt(t *time.Time) *DaemonUpdate {
+	if t != nil {
+		du.SetLastSeenAt(*t)
+	}
+	return du
+}
+
+// Mutation returns the DaemonMutation object of the builder.
+func (du *DaemonUpdate) Mutation() *DaemonMutation {
+	return du.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DaemonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DaemonUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DaemonUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DaemonUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (du *DaemonUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DaemonUpdate {
+	du.modifiers = append(du.modifiers, modifiers...)
+	return du
+}
+
+func (du *DaemonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(daemon.Table, daemon.Columns, sqlgraph.NewFieldSpec(daemon.FieldID, field.TypeString))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.SetField(daemon.FieldName, field.TypeString, value)
+	}
+	if du.mutation.NameCleared() {
+		_spec.ClearField(daemon.FieldName, field.TypeString)
+	}
+	if value, ok := du.mutation.Hostname(); ok {
+		_spec.SetField(daemon.FieldHostname, field.TypeString, value)
+	}
+	if du.mutation.HostnameCleared() {
+		_spec.ClearField(daemon.FieldHostname, field.TypeString)
+	}
+	if value, ok := du.mutation.Version(); ok {
+		_spec.SetField(daemon.FieldVersion, field.TypeString, value)
+	}
+	if du.mutation.VersionCleared() {
+		_spec.ClearField(daemon.FieldVersion, field.TypeString)
+	}
+	if value, ok := du.mutation.Os(); ok {
+		_spec.SetField(daemon.FieldOs, field.TypeString, value)
+	}
+	if du.mutation.OsCleared() {
+		_spec.ClearField(daemon.FieldOs, field.TypeString)
+	}
+	if value, ok := du.mutation.Arch(); ok {
+		_spec.SetField(daemon.FieldArch, field.TypeString, value)
+	}
+	if du.mutation.ArchCleared() {
+		_spec.ClearField(daemon.FieldArch, field.TypeString)
+	}
+	if value, ok := du.mutation.Labels(); ok {
+		_spec.SetField(daemon.FieldLabels, field.TypeJSON, value)
+	}
+	if du.mutation.LabelsCleared() {
+		_spec.ClearField(daemon.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := du.mutation.HasIperf3(); ok {
+		_spec.SetField(daemon.FieldHasIperf3, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.HasSpeedtest(); ok {
+		_spec.SetField(daemon.FieldHasSpeedtest, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.MeshAddress(); ok {
+		_spec.SetField(daemon.FieldMeshAddress, field.TypeString, value)
+	}
+	if du.mutation.MeshAddressCleared() {
+		_spec.ClearField(daemon.FieldMeshAddress, field.TypeString)
+	}
+	if value, ok := du.mutation.SpoolDepth(); ok {
+		_spec.SetField(daemon.FieldSpoolDepth, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedSpoolDepth(); ok {
+		_spec.AddField(daemon.FieldSpoolDepth, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.LastSeenAt(); ok {
+		_spec.SetField(daemon.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(du.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{daemon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DaemonUpdateOne is the builder for updating a single Daemon entity.
+type DaemonUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DaemonMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (duo *DaemonUpdateOne) SetName(s string) *DaemonUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableName(s *string) *DaemonUpdateOne {
+	if s != nil {
+		duo.SetName(*s)
+	}
+	return duo
+}
+
+// ClearName clears the value of the "name" field.
+func (duo *DaemonUpdateOne) ClearName() *DaemonUpdateOne {
+	duo.mutation.ClearName()
+	return duo
+}
+
+// SetHostname sets the "hostname" field.
+func (duo *DaemonUpdateOne) SetHostname(s string) *DaemonUpdateOne {
+	duo.mutation.SetHostname(s)
+	return duo
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableHostname(s *string) *DaemonUpdateOne {
+	if s != nil {
+		duo.SetHostname(*s)
+	}
+	return duo
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (duo *DaemonUpdateOne) ClearHostname() *DaemonUpdateOne {
+	duo.mutation.ClearHostname()
+	return duo
+}
+
+// SetVersion sets the "version" field.
+func (duo *DaemonUpdateOne) SetVersion(s string) *DaemonUpdateOne {
+	duo.mutation.SetVersion(s)
+	return duo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableVersion(s *string) *DaemonUpdateOne {
+	if s != nil {
+		duo.SetVersion(*s)
+	}
+	return duo
+}
+
+// ClearVersion clears the value of the "version" field.
+func (duo *DaemonUpdateOne) ClearVersion() *DaemonUpdateOne {
+	duo.mutation.ClearVersion()
+	return duo
+}
+
+// SetOs sets the "os" field.
+func (duo *DaemonUpdateOne) SetOs(s string) *DaemonUpdateOne {
+	duo.mutation.SetOs(s)
+	return duo
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableOs(s *string) *DaemonUpdateOne {
+	if s != nil {
+		duo.SetOs(*s)
+	}
+	return duo
+}
+
+// ClearOs clears the value of the "os" field.
+func (duo *DaemonUpdateOne) ClearOs() *DaemonUpdateOne {
+	duo.mutation.ClearOs()
+	return duo
+}
+
+// SetArch sets the "arch" field.
+func (duo *DaemonUpdateOne) SetArch(s string) *DaemonUpdateOne {
+	duo.mutation.SetArch(s)
+	return duo
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableArch(s *string) *DaemonUpdateOne {
+	if s != nil {
+		duo.SetArch(*s)
+	}
+	return duo
+}
+
+// ClearArch clears the value of the "arch" field.
+func (duo *DaemonUpdateOne) ClearArch() *DaemonUpdateOne {
+	duo.mutation.ClearArch()
+	return duo
+}
+
+// SetLabels sets the "labels" field.
+func (duo *DaemonUpdateOne) SetLabels(m map[string]string) *DaemonUpdateOne {
+	duo.mutation.SetLabels(m)
+	return duo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (duo *DaemonUpdateOne) ClearLabels() *DaemonUpdateOne {
+	duo.mutation.ClearLabels()
+	return duo
+}
+
+// SetHasIperf3 sets the "has_iperf3" field.
+func (duo *DaemonUpdateOne) SetHasIperf3(b bool) *DaemonUpdateOne {
+	duo.mutation.SetHasIperf3(b)
+	return duo
+}
+
+// SetNillableHasIperf3 sets the "has_iperf3" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableHasIperf3(b *bool) *DaemonUpdateOne {
+	if b != nil {
+		duo.SetHasIperf3(*b)
+	}
+	return duo
+}
+
+// SetHasSpeedtest sets the "has_speedtest" field.
+func (duo *DaemonUpdateOne) SetHasSpeedtest(b bool) *DaemonUpdateOne {
+	duo.mutation.SetHasSpeedtest(b)
+	return duo
+}
+
+// SetNillableHasSpeedtest sets the "has_speedtest" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableHasSpeedtest(b *bool) *DaemonUpdateOne {
+	if b != nil {
+		duo.SetHasSpeedtest(*b)
+	}
+	return duo
+}
+
+// SetMeshAddress sets the "mesh_address" field.
+func (duo *DaemonUpdateOne) SetMeshAddress(s string) *DaemonUpdateOne {
+	duo.mutation.SetMeshAddress(s)
+	return duo
+}
+
+// SetNillableMeshAddress sets the "mesh_address" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableMeshAddress(s *string) *DaemonUpdateOne {
+	if s != nil {
+		duo.SetMeshAddress(*s)
+	}
+	return duo
+}
+
+// ClearMeshAddress clears the value of the "mesh_address" field.
+func (duo *DaemonUpdateOne) ClearMeshAddress() *DaemonUpdateOne {
+	duo.mutation.ClearMeshAddress()
+	return duo
+}
+
+// SetSpoolDepth sets the "spool_depth" field.
+func (duo *DaemonUpdateOne) SetSpoolDepth(i int) *DaemonUpdateOne {
+	duo.mutation.ResetSpoolDepth()
+	duo.mutation.SetSpoolDepth(i)
+	return duo
+}
+
+// SetNillableSpoolDepth sets the "spool_depth" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableSpoolDepth(i *int) *DaemonUpdateOne {
+	if i != nil {
+		duo.SetSpoolDepth(*i)
+	}
+	return duo
+}
+
+// AddSpoolDepth adds i to the "spool_depth" field.
+func (duo *DaemonUpdateOne) AddSpoolDepth(i int) *DaemonUpdateOne {
+	duo.mutation.AddSpoolDepth(i)
+	return duo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (duo *DaemonUpdateOne) SetLastSeenAt(t time.Time) *DaemonUpdateOne {
+	duo.mutation.SetLastSeenAt(t)
+	return duo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (duo *DaemonUpdateOne) SetNillableLastSeenAt(t *time.Time) *DaemonUpdateOne {
+	if t != nil {
+		duo.SetLastSeenAt(*t)
+	}
+	return duo
+}
+
+// Mutation returns the DaemonMutation object of the builder.
+func (duo *DaemonUpdateOne) Mutation() *DaemonMutation {
+	return duo.mutation
+}
+
+// Where appends a list predicates to the DaemonUpdate builder.
+func (duo *DaemonUpdateOne) Where(ps ...predicate.Daemon) *DaemonUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DaemonUpdateOne) Select(field string, fields ...string) *DaemonUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Daemon entity.
+func (duo *DaemonUpdateOne) Save(ctx context.Context) (*Daemon, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DaemonUpdateOne) SaveX(ctx context.Context) *Daemon {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DaemonUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DaemonUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (duo *DaemonUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DaemonUpdateOne {
+	duo.modifiers = append(duo.modifiers, modifiers...)
+	return duo
+}
+
+func (duo *DaemonUpdateOne) sqlSave(ctx context.Context) (_node *Daemon, err error) {
+	_spec := sqlgraph.NewUpdateSpec(daemon.Table, daemon.Columns, sqlgraph.NewFieldSpec(daemon.FieldID, field.TypeString))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Daemon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, daemon.FieldID)
+		for _, f := range fields {
+			if !daemon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != daemon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.SetField(daemon.FieldName, field.TypeString, value)
+	}
+	if duo.mutation.NameCleared() {
+		_spec.ClearField(daemon.FieldName, field.TypeString)
+	}
+	if value, ok := duo.mutation.Hostname(); ok {
+		_spec.SetField(daemon.FieldHostname, field.TypeString, value)
+	}
+	if duo.mutation.HostnameCleared() {
+		_spec.ClearField(daemon.FieldHostname, field.TypeString)
+	}
+	if value, ok := duo.mutation.Version(); ok {
+		_spec.SetField(daemon.FieldVersion, field.TypeString, value)
+	}
+	if duo.mutation.VersionCleared() {
+		_spec.ClearField(daemon.FieldVersion, field.TypeString)
+	}
+	if value, ok := duo.mutation.Os(); ok {
+		_spec.SetField(daemon.FieldOs, field.TypeString, value)
+	}
+	if duo.mutation.OsCleared() {
+		_spec.ClearField(daemon.FieldOs, field.TypeString)
+	}
+	if value, ok := duo.mutation.Arch(); ok {
+		_spec.SetField(daemon.FieldArch, field.TypeString, value)
+	}
+	if duo.mutation.ArchCleared() {
+		_spec.ClearField(daemon.FieldArch, field.TypeString)
+	}
+	if value, ok := duo.mutation.Labels(); ok {
+		_spec.SetField(daemon.FieldLabels, field.TypeJSON, value)
+	}
+	if duo.mutation.LabelsCleared() {
+		_spec.ClearField(daemon.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.HasIperf3(); ok {
+		_spec.SetField(daemon.FieldHasIperf3, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.HasSpeedtest(); ok {
+		_spec.SetField(daemon.FieldHasSpeedtest, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.MeshAddress(); ok {
+		_spec.SetField(daemon.FieldMeshAddress, field.TypeString, value)
+	}
+	if duo.mutation.MeshAddressCleared() {
+		_spec.ClearField(daemon.FieldMeshAddress, field.TypeString)
+	}
+	if value, ok := duo.mutation.SpoolDepth(); ok {
+		_spec.SetField(daemon.FieldSpoolDepth, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedSpoolDepth(); ok {
+		_spec.AddField(daemon.FieldSpoolDepth, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.LastSeenAt(); ok {
+		_spec.SetField(daemon.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(duo.modifiers...)
+	_node = &Daemon{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{daemon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+)
+
+// DaemonConfig is the model entity for the DaemonConfig schema.
+type DaemonConfig struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Human-friendly name, e.g. what the override is for
+	Name string `json:"name,omitempty"`
+	// Daemon the override applies to; unset for global defaults and label overrides
+	DaemonID *string `json:"daemon_id,omitempty"`
+	// Labels a daemon must all carry for the override to apply
+	MatchLabels map[string]string `json:"match_labels,omitempty"`
+	// Order among configs of the same kind; higher priority wins
+	Priority int `json:"priority,omitempty"`
+	// SpeedtestIntervalSeconds holds the value of the "speedtest_interval_seconds" field.
+	SpeedtestIntervalSeconds *int `json:"speedtest_interval_seconds,omitempty"`
+	// IperfIntervalSeconds holds the value of the "iperf_interval_seconds" field.
+	IperfIntervalSeconds *int `json:"iperf_interval_seconds,omitempty"`
+	// IperfDurationSeconds holds the value of the "iperf_duration_seconds" field.
+	IperfDurationSeconds *int `json:"iperf_duration_seconds,omitempty"`
+	// SpeedtestEnabled holds the value of the "speedtest_enabled" field.
+	SpeedtestEnabled *bool `json:"speedtest_enabled,omitempty"`
+	// IperfEnabled holds the value of the "iperf_enabled" field.
+	IperfEnabled *bool `json:"iperf_enabled,omitempty"`
+	// AdaptiveEnabled holds the value of the "adaptive_enabled" field.
+	AdaptiveEnabled *bool `json:"adaptive_enabled,omitempty"`
+	// Only test hosts of these types; empty leaves the filter unset
+	HostTypes []string `json:"host_types,omitempty"`
+	// Only test these hosts; empty leaves the filter unset
+	HostIds []int `json:"host_ids,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DaemonConfig) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case daemonconfig.FieldMatchLabels, daemonconfig.FieldHostTypes, daemonconfig.FieldHostIds:
+			values[i] = new([]byte)
+		case daemonconfig.FieldSpeedtestEnabled, daemonconfig.FieldIperfEnabled, daemonconfig.FieldAdaptiveEnabled:
+			values[i] = new(sql.NullBool)
+		case daemonconfig.FieldID, daemonconfig.FieldPriority, daemonconfig.FieldSpeedtestIntervalSeconds, daemonconfig.FieldIperfIntervalSeconds, daemonconfig.FieldIperfDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case daemonconfig.FieldName, daemonconfig.FieldDaemonID:
+			values[i] = new(sql.NullString)
+		case daemonconfig.FieldCreatedAt, daemonconfig.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DaemonConfig fields.
+func (dc *DaemonConfig) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case daemonconfig.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dc.ID = int(value.Int64)
+		case daemonconfig.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				dc.Name = value.String
+			}
+		case daemonconfig.FieldDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_id", values[i])
+			} else if value.Valid {
+				dc.DaemonID = new(string)
+				*dc.DaemonID = value.String
+			}
+		case daemonconfig.FieldMatchLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field match_labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dc.MatchLabels); err != nil {
+					return fmt.Errorf("unmarshal field match_labels: %w", err)
+				}
+			}
+		case daemonconfig.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				dc.Priority = int(value.Int64)
+			}
+		case daemonconfig.FieldSpeedtestIntervalSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field speedtest_interval_seconds", values[i])
+			} else if value.Valid {
+				dc.SpeedtestIntervalSeconds = new(int)
+				*dc.SpeedtestIntervalSeconds = int(value.Int64)
+			}
+		case daemonconfig.FieldIperfIntervalSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field iperf_interval_seconds", values[i])
+			} else if value.Valid {
+				dc.IperfIntervalSeconds = new(int)
+				*dc.IperfIntervalSeconds = int(value.Int64)
+			}
+		case daemonconfig.FieldIperfDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field iperf_duration_seconds", values[i])
+			} else if value.Valid {
+				dc.IperfDurationSeconds = new(int)
+				*dc.IperfDurationSeconds = int(value.Int64)
+			}
+		case daemonconfig.FieldSpeedtestEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field speedtest_enabled", values[i])
+			} else if value.Valid {
+				dc.SpeedtestEnabled = new(bool)
+				*dc.SpeedtestEnabled = value.Bool
+			}
+		case daemonconfig.FieldIperfEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field iperf_enabled", values[i])
+			} else if value.Valid {
+				dc.IperfEnabled = new(bool)
+				*dc.IperfEnabled = value.Bool
+			}
+		case daemonconfig.FieldAdaptiveEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptive_enabled", values[i])
+			} else if value.Valid {
+				dc.AdaptiveEnabled = new(bool)
+				*dc.AdaptiveEnabled = value.Bool
+			}
+		case daemonconfig.FieldHostTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field host_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dc.HostTypes); err != nil {
+					return fmt.Errorf("unmarshal field host_types: %w", err)
+				}
+			}
+		case daemonconfig.FieldHostIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field host_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dc.HostIds); err != nil {
+					return fmt.Errorf("unmarshal field host_ids: %w", err)
+				}
+			}
+		case daemonconfig.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dc.CreatedAt = value.Time
+			}
+		case daemonconfig.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dc.UpdatedAt = value.Time
+			}
+		default:
+			dc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DaemonConfig.
+// This includes values selected through modifiers, order, etc.
+func (dc *DaemonConfig) Value(name string) (ent.Value, error) {
+	return dc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DaemonConfig.
+// Note that you need to call DaemonConfig.Unwrap() before calling this method if this DaemonConfig
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dc *DaemonConfig) Update() *DaemonConfigUpdateOne {
+	return NewDaemonConfigClient(dc.config).UpdateOne(dc)
+}
+
+// Unwrap unwraps the DaemonConfig entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dc *DaemonConfig) Unwrap() *DaemonConfig {
+	_tx, ok := dc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DaemonConfig is not a transactional entity")
+	}
+	dc.config.driver = _tx.drv
+	return dc
+}
+
+// String implements the fmt.Stringer.
+func (dc *DaemonConfig) String() string {
+	var builder strings.Builder
+	builder.WriteString("DaemonConfig(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dc.ID))
+	builder.WriteString("name=")
+	builder.WriteString(dc.Name)
+	builder.WriteString(", ")
+	if v := dc.DaemonID; v != nil {
+		builder.WriteString("daemon_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("match_labels=")
+	builder.WriteString(fmt.Sprintf("%v", dc.MatchLabels))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Priority))
+	builder.WriteString(", ")
+	if v := dc.SpeedtestIntervalSeconds; v != nil {
+		builder.WriteString("speedtest_interval_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := dc.IperfIntervalSeconds; v != nil {
+		builder.WriteString("iperf_interval_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := dc.IperfDurationSeconds; v != nil {
+		builder.WriteString("iperf_duration_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := dc.SpeedtestEnabled; v != nil {
+		builder.WriteString("speedtest_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := dc.IperfEnabled; v != nil {
+		builder.WriteString("iperf_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := dc.AdaptiveEnabled; v != nil {
+		builder.WriteString("adaptive_enabled=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("host_types=")
+	builder.WriteString(fmt.Sprintf("%v", dc.HostTypes))
+	builder.WriteString(", ")
+	builder.WriteString("host_ids=")
+	builder.WriteString(fmt.Sprintf("%v", dc.HostIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DaemonConfigs is a parsable slice of DaemonConfig.
+type DaemonConfigs []*DaemonConfig
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package daemonconfig
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the daemonconfig type in the database.
+	Label = "daemon_config"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDaemonID holds the string denoting the daemon_id field in the database.
+	FieldDaemonID = "daemon_id"
+	// FieldMatchLabels holds the string denoting the match_labels field in the database.
+	FieldMatchLabels = "match_labels"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldSpeedtestIntervalSeconds holds the string denoting the speedtest_interval_seconds field in the database.
+	FieldSpeedtestIntervalSeconds = "speedtest_interval_seconds"
+	// FieldIperfIntervalSeconds holds the string denoting the iperf_interval_seconds field in the database.
+	FieldIperfIntervalSeconds = "iperf_interval_seconds"
+	// FieldIperfDurationSeconds holds the string denoting the iperf_duration_seconds field in the database.
+	FieldIperfDurationSeconds = "iperf_duration_seconds"
+	// FieldSpeedtestEnabled holds the string denoting the speedtest_enabled field in the database.
+	FieldSpeedtestEnabled = "speedtest_enabled"
+	// FieldIperfEnabled holds the string denoting the iperf_enabled field in the database.
+	FieldIperfEnabled = "iperf_enabled"
+	// FieldAdaptiveEnabled holds the string denoting the adaptive_enabled field in the database.
+	FieldAdaptiveEnabled = "adaptive_enabled"
+	// FieldHostTypes holds the string denoting the host_types field in the database.
+	FieldHostTypes = "host_types"
+	// FieldHostIds holds the string denoting the host_ids field in the database.
+	FieldHostIds = "host_ids"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the daemonconfig in the database.
+	Table = "daemon_configs"
+)
+
+// Columns holds all SQL columns for daemonconfig fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDaemonID,
+	FieldMatchLabels,
+	FieldPriority,
+	FieldSpeedtestIntervalSeconds,
+	FieldIperfIntervalSeconds,
+	FieldIperfDurationSeconds,
+	FieldSpeedtestEnabled,
+	FieldIperfEnabled,
+	FieldAdaptiveEnabled,
+	FieldHostTypes,
+	FieldHostIds,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// SpeedtestIntervalSecondsValidator is a validator for the "speedtest_interval_seconds" field. It is called by the builders before save.
+	SpeedtestIntervalSecondsValidator func(int) error
+	// IperfIntervalSecondsValidator is a validator for the "iperf_interval_seconds" field. It is called by the builders before save.
+	IperfIntervalSecondsValidator func(int) error
+	// IperfDurationSecondsValidator is a validator for the "iperf_duration_seconds" field. It is called by the builders before save.
+	IperfDurationSecondsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DaemonConfig queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDaemonID orders the results by the daemon_id field.
+func ByDaemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDaemonID, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// BySpeedtestIntervalSeconds orders the results by the speedtest_interval_seconds field.
+func BySpeedtestIntervalSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpeedtestIntervalSeconds, opts...).ToFunc()
+}
+
+// ByIperfIntervalSeconds orders the results by the iperf_interval_seconds field.
+func ByIperfIntervalSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIperfIntervalSeconds, opts...).ToFunc()
+}
+
+// ByIperfDurationSeconds orders the results by the iperf_duration_seconds field.
+func ByIperfDurationSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIperfDurationSeconds, opts...).ToFunc()
+}
+
+// BySpeedtestEnabled orders the results by the speedtest_enabled field.
+func BySpeedtestEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpeedtestEnabled, opts...).ToFunc()
+}
+
+// ByIperfEnabled orders the results by the iperf_enabled field.
+func ByIperfEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIperfEnabled, opts...).ToFunc()
+}
+
+// ByAdaptiveEnabled orders the results by the adaptive_enabled field.
+func ByAdaptiveEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
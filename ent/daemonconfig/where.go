@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package daemonconfig
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldName, v))
+}
+
+// DaemonID applies equality check predicate on the "daemon_id" field. It's identical to DaemonIDEQ.
+func DaemonID(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldDaemonID, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldPriority, v))
+}
+
+// SpeedtestIntervalSeconds applies equality check predicate on the "speedtest_interval_seconds" field. It's identical to SpeedtestIntervalSecondsEQ.
+func SpeedtestIntervalSeconds(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldSpeedtestIntervalSeconds, v))
+}
+
+// IperfIntervalSeconds applies equality check predicate on the "iperf_interval_seconds" field. It's identical to IperfIntervalSecondsEQ.
+func IperfIntervalSeconds(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldIperfIntervalSeconds, v))
+}
+
+// IperfDurationSeconds applies equality check predicate on the "iperf_duration_seconds" field. It's identical to IperfDurationSecondsEQ.
+func IperfDurationSeconds(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldIperfDurationSeconds, v))
+}
+
+// SpeedtestEnabled applies equality check predicate on the "speedtest_enabled" field. It's identical to SpeedtestEnabledEQ.
+func SpeedtestEnabled(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldSpeedtestEnabled, v))
+}
+
+// IperfEnabled applies equality check predicate on the "iperf_enabled" field. It's identical to IperfEnabledEQ.
+func IperfEnabled(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldIperfEnabled, v))
+}
+
+// AdaptiveEnabled applies equality check predicate on the "adaptive_enabled" field. It's identical to AdaptiveEnabledEQ.
+func AdaptiveEnabled(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldAdaptiveEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldContainsFold(FieldName, v))
+}
+
+// DaemonIDEQ applies the EQ predicate on the "daemon_id" field.
+func DaemonIDEQ(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldDaemonID, v))
+}
+
+// DaemonIDNEQ applies the NEQ predicate on the "daemon_id" field.
+func DaemonIDNEQ(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldDaemonID, v))
+}
+
+// DaemonIDIn applies the In predicate on the "daemon_id" field.
+func DaemonIDIn(vs ...string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldDaemonID, vs...))
+}
+
+// DaemonIDNotIn applies the NotIn predicate on the "daemon_id" field.
+func DaemonIDNotIn(vs ...string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldDaemonID, vs...))
+}
+
+// DaemonIDGT applies the GT predicate on the "daemon_id" field.
+func DaemonIDGT(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldDaemonID, v))
+}
+
+// DaemonIDGTE applies the GTE predicate on the "daemon_id" field.
+func DaemonIDGTE(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldDaemonID, v))
+}
+
+// DaemonIDLT applies the LT predicate on the "daemon_id" field.
+func DaemonIDLT(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldDaemonID, v))
+}
+
+// DaemonIDLTE applies the LTE predicate on the "daemon_id" field.
+func DaemonIDLTE(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldDaemonID, v))
+}
+
+// DaemonIDContains applies the Contains predicate on the "daemon_id" field.
+func DaemonIDContains(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldContains(FieldDaemonID, v))
+}
+
+// DaemonIDHasPrefix applies the HasPrefix predicate on the "daemon_id" field.
+func DaemonIDHasPrefix(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldHasPrefix(FieldDaemonID, v))
+}
+
+// DaemonIDHasSuffix applies the HasSuffix predicate on the "daemon_id" field.
+func DaemonIDHasSuffix(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldHasSuffix(FieldDaemonID, v))
+}
+
+// DaemonIDIsNil applies the IsNil predicate on the "daemon_id" field.
+func DaemonIDIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldDaemonID))
+}
+
+// DaemonIDNotNil applies the NotNil predicate on the "daemon_id" field.
+func DaemonIDNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldDaemonID))
+}
+
+// DaemonIDEqualFold applies the EqualFold predicate on the "daemon_id" field.
+func DaemonIDEqualFold(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEqualFold(FieldDaemonID, v))
+}
+
+// DaemonIDContainsFold applies the ContainsFold predicate on the "daemon_id" field.
+func DaemonIDContainsFold(v string) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldContainsFold(FieldDaemonID, v))
+}
+
+// MatchLabelsIsNil applies the IsNil predicate on the "match_labels" field.
+func MatchLabelsIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldMatchLabels))
+}
+
+// MatchLabelsNotNil applies the NotNil predicate on the "match_labels" field.
+func MatchLabelsNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldMatchLabels))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldPriority, v))
+}
+
+// SpeedtestIntervalSecondsEQ applies the EQ predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldSpeedtestIntervalSeconds, v))
+}
+
+// SpeedtestIntervalSecondsNEQ applies the NEQ predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsNEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldSpeedtestIntervalSeconds, v))
+}
+
+// SpeedtestIntervalSecondsIn applies the In predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldSpeedtestIntervalSeconds, vs...))
+}
+
+// SpeedtestIntervalSecondsNotIn applies the NotIn predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsNotIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldSpeedtestIntervalSeconds, vs...))
+}
+
+// SpeedtestIntervalSecondsGT applies the GT predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsGT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldSpeedtestIntervalSeconds, v))
+}
+
+// SpeedtestIntervalSecondsGTE applies the GTE predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsGTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldSpeedtestIntervalSeconds, v))
+}
+
+// SpeedtestIntervalSecondsLT applies the LT predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsLT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldSpeedtestIntervalSeconds, v))
+}
+
+// SpeedtestIntervalSecondsLTE applies the LTE predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsLTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldSpeedtestIntervalSeconds, v))
+}
+
+// SpeedtestIntervalSecondsIsNil applies the IsNil predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldSpeedtestIntervalSeconds))
+}
+
+// SpeedtestIntervalSecondsNotNil applies the NotNil predicate on the "speedtest_interval_seconds" field.
+func SpeedtestIntervalSecondsNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldSpeedtestIntervalSeconds))
+}
+
+// IperfIntervalSecondsEQ applies the EQ predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldIperfIntervalSeconds, v))
+}
+
+// IperfIntervalSecondsNEQ applies the NEQ predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsNEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldIperfIntervalSeconds, v))
+}
+
+// IperfIntervalSecondsIn applies the In predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldIperfIntervalSeconds, vs...))
+}
+
+// IperfIntervalSecondsNotIn applies the NotIn predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsNotIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldIperfIntervalSeconds, vs...))
+}
+
+// IperfIntervalSecondsGT applies the GT predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsGT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldIperfIntervalSeconds, v))
+}
+
+// IperfIntervalSecondsGTE applies the GTE predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsGTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldIperfIntervalSeconds, v))
+}
+
+// IperfIntervalSecondsLT applies the LT predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsLT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldIperfIntervalSeconds, v))
+}
+
+// IperfIntervalSecondsLTE applies the LTE predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsLTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldIperfIntervalSeconds, v))
+}
+
+// IperfIntervalSecondsIsNil applies the IsNil predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldIperfIntervalSeconds))
+}
+
+// IperfIntervalSecondsNotNil applies the NotNil predicate on the "iperf_interval_seconds" field.
+func IperfIntervalSecondsNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldIperfIntervalSeconds))
+}
+
+// IperfDurationSecondsEQ applies the EQ predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldIperfDurationSeconds, v))
+}
+
+// IperfDurationSecondsNEQ applies the NEQ predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsNEQ(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldIperfDurationSeconds, v))
+}
+
+// IperfDurationSecondsIn applies the In predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldIperfDurationSeconds, vs...))
+}
+
+// IperfDurationSecondsNotIn applies the NotIn predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsNotIn(vs ...int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldIperfDurationSeconds, vs...))
+}
+
+// IperfDurationSecondsGT applies the GT predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsGT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldIperfDurationSeconds, v))
+}
+
+// IperfDurationSecondsGTE applies the GTE predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsGTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldIperfDurationSeconds, v))
+}
+
+// IperfDurationSecondsLT applies the LT predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsLT(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldIperfDurationSeconds, v))
+}
+
+// IperfDurationSecondsLTE applies the LTE predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsLTE(v int) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldIperfDurationSeconds, v))
+}
+
+// IperfDurationSecondsIsNil applies the IsNil predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldIperfDurationSeconds))
+}
+
+// IperfDurationSecondsNotNil applies the NotNil predicate on the "iperf_duration_seconds" field.
+func IperfDurationSecondsNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldIperfDurationSeconds))
+}
+
+// SpeedtestEnabledEQ applies the EQ predicate on the "speedtest_enabled" field.
+func SpeedtestEnabledEQ(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldSpeedtestEnabled, v))
+}
+
+// SpeedtestEnabledNEQ applies the NEQ predicate on the "speedtest_enabled" field.
+func SpeedtestEnabledNEQ(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldSpeedtestEnabled, v))
+}
+
+// SpeedtestEnabledIsNil applies the IsNil predicate on the "speedtest_enabled" field.
+func SpeedtestEnabledIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldSpeedtestEnabled))
+}
+
+// SpeedtestEnabledNotNil applies the NotNil predicate on the "speedtest_enabled" field.
+func SpeedtestEnabledNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldSpeedtestEnabled))
+}
+
+// IperfEnabledEQ applies the EQ predicate on the "iperf_enabled" field.
+func IperfEnabledEQ(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldIperfEnabled, v))
+}
+
+// IperfEnabledNEQ applies the NEQ predicate on the "iperf_enabled" field.
+func IperfEnabledNEQ(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldIperfEnabled, v))
+}
+
+// IperfEnabledIsNil applies the IsNil predicate on the "iperf_enabled" field.
+func IperfEnabledIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldIperfEnabled))
+}
+
+// IperfEnabledNotNil applies the NotNil predicate on the "iperf_enabled" field.
+func IperfEnabledNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldIperfEnabled))
+}
+
+// AdaptiveEnabledEQ applies the EQ predicate on the "adaptive_enabled" field.
+func AdaptiveEnabledEQ(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldAdaptiveEnabled, v))
+}
+
+// AdaptiveEnabledNEQ applies the NEQ predicate on the "adaptive_enabled" field.
+func AdaptiveEnabledNEQ(v bool) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldAdaptiveEnabled, v))
+}
+
+// AdaptiveEnabledIsNil applies the IsNil predicate on the "adaptive_enabled" field.
+func AdaptiveEnabledIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldAdaptiveEnabled))
+}
+
+// AdaptiveEnabledNotNil applies the NotNil predicate on the "adaptive_enabled" field.
+func AdaptiveEnabledNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldAdaptiveEnabled))
+}
+
+// HostTypesIsNil applies the IsNil predicate on the "host_types" field.
+func HostTypesIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldHostTypes))
+}
+
+// HostTypesNotNil applies the NotNil predicate on the "host_types" field.
+func HostTypesNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldHostTypes))
+}
+
+// HostIdsIsNil applies the IsNil predicate on the "host_ids" field.
+func HostIdsIsNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIsNull(FieldHostIds))
+}
+
+// HostIdsNotNil applies the NotNil predicate on the "host_ids" field.
+func HostIdsNotNil() predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotNull(FieldHostIds))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DaemonConfig) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DaemonConfig) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DaemonConfig) predicate.DaemonConfig {
+	return predicate.DaemonConfig(sql.NotPredicates(p))
+}
@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+)
+
+// DaemonConfigCreate is the builder for creating a DaemonConfig entity.
+type DaemonConfigCreate struct {
+	config
+	mutation *DaemonConfigMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (dcc *DaemonConfigCreate) SetName(s string) *DaemonConfigCreate {
+	dcc.mutation.SetName(s)
+	return dcc
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (dcc *DaemonConfigCreate) SetDaemonID(s string) *DaemonConfigCreate {
+	dcc.mutation.SetDaemonID(s)
+	return dcc
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableDaemonID(s *string) *DaemonConfigCreate {
+	if s != nil {
+		dcc.SetDaemonID(*s)
+	}
+	return dcc
+}
+
+// SetMatchLabels sets the "match_labels" field.
+func (dcc *DaemonConfigCreate) SetMatchLabels(m map[string]string) *DaemonConfigCreate {
+	dcc.mutation.SetMatchLabels(m)
+	return dcc
+}
+
+// SetPriority sets the "priority" field.
+func (dcc *DaemonConfigCreate) SetPriority(i int) *DaemonConfigCreate {
+	dcc.mutation.SetPriority(i)
+	return dcc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillablePriority(i *int) *DaemonConfigCreate {
+	if i != nil {
+		dcc.SetPriority(*i)
+	}
+	return dcc
+}
+
+// SetSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field.
+func (dcc *DaemonConfigCreate) SetSpeedtestIntervalSeconds(i int) *DaemonConfigCreate {
+	dcc.mutation.SetSpeedtestIntervalSeconds(i)
+	return dcc
+}
+
+// SetNillableSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableSpeedtestIntervalSeconds(i *int) *DaemonConfigCreate {
+	if i != nil {
+		dcc.SetSpeedtestIntervalSeconds(*i)
+	}
+	return dcc
+}
+
+// SetIperfIntervalSeconds sets the "iperf_interval_seconds" field.
+func (dcc *DaemonConfigCreate) SetIperfIntervalSeconds(i int) *DaemonConfigCreate {
+	dcc.mutation.SetIperfIntervalSeconds(i)
+	return dcc
+}
+
+// SetNillableIperfIntervalSeconds sets the "iperf_interval_seconds" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableIperfIntervalSeconds(i *int) *DaemonConfigCreate {
+	if i != nil {
+		dcc.SetIperfIntervalSeconds(*i)
+	}
+	return dcc
+}
+
+// SetIperfDurationSeconds sets the "iperf_duration_seconds" field.
+func (dcc *DaemonConfigCreate) SetIperfDurationSeconds(i int) *DaemonConfigCreate {
+	dcc.mutation.SetIperfDurationSeconds(i)
+	return dcc
+}
+
+// SetNillableIperfDurationSeconds sets the "iperf_duration_seconds" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableIperfDurationSeconds(i *int) *DaemonConfigCreate {
+	if i != nil {
+		dcc.SetIperfDurationSeconds(*i)
+	}
+	return dcc
+}
+
+// SetSpeedtestEnabled sets the "speedtest_enabled" field.
+func (dcc *DaemonConfigCreate) SetSpeedtestEnabled(b bool) *DaemonConfigCreate {
+	dcc.mutation.SetSpeedtestEnabled(b)
+	return dcc
+}
+
+// SetNillableSpeedtestEnabled sets the "speedtest_enabled" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableSpeedtestEnabled(b *bool) *DaemonConfigCreate {
+	if b != nil {
+		dcc.SetSpeedtestEnabled(*b)
+	}
+	return dcc
+}
+
+// SetIperfEnabled sets the "iperf_enabled" field.
+func (dcc *DaemonConfigCreate) SetIperfEnabled(b bool) *DaemonConfigCreate {
+	dcc.mutation.SetIperfEnabled(b)
+	return dcc
+}
+
+// SetNillableIperfEnabled sets the "iperf_enabled" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableIperfEnabled(b *bool) *DaemonConfigCreate {
+	if b != nil {
+		dcc.SetIperfEnabled(*b)
+	}
+	return dcc
+}
+
+// SetAdaptiveEnabled sets the "adaptive_enabled" field.
+func (dcc *DaemonConfigCreate) SetAdaptiveEnabled(b bool) *DaemonConfigCreate {
+	dcc.mutation.SetAdaptiveEnabled(b)
+	return dcc
+}
+
+// SetNillableAdaptiveEnabled sets the "adaptive_enabled" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableAdaptiveEnabled(b *bool) *DaemonConfigCreate {
+	if b != nil {
+		dcc.SetAdaptiveEnabled(*b)
+	}
+	return dcc
+}
+
+// SetHostTypes sets the "host_types" field.
+func (dcc *DaemonConfigCreate) SetHostTypes(s []string) *DaemonConfigCreate {
+	dcc.mutation.SetHostTypes(s)
+	return dcc
+}
+
+// SetHostIds sets the "host_ids" field.
+func (dcc *DaemonConfigCreate) SetHostIds(i []int) *DaemonConfigCreate {
+	dcc.mutation.SetHostIds(i)
+	return dcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dcc *DaemonConfigCreate) SetCreatedAt(t time.Time) *DaemonConfigCreate {
+	dcc.mutation.SetCreatedAt(t)
+	return dcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableCreatedAt(t *time.Time) *DaemonConfigCreate {
+	if t != nil {
+		dcc.SetCreatedAt(*t)
+	}
+	return dcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dcc *DaemonConfigCreate) SetUpdatedAt(t time.Time) *DaemonConfigCreate {
+	dcc.mutation.SetUpdatedAt(t)
+	return dcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dcc *DaemonConfigCreate) SetNillableUpdatedAt(t *time.Time) *DaemonConfigCreate {
+	if t != nil {
+		dcc.SetUpdatedAt(*t)
+	}
+	return dcc
+}
+
+// Mutation returns the DaemonConfigMutation object of the builder.
+func (dcc *DaemonConfigCreate) Mutation() *DaemonConfigMutation {
+	return dcc.mutation
+}
+
+// Save creates the DaemonConfig in the database.
+func (dcc *DaemonConfigCreate) Save(ctx context.Context) (*DaemonConfig, error) {
+	dcc.defaults()
+	return withHooks(ctx, dcc.sqlSave, dcc.mutation, dcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dcc *DaemonConfigCreate) SaveX(ctx context.Context) *DaemonConfig {
+	v, err := dcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcc *DaemonConfigCreate) Exec(ctx context.Context) error {
+	_, err := dcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcc *DaemonConfigCreate) ExecX(ctx context.Context) {
+	if err := dcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcc *DaemonConfigCreate) defaults() {
+	if _, ok := dcc.mutation.Priority(); !ok {
+		v := daemonconfig.DefaultPriority
+		dcc.mutation.SetPriority(v)
+	}
+	if _, ok := dcc.mutation.CreatedAt(); !ok {
+		v := daemonconfig.DefaultCreatedAt()
+		dcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dcc.mutation.UpdatedAt(); !ok {
+		v := daemonconfig.DefaultUpdatedAt()
+		dcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcc *DaemonConfigCreate) check() error {
+	if _, ok := dcc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DaemonConfig.name"`)}
+	}
+	if v, ok := dcc.mutation.Name(); ok {
+		if err := daemonconfig.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.name": %w`, err)}
+		}
+	}
+	if _, ok := dcc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "DaemonConfig.priority"`)}
+	}
+	if v, ok := dcc.mutation.SpeedtestIntervalSeconds(); ok {
+		if err := daemonconfig.SpeedtestIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "speedtest_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.speedtest_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := dcc.mutation.IperfIntervalSeconds(); ok {
+		if err := daemonconfig.IperfIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "iperf_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.iperf_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := dcc.mutation.IperfDurationSeconds(); ok {
+		if err := daemonconfig.IperfDurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "iperf_duration_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.iperf_duration_seconds": %w`, err)}
+		}
+	}
+	if _, ok := dcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DaemonConfig.created_at"`)}
+	}
+	if _, ok := dcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DaemonConfig.updated_at"`)}
+	}
+	return nil
+}
+
+func (dcc *DaemonConfigCreate) sqlSave(ctx context.Context) (*DaemonConfig, error) {
+	if err := dcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dcc.mutation.id = &_node.ID
+	dcc.mutation.done = true
+	return _node, nil
+}
+
+func (dcc *DaemonConfigCreate) createSpec() (*DaemonConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DaemonConfig{config: dcc.config}
+		_spec = sqlgraph.NewCreateSpec(daemonconfig.Table, sqlgraph.NewFieldSpec(daemonconfig.FieldID, field.TypeInt))
+	)
+	if value, ok := dcc.mutation.Name(); ok {
+		_spec.SetField(daemonconfig.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dcc.mutation.DaemonID(); ok {
+		_spec.SetField(daemonconfig.FieldDaemonID, field.TypeString, value)
+		_node.DaemonID = &value
+	}
+	if value, ok := dcc.mutation.MatchLabels(); ok {
+		_spec.SetField(daemonconfig.FieldMatchLabels, field.TypeJSON, value)
+		_node.MatchLabels = value
+	}
+	if value, ok := dcc.mutation.Priority(); ok {
+		_spec.SetField(daemonconfig.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := dcc.mutation.SpeedtestIntervalSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt, value)
+		_node.SpeedtestIntervalSeconds = &value
+	}
+	if value, ok := dcc.mutation.IperfIntervalSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt, value)
+		_node.IperfIntervalSeconds = &value
+	}
+	if value, ok := dcc.mutation.IperfDurationSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt, value)
+		_node.IperfDurationSeconds = &value
+	}
+	if value, ok := dcc.mutation.SpeedtestEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldSpeedtestEnabled, field.TypeBool, value)
+		_node.SpeedtestEnabled = &value
+	}
+	if value, ok := dcc.mutation.IperfEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldIperfEnabled, field.TypeBool, value)
+		_node.IperfEnabled = &value
+	}
+	if value, ok := dcc.mutation.AdaptiveEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldAdaptiveEnabled, field.TypeBool, value)
+		_node.AdaptiveEnabled = &value
+	}
+	if value, ok := dcc.mutation.HostTypes(); ok {
+		_spec.SetField(daemonconfig.FieldHostTypes, field.TypeJSON, value)
+		_node.HostTypes = value
+	}
+	if value, ok := dcc.mutation.HostIds(); ok {
+		_spec.SetField(daemonconfig.FieldHostIds, field.TypeJSON, value)
+		_node.HostIds = value
+	}
+	if value, ok := dcc.mutation.CreatedAt(); ok {
+		_spec.SetField(daemonconfig.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(daemonconfig.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DaemonConfigCreateBulk is the builder for creating many DaemonConfig entities in bulk.
+type DaemonConfigCreateBulk struct {
+	config
+	err      error
+	builders []*DaemonConfigCreate
+}
+
+// Save creates the DaemonConfig entities in the database.
+func (dccb *DaemonConfigCreateBulk) Save(ctx context.Context) ([]*DaemonConfig, error) {
+	if dccb.err != nil {
+		return nil, dccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dccb.builders))
+	nodes := make([]*DaemonConfig, len(dccb.builders))
+	mutators := make([]Mutator, len(dccb.builders))
+	for i := range dccb.builders {
+		func(i int, root context.Context) {
+			builder := dccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DaemonConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dccb *DaemonConfigCreateBulk) SaveX(ctx context.Context) []*DaemonConfig {
+	v, err := dccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dccb *DaemonConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := dccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dccb *DaemonConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := dccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
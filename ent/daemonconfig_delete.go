@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// DaemonConfigDelete is the builder for deleting a DaemonConfig entity.
+type DaemonConfigDelete struct {
+	config
+	hooks    []Hook
+	mutation *DaemonConfigMutation
+}
+
+// Where appends a list predicates to the DaemonConfigDelete builder.
+func (dcd *DaemonConfigDelete) Where(ps ...predicate.DaemonConfig) *DaemonConfigDelete {
+	dcd.mutation.Where(ps...)
+	return dcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dcd *DaemonConfigDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dcd.sqlExec, dcd.mutation, dcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcd *DaemonConfigDelete) ExecX(ctx context.Context) int {
+	n, err := dcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dcd *DaemonConfigDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(daemonconfig.Table, sqlgraph.NewFieldSpec(daemonconfig.FieldID, field.TypeInt))
+	if ps := dcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dcd.mutation.done = true
+	return affected, err
+}
+
+// DaemonConfigDeleteOne is the builder for deleting a single DaemonConfig entity.
+type DaemonConfigDeleteOne struct {
+	dcd *DaemonConfigDelete
+}
+
+// Where appends a list predicates to the DaemonConfigDelete builder.
+func (dcdo *DaemonConfigDeleteOne) Where(ps ...predicate.DaemonConfig) *DaemonConfigDeleteOne {
+	dcdo.dcd.mutation.Where(ps...)
+	return dcdo
+}
+
+// Exec executes the deletion query.
+func (dcdo *DaemonConfigDeleteOne) Exec(ctx context.Context) error {
+	n, err := dcdo.dcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{daemonconfig.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcdo *DaemonConfigDeleteOne) ExecX(ctx context.Context) {
+	if err := dcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// DaemonConfigQuery is the builder for querying DaemonConfig entities.
+type DaemonConfigQuery struct {
+	config
+	ctx        *QueryContext
+	order      []daemonconfig.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DaemonConfig
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DaemonConfigQuery builder.
+func (dcq *DaemonConfigQuery) Where(ps ...predicate.DaemonConfig) *DaemonConfigQuery {
+	dcq.predicates = append(dcq.predicates, ps...)
+	return dcq
+}
+
+// Limit the number of records to be returned by this query.
+func (dcq *DaemonConfigQuery) Limit(limit int) *DaemonConfigQuery {
+	dcq.ctx.Limit = &limit
+	return dcq
+}
+
+// Offset to start from.
+func (dcq *DaemonConfigQuery) Offset(offset int) *DaemonConfigQuery {
+	dcq.ctx.Offset = &offset
+	return dcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dcq *DaemonConfigQuery) Unique(unique bool) *DaemonConfigQuery {
+	dcq.ctx.Unique = &unique
+	return dcq
+}
+
+// Order specifies how the records should be ordered.
+func (dcq *DaemonConfigQuery) Order(o ...daemonconfig.OrderOption) *DaemonConfigQuery {
+	dcq.order = append(dcq.order, o...)
+	return dcq
+}
+
+// First returns the first DaemonConfig entity from the query.
+// Returns a *NotFoundError when no DaemonConfig was found.
+func (dcq *DaemonConfigQuery) First(ctx context.Context) (*DaemonConfig, error) {
+	nodes, err := dcq.Limit(1).All(setContextOp(ctx, dcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{daemonconfig.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) FirstX(ctx context.Context) *DaemonConfig {
+	node, err := dcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DaemonConfig ID from the query.
+// Returns a *NotFoundError when no DaemonConfig ID was found.
+func (dcq *DaemonConfigQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dcq.Limit(1).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{daemonconfig.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) FirstIDX(ctx context.Context) int {
+	id, err := dcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DaemonConfig entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DaemonConfig entity is found.
+// Returns a *NotFoundError when no DaemonConfig entities are found.
+func (dcq *DaemonConfigQuery) Only(ctx context.Context) (*DaemonConfig, error) {
+	nodes, err := dcq.Limit(2).All(setContextOp(ctx, dcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{daemonconfig.Label}
+	default:
+		return nil, &NotSingularError{daemonconfig.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) OnlyX(ctx context.Context) *DaemonConfig {
+	node, err := dcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DaemonConfig ID in the query.
+// Returns a *NotSingularError when more than one DaemonConfig ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dcq *DaemonConfigQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dcq.Limit(2).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{daemonconfig.Label}
+	default:
+		err = &NotSingularError{daemonconfig.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DaemonConfigs.
+func (dcq *DaemonConfigQuery) All(ctx context.Context) ([]*DaemonConfig, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryAll)
+	if err := dcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DaemonConfig, *DaemonConfigQuery]()
+	return withInterceptors[[]*DaemonConfig](ctx, dcq, qr, dcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) AllX(ctx context.Context) []*DaemonConfig {
+	nodes, err := dcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DaemonConfig IDs.
+func (dcq *DaemonConfigQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dcq.ctx.Unique == nil && dcq.path != nil {
+		dcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryIDs)
+	if err = dcq.Select(daemonconfig.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) IDsX(ctx context.Context) []int {
+	ids, err := dcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dcq *DaemonConfigQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryCount)
+	if err := dcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dcq, querierCount[*DaemonConfigQuery](), dcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) CountX(ctx context.Context) int {
+	count, err := dcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dcq *DaemonConfigQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryExist)
+	switch _, err := dcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dcq *DaemonConfigQuery) ExistX(ctx context.Context) bool {
+	exist, err := dcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DaemonConfigQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dcq *DaemonConfigQuery) Clone() *DaemonConfigQuery {
+	if dcq == nil {
+		return nil
+	}
+	return &DaemonConfigQuery{
+		config:     dcq.config,
+		ctx:        dcq.ctx.Clone(),
+		order:      append([]daemonconfig.OrderOption{}, dcq.order...),
+		inters:     append([]Interceptor{}, dcq.inters...),
+		predicates: append([]predicate.DaemonConfig{}, dcq.predicates...),
+		// clone intermediate query.
+		sql:       dcq.sql.Clone(),
+		path:      dcq.path,
+		modifiers: append([]func(*sql.Selector){}, dcq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DaemonConfig.Query().
+//		GroupBy(daemonconfig.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dcq *DaemonConfigQuery) GroupBy(field string, fields ...string) *DaemonConfigGroupBy {
+	dcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DaemonConfigGroupBy{build: dcq}
+	grbuild.flds = &dcq.ctx.Fields
+	grbuild.label = daemonconfig.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.DaemonConfig.Query().
+//		Select(daemonconfig.FieldName).
+//		Scan(ctx, &v)
+func (dcq *DaemonConfigQuery) Select(fields ...string) *DaemonConfigSelect {
+	dcq.ctx.Fields = append(dcq.ctx.Fields, fields...)
+	sbuild := &DaemonConfigSelect{DaemonConfigQuery: dcq}
+	sbuild.label = daemonconfig.Label
+	sbuild.flds, sbuild.scan = &dcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DaemonConfigSelect configured with the given aggregations.
+func (dcq *DaemonConfigQuery) Aggregate(fns ...AggregateFunc) *DaemonConfigSelect {
+	return dcq.Select().Aggregate(fns...)
+}
+
+func (dcq *DaemonConfigQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dcq.ctx.Fields {
+		if !daemonconfig.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dcq.path != nil {
+		prev, err := dcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dcq.sql = prev
+	}
+	return nil
+}
+
+func (dcq *DaemonConfigQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DaemonConfig, error) {
+	var (
+		nodes = []*DaemonConfig{}
+		_spec = dcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DaemonConfig).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DaemonConfig{config: dcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dcq.modifiers) > 0 {
+		_spec.Modifiers = dcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dcq *DaemonConfigQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dcq.querySpec()
+	if len(dcq.modifiers) > 0 {
+		_spec.Modifiers = dcq.modifiers
+	}
+	_spec.Node.Columns = dcq.ctx.Fields
+	if len(dcq.ctx.Fields) > 0 {
+		_spec.Unique = dcq.ctx.Unique != nil && *dcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dcq.driver, _spec)
+}
+
+func (dcq *DaemonConfigQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(daemonconfig.Table, daemonconfig.Columns, sqlgraph.NewFieldSpec(daemonconfig.FieldID, field.TypeInt))
+	_spec.From = dcq.sql
+	if unique := dcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, daemonconfig.FieldID)
+		for i := range fields {
+			if fields[i] != daemonconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dcq *DaemonConfigQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dcq.driver.Dialect())
+	t1 := builder.Table(daemonconfig.Table)
+	columns := dcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = daemonconfig.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dcq.sql != nil {
+		selector = dcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dcq.ctx.Unique != nil && *dcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dcq.modifiers {
+		m(selector)
+	}
+	for _, p := range dcq.predicates {
+		p(selector)
+	}
+	for _, p := range dcq.order {
+		p(selector)
+	}
+	if offset := dcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dcq *DaemonConfigQuery) Modify(modifiers ...func(s *sql.Selector)) *DaemonConfigSelect {
+	dcq.modifiers = append(dcq.modifiers, modifiers...)
+	return dcq.Select()
+}
+
+// DaemonConfigGroupBy is the group-by builder for DaemonConfig entities.
+type DaemonConfigGroupBy struct {
+	selector
+	build *DaemonConfigQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dcgb *DaemonConfigGroupBy) Aggregate(fns ...AggregateFunc) *DaemonConfigGroupBy {
+	dcgb.fns = append(dcgb.fns, fns...)
+	return dcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dcgb *DaemonConfigGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DaemonConfigQuery, *DaemonConfigGroupBy](ctx, dcgb.build, dcgb, dcgb.build.inters, v)
+}
+
+func (dcgb *DaemonConfigGroupBy) sqlScan(ctx context.Context, root *DaemonConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dcgb.fns))
+	for _, fn := range dcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dcgb.flds)+len(dcgb.fns))
+		for _, f := range *dcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DaemonConfigSelect is the builder for selecting fields of DaemonConfig entities.
+type DaemonConfigSelect struct {
+	*DaemonConfigQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dcs *DaemonConfigSelect) Aggregate(fns ...AggregateFunc) *DaemonConfigSelect {
+	dcs.fns = append(dcs.fns, fns...)
+	return dcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dcs *DaemonConfigSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dcs.ctx, ent.OpQuerySelect)
+	if err := dcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DaemonConfigQuery, *DaemonConfigSelect](ctx, dcs.DaemonConfigQuery, dcs, dcs.inters, v)
+}
+
+func (dcs *DaemonConfigSelect) sqlScan(ctx context.Context, root *DaemonConfigQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dcs.fns))
+	for _, fn := range dcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dcs *DaemonConfigSelect) Modify(modifiers ...func(s *sql.Selector)) *DaemonConfigSelect {
+	dcs.modifiers = append(dcs.modifiers, modifiers...)
+	return dcs
+}
@@ -0,0 +1,949 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// DaemonConfigUpdate is the builder for updating DaemonConfig entities.
+type DaemonConfigUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DaemonConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DaemonConfigUpdate builder.
+func (dcu *DaemonConfigUpdate) Where(ps ...predicate.DaemonConfig) *DaemonConfigUpdate {
+	dcu.mutation.Where(ps...)
+	return dcu
+}
+
+// SetName sets the "name" field.
+func (dcu *DaemonConfigUpdate) SetName(s string) *DaemonConfigUpdate {
+	dcu.mutation.SetName(s)
+	return dcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableName(s *string) *DaemonConfigUpdate {
+	if s != nil {
+		dcu.SetName(*s)
+	}
+	return dcu
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (dcu *DaemonConfigUpdate) SetDaemonID(s string) *DaemonConfigUpdate {
+	dcu.mutation.SetDaemonID(s)
+	return dcu
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableDaemonID(s *string) *DaemonConfigUpdate {
+	if s != nil {
+		dcu.SetDaemonID(*s)
+	}
+	return dcu
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (dcu *DaemonConfigUpdate) ClearDaemonID() *DaemonConfigUpdate {
+	dcu.mutation.ClearDaemonID()
+	return dcu
+}
+
+// SetMatchLabels sets the "match_labels" field.
+func (dcu *DaemonConfigUpdate) SetMatchLabels(m map[string]string) *DaemonConfigUpdate {
+	dcu.mutation.SetMatchLabels(m)
+	return dcu
+}
+
+// ClearMatchLabels clears the value of the "match_labels" field.
+func (dcu *DaemonConfigUpdate) ClearMatchLabels() *DaemonConfigUpdate {
+	dcu.mutation.ClearMatchLabels()
+	return dcu
+}
+
+// SetPriority sets the "priority" field.
+func (dcu *DaemonConfigUpdate) SetPriority(i int) *DaemonConfigUpdate {
+	dcu.mutation.ResetPriority()
+	dcu.mutation.SetPriority(i)
+	return dcu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillablePriority(i *int) *DaemonConfigUpdate {
+	if i != nil {
+		dcu.SetPriority(*i)
+	}
+	return dcu
+}
+
+// AddPriority adds i to the "priority" field.
+func (dcu *DaemonConfigUpdate) AddPriority(i int) *DaemonConfigUpdate {
+	dcu.mutation.AddPriority(i)
+	return dcu
+}
+
+// SetSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field.
+func (dcu *DaemonConfigUpdate) SetSpeedtestIntervalSeconds(i int) *DaemonConfigUpdate {
+	dcu.mutation.ResetSpeedtestIntervalSeconds()
+	dcu.mutation.SetSpeedtestIntervalSeconds(i)
+	return dcu
+}
+
+// SetNillableSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableSpeedtestIntervalSeconds(i *int) *DaemonConfigUpdate {
+	if i != nil {
+		dcu.SetSpeedtestIntervalSeconds(*i)
+	}
+	return dcu
+}
+
+// AddSpeedtestIntervalSeconds adds i to the "speedtest_interval_seconds" field.
+func (dcu *DaemonConfigUpdate) AddSpeedtestIntervalSeconds(i int) *DaemonConfigUpdate {
+	dcu.mutation.AddSpeedtestIntervalSeconds(i)
+	return dcu
+}
+
+// ClearSpeedtestIntervalSeconds clears the value of the "speedtest_interval_seconds" field.
+func (dcu *DaemonConfigUpdate) ClearSpeedtestIntervalSeconds() *DaemonConfigUpdate {
+	dcu.mutation.ClearSpeedtestIntervalSeconds()
+	return dcu
+}
+
+// SetIperfIntervalSeconds sets the "iperf_interval_seconds" field.
+func (dcu *DaemonConfigUpdate) SetIperfIntervalSeconds(i int) *DaemonConfigUpdate {
+	dcu.mutation.ResetIperfIntervalSeconds()
+	dcu.mutation.SetIperfIntervalSeconds(i)
+	return dcu
+}
+
+// SetNillableIperfIntervalSeconds sets the "iperf_interval_seconds" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableIperfIntervalSeconds(i *int) *DaemonConfigUpdate {
+	if i != nil {
+		dcu.SetIperfIntervalSeconds(*i)
+	}
+	return dcu
+}
+
+// AddIperfIntervalSeconds adds i to the "iperf_interval_seconds" field.
+func (dcu *DaemonConfigUpdate) AddIperfIntervalSeconds(i int) *DaemonConfigUpdate {
+	dcu.mutation.AddIperfIntervalSeconds(i)
+	return dcu
+}
+
+// ClearIperfIntervalSeconds clears the value of the "iperf_interval_seconds" field.
+func (dcu *DaemonConfigUpdate) ClearIperfIntervalSeconds() *DaemonConfigUpdate {
+	dcu.mutation.ClearIperfIntervalSeconds()
+	return dcu
+}
+
+// SetIperfDurationSeconds sets the "iperf_duration_seconds" field.
+func (dcu *DaemonConfigUpdate) SetIperfDurationSeconds(i int) *DaemonConfigUpdate {
+	dcu.mutation.ResetIperfDurationSeconds()
+	dcu.mutation.SetIperfDurationSeconds(i)
+	return dcu
+}
+
+// SetNillableIperfDurationSeconds sets the "iperf_duration_seconds" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableIperfDurationSeconds(i *int) *DaemonConfigUpdate {
+	if i != nil {
+		dcu.SetIperfDurationSeconds(*i)
+	}
+	return dcu
+}
+
+// AddIperfDurationSeconds adds i to the "iperf_duration_seconds" field.
+func (dcu *DaemonConfigUpdate) AddIperfDurationSeconds(i int) *DaemonConfigUpdate {
+	dcu.mutation.AddIperfDurationSeconds(i)
+	return dcu
+}
+
+// ClearIperfDurationSeconds clears the value of the "iperf_duration_seconds" field.
+func (dcu *DaemonConfigUpdate) ClearIperfDurationSeconds() *DaemonConfigUpdate {
+	dcu.mutation.ClearIperfDurationSeconds()
+	return dcu
+}
+
+// SetSpeedtestEnabled sets the "speedtest_enabled" field.
+func (dcu *DaemonConfigUpdate) SetSpeedtestEnabled(b bool) *DaemonConfigUpdate {
+	dcu.mutation.SetSpeedtestEnabled(b)
+	return dcu
+}
+
+// SetNillableSpeedtestEnabled sets the "speedtest_enabled" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableSpeedtestEnabled(b *bool) *DaemonConfigUpdate {
+	if b != nil {
+		dcu.SetSpeedtestEnabled(*b)
+	}
+	return dcu
+}
+
+// ClearSpeedtestEnabled clears the value of the "speedtest_enabled" field.
+func (dcu *DaemonConfigUpdate) ClearSpeedtestEnabled() *DaemonConfigUpdate {
+	dcu.mutation.ClearSpeedtestEnabled()
+	return dcu
+}
+
+// SetIperfEnabled sets the "iperf_enabled" field.
+func (dcu *DaemonConfigUpdate) SetIperfEnabled(b bool) *DaemonConfigUpdate {
+	dcu.mutation.SetIperfEnabled(b)
+	return dcu
+}
+
+// SetNillableIperfEnabled sets the "iperf_enabled" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableIperfEnabled(b *bool) *DaemonConfigUpdate {
+	if b != nil {
+		dcu.SetIperfEnabled(*b)
+	}
+	return dcu
+}
+
+// ClearIperfEnabled clears the value of the "iperf_enabled" field.
+func (dcu *DaemonConfigUpdate) ClearIperfEnabled() *DaemonConfigUpdate {
+	dcu.mutation.ClearIperfEnabled()
+	return dcu
+}
+
+// SetAdaptiveEnabled sets the "adaptive_enabled" field.
+func (dcu *DaemonConfigUpdate) SetAdaptiveEnabled(b bool) *DaemonConfigUpdate {
+	dcu.mutation.SetAdaptiveEnabled(b)
+	return dcu
+}
+
+// SetNillableAdaptiveEnabled sets the "adaptive_enabled" field if the given value is not nil.
+func (dcu *DaemonConfigUpdate) SetNillableAdaptiveEnabled(b *bool) *DaemonConfigUpdate {
+	if b != nil {
+		dcu.SetAdaptiveEnabled(*b)
+	}
+	return dcu
+}
+
+// ClearAdaptiveEnabled clears the value of the "adaptive_enabled" field.
+func (dcu *DaemonConfigUpdate) ClearAdaptiveEnabled() *DaemonConfigUpdate {
+	dcu.mutation.ClearAdaptiveEnabled()
+	return dcu
+}
+
+// SetHostTypes sets the "host_types" field.
+func (dcu *DaemonConfigUpdate) SetHostTypes(s []string) *DaemonConfigUpdate {
+	dcu.mutation.SetHostTypes(s)
+	return dcu
+}
+
+// AppendHostTypes appends s to the "host_types" field.
+func (dcu *DaemonConfigUpdate) AppendHostTypes(s []string) *DaemonConfigUpdate {
+	dcu.mutation.AppendHostTypes(s)
+	return dcu
+}
+
+// ClearHostTypes clears the value of the "host_types" field.
+func (dcu *DaemonConfigUpdate) ClearHostTypes() *DaemonConfigUpdate {
+	dcu.mutation.ClearHostTypes()
+	return dcu
+}
+
+// SetHostIds sets the "host_ids" field.
+func (dcu *DaemonConfigUpdate) SetHostIds(i []int) *DaemonConfigUpdate {
+	dcu.mutation.SetHostIds(i)
+	return dcu
+}
+
+// AppendHostIds appends i to the "host_ids" field.
+func (dcu *DaemonConfigUpdate) AppendHostIds(i []int) *DaemonConfigUpdate {
+	dcu.mutation.AppendHostIds(i)
+	return dcu
+}
+
+// ClearHostIds clears the value of the "host_ids" field.
+func (dcu *DaemonConfigUpdate) ClearHostIds() *DaemonConfigUpdate {
+	dcu.mutation.ClearHostIds()
+	return dcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dcu *DaemonConfigUpdate) SetUpdatedAt(t time.Time) *DaemonConfigUpdate {
+	dcu.mutation.SetUpdatedAt(t)
+	return dcu
+}
+
+// Mutation returns the DaemonConfigMutation object of the builder.
+func (dcu *DaemonConfigUpdate) Mutation() *DaemonConfigMutation {
+	return dcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dcu *DaemonConfigUpdate) Save(ctx context.Context) (int, error) {
+	dcu.defaults()
+	return withHooks(ctx, dcu.sqlSave, dcu.mutation, dcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcu *DaemonConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := dcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dcu *DaemonConfigUpdate) Exec(ctx context.Context) error {
+	_, err := dcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcu *DaemonConfigUpdate) ExecX(ctx context.Context) {
+	if err := dcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcu *DaemonConfigUpdate) defaults() {
+	if _, ok := dcu.mutation.UpdatedAt(); !ok {
+		v := daemonconfig.UpdateDefaultUpdatedAt()
+		dcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcu *DaemonConfigUpdate) check() error {
+	if v, ok := dcu.mutation.Name(); ok {
+		if err := daemonconfig.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.name": %w`, err)}
+		}
+	}
+	if v, ok := dcu.mutation.SpeedtestIntervalSeconds(); ok {
+		if err := daemonconfig.SpeedtestIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "speedtest_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.speedtest_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := dcu.mutation.IperfIntervalSeconds(); ok {
+		if err := daemonconfig.IperfIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "iperf_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.iperf_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := dcu.mutation.IperfDurationSeconds(); ok {
+		if err := daemonconfig.IperfDurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "iperf_duration_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.iperf_duration_seconds": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dcu *DaemonConfigUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DaemonConfigUpdate {
+	dcu.modifiers = append(dcu.modifiers, modifiers...)
+	return dcu
+}
+
+func (dcu *DaemonConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(daemonconfig.Table, daemonconfig.Columns, sqlgraph.NewFieldSpec(daemonconfig.FieldID, field.TypeInt))
+	if ps := dcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dcu.mutation.Name(); ok {
+		_spec.SetField(daemonconfig.FieldName, field.TypeString, value)
+	}
+	if value, ok := dcu.mutation.DaemonID(); ok {
+		_spec.SetField(daemonconfig.FieldDaemonID, field.TypeString, value)
+	}
+	if dcu.mutation.DaemonIDCleared() {
+		_spec.ClearField(daemonconfig.FieldDaemonID, field.TypeString)
+	}
+	if value, ok := dcu.mutation.MatchLabels(); ok {
+		_spec.SetField(daemonconfig.FieldMatchLabels, field.TypeJSON, value)
+	}
+	if dcu.mutation.MatchLabelsCleared() {
+		_spec.ClearField(daemonconfig.FieldMatchLabels, field.TypeJSON)
+	}
+	if value, ok := dcu.mutation.Priority(); ok {
+		_spec.SetField(daemonconfig.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.AddedPriority(); ok {
+		_spec.AddField(daemonconfig.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.SpeedtestIntervalSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.AddedSpeedtestIntervalSeconds(); ok {
+		_spec.AddField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt, value)
+	}
+	if dcu.mutation.SpeedtestIntervalSecondsCleared() {
+		_spec.ClearField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt)
+	}
+	if value, ok := dcu.mutation.IperfIntervalSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.AddedIperfIntervalSeconds(); ok {
+		_spec.AddField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt, value)
+	}
+	if dcu.mutation.IperfIntervalSecondsCleared() {
+		_spec.ClearField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt)
+	}
+	if value, ok := dcu.mutation.IperfDurationSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.AddedIperfDurationSeconds(); ok {
+		_spec.AddField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt, value)
+	}
+	if dcu.mutation.IperfDurationSecondsCleared() {
+		_spec.ClearField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt)
+	}
+	if value, ok := dcu.mutation.SpeedtestEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldSpeedtestEnabled, field.TypeBool, value)
+	}
+	if dcu.mutation.SpeedtestEnabledCleared() {
+		_spec.ClearField(daemonconfig.FieldSpeedtestEnabled, field.TypeBool)
+	}
+	if value, ok := dcu.mutation.IperfEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldIperfEnabled, field.TypeBool, value)
+	}
+	if dcu.mutation.IperfEnabledCleared() {
+		_spec.ClearField(daemonconfig.FieldIperfEnabled, field.TypeBool)
+	}
+	if value, ok := dcu.mutation.AdaptiveEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldAdaptiveEnabled, field.TypeBool, value)
+	}
+	if dcu.mutation.AdaptiveEnabledCleared() {
+		_spec.ClearField(daemonconfig.FieldAdaptiveEnabled, field.TypeBool)
+	}
+	if value, ok := dcu.mutation.HostTypes(); ok {
+		_spec.SetField(daemonconfig.FieldHostTypes, field.TypeJSON, value)
+	}
+	if value, ok := dcu.mutation.AppendedHostTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, daemonconfig.FieldHostTypes, value)
+		})
+	}
+	if dcu.mutation.HostTypesCleared() {
+		_spec.ClearField(daemonconfig.FieldHostTypes, field.TypeJSON)
+	}
+	if value, ok := dcu.mutation.HostIds(); ok {
+		_spec.SetField(daemonconfig.FieldHostIds, field.TypeJSON, value)
+	}
+	if value, ok := dcu.mutation.AppendedHostIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, daemonconfig.FieldHostIds, value)
+		})
+	}
+	if dcu.mutation.HostIdsCleared() {
+		_spec.ClearField(daemonconfig.FieldHostIds, field.TypeJSON)
+	}
+	if value, ok := dcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(daemonconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(dcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{daemonconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dcu.mutation.done = true
+	return n, nil
+}
+
+// DaemonConfigUpdateOne is the builder for updating a single DaemonConfig entity.
+type DaemonConfigUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DaemonConfigMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (dcuo *DaemonConfigUpdateOne) SetName(s string) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetName(s)
+	return dcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableName(s *string) *DaemonConfigUpdateOne {
+	if s != nil {
+		dcuo.SetName(*s)
+	}
+	return dcuo
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (dcuo *DaemonConfigUpdateOne) SetDaemonID(s string) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetDaemonID(s)
+	return dcuo
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableDaemonID(s *string) *DaemonConfigUpdateOne {
+	if s != nil {
+		dcuo.SetDaemonID(*s)
+	}
+	return dcuo
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (dcuo *DaemonConfigUpdateOne) ClearDaemonID() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearDaemonID()
+	return dcuo
+}
+
+// SetMatchLabels sets the "match_labels" field.
+func (dcuo *DaemonConfigUpdateOne) SetMatchLabels(m map[string]string) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetMatchLabels(m)
+	return dcuo
+}
+
+// ClearMatchLabels clears the value of the "match_labels" field.
+func (dcuo *DaemonConfigUpdateOne) ClearMatchLabels() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearMatchLabels()
+	return dcuo
+}
+
+// SetPriority sets the "priority" field.
+func (dcuo *DaemonConfigUpdateOne) SetPriority(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.ResetPriority()
+	dcuo.mutation.SetPriority(i)
+	return dcuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillablePriority(i *int) *DaemonConfigUpdateOne {
+	if i != nil {
+		dcuo.SetPriority(*i)
+	}
+	return dcuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (dcuo *DaemonConfigUpdateOne) AddPriority(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.AddPriority(i)
+	return dcuo
+}
+
+// SetSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) SetSpeedtestIntervalSeconds(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.ResetSpeedtestIntervalSeconds()
+	dcuo.mutation.SetSpeedtestIntervalSeconds(i)
+	return dcuo
+}
+
+// SetNillableSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableSpeedtestIntervalSeconds(i *int) *DaemonConfigUpdateOne {
+	if i != nil {
+		dcuo.SetSpeedtestIntervalSeconds(*i)
+	}
+	return dcuo
+}
+
+// AddSpeedtestIntervalSeconds adds i to the "speedtest_interval_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) AddSpeedtestIntervalSeconds(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.AddSpeedtestIntervalSeconds(i)
+	return dcuo
+}
+
+// ClearSpeedtestIntervalSeconds clears the value of the "speedtest_interval_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) ClearSpeedtestIntervalSeconds() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearSpeedtestIntervalSeconds()
+	return dcuo
+}
+
+// SetIperfIntervalSeconds sets the "iperf_interval_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) SetIperfIntervalSeconds(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.ResetIperfIntervalSeconds()
+	dcuo.mutation.SetIperfIntervalSeconds(i)
+	return dcuo
+}
+
+// SetNillableIperfIntervalSeconds sets the "iperf_interval_seconds" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableIperfIntervalSeconds(i *int) *DaemonConfigUpdateOne {
+	if i != nil {
+		dcuo.SetIperfIntervalSeconds(*i)
+	}
+	return dcuo
+}
+
+// AddIperfIntervalSeconds adds i to the "iperf_interval_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) AddIperfIntervalSeconds(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.AddIperfIntervalSeconds(i)
+	return dcuo
+}
+
+// ClearIperfIntervalSeconds clears the value of the "iperf_interval_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) ClearIperfIntervalSeconds() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearIperfIntervalSeconds()
+	return dcuo
+}
+
+// SetIperfDurationSeconds sets the "iperf_duration_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) SetIperfDurationSeconds(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.ResetIperfDurationSeconds()
+	dcuo.mutation.SetIperfDurationSeconds(i)
+	return dcuo
+}
+
+// SetNillableIperfDurationSeconds sets the "iperf_duration_seconds" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableIperfDurationSeconds(i *int) *DaemonConfigUpdateOne {
+	if i != nil {
+		dcuo.SetIperfDurationSeconds(*i)
+	}
+	return dcuo
+}
+
+// AddIperfDurationSeconds adds i to the "iperf_duration_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) AddIperfDurationSeconds(i int) *DaemonConfigUpdateOne {
+	dcuo.mutation.AddIperfDurationSeconds(i)
+	return dcuo
+}
+
+// ClearIperfDurationSeconds clears the value of the "iperf_duration_seconds" field.
+func (dcuo *DaemonConfigUpdateOne) ClearIperfDurationSeconds() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearIperfDurationSeconds()
+	return dcuo
+}
+
+// SetSpeedtestEnabled sets the "speedtest_enabled" field.
+func (dcuo *DaemonConfigUpdateOne) SetSpeedtestEnabled(b bool) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetSpeedtestEnabled(b)
+	return dcuo
+}
+
+// SetNillableSpeedtestEnabled sets the "speedtest_enabled" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableSpeedtestEnabled(b *bool) *DaemonConfigUpdateOne {
+	if b != nil {
+		dcuo.SetSpeedtestEnabled(*b)
+	}
+	return dcuo
+}
+
+// ClearSpeedtestEnabled clears the value of the "speedtest_enabled" field.
+func (dcuo *DaemonConfigUpdateOne) ClearSpeedtestEnabled() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearSpeedtestEnabled()
+	return dcuo
+}
+
+// SetIperfEnabled sets the "iperf_enabled" field.
+func (dcuo *DaemonConfigUpdateOne) SetIperfEnabled(b bool) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetIperfEnabled(b)
+	return dcuo
+}
+
+// SetNillableIperfEnabled sets the "iperf_enabled" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableIperfEnabled(b *bool) *DaemonConfigUpdateOne {
+	if b != nil {
+		dcuo.SetIperfEnabled(*b)
+	}
+	return dcuo
+}
+
+// ClearIperfEnabled clears the value of the "iperf_enabled" field.
+func (dcuo *DaemonConfigUpdateOne) ClearIperfEnabled() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearIperfEnabled()
+	return dcuo
+}
+
+// SetAdaptiveEnabled sets the "adaptive_enabled" field.
+func (dcuo *DaemonConfigUpdateOne) SetAdaptiveEnabled(b bool) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetAdaptiveEnabled(b)
+	return dcuo
+}
+
+// SetNillableAdaptiveEnabled sets the "adaptive_enabled" field if the given value is not nil.
+func (dcuo *DaemonConfigUpdateOne) SetNillableAdaptiveEnabled(b *bool) *DaemonConfigUpdateOne {
+	if b != nil {
+		dcuo.SetAdaptiveEnabled(*b)
+	}
+	return dcuo
+}
+
+// ClearAdaptiveEnabled clears the value of the "adaptive_enabled" field.
+func (dcuo *DaemonConfigUpdateOne) ClearAdaptiveEnabled() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearAdaptiveEnabled()
+	return dcuo
+}
+
+// SetHostTypes sets the "host_types" field.
+func (dcuo *DaemonConfigUpdateOne) SetHostTypes(s []string) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetHostTypes(s)
+	return dcuo
+}
+
+// AppendHostTypes appends s to the "host_types" field.
+func (dcuo *DaemonConfigUpdateOne) AppendHostTypes(s []string) *DaemonConfigUpdateOne {
+	dcuo.mutation.AppendHostTypes(s)
+	return dcuo
+}
+
+// ClearHostTypes clears the value of the "host_types" field.
+func (dcuo *DaemonConfigUpdateOne) ClearHostTypes() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearHostTypes()
+	return dcuo
+}
+
+// SetHostIds sets the "host_ids" field.
+func (dcuo *DaemonConfigUpdateOne) SetHostIds(i []int) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetHostIds(i)
+	return dcuo
+}
+
+// AppendHostIds appends i to the "host_ids" field.
+func (dcuo *DaemonConfigUpdateOne) AppendHostIds(i []int) *DaemonConfigUpdateOne {
+	dcuo.mutation.AppendHostIds(i)
+	return dcuo
+}
+
+// ClearHostIds clears the value of the "host_ids" field.
+func (dcuo *DaemonConfigUpdateOne) ClearHostIds() *DaemonConfigUpdateOne {
+	dcuo.mutation.ClearHostIds()
+	return dcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dcuo *DaemonConfigUpdateOne) SetUpdatedAt(t time.Time) *DaemonConfigUpdateOne {
+	dcuo.mutation.SetUpdatedAt(t)
+	return dcuo
+}
+
+// Mutation returns the DaemonConfigMutation object of the builder.
+func (dcuo *DaemonConfigUpdateOne) Mutation() *DaemonConfigMutation {
+	return dcuo.mutation
+}
+
+// Where appends a list predicates to the DaemonConfigUpdate builder.
+func (dcuo *DaemonConfigUpdateOne) Where(ps ...predicate.DaemonConfig) *DaemonConfigUpdateOne {
+	dcuo.mutation.Where(ps...)
+	return dcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dcuo *DaemonConfigUpdateOne) Select(field string, fields ...string) *DaemonConfigUpdateOne {
+	dcuo.fields = append([]string{field}, fields...)
+	return dcuo
+}
+
+// Save executes the query and returns the updated DaemonConfig entity.
+func (dcuo *DaemonConfigUpdateOne) Save(ctx context.Context) (*DaemonConfig, error) {
+	dcuo.defaults()
+	return withHooks(ctx, dcuo.sqlSave, dcuo.mutation, dcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcuo *DaemonConfigUpdateOne) SaveX(ctx context.Context) *DaemonConfig {
+	node, err := dcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dcuo *DaemonConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := dcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcuo *DaemonConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := dcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcuo *DaemonConfigUpdateOne) defaults() {
+	if _, ok := dcuo.mutation.UpdatedAt(); !ok {
+		v := daemonconfig.UpdateDefaultUpdatedAt()
+		dcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcuo *DaemonConfigUpdateOne) check() error {
+	if v, ok := dcuo.mutation.Name(); ok {
+		if err := daemonconfig.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.name": %w`, err)}
+		}
+	}
+	if v, ok := dcuo.mutation.SpeedtestIntervalSeconds(); ok {
+		if err := daemonconfig.SpeedtestIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "speedtest_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.speedtest_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := dcuo.mutation.IperfIntervalSeconds(); ok {
+		if err := daemonconfig.IperfIntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "iperf_interval_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.iperf_interval_seconds": %w`, err)}
+		}
+	}
+	if v, ok := dcuo.mutation.IperfDurationSeconds(); ok {
+		if err := daemonconfig.IperfDurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "iperf_duration_seconds", err: fmt.Errorf(`ent: validator failed for field "DaemonConfig.iperf_duration_seconds": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dcuo *DaemonConfigUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DaemonConfigUpdateOne {
+	dcuo.modifiers = append(dcuo.modifiers, modifiers...)
+	return dcuo
+}
+
+func (dcuo *DaemonConfigUpdateOne) sqlSave(ctx context.Context) (_node *DaemonConfig, err error) {
+	if err := dcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(daemonconfig.Table, daemonconfig.Columns, sqlgraph.NewFieldSpec(daemonconfig.FieldID, field.TypeInt))
+	id, ok := dcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DaemonConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, daemonconfig.FieldID)
+		for _, f := range fields {
+			if !daemonconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != daemonconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dcuo.mutation.Name(); ok {
+		_spec.SetField(daemonconfig.FieldName, field.TypeString, value)
+	}
+	if value, ok := dcuo.mutation.DaemonID(); ok {
+		_spec.SetField(daemonconfig.FieldDaemonID, field.TypeString, value)
+	}
+	if dcuo.mutation.DaemonIDCleared() {
+		_spec.ClearField(daemonconfig.FieldDaemonID, field.TypeString)
+	}
+	if value, ok := dcuo.mutation.MatchLabels(); ok {
+		_spec.SetField(daemonconfig.FieldMatchLabels, field.TypeJSON, value)
+	}
+	if dcuo.mutation.MatchLabelsCleared() {
+		_spec.ClearField(daemonconfig.FieldMatchLabels, field.TypeJSON)
+	}
+	if value, ok := dcuo.mutation.Priority(); ok {
+		_spec.SetField(daemonconfig.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.AddedPriority(); ok {
+		_spec.AddField(daemonconfig.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.SpeedtestIntervalSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.AddedSpeedtestIntervalSeconds(); ok {
+		_spec.AddField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt, value)
+	}
+	if dcuo.mutation.SpeedtestIntervalSecondsCleared() {
+		_spec.ClearField(daemonconfig.FieldSpeedtestIntervalSeconds, field.TypeInt)
+	}
+	if value, ok := dcuo.mutation.IperfIntervalSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.AddedIperfIntervalSeconds(); ok {
+		_spec.AddField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt, value)
+	}
+	if dcuo.mutation.IperfIntervalSecondsCleared() {
+		_spec.ClearField(daemonconfig.FieldIperfIntervalSeconds, field.TypeInt)
+	}
+	if value, ok := dcuo.mutation.IperfDurationSeconds(); ok {
+		_spec.SetField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.AddedIperfDurationSeconds(); ok {
+		_spec.AddField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt, value)
+	}
+	if dcuo.mutation.IperfDurationSecondsCleared() {
+		_spec.ClearField(daemonconfig.FieldIperfDurationSeconds, field.TypeInt)
+	}
+	if value, ok := dcuo.mutation.SpeedtestEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldSpeedtestEnabled, field.TypeBool, value)
+	}
+	if dcuo.mutation.SpeedtestEnabledCleared() {
+		_spec.ClearField(daemonconfig.FieldSpeedtestEnabled, field.TypeBool)
+	}
+	if value, ok := dcuo.mutation.IperfEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldIperfEnabled, field.TypeBool, value)
+	}
+	if dcuo.mutation.IperfEnabledCleared() {
+		_spec.ClearField(daemonconfig.FieldIperfEnabled, field.TypeBool)
+	}
+	if value, ok := dcuo.mutation.AdaptiveEnabled(); ok {
+		_spec.SetField(daemonconfig.FieldAdaptiveEnabled, field.TypeBool, value)
+	}
+	if dcuo.mutation.AdaptiveEnabledCleared() {
+		_spec.ClearField(daemonconfig.FieldAdaptiveEnabled, field.TypeBool)
+	}
+	if value, ok := dcuo.mutation.HostTypes(); ok {
+		_spec.SetField(daemonconfig.FieldHostTypes, field.TypeJSON, value)
+	}
+	if value, ok := dcuo.mutation.AppendedHostTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, daemonconfig.FieldHostTypes, value)
+		})
+	}
+	if dcuo.mutation.HostTypesCleared() {
+		_spec.ClearField(daemonconfig.FieldHostTypes, field.TypeJSON)
+	}
+	if value, ok := dcuo.mutation.HostIds(); ok {
+		_spec.SetField(daemonconfig.FieldHostIds, field.TypeJSON, value)
+	}
+	if value, ok := dcuo.mutation.AppendedHostIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, daemonconfig.FieldHostIds, value)
+		})
+	}
+	if dcuo.mutation.HostIdsCleared() {
+		_spec.ClearField(daemonconfig.FieldHostIds, field.TypeJSON)
+	}
+	if value, ok := dcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(daemonconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(dcuo.modifiers...)
+	_node = &DaemonConfig{config: dcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{daemonconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dcuo.mutation.done = true
+	return _node, nil
+}
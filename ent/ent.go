@@ -12,9 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bfirestone/speed-checker/ent/apikey"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			host.Table:      host.ValidColumn,
-			iperftest.Table: iperftest.ValidColumn,
-			speedtest.Table: speedtest.ValidColumn,
+			apikey.Table:       apikey.ValidColumn,
+			campaign.Table:     campaign.ValidColumn,
+			daemon.Table:       daemon.ValidColumn,
+			daemonconfig.Table: daemonconfig.ValidColumn,
+			host.Table:         host.ValidColumn,
+			iperftest.Table:    iperftest.ValidColumn,
+			job.Table:          job.ValidColumn,
+			meshtest.Table:     meshtest.ValidColumn,
+			speedtest.Table:    speedtest.ValidColumn,
+			testrun.Table:      testrun.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -9,6 +9,54 @@ import (
 	"github.com/bfirestone/speed-checker/ent"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *ent.APIKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
+}
+
+// The CampaignFunc type is an adapter to allow the use of ordinary
+// function as Campaign mutator.
+type CampaignFunc func(context.Context, *ent.CampaignMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CampaignFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CampaignMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CampaignMutation", m)
+}
+
+// The DaemonFunc type is an adapter to allow the use of ordinary
+// function as Daemon mutator.
+type DaemonFunc func(context.Context, *ent.DaemonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DaemonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DaemonMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DaemonMutation", m)
+}
+
+// The DaemonConfigFunc type is an adapter to allow the use of ordinary
+// function as DaemonConfig mutator.
+type DaemonConfigFunc func(context.Context, *ent.DaemonConfigMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DaemonConfigFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DaemonConfigMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DaemonConfigMutation", m)
+}
+
 // The HostFunc type is an adapter to allow the use of ordinary
 // function as Host mutator.
 type HostFunc func(context.Context, *ent.HostMutation) (ent.Value, error)
@@ -33,6 +81,30 @@ func (f IperfTestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IperfTestMutation", m)
 }
 
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+}
+
+// The MeshTestFunc type is an adapter to allow the use of ordinary
+// function as MeshTest mutator.
+type MeshTestFunc func(context.Context, *ent.MeshTestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MeshTestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MeshTestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MeshTestMutation", m)
+}
+
 // The SpeedTestFunc type is an adapter to allow the use of ordinary
 // function as SpeedTest mutator.
 type SpeedTestFunc func(context.Context, *ent.SpeedTestMutation) (ent.Value, error)
@@ -45,6 +117,18 @@ func (f SpeedTestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpeedTestMutation", m)
 }
 
+// The TestRunFunc type is an adapter to allow the use of ordinary
+// function as TestRun mutator.
+type TestRunFunc func(context.Context, *ent.TestRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TestRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TestRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestRunMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
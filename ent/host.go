@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -28,6 +29,10 @@ type Host struct {
 	Active bool `json:"active,omitempty"`
 	// Optional description of the host
 	Description string `json:"description,omitempty"`
+	// Labels a daemon must all carry to test this host
+	DaemonSelector map[string]string `json:"daemon_selector,omitempty"`
+	// Daemons assigned to test this host regardless of their labels
+	DaemonIds []string `json:"daemon_ids,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HostQuery when eager-loading is set.
 	Edges        HostEdges `json:"edges"`
@@ -38,9 +43,13 @@ type Host struct {
 type HostEdges struct {
 	// IperfTests holds the value of the iperf_tests edge.
 	IperfTests []*IperfTest `json:"iperf_tests,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*Job `json:"jobs,omitempty"`
+	// TestRuns holds the value of the test_runs edge.
+	TestRuns []*TestRun `json:"test_runs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // IperfTestsOrErr returns the IperfTests value or an error if the edge
@@ -52,11 +61,31 @@ func (e HostEdges) IperfTestsOrErr() ([]*IperfTest, error) {
 	return nil, &NotLoadedError{edge: "iperf_tests"}
 }
 
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e HostEdges) JobsOrErr() ([]*Job, error) {
+	if e.loadedTypes[1] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
+// TestRunsOrErr returns the TestRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e HostEdges) TestRunsOrErr() ([]*TestRun, error) {
+	if e.loadedTypes[2] {
+		return e.TestRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "test_runs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Host) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case host.FieldDaemonSelector, host.FieldDaemonIds:
+			values[i] = new([]byte)
 		case host.FieldActive:
 			values[i] = new(sql.NullBool)
 		case host.FieldID, host.FieldPort:
@@ -120,6 +149,22 @@ func (h *Host) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				h.Description = value.String
 			}
+		case host.FieldDaemonSelector:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_selector", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &h.DaemonSelector); err != nil {
+					return fmt.Errorf("unmarshal field daemon_selector: %w", err)
+				}
+			}
+		case host.FieldDaemonIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &h.DaemonIds); err != nil {
+					return fmt.Errorf("unmarshal field daemon_ids: %w", err)
+				}
+			}
 		default:
 			h.selectValues.Set(columns[i], values[i])
 		}
@@ -138,6 +183,16 @@ func (h *Host) QueryIperfTests() *IperfTestQuery {
 	return NewHostClient(h.config).QueryIperfTests(h)
 }
 
+// QueryJobs queries the "jobs" edge of the Host entity.
+func (h *Host) QueryJobs() *JobQuery {
+	return NewHostClient(h.config).QueryJobs(h)
+}
+
+// QueryTestRuns queries the "test_runs" edge of the Host entity.
+func (h *Host) QueryTestRuns() *TestRunQuery {
+	return NewHostClient(h.config).QueryTestRuns(h)
+}
+
 // Update returns a builder for updating this Host.
 // Note that you need to call Host.Unwrap() before calling this method if this Host
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -178,6 +233,12 @@ func (h *Host) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(h.Description)
+	builder.WriteString(", ")
+	builder.WriteString("daemon_selector=")
+	builder.WriteString(fmt.Sprintf("%v", h.DaemonSelector))
+	builder.WriteString(", ")
+	builder.WriteString("daemon_ids=")
+	builder.WriteString(fmt.Sprintf("%v", h.DaemonIds))
 	builder.WriteByte(')')
 	return builder.String()
 }
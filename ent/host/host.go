@@ -26,8 +26,16 @@ const (
 	FieldActive = "active"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldDaemonSelector holds the string denoting the daemon_selector field in the database.
+	FieldDaemonSelector = "daemon_selector"
+	// FieldDaemonIds holds the string denoting the daemon_ids field in the database.
+	FieldDaemonIds = "daemon_ids"
 	// EdgeIperfTests holds the string denoting the iperf_tests edge name in mutations.
 	EdgeIperfTests = "iperf_tests"
+	// EdgeJobs holds the string denoting the jobs edge name in mutations.
+	EdgeJobs = "jobs"
+	// EdgeTestRuns holds the string denoting the test_runs edge name in mutations.
+	EdgeTestRuns = "test_runs"
 	// Table holds the table name of the host in the database.
 	Table = "hosts"
 	// IperfTestsTable is the table that holds the iperf_tests relation/edge.
@@ -37,6 +45,20 @@ const (
 	IperfTestsInverseTable = "iperf_tests"
 	// IperfTestsColumn is the table column denoting the iperf_tests relation/edge.
 	IperfTestsColumn = "host_iperf_tests"
+	// JobsTable is the table that holds the jobs relation/edge.
+	JobsTable = "jobs"
+	// JobsInverseTable is the table name for the Job entity.
+	// It exists in this package in order to avoid circular dependency with the "job" package.
+	JobsInverseTable = "jobs"
+	// JobsColumn is the table column denoting the jobs relation/edge.
+	JobsColumn = "host_jobs"
+	// TestRunsTable is the table that holds the test_runs relation/edge.
+	TestRunsTable = "test_runs"
+	// TestRunsInverseTable is the table name for the TestRun entity.
+	// It exists in this package in order to avoid circular dependency with the "testrun" package.
+	TestRunsInverseTable = "test_runs"
+	// TestRunsColumn is the table column denoting the test_runs relation/edge.
+	TestRunsColumn = "host_id"
 )
 
 // Columns holds all SQL columns for host fields.
@@ -48,6 +70,8 @@ var Columns = []string{
 	FieldType,
 	FieldActive,
 	FieldDescription,
+	FieldDaemonSelector,
+	FieldDaemonIds,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -142,6 +166,34 @@ func ByIperfTests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newIperfTestsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByJobsCount orders the results by jobs count.
+func ByJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJobsStep(), opts...)
+	}
+}
+
+// ByJobs orders the results by jobs terms.
+func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTestRunsCount orders the results by test_runs count.
+func ByTestRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTestRunsStep(), opts...)
+	}
+}
+
+// ByTestRuns orders the results by test_runs terms.
+func ByTestRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newIperfTestsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -149,3 +201,17 @@ func newIperfTestsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, IperfTestsTable, IperfTestsColumn),
 	)
 }
+func newJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+	)
+}
+func newTestRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TestRunsTable, TestRunsColumn),
+	)
+}
@@ -353,6 +353,26 @@ func DescriptionContainsFold(v string) predicate.Host {
 	return predicate.Host(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// DaemonSelectorIsNil applies the IsNil predicate on the "daemon_selector" field.
+func DaemonSelectorIsNil() predicate.Host {
+	return predicate.Host(sql.FieldIsNull(FieldDaemonSelector))
+}
+
+// DaemonSelectorNotNil applies the NotNil predicate on the "daemon_selector" field.
+func DaemonSelectorNotNil() predicate.Host {
+	return predicate.Host(sql.FieldNotNull(FieldDaemonSelector))
+}
+
+// DaemonIdsIsNil applies the IsNil predicate on the "daemon_ids" field.
+func DaemonIdsIsNil() predicate.Host {
+	return predicate.Host(sql.FieldIsNull(FieldDaemonIds))
+}
+
+// DaemonIdsNotNil applies the NotNil predicate on the "daemon_ids" field.
+func DaemonIdsNotNil() predicate.Host {
+	return predicate.Host(sql.FieldNotNull(FieldDaemonIds))
+}
+
 // HasIperfTests applies the HasEdge predicate on the "iperf_tests" edge.
 func HasIperfTests() predicate.Host {
 	return predicate.Host(func(s *sql.Selector) {
@@ -376,6 +396,52 @@ func HasIperfTestsWith(preds ...predicate.IperfTest) predicate.Host {
 	})
 }
 
+// HasJobs applies the HasEdge predicate on the "jobs" edge.
+func HasJobs() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobsWith applies the HasEdge predicate on the "jobs" edge with a given conditions (other predicates).
+func HasJobsWith(preds ...predicate.Job) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		step := newJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTestRuns applies the HasEdge predicate on the "test_runs" edge.
+func HasTestRuns() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TestRunsTable, TestRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestRunsWith applies the HasEdge predicate on the "test_runs" edge with a given conditions (other predicates).
+func HasTestRunsWith(preds ...predicate.TestRun) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		step := newTestRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Host) predicate.Host {
 	return predicate.Host(sql.AndPredicates(predicates...))
@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/testrun"
 )
 
 // HostCreate is the builder for creating a Host entity.
@@ -80,6 +82,18 @@ func (hc *HostCreate) SetNillableDescription(s *string) *HostCreate {
 	return hc
 }
 
+// SetDaemonSelector sets the "daemon_selector" field.
+func (hc *HostCreate) SetDaemonSelector(m map[string]string) *HostCreate {
+	hc.mutation.SetDaemonSelector(m)
+	return hc
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (hc *HostCreate) SetDaemonIds(s []string) *HostCreate {
+	hc.mutation.SetDaemonIds(s)
+	return hc
+}
+
 // AddIperfTestIDs adds the "iperf_tests" edge to the IperfTest entity by IDs.
 func (hc *HostCreate) AddIperfTestIDs(ids ...int) *HostCreate {
 	hc.mutation.AddIperfTestIDs(ids...)
@@ -95,6 +109,36 @@ func (hc *HostCreate) AddIperfTests(i ...*IperfTest) *HostCreate {
 	return hc.AddIperfTestIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (hc *HostCreate) AddJobIDs(ids ...int) *HostCreate {
+	hc.mutation.AddJobIDs(ids...)
+	return hc
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (hc *HostCreate) AddJobs(j ...*Job) *HostCreate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return hc.AddJobIDs(ids...)
+}
+
+// AddTestRunIDs adds the "test_runs" edge to the TestRun entity by IDs.
+func (hc *HostCreate) AddTestRunIDs(ids ...int) *HostCreate {
+	hc.mutation.AddTestRunIDs(ids...)
+	return hc
+}
+
+// AddTestRuns adds the "test_runs" edges to the TestRun entity.
+func (hc *HostCreate) AddTestRuns(t ...*TestRun) *HostCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return hc.AddTestRunIDs(ids...)
+}
+
 // Mutation returns the HostMutation object of the builder.
 func (hc *HostCreate) Mutation() *HostMutation {
 	return hc.mutation
@@ -212,6 +256,14 @@ func (hc *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 		_spec.SetField(host.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := hc.mutation.DaemonSelector(); ok {
+		_spec.SetField(host.FieldDaemonSelector, field.TypeJSON, value)
+		_node.DaemonSelector = value
+	}
+	if value, ok := hc.mutation.DaemonIds(); ok {
+		_spec.SetField(host.FieldDaemonIds, field.TypeJSON, value)
+		_node.DaemonIds = value
+	}
 	if nodes := hc.mutation.IperfTestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -228,6 +280,38 @@ func (hc *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := hc.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hc.mutation.TestRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
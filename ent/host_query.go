@@ -14,7 +14,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
 	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/ent/testrun"
 )
 
 // HostQuery is the builder for querying Host entities.
@@ -25,6 +27,9 @@ type HostQuery struct {
 	inters         []Interceptor
 	predicates     []predicate.Host
 	withIperfTests *IperfTestQuery
+	withJobs       *JobQuery
+	withTestRuns   *TestRunQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +88,50 @@ func (hq *HostQuery) QueryIperfTests() *IperfTestQuery {
 	return query
 }
 
+// QueryJobs chains the current query on the "jobs" edge.
+func (hq *HostQuery) QueryJobs() *JobQuery {
+	query := (&JobClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(host.Table, host.FieldID, selector),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, host.JobsTable, host.JobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTestRuns chains the current query on the "test_runs" edge.
+func (hq *HostQuery) QueryTestRuns() *TestRunQuery {
+	query := (&TestRunClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(host.Table, host.FieldID, selector),
+			sqlgraph.To(testrun.Table, testrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, host.TestRunsTable, host.TestRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Host entity from the query.
 // Returns a *NotFoundError when no Host was found.
 func (hq *HostQuery) First(ctx context.Context) (*Host, error) {
@@ -276,9 +325,12 @@ func (hq *HostQuery) Clone() *HostQuery {
 		inters:         append([]Interceptor{}, hq.inters...),
 		predicates:     append([]predicate.Host{}, hq.predicates...),
 		withIperfTests: hq.withIperfTests.Clone(),
+		withJobs:       hq.withJobs.Clone(),
+		withTestRuns:   hq.withTestRuns.Clone(),
 		// clone intermediate query.
-		sql:  hq.sql.Clone(),
-		path: hq.path,
+		sql:       hq.sql.Clone(),
+		path:      hq.path,
+		modifiers: append([]func(*sql.Selector){}, hq.modifiers...),
 	}
 }
 
@@ -293,6 +345,28 @@ func (hq *HostQuery) WithIperfTests(opts ...func(*IperfTestQuery)) *HostQuery {
 	return hq
 }
 
+// WithJobs tells the query-builder to eager-load the nodes that are connected to
+// the "jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HostQuery) WithJobs(opts ...func(*JobQuery)) *HostQuery {
+	query := (&JobClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withJobs = query
+	return hq
+}
+
+// WithTestRuns tells the query-builder to eager-load the nodes that are connected to
+// the "test_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HostQuery) WithTestRuns(opts ...func(*TestRunQuery)) *HostQuery {
+	query := (&TestRunClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withTestRuns = query
+	return hq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +445,10 @@ func (hq *HostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Host, e
 	var (
 		nodes       = []*Host{}
 		_spec       = hq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			hq.withIperfTests != nil,
+			hq.withJobs != nil,
+			hq.withTestRuns != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +460,9 @@ func (hq *HostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Host, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(hq.modifiers) > 0 {
+		_spec.Modifiers = hq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -400,6 +479,20 @@ func (hq *HostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Host, e
 			return nil, err
 		}
 	}
+	if query := hq.withJobs; query != nil {
+		if err := hq.loadJobs(ctx, query, nodes,
+			func(n *Host) { n.Edges.Jobs = []*Job{} },
+			func(n *Host, e *Job) { n.Edges.Jobs = append(n.Edges.Jobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hq.withTestRuns; query != nil {
+		if err := hq.loadTestRuns(ctx, query, nodes,
+			func(n *Host) { n.Edges.TestRuns = []*TestRun{} },
+			func(n *Host, e *TestRun) { n.Edges.TestRuns = append(n.Edges.TestRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,9 +527,76 @@ func (hq *HostQuery) loadIperfTests(ctx context.Context, query *IperfTestQuery,
 	}
 	return nil
 }
+func (hq *HostQuery) loadJobs(ctx context.Context, query *JobQuery, nodes []*Host, init func(*Host), assign func(*Host, *Job)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Host)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Job(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(host.JobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.host_jobs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "host_jobs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "host_jobs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (hq *HostQuery) loadTestRuns(ctx context.Context, query *TestRunQuery, nodes []*Host, init func(*Host), assign func(*Host, *TestRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Host)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(testrun.FieldHostID)
+	}
+	query.Where(predicate.TestRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(host.TestRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.HostID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "host_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "host_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (hq *HostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := hq.querySpec()
+	if len(hq.modifiers) > 0 {
+		_spec.Modifiers = hq.modifiers
+	}
 	_spec.Node.Columns = hq.ctx.Fields
 	if len(hq.ctx.Fields) > 0 {
 		_spec.Unique = hq.ctx.Unique != nil && *hq.ctx.Unique
@@ -499,6 +659,9 @@ func (hq *HostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if hq.ctx.Unique != nil && *hq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range hq.modifiers {
+		m(selector)
+	}
 	for _, p := range hq.predicates {
 		p(selector)
 	}
@@ -516,6 +679,12 @@ func (hq *HostQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hq *HostQuery) Modify(modifiers ...func(s *sql.Selector)) *HostSelect {
+	hq.modifiers = append(hq.modifiers, modifiers...)
+	return hq.Select()
+}
+
 // HostGroupBy is the group-by builder for Host entities.
 type HostGroupBy struct {
 	selector
@@ -605,3 +774,9 @@ func (hs *HostSelect) sqlScan(ctx context.Context, root *HostQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (hs *HostSelect) Modify(modifiers ...func(s *sql.Selector)) *HostSelect {
+	hs.modifiers = append(hs.modifiers, modifiers...)
+	return hs
+}
@@ -9,17 +9,21 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
 	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/ent/testrun"
 )
 
 // HostUpdate is the builder for updating Host entities.
 type HostUpdate struct {
 	config
-	hooks    []Hook
-	mutation *HostMutation
+	hooks     []Hook
+	mutation  *HostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the HostUpdate builder.
@@ -125,6 +129,36 @@ func (hu *HostUpdate) ClearDescription() *HostUpdate {
 	return hu
 }
 
+// SetDaemonSelector sets the "daemon_selector" field.
+func (hu *HostUpdate) SetDaemonSelector(m map[string]string) *HostUpdate {
+	hu.mutation.SetDaemonSelector(m)
+	return hu
+}
+
+// ClearDaemonSelector clears the value of the "daemon_selector" field.
+func (hu *HostUpdate) ClearDaemonSelector() *HostUpdate {
+	hu.mutation.ClearDaemonSelector()
+	return hu
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (hu *HostUpdate) SetDaemonIds(s []string) *HostUpdate {
+	hu.mutation.SetDaemonIds(s)
+	return hu
+}
+
+// AppendDaemonIds appends s to the "daemon_ids" field.
+func (hu *HostUpdate) AppendDaemonIds(s []string) *HostUpdate {
+	hu.mutation.AppendDaemonIds(s)
+	return hu
+}
+
+// ClearDaemonIds clears the value of the "daemon_ids" field.
+func (hu *HostUpdate) ClearDaemonIds() *HostUpdate {
+	hu.mutation.ClearDaemonIds()
+	return hu
+}
+
 // AddIperfTestIDs adds the "iperf_tests" edge to the IperfTest entity by IDs.
 func (hu *HostUpdate) AddIperfTestIDs(ids ...int) *HostUpdate {
 	hu.mutation.AddIperfTestIDs(ids...)
@@ -140,6 +174,36 @@ func (hu *HostUpdate) AddIperfTests(i ...*IperfTest) *HostUpdate {
 	return hu.AddIperfTestIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (hu *HostUpdate) AddJobIDs(ids ...int) *HostUpdate {
+	hu.mutation.AddJobIDs(ids...)
+	return hu
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (hu *HostUpdate) AddJobs(j ...*Job) *HostUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return hu.AddJobIDs(ids...)
+}
+
+// AddTestRunIDs adds the "test_runs" edge to the TestRun entity by IDs.
+func (hu *HostUpdate) AddTestRunIDs(ids ...int) *HostUpdate {
+	hu.mutation.AddTestRunIDs(ids...)
+	return hu
+}
+
+// AddTestRuns adds the "test_runs" edges to the TestRun entity.
+func (hu *HostUpdate) AddTestRuns(t ...*TestRun) *HostUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return hu.AddTestRunIDs(ids...)
+}
+
 // Mutation returns the HostMutation object of the builder.
 func (hu *HostUpdate) Mutation() *HostMutation {
 	return hu.mutation
@@ -166,6 +230,48 @@ func (hu *HostUpdate) RemoveIperfTests(i ...*IperfTest) *HostUpdate {
 	return hu.RemoveIperfTestIDs(ids...)
 }
 
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (hu *HostUpdate) ClearJobs() *HostUpdate {
+	hu.mutation.ClearJobs()
+	return hu
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (hu *HostUpdate) RemoveJobIDs(ids ...int) *HostUpdate {
+	hu.mutation.RemoveJobIDs(ids...)
+	return hu
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (hu *HostUpdate) RemoveJobs(j ...*Job) *HostUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return hu.RemoveJobIDs(ids...)
+}
+
+// ClearTestRuns clears all "test_runs" edges to the TestRun entity.
+func (hu *HostUpdate) ClearTestRuns() *HostUpdate {
+	hu.mutation.ClearTestRuns()
+	return hu
+}
+
+// RemoveTestRunIDs removes the "test_runs" edge to TestRun entities by IDs.
+func (hu *HostUpdate) RemoveTestRunIDs(ids ...int) *HostUpdate {
+	hu.mutation.RemoveTestRunIDs(ids...)
+	return hu
+}
+
+// RemoveTestRuns removes "test_runs" edges to TestRun entities.
+func (hu *HostUpdate) RemoveTestRuns(t ...*TestRun) *HostUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return hu.RemoveTestRunIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (hu *HostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, hu.sqlSave, hu.mutation, hu.hooks)
@@ -203,6 +309,12 @@ func (hu *HostUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (hu *HostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HostUpdate {
+	hu.modifiers = append(hu.modifiers, modifiers...)
+	return hu
+}
+
 func (hu *HostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := hu.check(); err != nil {
 		return n, err
@@ -239,6 +351,23 @@ func (hu *HostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if hu.mutation.DescriptionCleared() {
 		_spec.ClearField(host.FieldDescription, field.TypeString)
 	}
+	if value, ok := hu.mutation.DaemonSelector(); ok {
+		_spec.SetField(host.FieldDaemonSelector, field.TypeJSON, value)
+	}
+	if hu.mutation.DaemonSelectorCleared() {
+		_spec.ClearField(host.FieldDaemonSelector, field.TypeJSON)
+	}
+	if value, ok := hu.mutation.DaemonIds(); ok {
+		_spec.SetField(host.FieldDaemonIds, field.TypeJSON, value)
+	}
+	if value, ok := hu.mutation.AppendedDaemonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, host.FieldDaemonIds, value)
+		})
+	}
+	if hu.mutation.DaemonIdsCleared() {
+		_spec.ClearField(host.FieldDaemonIds, field.TypeJSON)
+	}
 	if hu.mutation.IperfTestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -284,6 +413,97 @@ func (hu *HostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if hu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.RemovedJobsIDs(); len(nodes) > 0 && !hu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hu.mutation.TestRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.RemovedTestRunsIDs(); len(nodes) > 0 && !hu.mutation.TestRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.TestRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(hu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{host.Label}
@@ -299,9 +519,10 @@ func (hu *HostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // HostUpdateOne is the builder for updating a single Host entity.
 type HostUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *HostMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *HostMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -401,6 +622,36 @@ func (huo *HostUpdateOne) ClearDescription() *HostUpdateOne {
 	return huo
 }
 
+// SetDaemonSelector sets the "daemon_selector" field.
+func (huo *HostUpdateOne) SetDaemonSelector(m map[string]string) *HostUpdateOne {
+	huo.mutation.SetDaemonSelector(m)
+	return huo
+}
+
+// ClearDaemonSelector clears the value of the "daemon_selector" field.
+func (huo *HostUpdateOne) ClearDaemonSelector() *HostUpdateOne {
+	huo.mutation.ClearDaemonSelector()
+	return huo
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (huo *HostUpdateOne) SetDaemonIds(s []string) *HostUpdateOne {
+	huo.mutation.SetDaemonIds(s)
+	return huo
+}
+
+// AppendDaemonIds appends s to the "daemon_ids" field.
+func (huo *HostUpdateOne) AppendDaemonIds(s []string) *HostUpdateOne {
+	huo.mutation.AppendDaemonIds(s)
+	return huo
+}
+
+// ClearDaemonIds clears the value of the "daemon_ids" field.
+func (huo *HostUpdateOne) ClearDaemonIds() *HostUpdateOne {
+	huo.mutation.ClearDaemonIds()
+	return huo
+}
+
 // AddIperfTestIDs adds the "iperf_tests" edge to the IperfTest entity by IDs.
 func (huo *HostUpdateOne) AddIperfTestIDs(ids ...int) *HostUpdateOne {
 	huo.mutation.AddIperfTestIDs(ids...)
@@ -416,6 +667,36 @@ func (huo *HostUpdateOne) AddIperfTests(i ...*IperfTest) *HostUpdateOne {
 	return huo.AddIperfTestIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (huo *HostUpdateOne) AddJobIDs(ids ...int) *HostUpdateOne {
+	huo.mutation.AddJobIDs(ids...)
+	return huo
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (huo *HostUpdateOne) AddJobs(j ...*Job) *HostUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return huo.AddJobIDs(ids...)
+}
+
+// AddTestRunIDs adds the "test_runs" edge to the TestRun entity by IDs.
+func (huo *HostUpdateOne) AddTestRunIDs(ids ...int) *HostUpdateOne {
+	huo.mutation.AddTestRunIDs(ids...)
+	return huo
+}
+
+// AddTestRuns adds the "test_runs" edges to the TestRun entity.
+func (huo *HostUpdateOne) AddTestRuns(t ...*TestRun) *HostUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return huo.AddTestRunIDs(ids...)
+}
+
 // Mutation returns the HostMutation object of the builder.
 func (huo *HostUpdateOne) Mutation() *HostMutation {
 	return huo.mutation
@@ -442,6 +723,48 @@ func (huo *HostUpdateOne) RemoveIperfTests(i ...*IperfTest) *HostUpdateOne {
 	return huo.RemoveIperfTestIDs(ids...)
 }
 
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (huo *HostUpdateOne) ClearJobs() *HostUpdateOne {
+	huo.mutation.ClearJobs()
+	return huo
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (huo *HostUpdateOne) RemoveJobIDs(ids ...int) *HostUpdateOne {
+	huo.mutation.RemoveJobIDs(ids...)
+	return huo
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (huo *HostUpdateOne) RemoveJobs(j ...*Job) *HostUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return huo.RemoveJobIDs(ids...)
+}
+
+// ClearTestRuns clears all "test_runs" edges to the TestRun entity.
+func (huo *HostUpdateOne) ClearTestRuns() *HostUpdateOne {
+	huo.mutation.ClearTestRuns()
+	return huo
+}
+
+// RemoveTestRunIDs removes the "test_runs" edge to TestRun entities by IDs.
+func (huo *HostUpdateOne) RemoveTestRunIDs(ids ...int) *HostUpdateOne {
+	huo.mutation.RemoveTestRunIDs(ids...)
+	return huo
+}
+
+// RemoveTestRuns removes "test_runs" edges to TestRun entities.
+func (huo *HostUpdateOne) RemoveTestRuns(t ...*TestRun) *HostUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return huo.RemoveTestRunIDs(ids...)
+}
+
 // Where appends a list predicates to the HostUpdate builder.
 func (huo *HostUpdateOne) Where(ps ...predicate.Host) *HostUpdateOne {
 	huo.mutation.Where(ps...)
@@ -492,6 +815,12 @@ func (huo *HostUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (huo *HostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HostUpdateOne {
+	huo.modifiers = append(huo.modifiers, modifiers...)
+	return huo
+}
+
 func (huo *HostUpdateOne) sqlSave(ctx context.Context) (_node *Host, err error) {
 	if err := huo.check(); err != nil {
 		return _node, err
@@ -545,6 +874,23 @@ func (huo *HostUpdateOne) sqlSave(ctx context.Context) (_node *Host, err error)
 	if huo.mutation.DescriptionCleared() {
 		_spec.ClearField(host.FieldDescription, field.TypeString)
 	}
+	if value, ok := huo.mutation.DaemonSelector(); ok {
+		_spec.SetField(host.FieldDaemonSelector, field.TypeJSON, value)
+	}
+	if huo.mutation.DaemonSelectorCleared() {
+		_spec.ClearField(host.FieldDaemonSelector, field.TypeJSON)
+	}
+	if value, ok := huo.mutation.DaemonIds(); ok {
+		_spec.SetField(host.FieldDaemonIds, field.TypeJSON, value)
+	}
+	if value, ok := huo.mutation.AppendedDaemonIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, host.FieldDaemonIds, value)
+		})
+	}
+	if huo.mutation.DaemonIdsCleared() {
+		_spec.ClearField(host.FieldDaemonIds, field.TypeJSON)
+	}
 	if huo.mutation.IperfTestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -590,6 +936,97 @@ func (huo *HostUpdateOne) sqlSave(ctx context.Context) (_node *Host, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if huo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.RemovedJobsIDs(); len(nodes) > 0 && !huo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.JobsTable,
+			Columns: []string{host.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if huo.mutation.TestRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.RemovedTestRunsIDs(); len(nodes) > 0 && !huo.mutation.TestRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.TestRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   host.TestRunsTable,
+			Columns: []string{host.TestRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(huo.modifiers...)
 	_node = &Host{config: huo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/google/uuid"
 )
 
 // IperfTest is the model entity for the IperfTest schema.
@@ -38,6 +39,22 @@ type IperfTest struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 	// Identifier of the daemon that performed the test
 	DaemonID string `json:"daemon_id,omitempty"`
+	// Client-generated ID used to deduplicate retried submissions
+	SubmissionID *uuid.UUID `json:"submission_id,omitempty"`
+	// What caused the test to run
+	Trigger iperftest.Trigger `json:"trigger,omitempty"`
+	// Upstream host type whose failure blocked this test; empty when the test ran
+	BlockedBy iperftest.BlockedBy `json:"blocked_by,omitempty"`
+	// Server time the result was received; unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	// Server clock minus daemon clock in milliseconds as measured by the daemon
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+	// Whether the daemon adjusted the timestamp by clock_offset_ms
+	ClockCorrected bool `json:"clock_corrected,omitempty"`
+	// Campaign the result was collected for
+	CampaignID *int `json:"campaign_id,omitempty"`
+	// Timestamp was outside the clock skew window; kept out of listings and baselines
+	Quarantined bool `json:"quarantined,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the IperfTestQuery when eager-loading is set.
 	Edges            IperfTestEdges `json:"edges"`
@@ -70,15 +87,17 @@ func (*IperfTest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case iperftest.FieldSuccess:
+		case iperftest.FieldSubmissionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case iperftest.FieldSuccess, iperftest.FieldClockCorrected, iperftest.FieldQuarantined:
 			values[i] = new(sql.NullBool)
 		case iperftest.FieldSentMbps, iperftest.FieldReceivedMbps, iperftest.FieldRetransmits, iperftest.FieldMeanRttMs:
 			values[i] = new(sql.NullFloat64)
-		case iperftest.FieldID, iperftest.FieldDurationSeconds:
+		case iperftest.FieldID, iperftest.FieldDurationSeconds, iperftest.FieldClockOffsetMs, iperftest.FieldCampaignID:
 			values[i] = new(sql.NullInt64)
-		case iperftest.FieldProtocol, iperftest.FieldErrorMessage, iperftest.FieldDaemonID:
+		case iperftest.FieldProtocol, iperftest.FieldErrorMessage, iperftest.FieldDaemonID, iperftest.FieldTrigger, iperftest.FieldBlockedBy:
 			values[i] = new(sql.NullString)
-		case iperftest.FieldTimestamp:
+		case iperftest.FieldTimestamp, iperftest.FieldReceivedAt:
 			values[i] = new(sql.NullTime)
 		case iperftest.ForeignKeys[0]: // host_iperf_tests
 			values[i] = new(sql.NullInt64)
@@ -163,6 +182,58 @@ func (it *IperfTest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				it.DaemonID = value.String
 			}
+		case iperftest.FieldSubmissionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_id", values[i])
+			} else if value.Valid {
+				it.SubmissionID = new(uuid.UUID)
+				*it.SubmissionID = *value.S.(*uuid.UUID)
+			}
+		case iperftest.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				it.Trigger = iperftest.Trigger(value.String)
+			}
+		case iperftest.FieldBlockedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_by", values[i])
+			} else if value.Valid {
+				it.BlockedBy = iperftest.BlockedBy(value.String)
+			}
+		case iperftest.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				it.ReceivedAt = new(time.Time)
+				*it.ReceivedAt = value.Time
+			}
+		case iperftest.FieldClockOffsetMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_offset_ms", values[i])
+			} else if value.Valid {
+				it.ClockOffsetMs = new(int64)
+				*it.ClockOffsetMs = value.Int64
+			}
+		case iperftest.FieldClockCorrected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_corrected", values[i])
+			} else if value.Valid {
+				it.ClockCorrected = value.Bool
+			}
+		case iperftest.FieldCampaignID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_id", values[i])
+			} else if value.Valid {
+				it.CampaignID = new(int)
+				*it.CampaignID = int(value.Int64)
+			}
+		case iperftest.FieldQuarantined:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantined", values[i])
+			} else if value.Valid {
+				it.Quarantined = value.Bool
+			}
 		case iperftest.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field host_iperf_tests", value)
@@ -240,6 +311,38 @@ func (it *IperfTest) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("daemon_id=")
 	builder.WriteString(it.DaemonID)
+	builder.WriteString(", ")
+	if v := it.SubmissionID; v != nil {
+		builder.WriteString("submission_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", it.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("blocked_by=")
+	builder.WriteString(fmt.Sprintf("%v", it.BlockedBy))
+	builder.WriteString(", ")
+	if v := it.ReceivedAt; v != nil {
+		builder.WriteString("received_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := it.ClockOffsetMs; v != nil {
+		builder.WriteString("clock_offset_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clock_corrected=")
+	builder.WriteString(fmt.Sprintf("%v", it.ClockCorrected))
+	builder.WriteString(", ")
+	if v := it.CampaignID; v != nil {
+		builder.WriteString("campaign_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quarantined=")
+	builder.WriteString(fmt.Sprintf("%v", it.Quarantined))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package iperftest
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -34,6 +35,22 @@ const (
 	FieldErrorMessage = "error_message"
 	// FieldDaemonID holds the string denoting the daemon_id field in the database.
 	FieldDaemonID = "daemon_id"
+	// FieldSubmissionID holds the string denoting the submission_id field in the database.
+	FieldSubmissionID = "submission_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldBlockedBy holds the string denoting the blocked_by field in the database.
+	FieldBlockedBy = "blocked_by"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldClockOffsetMs holds the string denoting the clock_offset_ms field in the database.
+	FieldClockOffsetMs = "clock_offset_ms"
+	// FieldClockCorrected holds the string denoting the clock_corrected field in the database.
+	FieldClockCorrected = "clock_corrected"
+	// FieldCampaignID holds the string denoting the campaign_id field in the database.
+	FieldCampaignID = "campaign_id"
+	// FieldQuarantined holds the string denoting the quarantined field in the database.
+	FieldQuarantined = "quarantined"
 	// EdgeHost holds the string denoting the host edge name in mutations.
 	EdgeHost = "host"
 	// Table holds the table name of the iperftest in the database.
@@ -60,6 +77,14 @@ var Columns = []string{
 	FieldSuccess,
 	FieldErrorMessage,
 	FieldDaemonID,
+	FieldSubmissionID,
+	FieldTrigger,
+	FieldBlockedBy,
+	FieldReceivedAt,
+	FieldClockOffsetMs,
+	FieldClockCorrected,
+	FieldCampaignID,
+	FieldQuarantined,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "iperf_tests"
@@ -92,8 +117,65 @@ var (
 	DefaultProtocol string
 	// DefaultSuccess holds the default value on creation for the "success" field.
 	DefaultSuccess bool
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultClockCorrected holds the default value on creation for the "clock_corrected" field.
+	DefaultClockCorrected bool
+	// DefaultQuarantined holds the default value on creation for the "quarantined" field.
+	DefaultQuarantined bool
 )
 
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerScheduled is the default value of the Trigger enum.
+const DefaultTrigger = TriggerScheduled
+
+// Trigger values.
+const (
+	TriggerScheduled Trigger = "scheduled"
+	TriggerManual    Trigger = "manual"
+	TriggerAdaptive  Trigger = "adaptive"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerScheduled, TriggerManual, TriggerAdaptive:
+		return nil
+	default:
+		return fmt.Errorf("iperftest: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// BlockedBy defines the type for the "blocked_by" enum field.
+type BlockedBy string
+
+// BlockedBy values.
+const (
+	BlockedByLan    BlockedBy = "lan"
+	BlockedByVpn    BlockedBy = "vpn"
+	BlockedByRemote BlockedBy = "remote"
+)
+
+func (bb BlockedBy) String() string {
+	return string(bb)
+}
+
+// BlockedByValidator is a validator for the "blocked_by" field enum values. It is called by the builders before save.
+func BlockedByValidator(bb BlockedBy) error {
+	switch bb {
+	case BlockedByLan, BlockedByVpn, BlockedByRemote:
+		return nil
+	default:
+		return fmt.Errorf("iperftest: invalid enum value for blocked_by field: %q", bb)
+	}
+}
+
 // OrderOption defines the ordering options for the IperfTest queries.
 type OrderOption func(*sql.Selector)
 
@@ -152,6 +234,46 @@ func ByDaemonID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDaemonID, opts...).ToFunc()
 }
 
+// BySubmissionID orders the results by the submission_id field.
+func BySubmissionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByBlockedBy orders the results by the blocked_by field.
+func ByBlockedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockedBy, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByClockOffsetMs orders the results by the clock_offset_ms field.
+func ByClockOffsetMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockOffsetMs, opts...).ToFunc()
+}
+
+// ByClockCorrected orders the results by the clock_corrected field.
+func ByClockCorrected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockCorrected, opts...).ToFunc()
+}
+
+// ByCampaignID orders the results by the campaign_id field.
+func ByCampaignID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaignID, opts...).ToFunc()
+}
+
+// ByQuarantined orders the results by the quarantined field.
+func ByQuarantined(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantined, opts...).ToFunc()
+}
+
 // ByHostField orders the results by host field.
 func ByHostField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
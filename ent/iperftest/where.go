@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/google/uuid"
 )
 
 // ID filters vertices based on their ID field.
@@ -105,6 +106,36 @@ func DaemonID(v string) predicate.IperfTest {
 	return predicate.IperfTest(sql.FieldEQ(FieldDaemonID, v))
 }
 
+// SubmissionID applies equality check predicate on the "submission_id" field. It's identical to SubmissionIDEQ.
+func SubmissionID(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ClockOffsetMs applies equality check predicate on the "clock_offset_ms" field. It's identical to ClockOffsetMsEQ.
+func ClockOffsetMs(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldClockOffsetMs, v))
+}
+
+// ClockCorrected applies equality check predicate on the "clock_corrected" field. It's identical to ClockCorrectedEQ.
+func ClockCorrected(v bool) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldClockCorrected, v))
+}
+
+// CampaignID applies equality check predicate on the "campaign_id" field. It's identical to CampaignIDEQ.
+func CampaignID(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// Quarantined applies equality check predicate on the "quarantined" field. It's identical to QuarantinedEQ.
+func Quarantined(v bool) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldQuarantined, v))
+}
+
 // TimestampEQ applies the EQ predicate on the "timestamp" field.
 func TimestampEQ(v time.Time) predicate.IperfTest {
 	return predicate.IperfTest(sql.FieldEQ(FieldTimestamp, v))
@@ -590,6 +621,276 @@ func DaemonIDContainsFold(v string) predicate.IperfTest {
 	return predicate.IperfTest(sql.FieldContainsFold(FieldDaemonID, v))
 }
 
+// SubmissionIDEQ applies the EQ predicate on the "submission_id" field.
+func SubmissionIDEQ(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDNEQ applies the NEQ predicate on the "submission_id" field.
+func SubmissionIDNEQ(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDIn applies the In predicate on the "submission_id" field.
+func SubmissionIDIn(vs ...uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDNotIn applies the NotIn predicate on the "submission_id" field.
+func SubmissionIDNotIn(vs ...uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDGT applies the GT predicate on the "submission_id" field.
+func SubmissionIDGT(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGT(FieldSubmissionID, v))
+}
+
+// SubmissionIDGTE applies the GTE predicate on the "submission_id" field.
+func SubmissionIDGTE(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDLT applies the LT predicate on the "submission_id" field.
+func SubmissionIDLT(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLT(FieldSubmissionID, v))
+}
+
+// SubmissionIDLTE applies the LTE predicate on the "submission_id" field.
+func SubmissionIDLTE(v uuid.UUID) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDIsNil applies the IsNil predicate on the "submission_id" field.
+func SubmissionIDIsNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIsNull(FieldSubmissionID))
+}
+
+// SubmissionIDNotNil applies the NotNil predicate on the "submission_id" field.
+func SubmissionIDNotNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotNull(FieldSubmissionID))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// BlockedByEQ applies the EQ predicate on the "blocked_by" field.
+func BlockedByEQ(v BlockedBy) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldBlockedBy, v))
+}
+
+// BlockedByNEQ applies the NEQ predicate on the "blocked_by" field.
+func BlockedByNEQ(v BlockedBy) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldBlockedBy, v))
+}
+
+// BlockedByIn applies the In predicate on the "blocked_by" field.
+func BlockedByIn(vs ...BlockedBy) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIn(FieldBlockedBy, vs...))
+}
+
+// BlockedByNotIn applies the NotIn predicate on the "blocked_by" field.
+func BlockedByNotIn(vs ...BlockedBy) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotIn(FieldBlockedBy, vs...))
+}
+
+// BlockedByIsNil applies the IsNil predicate on the "blocked_by" field.
+func BlockedByIsNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIsNull(FieldBlockedBy))
+}
+
+// BlockedByNotNil applies the NotNil predicate on the "blocked_by" field.
+func BlockedByNotNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotNull(FieldBlockedBy))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtIsNil applies the IsNil predicate on the "received_at" field.
+func ReceivedAtIsNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIsNull(FieldReceivedAt))
+}
+
+// ReceivedAtNotNil applies the NotNil predicate on the "received_at" field.
+func ReceivedAtNotNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotNull(FieldReceivedAt))
+}
+
+// ClockOffsetMsEQ applies the EQ predicate on the "clock_offset_ms" field.
+func ClockOffsetMsEQ(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsNEQ applies the NEQ predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNEQ(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsIn applies the In predicate on the "clock_offset_ms" field.
+func ClockOffsetMsIn(vs ...int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIn(FieldClockOffsetMs, vs...))
+}
+
+// ClockOffsetMsNotIn applies the NotIn predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNotIn(vs ...int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotIn(FieldClockOffsetMs, vs...))
+}
+
+// ClockOffsetMsGT applies the GT predicate on the "clock_offset_ms" field.
+func ClockOffsetMsGT(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGT(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsGTE applies the GTE predicate on the "clock_offset_ms" field.
+func ClockOffsetMsGTE(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGTE(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsLT applies the LT predicate on the "clock_offset_ms" field.
+func ClockOffsetMsLT(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLT(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsLTE applies the LTE predicate on the "clock_offset_ms" field.
+func ClockOffsetMsLTE(v int64) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLTE(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsIsNil applies the IsNil predicate on the "clock_offset_ms" field.
+func ClockOffsetMsIsNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIsNull(FieldClockOffsetMs))
+}
+
+// ClockOffsetMsNotNil applies the NotNil predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNotNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotNull(FieldClockOffsetMs))
+}
+
+// ClockCorrectedEQ applies the EQ predicate on the "clock_corrected" field.
+func ClockCorrectedEQ(v bool) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldClockCorrected, v))
+}
+
+// ClockCorrectedNEQ applies the NEQ predicate on the "clock_corrected" field.
+func ClockCorrectedNEQ(v bool) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldClockCorrected, v))
+}
+
+// CampaignIDEQ applies the EQ predicate on the "campaign_id" field.
+func CampaignIDEQ(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignIDNEQ applies the NEQ predicate on the "campaign_id" field.
+func CampaignIDNEQ(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldCampaignID, v))
+}
+
+// CampaignIDIn applies the In predicate on the "campaign_id" field.
+func CampaignIDIn(vs ...int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDNotIn applies the NotIn predicate on the "campaign_id" field.
+func CampaignIDNotIn(vs ...int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDGT applies the GT predicate on the "campaign_id" field.
+func CampaignIDGT(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGT(FieldCampaignID, v))
+}
+
+// CampaignIDGTE applies the GTE predicate on the "campaign_id" field.
+func CampaignIDGTE(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldGTE(FieldCampaignID, v))
+}
+
+// CampaignIDLT applies the LT predicate on the "campaign_id" field.
+func CampaignIDLT(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLT(FieldCampaignID, v))
+}
+
+// CampaignIDLTE applies the LTE predicate on the "campaign_id" field.
+func CampaignIDLTE(v int) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldLTE(FieldCampaignID, v))
+}
+
+// CampaignIDIsNil applies the IsNil predicate on the "campaign_id" field.
+func CampaignIDIsNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldIsNull(FieldCampaignID))
+}
+
+// CampaignIDNotNil applies the NotNil predicate on the "campaign_id" field.
+func CampaignIDNotNil() predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNotNull(FieldCampaignID))
+}
+
+// QuarantinedEQ applies the EQ predicate on the "quarantined" field.
+func QuarantinedEQ(v bool) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldEQ(FieldQuarantined, v))
+}
+
+// QuarantinedNEQ applies the NEQ predicate on the "quarantined" field.
+func QuarantinedNEQ(v bool) predicate.IperfTest {
+	return predicate.IperfTest(sql.FieldNEQ(FieldQuarantined, v))
+}
+
 // HasHost applies the HasEdge predicate on the "host" edge.
 func HasHost() predicate.IperfTest {
 	return predicate.IperfTest(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/google/uuid"
 )
 
 // IperfTestCreate is the builder for creating a IperfTest entity.
@@ -145,6 +146,118 @@ func (itc *IperfTestCreate) SetNillableDaemonID(s *string) *IperfTestCreate {
 	return itc
 }
 
+// SetSubmissionID sets the "submission_id" field.
+func (itc *IperfTestCreate) SetSubmissionID(u uuid.UUID) *IperfTestCreate {
+	itc.mutation.SetSubmissionID(u)
+	return itc
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableSubmissionID(u *uuid.UUID) *IperfTestCreate {
+	if u != nil {
+		itc.SetSubmissionID(*u)
+	}
+	return itc
+}
+
+// SetTrigger sets the "trigger" field.
+func (itc *IperfTestCreate) SetTrigger(i iperftest.Trigger) *IperfTestCreate {
+	itc.mutation.SetTrigger(i)
+	return itc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableTrigger(i *iperftest.Trigger) *IperfTestCreate {
+	if i != nil {
+		itc.SetTrigger(*i)
+	}
+	return itc
+}
+
+// SetBlockedBy sets the "blocked_by" field.
+func (itc *IperfTestCreate) SetBlockedBy(ib iperftest.BlockedBy) *IperfTestCreate {
+	itc.mutation.SetBlockedBy(ib)
+	return itc
+}
+
+// SetNillableBlockedBy sets the "blocked_by" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableBlockedBy(ib *iperftest.BlockedBy) *IperfTestCreate {
+	if ib != nil {
+		itc.SetBlockedBy(*ib)
+	}
+	return itc
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (itc *IperfTestCreate) SetReceivedAt(t time.Time) *IperfTestCreate {
+	itc.mutation.SetReceivedAt(t)
+	return itc
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableReceivedAt(t *time.Time) *IperfTestCreate {
+	if t != nil {
+		itc.SetReceivedAt(*t)
+	}
+	return itc
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (itc *IperfTestCreate) SetClockOffsetMs(i int64) *IperfTestCreate {
+	itc.mutation.SetClockOffsetMs(i)
+	return itc
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableClockOffsetMs(i *int64) *IperfTestCreate {
+	if i != nil {
+		itc.SetClockOffsetMs(*i)
+	}
+	return itc
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (itc *IperfTestCreate) SetClockCorrected(b bool) *IperfTestCreate {
+	itc.mutation.SetClockCorrected(b)
+	return itc
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableClockCorrected(b *bool) *IperfTestCreate {
+	if b != nil {
+		itc.SetClockCorrected(*b)
+	}
+	return itc
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (itc *IperfTestCreate) SetCampaignID(i int) *IperfTestCreate {
+	itc.mutation.SetCampaignID(i)
+	return itc
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableCampaignID(i *int) *IperfTestCreate {
+	if i != nil {
+		itc.SetCampaignID(*i)
+	}
+	return itc
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (itc *IperfTestCreate) SetQuarantined(b bool) *IperfTestCreate {
+	itc.mutation.SetQuarantined(b)
+	return itc
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (itc *IperfTestCreate) SetNillableQuarantined(b *bool) *IperfTestCreate {
+	if b != nil {
+		itc.SetQuarantined(*b)
+	}
+	return itc
+}
+
 // SetHostID sets the "host" edge to the Host entity by ID.
 func (itc *IperfTestCreate) SetHostID(id int) *IperfTestCreate {
 	itc.mutation.SetHostID(id)
@@ -215,6 +328,22 @@ func (itc *IperfTestCreate) defaults() {
 		v := iperftest.DefaultSuccess
 		itc.mutation.SetSuccess(v)
 	}
+	if _, ok := itc.mutation.Trigger(); !ok {
+		v := iperftest.DefaultTrigger
+		itc.mutation.SetTrigger(v)
+	}
+	if _, ok := itc.mutation.ReceivedAt(); !ok {
+		v := iperftest.DefaultReceivedAt()
+		itc.mutation.SetReceivedAt(v)
+	}
+	if _, ok := itc.mutation.ClockCorrected(); !ok {
+		v := iperftest.DefaultClockCorrected
+		itc.mutation.SetClockCorrected(v)
+	}
+	if _, ok := itc.mutation.Quarantined(); !ok {
+		v := iperftest.DefaultQuarantined
+		itc.mutation.SetQuarantined(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -237,6 +366,25 @@ func (itc *IperfTestCreate) check() error {
 	if _, ok := itc.mutation.Success(); !ok {
 		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "IperfTest.success"`)}
 	}
+	if _, ok := itc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "IperfTest.trigger"`)}
+	}
+	if v, ok := itc.mutation.Trigger(); ok {
+		if err := iperftest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "IperfTest.trigger": %w`, err)}
+		}
+	}
+	if v, ok := itc.mutation.BlockedBy(); ok {
+		if err := iperftest.BlockedByValidator(v); err != nil {
+			return &ValidationError{Name: "blocked_by", err: fmt.Errorf(`ent: validator failed for field "IperfTest.blocked_by": %w`, err)}
+		}
+	}
+	if _, ok := itc.mutation.ClockCorrected(); !ok {
+		return &ValidationError{Name: "clock_corrected", err: errors.New(`ent: missing required field "IperfTest.clock_corrected"`)}
+	}
+	if _, ok := itc.mutation.Quarantined(); !ok {
+		return &ValidationError{Name: "quarantined", err: errors.New(`ent: missing required field "IperfTest.quarantined"`)}
+	}
 	return nil
 }
 
@@ -303,6 +451,38 @@ func (itc *IperfTestCreate) createSpec() (*IperfTest, *sqlgraph.CreateSpec) {
 		_spec.SetField(iperftest.FieldDaemonID, field.TypeString, value)
 		_node.DaemonID = value
 	}
+	if value, ok := itc.mutation.SubmissionID(); ok {
+		_spec.SetField(iperftest.FieldSubmissionID, field.TypeUUID, value)
+		_node.SubmissionID = &value
+	}
+	if value, ok := itc.mutation.Trigger(); ok {
+		_spec.SetField(iperftest.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := itc.mutation.BlockedBy(); ok {
+		_spec.SetField(iperftest.FieldBlockedBy, field.TypeEnum, value)
+		_node.BlockedBy = value
+	}
+	if value, ok := itc.mutation.ReceivedAt(); ok {
+		_spec.SetField(iperftest.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = &value
+	}
+	if value, ok := itc.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(iperftest.FieldClockOffsetMs, field.TypeInt64, value)
+		_node.ClockOffsetMs = &value
+	}
+	if value, ok := itc.mutation.ClockCorrected(); ok {
+		_spec.SetField(iperftest.FieldClockCorrected, field.TypeBool, value)
+		_node.ClockCorrected = value
+	}
+	if value, ok := itc.mutation.CampaignID(); ok {
+		_spec.SetField(iperftest.FieldCampaignID, field.TypeInt, value)
+		_node.CampaignID = &value
+	}
+	if value, ok := itc.mutation.Quarantined(); ok {
+		_spec.SetField(iperftest.FieldQuarantined, field.TypeBool, value)
+		_node.Quarantined = value
+	}
 	if nodes := itc.mutation.HostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -25,6 +25,7 @@ type IperfTestQuery struct {
 	predicates []predicate.IperfTest
 	withHost   *HostQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -277,8 +278,9 @@ func (itq *IperfTestQuery) Clone() *IperfTestQuery {
 		predicates: append([]predicate.IperfTest{}, itq.predicates...),
 		withHost:   itq.withHost.Clone(),
 		// clone intermediate query.
-		sql:  itq.sql.Clone(),
-		path: itq.path,
+		sql:       itq.sql.Clone(),
+		path:      itq.path,
+		modifiers: append([]func(*sql.Selector){}, itq.modifiers...),
 	}
 }
 
@@ -391,6 +393,9 @@ func (itq *IperfTestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*I
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +449,9 @@ func (itq *IperfTestQuery) loadHost(ctx context.Context, query *HostQuery, nodes
 
 func (itq *IperfTestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := itq.querySpec()
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
 	_spec.Node.Columns = itq.ctx.Fields
 	if len(itq.ctx.Fields) > 0 {
 		_spec.Unique = itq.ctx.Unique != nil && *itq.ctx.Unique
@@ -506,6 +514,9 @@ func (itq *IperfTestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if itq.ctx.Unique != nil && *itq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range itq.modifiers {
+		m(selector)
+	}
 	for _, p := range itq.predicates {
 		p(selector)
 	}
@@ -523,6 +534,12 @@ func (itq *IperfTestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (itq *IperfTestQuery) Modify(modifiers ...func(s *sql.Selector)) *IperfTestSelect {
+	itq.modifiers = append(itq.modifiers, modifiers...)
+	return itq.Select()
+}
+
 // IperfTestGroupBy is the group-by builder for IperfTest entities.
 type IperfTestGroupBy struct {
 	selector
@@ -612,3 +629,9 @@ func (its *IperfTestSelect) sqlScan(ctx context.Context, root *IperfTestQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (its *IperfTestSelect) Modify(modifiers ...func(s *sql.Selector)) *IperfTestSelect {
+	its.modifiers = append(its.modifiers, modifiers...)
+	return its
+}
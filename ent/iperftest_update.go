@@ -14,13 +14,15 @@ import (
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
 	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/google/uuid"
 )
 
 // IperfTestUpdate is the builder for updating IperfTest entities.
 type IperfTestUpdate struct {
 	config
-	hooks    []Hook
-	mutation *IperfTestMutation
+	hooks     []Hook
+	mutation  *IperfTestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the IperfTestUpdate builder.
@@ -228,6 +230,142 @@ func (itu *IperfTestUpdate) ClearDaemonID() *IperfTestUpdate {
 	return itu
 }
 
+// SetSubmissionID sets the "submission_id" field.
+func (itu *IperfTestUpdate) SetSubmissionID(u uuid.UUID) *IperfTestUpdate {
+	itu.mutation.SetSubmissionID(u)
+	return itu
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableSubmissionID(u *uuid.UUID) *IperfTestUpdate {
+	if u != nil {
+		itu.SetSubmissionID(*u)
+	}
+	return itu
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (itu *IperfTestUpdate) ClearSubmissionID() *IperfTestUpdate {
+	itu.mutation.ClearSubmissionID()
+	return itu
+}
+
+// SetTrigger sets the "trigger" field.
+func (itu *IperfTestUpdate) SetTrigger(i iperftest.Trigger) *IperfTestUpdate {
+	itu.mutation.SetTrigger(i)
+	return itu
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableTrigger(i *iperftest.Trigger) *IperfTestUpdate {
+	if i != nil {
+		itu.SetTrigger(*i)
+	}
+	return itu
+}
+
+// SetBlockedBy sets the "blocked_by" field.
+func (itu *IperfTestUpdate) SetBlockedBy(ib iperftest.BlockedBy) *IperfTestUpdate {
+	itu.mutation.SetBlockedBy(ib)
+	return itu
+}
+
+// SetNillableBlockedBy sets the "blocked_by" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableBlockedBy(ib *iperftest.BlockedBy) *IperfTestUpdate {
+	if ib != nil {
+		itu.SetBlockedBy(*ib)
+	}
+	return itu
+}
+
+// ClearBlockedBy clears the value of the "blocked_by" field.
+func (itu *IperfTestUpdate) ClearBlockedBy() *IperfTestUpdate {
+	itu.mutation.ClearBlockedBy()
+	return itu
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (itu *IperfTestUpdate) SetClockOffsetMs(i int64) *IperfTestUpdate {
+	itu.mutation.ResetClockOffsetMs()
+	itu.mutation.SetClockOffsetMs(i)
+	return itu
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableClockOffsetMs(i *int64) *IperfTestUpdate {
+	if i != nil {
+		itu.SetClockOffsetMs(*i)
+	}
+	return itu
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (itu *IperfTestUpdate) AddClockOffsetMs(i int64) *IperfTestUpdate {
+	itu.mutation.AddClockOffsetMs(i)
+	return itu
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (itu *IperfTestUpdate) ClearClockOffsetMs() *IperfTestUpdate {
+	itu.mutation.ClearClockOffsetMs()
+	return itu
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (itu *IperfTestUpdate) SetClockCorrected(b bool) *IperfTestUpdate {
+	itu.mutation.SetClockCorrected(b)
+	return itu
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableClockCorrected(b *bool) *IperfTestUpdate {
+	if b != nil {
+		itu.SetClockCorrected(*b)
+	}
+	return itu
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (itu *IperfTestUpdate) SetCampaignID(i int) *IperfTestUpdate {
+	itu.mutation.ResetCampaignID()
+	itu.mutation.SetCampaignID(i)
+	return itu
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableCampaignID(i *int) *IperfTestUpdate {
+	if i != nil {
+		itu.SetCampaignID(*i)
+	}
+	return itu
+}
+
+// AddCampaignID adds i to the "campaign_id" field.
+func (itu *IperfTestUpdate) AddCampaignID(i int) *IperfTestUpdate {
+	itu.mutation.AddCampaignID(i)
+	return itu
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (itu *IperfTestUpdate) ClearCampaignID() *IperfTestUpdate {
+	itu.mutation.ClearCampaignID()
+	return itu
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (itu *IperfTestUpdate) SetQuarantined(b bool) *IperfTestUpdate {
+	itu.mutation.SetQuarantined(b)
+	return itu
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (itu *IperfTestUpdate) SetNillableQuarantined(b *bool) *IperfTestUpdate {
+	if b != nil {
+		itu.SetQuarantined(*b)
+	}
+	return itu
+}
+
 // SetHostID sets the "host" edge to the Host entity by ID.
 func (itu *IperfTestUpdate) SetHostID(id int) *IperfTestUpdate {
 	itu.mutation.SetHostID(id)
@@ -285,7 +423,31 @@ func (itu *IperfTestUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (itu *IperfTestUpdate) check() error {
+	if v, ok := itu.mutation.Trigger(); ok {
+		if err := iperftest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "IperfTest.trigger": %w`, err)}
+		}
+	}
+	if v, ok := itu.mutation.BlockedBy(); ok {
+		if err := iperftest.BlockedByValidator(v); err != nil {
+			return &ValidationError{Name: "blocked_by", err: fmt.Errorf(`ent: validator failed for field "IperfTest.blocked_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (itu *IperfTestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IperfTestUpdate {
+	itu.modifiers = append(itu.modifiers, modifiers...)
+	return itu
+}
+
 func (itu *IperfTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := itu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(iperftest.Table, iperftest.Columns, sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt))
 	if ps := itu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -351,6 +513,48 @@ func (itu *IperfTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if itu.mutation.DaemonIDCleared() {
 		_spec.ClearField(iperftest.FieldDaemonID, field.TypeString)
 	}
+	if value, ok := itu.mutation.SubmissionID(); ok {
+		_spec.SetField(iperftest.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if itu.mutation.SubmissionIDCleared() {
+		_spec.ClearField(iperftest.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := itu.mutation.Trigger(); ok {
+		_spec.SetField(iperftest.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := itu.mutation.BlockedBy(); ok {
+		_spec.SetField(iperftest.FieldBlockedBy, field.TypeEnum, value)
+	}
+	if itu.mutation.BlockedByCleared() {
+		_spec.ClearField(iperftest.FieldBlockedBy, field.TypeEnum)
+	}
+	if itu.mutation.ReceivedAtCleared() {
+		_spec.ClearField(iperftest.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := itu.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(iperftest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if value, ok := itu.mutation.AddedClockOffsetMs(); ok {
+		_spec.AddField(iperftest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if itu.mutation.ClockOffsetMsCleared() {
+		_spec.ClearField(iperftest.FieldClockOffsetMs, field.TypeInt64)
+	}
+	if value, ok := itu.mutation.ClockCorrected(); ok {
+		_spec.SetField(iperftest.FieldClockCorrected, field.TypeBool, value)
+	}
+	if value, ok := itu.mutation.CampaignID(); ok {
+		_spec.SetField(iperftest.FieldCampaignID, field.TypeInt, value)
+	}
+	if value, ok := itu.mutation.AddedCampaignID(); ok {
+		_spec.AddField(iperftest.FieldCampaignID, field.TypeInt, value)
+	}
+	if itu.mutation.CampaignIDCleared() {
+		_spec.ClearField(iperftest.FieldCampaignID, field.TypeInt)
+	}
+	if value, ok := itu.mutation.Quarantined(); ok {
+		_spec.SetField(iperftest.FieldQuarantined, field.TypeBool, value)
+	}
 	if itu.mutation.HostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -380,6 +584,7 @@ func (itu *IperfTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(itu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, itu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{iperftest.Label}
@@ -395,9 +600,10 @@ func (itu *IperfTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // IperfTestUpdateOne is the builder for updating a single IperfTest entity.
 type IperfTestUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *IperfTestMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *IperfTestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTimestamp sets the "timestamp" field.
@@ -599,6 +805,142 @@ func (ituo *IperfTestUpdateOne) ClearDaemonID() *IperfTestUpdateOne {
 	return ituo
 }
 
+// SetSubmissionID sets the "submission_id" field.
+func (ituo *IperfTestUpdateOne) SetSubmissionID(u uuid.UUID) *IperfTestUpdateOne {
+	ituo.mutation.SetSubmissionID(u)
+	return ituo
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableSubmissionID(u *uuid.UUID) *IperfTestUpdateOne {
+	if u != nil {
+		ituo.SetSubmissionID(*u)
+	}
+	return ituo
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (ituo *IperfTestUpdateOne) ClearSubmissionID() *IperfTestUpdateOne {
+	ituo.mutation.ClearSubmissionID()
+	return ituo
+}
+
+// SetTrigger sets the "trigger" field.
+func (ituo *IperfTestUpdateOne) SetTrigger(i iperftest.Trigger) *IperfTestUpdateOne {
+	ituo.mutation.SetTrigger(i)
+	return ituo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableTrigger(i *iperftest.Trigger) *IperfTestUpdateOne {
+	if i != nil {
+		ituo.SetTrigger(*i)
+	}
+	return ituo
+}
+
+// SetBlockedBy sets the "blocked_by" field.
+func (ituo *IperfTestUpdateOne) SetBlockedBy(ib iperftest.BlockedBy) *IperfTestUpdateOne {
+	ituo.mutation.SetBlockedBy(ib)
+	return ituo
+}
+
+// SetNillableBlockedBy sets the "blocked_by" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableBlockedBy(ib *iperftest.BlockedBy) *IperfTestUpdateOne {
+	if ib != nil {
+		ituo.SetBlockedBy(*ib)
+	}
+	return ituo
+}
+
+// ClearBlockedBy clears the value of the "blocked_by" field.
+func (ituo *IperfTestUpdateOne) ClearBlockedBy() *IperfTestUpdateOne {
+	ituo.mutation.ClearBlockedBy()
+	return ituo
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (ituo *IperfTestUpdateOne) SetClockOffsetMs(i int64) *IperfTestUpdateOne {
+	ituo.mutation.ResetClockOffsetMs()
+	ituo.mutation.SetClockOffsetMs(i)
+	return ituo
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableClockOffsetMs(i *int64) *IperfTestUpdateOne {
+	if i != nil {
+		ituo.SetClockOffsetMs(*i)
+	}
+	return ituo
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (ituo *IperfTestUpdateOne) AddClockOffsetMs(i int64) *IperfTestUpdateOne {
+	ituo.mutation.AddClockOffsetMs(i)
+	return ituo
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (ituo *IperfTestUpdateOne) ClearClockOffsetMs() *IperfTestUpdateOne {
+	ituo.mutation.ClearClockOffsetMs()
+	return ituo
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (ituo *IperfTestUpdateOne) SetClockCorrected(b bool) *IperfTestUpdateOne {
+	ituo.mutation.SetClockCorrected(b)
+	return ituo
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableClockCorrected(b *bool) *IperfTestUpdateOne {
+	if b != nil {
+		ituo.SetClockCorrected(*b)
+	}
+	return ituo
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (ituo *IperfTestUpdateOne) SetCampaignID(i int) *IperfTestUpdateOne {
+	ituo.mutation.ResetCampaignID()
+	ituo.mutation.SetCampaignID(i)
+	return ituo
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableCampaignID(i *int) *IperfTestUpdateOne {
+	if i != nil {
+		ituo.SetCampaignID(*i)
+	}
+	return ituo
+}
+
+// AddCampaignID adds i to the "campaign_id" field.
+func (ituo *IperfTestUpdateOne) AddCampaignID(i int) *IperfTestUpdateOne {
+	ituo.mutation.AddCampaignID(i)
+	return ituo
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (ituo *IperfTestUpdateOne) ClearCampaignID() *IperfTestUpdateOne {
+	ituo.mutation.ClearCampaignID()
+	return ituo
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (ituo *IperfTestUpdateOne) SetQuarantined(b bool) *IperfTestUpdateOne {
+	ituo.mutation.SetQuarantined(b)
+	return ituo
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (ituo *IperfTestUpdateOne) SetNillableQuarantined(b *bool) *IperfTestUpdateOne {
+	if b != nil {
+		ituo.SetQuarantined(*b)
+	}
+	return ituo
+}
+
 // SetHostID sets the "host" edge to the Host entity by ID.
 func (ituo *IperfTestUpdateOne) SetHostID(id int) *IperfTestUpdateOne {
 	ituo.mutation.SetHostID(id)
@@ -669,7 +1011,31 @@ func (ituo *IperfTestUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ituo *IperfTestUpdateOne) check() error {
+	if v, ok := ituo.mutation.Trigger(); ok {
+		if err := iperftest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "IperfTest.trigger": %w`, err)}
+		}
+	}
+	if v, ok := ituo.mutation.BlockedBy(); ok {
+		if err := iperftest.BlockedByValidator(v); err != nil {
+			return &ValidationError{Name: "blocked_by", err: fmt.Errorf(`ent: validator failed for field "IperfTest.blocked_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ituo *IperfTestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IperfTestUpdateOne {
+	ituo.modifiers = append(ituo.modifiers, modifiers...)
+	return ituo
+}
+
 func (ituo *IperfTestUpdateOne) sqlSave(ctx context.Context) (_node *IperfTest, err error) {
+	if err := ituo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(iperftest.Table, iperftest.Columns, sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt))
 	id, ok := ituo.mutation.ID()
 	if !ok {
@@ -752,6 +1118,48 @@ func (ituo *IperfTestUpdateOne) sqlSave(ctx context.Context) (_node *IperfTest,
 	if ituo.mutation.DaemonIDCleared() {
 		_spec.ClearField(iperftest.FieldDaemonID, field.TypeString)
 	}
+	if value, ok := ituo.mutation.SubmissionID(); ok {
+		_spec.SetField(iperftest.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if ituo.mutation.SubmissionIDCleared() {
+		_spec.ClearField(iperftest.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := ituo.mutation.Trigger(); ok {
+		_spec.SetField(iperftest.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := ituo.mutation.BlockedBy(); ok {
+		_spec.SetField(iperftest.FieldBlockedBy, field.TypeEnum, value)
+	}
+	if ituo.mutation.BlockedByCleared() {
+		_spec.ClearField(iperftest.FieldBlockedBy, field.TypeEnum)
+	}
+	if ituo.mutation.ReceivedAtCleared() {
+		_spec.ClearField(iperftest.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := ituo.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(iperftest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if value, ok := ituo.mutation.AddedClockOffsetMs(); ok {
+		_spec.AddField(iperftest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if ituo.mutation.ClockOffsetMsCleared() {
+		_spec.ClearField(iperftest.FieldClockOffsetMs, field.TypeInt64)
+	}
+	if value, ok := ituo.mutation.ClockCorrected(); ok {
+		_spec.SetField(iperftest.FieldClockCorrected, field.TypeBool, value)
+	}
+	if value, ok := ituo.mutation.CampaignID(); ok {
+		_spec.SetField(iperftest.FieldCampaignID, field.TypeInt, value)
+	}
+	if value, ok := ituo.mutation.AddedCampaignID(); ok {
+		_spec.AddField(iperftest.FieldCampaignID, field.TypeInt, value)
+	}
+	if ituo.mutation.CampaignIDCleared() {
+		_spec.ClearField(iperftest.FieldCampaignID, field.TypeInt)
+	}
+	if value, ok := ituo.mutation.Quarantined(); ok {
+		_spec.SetField(iperftest.FieldQuarantined, field.TypeBool, value)
+	}
 	if ituo.mutation.HostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -781,6 +1189,7 @@ func (ituo *IperfTestUpdateOne) sqlSave(ctx context.Context) (_node *IperfTest,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ituo.modifiers...)
 	_node = &IperfTest{config: ituo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/job"
+)
+
+// Job is the model entity for the Job schema.
+type Job struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind of test the job runs
+	Type job.Type `json:"type,omitempty"`
+	// Lifecycle state of the job
+	Status job.Status `json:"status,omitempty"`
+	// Daemon the job is pinned to; empty means any daemon may lease it
+	DaemonID string `json:"daemon_id,omitempty"`
+	// Daemon whose iperf3 server a mesh job tests
+	TargetDaemonID string `json:"target_daemon_id,omitempty"`
+	// host:port of the target daemon's iperf3 server, resolved when the mesh job is enqueued
+	TargetAddress string `json:"target_address,omitempty"`
+	// iperf test duration in seconds; daemon default when unset
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// What caused the job to be enqueued
+	Trigger job.Trigger `json:"trigger,omitempty"`
+	// Higher priority jobs are leased first; on-demand runs use a raised priority
+	Priority int `json:"priority,omitempty"`
+	// Number of times the job has been leased
+	Attempts int `json:"attempts,omitempty"`
+	// Maximum number of leases before the job is marked failed
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// Daemon currently holding the lease
+	LeasedBy string `json:"leased_by,omitempty"`
+	// When the current lease expires and the job becomes leasable again
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	// Earliest time the job may be leased
+	ScheduledAt time.Time `json:"scheduled_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the job reached a terminal state
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// ID of the speed or iperf result produced by the job
+	ResultID *int `json:"result_id,omitempty"`
+	// Error reported by the last failed attempt
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Campaign the job belongs to
+	CampaignID *int `json:"campaign_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JobQuery when eager-loading is set.
+	Edges        JobEdges `json:"edges"`
+	host_jobs    *int
+	selectValues sql.SelectValues
+}
+
+// JobEdges holds the relations/edges for other nodes in the graph.
+type JobEdges struct {
+	// Target host for iperf jobs
+	Host *Host `json:"host,omitempty"`
+	// Campaign holds the value of the campaign edge.
+	Campaign *Campaign `json:"campaign,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// HostOrErr returns the Host value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobEdges) HostOrErr() (*Host, error) {
+	if e.Host != nil {
+		return e.Host, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: host.Label}
+	}
+	return nil, &NotLoadedError{edge: "host"}
+}
+
+// CampaignOrErr returns the Campaign value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobEdges) CampaignOrErr() (*Campaign, error) {
+	if e.Campaign != nil {
+		return e.Campaign, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: campaign.Label}
+	}
+	return nil, &NotLoadedError{edge: "campaign"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Job) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case job.FieldID, job.FieldDurationSeconds, job.FieldPriority, job.FieldAttempts, job.FieldMaxAttempts, job.FieldResultID, job.FieldCampaignID:
+			values[i] = new(sql.NullInt64)
+		case job.FieldType, job.FieldStatus, job.FieldDaemonID, job.FieldTargetDaemonID, job.FieldTargetAddress, job.FieldTrigger, job.FieldLeasedBy, job.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case job.FieldLeaseExpiresAt, job.FieldScheduledAt, job.FieldCreatedAt, job.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case job.ForeignKeys[0]: // host_jobs
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Job fields.
+func (j *Job) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case job.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			j.ID = int(value.Int64)
+		case job.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				j.Type = job.Type(value.String)
+			}
+		case job.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				j.Status = job.Status(value.String)
+			}
+		case job.FieldDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_id", values[i])
+			} else if value.Valid {
+				j.DaemonID = value.String
+			}
+		case job.FieldTargetDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_daemon_id", values[i])
+			} else if value.Valid {
+				j.TargetDaemonID = value.String
+			}
+		case job.FieldTargetAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_address", values[i])
+			} else if value.Valid {
+				j.TargetAddress = value.String
+			}
+		case job.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				j.DurationSeconds = int(value.Int64)
+			}
+		case job.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				j.Trigger = job.Trigger(value.String)
+			}
+		case job.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				j.Priority = int(value.Int64)
+			}
+		case job.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				j.Attempts = int(value.Int64)
+			}
+		case job.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				j.MaxAttempts = int(value.Int64)
+			}
+		case job.FieldLeasedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field leased_by", values[i])
+			} else if value.Valid {
+				j.LeasedBy = value.String
+			}
+		case job.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				j.LeaseExpiresAt = new(time.Time)
+				*j.LeaseExpiresAt = value.Time
+			}
+		case job.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				j.ScheduledAt = value.Time
+			}
+		case job.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				j.CreatedAt = value.Time
+			}
+		case job.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				j.CompletedAt = new(time.Time)
+				*j.CompletedAt = value.Time
+			}
+		case job.FieldResultID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field result_id", values[i])
+			} else if value.Valid {
+				j.ResultID = new(int)
+				*j.ResultID = int(value.Int64)
+			}
+		case job.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				j.ErrorMessage = value.String
+			}
+		case job.FieldCampaignID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_id", values[i])
+			} else if value.Valid {
+				j.CampaignID = new(int)
+				*j.CampaignID = int(value.Int64)
+			}
+		case job.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field host_jobs", value)
+			} else if value.Valid {
+				j.host_jobs = new(int)
+				*j.host_jobs = int(value.Int64)
+			}
+		default:
+			j.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Job.
+// This includes values selected through modifiers, order, etc.
+func (j *Job) Value(name string) (ent.Value, error) {
+	return j.selectValues.Get(name)
+}
+
+// QueryHost queries the "host" edge of the Job entity.
+func (j *Job) QueryHost() *HostQuery {
+	return NewJobClient(j.config).QueryHost(j)
+}
+
+// QueryCampaign queries the "campaign" edge of the Job entity.
+func (j *Job) QueryCampaign() *CampaignQuery {
+	return NewJobClient(j.config).QueryCampaign(j)
+}
+
+// Update returns a builder for updating this Job.
+// Note that you need to call Job.Unwrap() before calling this method if this Job
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (j *Job) Update() *JobUpdateOne {
+	return NewJobClient(j.config).UpdateOne(j)
+}
+
+// Unwrap unwraps the Job entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (j *Job) Unwrap() *Job {
+	_tx, ok := j.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Job is not a transactional entity")
+	}
+	j.config.driver = _tx.drv
+	return j
+}
+
+// String implements the fmt.Stringer.
+func (j *Job) String() string {
+	var builder strings.Builder
+	builder.WriteString("Job(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", j.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", j.Type))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", j.Status))
+	builder.WriteString(", ")
+	builder.WriteString("daemon_id=")
+	builder.WriteString(j.DaemonID)
+	builder.WriteString(", ")
+	builder.WriteString("target_daemon_id=")
+	builder.WriteString(j.TargetDaemonID)
+	builder.WriteString(", ")
+	builder.WriteString("target_address=")
+	builder.WriteString(j.TargetAddress)
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", j.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", j.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", j.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("max_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", j.MaxAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("leased_by=")
+	builder.WriteString(j.LeasedBy)
+	builder.WriteString(", ")
+	if v := j.LeaseExpiresAt; v != nil {
+		builder.WriteString("lease_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(j.ScheduledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(j.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := j.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := j.ResultID; v != nil {
+		builder.WriteString("result_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(j.ErrorMessage)
+	builder.WriteString(", ")
+	if v := j.CampaignID; v != nil {
+		builder.WriteString("campaign_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Jobs is a parsable slice of Job.
+type Jobs []*Job
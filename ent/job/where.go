@@ -0,0 +1,1057 @@
+// Code generated by ent, DO NOT EDIT.
+
+package job
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldID, id))
+}
+
+// DaemonID applies equality check predicate on the "daemon_id" field. It's identical to DaemonIDEQ.
+func DaemonID(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDaemonID, v))
+}
+
+// TargetDaemonID applies equality check predicate on the "target_daemon_id" field. It's identical to TargetDaemonIDEQ.
+func TargetDaemonID(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTargetDaemonID, v))
+}
+
+// TargetAddress applies equality check predicate on the "target_address" field. It's identical to TargetAddressEQ.
+func TargetAddress(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTargetAddress, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPriority, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// LeasedBy applies equality check predicate on the "leased_by" field. It's identical to LeasedByEQ.
+func LeasedBy(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLeasedBy, v))
+}
+
+// LeaseExpiresAt applies equality check predicate on the "lease_expires_at" field. It's identical to LeaseExpiresAtEQ.
+func LeaseExpiresAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// ResultID applies equality check predicate on the "result_id" field. It's identical to ResultIDEQ.
+func ResultID(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldResultID, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// CampaignID applies equality check predicate on the "campaign_id" field. It's identical to CampaignIDEQ.
+func CampaignID(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldType, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DaemonIDEQ applies the EQ predicate on the "daemon_id" field.
+func DaemonIDEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDaemonID, v))
+}
+
+// DaemonIDNEQ applies the NEQ predicate on the "daemon_id" field.
+func DaemonIDNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldDaemonID, v))
+}
+
+// DaemonIDIn applies the In predicate on the "daemon_id" field.
+func DaemonIDIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldDaemonID, vs...))
+}
+
+// DaemonIDNotIn applies the NotIn predicate on the "daemon_id" field.
+func DaemonIDNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldDaemonID, vs...))
+}
+
+// DaemonIDGT applies the GT predicate on the "daemon_id" field.
+func DaemonIDGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldDaemonID, v))
+}
+
+// DaemonIDGTE applies the GTE predicate on the "daemon_id" field.
+func DaemonIDGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldDaemonID, v))
+}
+
+// DaemonIDLT applies the LT predicate on the "daemon_id" field.
+func DaemonIDLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldDaemonID, v))
+}
+
+// DaemonIDLTE applies the LTE predicate on the "daemon_id" field.
+func DaemonIDLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldDaemonID, v))
+}
+
+// DaemonIDContains applies the Contains predicate on the "daemon_id" field.
+func DaemonIDContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldDaemonID, v))
+}
+
+// DaemonIDHasPrefix applies the HasPrefix predicate on the "daemon_id" field.
+func DaemonIDHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldDaemonID, v))
+}
+
+// DaemonIDHasSuffix applies the HasSuffix predicate on the "daemon_id" field.
+func DaemonIDHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldDaemonID, v))
+}
+
+// DaemonIDIsNil applies the IsNil predicate on the "daemon_id" field.
+func DaemonIDIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldDaemonID))
+}
+
+// DaemonIDNotNil applies the NotNil predicate on the "daemon_id" field.
+func DaemonIDNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldDaemonID))
+}
+
+// DaemonIDEqualFold applies the EqualFold predicate on the "daemon_id" field.
+func DaemonIDEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldDaemonID, v))
+}
+
+// DaemonIDContainsFold applies the ContainsFold predicate on the "daemon_id" field.
+func DaemonIDContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldDaemonID, v))
+}
+
+// TargetDaemonIDEQ applies the EQ predicate on the "target_daemon_id" field.
+func TargetDaemonIDEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDNEQ applies the NEQ predicate on the "target_daemon_id" field.
+func TargetDaemonIDNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDIn applies the In predicate on the "target_daemon_id" field.
+func TargetDaemonIDIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldTargetDaemonID, vs...))
+}
+
+// TargetDaemonIDNotIn applies the NotIn predicate on the "target_daemon_id" field.
+func TargetDaemonIDNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldTargetDaemonID, vs...))
+}
+
+// TargetDaemonIDGT applies the GT predicate on the "target_daemon_id" field.
+func TargetDaemonIDGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDGTE applies the GTE predicate on the "target_daemon_id" field.
+func TargetDaemonIDGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDLT applies the LT predicate on the "target_daemon_id" field.
+func TargetDaemonIDLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDLTE applies the LTE predicate on the "target_daemon_id" field.
+func TargetDaemonIDLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDContains applies the Contains predicate on the "target_daemon_id" field.
+func TargetDaemonIDContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDHasPrefix applies the HasPrefix predicate on the "target_daemon_id" field.
+func TargetDaemonIDHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDHasSuffix applies the HasSuffix predicate on the "target_daemon_id" field.
+func TargetDaemonIDHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDIsNil applies the IsNil predicate on the "target_daemon_id" field.
+func TargetDaemonIDIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldTargetDaemonID))
+}
+
+// TargetDaemonIDNotNil applies the NotNil predicate on the "target_daemon_id" field.
+func TargetDaemonIDNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldTargetDaemonID))
+}
+
+// TargetDaemonIDEqualFold applies the EqualFold predicate on the "target_daemon_id" field.
+func TargetDaemonIDEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDContainsFold applies the ContainsFold predicate on the "target_daemon_id" field.
+func TargetDaemonIDContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldTargetDaemonID, v))
+}
+
+// TargetAddressEQ applies the EQ predicate on the "target_address" field.
+func TargetAddressEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTargetAddress, v))
+}
+
+// TargetAddressNEQ applies the NEQ predicate on the "target_address" field.
+func TargetAddressNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldTargetAddress, v))
+}
+
+// TargetAddressIn applies the In predicate on the "target_address" field.
+func TargetAddressIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldTargetAddress, vs...))
+}
+
+// TargetAddressNotIn applies the NotIn predicate on the "target_address" field.
+func TargetAddressNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldTargetAddress, vs...))
+}
+
+// TargetAddressGT applies the GT predicate on the "target_address" field.
+func TargetAddressGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldTargetAddress, v))
+}
+
+// TargetAddressGTE applies the GTE predicate on the "target_address" field.
+func TargetAddressGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldTargetAddress, v))
+}
+
+// TargetAddressLT applies the LT predicate on the "target_address" field.
+func TargetAddressLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldTargetAddress, v))
+}
+
+// TargetAddressLTE applies the LTE predicate on the "target_address" field.
+func TargetAddressLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldTargetAddress, v))
+}
+
+// TargetAddressContains applies the Contains predicate on the "target_address" field.
+func TargetAddressContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldTargetAddress, v))
+}
+
+// TargetAddressHasPrefix applies the HasPrefix predicate on the "target_address" field.
+func TargetAddressHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldTargetAddress, v))
+}
+
+// TargetAddressHasSuffix applies the HasSuffix predicate on the "target_address" field.
+func TargetAddressHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldTargetAddress, v))
+}
+
+// TargetAddressIsNil applies the IsNil predicate on the "target_address" field.
+func TargetAddressIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldTargetAddress))
+}
+
+// TargetAddressNotNil applies the NotNil predicate on the "target_address" field.
+func TargetAddressNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldTargetAddress))
+}
+
+// TargetAddressEqualFold applies the EqualFold predicate on the "target_address" field.
+func TargetAddressEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldTargetAddress, v))
+}
+
+// TargetAddressContainsFold applies the ContainsFold predicate on the "target_address" field.
+func TargetAddressContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldTargetAddress, v))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIsNil applies the IsNil predicate on the "duration_seconds" field.
+func DurationSecondsIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldDurationSeconds))
+}
+
+// DurationSecondsNotNil applies the NotNil predicate on the "duration_seconds" field.
+func DurationSecondsNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldDurationSeconds))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldPriority, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldAttempts, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// LeasedByEQ applies the EQ predicate on the "leased_by" field.
+func LeasedByEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLeasedBy, v))
+}
+
+// LeasedByNEQ applies the NEQ predicate on the "leased_by" field.
+func LeasedByNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLeasedBy, v))
+}
+
+// LeasedByIn applies the In predicate on the "leased_by" field.
+func LeasedByIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLeasedBy, vs...))
+}
+
+// LeasedByNotIn applies the NotIn predicate on the "leased_by" field.
+func LeasedByNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLeasedBy, vs...))
+}
+
+// LeasedByGT applies the GT predicate on the "leased_by" field.
+func LeasedByGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLeasedBy, v))
+}
+
+// LeasedByGTE applies the GTE predicate on the "leased_by" field.
+func LeasedByGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLeasedBy, v))
+}
+
+// LeasedByLT applies the LT predicate on the "leased_by" field.
+func LeasedByLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLeasedBy, v))
+}
+
+// LeasedByLTE applies the LTE predicate on the "leased_by" field.
+func LeasedByLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLeasedBy, v))
+}
+
+// LeasedByContains applies the Contains predicate on the "leased_by" field.
+func LeasedByContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldLeasedBy, v))
+}
+
+// LeasedByHasPrefix applies the HasPrefix predicate on the "leased_by" field.
+func LeasedByHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldLeasedBy, v))
+}
+
+// LeasedByHasSuffix applies the HasSuffix predicate on the "leased_by" field.
+func LeasedByHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldLeasedBy, v))
+}
+
+// LeasedByIsNil applies the IsNil predicate on the "leased_by" field.
+func LeasedByIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLeasedBy))
+}
+
+// LeasedByNotNil applies the NotNil predicate on the "leased_by" field.
+func LeasedByNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLeasedBy))
+}
+
+// LeasedByEqualFold applies the EqualFold predicate on the "leased_by" field.
+func LeasedByEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldLeasedBy, v))
+}
+
+// LeasedByContainsFold applies the ContainsFold predicate on the "leased_by" field.
+func LeasedByContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldLeasedBy, v))
+}
+
+// LeaseExpiresAtEQ applies the EQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtNEQ applies the NEQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIn applies the In predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtNotIn applies the NotIn predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtGT applies the GT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtGTE applies the GTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLT applies the LT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLTE applies the LTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIsNil applies the IsNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldLeaseExpiresAt))
+}
+
+// LeaseExpiresAtNotNil applies the NotNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldLeaseExpiresAt))
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldScheduledAt, v))
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldScheduledAt, v))
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldScheduledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// ResultIDEQ applies the EQ predicate on the "result_id" field.
+func ResultIDEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldResultID, v))
+}
+
+// ResultIDNEQ applies the NEQ predicate on the "result_id" field.
+func ResultIDNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldResultID, v))
+}
+
+// ResultIDIn applies the In predicate on the "result_id" field.
+func ResultIDIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldResultID, vs...))
+}
+
+// ResultIDNotIn applies the NotIn predicate on the "result_id" field.
+func ResultIDNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldResultID, vs...))
+}
+
+// ResultIDGT applies the GT predicate on the "result_id" field.
+func ResultIDGT(v int) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldResultID, v))
+}
+
+// ResultIDGTE applies the GTE predicate on the "result_id" field.
+func ResultIDGTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldResultID, v))
+}
+
+// ResultIDLT applies the LT predicate on the "result_id" field.
+func ResultIDLT(v int) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldResultID, v))
+}
+
+// ResultIDLTE applies the LTE predicate on the "result_id" field.
+func ResultIDLTE(v int) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldResultID, v))
+}
+
+// ResultIDIsNil applies the IsNil predicate on the "result_id" field.
+func ResultIDIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldResultID))
+}
+
+// ResultIDNotNil applies the NotNil predicate on the "result_id" field.
+func ResultIDNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldResultID))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.Job {
+	return predicate.Job(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.Job {
+	return predicate.Job(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.Job {
+	return predicate.Job(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.Job {
+	return predicate.Job(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.Job {
+	return predicate.Job(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.Job {
+	return predicate.Job(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// CampaignIDEQ applies the EQ predicate on the "campaign_id" field.
+func CampaignIDEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignIDNEQ applies the NEQ predicate on the "campaign_id" field.
+func CampaignIDNEQ(v int) predicate.Job {
+	return predicate.Job(sql.FieldNEQ(FieldCampaignID, v))
+}
+
+// CampaignIDIn applies the In predicate on the "campaign_id" field.
+func CampaignIDIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDNotIn applies the NotIn predicate on the "campaign_id" field.
+func CampaignIDNotIn(vs ...int) predicate.Job {
+	return predicate.Job(sql.FieldNotIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDIsNil applies the IsNil predicate on the "campaign_id" field.
+func CampaignIDIsNil() predicate.Job {
+	return predicate.Job(sql.FieldIsNull(FieldCampaignID))
+}
+
+// CampaignIDNotNil applies the NotNil predicate on the "campaign_id" field.
+func CampaignIDNotNil() predicate.Job {
+	return predicate.Job(sql.FieldNotNull(FieldCampaignID))
+}
+
+// HasHost applies the HasEdge predicate on the "host" edge.
+func HasHost() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HostTable, HostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHostWith applies the HasEdge predicate on the "host" edge with a given conditions (other predicates).
+func HasHostWith(preds ...predicate.Host) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newHostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCampaign applies the HasEdge predicate on the "campaign" edge.
+func HasCampaign() predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CampaignTable, CampaignColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCampaignWith applies the HasEdge predicate on the "campaign" edge with a given conditions (other predicates).
+func HasCampaignWith(preds ...predicate.Campaign) predicate.Job {
+	return predicate.Job(func(s *sql.Selector) {
+		step := newCampaignStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Job) predicate.Job {
+	return predicate.Job(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Job) predicate.Job {
+	return predicate.Job(sql.NotPredicates(p))
+}
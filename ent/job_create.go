@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/job"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (jc *JobCreate) SetType(j job.Type) *JobCreate {
+	jc.mutation.SetType(j)
+	return jc
+}
+
+// SetStatus sets the "status" field.
+func (jc *JobCreate) SetStatus(j job.Status) *JobCreate {
+	jc.mutation.SetStatus(j)
+	return jc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jc *JobCreate) SetNillableStatus(j *job.Status) *JobCreate {
+	if j != nil {
+		jc.SetStatus(*j)
+	}
+	return jc
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (jc *JobCreate) SetDaemonID(s string) *JobCreate {
+	jc.mutation.SetDaemonID(s)
+	return jc
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (jc *JobCreate) SetNillableDaemonID(s *string) *JobCreate {
+	if s != nil {
+		jc.SetDaemonID(*s)
+	}
+	return jc
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (jc *JobCreate) SetTargetDaemonID(s string) *JobCreate {
+	jc.mutation.SetTargetDaemonID(s)
+	return jc
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (jc *JobCreate) SetNillableTargetDaemonID(s *string) *JobCreate {
+	if s != nil {
+		jc.SetTargetDaemonID(*s)
+	}
+	return jc
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (jc *JobCreate) SetTargetAddress(s string) *JobCreate {
+	jc.mutation.SetTargetAddress(s)
+	return jc
+}
+
+// SetNillableTargetAddress sets the "target_address" field if the given value is not nil.
+func (jc *JobCreate) SetNillableTargetAddress(s *string) *JobCreate {
+	if s != nil {
+		jc.SetTargetAddress(*s)
+	}
+	return jc
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (jc *JobCreate) SetDurationSeconds(i int) *JobCreate {
+	jc.mutation.SetDurationSeconds(i)
+	return jc
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (jc *JobCreate) SetNillableDurationSeconds(i *int) *JobCreate {
+	if i != nil {
+		jc.SetDurationSeconds(*i)
+	}
+	return jc
+}
+
+// SetTrigger sets the "trigger" field.
+func (jc *JobCreate) SetTrigger(j job.Trigger) *JobCreate {
+	jc.mutation.SetTrigger(j)
+	return jc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jc *JobCreate) SetNillableTrigger(j *job.Trigger) *JobCreate {
+	if j != nil {
+		jc.SetTrigger(*j)
+	}
+	return jc
+}
+
+// SetPriority sets the "priority" field.
+func (jc *JobCreate) SetPriority(i int) *JobCreate {
+	jc.mutation.SetPriority(i)
+	return jc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (jc *JobCreate) SetNillablePriority(i *int) *JobCreate {
+	if i != nil {
+		jc.SetPriority(*i)
+	}
+	return jc
+}
+
+// SetAttempts sets the "attempts" field.
+func (jc *JobCreate) SetAttempts(i int) *JobCreate {
+	jc.mutation.SetAttempts(i)
+	return jc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jc *JobCreate) SetNillableAttempts(i *int) *JobCreate {
+	if i != nil {
+		jc.SetAttempts(*i)
+	}
+	return jc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (jc *JobCreate) SetMaxAttempts(i int) *JobCreate {
+	jc.mutation.SetMaxAttempts(i)
+	return jc
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (jc *JobCreate) SetNillableMaxAttempts(i *int) *JobCreate {
+	if i != nil {
+		jc.SetMaxAttempts(*i)
+	}
+	return jc
+}
+
+// SetLeasedBy sets the "leased_by" field.
+func (jc *JobCreate) SetLeasedBy(s string) *JobCreate {
+	jc.mutation.SetLeasedBy(s)
+	return jc
+}
+
+// SetNillableLeasedBy sets the "leased_by" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLeasedBy(s *string) *JobCreate {
+	if s != nil {
+		jc.SetLeasedBy(*s)
+	}
+	return jc
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (jc *JobCreate) SetLeaseExpiresAt(t time.Time) *JobCreate {
+	jc.mutation.SetLeaseExpiresAt(t)
+	return jc
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableLeaseExpiresAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetLeaseExpiresAt(*t)
+	}
+	return jc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jc *JobCreate) SetScheduledAt(t time.Time) *JobCreate {
+	jc.mutation.SetScheduledAt(t)
+	return jc
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableScheduledAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetScheduledAt(*t)
+	}
+	return jc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetCreatedAt(t)
+	return jc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCreatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCreatedAt(*t)
+	}
+	return jc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (jc *JobCreate) SetCompletedAt(t time.Time) *JobCreate {
+	jc.mutation.SetCompletedAt(t)
+	return jc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCompletedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCompletedAt(*t)
+	}
+	return jc
+}
+
+// SetResultID sets the "result_id" field.
+func (jc *JobCreate) SetResultID(i int) *JobCreate {
+	jc.mutation.SetResultID(i)
+	return jc
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (jc *JobCreate) SetNillableResultID(i *int) *JobCreate {
+	if i != nil {
+		jc.SetResultID(*i)
+	}
+	return jc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (jc *JobCreate) SetErrorMessage(s string) *JobCreate {
+	jc.mutation.SetErrorMessage(s)
+	return jc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (jc *JobCreate) SetNillableErrorMessage(s *string) *JobCreate {
+	if s != nil {
+		jc.SetErrorMessage(*s)
+	}
+	return jc
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (jc *JobCreate) SetCampaignID(i int) *JobCreate {
+	jc.mutation.SetCampaignID(i)
+	return jc
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCampaignID(i *int) *JobCreate {
+	if i != nil {
+		jc.SetCampaignID(*i)
+	}
+	return jc
+}
+
+// SetHostID sets the "host" edge to the Host entity by ID.
+func (jc *JobCreate) SetHostID(id int) *JobCreate {
+	jc.mutation.SetHostID(id)
+	return jc
+}
+
+// SetNillableHostID sets the "host" edge to the Host entity by ID if the given value is not nil.
+func (jc *JobCreate) SetNillableHostID(id *int) *JobCreate {
+	if id != nil {
+		jc = jc.SetHostID(*id)
+	}
+	return jc
+}
+
+// SetHost sets the "host" edge to the Host entity.
+func (jc *JobCreate) SetHost(h *Host) *JobCreate {
+	return jc.SetHostID(h.ID)
+}
+
+// SetCampaign sets the "campaign" edge to the Campaign entity.
+func (jc *JobCreate) SetCampaign(c *Campaign) *JobCreate {
+	return jc.SetCampaignID(c.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (jc *JobCreate) Mutation() *JobMutation {
+	return jc.mutation
+}
+
+// Save creates the Job in the database.
+func (jc *JobCreate) Save(ctx context.Context) (*Job, error) {
+	jc.defaults()
+	return withHooks(ctx, jc.sqlSave, jc.mutation, jc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jc *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := jc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jc *JobCreate) Exec(ctx context.Context) error {
+	_, err := jc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jc *JobCreate) ExecX(ctx context.Context) {
+	if err := jc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jc *JobCreate) defaults() {
+	if _, ok := jc.mutation.Status(); !ok {
+		v := job.DefaultStatus
+		jc.mutation.SetStatus(v)
+	}
+	if _, ok := jc.mutation.Trigger(); !ok {
+		v := job.DefaultTrigger
+		jc.mutation.SetTrigger(v)
+	}
+	if _, ok := jc.mutation.Priority(); !ok {
+		v := job.DefaultPriority
+		jc.mutation.SetPriority(v)
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		v := job.DefaultAttempts
+		jc.mutation.SetAttempts(v)
+	}
+	if _, ok := jc.mutation.MaxAttempts(); !ok {
+		v := job.DefaultMaxAttempts
+		jc.mutation.SetMaxAttempts(v)
+	}
+	if _, ok := jc.mutation.ScheduledAt(); !ok {
+		v := job.DefaultScheduledAt()
+		jc.mutation.SetScheduledAt(v)
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		jc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jc *JobCreate) check() error {
+	if _, ok := jc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Job.type"`)}
+	}
+	if v, ok := jc.mutation.GetType(); ok {
+		if err := job.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Job.type": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Job.status"`)}
+	}
+	if v, ok := jc.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "Job.trigger"`)}
+	}
+	if v, ok := jc.mutation.Trigger(); ok {
+		if err := job.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Job.trigger": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Job.priority"`)}
+	}
+	if _, ok := jc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Job.attempts"`)}
+	}
+	if _, ok := jc.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "Job.max_attempts"`)}
+	}
+	if _, ok := jc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "Job.scheduled_at"`)}
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	return nil
+}
+
+func (jc *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	if err := jc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	jc.mutation.id = &_node.ID
+	jc.mutation.done = true
+	return _node, nil
+}
+
+func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: jc.config}
+		_spec = sqlgraph.NewCreateSpec(job.Table, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	)
+	if value, ok := jc.mutation.GetType(); ok {
+		_spec.SetField(job.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := jc.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := jc.mutation.DaemonID(); ok {
+		_spec.SetField(job.FieldDaemonID, field.TypeString, value)
+		_node.DaemonID = value
+	}
+	if value, ok := jc.mutation.TargetDaemonID(); ok {
+		_spec.SetField(job.FieldTargetDaemonID, field.TypeString, value)
+		_node.TargetDaemonID = value
+	}
+	if value, ok := jc.mutation.TargetAddress(); ok {
+		_spec.SetField(job.FieldTargetAddress, field.TypeString, value)
+		_node.TargetAddress = value
+	}
+	if value, ok := jc.mutation.DurationSeconds(); ok {
+		_spec.SetField(job.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := jc.mutation.Trigger(); ok {
+		_spec.SetField(job.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := jc.mutation.Priority(); ok {
+		_spec.SetField(job.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := jc.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := jc.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := jc.mutation.LeasedBy(); ok {
+		_spec.SetField(job.FieldLeasedBy, field.TypeString, value)
+		_node.LeasedBy = value
+	}
+	if value, ok := jc.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(job.FieldLeaseExpiresAt, field.TypeTime, value)
+		_node.LeaseExpiresAt = &value
+	}
+	if value, ok := jc.mutation.ScheduledAt(); ok {
+		_spec.SetField(job.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := jc.mutation.CreatedAt(); ok {
+		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := jc.mutation.CompletedAt(); ok {
+		_spec.SetField(job.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := jc.mutation.ResultID(); ok {
+		_spec.SetField(job.FieldResultID, field.TypeInt, value)
+		_node.ResultID = &value
+	}
+	if value, ok := jc.mutation.ErrorMessage(); ok {
+		_spec.SetField(job.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if nodes := jc.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.HostTable,
+			Columns: []string{job.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.host_jobs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := jc.mutation.CampaignIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.CampaignTable,
+			Columns: []string{job.CampaignColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CampaignID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	err      error
+	builders []*JobCreate
+}
+
+// Save creates the Job entities in the database.
+func (jcb *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	if jcb.err != nil {
+		return nil, jcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jcb.builders))
+	nodes := make([]*Job, len(jcb.builders))
+	mutators := make([]Mutator, len(jcb.builders))
+	for i := range jcb.builders {
+		func(i int, root context.Context) {
+			builder := jcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jcb *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := jcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jcb *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := jcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jcb *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := jcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (ju *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	ju.mutation.Where(ps...)
+	return ju
+}
+
+// SetType sets the "type" field.
+func (ju *JobUpdate) SetType(j job.Type) *JobUpdate {
+	ju.mutation.SetType(j)
+	return ju
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableType(j *job.Type) *JobUpdate {
+	if j != nil {
+		ju.SetType(*j)
+	}
+	return ju
+}
+
+// SetStatus sets the "status" field.
+func (ju *JobUpdate) SetStatus(j job.Status) *JobUpdate {
+	ju.mutation.SetStatus(j)
+	return ju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableStatus(j *job.Status) *JobUpdate {
+	if j != nil {
+		ju.SetStatus(*j)
+	}
+	return ju
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (ju *JobUpdate) SetDaemonID(s string) *JobUpdate {
+	ju.mutation.SetDaemonID(s)
+	return ju
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableDaemonID(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetDaemonID(*s)
+	}
+	return ju
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (ju *JobUpdate) ClearDaemonID() *JobUpdate {
+	ju.mutation.ClearDaemonID()
+	return ju
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (ju *JobUpdate) SetTargetDaemonID(s string) *JobUpdate {
+	ju.mutation.SetTargetDaemonID(s)
+	return ju
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableTargetDaemonID(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetTargetDaemonID(*s)
+	}
+	return ju
+}
+
+// ClearTargetDaemonID clears the value of the "target_daemon_id" field.
+func (ju *JobUpdate) ClearTargetDaemonID() *JobUpdate {
+	ju.mutation.ClearTargetDaemonID()
+	return ju
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (ju *JobUpdate) SetTargetAddress(s string) *JobUpdate {
+	ju.mutation.SetTargetAddress(s)
+	return ju
+}
+
+// SetNillableTargetAddress sets the "target_address" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableTargetAddress(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetTargetAddress(*s)
+	}
+	return ju
+}
+
+// ClearTargetAddress clears the value of the "target_address" field.
+func (ju *JobUpdate) ClearTargetAddress() *JobUpdate {
+	ju.mutation.ClearTargetAddress()
+	return ju
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (ju *JobUpdate) SetDurationSeconds(i int) *JobUpdate {
+	ju.mutation.ResetDurationSeconds()
+	ju.mutation.SetDurationSeconds(i)
+	return ju
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableDurationSeconds(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetDurationSeconds(*i)
+	}
+	return ju
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (ju *JobUpdate) AddDurationSeconds(i int) *JobUpdate {
+	ju.mutation.AddDurationSeconds(i)
+	return ju
+}
+
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (ju *JobUpdate) ClearDurationSeconds() *JobUpdate {
+	ju.mutation.ClearDurationSeconds()
+	return ju
+}
+
+// SetTrigger sets the "trigger" field.
+func (ju *JobUpdate) SetTrigger(j job.Trigger) *JobUpdate {
+	ju.mutation.SetTrigger(j)
+	return ju
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableTrigger(j *job.Trigger) *JobUpdate {
+	if j != nil {
+		ju.SetTrigger(*j)
+	}
+	return ju
+}
+
+// SetPriority sets the "priority" field.
+func (ju *JobUpdate) SetPriority(i int) *JobUpdate {
+	ju.mutation.ResetPriority()
+	ju.mutation.SetPriority(i)
+	return ju
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (ju *JobUpdate) SetNillablePriority(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetPriority(*i)
+	}
+	return ju
+}
+
+// AddPriority adds i to the "priority" field.
+func (ju *JobUpdate) AddPriority(i int) *JobUpdate {
+	ju.mutation.AddPriority(i)
+	return ju
+}
+
+// SetAttempts sets the "attempts" field.
+func (ju *JobUpdate) SetAttempts(i int) *JobUpdate {
+	ju.mutation.ResetAttempts()
+	ju.mutation.SetAttempts(i)
+	return ju
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetAttempts(*i)
+	}
+	return ju
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ju *JobUpdate) AddAttempts(i int) *JobUpdate {
+	ju.mutation.AddAttempts(i)
+	return ju
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (ju *JobUpdate) SetMaxAttempts(i int) *JobUpdate {
+	ju.mutation.ResetMaxAttempts()
+	ju.mutation.SetMaxAttempts(i)
+	return ju
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableMaxAttempts(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetMaxAttempts(*i)
+	}
+	return ju
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (ju *JobUpdate) AddMaxAttempts(i int) *JobUpdate {
+	ju.mutation.AddMaxAttempts(i)
+	return ju
+}
+
+// SetLeasedBy sets the "leased_by" field.
+func (ju *JobUpdate) SetLeasedBy(s string) *JobUpdate {
+	ju.mutation.SetLeasedBy(s)
+	return ju
+}
+
+// SetNillableLeasedBy sets the "leased_by" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLeasedBy(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetLeasedBy(*s)
+	}
+	return ju
+}
+
+// ClearLeasedBy clears the value of the "leased_by" field.
+func (ju *JobUpdate) ClearLeasedBy() *JobUpdate {
+	ju.mutation.ClearLeasedBy()
+	return ju
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (ju *JobUpdate) SetLeaseExpiresAt(t time.Time) *JobUpdate {
+	ju.mutation.SetLeaseExpiresAt(t)
+	return ju
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableLeaseExpiresAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetLeaseExpiresAt(*t)
+	}
+	return ju
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (ju *JobUpdate) ClearLeaseExpiresAt() *JobUpdate {
+	ju.mutation.ClearLeaseExpiresAt()
+	return ju
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (ju *JobUpdate) SetScheduledAt(t time.Time) *JobUpdate {
+	ju.mutation.SetScheduledAt(t)
+	return ju
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableScheduledAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetScheduledAt(*t)
+	}
+	return ju
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ju *JobUpdate) SetCompletedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetCompletedAt(t)
+	return ju
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableCompletedAt(t *time.Time) *JobUpdate {
+	if t != nil {
+		ju.SetCompletedAt(*t)
+	}
+	return ju
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ju *JobUpdate) ClearCompletedAt() *JobUpdate {
+	ju.mutation.ClearCompletedAt()
+	return ju
+}
+
+// SetResultID sets the "result_id" field.
+func (ju *JobUpdate) SetResultID(i int) *JobUpdate {
+	ju.mutation.ResetResultID()
+	ju.mutation.SetResultID(i)
+	return ju
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableResultID(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetResultID(*i)
+	}
+	return ju
+}
+
+// AddResultID adds i to the "result_id" field.
+func (ju *JobUpdate) AddResultID(i int) *JobUpdate {
+	ju.mutation.AddResultID(i)
+	return ju
+}
+
+// ClearResultID clears the value of the "result_id" field.
+func (ju *JobUpdate) ClearResultID() *JobUpdate {
+	ju.mutation.ClearResultID()
+	return ju
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (ju *JobUpdate) SetErrorMessage(s string) *JobUpdate {
+	ju.mutation.SetErrorMessage(s)
+	return ju
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableErrorMessage(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetErrorMessage(*s)
+	}
+	return ju
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (ju *JobUpdate) ClearErrorMessage() *JobUpdate {
+	ju.mutation.ClearErrorMessage()
+	return ju
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (ju *JobUpdate) SetCampaignID(i int) *JobUpdate {
+	ju.mutation.SetCampaignID(i)
+	return ju
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableCampaignID(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetCampaignID(*i)
+	}
+	return ju
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (ju *JobUpdate) ClearCampaignID() *JobUpdate {
+	ju.mutation.ClearCampaignID()
+	return ju
+}
+
+// SetHostID sets the "host" edge to the Host entity by ID.
+func (ju *JobUpdate) SetHostID(id int) *JobUpdate {
+	ju.mutation.SetHostID(id)
+	return ju
+}
+
+// SetNillableHostID sets the "host" edge to the Host entity by ID if the given value is not nil.
+func (ju *JobUpdate) SetNillableHostID(id *int) *JobUpdate {
+	if id != nil {
+		ju = ju.SetHostID(*id)
+	}
+	return ju
+}
+
+// SetHost sets the "host" edge to the Host entity.
+func (ju *JobUpdate) SetHost(h *Host) *JobUpdate {
+	return ju.SetHostID(h.ID)
+}
+
+// SetCampaign sets the "campaign" edge to the Campaign entity.
+func (ju *JobUpdate) SetCampaign(c *Campaign) *JobUpdate {
+	return ju.SetCampaignID(c.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (ju *JobUpdate) Mutation() *JobMutation {
+	return ju.mutation
+}
+
+// ClearHost clears the "host" edge to the Host entity.
+func (ju *JobUpdate) ClearHost() *JobUpdate {
+	ju.mutation.ClearHost()
+	return ju
+}
+
+// ClearCampaign clears the "campaign" edge to the Campaign entity.
+func (ju *JobUpdate) ClearCampaign() *JobUpdate {
+	ju.mutation.ClearCampaign()
+	return ju
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ju *JobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ju.sqlSave, ju.mutation, ju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ju *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := ju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ju *JobUpdate) Exec(ctx context.Context) error {
+	_, err := ju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ju *JobUpdate) ExecX(ctx context.Context) {
+	if err := ju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ju *JobUpdate) check() error {
+	if v, ok := ju.mutation.GetType(); ok {
+		if err := job.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Job.type": %w`, err)}
+		}
+	}
+	if v, ok := ju.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if v, ok := ju.mutation.Trigger(); ok {
+		if err := job.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Job.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ju *JobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobUpdate {
+	ju.modifiers = append(ju.modifiers, modifiers...)
+	return ju
+}
+
+func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	if ps := ju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ju.mutation.GetType(); ok {
+		_spec.SetField(job.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ju.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ju.mutation.DaemonID(); ok {
+		_spec.SetField(job.FieldDaemonID, field.TypeString, value)
+	}
+	if ju.mutation.DaemonIDCleared() {
+		_spec.ClearField(job.FieldDaemonID, field.TypeString)
+	}
+	if value, ok := ju.mutation.TargetDaemonID(); ok {
+		_spec.SetField(job.FieldTargetDaemonID, field.TypeString, value)
+	}
+	if ju.mutation.TargetDaemonIDCleared() {
+		_spec.ClearField(job.FieldTargetDaemonID, field.TypeString)
+	}
+	if value, ok := ju.mutation.TargetAddress(); ok {
+		_spec.SetField(job.FieldTargetAddress, field.TypeString, value)
+	}
+	if ju.mutation.TargetAddressCleared() {
+		_spec.ClearField(job.FieldTargetAddress, field.TypeString)
+	}
+	if value, ok := ju.mutation.DurationSeconds(); ok {
+		_spec.SetField(job.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(job.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if ju.mutation.DurationSecondsCleared() {
+		_spec.ClearField(job.FieldDurationSeconds, field.TypeInt)
+	}
+	if value, ok := ju.mutation.Trigger(); ok {
+		_spec.SetField(job.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := ju.mutation.Priority(); ok {
+		_spec.SetField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedPriority(); ok {
+		_spec.AddField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.LeasedBy(); ok {
+		_spec.SetField(job.FieldLeasedBy, field.TypeString, value)
+	}
+	if ju.mutation.LeasedByCleared() {
+		_spec.ClearField(job.FieldLeasedBy, field.TypeString)
+	}
+	if value, ok := ju.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(job.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if ju.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(job.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := ju.mutation.ScheduledAt(); ok {
+		_spec.SetField(job.FieldScheduledAt, field.TypeTime, value)
+	}
+	if value, ok := ju.mutation.CompletedAt(); ok {
+		_spec.SetField(job.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ju.mutation.CompletedAtCleared() {
+		_spec.ClearField(job.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := ju.mutation.ResultID(); ok {
+		_spec.SetField(job.FieldResultID, field.TypeInt, value)
+	}
+	if value, ok := ju.mutation.AddedResultID(); ok {
+		_spec.AddField(job.FieldResultID, field.TypeInt, value)
+	}
+	if ju.mutation.ResultIDCleared() {
+		_spec.ClearField(job.FieldResultID, field.TypeInt)
+	}
+	if value, ok := ju.mutation.ErrorMessage(); ok {
+		_spec.SetField(job.FieldErrorMessage, field.TypeString, value)
+	}
+	if ju.mutation.ErrorMessageCleared() {
+		_spec.ClearField(job.FieldErrorMessage, field.TypeString)
+	}
+	if ju.mutation.HostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.HostTable,
+			Columns: []string{job.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ju.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.HostTable,
+			Columns: []string{job.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ju.mutation.CampaignCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.CampaignTable,
+			Columns: []string{job.CampaignColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ju.mutation.CampaignIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.CampaignTable,
+			Columns: []string{job.CampaignColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ju.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ju.mutation.done = true
+	return n, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetType sets the "type" field.
+func (juo *JobUpdateOne) SetType(j job.Type) *JobUpdateOne {
+	juo.mutation.SetType(j)
+	return juo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableType(j *job.Type) *JobUpdateOne {
+	if j != nil {
+		juo.SetType(*j)
+	}
+	return juo
+}
+
+// SetStatus sets the "status" field.
+func (juo *JobUpdateOne) SetStatus(j job.Status) *JobUpdateOne {
+	juo.mutation.SetStatus(j)
+	return juo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableStatus(j *job.Status) *JobUpdateOne {
+	if j != nil {
+		juo.SetStatus(*j)
+	}
+	return juo
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (juo *JobUpdateOne) SetDaemonID(s string) *JobUpdateOne {
+	juo.mutation.SetDaemonID(s)
+	return juo
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableDaemonID(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetDaemonID(*s)
+	}
+	return juo
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (juo *JobUpdateOne) ClearDaemonID() *JobUpdateOne {
+	juo.mutation.ClearDaemonID()
+	return juo
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (juo *JobUpdateOne) SetTargetDaemonID(s string) *JobUpdateOne {
+	juo.mutation.SetTargetDaemonID(s)
+	return juo
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableTargetDaemonID(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetTargetDaemonID(*s)
+	}
+	return juo
+}
+
+// ClearTargetDaemonID clears the value of the "target_daemon_id" field.
+func (juo *JobUpdateOne) ClearTargetDaemonID() *JobUpdateOne {
+	juo.mutation.ClearTargetDaemonID()
+	return juo
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (juo *JobUpdateOne) SetTargetAddress(s string) *JobUpdateOne {
+	juo.mutation.SetTargetAddress(s)
+	return juo
+}
+
+// SetNillableTargetAddress sets the "target_address" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableTargetAddress(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetTargetAddress(*s)
+	}
+	return juo
+}
+
+// ClearTargetAddress clears the value of the "target_address" field.
+func (juo *JobUpdateOne) ClearTargetAddress() *JobUpdateOne {
+	juo.mutation.ClearTargetAddress()
+	return juo
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (juo *JobUpdateOne) SetDurationSeconds(i int) *JobUpdateOne {
+	juo.mutation.ResetDurationSeconds()
+	juo.mutation.SetDurationSeconds(i)
+	return juo
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableDurationSeconds(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetDurationSeconds(*i)
+	}
+	return juo
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (juo *JobUpdateOne) AddDurationSeconds(i int) *JobUpdateOne {
+	juo.mutation.AddDurationSeconds(i)
+	return juo
+}
+
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (juo *JobUpdateOne) ClearDurationSeconds() *JobUpdateOne {
+	juo.mutation.ClearDurationSeconds()
+	return juo
+}
+
+// SetTrigger sets the "trigger" field.
+func (juo *JobUpdateOne) SetTrigger(j job.Trigger) *JobUpdateOne {
+	juo.mutation.SetTrigger(j)
+	return juo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableTrigger(j *job.Trigger) *JobUpdateOne {
+	if j != nil {
+		juo.SetTrigger(*j)
+	}
+	return juo
+}
+
+// SetPriority sets the "priority" field.
+func (juo *JobUpdateOne) SetPriority(i int) *JobUpdateOne {
+	juo.mutation.ResetPriority()
+	juo.mutation.SetPriority(i)
+	return juo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillablePriority(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetPriority(*i)
+	}
+	return juo
+}
+
+// AddPriority adds i to the "priority" field.
+func (juo *JobUpdateOne) AddPriority(i int) *JobUpdateOne {
+	juo.mutation.AddPriority(i)
+	return juo
+}
+
+// SetAttempts sets the "attempts" field.
+func (juo *JobUpdateOne) SetAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetAttempts()
+	juo.mutation.SetAttempts(i)
+	return juo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetAttempts(*i)
+	}
+	return juo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (juo *JobUpdateOne) AddAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddAttempts(i)
+	return juo
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (juo *JobUpdateOne) SetMaxAttempts(i int) *JobUpdateOne {
+	juo.mutation.ResetMaxAttempts()
+	juo.mutation.SetMaxAttempts(i)
+	return juo
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableMaxAttempts(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetMaxAttempts(*i)
+	}
+	return juo
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (juo *JobUpdateOne) AddMaxAttempts(i int) *JobUpdateOne {
+	juo.mutation.AddMaxAttempts(i)
+	return juo
+}
+
+// SetLeasedBy sets the "leased_by" field.
+func (juo *JobUpdateOne) SetLeasedBy(s string) *JobUpdateOne {
+	juo.mutation.SetLeasedBy(s)
+	return juo
+}
+
+// SetNillableLeasedBy sets the "leased_by" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLeasedBy(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetLeasedBy(*s)
+	}
+	return juo
+}
+
+// ClearLeasedBy clears the value of the "leased_by" field.
+func (juo *JobUpdateOne) ClearLeasedBy() *JobUpdateOne {
+	juo.mutation.ClearLeasedBy()
+	return juo
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (juo *JobUpdateOne) SetLeaseExpiresAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetLeaseExpiresAt(t)
+	return juo
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableLeaseExpiresAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetLeaseExpiresAt(*t)
+	}
+	return juo
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (juo *JobUpdateOne) ClearLeaseExpiresAt() *JobUpdateOne {
+	juo.mutation.ClearLeaseExpiresAt()
+	return juo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (juo *JobUpdateOne) SetScheduledAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetScheduledAt(t)
+	return juo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableScheduledAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetScheduledAt(*t)
+	}
+	return juo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (juo *JobUpdateOne) SetCompletedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetCompletedAt(t)
+	return juo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableCompletedAt(t *time.Time) *JobUpdateOne {
+	if t != nil {
+		juo.SetCompletedAt(*t)
+	}
+	return juo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (juo *JobUpdateOne) ClearCompletedAt() *JobUpdateOne {
+	juo.mutation.ClearCompletedAt()
+	return juo
+}
+
+// SetResultID sets the "result_id" field.
+func (juo *JobUpdateOne) SetResultID(i int) *JobUpdateOne {
+	juo.mutation.ResetResultID()
+	juo.mutation.SetResultID(i)
+	return juo
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableResultID(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetResultID(*i)
+	}
+	return juo
+}
+
+// AddResultID adds i to the "result_id" field.
+func (juo *JobUpdateOne) AddResultID(i int) *JobUpdateOne {
+	juo.mutation.AddResultID(i)
+	return juo
+}
+
+// ClearResultID clears the value of the "result_id" field.
+func (juo *JobUpdateOne) ClearResultID() *JobUpdateOne {
+	juo.mutation.ClearResultID()
+	return juo
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (juo *JobUpdateOne) SetErrorMessage(s string) *JobUpdateOne {
+	juo.mutation.SetErrorMessage(s)
+	return juo
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableErrorMessage(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetErrorMessage(*s)
+	}
+	return juo
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (juo *JobUpdateOne) ClearErrorMessage() *JobUpdateOne {
+	juo.mutation.ClearErrorMessage()
+	return juo
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (juo *JobUpdateOne) SetCampaignID(i int) *JobUpdateOne {
+	juo.mutation.SetCampaignID(i)
+	return juo
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableCampaignID(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetCampaignID(*i)
+	}
+	return juo
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (juo *JobUpdateOne) ClearCampaignID() *JobUpdateOne {
+	juo.mutation.ClearCampaignID()
+	return juo
+}
+
+// SetHostID sets the "host" edge to the Host entity by ID.
+func (juo *JobUpdateOne) SetHostID(id int) *JobUpdateOne {
+	juo.mutation.SetHostID(id)
+	return juo
+}
+
+// SetNillableHostID sets the "host" edge to the Host entity by ID if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableHostID(id *int) *JobUpdateOne {
+	if id != nil {
+		juo = juo.SetHostID(*id)
+	}
+	return juo
+}
+
+// SetHost sets the "host" edge to the Host entity.
+func (juo *JobUpdateOne) SetHost(h *Host) *JobUpdateOne {
+	return juo.SetHostID(h.ID)
+}
+
+// SetCampaign sets the "campaign" edge to the Campaign entity.
+func (juo *JobUpdateOne) SetCampaign(c *Campaign) *JobUpdateOne {
+	return juo.SetCampaignID(c.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (juo *JobUpdateOne) Mutation() *JobMutation {
+	return juo.mutation
+}
+
+// ClearHost clears the "host" edge to the Host entity.
+func (juo *JobUpdateOne) ClearHost() *JobUpdateOne {
+	juo.mutation.ClearHost()
+	return juo
+}
+
+// ClearCampaign clears the "campaign" edge to the Campaign entity.
+func (juo *JobUpdateOne) ClearCampaign() *JobUpdateOne {
+	juo.mutation.ClearCampaign()
+	return juo
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (juo *JobUpdateOne) Where(ps ...predicate.Job) *JobUpdateOne {
+	juo.mutation.Where(ps...)
+	return juo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (juo *JobUpdateOne) Select(field string, fields ...string) *JobUpdateOne {
+	juo.fields = append([]string{field}, fields...)
+	return juo
+}
+
+// Save executes the query and returns the updated Job entity.
+func (juo *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	return withHooks(ctx, juo.sqlSave, juo.mutation, juo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (juo *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	node, err := juo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (juo *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := juo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (juo *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := juo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (juo *JobUpdateOne) check() error {
+	if v, ok := juo.mutation.GetType(); ok {
+		if err := job.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Job.type": %w`, err)}
+		}
+	}
+	if v, ok := juo.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if v, ok := juo.mutation.Trigger(); ok {
+		if err := job.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Job.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (juo *JobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobUpdateOne {
+	juo.modifiers = append(juo.modifiers, modifiers...)
+	return juo
+}
+
+func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
+	if err := juo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(job.Table, job.Columns, sqlgraph.NewFieldSpec(job.FieldID, field.TypeInt))
+	id, ok := juo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Job.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := juo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, job.FieldID)
+		for _, f := range fields {
+			if !job.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != job.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := juo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := juo.mutation.GetType(); ok {
+		_spec.SetField(job.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := juo.mutation.Status(); ok {
+		_spec.SetField(job.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := juo.mutation.DaemonID(); ok {
+		_spec.SetField(job.FieldDaemonID, field.TypeString, value)
+	}
+	if juo.mutation.DaemonIDCleared() {
+		_spec.ClearField(job.FieldDaemonID, field.TypeString)
+	}
+	if value, ok := juo.mutation.TargetDaemonID(); ok {
+		_spec.SetField(job.FieldTargetDaemonID, field.TypeString, value)
+	}
+	if juo.mutation.TargetDaemonIDCleared() {
+		_spec.ClearField(job.FieldTargetDaemonID, field.TypeString)
+	}
+	if value, ok := juo.mutation.TargetAddress(); ok {
+		_spec.SetField(job.FieldTargetAddress, field.TypeString, value)
+	}
+	if juo.mutation.TargetAddressCleared() {
+		_spec.ClearField(job.FieldTargetAddress, field.TypeString)
+	}
+	if value, ok := juo.mutation.DurationSeconds(); ok {
+		_spec.SetField(job.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(job.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if juo.mutation.DurationSecondsCleared() {
+		_spec.ClearField(job.FieldDurationSeconds, field.TypeInt)
+	}
+	if value, ok := juo.mutation.Trigger(); ok {
+		_spec.SetField(job.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := juo.mutation.Priority(); ok {
+		_spec.SetField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedPriority(); ok {
+		_spec.AddField(job.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.Attempts(); ok {
+		_spec.SetField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedAttempts(); ok {
+		_spec.AddField(job.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.MaxAttempts(); ok {
+		_spec.SetField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(job.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.LeasedBy(); ok {
+		_spec.SetField(job.FieldLeasedBy, field.TypeString, value)
+	}
+	if juo.mutation.LeasedByCleared() {
+		_spec.ClearField(job.FieldLeasedBy, field.TypeString)
+	}
+	if value, ok := juo.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(job.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if juo.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(job.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := juo.mutation.ScheduledAt(); ok {
+		_spec.SetField(job.FieldScheduledAt, field.TypeTime, value)
+	}
+	if value, ok := juo.mutation.CompletedAt(); ok {
+		_spec.SetField(job.FieldCompletedAt, field.TypeTime, value)
+	}
+	if juo.mutation.CompletedAtCleared() {
+		_spec.ClearField(job.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := juo.mutation.ResultID(); ok {
+		_spec.SetField(job.FieldResultID, field.TypeInt, value)
+	}
+	if value, ok := juo.mutation.AddedResultID(); ok {
+		_spec.AddField(job.FieldResultID, field.TypeInt, value)
+	}
+	if juo.mutation.ResultIDCleared() {
+		_spec.ClearField(job.FieldResultID, field.TypeInt)
+	}
+	if value, ok := juo.mutation.ErrorMessage(); ok {
+		_spec.SetField(job.FieldErrorMessage, field.TypeString, value)
+	}
+	if juo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(job.FieldErrorMessage, field.TypeString)
+	}
+	if juo.mutation.HostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.HostTable,
+			Columns: []string{job.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := juo.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.HostTable,
+			Columns: []string{job.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if juo.mutation.CampaignCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.CampaignTable,
+			Columns: []string{job.CampaignColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := juo.mutation.CampaignIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.CampaignTable,
+			Columns: []string{job.CampaignColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(campaign.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(juo.modifiers...)
+	_node = &Job{config: juo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, juo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	juo.mutation.done = true
+	return _node, nil
+}
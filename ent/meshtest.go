@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/google/uuid"
+)
+
+// MeshTest is the model entity for the MeshTest schema.
+type MeshTest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Daemon that ran the iperf3 client
+	SourceDaemonID string `json:"source_daemon_id,omitempty"`
+	// Daemon whose iperf3 server was tested
+	TargetDaemonID string `json:"target_daemon_id,omitempty"`
+	// host:port of the target's iperf3 server at the time of the test
+	TargetAddress string `json:"target_address,omitempty"`
+	// Throughput from source to target in Mbps
+	SentMbps float64 `json:"sent_mbps,omitempty"`
+	// Throughput received by the target in Mbps
+	ReceivedMbps float64 `json:"received_mbps,omitempty"`
+	// Number of retransmits
+	Retransmits float64 `json:"retransmits,omitempty"`
+	// Mean round-trip time in milliseconds
+	MeanRttMs float64 `json:"mean_rtt_ms,omitempty"`
+	// Test duration in seconds
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// Whether the test completed successfully
+	Success bool `json:"success,omitempty"`
+	// Error message if test failed
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Client-generated ID used to deduplicate retried submissions
+	SubmissionID *uuid.UUID `json:"submission_id,omitempty"`
+	// What caused the test to run
+	Trigger meshtest.Trigger `json:"trigger,omitempty"`
+	// Server time the result was received
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// Server clock minus daemon clock in milliseconds as measured by the daemon
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+	// Whether the daemon adjusted the timestamp by clock_offset_ms
+	ClockCorrected bool `json:"clock_corrected,omitempty"`
+	// Timestamp was outside the clock skew window; kept out of the matrix
+	Quarantined  bool `json:"quarantined,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MeshTest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case meshtest.FieldSubmissionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case meshtest.FieldSuccess, meshtest.FieldClockCorrected, meshtest.FieldQuarantined:
+			values[i] = new(sql.NullBool)
+		case meshtest.FieldSentMbps, meshtest.FieldReceivedMbps, meshtest.FieldRetransmits, meshtest.FieldMeanRttMs:
+			values[i] = new(sql.NullFloat64)
+		case meshtest.FieldID, meshtest.FieldDurationSeconds, meshtest.FieldClockOffsetMs:
+			values[i] = new(sql.NullInt64)
+		case meshtest.FieldSourceDaemonID, meshtest.FieldTargetDaemonID, meshtest.FieldTargetAddress, meshtest.FieldErrorMessage, meshtest.FieldTrigger:
+			values[i] = new(sql.NullString)
+		case meshtest.FieldTimestamp, meshtest.FieldReceivedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MeshTest fields.
+func (mt *MeshTest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case meshtest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mt.ID = int(value.Int64)
+		case meshtest.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				mt.Timestamp = value.Time
+			}
+		case meshtest.FieldSourceDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_daemon_id", values[i])
+			} else if value.Valid {
+				mt.SourceDaemonID = value.String
+			}
+		case meshtest.FieldTargetDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_daemon_id", values[i])
+			} else if value.Valid {
+				mt.TargetDaemonID = value.String
+			}
+		case meshtest.FieldTargetAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_address", values[i])
+			} else if value.Valid {
+				mt.TargetAddress = value.String
+			}
+		case meshtest.FieldSentMbps:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_mbps", values[i])
+			} else if value.Valid {
+				mt.SentMbps = value.Float64
+			}
+		case meshtest.FieldReceivedMbps:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field received_mbps", values[i])
+			} else if value.Valid {
+				mt.ReceivedMbps = value.Float64
+			}
+		case meshtest.FieldRetransmits:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field retransmits", values[i])
+			} else if value.Valid {
+				mt.Retransmits = value.Float64
+			}
+		case meshtest.FieldMeanRttMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field mean_rtt_ms", values[i])
+			} else if value.Valid {
+				mt.MeanRttMs = value.Float64
+			}
+		case meshtest.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				mt.DurationSeconds = int(value.Int64)
+			}
+		case meshtest.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				mt.Success = value.Bool
+			}
+		case meshtest.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				mt.ErrorMessage = value.String
+			}
+		case meshtest.FieldSubmissionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_id", values[i])
+			} else if value.Valid {
+				mt.SubmissionID = new(uuid.UUID)
+				*mt.SubmissionID = *value.S.(*uuid.UUID)
+			}
+		case meshtest.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				mt.Trigger = meshtest.Trigger(value.String)
+			}
+		case meshtest.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				mt.ReceivedAt = value.Time
+			}
+		case meshtest.FieldClockOffsetMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_offset_ms", values[i])
+			} else if value.Valid {
+				mt.ClockOffsetMs = new(int64)
+				*mt.ClockOffsetMs = value.Int64
+			}
+		case meshtest.FieldClockCorrected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_corrected", values[i])
+			} else if value.Valid {
+				mt.ClockCorrected = value.Bool
+			}
+		case meshtest.FieldQuarantined:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantined", values[i])
+			} else if value.Valid {
+				mt.Quarantined = value.Bool
+			}
+		default:
+			mt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MeshTest.
+// This includes values selected through modifiers, order, etc.
+func (mt *MeshTest) Value(name string) (ent.Value, error) {
+	return mt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MeshTest.
+// Note that you need to call MeshTest.Unwrap() before calling this method if this MeshTest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mt *MeshTest) Update() *MeshTestUpdateOne {
+	return NewMeshTestClient(mt.config).UpdateOne(mt)
+}
+
+// Unwrap unwraps the MeshTest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mt *MeshTest) Unwrap() *MeshTest {
+	_tx, ok := mt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MeshTest is not a transactional entity")
+	}
+	mt.config.driver = _tx.drv
+	return mt
+}
+
+// String implements the fmt.Stringer.
+func (mt *MeshTest) String() string {
+	var builder strings.Builder
+	builder.WriteString("MeshTest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mt.ID))
+	builder.WriteString("timestamp=")
+	builder.WriteString(mt.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source_daemon_id=")
+	builder.WriteString(mt.SourceDaemonID)
+	builder.WriteString(", ")
+	builder.WriteString("target_daemon_id=")
+	builder.WriteString(mt.TargetDaemonID)
+	builder.WriteString(", ")
+	builder.WriteString("target_address=")
+	builder.WriteString(mt.TargetAddress)
+	builder.WriteString(", ")
+	builder.WriteString("sent_mbps=")
+	builder.WriteString(fmt.Sprintf("%v", mt.SentMbps))
+	builder.WriteString(", ")
+	builder.WriteString("received_mbps=")
+	builder.WriteString(fmt.Sprintf("%v", mt.ReceivedMbps))
+	builder.WriteString(", ")
+	builder.WriteString("retransmits=")
+	builder.WriteString(fmt.Sprintf("%v", mt.Retransmits))
+	builder.WriteString(", ")
+	builder.WriteString("mean_rtt_ms=")
+	builder.WriteString(fmt.Sprintf("%v", mt.MeanRttMs))
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", mt.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", mt.Success))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(mt.ErrorMessage)
+	builder.WriteString(", ")
+	if v := mt.SubmissionID; v != nil {
+		builder.WriteString("submission_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", mt.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(mt.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := mt.ClockOffsetMs; v != nil {
+		builder.WriteString("clock_offset_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clock_corrected=")
+	builder.WriteString(fmt.Sprintf("%v", mt.ClockCorrected))
+	builder.WriteString(", ")
+	builder.WriteString("quarantined=")
+	builder.WriteString(fmt.Sprintf("%v", mt.Quarantined))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MeshTests is a parsable slice of MeshTest.
+type MeshTests []*MeshTest
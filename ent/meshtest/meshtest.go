@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package meshtest
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the meshtest type in the database.
+	Label = "mesh_test"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldSourceDaemonID holds the string denoting the source_daemon_id field in the database.
+	FieldSourceDaemonID = "source_daemon_id"
+	// FieldTargetDaemonID holds the string denoting the target_daemon_id field in the database.
+	FieldTargetDaemonID = "target_daemon_id"
+	// FieldTargetAddress holds the string denoting the target_address field in the database.
+	FieldTargetAddress = "target_address"
+	// FieldSentMbps holds the string denoting the sent_mbps field in the database.
+	FieldSentMbps = "sent_mbps"
+	// FieldReceivedMbps holds the string denoting the received_mbps field in the database.
+	FieldReceivedMbps = "received_mbps"
+	// FieldRetransmits holds the string denoting the retransmits field in the database.
+	FieldRetransmits = "retransmits"
+	// FieldMeanRttMs holds the string denoting the mean_rtt_ms field in the database.
+	FieldMeanRttMs = "mean_rtt_ms"
+	// FieldDurationSeconds holds the string denoting the duration_seconds field in the database.
+	FieldDurationSeconds = "duration_seconds"
+	// FieldSuccess holds the string denoting the success field in the database.
+	FieldSuccess = "success"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldSubmissionID holds the string denoting the submission_id field in the database.
+	FieldSubmissionID = "submission_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldClockOffsetMs holds the string denoting the clock_offset_ms field in the database.
+	FieldClockOffsetMs = "clock_offset_ms"
+	// FieldClockCorrected holds the string denoting the clock_corrected field in the database.
+	FieldClockCorrected = "clock_corrected"
+	// FieldQuarantined holds the string denoting the quarantined field in the database.
+	FieldQuarantined = "quarantined"
+	// Table holds the table name of the meshtest in the database.
+	Table = "mesh_tests"
+)
+
+// Columns holds all SQL columns for meshtest fields.
+var Columns = []string{
+	FieldID,
+	FieldTimestamp,
+	FieldSourceDaemonID,
+	FieldTargetDaemonID,
+	FieldTargetAddress,
+	FieldSentMbps,
+	FieldReceivedMbps,
+	FieldRetransmits,
+	FieldMeanRttMs,
+	FieldDurationSeconds,
+	FieldSuccess,
+	FieldErrorMessage,
+	FieldSubmissionID,
+	FieldTrigger,
+	FieldReceivedAt,
+	FieldClockOffsetMs,
+	FieldClockCorrected,
+	FieldQuarantined,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+	// SourceDaemonIDValidator is a validator for the "source_daemon_id" field. It is called by the builders before save.
+	SourceDaemonIDValidator func(string) error
+	// TargetDaemonIDValidator is a validator for the "target_daemon_id" field. It is called by the builders before save.
+	TargetDaemonIDValidator func(string) error
+	// DefaultDurationSeconds holds the default value on creation for the "duration_seconds" field.
+	DefaultDurationSeconds int
+	// DefaultSuccess holds the default value on creation for the "success" field.
+	DefaultSuccess bool
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultClockCorrected holds the default value on creation for the "clock_corrected" field.
+	DefaultClockCorrected bool
+	// DefaultQuarantined holds the default value on creation for the "quarantined" field.
+	DefaultQuarantined bool
+)
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerScheduled is the default value of the Trigger enum.
+const DefaultTrigger = TriggerScheduled
+
+// Trigger values.
+const (
+	TriggerScheduled Trigger = "scheduled"
+	TriggerManual    Trigger = "manual"
+	TriggerAdaptive  Trigger = "adaptive"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerScheduled, TriggerManual, TriggerAdaptive:
+		return nil
+	default:
+		return fmt.Errorf("meshtest: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the MeshTest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// BySourceDaemonID orders the results by the source_daemon_id field.
+func BySourceDaemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceDaemonID, opts...).ToFunc()
+}
+
+// ByTargetDaemonID orders the results by the target_daemon_id field.
+func ByTargetDaemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetDaemonID, opts...).ToFunc()
+}
+
+// ByTargetAddress orders the results by the target_address field.
+func ByTargetAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetAddress, opts...).ToFunc()
+}
+
+// BySentMbps orders the results by the sent_mbps field.
+func BySentMbps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentMbps, opts...).ToFunc()
+}
+
+// ByReceivedMbps orders the results by the received_mbps field.
+func ByReceivedMbps(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedMbps, opts...).ToFunc()
+}
+
+// ByRetransmits orders the results by the retransmits field.
+func ByRetransmits(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetransmits, opts...).ToFunc()
+}
+
+// ByMeanRttMs orders the results by the mean_rtt_ms field.
+func ByMeanRttMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeanRttMs, opts...).ToFunc()
+}
+
+// ByDurationSeconds orders the results by the duration_seconds field.
+func ByDurationSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationSeconds, opts...).ToFunc()
+}
+
+// BySuccess orders the results by the success field.
+func BySuccess(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuccess, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// BySubmissionID orders the results by the submission_id field.
+func BySubmissionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByClockOffsetMs orders the results by the clock_offset_ms field.
+func ByClockOffsetMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockOffsetMs, opts...).ToFunc()
+}
+
+// ByClockCorrected orders the results by the clock_corrected field.
+func ByClockCorrected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockCorrected, opts...).ToFunc()
+}
+
+// ByQuarantined orders the results by the quarantined field.
+func ByQuarantined(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantined, opts...).ToFunc()
+}
@@ -0,0 +1,881 @@
+// Code generated by ent, DO NOT EDIT.
+
+package meshtest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldID, id))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// SourceDaemonID applies equality check predicate on the "source_daemon_id" field. It's identical to SourceDaemonIDEQ.
+func SourceDaemonID(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSourceDaemonID, v))
+}
+
+// TargetDaemonID applies equality check predicate on the "target_daemon_id" field. It's identical to TargetDaemonIDEQ.
+func TargetDaemonID(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTargetDaemonID, v))
+}
+
+// TargetAddress applies equality check predicate on the "target_address" field. It's identical to TargetAddressEQ.
+func TargetAddress(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTargetAddress, v))
+}
+
+// SentMbps applies equality check predicate on the "sent_mbps" field. It's identical to SentMbpsEQ.
+func SentMbps(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSentMbps, v))
+}
+
+// ReceivedMbps applies equality check predicate on the "received_mbps" field. It's identical to ReceivedMbpsEQ.
+func ReceivedMbps(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldReceivedMbps, v))
+}
+
+// Retransmits applies equality check predicate on the "retransmits" field. It's identical to RetransmitsEQ.
+func Retransmits(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldRetransmits, v))
+}
+
+// MeanRttMs applies equality check predicate on the "mean_rtt_ms" field. It's identical to MeanRttMsEQ.
+func MeanRttMs(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldMeanRttMs, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSuccess, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// SubmissionID applies equality check predicate on the "submission_id" field. It's identical to SubmissionIDEQ.
+func SubmissionID(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ClockOffsetMs applies equality check predicate on the "clock_offset_ms" field. It's identical to ClockOffsetMsEQ.
+func ClockOffsetMs(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldClockOffsetMs, v))
+}
+
+// ClockCorrected applies equality check predicate on the "clock_corrected" field. It's identical to ClockCorrectedEQ.
+func ClockCorrected(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldClockCorrected, v))
+}
+
+// Quarantined applies equality check predicate on the "quarantined" field. It's identical to QuarantinedEQ.
+func Quarantined(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldQuarantined, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// SourceDaemonIDEQ applies the EQ predicate on the "source_daemon_id" field.
+func SourceDaemonIDEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDNEQ applies the NEQ predicate on the "source_daemon_id" field.
+func SourceDaemonIDNEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDIn applies the In predicate on the "source_daemon_id" field.
+func SourceDaemonIDIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldSourceDaemonID, vs...))
+}
+
+// SourceDaemonIDNotIn applies the NotIn predicate on the "source_daemon_id" field.
+func SourceDaemonIDNotIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldSourceDaemonID, vs...))
+}
+
+// SourceDaemonIDGT applies the GT predicate on the "source_daemon_id" field.
+func SourceDaemonIDGT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDGTE applies the GTE predicate on the "source_daemon_id" field.
+func SourceDaemonIDGTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDLT applies the LT predicate on the "source_daemon_id" field.
+func SourceDaemonIDLT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDLTE applies the LTE predicate on the "source_daemon_id" field.
+func SourceDaemonIDLTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDContains applies the Contains predicate on the "source_daemon_id" field.
+func SourceDaemonIDContains(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContains(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDHasPrefix applies the HasPrefix predicate on the "source_daemon_id" field.
+func SourceDaemonIDHasPrefix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasPrefix(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDHasSuffix applies the HasSuffix predicate on the "source_daemon_id" field.
+func SourceDaemonIDHasSuffix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasSuffix(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDEqualFold applies the EqualFold predicate on the "source_daemon_id" field.
+func SourceDaemonIDEqualFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEqualFold(FieldSourceDaemonID, v))
+}
+
+// SourceDaemonIDContainsFold applies the ContainsFold predicate on the "source_daemon_id" field.
+func SourceDaemonIDContainsFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContainsFold(FieldSourceDaemonID, v))
+}
+
+// TargetDaemonIDEQ applies the EQ predicate on the "target_daemon_id" field.
+func TargetDaemonIDEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDNEQ applies the NEQ predicate on the "target_daemon_id" field.
+func TargetDaemonIDNEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDIn applies the In predicate on the "target_daemon_id" field.
+func TargetDaemonIDIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldTargetDaemonID, vs...))
+}
+
+// TargetDaemonIDNotIn applies the NotIn predicate on the "target_daemon_id" field.
+func TargetDaemonIDNotIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldTargetDaemonID, vs...))
+}
+
+// TargetDaemonIDGT applies the GT predicate on the "target_daemon_id" field.
+func TargetDaemonIDGT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDGTE applies the GTE predicate on the "target_daemon_id" field.
+func TargetDaemonIDGTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDLT applies the LT predicate on the "target_daemon_id" field.
+func TargetDaemonIDLT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDLTE applies the LTE predicate on the "target_daemon_id" field.
+func TargetDaemonIDLTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDContains applies the Contains predicate on the "target_daemon_id" field.
+func TargetDaemonIDContains(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContains(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDHasPrefix applies the HasPrefix predicate on the "target_daemon_id" field.
+func TargetDaemonIDHasPrefix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasPrefix(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDHasSuffix applies the HasSuffix predicate on the "target_daemon_id" field.
+func TargetDaemonIDHasSuffix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasSuffix(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDEqualFold applies the EqualFold predicate on the "target_daemon_id" field.
+func TargetDaemonIDEqualFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEqualFold(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDContainsFold applies the ContainsFold predicate on the "target_daemon_id" field.
+func TargetDaemonIDContainsFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContainsFold(FieldTargetDaemonID, v))
+}
+
+// TargetAddressEQ applies the EQ predicate on the "target_address" field.
+func TargetAddressEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTargetAddress, v))
+}
+
+// TargetAddressNEQ applies the NEQ predicate on the "target_address" field.
+func TargetAddressNEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldTargetAddress, v))
+}
+
+// TargetAddressIn applies the In predicate on the "target_address" field.
+func TargetAddressIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldTargetAddress, vs...))
+}
+
+// TargetAddressNotIn applies the NotIn predicate on the "target_address" field.
+func TargetAddressNotIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldTargetAddress, vs...))
+}
+
+// TargetAddressGT applies the GT predicate on the "target_address" field.
+func TargetAddressGT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldTargetAddress, v))
+}
+
+// TargetAddressGTE applies the GTE predicate on the "target_address" field.
+func TargetAddressGTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldTargetAddress, v))
+}
+
+// TargetAddressLT applies the LT predicate on the "target_address" field.
+func TargetAddressLT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldTargetAddress, v))
+}
+
+// TargetAddressLTE applies the LTE predicate on the "target_address" field.
+func TargetAddressLTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldTargetAddress, v))
+}
+
+// TargetAddressContains applies the Contains predicate on the "target_address" field.
+func TargetAddressContains(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContains(FieldTargetAddress, v))
+}
+
+// TargetAddressHasPrefix applies the HasPrefix predicate on the "target_address" field.
+func TargetAddressHasPrefix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasPrefix(FieldTargetAddress, v))
+}
+
+// TargetAddressHasSuffix applies the HasSuffix predicate on the "target_address" field.
+func TargetAddressHasSuffix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasSuffix(FieldTargetAddress, v))
+}
+
+// TargetAddressIsNil applies the IsNil predicate on the "target_address" field.
+func TargetAddressIsNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIsNull(FieldTargetAddress))
+}
+
+// TargetAddressNotNil applies the NotNil predicate on the "target_address" field.
+func TargetAddressNotNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotNull(FieldTargetAddress))
+}
+
+// TargetAddressEqualFold applies the EqualFold predicate on the "target_address" field.
+func TargetAddressEqualFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEqualFold(FieldTargetAddress, v))
+}
+
+// TargetAddressContainsFold applies the ContainsFold predicate on the "target_address" field.
+func TargetAddressContainsFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContainsFold(FieldTargetAddress, v))
+}
+
+// SentMbpsEQ applies the EQ predicate on the "sent_mbps" field.
+func SentMbpsEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSentMbps, v))
+}
+
+// SentMbpsNEQ applies the NEQ predicate on the "sent_mbps" field.
+func SentMbpsNEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldSentMbps, v))
+}
+
+// SentMbpsIn applies the In predicate on the "sent_mbps" field.
+func SentMbpsIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldSentMbps, vs...))
+}
+
+// SentMbpsNotIn applies the NotIn predicate on the "sent_mbps" field.
+func SentMbpsNotIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldSentMbps, vs...))
+}
+
+// SentMbpsGT applies the GT predicate on the "sent_mbps" field.
+func SentMbpsGT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldSentMbps, v))
+}
+
+// SentMbpsGTE applies the GTE predicate on the "sent_mbps" field.
+func SentMbpsGTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldSentMbps, v))
+}
+
+// SentMbpsLT applies the LT predicate on the "sent_mbps" field.
+func SentMbpsLT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldSentMbps, v))
+}
+
+// SentMbpsLTE applies the LTE predicate on the "sent_mbps" field.
+func SentMbpsLTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldSentMbps, v))
+}
+
+// ReceivedMbpsEQ applies the EQ predicate on the "received_mbps" field.
+func ReceivedMbpsEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldReceivedMbps, v))
+}
+
+// ReceivedMbpsNEQ applies the NEQ predicate on the "received_mbps" field.
+func ReceivedMbpsNEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldReceivedMbps, v))
+}
+
+// ReceivedMbpsIn applies the In predicate on the "received_mbps" field.
+func ReceivedMbpsIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldReceivedMbps, vs...))
+}
+
+// ReceivedMbpsNotIn applies the NotIn predicate on the "received_mbps" field.
+func ReceivedMbpsNotIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldReceivedMbps, vs...))
+}
+
+// ReceivedMbpsGT applies the GT predicate on the "received_mbps" field.
+func ReceivedMbpsGT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldReceivedMbps, v))
+}
+
+// ReceivedMbpsGTE applies the GTE predicate on the "received_mbps" field.
+func ReceivedMbpsGTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldReceivedMbps, v))
+}
+
+// ReceivedMbpsLT applies the LT predicate on the "received_mbps" field.
+func ReceivedMbpsLT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldReceivedMbps, v))
+}
+
+// ReceivedMbpsLTE applies the LTE predicate on the "received_mbps" field.
+func ReceivedMbpsLTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldReceivedMbps, v))
+}
+
+// RetransmitsEQ applies the EQ predicate on the "retransmits" field.
+func RetransmitsEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldRetransmits, v))
+}
+
+// RetransmitsNEQ applies the NEQ predicate on the "retransmits" field.
+func RetransmitsNEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldRetransmits, v))
+}
+
+// RetransmitsIn applies the In predicate on the "retransmits" field.
+func RetransmitsIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldRetransmits, vs...))
+}
+
+// RetransmitsNotIn applies the NotIn predicate on the "retransmits" field.
+func RetransmitsNotIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldRetransmits, vs...))
+}
+
+// RetransmitsGT applies the GT predicate on the "retransmits" field.
+func RetransmitsGT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldRetransmits, v))
+}
+
+// RetransmitsGTE applies the GTE predicate on the "retransmits" field.
+func RetransmitsGTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldRetransmits, v))
+}
+
+// RetransmitsLT applies the LT predicate on the "retransmits" field.
+func RetransmitsLT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldRetransmits, v))
+}
+
+// RetransmitsLTE applies the LTE predicate on the "retransmits" field.
+func RetransmitsLTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldRetransmits, v))
+}
+
+// RetransmitsIsNil applies the IsNil predicate on the "retransmits" field.
+func RetransmitsIsNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIsNull(FieldRetransmits))
+}
+
+// RetransmitsNotNil applies the NotNil predicate on the "retransmits" field.
+func RetransmitsNotNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotNull(FieldRetransmits))
+}
+
+// MeanRttMsEQ applies the EQ predicate on the "mean_rtt_ms" field.
+func MeanRttMsEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldMeanRttMs, v))
+}
+
+// MeanRttMsNEQ applies the NEQ predicate on the "mean_rtt_ms" field.
+func MeanRttMsNEQ(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldMeanRttMs, v))
+}
+
+// MeanRttMsIn applies the In predicate on the "mean_rtt_ms" field.
+func MeanRttMsIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldMeanRttMs, vs...))
+}
+
+// MeanRttMsNotIn applies the NotIn predicate on the "mean_rtt_ms" field.
+func MeanRttMsNotIn(vs ...float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldMeanRttMs, vs...))
+}
+
+// MeanRttMsGT applies the GT predicate on the "mean_rtt_ms" field.
+func MeanRttMsGT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldMeanRttMs, v))
+}
+
+// MeanRttMsGTE applies the GTE predicate on the "mean_rtt_ms" field.
+func MeanRttMsGTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldMeanRttMs, v))
+}
+
+// MeanRttMsLT applies the LT predicate on the "mean_rtt_ms" field.
+func MeanRttMsLT(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldMeanRttMs, v))
+}
+
+// MeanRttMsLTE applies the LTE predicate on the "mean_rtt_ms" field.
+func MeanRttMsLTE(v float64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldMeanRttMs, v))
+}
+
+// MeanRttMsIsNil applies the IsNil predicate on the "mean_rtt_ms" field.
+func MeanRttMsIsNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIsNull(FieldMeanRttMs))
+}
+
+// MeanRttMsNotNil applies the NotNil predicate on the "mean_rtt_ms" field.
+func MeanRttMsNotNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotNull(FieldMeanRttMs))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// SubmissionIDEQ applies the EQ predicate on the "submission_id" field.
+func SubmissionIDEQ(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDNEQ applies the NEQ predicate on the "submission_id" field.
+func SubmissionIDNEQ(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDIn applies the In predicate on the "submission_id" field.
+func SubmissionIDIn(vs ...uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDNotIn applies the NotIn predicate on the "submission_id" field.
+func SubmissionIDNotIn(vs ...uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDGT applies the GT predicate on the "submission_id" field.
+func SubmissionIDGT(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldSubmissionID, v))
+}
+
+// SubmissionIDGTE applies the GTE predicate on the "submission_id" field.
+func SubmissionIDGTE(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDLT applies the LT predicate on the "submission_id" field.
+func SubmissionIDLT(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldSubmissionID, v))
+}
+
+// SubmissionIDLTE applies the LTE predicate on the "submission_id" field.
+func SubmissionIDLTE(v uuid.UUID) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDIsNil applies the IsNil predicate on the "submission_id" field.
+func SubmissionIDIsNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIsNull(FieldSubmissionID))
+}
+
+// SubmissionIDNotNil applies the NotNil predicate on the "submission_id" field.
+func SubmissionIDNotNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotNull(FieldSubmissionID))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// ClockOffsetMsEQ applies the EQ predicate on the "clock_offset_ms" field.
+func ClockOffsetMsEQ(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsNEQ applies the NEQ predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNEQ(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsIn applies the In predicate on the "clock_offset_ms" field.
+func ClockOffsetMsIn(vs ...int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIn(FieldClockOffsetMs, vs...))
+}
+
+// ClockOffsetMsNotIn applies the NotIn predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNotIn(vs ...int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotIn(FieldClockOffsetMs, vs...))
+}
+
+// ClockOffsetMsGT applies the GT predicate on the "clock_offset_ms" field.
+func ClockOffsetMsGT(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGT(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsGTE applies the GTE predicate on the "clock_offset_ms" field.
+func ClockOffsetMsGTE(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldGTE(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsLT applies the LT predicate on the "clock_offset_ms" field.
+func ClockOffsetMsLT(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLT(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsLTE applies the LTE predicate on the "clock_offset_ms" field.
+func ClockOffsetMsLTE(v int64) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldLTE(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsIsNil applies the IsNil predicate on the "clock_offset_ms" field.
+func ClockOffsetMsIsNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldIsNull(FieldClockOffsetMs))
+}
+
+// ClockOffsetMsNotNil applies the NotNil predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNotNil() predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNotNull(FieldClockOffsetMs))
+}
+
+// ClockCorrectedEQ applies the EQ predicate on the "clock_corrected" field.
+func ClockCorrectedEQ(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldClockCorrected, v))
+}
+
+// ClockCorrectedNEQ applies the NEQ predicate on the "clock_corrected" field.
+func ClockCorrectedNEQ(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldClockCorrected, v))
+}
+
+// QuarantinedEQ applies the EQ predicate on the "quarantined" field.
+func QuarantinedEQ(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldEQ(FieldQuarantined, v))
+}
+
+// QuarantinedNEQ applies the NEQ predicate on the "quarantined" field.
+func QuarantinedNEQ(v bool) predicate.MeshTest {
+	return predicate.MeshTest(sql.FieldNEQ(FieldQuarantined, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MeshTest) predicate.MeshTest {
+	return predicate.MeshTest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MeshTest) predicate.MeshTest {
+	return predicate.MeshTest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MeshTest) predicate.MeshTest {
+	return predicate.MeshTest(sql.NotPredicates(p))
+}
@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/google/uuid"
+)
+
+// MeshTestCreate is the builder for creating a MeshTest entity.
+type MeshTestCreate struct {
+	config
+	mutation *MeshTestMutation
+	hooks    []Hook
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (mtc *MeshTestCreate) SetTimestamp(t time.Time) *MeshTestCreate {
+	mtc.mutation.SetTimestamp(t)
+	return mtc
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableTimestamp(t *time.Time) *MeshTestCreate {
+	if t != nil {
+		mtc.SetTimestamp(*t)
+	}
+	return mtc
+}
+
+// SetSourceDaemonID sets the "source_daemon_id" field.
+func (mtc *MeshTestCreate) SetSourceDaemonID(s string) *MeshTestCreate {
+	mtc.mutation.SetSourceDaemonID(s)
+	return mtc
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (mtc *MeshTestCreate) SetTargetDaemonID(s string) *MeshTestCreate {
+	mtc.mutation.SetTargetDaemonID(s)
+	return mtc
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (mtc *MeshTestCreate) SetTargetAddress(s string) *MeshTestCreate {
+	mtc.mutation.SetTargetAddress(s)
+	return mtc
+}
+
+// SetNillableTargetAddress sets the "target_address" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableTargetAddress(s *string) *MeshTestCreate {
+	if s != nil {
+		mtc.SetTargetAddress(*s)
+	}
+	return mtc
+}
+
+// SetSentMbps sets the "sent_mbps" field.
+func (mtc *MeshTestCreate) SetSentMbps(f float64) *MeshTestCreate {
+	mtc.mutation.SetSentMbps(f)
+	return mtc
+}
+
+// SetReceivedMbps sets the "received_mbps" field.
+func (mtc *MeshTestCreate) SetReceivedMbps(f float64) *MeshTestCreate {
+	mtc.mutation.SetReceivedMbps(f)
+	return mtc
+}
+
+// SetRetransmits sets the "retransmits" field.
+func (mtc *MeshTestCreate) SetRetransmits(f float64) *MeshTestCreate {
+	mtc.mutation.SetRetransmits(f)
+	return mtc
+}
+
+// SetNillableRetransmits sets the "retransmits" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableRetransmits(f *float64) *MeshTestCreate {
+	if f != nil {
+		mtc.SetRetransmits(*f)
+	}
+	return mtc
+}
+
+// SetMeanRttMs sets the "mean_rtt_ms" field.
+func (mtc *MeshTestCreate) SetMeanRttMs(f float64) *MeshTestCreate {
+	mtc.mutation.SetMeanRttMs(f)
+	return mtc
+}
+
+// SetNillableMeanRttMs sets the "mean_rtt_ms" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableMeanRttMs(f *float64) *MeshTestCreate {
+	if f != nil {
+		mtc.SetMeanRttMs(*f)
+	}
+	return mtc
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (mtc *MeshTestCreate) SetDurationSeconds(i int) *MeshTestCreate {
+	mtc.mutation.SetDurationSeconds(i)
+	return mtc
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableDurationSeconds(i *int) *MeshTestCreate {
+	if i != nil {
+		mtc.SetDurationSeconds(*i)
+	}
+	return mtc
+}
+
+// SetSuccess sets the "success" field.
+func (mtc *MeshTestCreate) SetSuccess(b bool) *MeshTestCreate {
+	mtc.mutation.SetSuccess(b)
+	return mtc
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableSuccess(b *bool) *MeshTestCreate {
+	if b != nil {
+		mtc.SetSuccess(*b)
+	}
+	return mtc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (mtc *MeshTestCreate) SetErrorMessage(s string) *MeshTestCreate {
+	mtc.mutation.SetErrorMessage(s)
+	return mtc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableErrorMessage(s *string) *MeshTestCreate {
+	if s != nil {
+		mtc.SetErrorMessage(*s)
+	}
+	return mtc
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (mtc *MeshTestCreate) SetSubmissionID(u uuid.UUID) *MeshTestCreate {
+	mtc.mutation.SetSubmissionID(u)
+	return mtc
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableSubmissionID(u *uuid.UUID) *MeshTestCreate {
+	if u != nil {
+		mtc.SetSubmissionID(*u)
+	}
+	return mtc
+}
+
+// SetTrigger sets the "trigger" field.
+func (mtc *MeshTestCreate) SetTrigger(m meshtest.Trigger) *MeshTestCreate {
+	mtc.mutation.SetTrigger(m)
+	return mtc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableTrigger(m *meshtest.Trigger) *MeshTestCreate {
+	if m != nil {
+		mtc.SetTrigger(*m)
+	}
+	return mtc
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (mtc *MeshTestCreate) SetReceivedAt(t time.Time) *MeshTestCreate {
+	mtc.mutation.SetReceivedAt(t)
+	return mtc
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableReceivedAt(t *time.Time) *MeshTestCreate {
+	if t != nil {
+		mtc.SetReceivedAt(*t)
+	}
+	return mtc
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (mtc *MeshTestCreate) SetClockOffsetMs(i int64) *MeshTestCreate {
+	mtc.mutation.SetClockOffsetMs(i)
+	return mtc
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableClockOffsetMs(i *int64) *MeshTestCreate {
+	if i != nil {
+		mtc.SetClockOffsetMs(*i)
+	}
+	return mtc
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (mtc *MeshTestCreate) SetClockCorrected(b bool) *MeshTestCreate {
+	mtc.mutation.SetClockCorrected(b)
+	return mtc
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableClockCorrected(b *bool) *MeshTestCreate {
+	if b != nil {
+		mtc.SetClockCorrected(*b)
+	}
+	return mtc
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (mtc *MeshTestCreate) SetQuarantined(b bool) *MeshTestCreate {
+	mtc.mutation.SetQuarantined(b)
+	return mtc
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (mtc *MeshTestCreate) SetNillableQuarantined(b *bool) *MeshTestCreate {
+	if b != nil {
+		mtc.SetQuarantined(*b)
+	}
+	return mtc
+}
+
+// Mutation returns the MeshTestMutation object of the builder.
+func (mtc *MeshTestCreate) Mutation() *MeshTestMutation {
+	return mtc.mutation
+}
+
+// Save creates the MeshTest in the database.
+func (mtc *MeshTestCreate) Save(ctx context.Context) (*MeshTest, error) {
+	mtc.defaults()
+	return withHooks(ctx, mtc.sqlSave, mtc.mutation, mtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mtc *MeshTestCreate) SaveX(ctx context.Context) *MeshTest {
+	v, err := mtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtc *MeshTestCreate) Exec(ctx context.Context) error {
+	_, err := mtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtc *MeshTestCreate) ExecX(ctx context.Context) {
+	if err := mtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtc *MeshTestCreate) defaults() {
+	if _, ok := mtc.mutation.Timestamp(); !ok {
+		v := meshtest.DefaultTimestamp()
+		mtc.mutation.SetTimestamp(v)
+	}
+	if _, ok := mtc.mutation.DurationSeconds(); !ok {
+		v := meshtest.DefaultDurationSeconds
+		mtc.mutation.SetDurationSeconds(v)
+	}
+	if _, ok := mtc.mutation.Success(); !ok {
+		v := meshtest.DefaultSuccess
+		mtc.mutation.SetSuccess(v)
+	}
+	if _, ok := mtc.mutation.Trigger(); !ok {
+		v := meshtest.DefaultTrigger
+		mtc.mutation.SetTrigger(v)
+	}
+	if _, ok := mtc.mutation.ReceivedAt(); !ok {
+		v := meshtest.DefaultReceivedAt()
+		mtc.mutation.SetReceivedAt(v)
+	}
+	if _, ok := mtc.mutation.ClockCorrected(); !ok {
+		v := meshtest.DefaultClockCorrected
+		mtc.mutation.SetClockCorrected(v)
+	}
+	if _, ok := mtc.mutation.Quarantined(); !ok {
+		v := meshtest.DefaultQuarantined
+		mtc.mutation.SetQuarantined(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtc *MeshTestCreate) check() error {
+	if _, ok := mtc.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "MeshTest.timestamp"`)}
+	}
+	if _, ok := mtc.mutation.SourceDaemonID(); !ok {
+		return &ValidationError{Name: "source_daemon_id", err: errors.New(`ent: missing required field "MeshTest.source_daemon_id"`)}
+	}
+	if v, ok := mtc.mutation.SourceDaemonID(); ok {
+		if err := meshtest.SourceDaemonIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_daemon_id", err: fmt.Errorf(`ent: validator failed for field "MeshTest.source_daemon_id": %w`, err)}
+		}
+	}
+	if _, ok := mtc.mutation.TargetDaemonID(); !ok {
+		return &ValidationError{Name: "target_daemon_id", err: errors.New(`ent: missing required field "MeshTest.target_daemon_id"`)}
+	}
+	if v, ok := mtc.mutation.TargetDaemonID(); ok {
+		if err := meshtest.TargetDaemonIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_daemon_id", err: fmt.Errorf(`ent: validator failed for field "MeshTest.target_daemon_id": %w`, err)}
+		}
+	}
+	if _, ok := mtc.mutation.SentMbps(); !ok {
+		return &ValidationError{Name: "sent_mbps", err: errors.New(`ent: missing required field "MeshTest.sent_mbps"`)}
+	}
+	if _, ok := mtc.mutation.ReceivedMbps(); !ok {
+		return &ValidationError{Name: "received_mbps", err: errors.New(`ent: missing required field "MeshTest.received_mbps"`)}
+	}
+	if _, ok := mtc.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`ent: missing required field "MeshTest.duration_seconds"`)}
+	}
+	if _, ok := mtc.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "MeshTest.success"`)}
+	}
+	if _, ok := mtc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "MeshTest.trigger"`)}
+	}
+	if v, ok := mtc.mutation.Trigger(); ok {
+		if err := meshtest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "MeshTest.trigger": %w`, err)}
+		}
+	}
+	if _, ok := mtc.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "MeshTest.received_at"`)}
+	}
+	if _, ok := mtc.mutation.ClockCorrected(); !ok {
+		return &ValidationError{Name: "clock_corrected", err: errors.New(`ent: missing required field "MeshTest.clock_corrected"`)}
+	}
+	if _, ok := mtc.mutation.Quarantined(); !ok {
+		return &ValidationError{Name: "quarantined", err: errors.New(`ent: missing required field "MeshTest.quarantined"`)}
+	}
+	return nil
+}
+
+func (mtc *MeshTestCreate) sqlSave(ctx context.Context) (*MeshTest, error) {
+	if err := mtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mtc.mutation.id = &_node.ID
+	mtc.mutation.done = true
+	return _node, nil
+}
+
+func (mtc *MeshTestCreate) createSpec() (*MeshTest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MeshTest{config: mtc.config}
+		_spec = sqlgraph.NewCreateSpec(meshtest.Table, sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt))
+	)
+	if value, ok := mtc.mutation.Timestamp(); ok {
+		_spec.SetField(meshtest.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := mtc.mutation.SourceDaemonID(); ok {
+		_spec.SetField(meshtest.FieldSourceDaemonID, field.TypeString, value)
+		_node.SourceDaemonID = value
+	}
+	if value, ok := mtc.mutation.TargetDaemonID(); ok {
+		_spec.SetField(meshtest.FieldTargetDaemonID, field.TypeString, value)
+		_node.TargetDaemonID = value
+	}
+	if value, ok := mtc.mutation.TargetAddress(); ok {
+		_spec.SetField(meshtest.FieldTargetAddress, field.TypeString, value)
+		_node.TargetAddress = value
+	}
+	if value, ok := mtc.mutation.SentMbps(); ok {
+		_spec.SetField(meshtest.FieldSentMbps, field.TypeFloat64, value)
+		_node.SentMbps = value
+	}
+	if value, ok := mtc.mutation.ReceivedMbps(); ok {
+		_spec.SetField(meshtest.FieldReceivedMbps, field.TypeFloat64, value)
+		_node.ReceivedMbps = value
+	}
+	if value, ok := mtc.mutation.Retransmits(); ok {
+		_spec.SetField(meshtest.FieldRetransmits, field.TypeFloat64, value)
+		_node.Retransmits = value
+	}
+	if value, ok := mtc.mutation.MeanRttMs(); ok {
+		_spec.SetField(meshtest.FieldMeanRttMs, field.TypeFloat64, value)
+		_node.MeanRttMs = value
+	}
+	if value, ok := mtc.mutation.DurationSeconds(); ok {
+		_spec.SetField(meshtest.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := mtc.mutation.Success(); ok {
+		_spec.SetField(meshtest.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := mtc.mutation.ErrorMessage(); ok {
+		_spec.SetField(meshtest.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := mtc.mutation.SubmissionID(); ok {
+		_spec.SetField(meshtest.FieldSubmissionID, field.TypeUUID, value)
+		_node.SubmissionID = &value
+	}
+	if value, ok := mtc.mutation.Trigger(); ok {
+		_spec.SetField(meshtest.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := mtc.mutation.ReceivedAt(); ok {
+		_spec.SetField(meshtest.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := mtc.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(meshtest.FieldClockOffsetMs, field.TypeInt64, value)
+		_node.ClockOffsetMs = &value
+	}
+	if value, ok := mtc.mutation.ClockCorrected(); ok {
+		_spec.SetField(meshtest.FieldClockCorrected, field.TypeBool, value)
+		_node.ClockCorrected = value
+	}
+	if value, ok := mtc.mutation.Quarantined(); ok {
+		_spec.SetField(meshtest.FieldQuarantined, field.TypeBool, value)
+		_node.Quarantined = value
+	}
+	return _node, _spec
+}
+
+// MeshTestCreateBulk is the builder for creating many MeshTest entities in bulk.
+type MeshTestCreateBulk struct {
+	config
+	err      error
+	builders []*MeshTestCreate
+}
+
+// Save creates the MeshTest entities in the database.
+func (mtcb *MeshTestCreateBulk) Save(ctx context.Context) ([]*MeshTest, error) {
+	if mtcb.err != nil {
+		return nil, mtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mtcb.builders))
+	nodes := make([]*MeshTest, len(mtcb.builders))
+	mutators := make([]Mutator, len(mtcb.builders))
+	for i := range mtcb.builders {
+		func(i int, root context.Context) {
+			builder := mtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MeshTestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtcb *MeshTestCreateBulk) SaveX(ctx context.Context) []*MeshTest {
+	v, err := mtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtcb *MeshTestCreateBulk) Exec(ctx context.Context) error {
+	_, err := mtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtcb *MeshTestCreateBulk) ExecX(ctx context.Context) {
+	if err := mtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// MeshTestDelete is the builder for deleting a MeshTest entity.
+type MeshTestDelete struct {
+	config
+	hooks    []Hook
+	mutation *MeshTestMutation
+}
+
+// Where appends a list predicates to the MeshTestDelete builder.
+func (mtd *MeshTestDelete) Where(ps ...predicate.MeshTest) *MeshTestDelete {
+	mtd.mutation.Where(ps...)
+	return mtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mtd *MeshTestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mtd.sqlExec, mtd.mutation, mtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtd *MeshTestDelete) ExecX(ctx context.Context) int {
+	n, err := mtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mtd *MeshTestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(meshtest.Table, sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt))
+	if ps := mtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mtd.mutation.done = true
+	return affected, err
+}
+
+// MeshTestDeleteOne is the builder for deleting a single MeshTest entity.
+type MeshTestDeleteOne struct {
+	mtd *MeshTestDelete
+}
+
+// Where appends a list predicates to the MeshTestDelete builder.
+func (mtdo *MeshTestDeleteOne) Where(ps ...predicate.MeshTest) *MeshTestDeleteOne {
+	mtdo.mtd.mutation.Where(ps...)
+	return mtdo
+}
+
+// Exec executes the deletion query.
+func (mtdo *MeshTestDeleteOne) Exec(ctx context.Context) error {
+	n, err := mtdo.mtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{meshtest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtdo *MeshTestDeleteOne) ExecX(ctx context.Context) {
+	if err := mtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+)
+
+// MeshTestQuery is the builder for querying MeshTest entities.
+type MeshTestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []meshtest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MeshTest
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MeshTestQuery builder.
+func (mtq *MeshTestQuery) Where(ps ...predicate.MeshTest) *MeshTestQuery {
+	mtq.predicates = append(mtq.predicates, ps...)
+	return mtq
+}
+
+// Limit the number of records to be returned by this query.
+func (mtq *MeshTestQuery) Limit(limit int) *MeshTestQuery {
+	mtq.ctx.Limit = &limit
+	return mtq
+}
+
+// Offset to start from.
+func (mtq *MeshTestQuery) Offset(offset int) *MeshTestQuery {
+	mtq.ctx.Offset = &offset
+	return mtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mtq *MeshTestQuery) Unique(unique bool) *MeshTestQuery {
+	mtq.ctx.Unique = &unique
+	return mtq
+}
+
+// Order specifies how the records should be ordered.
+func (mtq *MeshTestQuery) Order(o ...meshtest.OrderOption) *MeshTestQuery {
+	mtq.order = append(mtq.order, o...)
+	return mtq
+}
+
+// First returns the first MeshTest entity from the query.
+// Returns a *NotFoundError when no MeshTest was found.
+func (mtq *MeshTestQuery) First(ctx context.Context) (*MeshTest, error) {
+	nodes, err := mtq.Limit(1).All(setContextOp(ctx, mtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{meshtest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mtq *MeshTestQuery) FirstX(ctx context.Context) *MeshTest {
+	node, err := mtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MeshTest ID from the query.
+// Returns a *NotFoundError when no MeshTest ID was found.
+func (mtq *MeshTestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mtq.Limit(1).IDs(setContextOp(ctx, mtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{meshtest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mtq *MeshTestQuery) FirstIDX(ctx context.Context) int {
+	id, err := mtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MeshTest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MeshTest entity is found.
+// Returns a *NotFoundError when no MeshTest entities are found.
+func (mtq *MeshTestQuery) Only(ctx context.Context) (*MeshTest, error) {
+	nodes, err := mtq.Limit(2).All(setContextOp(ctx, mtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{meshtest.Label}
+	default:
+		return nil, &NotSingularError{meshtest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mtq *MeshTestQuery) OnlyX(ctx context.Context) *MeshTest {
+	node, err := mtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MeshTest ID in the query.
+// Returns a *NotSingularError when more than one MeshTest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mtq *MeshTestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mtq.Limit(2).IDs(setContextOp(ctx, mtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{meshtest.Label}
+	default:
+		err = &NotSingularError{meshtest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mtq *MeshTestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MeshTests.
+func (mtq *MeshTestQuery) All(ctx context.Context) ([]*MeshTest, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryAll)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MeshTest, *MeshTestQuery]()
+	return withInterceptors[[]*MeshTest](ctx, mtq, qr, mtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mtq *MeshTestQuery) AllX(ctx context.Context) []*MeshTest {
+	nodes, err := mtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MeshTest IDs.
+func (mtq *MeshTestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mtq.ctx.Unique == nil && mtq.path != nil {
+		mtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryIDs)
+	if err = mtq.Select(meshtest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mtq *MeshTestQuery) IDsX(ctx context.Context) []int {
+	ids, err := mtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mtq *MeshTestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryCount)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mtq, querierCount[*MeshTestQuery](), mtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mtq *MeshTestQuery) CountX(ctx context.Context) int {
+	count, err := mtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mtq *MeshTestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryExist)
+	switch _, err := mtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mtq *MeshTestQuery) ExistX(ctx context.Context) bool {
+	exist, err := mtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MeshTestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mtq *MeshTestQuery) Clone() *MeshTestQuery {
+	if mtq == nil {
+		return nil
+	}
+	return &MeshTestQuery{
+		config:     mtq.config,
+		ctx:        mtq.ctx.Clone(),
+		order:      append([]meshtest.OrderOption{}, mtq.order...),
+		inters:     append([]Interceptor{}, mtq.inters...),
+		predicates: append([]predicate.MeshTest{}, mtq.predicates...),
+		// clone intermediate query.
+		sql:       mtq.sql.Clone(),
+		path:      mtq.path,
+		modifiers: append([]func(*sql.Selector){}, mtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Timestamp time.Time `json:"timestamp,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MeshTest.Query().
+//		GroupBy(meshtest.FieldTimestamp).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mtq *MeshTestQuery) GroupBy(field string, fields ...string) *MeshTestGroupBy {
+	mtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MeshTestGroupBy{build: mtq}
+	grbuild.flds = &mtq.ctx.Fields
+	grbuild.label = meshtest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Timestamp time.Time `json:"timestamp,omitempty"`
+//	}
+//
+//	client.MeshTest.Query().
+//		Select(meshtest.FieldTimestamp).
+//		Scan(ctx, &v)
+func (mtq *MeshTestQuery) Select(fields ...string) *MeshTestSelect {
+	mtq.ctx.Fields = append(mtq.ctx.Fields, fields...)
+	sbuild := &MeshTestSelect{MeshTestQuery: mtq}
+	sbuild.label = meshtest.Label
+	sbuild.flds, sbuild.scan = &mtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MeshTestSelect configured with the given aggregations.
+func (mtq *MeshTestQuery) Aggregate(fns ...AggregateFunc) *MeshTestSelect {
+	return mtq.Select().Aggregate(fns...)
+}
+
+func (mtq *MeshTestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mtq.ctx.Fields {
+		if !meshtest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mtq.path != nil {
+		prev, err := mtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mtq.sql = prev
+	}
+	return nil
+}
+
+func (mtq *MeshTestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MeshTest, error) {
+	var (
+		nodes = []*MeshTest{}
+		_spec = mtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MeshTest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MeshTest{config: mtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mtq.modifiers) > 0 {
+		_spec.Modifiers = mtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mtq *MeshTestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mtq.querySpec()
+	if len(mtq.modifiers) > 0 {
+		_spec.Modifiers = mtq.modifiers
+	}
+	_spec.Node.Columns = mtq.ctx.Fields
+	if len(mtq.ctx.Fields) > 0 {
+		_spec.Unique = mtq.ctx.Unique != nil && *mtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mtq.driver, _spec)
+}
+
+func (mtq *MeshTestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(meshtest.Table, meshtest.Columns, sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt))
+	_spec.From = mtq.sql
+	if unique := mtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, meshtest.FieldID)
+		for i := range fields {
+			if fields[i] != meshtest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mtq *MeshTestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mtq.driver.Dialect())
+	t1 := builder.Table(meshtest.Table)
+	columns := mtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = meshtest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mtq.sql != nil {
+		selector = mtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mtq.ctx.Unique != nil && *mtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mtq.modifiers {
+		m(selector)
+	}
+	for _, p := range mtq.predicates {
+		p(selector)
+	}
+	for _, p := range mtq.order {
+		p(selector)
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mtq *MeshTestQuery) Modify(modifiers ...func(s *sql.Selector)) *MeshTestSelect {
+	mtq.modifiers = append(mtq.modifiers, modifiers...)
+	return mtq.Select()
+}
+
+// MeshTestGroupBy is the group-by builder for MeshTest entities.
+type MeshTestGroupBy struct {
+	selector
+	build *MeshTestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mtgb *MeshTestGroupBy) Aggregate(fns ...AggregateFunc) *MeshTestGroupBy {
+	mtgb.fns = append(mtgb.fns, fns...)
+	return mtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mtgb *MeshTestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MeshTestQuery, *MeshTestGroupBy](ctx, mtgb.build, mtgb, mtgb.build.inters, v)
+}
+
+func (mtgb *MeshTestGroupBy) sqlScan(ctx context.Context, root *MeshTestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mtgb.fns))
+	for _, fn := range mtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mtgb.flds)+len(mtgb.fns))
+		for _, f := range *mtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MeshTestSelect is the builder for selecting fields of MeshTest entities.
+type MeshTestSelect struct {
+	*MeshTestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mts *MeshTestSelect) Aggregate(fns ...AggregateFunc) *MeshTestSelect {
+	mts.fns = append(mts.fns, fns...)
+	return mts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mts *MeshTestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mts.ctx, ent.OpQuerySelect)
+	if err := mts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MeshTestQuery, *MeshTestSelect](ctx, mts.MeshTestQuery, mts, mts.inters, v)
+}
+
+func (mts *MeshTestSelect) sqlScan(ctx context.Context, root *MeshTestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mts.fns))
+	for _, fn := range mts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mts *MeshTestSelect) Modify(modifiers ...func(s *sql.Selector)) *MeshTestSelect {
+	mts.modifiers = append(mts.modifiers, modifiers...)
+	return mts
+}
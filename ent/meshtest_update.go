@@ -0,0 +1,1011 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// MeshTestUpdate is the builder for updating MeshTest entities.
+type MeshTestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MeshTestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MeshTestUpdate builder.
+func (mtu *MeshTestUpdate) Where(ps ...predicate.MeshTest) *MeshTestUpdate {
+	mtu.mutation.Where(ps...)
+	return mtu
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (mtu *MeshTestUpdate) SetTimestamp(t time.Time) *MeshTestUpdate {
+	mtu.mutation.SetTimestamp(t)
+	return mtu
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableTimestamp(t *time.Time) *MeshTestUpdate {
+	if t != nil {
+		mtu.SetTimestamp(*t)
+	}
+	return mtu
+}
+
+// SetSourceDaemonID sets the "source_daemon_id" field.
+func (mtu *MeshTestUpdate) SetSourceDaemonID(s string) *MeshTestUpdate {
+	mtu.mutation.SetSourceDaemonID(s)
+	return mtu
+}
+
+// SetNillableSourceDaemonID sets the "source_daemon_id" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableSourceDaemonID(s *string) *MeshTestUpdate {
+	if s != nil {
+		mtu.SetSourceDaemonID(*s)
+	}
+	return mtu
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (mtu *MeshTestUpdate) SetTargetDaemonID(s string) *MeshTestUpdate {
+	mtu.mutation.SetTargetDaemonID(s)
+	return mtu
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableTargetDaemonID(s *string) *MeshTestUpdate {
+	if s != nil {
+		mtu.SetTargetDaemonID(*s)
+	}
+	return mtu
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (mtu *MeshTestUpdate) SetTargetAddress(s string) *MeshTestUpdate {
+	mtu.mutation.SetTargetAddress(s)
+	return mtu
+}
+
+// SetNillableTargetAddress sets the "target_address" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableTargetAddress(s *string) *MeshTestUpdate {
+	if s != nil {
+		mtu.SetTargetAddress(*s)
+	}
+	return mtu
+}
+
+// ClearTargetAddress clears the value of the "target_address" field.
+func (mtu *MeshTestUpdate) ClearTargetAddress() *MeshTestUpdate {
+	mtu.mutation.ClearTargetAddress()
+	return mtu
+}
+
+// SetSentMbps sets the "sent_mbps" field.
+func (mtu *MeshTestUpdate) SetSentMbps(f float64) *MeshTestUpdate {
+	mtu.mutation.ResetSentMbps()
+	mtu.mutation.SetSentMbps(f)
+	return mtu
+}
+
+// SetNillableSentMbps sets the "sent_mbps" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableSentMbps(f *float64) *MeshTestUpdate {
+	if f != nil {
+		mtu.SetSentMbps(*f)
+	}
+	return mtu
+}
+
+// AddSentMbps adds f to the "sent_mbps" field.
+func (mtu *MeshTestUpdate) AddSentMbps(f float64) *MeshTestUpdate {
+	mtu.mutation.AddSentMbps(f)
+	return mtu
+}
+
+// SetReceivedMbps sets the "received_mbps" field.
+func (mtu *MeshTestUpdate) SetReceivedMbps(f float64) *MeshTestUpdate {
+	mtu.mutation.ResetReceivedMbps()
+	mtu.mutation.SetReceivedMbps(f)
+	return mtu
+}
+
+// SetNillableReceivedMbps sets the "received_mbps" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableReceivedMbps(f *float64) *MeshTestUpdate {
+	if f != nil {
+		mtu.SetReceivedMbps(*f)
+	}
+	return mtu
+}
+
+// AddReceivedMbps adds f to the "received_mbps" field.
+func (mtu *MeshTestUpdate) AddReceivedMbps(f float64) *MeshTestUpdate {
+	mtu.mutation.AddReceivedMbps(f)
+	return mtu
+}
+
+// SetRetransmits sets the "retransmits" field.
+func (mtu *MeshTestUpdate) SetRetransmits(f float64) *MeshTestUpdate {
+	mtu.mutation.ResetRetransmits()
+	mtu.mutation.SetRetransmits(f)
+	return mtu
+}
+
+// SetNillableRetransmits sets the "retransmits" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableRetransmits(f *float64) *MeshTestUpdate {
+	if f != nil {
+		mtu.SetRetransmits(*f)
+	}
+	return mtu
+}
+
+// AddRetransmits adds f to the "retransmits" field.
+func (mtu *MeshTestUpdate) AddRetransmits(f float64) *MeshTestUpdate {
+	mtu.mutation.AddRetransmits(f)
+	return mtu
+}
+
+// ClearRetransmits clears the value of the "retransmits" field.
+func (mtu *MeshTestUpdate) ClearRetransmits() *MeshTestUpdate {
+	mtu.mutation.ClearRetransmits()
+	return mtu
+}
+
+// SetMeanRttMs sets the "mean_rtt_ms" field.
+func (mtu *MeshTestUpdate) SetMeanRttMs(f float64) *MeshTestUpdate {
+	mtu.mutation.ResetMeanRttMs()
+	mtu.mutation.SetMeanRttMs(f)
+	return mtu
+}
+
+// SetNillableMeanRttMs sets the "mean_rtt_ms" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableMeanRttMs(f *float64) *MeshTestUpdate {
+	if f != nil {
+		mtu.SetMeanRttMs(*f)
+	}
+	return mtu
+}
+
+// AddMeanRttMs adds f to the "mean_rtt_ms" field.
+func (mtu *MeshTestUpdate) AddMeanRttMs(f float64) *MeshTestUpdate {
+	mtu.mutation.AddMeanRttMs(f)
+	return mtu
+}
+
+// ClearMeanRttMs clears the value of the "mean_rtt_ms" field.
+func (mtu *MeshTestUpdate) ClearMeanRttMs() *MeshTestUpdate {
+	mtu.mutation.ClearMeanRttMs()
+	return mtu
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (mtu *MeshTestUpdate) SetDurationSeconds(i int) *MeshTestUpdate {
+	mtu.mutation.ResetDurationSeconds()
+	mtu.mutation.SetDurationSeconds(i)
+	return mtu
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableDurationSeconds(i *int) *MeshTestUpdate {
+	if i != nil {
+		mtu.SetDurationSeconds(*i)
+	}
+	return mtu
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (mtu *MeshTestUpdate) AddDurationSeconds(i int) *MeshTestUpdate {
+	mtu.mutation.AddDurationSeconds(i)
+	return mtu
+}
+
+// SetSuccess sets the "success" field.
+func (mtu *MeshTestUpdate) SetSuccess(b bool) *MeshTestUpdate {
+	mtu.mutation.SetSuccess(b)
+	return mtu
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableSuccess(b *bool) *MeshTestUpdate {
+	if b != nil {
+		mtu.SetSuccess(*b)
+	}
+	return mtu
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (mtu *MeshTestUpdate) SetErrorMessage(s string) *MeshTestUpdate {
+	mtu.mutation.SetErrorMessage(s)
+	return mtu
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableErrorMessage(s *string) *MeshTestUpdate {
+	if s != nil {
+		mtu.SetErrorMessage(*s)
+	}
+	return mtu
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (mtu *MeshTestUpdate) ClearErrorMessage() *MeshTestUpdate {
+	mtu.mutation.ClearErrorMessage()
+	return mtu
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (mtu *MeshTestUpdate) SetSubmissionID(u uuid.UUID) *MeshTestUpdate {
+	mtu.mutation.SetSubmissionID(u)
+	return mtu
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableSubmissionID(u *uuid.UUID) *MeshTestUpdate {
+	if u != nil {
+		mtu.SetSubmissionID(*u)
+	}
+	return mtu
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (mtu *MeshTestUpdate) ClearSubmissionID() *MeshTestUpdate {
+	mtu.mutation.ClearSubmissionID()
+	return mtu
+}
+
+// SetTrigger sets the "trigger" field.
+func (mtu *MeshTestUpdate) SetTrigger(m meshtest.Trigger) *MeshTestUpdate {
+	mtu.mutation.SetTrigger(m)
+	return mtu
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableTrigger(m *meshtest.Trigger) *MeshTestUpdate {
+	if m != nil {
+		mtu.SetTrigger(*m)
+	}
+	return mtu
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (mtu *MeshTestUpdate) SetClockOffsetMs(i int64) *MeshTestUpdate {
+	mtu.mutation.ResetClockOffsetMs()
+	mtu.mutation.SetClockOffsetMs(i)
+	return mtu
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableClockOffsetMs(i *int64) *MeshTestUpdate {
+	if i != nil {
+		mtu.SetClockOffsetMs(*i)
+	}
+	return mtu
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (mtu *MeshTestUpdate) AddClockOffsetMs(i int64) *MeshTestUpdate {
+	mtu.mutation.AddClockOffsetMs(i)
+	return mtu
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (mtu *MeshTestUpdate) ClearClockOffsetMs() *MeshTestUpdate {
+	mtu.mutation.ClearClockOffsetMs()
+	return mtu
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (mtu *MeshTestUpdate) SetClockCorrected(b bool) *MeshTestUpdate {
+	mtu.mutation.SetClockCorrected(b)
+	return mtu
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableClockCorrected(b *bool) *MeshTestUpdate {
+	if b != nil {
+		mtu.SetClockCorrected(*b)
+	}
+	return mtu
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (mtu *MeshTestUpdate) SetQuarantined(b bool) *MeshTestUpdate {
+	mtu.mutation.SetQuarantined(b)
+	return mtu
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (mtu *MeshTestUpdate) SetNillableQuarantined(b *bool) *MeshTestUpdate {
+	if b != nil {
+		mtu.SetQuarantined(*b)
+	}
+	return mtu
+}
+
+// Mutation returns the MeshTestMutation object of the builder.
+func (mtu *MeshTestUpdate) Mutation() *MeshTestMutation {
+	return mtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mtu *MeshTestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mtu.sqlSave, mtu.mutation, mtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtu *MeshTestUpdate) SaveX(ctx context.Context) int {
+	affected, err := mtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mtu *MeshTestUpdate) Exec(ctx context.Context) error {
+	_, err := mtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtu *MeshTestUpdate) ExecX(ctx context.Context) {
+	if err := mtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtu *MeshTestUpdate) check() error {
+	if v, ok := mtu.mutation.SourceDaemonID(); ok {
+		if err := meshtest.SourceDaemonIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_daemon_id", err: fmt.Errorf(`ent: validator failed for field "MeshTest.source_daemon_id": %w`, err)}
+		}
+	}
+	if v, ok := mtu.mutation.TargetDaemonID(); ok {
+		if err := meshtest.TargetDaemonIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_daemon_id", err: fmt.Errorf(`ent: validator failed for field "MeshTest.target_daemon_id": %w`, err)}
+		}
+	}
+	if v, ok := mtu.mutation.Trigger(); ok {
+		if err := meshtest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "MeshTest.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mtu *MeshTestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MeshTestUpdate {
+	mtu.modifiers = append(mtu.modifiers, modifiers...)
+	return mtu
+}
+
+func (mtu *MeshTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(meshtest.Table, meshtest.Columns, sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt))
+	if ps := mtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtu.mutation.Timestamp(); ok {
+		_spec.SetField(meshtest.FieldTimestamp, field.TypeTime, value)
+	}
+	if value, ok := mtu.mutation.SourceDaemonID(); ok {
+		_spec.SetField(meshtest.FieldSourceDaemonID, field.TypeString, value)
+	}
+	if value, ok := mtu.mutation.TargetDaemonID(); ok {
+		_spec.SetField(meshtest.FieldTargetDaemonID, field.TypeString, value)
+	}
+	if value, ok := mtu.mutation.TargetAddress(); ok {
+		_spec.SetField(meshtest.FieldTargetAddress, field.TypeString, value)
+	}
+	if mtu.mutation.TargetAddressCleared() {
+		_spec.ClearField(meshtest.FieldTargetAddress, field.TypeString)
+	}
+	if value, ok := mtu.mutation.SentMbps(); ok {
+		_spec.SetField(meshtest.FieldSentMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtu.mutation.AddedSentMbps(); ok {
+		_spec.AddField(meshtest.FieldSentMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtu.mutation.ReceivedMbps(); ok {
+		_spec.SetField(meshtest.FieldReceivedMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtu.mutation.AddedReceivedMbps(); ok {
+		_spec.AddField(meshtest.FieldReceivedMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtu.mutation.Retransmits(); ok {
+		_spec.SetField(meshtest.FieldRetransmits, field.TypeFloat64, value)
+	}
+	if value, ok := mtu.mutation.AddedRetransmits(); ok {
+		_spec.AddField(meshtest.FieldRetransmits, field.TypeFloat64, value)
+	}
+	if mtu.mutation.RetransmitsCleared() {
+		_spec.ClearField(meshtest.FieldRetransmits, field.TypeFloat64)
+	}
+	if value, ok := mtu.mutation.MeanRttMs(); ok {
+		_spec.SetField(meshtest.FieldMeanRttMs, field.TypeFloat64, value)
+	}
+	if value, ok := mtu.mutation.AddedMeanRttMs(); ok {
+		_spec.AddField(meshtest.FieldMeanRttMs, field.TypeFloat64, value)
+	}
+	if mtu.mutation.MeanRttMsCleared() {
+		_spec.ClearField(meshtest.FieldMeanRttMs, field.TypeFloat64)
+	}
+	if value, ok := mtu.mutation.DurationSeconds(); ok {
+		_spec.SetField(meshtest.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := mtu.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(meshtest.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := mtu.mutation.Success(); ok {
+		_spec.SetField(meshtest.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := mtu.mutation.ErrorMessage(); ok {
+		_spec.SetField(meshtest.FieldErrorMessage, field.TypeString, value)
+	}
+	if mtu.mutation.ErrorMessageCleared() {
+		_spec.ClearField(meshtest.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := mtu.mutation.SubmissionID(); ok {
+		_spec.SetField(meshtest.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if mtu.mutation.SubmissionIDCleared() {
+		_spec.ClearField(meshtest.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := mtu.mutation.Trigger(); ok {
+		_spec.SetField(meshtest.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := mtu.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(meshtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if value, ok := mtu.mutation.AddedClockOffsetMs(); ok {
+		_spec.AddField(meshtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if mtu.mutation.ClockOffsetMsCleared() {
+		_spec.ClearField(meshtest.FieldClockOffsetMs, field.TypeInt64)
+	}
+	if value, ok := mtu.mutation.ClockCorrected(); ok {
+		_spec.SetField(meshtest.FieldClockCorrected, field.TypeBool, value)
+	}
+	if value, ok := mtu.mutation.Quarantined(); ok {
+		_spec.SetField(meshtest.FieldQuarantined, field.TypeBool, value)
+	}
+	_spec.AddModifiers(mtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{meshtest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mtu.mutation.done = true
+	return n, nil
+}
+
+// MeshTestUpdateOne is the builder for updating a single MeshTest entity.
+type MeshTestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MeshTestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (mtuo *MeshTestUpdateOne) SetTimestamp(t time.Time) *MeshTestUpdateOne {
+	mtuo.mutation.SetTimestamp(t)
+	return mtuo
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableTimestamp(t *time.Time) *MeshTestUpdateOne {
+	if t != nil {
+		mtuo.SetTimestamp(*t)
+	}
+	return mtuo
+}
+
+// SetSourceDaemonID sets the "source_daemon_id" field.
+func (mtuo *MeshTestUpdateOne) SetSourceDaemonID(s string) *MeshTestUpdateOne {
+	mtuo.mutation.SetSourceDaemonID(s)
+	return mtuo
+}
+
+// SetNillableSourceDaemonID sets the "source_daemon_id" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableSourceDaemonID(s *string) *MeshTestUpdateOne {
+	if s != nil {
+		mtuo.SetSourceDaemonID(*s)
+	}
+	return mtuo
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (mtuo *MeshTestUpdateOne) SetTargetDaemonID(s string) *MeshTestUpdateOne {
+	mtuo.mutation.SetTargetDaemonID(s)
+	return mtuo
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableTargetDaemonID(s *string) *MeshTestUpdateOne {
+	if s != nil {
+		mtuo.SetTargetDaemonID(*s)
+	}
+	return mtuo
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (mtuo *MeshTestUpdateOne) SetTargetAddress(s string) *MeshTestUpdateOne {
+	mtuo.mutation.SetTargetAddress(s)
+	return mtuo
+}
+
+// SetNillableTargetAddress sets the "target_address" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableTargetAddress(s *string) *MeshTestUpdateOne {
+	if s != nil {
+		mtuo.SetTargetAddress(*s)
+	}
+	return mtuo
+}
+
+// ClearTargetAddress clears the value of the "target_address" field.
+func (mtuo *MeshTestUpdateOne) ClearTargetAddress() *MeshTestUpdateOne {
+	mtuo.mutation.ClearTargetAddress()
+	return mtuo
+}
+
+// SetSentMbps sets the "sent_mbps" field.
+func (mtuo *MeshTestUpdateOne) SetSentMbps(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.ResetSentMbps()
+	mtuo.mutation.SetSentMbps(f)
+	return mtuo
+}
+
+// SetNillableSentMbps sets the "sent_mbps" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableSentMbps(f *float64) *MeshTestUpdateOne {
+	if f != nil {
+		mtuo.SetSentMbps(*f)
+	}
+	return mtuo
+}
+
+// AddSentMbps adds f to the "sent_mbps" field.
+func (mtuo *MeshTestUpdateOne) AddSentMbps(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.AddSentMbps(f)
+	return mtuo
+}
+
+// SetReceivedMbps sets the "received_mbps" field.
+func (mtuo *MeshTestUpdateOne) SetReceivedMbps(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.ResetReceivedMbps()
+	mtuo.mutation.SetReceivedMbps(f)
+	return mtuo
+}
+
+// SetNillableReceivedMbps sets the "received_mbps" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableReceivedMbps(f *float64) *MeshTestUpdateOne {
+	if f != nil {
+		mtuo.SetReceivedMbps(*f)
+	}
+	return mtuo
+}
+
+// AddReceivedMbps adds f to the "received_mbps" field.
+func (mtuo *MeshTestUpdateOne) AddReceivedMbps(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.AddReceivedMbps(f)
+	return mtuo
+}
+
+// SetRetransmits sets the "retransmits" field.
+func (mtuo *MeshTestUpdateOne) SetRetransmits(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.ResetRetransmits()
+	mtuo.mutation.SetRetransmits(f)
+	return mtuo
+}
+
+// SetNillableRetransmits sets the "retransmits" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableRetransmits(f *float64) *MeshTestUpdateOne {
+	if f != nil {
+		mtuo.SetRetransmits(*f)
+	}
+	return mtuo
+}
+
+// AddRetransmits adds f to the "retransmits" field.
+func (mtuo *MeshTestUpdateOne) AddRetransmits(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.AddRetransmits(f)
+	return mtuo
+}
+
+// ClearRetransmits clears the value of the "retransmits" field.
+func (mtuo *MeshTestUpdateOne) ClearRetransmits() *MeshTestUpdateOne {
+	mtuo.mutation.ClearRetransmits()
+	return mtuo
+}
+
+// SetMeanRttMs sets the "mean_rtt_ms" field.
+func (mtuo *MeshTestUpdateOne) SetMeanRttMs(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.ResetMeanRttMs()
+	mtuo.mutation.SetMeanRttMs(f)
+	return mtuo
+}
+
+// SetNillableMeanRttMs sets the "mean_rtt_ms" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableMeanRttMs(f *float64) *MeshTestUpdateOne {
+	if f != nil {
+		mtuo.SetMeanRttMs(*f)
+	}
+	return mtuo
+}
+
+// AddMeanRttMs adds f to the "mean_rtt_ms" field.
+func (mtuo *MeshTestUpdateOne) AddMeanRttMs(f float64) *MeshTestUpdateOne {
+	mtuo.mutation.AddMeanRttMs(f)
+	return mtuo
+}
+
+// ClearMeanRttMs clears the value of the "mean_rtt_ms" field.
+func (mtuo *MeshTestUpdateOne) ClearMeanRttMs() *MeshTestUpdateOne {
+	mtuo.mutation.ClearMeanRttMs()
+	return mtuo
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (mtuo *MeshTestUpdateOne) SetDurationSeconds(i int) *MeshTestUpdateOne {
+	mtuo.mutation.ResetDurationSeconds()
+	mtuo.mutation.SetDurationSeconds(i)
+	return mtuo
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableDurationSeconds(i *int) *MeshTestUpdateOne {
+	if i != nil {
+		mtuo.SetDurationSeconds(*i)
+	}
+	return mtuo
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (mtuo *MeshTestUpdateOne) AddDurationSeconds(i int) *MeshTestUpdateOne {
+	mtuo.mutation.AddDurationSeconds(i)
+	return mtuo
+}
+
+// SetSuccess sets the "success" field.
+func (mtuo *MeshTestUpdateOne) SetSuccess(b bool) *MeshTestUpdateOne {
+	mtuo.mutation.SetSuccess(b)
+	return mtuo
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableSuccess(b *bool) *MeshTestUpdateOne {
+	if b != nil {
+		mtuo.SetSuccess(*b)
+	}
+	return mtuo
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (mtuo *MeshTestUpdateOne) SetErrorMessage(s string) *MeshTestUpdateOne {
+	mtuo.mutation.SetErrorMessage(s)
+	return mtuo
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableErrorMessage(s *string) *MeshTestUpdateOne {
+	if s != nil {
+		mtuo.SetErrorMessage(*s)
+	}
+	return mtuo
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (mtuo *MeshTestUpdateOne) ClearErrorMessage() *MeshTestUpdateOne {
+	mtuo.mutation.ClearErrorMessage()
+	return mtuo
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (mtuo *MeshTestUpdateOne) SetSubmissionID(u uuid.UUID) *MeshTestUpdateOne {
+	mtuo.mutation.SetSubmissionID(u)
+	return mtuo
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableSubmissionID(u *uuid.UUID) *MeshTestUpdateOne {
+	if u != nil {
+		mtuo.SetSubmissionID(*u)
+	}
+	return mtuo
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (mtuo *MeshTestUpdateOne) ClearSubmissionID() *MeshTestUpdateOne {
+	mtuo.mutation.ClearSubmissionID()
+	return mtuo
+}
+
+// SetTrigger sets the "trigger" field.
+func (mtuo *MeshTestUpdateOne) SetTrigger(m meshtest.Trigger) *MeshTestUpdateOne {
+	mtuo.mutation.SetTrigger(m)
+	return mtuo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableTrigger(m *meshtest.Trigger) *MeshTestUpdateOne {
+	if m != nil {
+		mtuo.SetTrigger(*m)
+	}
+	return mtuo
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (mtuo *MeshTestUpdateOne) SetClockOffsetMs(i int64) *MeshTestUpdateOne {
+	mtuo.mutation.ResetClockOffsetMs()
+	mtuo.mutation.SetClockOffsetMs(i)
+	return mtuo
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableClockOffsetMs(i *int64) *MeshTestUpdateOne {
+	if i != nil {
+		mtuo.SetClockOffsetMs(*i)
+	}
+	return mtuo
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (mtuo *MeshTestUpdateOne) AddClockOffsetMs(i int64) *MeshTestUpdateOne {
+	mtuo.mutation.AddClockOffsetMs(i)
+	return mtuo
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (mtuo *MeshTestUpdateOne) ClearClockOffsetMs() *MeshTestUpdateOne {
+	mtuo.mutation.ClearClockOffsetMs()
+	return mtuo
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (mtuo *MeshTestUpdateOne) SetClockCorrected(b bool) *MeshTestUpdateOne {
+	mtuo.mutation.SetClockCorrected(b)
+	return mtuo
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableClockCorrected(b *bool) *MeshTestUpdateOne {
+	if b != nil {
+		mtuo.SetClockCorrected(*b)
+	}
+	return mtuo
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (mtuo *MeshTestUpdateOne) SetQuarantined(b bool) *MeshTestUpdateOne {
+	mtuo.mutation.SetQuarantined(b)
+	return mtuo
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (mtuo *MeshTestUpdateOne) SetNillableQuarantined(b *bool) *MeshTestUpdateOne {
+	if b != nil {
+		mtuo.SetQuarantined(*b)
+	}
+	return mtuo
+}
+
+// Mutation returns the MeshTestMutation object of the builder.
+func (mtuo *MeshTestUpdateOne) Mutation() *MeshTestMutation {
+	return mtuo.mutation
+}
+
+// Where appends a list predicates to the MeshTestUpdate builder.
+func (mtuo *MeshTestUpdateOne) Where(ps ...predicate.MeshTest) *MeshTestUpdateOne {
+	mtuo.mutation.Where(ps...)
+	return mtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mtuo *MeshTestUpdateOne) Select(field string, fields ...string) *MeshTestUpdateOne {
+	mtuo.fields = append([]string{field}, fields...)
+	return mtuo
+}
+
+// Save executes the query and returns the updated MeshTest entity.
+func (mtuo *MeshTestUpdateOne) Save(ctx context.Context) (*MeshTest, error) {
+	return withHooks(ctx, mtuo.sqlSave, mtuo.mutation, mtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtuo *MeshTestUpdateOne) SaveX(ctx context.Context) *MeshTest {
+	node, err := mtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mtuo *MeshTestUpdateOne) Exec(ctx context.Context) error {
+	_, err := mtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtuo *MeshTestUpdateOne) ExecX(ctx context.Context) {
+	if err := mtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtuo *MeshTestUpdateOne) check() error {
+	if v, ok := mtuo.mutation.SourceDaemonID(); ok {
+		if err := meshtest.SourceDaemonIDValidator(v); err != nil {
+			return &ValidationError{Name: "source_daemon_id", err: fmt.Errorf(`ent: validator failed for field "MeshTest.source_daemon_id": %w`, err)}
+		}
+	}
+	if v, ok := mtuo.mutation.TargetDaemonID(); ok {
+		if err := meshtest.TargetDaemonIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_daemon_id", err: fmt.Errorf(`ent: validator failed for field "MeshTest.target_daemon_id": %w`, err)}
+		}
+	}
+	if v, ok := mtuo.mutation.Trigger(); ok {
+		if err := meshtest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "MeshTest.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mtuo *MeshTestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MeshTestUpdateOne {
+	mtuo.modifiers = append(mtuo.modifiers, modifiers...)
+	return mtuo
+}
+
+func (mtuo *MeshTestUpdateOne) sqlSave(ctx context.Context) (_node *MeshTest, err error) {
+	if err := mtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(meshtest.Table, meshtest.Columns, sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt))
+	id, ok := mtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MeshTest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, meshtest.FieldID)
+		for _, f := range fields {
+			if !meshtest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != meshtest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtuo.mutation.Timestamp(); ok {
+		_spec.SetField(meshtest.FieldTimestamp, field.TypeTime, value)
+	}
+	if value, ok := mtuo.mutation.SourceDaemonID(); ok {
+		_spec.SetField(meshtest.FieldSourceDaemonID, field.TypeString, value)
+	}
+	if value, ok := mtuo.mutation.TargetDaemonID(); ok {
+		_spec.SetField(meshtest.FieldTargetDaemonID, field.TypeString, value)
+	}
+	if value, ok := mtuo.mutation.TargetAddress(); ok {
+		_spec.SetField(meshtest.FieldTargetAddress, field.TypeString, value)
+	}
+	if mtuo.mutation.TargetAddressCleared() {
+		_spec.ClearField(meshtest.FieldTargetAddress, field.TypeString)
+	}
+	if value, ok := mtuo.mutation.SentMbps(); ok {
+		_spec.SetField(meshtest.FieldSentMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtuo.mutation.AddedSentMbps(); ok {
+		_spec.AddField(meshtest.FieldSentMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtuo.mutation.ReceivedMbps(); ok {
+		_spec.SetField(meshtest.FieldReceivedMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtuo.mutation.AddedReceivedMbps(); ok {
+		_spec.AddField(meshtest.FieldReceivedMbps, field.TypeFloat64, value)
+	}
+	if value, ok := mtuo.mutation.Retransmits(); ok {
+		_spec.SetField(meshtest.FieldRetransmits, field.TypeFloat64, value)
+	}
+	if value, ok := mtuo.mutation.AddedRetransmits(); ok {
+		_spec.AddField(meshtest.FieldRetransmits, field.TypeFloat64, value)
+	}
+	if mtuo.mutation.RetransmitsCleared() {
+		_spec.ClearField(meshtest.FieldRetransmits, field.TypeFloat64)
+	}
+	if value, ok := mtuo.mutation.MeanRttMs(); ok {
+		_spec.SetField(meshtest.FieldMeanRttMs, field.TypeFloat64, value)
+	}
+	if value, ok := mtuo.mutation.AddedMeanRttMs(); ok {
+		_spec.AddField(meshtest.FieldMeanRttMs, field.TypeFloat64, value)
+	}
+	if mtuo.mutation.MeanRttMsCleared() {
+		_spec.ClearField(meshtest.FieldMeanRttMs, field.TypeFloat64)
+	}
+	if value, ok := mtuo.mutation.DurationSeconds(); ok {
+		_spec.SetField(meshtest.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := mtuo.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(meshtest.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := mtuo.mutation.Success(); ok {
+		_spec.SetField(meshtest.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := mtuo.mutation.ErrorMessage(); ok {
+		_spec.SetField(meshtest.FieldErrorMessage, field.TypeString, value)
+	}
+	if mtuo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(meshtest.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := mtuo.mutation.SubmissionID(); ok {
+		_spec.SetField(meshtest.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if mtuo.mutation.SubmissionIDCleared() {
+		_spec.ClearField(meshtest.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := mtuo.mutation.Trigger(); ok {
+		_spec.SetField(meshtest.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := mtuo.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(meshtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if value, ok := mtuo.mutation.AddedClockOffsetMs(); ok {
+		_spec.AddField(meshtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if mtuo.mutation.ClockOffsetMsCleared() {
+		_spec.ClearField(meshtest.FieldClockOffsetMs, field.TypeInt64)
+	}
+	if value, ok := mtuo.mutation.ClockCorrected(); ok {
+		_spec.SetField(meshtest.FieldClockCorrected, field.TypeBool, value)
+	}
+	if value, ok := mtuo.mutation.Quarantined(); ok {
+		_spec.SetField(meshtest.FieldQuarantined, field.TypeBool, value)
+	}
+	_spec.AddModifiers(mtuo.modifiers...)
+	_node = &MeshTest{config: mtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{meshtest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mtuo.mutation.done = true
+	return _node, nil
+}
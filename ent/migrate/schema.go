@@ -8,6 +8,89 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+	}
+	// CampaignsColumns holds the columns for the "campaigns" table.
+	CampaignsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "speedtest", Type: field.TypeBool, Default: false},
+		{Name: "host_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "daemon_selector", Type: field.TypeJSON, Nullable: true},
+		{Name: "daemon_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "duration_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "scheduled_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CampaignsTable holds the schema information for the "campaigns" table.
+	CampaignsTable = &schema.Table{
+		Name:       "campaigns",
+		Columns:    CampaignsColumns,
+		PrimaryKey: []*schema.Column{CampaignsColumns[0]},
+	}
+	// DaemonsColumns holds the columns for the "daemons" table.
+	DaemonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "hostname", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeString, Nullable: true},
+		{Name: "os", Type: field.TypeString, Nullable: true},
+		{Name: "arch", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "has_iperf3", Type: field.TypeBool, Default: false},
+		{Name: "has_speedtest", Type: field.TypeBool, Default: false},
+		{Name: "mesh_address", Type: field.TypeString, Nullable: true},
+		{Name: "spool_depth", Type: field.TypeInt, Default: 0},
+		{Name: "registered_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+	}
+	// DaemonsTable holds the schema information for the "daemons" table.
+	DaemonsTable = &schema.Table{
+		Name:       "daemons",
+		Columns:    DaemonsColumns,
+		PrimaryKey: []*schema.Column{DaemonsColumns[0]},
+	}
+	// DaemonConfigsColumns holds the columns for the "daemon_configs" table.
+	DaemonConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "match_labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "speedtest_interval_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "iperf_interval_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "iperf_duration_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "speedtest_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "iperf_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "adaptive_enabled", Type: field.TypeBool, Nullable: true},
+		{Name: "host_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "host_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DaemonConfigsTable holds the schema information for the "daemon_configs" table.
+	DaemonConfigsTable = &schema.Table{
+		Name:       "daemon_configs",
+		Columns:    DaemonConfigsColumns,
+		PrimaryKey: []*schema.Column{DaemonConfigsColumns[0]},
+	}
 	// HostsColumns holds the columns for the "hosts" table.
 	HostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -17,6 +100,8 @@ var (
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"lan", "vpn", "remote"}},
 		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "daemon_selector", Type: field.TypeJSON, Nullable: true},
+		{Name: "daemon_ids", Type: field.TypeJSON, Nullable: true},
 	}
 	// HostsTable holds the schema information for the "hosts" table.
 	HostsTable = &schema.Table{
@@ -37,6 +122,14 @@ var (
 		{Name: "success", Type: field.TypeBool, Default: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "submission_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual", "adaptive"}, Default: "scheduled"},
+		{Name: "blocked_by", Type: field.TypeEnum, Nullable: true, Enums: []string{"lan", "vpn", "remote"}},
+		{Name: "received_at", Type: field.TypeTime, Nullable: true},
+		{Name: "clock_offset_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "clock_corrected", Type: field.TypeBool, Default: false},
+		{Name: "campaign_id", Type: field.TypeInt, Nullable: true},
+		{Name: "quarantined", Type: field.TypeBool, Default: false},
 		{Name: "host_iperf_tests", Type: field.TypeInt, Nullable: true},
 	}
 	// IperfTestsTable holds the schema information for the "iperf_tests" table.
@@ -47,11 +140,95 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "iperf_tests_hosts_iperf_tests",
-				Columns:    []*schema.Column{IperfTestsColumns[11]},
+				Columns:    []*schema.Column{IperfTestsColumns[19]},
+				RefColumns: []*schema.Column{HostsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"speedtest", "iperf", "mesh"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "leased", "completed", "failed"}, Default: "pending"},
+		{Name: "daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "target_daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "target_address", Type: field.TypeString, Nullable: true},
+		{Name: "duration_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual", "adaptive"}, Default: "scheduled"},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 3},
+		{Name: "leased_by", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scheduled_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "result_id", Type: field.TypeInt, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "campaign_id", Type: field.TypeInt, Nullable: true},
+		{Name: "host_jobs", Type: field.TypeInt, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_campaigns_jobs",
+				Columns:    []*schema.Column{JobsColumns[18]},
+				RefColumns: []*schema.Column{CampaignsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "jobs_hosts_jobs",
+				Columns:    []*schema.Column{JobsColumns[19]},
 				RefColumns: []*schema.Column{HostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_priority_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[2], JobsColumns[8], JobsColumns[13]},
+			},
+		},
+	}
+	// MeshTestsColumns holds the columns for the "mesh_tests" table.
+	MeshTestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "source_daemon_id", Type: field.TypeString},
+		{Name: "target_daemon_id", Type: field.TypeString},
+		{Name: "target_address", Type: field.TypeString, Nullable: true},
+		{Name: "sent_mbps", Type: field.TypeFloat64},
+		{Name: "received_mbps", Type: field.TypeFloat64},
+		{Name: "retransmits", Type: field.TypeFloat64, Nullable: true},
+		{Name: "mean_rtt_ms", Type: field.TypeFloat64, Nullable: true},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 10},
+		{Name: "success", Type: field.TypeBool, Default: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "submission_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual", "adaptive"}, Default: "scheduled"},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "clock_offset_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "clock_corrected", Type: field.TypeBool, Default: false},
+		{Name: "quarantined", Type: field.TypeBool, Default: false},
+	}
+	// MeshTestsTable holds the schema information for the "mesh_tests" table.
+	MeshTestsTable = &schema.Table{
+		Name:       "mesh_tests",
+		Columns:    MeshTestsColumns,
+		PrimaryKey: []*schema.Column{MeshTestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "meshtest_source_daemon_id_target_daemon_id_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{MeshTestsColumns[2], MeshTestsColumns[3], MeshTestsColumns[1]},
+			},
+		},
 	}
 	// SpeedTestsColumns holds the columns for the "speed_tests" table.
 	SpeedTestsColumns = []*schema.Column{
@@ -67,6 +244,13 @@ var (
 		{Name: "external_ip", Type: field.TypeString, Nullable: true},
 		{Name: "result_url", Type: field.TypeString, Nullable: true},
 		{Name: "daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "submission_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual", "adaptive"}, Default: "scheduled"},
+		{Name: "received_at", Type: field.TypeTime, Nullable: true},
+		{Name: "clock_offset_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "clock_corrected", Type: field.TypeBool, Default: false},
+		{Name: "campaign_id", Type: field.TypeInt, Nullable: true},
+		{Name: "quarantined", Type: field.TypeBool, Default: false},
 	}
 	// SpeedTestsTable holds the schema information for the "speed_tests" table.
 	SpeedTestsTable = &schema.Table{
@@ -74,14 +258,88 @@ var (
 		Columns:    SpeedTestsColumns,
 		PrimaryKey: []*schema.Column{SpeedTestsColumns[0]},
 	}
+	// TestRunsColumns holds the columns for the "test_runs" table.
+	TestRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "daemon_id", Type: field.TypeString},
+		{Name: "submission_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"speedtest", "iperf", "mesh"}},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "manual", "adaptive"}, Default: "scheduled"},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"success", "failed", "skipped", "timeout", "aborted", "spooled"}},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "target_daemon_id", Type: field.TypeString, Nullable: true},
+		{Name: "host_id", Type: field.TypeInt, Nullable: true},
+		{Name: "speed_test_id", Type: field.TypeInt, Nullable: true},
+		{Name: "iperf_test_id", Type: field.TypeInt, Nullable: true},
+		{Name: "mesh_test_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TestRunsTable holds the schema information for the "test_runs" table.
+	TestRunsTable = &schema.Table{
+		Name:       "test_runs",
+		Columns:    TestRunsColumns,
+		PrimaryKey: []*schema.Column{TestRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "test_runs_hosts_test_runs",
+				Columns:    []*schema.Column{TestRunsColumns[10]},
+				RefColumns: []*schema.Column{HostsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "test_runs_speed_tests_speed_test",
+				Columns:    []*schema.Column{TestRunsColumns[11]},
+				RefColumns: []*schema.Column{SpeedTestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "test_runs_iperf_tests_iperf_test",
+				Columns:    []*schema.Column{TestRunsColumns[12]},
+				RefColumns: []*schema.Column{IperfTestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "test_runs_mesh_tests_mesh_test",
+				Columns:    []*schema.Column{TestRunsColumns[13]},
+				RefColumns: []*schema.Column{MeshTestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "testrun_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{TestRunsColumns[6]},
+			},
+			{
+				Name:    "testrun_daemon_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{TestRunsColumns[1], TestRunsColumns[6]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		CampaignsTable,
+		DaemonsTable,
+		DaemonConfigsTable,
 		HostsTable,
 		IperfTestsTable,
+		JobsTable,
+		MeshTestsTable,
 		SpeedTestsTable,
+		TestRunsTable,
 	}
 )
 
 func init() {
 	IperfTestsTable.ForeignKeys[0].RefTable = HostsTable
+	JobsTable.ForeignKeys[0].RefTable = CampaignsTable
+	JobsTable.ForeignKeys[1].RefTable = HostsTable
+	TestRunsTable.ForeignKeys[0].RefTable = HostsTable
+	TestRunsTable.ForeignKeys[1].RefTable = SpeedTestsTable
+	TestRunsTable.ForeignKeys[2].RefTable = IperfTestsTable
+	TestRunsTable.ForeignKeys[3].RefTable = MeshTestsTable
 }
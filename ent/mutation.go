@@ -11,10 +11,18 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/apikey"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
 	"github.com/bfirestone/speed-checker/ent/predicate"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+	"github.com/google/uuid"
 )
 
 const (
@@ -26,44 +34,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeHost      = "Host"
-	TypeIperfTest = "IperfTest"
-	TypeSpeedTest = "SpeedTest"
+	TypeAPIKey       = "APIKey"
+	TypeCampaign     = "Campaign"
+	TypeDaemon       = "Daemon"
+	TypeDaemonConfig = "DaemonConfig"
+	TypeHost         = "Host"
+	TypeIperfTest    = "IperfTest"
+	TypeJob          = "Job"
+	TypeMeshTest     = "MeshTest"
+	TypeSpeedTest    = "SpeedTest"
+	TypeTestRun      = "TestRun"
 )
 
-// HostMutation represents an operation that mutates the Host nodes in the graph.
-type HostMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	hostname           *string
-	port               *int
-	addport            *int
-	_type              *host.Type
-	active             *bool
-	description        *string
-	clearedFields      map[string]struct{}
-	iperf_tests        map[int]struct{}
-	removediperf_tests map[int]struct{}
-	clearediperf_tests bool
-	done               bool
-	oldValue           func(context.Context) (*Host, error)
-	predicates         []predicate.Host
-}
-
-var _ ent.Mutation = (*HostMutation)(nil)
-
-// hostOption allows management of the mutation configuration using functional options.
-type hostOption func(*HostMutation)
-
-// newHostMutation creates new mutation for the Host entity.
-func newHostMutation(c config, op Op, opts ...hostOption) *HostMutation {
-	m := &HostMutation{
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	prefix        *string
+	key_hash      *string
+	scopes        *[]string
+	appendscopes  []string
+	daemon_id     *string
+	created_at    *time.Time
+	last_used_at  *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
+}
+
+var _ ent.Mutation = (*APIKeyMutation)(nil)
+
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
+
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHost,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +86,20 @@ func newHostMutation(c config, op Op, opts ...hostOption) *HostMutation {
 	return m
 }
 
-// withHostID sets the ID field of the mutation.
-func withHostID(id int) hostOption {
-	return func(m *HostMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id int) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Host
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Host, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Host.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +108,10 @@ func withHostID(id int) hostOption {
 	}
 }
 
-// withHost sets the old Host of the mutation.
-func withHost(node *Host) hostOption {
-	return func(m *HostMutation) {
-		m.oldValue = func(context.Context) (*Host, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +120,7 @@ func withHost(node *Host) hostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HostMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +128,7 @@ func (m HostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HostMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -125,7 +139,7 @@ func (m HostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HostMutation) ID() (id int, exists bool) {
+func (m *APIKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -136,7 +150,7 @@ func (m *HostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -145,19 +159,19 @@ func (m *HostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Host.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *HostMutation) SetName(s string) {
+func (m *APIKeyMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *HostMutation) Name() (r string, exists bool) {
+func (m *APIKeyMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -165,10 +179,10 @@ func (m *HostMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -183,286 +197,325 @@ func (m *HostMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *HostMutation) ResetName() {
+func (m *APIKeyMutation) ResetName() {
 	m.name = nil
 }
 
-// SetHostname sets the "hostname" field.
-func (m *HostMutation) SetHostname(s string) {
-	m.hostname = &s
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Hostname returns the value of the "hostname" field in the mutation.
-func (m *HostMutation) Hostname() (r string, exists bool) {
-	v := m.hostname
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHostname returns the old "hostname" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldHostname(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHostname requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.Hostname, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetHostname resets all changes to the "hostname" field.
-func (m *HostMutation) ResetHostname() {
-	m.hostname = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetPort sets the "port" field.
-func (m *HostMutation) SetPort(i int) {
-	m.port = &i
-	m.addport = nil
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// Port returns the value of the "port" field in the mutation.
-func (m *HostMutation) Port() (r int, exists bool) {
-	v := m.port
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPort returns the old "port" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldPort(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPort is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPort requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.Port, nil
+	return oldValue.KeyHash, nil
 }
 
-// AddPort adds i to the "port" field.
-func (m *HostMutation) AddPort(i int) {
-	if m.addport != nil {
-		*m.addport += i
-	} else {
-		m.addport = &i
-	}
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// AddedPort returns the value that was added to the "port" field in this mutation.
-func (m *HostMutation) AddedPort() (r int, exists bool) {
-	v := m.addport
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPort resets all changes to the "port" field.
-func (m *HostMutation) ResetPort() {
-	m.port = nil
-	m.addport = nil
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
 }
 
-// SetType sets the "type" field.
-func (m *HostMutation) SetType(h host.Type) {
-	m._type = &h
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *HostMutation) GetType() (r host.Type, exists bool) {
-	v := m._type
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (m *APIKeyMutation) SetDaemonID(s string) {
+	m.daemon_id = &s
+}
+
+// DaemonID returns the value of the "daemon_id" field in the mutation.
+func (m *APIKeyMutation) DaemonID() (r string, exists bool) {
+	v := m.daemon_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldDaemonID returns the old "daemon_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldType(ctx context.Context) (v host.Type, err error) {
+func (m *APIKeyMutation) OldDaemonID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldDaemonID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDaemonID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.DaemonID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *HostMutation) ResetType() {
-	m._type = nil
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (m *APIKeyMutation) ClearDaemonID() {
+	m.daemon_id = nil
+	m.clearedFields[apikey.FieldDaemonID] = struct{}{}
 }
 
-// SetActive sets the "active" field.
-func (m *HostMutation) SetActive(b bool) {
-	m.active = &b
+// DaemonIDCleared returns if the "daemon_id" field was cleared in this mutation.
+func (m *APIKeyMutation) DaemonIDCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldDaemonID]
+	return ok
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *HostMutation) Active() (r bool, exists bool) {
-	v := m.active
+// ResetDaemonID resets all changes to the "daemon_id" field.
+func (m *APIKeyMutation) ResetDaemonID() {
+	m.daemon_id = nil
+	delete(m.clearedFields, apikey.FieldDaemonID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *HostMutation) ResetActive() {
-	m.active = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *HostMutation) SetDescription(s string) {
-	m.description = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *HostMutation) Description() (r string, exists bool) {
-	v := m.description
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Host entity.
-// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *HostMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[host.FieldDescription] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *HostMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[host.FieldDescription]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *HostMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, host.FieldDescription)
-}
-
-// AddIperfTestIDs adds the "iperf_tests" edge to the IperfTest entity by ids.
-func (m *HostMutation) AddIperfTestIDs(ids ...int) {
-	if m.iperf_tests == nil {
-		m.iperf_tests = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.iperf_tests[ids[i]] = struct{}{}
-	}
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// ClearIperfTests clears the "iperf_tests" edge to the IperfTest entity.
-func (m *HostMutation) ClearIperfTests() {
-	m.clearediperf_tests = true
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// IperfTestsCleared reports if the "iperf_tests" edge to the IperfTest entity was cleared.
-func (m *HostMutation) IperfTestsCleared() bool {
-	return m.clearediperf_tests
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveIperfTestIDs removes the "iperf_tests" edge to the IperfTest entity by IDs.
-func (m *HostMutation) RemoveIperfTestIDs(ids ...int) {
-	if m.removediperf_tests == nil {
-		m.removediperf_tests = make(map[int]struct{})
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.iperf_tests, ids[i])
-		m.removediperf_tests[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
 }
 
-// RemovedIperfTests returns the removed IDs of the "iperf_tests" edge to the IperfTest entity.
-func (m *HostMutation) RemovedIperfTestsIDs() (ids []int) {
-	for id := range m.removediperf_tests {
-		ids = append(ids, id)
-	}
-	return
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// IperfTestsIDs returns the "iperf_tests" edge IDs in the mutation.
-func (m *HostMutation) IperfTestsIDs() (ids []int) {
-	for id := range m.iperf_tests {
-		ids = append(ids, id)
-	}
-	return
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
 }
 
-// ResetIperfTests resets all changes to the "iperf_tests" edge.
-func (m *HostMutation) ResetIperfTests() {
-	m.iperf_tests = nil
-	m.clearediperf_tests = false
-	m.removediperf_tests = nil
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
-// Where appends a list predicates to the HostMutation builder.
-func (m *HostMutation) Where(ps ...predicate.Host) {
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Host, len(ps))
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -470,42 +523,48 @@ func (m *HostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HostMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HostMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Host).
-func (m *HostMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HostMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.name != nil {
-		fields = append(fields, host.FieldName)
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.hostname != nil {
-		fields = append(fields, host.FieldHostname)
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
 	}
-	if m.port != nil {
-		fields = append(fields, host.FieldPort)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
 	}
-	if m._type != nil {
-		fields = append(fields, host.FieldType)
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
 	}
-	if m.active != nil {
-		fields = append(fields, host.FieldActive)
+	if m.daemon_id != nil {
+		fields = append(fields, apikey.FieldDaemonID)
 	}
-	if m.description != nil {
-		fields = append(fields, host.FieldDescription)
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -513,20 +572,24 @@ func (m *HostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HostMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case host.FieldName:
+	case apikey.FieldName:
 		return m.Name()
-	case host.FieldHostname:
-		return m.Hostname()
-	case host.FieldPort:
-		return m.Port()
-	case host.FieldType:
-		return m.GetType()
-	case host.FieldActive:
-		return m.Active()
-	case host.FieldDescription:
-		return m.Description()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldDaemonID:
+		return m.DaemonID()
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -534,290 +597,273 @@ func (m *HostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case host.FieldName:
+	case apikey.FieldName:
 		return m.OldName(ctx)
-	case host.FieldHostname:
-		return m.OldHostname(ctx)
-	case host.FieldPort:
-		return m.OldPort(ctx)
-	case host.FieldType:
-		return m.OldType(ctx)
-	case host.FieldActive:
-		return m.OldActive(ctx)
-	case host.FieldDescription:
-		return m.OldDescription(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldDaemonID:
+		return m.OldDaemonID(ctx)
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Host field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HostMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case host.FieldName:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case host.FieldHostname:
+	case apikey.FieldPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHostname(v)
+		m.SetPrefix(v)
 		return nil
-	case host.FieldPort:
-		v, ok := value.(int)
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPort(v)
+		m.SetKeyHash(v)
 		return nil
-	case host.FieldType:
-		v, ok := value.(host.Type)
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetScopes(v)
 		return nil
-	case host.FieldActive:
-		v, ok := value.(bool)
+	case apikey.FieldDaemonID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetDaemonID(v)
 		return nil
-	case host.FieldDescription:
-		v, ok := value.(string)
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetCreatedAt(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Host field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HostMutation) AddedFields() []string {
-	var fields []string
-	if m.addport != nil {
-		fields = append(fields, host.FieldPort)
-	}
-	return fields
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HostMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case host.FieldPort:
-		return m.AddedPort()
-	}
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HostMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case host.FieldPort:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPort(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Host numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HostMutation) ClearedFields() []string {
+func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(host.FieldDescription) {
-		fields = append(fields, host.FieldDescription)
+	if m.FieldCleared(apikey.FieldDaemonID) {
+		fields = append(fields, apikey.FieldDaemonID)
+	}
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HostMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HostMutation) ClearField(name string) error {
+func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
-	case host.FieldDescription:
-		m.ClearDescription()
+	case apikey.FieldDaemonID:
+		m.ClearDaemonID()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Host nullable field %s", name)
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HostMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case host.FieldName:
+	case apikey.FieldName:
 		m.ResetName()
 		return nil
-	case host.FieldHostname:
-		m.ResetHostname()
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case host.FieldPort:
-		m.ResetPort()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case host.FieldType:
-		m.ResetType()
+	case apikey.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case host.FieldActive:
-		m.ResetActive()
+	case apikey.FieldDaemonID:
+		m.ResetDaemonID()
 		return nil
-	case host.FieldDescription:
-		m.ResetDescription()
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Host field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.iperf_tests != nil {
-		edges = append(edges, host.EdgeIperfTests)
-	}
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HostMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case host.EdgeIperfTests:
-		ids := make([]ent.Value, 0, len(m.iperf_tests))
-		for id := range m.iperf_tests {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removediperf_tests != nil {
-		edges = append(edges, host.EdgeIperfTests)
-	}
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case host.EdgeIperfTests:
-		ids := make([]ent.Value, 0, len(m.removediperf_tests))
-		for id := range m.removediperf_tests {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearediperf_tests {
-		edges = append(edges, host.EdgeIperfTests)
-	}
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HostMutation) EdgeCleared(name string) bool {
-	switch name {
-	case host.EdgeIperfTests:
-		return m.clearediperf_tests
-	}
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HostMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Host unique edge %s", name)
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HostMutation) ResetEdge(name string) error {
-	switch name {
-	case host.EdgeIperfTests:
-		m.ResetIperfTests()
-		return nil
-	}
-	return fmt.Errorf("unknown Host edge %s", name)
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// IperfTestMutation represents an operation that mutates the IperfTest nodes in the graph.
-type IperfTestMutation struct {
+// CampaignMutation represents an operation that mutates the Campaign nodes in the graph.
+type CampaignMutation struct {
 	config
 	op                  Op
 	typ                 string
 	id                  *int
-	timestamp           *time.Time
-	sent_mbps           *float64
-	addsent_mbps        *float64
-	received_mbps       *float64
-	addreceived_mbps    *float64
-	retransmits         *float64
-	addretransmits      *float64
-	mean_rtt_ms         *float64
-	addmean_rtt_ms      *float64
+	name                *string
+	description         *string
+	speedtest           *bool
+	host_ids            *[]int
+	appendhost_ids      []int
+	daemon_selector     *map[string]string
+	daemon_ids          *[]string
+	appenddaemon_ids    []string
 	duration_seconds    *int
 	addduration_seconds *int
-	protocol            *string
-	success             *bool
-	error_message       *string
-	daemon_id           *string
+	scheduled_at        *time.Time
+	created_at          *time.Time
 	clearedFields       map[string]struct{}
-	host                *int
-	clearedhost         bool
+	jobs                map[int]struct{}
+	removedjobs         map[int]struct{}
+	clearedjobs         bool
 	done                bool
-	oldValue            func(context.Context) (*IperfTest, error)
-	predicates          []predicate.IperfTest
+	oldValue            func(context.Context) (*Campaign, error)
+	predicates          []predicate.Campaign
 }
 
-var _ ent.Mutation = (*IperfTestMutation)(nil)
+var _ ent.Mutation = (*CampaignMutation)(nil)
 
-// iperftestOption allows management of the mutation configuration using functional options.
-type iperftestOption func(*IperfTestMutation)
+// campaignOption allows management of the mutation configuration using functional options.
+type campaignOption func(*CampaignMutation)
 
-// newIperfTestMutation creates new mutation for the IperfTest entity.
-func newIperfTestMutation(c config, op Op, opts ...iperftestOption) *IperfTestMutation {
-	m := &IperfTestMutation{
+// newCampaignMutation creates new mutation for the Campaign entity.
+func newCampaignMutation(c config, op Op, opts ...campaignOption) *CampaignMutation {
+	m := &CampaignMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeIperfTest,
+		typ:           TypeCampaign,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -826,20 +872,20 @@ func newIperfTestMutation(c config, op Op, opts ...iperftestOption) *IperfTestMu
 	return m
 }
 
-// withIperfTestID sets the ID field of the mutation.
-func withIperfTestID(id int) iperftestOption {
-	return func(m *IperfTestMutation) {
+// withCampaignID sets the ID field of the mutation.
+func withCampaignID(id int) campaignOption {
+	return func(m *CampaignMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *IperfTest
+			value *Campaign
 		)
-		m.oldValue = func(ctx context.Context) (*IperfTest, error) {
+		m.oldValue = func(ctx context.Context) (*Campaign, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().IperfTest.Get(ctx, id)
+					value, err = m.Client().Campaign.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -848,10 +894,10 @@ func withIperfTestID(id int) iperftestOption {
 	}
 }
 
-// withIperfTest sets the old IperfTest of the mutation.
-func withIperfTest(node *IperfTest) iperftestOption {
-	return func(m *IperfTestMutation) {
-		m.oldValue = func(context.Context) (*IperfTest, error) {
+// withCampaign sets the old Campaign of the mutation.
+func withCampaign(node *Campaign) campaignOption {
+	return func(m *CampaignMutation) {
+		m.oldValue = func(context.Context) (*Campaign, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -860,7 +906,7 @@ func withIperfTest(node *IperfTest) iperftestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IperfTestMutation) Client() *Client {
+func (m CampaignMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -868,7 +914,7 @@ func (m IperfTestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m IperfTestMutation) Tx() (*Tx, error) {
+func (m CampaignMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -879,7 +925,7 @@ func (m IperfTestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *IperfTestMutation) ID() (id int, exists bool) {
+func (m *CampaignMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -890,7 +936,7 @@ func (m *IperfTestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *IperfTestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CampaignMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -899,308 +945,320 @@ func (m *IperfTestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().IperfTest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Campaign.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *IperfTestMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetName sets the "name" field.
+func (m *CampaignMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *IperfTestMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// Name returns the value of the "name" field in the mutation.
+func (m *CampaignMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *CampaignMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.Name, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *IperfTestMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetName resets all changes to the "name" field.
+func (m *CampaignMutation) ResetName() {
+	m.name = nil
 }
 
-// SetSentMbps sets the "sent_mbps" field.
-func (m *IperfTestMutation) SetSentMbps(f float64) {
-	m.sent_mbps = &f
-	m.addsent_mbps = nil
+// SetDescription sets the "description" field.
+func (m *CampaignMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// SentMbps returns the value of the "sent_mbps" field in the mutation.
-func (m *IperfTestMutation) SentMbps() (r float64, exists bool) {
-	v := m.sent_mbps
+// Description returns the value of the "description" field in the mutation.
+func (m *CampaignMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentMbps returns the old "sent_mbps" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldSentMbps(ctx context.Context) (v float64, err error) {
+func (m *CampaignMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentMbps is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentMbps requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentMbps: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.SentMbps, nil
+	return oldValue.Description, nil
 }
 
-// AddSentMbps adds f to the "sent_mbps" field.
-func (m *IperfTestMutation) AddSentMbps(f float64) {
-	if m.addsent_mbps != nil {
-		*m.addsent_mbps += f
-	} else {
-		m.addsent_mbps = &f
-	}
+// ClearDescription clears the value of the "description" field.
+func (m *CampaignMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[campaign.FieldDescription] = struct{}{}
 }
 
-// AddedSentMbps returns the value that was added to the "sent_mbps" field in this mutation.
-func (m *IperfTestMutation) AddedSentMbps() (r float64, exists bool) {
-	v := m.addsent_mbps
-	if v == nil {
-		return
-	}
-	return *v, true
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CampaignMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldDescription]
+	return ok
 }
 
-// ResetSentMbps resets all changes to the "sent_mbps" field.
-func (m *IperfTestMutation) ResetSentMbps() {
-	m.sent_mbps = nil
-	m.addsent_mbps = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *CampaignMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, campaign.FieldDescription)
 }
 
-// SetReceivedMbps sets the "received_mbps" field.
-func (m *IperfTestMutation) SetReceivedMbps(f float64) {
-	m.received_mbps = &f
-	m.addreceived_mbps = nil
+// SetSpeedtest sets the "speedtest" field.
+func (m *CampaignMutation) SetSpeedtest(b bool) {
+	m.speedtest = &b
 }
 
-// ReceivedMbps returns the value of the "received_mbps" field in the mutation.
-func (m *IperfTestMutation) ReceivedMbps() (r float64, exists bool) {
-	v := m.received_mbps
+// Speedtest returns the value of the "speedtest" field in the mutation.
+func (m *CampaignMutation) Speedtest() (r bool, exists bool) {
+	v := m.speedtest
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReceivedMbps returns the old "received_mbps" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldSpeedtest returns the old "speedtest" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldReceivedMbps(ctx context.Context) (v float64, err error) {
+func (m *CampaignMutation) OldSpeedtest(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceivedMbps is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpeedtest is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceivedMbps requires an ID field in the mutation")
+		return v, errors.New("OldSpeedtest requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceivedMbps: %w", err)
-	}
-	return oldValue.ReceivedMbps, nil
-}
-
-// AddReceivedMbps adds f to the "received_mbps" field.
-func (m *IperfTestMutation) AddReceivedMbps(f float64) {
-	if m.addreceived_mbps != nil {
-		*m.addreceived_mbps += f
-	} else {
-		m.addreceived_mbps = &f
-	}
-}
-
-// AddedReceivedMbps returns the value that was added to the "received_mbps" field in this mutation.
-func (m *IperfTestMutation) AddedReceivedMbps() (r float64, exists bool) {
-	v := m.addreceived_mbps
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSpeedtest: %w", err)
 	}
-	return *v, true
+	return oldValue.Speedtest, nil
 }
 
-// ResetReceivedMbps resets all changes to the "received_mbps" field.
-func (m *IperfTestMutation) ResetReceivedMbps() {
-	m.received_mbps = nil
-	m.addreceived_mbps = nil
+// ResetSpeedtest resets all changes to the "speedtest" field.
+func (m *CampaignMutation) ResetSpeedtest() {
+	m.speedtest = nil
 }
 
-// SetRetransmits sets the "retransmits" field.
-func (m *IperfTestMutation) SetRetransmits(f float64) {
-	m.retransmits = &f
-	m.addretransmits = nil
+// SetHostIds sets the "host_ids" field.
+func (m *CampaignMutation) SetHostIds(i []int) {
+	m.host_ids = &i
+	m.appendhost_ids = nil
 }
 
-// Retransmits returns the value of the "retransmits" field in the mutation.
-func (m *IperfTestMutation) Retransmits() (r float64, exists bool) {
-	v := m.retransmits
+// HostIds returns the value of the "host_ids" field in the mutation.
+func (m *CampaignMutation) HostIds() (r []int, exists bool) {
+	v := m.host_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRetransmits returns the old "retransmits" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldHostIds returns the old "host_ids" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldRetransmits(ctx context.Context) (v float64, err error) {
+func (m *CampaignMutation) OldHostIds(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRetransmits is only allowed on UpdateOne operations")
+		return v, errors.New("OldHostIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRetransmits requires an ID field in the mutation")
+		return v, errors.New("OldHostIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRetransmits: %w", err)
+		return v, fmt.Errorf("querying old value for OldHostIds: %w", err)
 	}
-	return oldValue.Retransmits, nil
+	return oldValue.HostIds, nil
 }
 
-// AddRetransmits adds f to the "retransmits" field.
-func (m *IperfTestMutation) AddRetransmits(f float64) {
-	if m.addretransmits != nil {
-		*m.addretransmits += f
-	} else {
-		m.addretransmits = &f
+// AppendHostIds adds i to the "host_ids" field.
+func (m *CampaignMutation) AppendHostIds(i []int) {
+	m.appendhost_ids = append(m.appendhost_ids, i...)
+}
+
+// AppendedHostIds returns the list of values that were appended to the "host_ids" field in this mutation.
+func (m *CampaignMutation) AppendedHostIds() ([]int, bool) {
+	if len(m.appendhost_ids) == 0 {
+		return nil, false
 	}
+	return m.appendhost_ids, true
 }
 
-// AddedRetransmits returns the value that was added to the "retransmits" field in this mutation.
-func (m *IperfTestMutation) AddedRetransmits() (r float64, exists bool) {
-	v := m.addretransmits
+// ClearHostIds clears the value of the "host_ids" field.
+func (m *CampaignMutation) ClearHostIds() {
+	m.host_ids = nil
+	m.appendhost_ids = nil
+	m.clearedFields[campaign.FieldHostIds] = struct{}{}
+}
+
+// HostIdsCleared returns if the "host_ids" field was cleared in this mutation.
+func (m *CampaignMutation) HostIdsCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldHostIds]
+	return ok
+}
+
+// ResetHostIds resets all changes to the "host_ids" field.
+func (m *CampaignMutation) ResetHostIds() {
+	m.host_ids = nil
+	m.appendhost_ids = nil
+	delete(m.clearedFields, campaign.FieldHostIds)
+}
+
+// SetDaemonSelector sets the "daemon_selector" field.
+func (m *CampaignMutation) SetDaemonSelector(value map[string]string) {
+	m.daemon_selector = &value
+}
+
+// DaemonSelector returns the value of the "daemon_selector" field in the mutation.
+func (m *CampaignMutation) DaemonSelector() (r map[string]string, exists bool) {
+	v := m.daemon_selector
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRetransmits clears the value of the "retransmits" field.
-func (m *IperfTestMutation) ClearRetransmits() {
-	m.retransmits = nil
-	m.addretransmits = nil
-	m.clearedFields[iperftest.FieldRetransmits] = struct{}{}
+// OldDaemonSelector returns the old "daemon_selector" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CampaignMutation) OldDaemonSelector(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaemonSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaemonSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaemonSelector: %w", err)
+	}
+	return oldValue.DaemonSelector, nil
 }
 
-// RetransmitsCleared returns if the "retransmits" field was cleared in this mutation.
-func (m *IperfTestMutation) RetransmitsCleared() bool {
-	_, ok := m.clearedFields[iperftest.FieldRetransmits]
+// ClearDaemonSelector clears the value of the "daemon_selector" field.
+func (m *CampaignMutation) ClearDaemonSelector() {
+	m.daemon_selector = nil
+	m.clearedFields[campaign.FieldDaemonSelector] = struct{}{}
+}
+
+// DaemonSelectorCleared returns if the "daemon_selector" field was cleared in this mutation.
+func (m *CampaignMutation) DaemonSelectorCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldDaemonSelector]
 	return ok
 }
 
-// ResetRetransmits resets all changes to the "retransmits" field.
-func (m *IperfTestMutation) ResetRetransmits() {
-	m.retransmits = nil
-	m.addretransmits = nil
-	delete(m.clearedFields, iperftest.FieldRetransmits)
+// ResetDaemonSelector resets all changes to the "daemon_selector" field.
+func (m *CampaignMutation) ResetDaemonSelector() {
+	m.daemon_selector = nil
+	delete(m.clearedFields, campaign.FieldDaemonSelector)
 }
 
-// SetMeanRttMs sets the "mean_rtt_ms" field.
-func (m *IperfTestMutation) SetMeanRttMs(f float64) {
-	m.mean_rtt_ms = &f
-	m.addmean_rtt_ms = nil
+// SetDaemonIds sets the "daemon_ids" field.
+func (m *CampaignMutation) SetDaemonIds(s []string) {
+	m.daemon_ids = &s
+	m.appenddaemon_ids = nil
 }
 
-// MeanRttMs returns the value of the "mean_rtt_ms" field in the mutation.
-func (m *IperfTestMutation) MeanRttMs() (r float64, exists bool) {
-	v := m.mean_rtt_ms
+// DaemonIds returns the value of the "daemon_ids" field in the mutation.
+func (m *CampaignMutation) DaemonIds() (r []string, exists bool) {
+	v := m.daemon_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMeanRttMs returns the old "mean_rtt_ms" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldDaemonIds returns the old "daemon_ids" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldMeanRttMs(ctx context.Context) (v float64, err error) {
+func (m *CampaignMutation) OldDaemonIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMeanRttMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldDaemonIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMeanRttMs requires an ID field in the mutation")
+		return v, errors.New("OldDaemonIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMeanRttMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldDaemonIds: %w", err)
 	}
-	return oldValue.MeanRttMs, nil
+	return oldValue.DaemonIds, nil
 }
 
-// AddMeanRttMs adds f to the "mean_rtt_ms" field.
-func (m *IperfTestMutation) AddMeanRttMs(f float64) {
-	if m.addmean_rtt_ms != nil {
-		*m.addmean_rtt_ms += f
-	} else {
-		m.addmean_rtt_ms = &f
-	}
+// AppendDaemonIds adds s to the "daemon_ids" field.
+func (m *CampaignMutation) AppendDaemonIds(s []string) {
+	m.appenddaemon_ids = append(m.appenddaemon_ids, s...)
 }
 
-// AddedMeanRttMs returns the value that was added to the "mean_rtt_ms" field in this mutation.
-func (m *IperfTestMutation) AddedMeanRttMs() (r float64, exists bool) {
-	v := m.addmean_rtt_ms
-	if v == nil {
-		return
+// AppendedDaemonIds returns the list of values that were appended to the "daemon_ids" field in this mutation.
+func (m *CampaignMutation) AppendedDaemonIds() ([]string, bool) {
+	if len(m.appenddaemon_ids) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appenddaemon_ids, true
 }
 
-// ClearMeanRttMs clears the value of the "mean_rtt_ms" field.
-func (m *IperfTestMutation) ClearMeanRttMs() {
-	m.mean_rtt_ms = nil
-	m.addmean_rtt_ms = nil
-	m.clearedFields[iperftest.FieldMeanRttMs] = struct{}{}
+// ClearDaemonIds clears the value of the "daemon_ids" field.
+func (m *CampaignMutation) ClearDaemonIds() {
+	m.daemon_ids = nil
+	m.appenddaemon_ids = nil
+	m.clearedFields[campaign.FieldDaemonIds] = struct{}{}
 }
 
-// MeanRttMsCleared returns if the "mean_rtt_ms" field was cleared in this mutation.
-func (m *IperfTestMutation) MeanRttMsCleared() bool {
-	_, ok := m.clearedFields[iperftest.FieldMeanRttMs]
+// DaemonIdsCleared returns if the "daemon_ids" field was cleared in this mutation.
+func (m *CampaignMutation) DaemonIdsCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldDaemonIds]
 	return ok
 }
 
-// ResetMeanRttMs resets all changes to the "mean_rtt_ms" field.
-func (m *IperfTestMutation) ResetMeanRttMs() {
-	m.mean_rtt_ms = nil
-	m.addmean_rtt_ms = nil
-	delete(m.clearedFields, iperftest.FieldMeanRttMs)
+// ResetDaemonIds resets all changes to the "daemon_ids" field.
+func (m *CampaignMutation) ResetDaemonIds() {
+	m.daemon_ids = nil
+	m.appenddaemon_ids = nil
+	delete(m.clearedFields, campaign.FieldDaemonIds)
 }
 
 // SetDurationSeconds sets the "duration_seconds" field.
-func (m *IperfTestMutation) SetDurationSeconds(i int) {
+func (m *CampaignMutation) SetDurationSeconds(i int) {
 	m.duration_seconds = &i
 	m.addduration_seconds = nil
 }
 
 // DurationSeconds returns the value of the "duration_seconds" field in the mutation.
-func (m *IperfTestMutation) DurationSeconds() (r int, exists bool) {
+func (m *CampaignMutation) DurationSeconds() (r int, exists bool) {
 	v := m.duration_seconds
 	if v == nil {
 		return
@@ -1208,10 +1266,10 @@ func (m *IperfTestMutation) DurationSeconds() (r int, exists bool) {
 	return *v, true
 }
 
-// OldDurationSeconds returns the old "duration_seconds" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationSeconds returns the old "duration_seconds" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+func (m *CampaignMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
 	}
@@ -1226,7 +1284,7 @@ func (m *IperfTestMutation) OldDurationSeconds(ctx context.Context) (v int, err
 }
 
 // AddDurationSeconds adds i to the "duration_seconds" field.
-func (m *IperfTestMutation) AddDurationSeconds(i int) {
+func (m *CampaignMutation) AddDurationSeconds(i int) {
 	if m.addduration_seconds != nil {
 		*m.addduration_seconds += i
 	} else {
@@ -1235,7 +1293,7 @@ func (m *IperfTestMutation) AddDurationSeconds(i int) {
 }
 
 // AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
-func (m *IperfTestMutation) AddedDurationSeconds() (r int, exists bool) {
+func (m *CampaignMutation) AddedDurationSeconds() (r int, exists bool) {
 	v := m.addduration_seconds
 	if v == nil {
 		return
@@ -1243,151 +1301,9743 @@ func (m *IperfTestMutation) AddedDurationSeconds() (r int, exists bool) {
 	return *v, true
 }
 
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (m *CampaignMutation) ClearDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+	m.clearedFields[campaign.FieldDurationSeconds] = struct{}{}
+}
+
+// DurationSecondsCleared returns if the "duration_seconds" field was cleared in this mutation.
+func (m *CampaignMutation) DurationSecondsCleared() bool {
+	_, ok := m.clearedFields[campaign.FieldDurationSeconds]
+	return ok
+}
+
 // ResetDurationSeconds resets all changes to the "duration_seconds" field.
-func (m *IperfTestMutation) ResetDurationSeconds() {
+func (m *CampaignMutation) ResetDurationSeconds() {
 	m.duration_seconds = nil
 	m.addduration_seconds = nil
+	delete(m.clearedFields, campaign.FieldDurationSeconds)
 }
 
-// SetProtocol sets the "protocol" field.
-func (m *IperfTestMutation) SetProtocol(s string) {
-	m.protocol = &s
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *CampaignMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
 }
 
-// Protocol returns the value of the "protocol" field in the mutation.
-func (m *IperfTestMutation) Protocol() (r string, exists bool) {
-	v := m.protocol
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *CampaignMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProtocol returns the old "protocol" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduledAt returns the old "scheduled_at" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldProtocol(ctx context.Context) (v string, err error) {
+func (m *CampaignMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProtocol requires an ID field in the mutation")
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
 	}
-	return oldValue.Protocol, nil
+	return oldValue.ScheduledAt, nil
 }
 
-// ResetProtocol resets all changes to the "protocol" field.
-func (m *IperfTestMutation) ResetProtocol() {
-	m.protocol = nil
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *CampaignMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
 }
 
-// SetSuccess sets the "success" field.
-func (m *IperfTestMutation) SetSuccess(b bool) {
-	m.success = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *CampaignMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Success returns the value of the "success" field in the mutation.
-func (m *IperfTestMutation) Success() (r bool, exists bool) {
-	v := m.success
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CampaignMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccess returns the old "success" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Campaign entity.
+// If the Campaign object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+func (m *CampaignMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccess requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Success, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSuccess resets all changes to the "success" field.
-func (m *IperfTestMutation) ResetSuccess() {
-	m.success = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CampaignMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *IperfTestMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// AddJobIDs adds the "jobs" edge to the Job entity by ids.
+func (m *CampaignMutation) AddJobIDs(ids ...int) {
+	if m.jobs == nil {
+		m.jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *IperfTestMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearJobs clears the "jobs" edge to the Job entity.
+func (m *CampaignMutation) ClearJobs() {
+	m.clearedjobs = true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
+func (m *CampaignMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
+func (m *CampaignMutation) RemoveJobIDs(ids ...int) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
 	}
-	return oldValue.ErrorMessage, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *IperfTestMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[iperftest.FieldErrorMessage] = struct{}{}
+// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
+func (m *CampaignMutation) RemovedJobsIDs() (ids []int) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *IperfTestMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[iperftest.FieldErrorMessage]
-	return ok
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *CampaignMutation) JobsIDs() (ids []int) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *IperfTestMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, iperftest.FieldErrorMessage)
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *CampaignMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
 }
 
-// SetDaemonID sets the "daemon_id" field.
-func (m *IperfTestMutation) SetDaemonID(s string) {
-	m.daemon_id = &s
+// Where appends a list predicates to the CampaignMutation builder.
+func (m *CampaignMutation) Where(ps ...predicate.Campaign) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// DaemonID returns the value of the "daemon_id" field in the mutation.
-func (m *IperfTestMutation) DaemonID() (r string, exists bool) {
-	v := m.daemon_id
+// WhereP appends storage-level predicates to the CampaignMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CampaignMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Campaign, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CampaignMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CampaignMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Campaign).
+func (m *CampaignMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CampaignMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, campaign.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, campaign.FieldDescription)
+	}
+	if m.speedtest != nil {
+		fields = append(fields, campaign.FieldSpeedtest)
+	}
+	if m.host_ids != nil {
+		fields = append(fields, campaign.FieldHostIds)
+	}
+	if m.daemon_selector != nil {
+		fields = append(fields, campaign.FieldDaemonSelector)
+	}
+	if m.daemon_ids != nil {
+		fields = append(fields, campaign.FieldDaemonIds)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, campaign.FieldDurationSeconds)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, campaign.FieldScheduledAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, campaign.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CampaignMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case campaign.FieldName:
+		return m.Name()
+	case campaign.FieldDescription:
+		return m.Description()
+	case campaign.FieldSpeedtest:
+		return m.Speedtest()
+	case campaign.FieldHostIds:
+		return m.HostIds()
+	case campaign.FieldDaemonSelector:
+		return m.DaemonSelector()
+	case campaign.FieldDaemonIds:
+		return m.DaemonIds()
+	case campaign.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case campaign.FieldScheduledAt:
+		return m.ScheduledAt()
+	case campaign.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CampaignMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case campaign.FieldName:
+		return m.OldName(ctx)
+	case campaign.FieldDescription:
+		return m.OldDescription(ctx)
+	case campaign.FieldSpeedtest:
+		return m.OldSpeedtest(ctx)
+	case campaign.FieldHostIds:
+		return m.OldHostIds(ctx)
+	case campaign.FieldDaemonSelector:
+		return m.OldDaemonSelector(ctx)
+	case campaign.FieldDaemonIds:
+		return m.OldDaemonIds(ctx)
+	case campaign.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case campaign.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case campaign.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Campaign field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CampaignMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case campaign.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case campaign.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case campaign.FieldSpeedtest:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeedtest(v)
+		return nil
+	case campaign.FieldHostIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIds(v)
+		return nil
+	case campaign.FieldDaemonSelector:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonSelector(v)
+		return nil
+	case campaign.FieldDaemonIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonIds(v)
+		return nil
+	case campaign.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case campaign.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case campaign.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CampaignMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_seconds != nil {
+		fields = append(fields, campaign.FieldDurationSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CampaignMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case campaign.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CampaignMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case campaign.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CampaignMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(campaign.FieldDescription) {
+		fields = append(fields, campaign.FieldDescription)
+	}
+	if m.FieldCleared(campaign.FieldHostIds) {
+		fields = append(fields, campaign.FieldHostIds)
+	}
+	if m.FieldCleared(campaign.FieldDaemonSelector) {
+		fields = append(fields, campaign.FieldDaemonSelector)
+	}
+	if m.FieldCleared(campaign.FieldDaemonIds) {
+		fields = append(fields, campaign.FieldDaemonIds)
+	}
+	if m.FieldCleared(campaign.FieldDurationSeconds) {
+		fields = append(fields, campaign.FieldDurationSeconds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CampaignMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CampaignMutation) ClearField(name string) error {
+	switch name {
+	case campaign.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case campaign.FieldHostIds:
+		m.ClearHostIds()
+		return nil
+	case campaign.FieldDaemonSelector:
+		m.ClearDaemonSelector()
+		return nil
+	case campaign.FieldDaemonIds:
+		m.ClearDaemonIds()
+		return nil
+	case campaign.FieldDurationSeconds:
+		m.ClearDurationSeconds()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CampaignMutation) ResetField(name string) error {
+	switch name {
+	case campaign.FieldName:
+		m.ResetName()
+		return nil
+	case campaign.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case campaign.FieldSpeedtest:
+		m.ResetSpeedtest()
+		return nil
+	case campaign.FieldHostIds:
+		m.ResetHostIds()
+		return nil
+	case campaign.FieldDaemonSelector:
+		m.ResetDaemonSelector()
+		return nil
+	case campaign.FieldDaemonIds:
+		m.ResetDaemonIds()
+		return nil
+	case campaign.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case campaign.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case campaign.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CampaignMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.jobs != nil {
+		edges = append(edges, campaign.EdgeJobs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CampaignMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case campaign.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CampaignMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedjobs != nil {
+		edges = append(edges, campaign.EdgeJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CampaignMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case campaign.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CampaignMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjobs {
+		edges = append(edges, campaign.EdgeJobs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CampaignMutation) EdgeCleared(name string) bool {
+	switch name {
+	case campaign.EdgeJobs:
+		return m.clearedjobs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CampaignMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Campaign unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CampaignMutation) ResetEdge(name string) error {
+	switch name {
+	case campaign.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	}
+	return fmt.Errorf("unknown Campaign edge %s", name)
+}
+
+// DaemonMutation represents an operation that mutates the Daemon nodes in the graph.
+type DaemonMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	name           *string
+	hostname       *string
+	version        *string
+	os             *string
+	arch           *string
+	labels         *map[string]string
+	has_iperf3     *bool
+	has_speedtest  *bool
+	mesh_address   *string
+	spool_depth    *int
+	addspool_depth *int
+	registered_at  *time.Time
+	last_seen_at   *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Daemon, error)
+	predicates     []predicate.Daemon
+}
+
+var _ ent.Mutation = (*DaemonMutation)(nil)
+
+// daemonOption allows management of the mutation configuration using functional options.
+type daemonOption func(*DaemonMutation)
+
+// newDaemonMutation creates new mutation for the Daemon entity.
+func newDaemonMutation(c config, op Op, opts ...daemonOption) *DaemonMutation {
+	m := &DaemonMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDaemon,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDaemonID sets the ID field of the mutation.
+func withDaemonID(id string) daemonOption {
+	return func(m *DaemonMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Daemon
+		)
+		m.oldValue = func(ctx context.Context) (*Daemon, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Daemon.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDaemon sets the old Daemon of the mutation.
+func withDaemon(node *Daemon) daemonOption {
+	return func(m *DaemonMutation) {
+		m.oldValue = func(context.Context) (*Daemon, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DaemonMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DaemonMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Daemon entities.
+func (m *DaemonMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DaemonMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DaemonMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Daemon.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DaemonMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DaemonMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *DaemonMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[daemon.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *DaemonMutation) NameCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DaemonMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, daemon.FieldName)
+}
+
+// SetHostname sets the "hostname" field.
+func (m *DaemonMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *DaemonMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (m *DaemonMutation) ClearHostname() {
+	m.hostname = nil
+	m.clearedFields[daemon.FieldHostname] = struct{}{}
+}
+
+// HostnameCleared returns if the "hostname" field was cleared in this mutation.
+func (m *DaemonMutation) HostnameCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldHostname]
+	return ok
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *DaemonMutation) ResetHostname() {
+	m.hostname = nil
+	delete(m.clearedFields, daemon.FieldHostname)
+}
+
+// SetVersion sets the "version" field.
+func (m *DaemonMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *DaemonMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *DaemonMutation) ClearVersion() {
+	m.version = nil
+	m.clearedFields[daemon.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *DaemonMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldVersion]
+	return ok
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *DaemonMutation) ResetVersion() {
+	m.version = nil
+	delete(m.clearedFields, daemon.FieldVersion)
+}
+
+// SetOs sets the "os" field.
+func (m *DaemonMutation) SetOs(s string) {
+	m.os = &s
+}
+
+// Os returns the value of the "os" field in the mutation.
+func (m *DaemonMutation) Os() (r string, exists bool) {
+	v := m.os
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOs returns the old "os" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldOs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+	}
+	return oldValue.Os, nil
+}
+
+// ClearOs clears the value of the "os" field.
+func (m *DaemonMutation) ClearOs() {
+	m.os = nil
+	m.clearedFields[daemon.FieldOs] = struct{}{}
+}
+
+// OsCleared returns if the "os" field was cleared in this mutation.
+func (m *DaemonMutation) OsCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldOs]
+	return ok
+}
+
+// ResetOs resets all changes to the "os" field.
+func (m *DaemonMutation) ResetOs() {
+	m.os = nil
+	delete(m.clearedFields, daemon.FieldOs)
+}
+
+// SetArch sets the "arch" field.
+func (m *DaemonMutation) SetArch(s string) {
+	m.arch = &s
+}
+
+// Arch returns the value of the "arch" field in the mutation.
+func (m *DaemonMutation) Arch() (r string, exists bool) {
+	v := m.arch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArch returns the old "arch" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldArch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArch: %w", err)
+	}
+	return oldValue.Arch, nil
+}
+
+// ClearArch clears the value of the "arch" field.
+func (m *DaemonMutation) ClearArch() {
+	m.arch = nil
+	m.clearedFields[daemon.FieldArch] = struct{}{}
+}
+
+// ArchCleared returns if the "arch" field was cleared in this mutation.
+func (m *DaemonMutation) ArchCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldArch]
+	return ok
+}
+
+// ResetArch resets all changes to the "arch" field.
+func (m *DaemonMutation) ResetArch() {
+	m.arch = nil
+	delete(m.clearedFields, daemon.FieldArch)
+}
+
+// SetLabels sets the "labels" field.
+func (m *DaemonMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *DaemonMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *DaemonMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[daemon.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *DaemonMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *DaemonMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, daemon.FieldLabels)
+}
+
+// SetHasIperf3 sets the "has_iperf3" field.
+func (m *DaemonMutation) SetHasIperf3(b bool) {
+	m.has_iperf3 = &b
+}
+
+// HasIperf3 returns the value of the "has_iperf3" field in the mutation.
+func (m *DaemonMutation) HasIperf3() (r bool, exists bool) {
+	v := m.has_iperf3
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasIperf3 returns the old "has_iperf3" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldHasIperf3(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasIperf3 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasIperf3 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasIperf3: %w", err)
+	}
+	return oldValue.HasIperf3, nil
+}
+
+// ResetHasIperf3 resets all changes to the "has_iperf3" field.
+func (m *DaemonMutation) ResetHasIperf3() {
+	m.has_iperf3 = nil
+}
+
+// SetHasSpeedtest sets the "has_speedtest" field.
+func (m *DaemonMutation) SetHasSpeedtest(b bool) {
+	m.has_speedtest = &b
+}
+
+// HasSpeedtest returns the value of the "has_speedtest" field in the mutation.
+func (m *DaemonMutation) HasSpeedtest() (r bool, exists bool) {
+	v := m.has_speedtest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasSpeedtest returns the old "has_speedtest" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldHasSpeedtest(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasSpeedtest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasSpeedtest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasSpeedtest: %w", err)
+	}
+	return oldValue.HasSpeedtest, nil
+}
+
+// ResetHasSpeedtest resets all changes to the "has_speedtest" field.
+func (m *DaemonMutation) ResetHasSpeedtest() {
+	m.has_speedtest = nil
+}
+
+// SetMeshAddress sets the "mesh_address" field.
+func (m *DaemonMutation) SetMeshAddress(s string) {
+	m.mesh_address = &s
+}
+
+// MeshAddress returns the value of the "mesh_address" field in the mutation.
+func (m *DaemonMutation) MeshAddress() (r string, exists bool) {
+	v := m.mesh_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeshAddress returns the old "mesh_address" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldMeshAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeshAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeshAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeshAddress: %w", err)
+	}
+	return oldValue.MeshAddress, nil
+}
+
+// ClearMeshAddress clears the value of the "mesh_address" field.
+func (m *DaemonMutation) ClearMeshAddress() {
+	m.mesh_address = nil
+	m.clearedFields[daemon.FieldMeshAddress] = struct{}{}
+}
+
+// MeshAddressCleared returns if the "mesh_address" field was cleared in this mutation.
+func (m *DaemonMutation) MeshAddressCleared() bool {
+	_, ok := m.clearedFields[daemon.FieldMeshAddress]
+	return ok
+}
+
+// ResetMeshAddress resets all changes to the "mesh_address" field.
+func (m *DaemonMutation) ResetMeshAddress() {
+	m.mesh_address = nil
+	delete(m.clearedFields, daemon.FieldMeshAddress)
+}
+
+// SetSpoolDepth sets the "spool_depth" field.
+func (m *DaemonMutation) SetSpoolDepth(i int) {
+	m.spool_depth = &i
+	m.addspool_depth = nil
+}
+
+// SpoolDepth returns the value of the "spool_depth" field in the mutation.
+func (m *DaemonMutation) SpoolDepth() (r int, exists bool) {
+	v := m.spool_depth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpoolDepth returns the old "spool_depth" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldSpoolDepth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpoolDepth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpoolDepth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpoolDepth: %w", err)
+	}
+	return oldValue.SpoolDepth, nil
+}
+
+// AddSpoolDepth adds i to the "spool_depth" field.
+func (m *DaemonMutation) AddSpoolDepth(i int) {
+	if m.addspool_depth != nil {
+		*m.addspool_depth += i
+	} else {
+		m.addspool_depth = &i
+	}
+}
+
+// AddedSpoolDepth returns the value that was added to the "spool_depth" field in this mutation.
+func (m *DaemonMutation) AddedSpoolDepth() (r int, exists bool) {
+	v := m.addspool_depth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpoolDepth resets all changes to the "spool_depth" field.
+func (m *DaemonMutation) ResetSpoolDepth() {
+	m.spool_depth = nil
+	m.addspool_depth = nil
+}
+
+// SetRegisteredAt sets the "registered_at" field.
+func (m *DaemonMutation) SetRegisteredAt(t time.Time) {
+	m.registered_at = &t
+}
+
+// RegisteredAt returns the value of the "registered_at" field in the mutation.
+func (m *DaemonMutation) RegisteredAt() (r time.Time, exists bool) {
+	v := m.registered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegisteredAt returns the old "registered_at" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldRegisteredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegisteredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegisteredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegisteredAt: %w", err)
+	}
+	return oldValue.RegisteredAt, nil
+}
+
+// ResetRegisteredAt resets all changes to the "registered_at" field.
+func (m *DaemonMutation) ResetRegisteredAt() {
+	m.registered_at = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *DaemonMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *DaemonMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Daemon entity.
+// If the Daemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *DaemonMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// Where appends a list predicates to the DaemonMutation builder.
+func (m *DaemonMutation) Where(ps ...predicate.Daemon) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DaemonMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DaemonMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Daemon, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DaemonMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DaemonMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Daemon).
+func (m *DaemonMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DaemonMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, daemon.FieldName)
+	}
+	if m.hostname != nil {
+		fields = append(fields, daemon.FieldHostname)
+	}
+	if m.version != nil {
+		fields = append(fields, daemon.FieldVersion)
+	}
+	if m.os != nil {
+		fields = append(fields, daemon.FieldOs)
+	}
+	if m.arch != nil {
+		fields = append(fields, daemon.FieldArch)
+	}
+	if m.labels != nil {
+		fields = append(fields, daemon.FieldLabels)
+	}
+	if m.has_iperf3 != nil {
+		fields = append(fields, daemon.FieldHasIperf3)
+	}
+	if m.has_speedtest != nil {
+		fields = append(fields, daemon.FieldHasSpeedtest)
+	}
+	if m.mesh_address != nil {
+		fields = append(fields, daemon.FieldMeshAddress)
+	}
+	if m.spool_depth != nil {
+		fields = append(fields, daemon.FieldSpoolDepth)
+	}
+	if m.registered_at != nil {
+		fields = append(fields, daemon.FieldRegisteredAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, daemon.FieldLastSeenAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DaemonMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case daemon.FieldName:
+		return m.Name()
+	case daemon.FieldHostname:
+		return m.Hostname()
+	case daemon.FieldVersion:
+		return m.Version()
+	case daemon.FieldOs:
+		return m.Os()
+	case daemon.FieldArch:
+		return m.Arch()
+	case daemon.FieldLabels:
+		return m.Labels()
+	case daemon.FieldHasIperf3:
+		return m.HasIperf3()
+	case daemon.FieldHasSpeedtest:
+		return m.HasSpeedtest()
+	case daemon.FieldMeshAddress:
+		return m.MeshAddress()
+	case daemon.FieldSpoolDepth:
+		return m.SpoolDepth()
+	case daemon.FieldRegisteredAt:
+		return m.RegisteredAt()
+	case daemon.FieldLastSeenAt:
+		return m.LastSeenAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DaemonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case daemon.FieldName:
+		return m.OldName(ctx)
+	case daemon.FieldHostname:
+		return m.OldHostname(ctx)
+	case daemon.FieldVersion:
+		return m.OldVersion(ctx)
+	case daemon.FieldOs:
+		return m.OldOs(ctx)
+	case daemon.FieldArch:
+		return m.OldArch(ctx)
+	case daemon.FieldLabels:
+		return m.OldLabels(ctx)
+	case daemon.FieldHasIperf3:
+		return m.OldHasIperf3(ctx)
+	case daemon.FieldHasSpeedtest:
+		return m.OldHasSpeedtest(ctx)
+	case daemon.FieldMeshAddress:
+		return m.OldMeshAddress(ctx)
+	case daemon.FieldSpoolDepth:
+		return m.OldSpoolDepth(ctx)
+	case daemon.FieldRegisteredAt:
+		return m.OldRegisteredAt(ctx)
+	case daemon.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Daemon field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DaemonMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case daemon.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case daemon.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case daemon.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case daemon.FieldOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOs(v)
+		return nil
+	case daemon.FieldArch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArch(v)
+		return nil
+	case daemon.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case daemon.FieldHasIperf3:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasIperf3(v)
+		return nil
+	case daemon.FieldHasSpeedtest:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasSpeedtest(v)
+		return nil
+	case daemon.FieldMeshAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeshAddress(v)
+		return nil
+	case daemon.FieldSpoolDepth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpoolDepth(v)
+		return nil
+	case daemon.FieldRegisteredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegisteredAt(v)
+		return nil
+	case daemon.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Daemon field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DaemonMutation) AddedFields() []string {
+	var fields []string
+	if m.addspool_depth != nil {
+		fields = append(fields, daemon.FieldSpoolDepth)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DaemonMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case daemon.FieldSpoolDepth:
+		return m.AddedSpoolDepth()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DaemonMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case daemon.FieldSpoolDepth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpoolDepth(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Daemon numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DaemonMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(daemon.FieldName) {
+		fields = append(fields, daemon.FieldName)
+	}
+	if m.FieldCleared(daemon.FieldHostname) {
+		fields = append(fields, daemon.FieldHostname)
+	}
+	if m.FieldCleared(daemon.FieldVersion) {
+		fields = append(fields, daemon.FieldVersion)
+	}
+	if m.FieldCleared(daemon.FieldOs) {
+		fields = append(fields, daemon.FieldOs)
+	}
+	if m.FieldCleared(daemon.FieldArch) {
+		fields = append(fields, daemon.FieldArch)
+	}
+	if m.FieldCleared(daemon.FieldLabels) {
+		fields = append(fields, daemon.FieldLabels)
+	}
+	if m.FieldCleared(daemon.FieldMeshAddress) {
+		fields = append(fields, daemon.FieldMeshAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DaemonMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DaemonMutation) ClearField(name string) error {
+	switch name {
+	case daemon.FieldName:
+		m.ClearName()
+		return nil
+	case daemon.FieldHostname:
+		m.ClearHostname()
+		return nil
+	case daemon.FieldVersion:
+		m.ClearVersion()
+		return nil
+	case daemon.FieldOs:
+		m.ClearOs()
+		return nil
+	case daemon.FieldArch:
+		m.ClearArch()
+		return nil
+	case daemon.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case daemon.FieldMeshAddress:
+		m.ClearMeshAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown Daemon nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DaemonMutation) ResetField(name string) error {
+	switch name {
+	case daemon.FieldName:
+		m.ResetName()
+		return nil
+	case daemon.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case daemon.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case daemon.FieldOs:
+		m.ResetOs()
+		return nil
+	case daemon.FieldArch:
+		m.ResetArch()
+		return nil
+	case daemon.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case daemon.FieldHasIperf3:
+		m.ResetHasIperf3()
+		return nil
+	case daemon.FieldHasSpeedtest:
+		m.ResetHasSpeedtest()
+		return nil
+	case daemon.FieldMeshAddress:
+		m.ResetMeshAddress()
+		return nil
+	case daemon.FieldSpoolDepth:
+		m.ResetSpoolDepth()
+		return nil
+	case daemon.FieldRegisteredAt:
+		m.ResetRegisteredAt()
+		return nil
+	case daemon.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Daemon field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DaemonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DaemonMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DaemonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DaemonMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DaemonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DaemonMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DaemonMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Daemon unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DaemonMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Daemon edge %s", name)
+}
+
+// DaemonConfigMutation represents an operation that mutates the DaemonConfig nodes in the graph.
+type DaemonConfigMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *int
+	name                          *string
+	daemon_id                     *string
+	match_labels                  *map[string]string
+	priority                      *int
+	addpriority                   *int
+	speedtest_interval_seconds    *int
+	addspeedtest_interval_seconds *int
+	iperf_interval_seconds        *int
+	addiperf_interval_seconds     *int
+	iperf_duration_seconds        *int
+	addiperf_duration_seconds     *int
+	speedtest_enabled             *bool
+	iperf_enabled                 *bool
+	adaptive_enabled              *bool
+	host_types                    *[]string
+	appendhost_types              []string
+	host_ids                      *[]int
+	appendhost_ids                []int
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*DaemonConfig, error)
+	predicates                    []predicate.DaemonConfig
+}
+
+var _ ent.Mutation = (*DaemonConfigMutation)(nil)
+
+// daemonconfigOption allows management of the mutation configuration using functional options.
+type daemonconfigOption func(*DaemonConfigMutation)
+
+// newDaemonConfigMutation creates new mutation for the DaemonConfig entity.
+func newDaemonConfigMutation(c config, op Op, opts ...daemonconfigOption) *DaemonConfigMutation {
+	m := &DaemonConfigMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDaemonConfig,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDaemonConfigID sets the ID field of the mutation.
+func withDaemonConfigID(id int) daemonconfigOption {
+	return func(m *DaemonConfigMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DaemonConfig
+		)
+		m.oldValue = func(ctx context.Context) (*DaemonConfig, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DaemonConfig.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDaemonConfig sets the old DaemonConfig of the mutation.
+func withDaemonConfig(node *DaemonConfig) daemonconfigOption {
+	return func(m *DaemonConfigMutation) {
+		m.oldValue = func(context.Context) (*DaemonConfig, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DaemonConfigMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DaemonConfigMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DaemonConfigMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DaemonConfigMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DaemonConfig.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DaemonConfigMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DaemonConfigMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DaemonConfigMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (m *DaemonConfigMutation) SetDaemonID(s string) {
+	m.daemon_id = &s
+}
+
+// DaemonID returns the value of the "daemon_id" field in the mutation.
+func (m *DaemonConfigMutation) DaemonID() (r string, exists bool) {
+	v := m.daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaemonID returns the old "daemon_id" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldDaemonID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaemonID: %w", err)
+	}
+	return oldValue.DaemonID, nil
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (m *DaemonConfigMutation) ClearDaemonID() {
+	m.daemon_id = nil
+	m.clearedFields[daemonconfig.FieldDaemonID] = struct{}{}
+}
+
+// DaemonIDCleared returns if the "daemon_id" field was cleared in this mutation.
+func (m *DaemonConfigMutation) DaemonIDCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldDaemonID]
+	return ok
+}
+
+// ResetDaemonID resets all changes to the "daemon_id" field.
+func (m *DaemonConfigMutation) ResetDaemonID() {
+	m.daemon_id = nil
+	delete(m.clearedFields, daemonconfig.FieldDaemonID)
+}
+
+// SetMatchLabels sets the "match_labels" field.
+func (m *DaemonConfigMutation) SetMatchLabels(value map[string]string) {
+	m.match_labels = &value
+}
+
+// MatchLabels returns the value of the "match_labels" field in the mutation.
+func (m *DaemonConfigMutation) MatchLabels() (r map[string]string, exists bool) {
+	v := m.match_labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMatchLabels returns the old "match_labels" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldMatchLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatchLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatchLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatchLabels: %w", err)
+	}
+	return oldValue.MatchLabels, nil
+}
+
+// ClearMatchLabels clears the value of the "match_labels" field.
+func (m *DaemonConfigMutation) ClearMatchLabels() {
+	m.match_labels = nil
+	m.clearedFields[daemonconfig.FieldMatchLabels] = struct{}{}
+}
+
+// MatchLabelsCleared returns if the "match_labels" field was cleared in this mutation.
+func (m *DaemonConfigMutation) MatchLabelsCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldMatchLabels]
+	return ok
+}
+
+// ResetMatchLabels resets all changes to the "match_labels" field.
+func (m *DaemonConfigMutation) ResetMatchLabels() {
+	m.match_labels = nil
+	delete(m.clearedFields, daemonconfig.FieldMatchLabels)
+}
+
+// SetPriority sets the "priority" field.
+func (m *DaemonConfigMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *DaemonConfigMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *DaemonConfigMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *DaemonConfigMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *DaemonConfigMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetSpeedtestIntervalSeconds sets the "speedtest_interval_seconds" field.
+func (m *DaemonConfigMutation) SetSpeedtestIntervalSeconds(i int) {
+	m.speedtest_interval_seconds = &i
+	m.addspeedtest_interval_seconds = nil
+}
+
+// SpeedtestIntervalSeconds returns the value of the "speedtest_interval_seconds" field in the mutation.
+func (m *DaemonConfigMutation) SpeedtestIntervalSeconds() (r int, exists bool) {
+	v := m.speedtest_interval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpeedtestIntervalSeconds returns the old "speedtest_interval_seconds" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldSpeedtestIntervalSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeedtestIntervalSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeedtestIntervalSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeedtestIntervalSeconds: %w", err)
+	}
+	return oldValue.SpeedtestIntervalSeconds, nil
+}
+
+// AddSpeedtestIntervalSeconds adds i to the "speedtest_interval_seconds" field.
+func (m *DaemonConfigMutation) AddSpeedtestIntervalSeconds(i int) {
+	if m.addspeedtest_interval_seconds != nil {
+		*m.addspeedtest_interval_seconds += i
+	} else {
+		m.addspeedtest_interval_seconds = &i
+	}
+}
+
+// AddedSpeedtestIntervalSeconds returns the value that was added to the "speedtest_interval_seconds" field in this mutation.
+func (m *DaemonConfigMutation) AddedSpeedtestIntervalSeconds() (r int, exists bool) {
+	v := m.addspeedtest_interval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSpeedtestIntervalSeconds clears the value of the "speedtest_interval_seconds" field.
+func (m *DaemonConfigMutation) ClearSpeedtestIntervalSeconds() {
+	m.speedtest_interval_seconds = nil
+	m.addspeedtest_interval_seconds = nil
+	m.clearedFields[daemonconfig.FieldSpeedtestIntervalSeconds] = struct{}{}
+}
+
+// SpeedtestIntervalSecondsCleared returns if the "speedtest_interval_seconds" field was cleared in this mutation.
+func (m *DaemonConfigMutation) SpeedtestIntervalSecondsCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldSpeedtestIntervalSeconds]
+	return ok
+}
+
+// ResetSpeedtestIntervalSeconds resets all changes to the "speedtest_interval_seconds" field.
+func (m *DaemonConfigMutation) ResetSpeedtestIntervalSeconds() {
+	m.speedtest_interval_seconds = nil
+	m.addspeedtest_interval_seconds = nil
+	delete(m.clearedFields, daemonconfig.FieldSpeedtestIntervalSeconds)
+}
+
+// SetIperfIntervalSeconds sets the "iperf_interval_seconds" field.
+func (m *DaemonConfigMutation) SetIperfIntervalSeconds(i int) {
+	m.iperf_interval_seconds = &i
+	m.addiperf_interval_seconds = nil
+}
+
+// IperfIntervalSeconds returns the value of the "iperf_interval_seconds" field in the mutation.
+func (m *DaemonConfigMutation) IperfIntervalSeconds() (r int, exists bool) {
+	v := m.iperf_interval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIperfIntervalSeconds returns the old "iperf_interval_seconds" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldIperfIntervalSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIperfIntervalSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIperfIntervalSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIperfIntervalSeconds: %w", err)
+	}
+	return oldValue.IperfIntervalSeconds, nil
+}
+
+// AddIperfIntervalSeconds adds i to the "iperf_interval_seconds" field.
+func (m *DaemonConfigMutation) AddIperfIntervalSeconds(i int) {
+	if m.addiperf_interval_seconds != nil {
+		*m.addiperf_interval_seconds += i
+	} else {
+		m.addiperf_interval_seconds = &i
+	}
+}
+
+// AddedIperfIntervalSeconds returns the value that was added to the "iperf_interval_seconds" field in this mutation.
+func (m *DaemonConfigMutation) AddedIperfIntervalSeconds() (r int, exists bool) {
+	v := m.addiperf_interval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIperfIntervalSeconds clears the value of the "iperf_interval_seconds" field.
+func (m *DaemonConfigMutation) ClearIperfIntervalSeconds() {
+	m.iperf_interval_seconds = nil
+	m.addiperf_interval_seconds = nil
+	m.clearedFields[daemonconfig.FieldIperfIntervalSeconds] = struct{}{}
+}
+
+// IperfIntervalSecondsCleared returns if the "iperf_interval_seconds" field was cleared in this mutation.
+func (m *DaemonConfigMutation) IperfIntervalSecondsCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldIperfIntervalSeconds]
+	return ok
+}
+
+// ResetIperfIntervalSeconds resets all changes to the "iperf_interval_seconds" field.
+func (m *DaemonConfigMutation) ResetIperfIntervalSeconds() {
+	m.iperf_interval_seconds = nil
+	m.addiperf_interval_seconds = nil
+	delete(m.clearedFields, daemonconfig.FieldIperfIntervalSeconds)
+}
+
+// SetIperfDurationSeconds sets the "iperf_duration_seconds" field.
+func (m *DaemonConfigMutation) SetIperfDurationSeconds(i int) {
+	m.iperf_duration_seconds = &i
+	m.addiperf_duration_seconds = nil
+}
+
+// IperfDurationSeconds returns the value of the "iperf_duration_seconds" field in the mutation.
+func (m *DaemonConfigMutation) IperfDurationSeconds() (r int, exists bool) {
+	v := m.iperf_duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIperfDurationSeconds returns the old "iperf_duration_seconds" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldIperfDurationSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIperfDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIperfDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIperfDurationSeconds: %w", err)
+	}
+	return oldValue.IperfDurationSeconds, nil
+}
+
+// AddIperfDurationSeconds adds i to the "iperf_duration_seconds" field.
+func (m *DaemonConfigMutation) AddIperfDurationSeconds(i int) {
+	if m.addiperf_duration_seconds != nil {
+		*m.addiperf_duration_seconds += i
+	} else {
+		m.addiperf_duration_seconds = &i
+	}
+}
+
+// AddedIperfDurationSeconds returns the value that was added to the "iperf_duration_seconds" field in this mutation.
+func (m *DaemonConfigMutation) AddedIperfDurationSeconds() (r int, exists bool) {
+	v := m.addiperf_duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIperfDurationSeconds clears the value of the "iperf_duration_seconds" field.
+func (m *DaemonConfigMutation) ClearIperfDurationSeconds() {
+	m.iperf_duration_seconds = nil
+	m.addiperf_duration_seconds = nil
+	m.clearedFields[daemonconfig.FieldIperfDurationSeconds] = struct{}{}
+}
+
+// IperfDurationSecondsCleared returns if the "iperf_duration_seconds" field was cleared in this mutation.
+func (m *DaemonConfigMutation) IperfDurationSecondsCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldIperfDurationSeconds]
+	return ok
+}
+
+// ResetIperfDurationSeconds resets all changes to the "iperf_duration_seconds" field.
+func (m *DaemonConfigMutation) ResetIperfDurationSeconds() {
+	m.iperf_duration_seconds = nil
+	m.addiperf_duration_seconds = nil
+	delete(m.clearedFields, daemonconfig.FieldIperfDurationSeconds)
+}
+
+// SetSpeedtestEnabled sets the "speedtest_enabled" field.
+func (m *DaemonConfigMutation) SetSpeedtestEnabled(b bool) {
+	m.speedtest_enabled = &b
+}
+
+// SpeedtestEnabled returns the value of the "speedtest_enabled" field in the mutation.
+func (m *DaemonConfigMutation) SpeedtestEnabled() (r bool, exists bool) {
+	v := m.speedtest_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpeedtestEnabled returns the old "speedtest_enabled" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldSpeedtestEnabled(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeedtestEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeedtestEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeedtestEnabled: %w", err)
+	}
+	return oldValue.SpeedtestEnabled, nil
+}
+
+// ClearSpeedtestEnabled clears the value of the "speedtest_enabled" field.
+func (m *DaemonConfigMutation) ClearSpeedtestEnabled() {
+	m.speedtest_enabled = nil
+	m.clearedFields[daemonconfig.FieldSpeedtestEnabled] = struct{}{}
+}
+
+// SpeedtestEnabledCleared returns if the "speedtest_enabled" field was cleared in this mutation.
+func (m *DaemonConfigMutation) SpeedtestEnabledCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldSpeedtestEnabled]
+	return ok
+}
+
+// ResetSpeedtestEnabled resets all changes to the "speedtest_enabled" field.
+func (m *DaemonConfigMutation) ResetSpeedtestEnabled() {
+	m.speedtest_enabled = nil
+	delete(m.clearedFields, daemonconfig.FieldSpeedtestEnabled)
+}
+
+// SetIperfEnabled sets the "iperf_enabled" field.
+func (m *DaemonConfigMutation) SetIperfEnabled(b bool) {
+	m.iperf_enabled = &b
+}
+
+// IperfEnabled returns the value of the "iperf_enabled" field in the mutation.
+func (m *DaemonConfigMutation) IperfEnabled() (r bool, exists bool) {
+	v := m.iperf_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIperfEnabled returns the old "iperf_enabled" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldIperfEnabled(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIperfEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIperfEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIperfEnabled: %w", err)
+	}
+	return oldValue.IperfEnabled, nil
+}
+
+// ClearIperfEnabled clears the value of the "iperf_enabled" field.
+func (m *DaemonConfigMutation) ClearIperfEnabled() {
+	m.iperf_enabled = nil
+	m.clearedFields[daemonconfig.FieldIperfEnabled] = struct{}{}
+}
+
+// IperfEnabledCleared returns if the "iperf_enabled" field was cleared in this mutation.
+func (m *DaemonConfigMutation) IperfEnabledCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldIperfEnabled]
+	return ok
+}
+
+// ResetIperfEnabled resets all changes to the "iperf_enabled" field.
+func (m *DaemonConfigMutation) ResetIperfEnabled() {
+	m.iperf_enabled = nil
+	delete(m.clearedFields, daemonconfig.FieldIperfEnabled)
+}
+
+// SetAdaptiveEnabled sets the "adaptive_enabled" field.
+func (m *DaemonConfigMutation) SetAdaptiveEnabled(b bool) {
+	m.adaptive_enabled = &b
+}
+
+// AdaptiveEnabled returns the value of the "adaptive_enabled" field in the mutation.
+func (m *DaemonConfigMutation) AdaptiveEnabled() (r bool, exists bool) {
+	v := m.adaptive_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdaptiveEnabled returns the old "adaptive_enabled" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldAdaptiveEnabled(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdaptiveEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdaptiveEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdaptiveEnabled: %w", err)
+	}
+	return oldValue.AdaptiveEnabled, nil
+}
+
+// ClearAdaptiveEnabled clears the value of the "adaptive_enabled" field.
+func (m *DaemonConfigMutation) ClearAdaptiveEnabled() {
+	m.adaptive_enabled = nil
+	m.clearedFields[daemonconfig.FieldAdaptiveEnabled] = struct{}{}
+}
+
+// AdaptiveEnabledCleared returns if the "adaptive_enabled" field was cleared in this mutation.
+func (m *DaemonConfigMutation) AdaptiveEnabledCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldAdaptiveEnabled]
+	return ok
+}
+
+// ResetAdaptiveEnabled resets all changes to the "adaptive_enabled" field.
+func (m *DaemonConfigMutation) ResetAdaptiveEnabled() {
+	m.adaptive_enabled = nil
+	delete(m.clearedFields, daemonconfig.FieldAdaptiveEnabled)
+}
+
+// SetHostTypes sets the "host_types" field.
+func (m *DaemonConfigMutation) SetHostTypes(s []string) {
+	m.host_types = &s
+	m.appendhost_types = nil
+}
+
+// HostTypes returns the value of the "host_types" field in the mutation.
+func (m *DaemonConfigMutation) HostTypes() (r []string, exists bool) {
+	v := m.host_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostTypes returns the old "host_types" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldHostTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostTypes: %w", err)
+	}
+	return oldValue.HostTypes, nil
+}
+
+// AppendHostTypes adds s to the "host_types" field.
+func (m *DaemonConfigMutation) AppendHostTypes(s []string) {
+	m.appendhost_types = append(m.appendhost_types, s...)
+}
+
+// AppendedHostTypes returns the list of values that were appended to the "host_types" field in this mutation.
+func (m *DaemonConfigMutation) AppendedHostTypes() ([]string, bool) {
+	if len(m.appendhost_types) == 0 {
+		return nil, false
+	}
+	return m.appendhost_types, true
+}
+
+// ClearHostTypes clears the value of the "host_types" field.
+func (m *DaemonConfigMutation) ClearHostTypes() {
+	m.host_types = nil
+	m.appendhost_types = nil
+	m.clearedFields[daemonconfig.FieldHostTypes] = struct{}{}
+}
+
+// HostTypesCleared returns if the "host_types" field was cleared in this mutation.
+func (m *DaemonConfigMutation) HostTypesCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldHostTypes]
+	return ok
+}
+
+// ResetHostTypes resets all changes to the "host_types" field.
+func (m *DaemonConfigMutation) ResetHostTypes() {
+	m.host_types = nil
+	m.appendhost_types = nil
+	delete(m.clearedFields, daemonconfig.FieldHostTypes)
+}
+
+// SetHostIds sets the "host_ids" field.
+func (m *DaemonConfigMutation) SetHostIds(i []int) {
+	m.host_ids = &i
+	m.appendhost_ids = nil
+}
+
+// HostIds returns the value of the "host_ids" field in the mutation.
+func (m *DaemonConfigMutation) HostIds() (r []int, exists bool) {
+	v := m.host_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostIds returns the old "host_ids" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldHostIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostIds: %w", err)
+	}
+	return oldValue.HostIds, nil
+}
+
+// AppendHostIds adds i to the "host_ids" field.
+func (m *DaemonConfigMutation) AppendHostIds(i []int) {
+	m.appendhost_ids = append(m.appendhost_ids, i...)
+}
+
+// AppendedHostIds returns the list of values that were appended to the "host_ids" field in this mutation.
+func (m *DaemonConfigMutation) AppendedHostIds() ([]int, bool) {
+	if len(m.appendhost_ids) == 0 {
+		return nil, false
+	}
+	return m.appendhost_ids, true
+}
+
+// ClearHostIds clears the value of the "host_ids" field.
+func (m *DaemonConfigMutation) ClearHostIds() {
+	m.host_ids = nil
+	m.appendhost_ids = nil
+	m.clearedFields[daemonconfig.FieldHostIds] = struct{}{}
+}
+
+// HostIdsCleared returns if the "host_ids" field was cleared in this mutation.
+func (m *DaemonConfigMutation) HostIdsCleared() bool {
+	_, ok := m.clearedFields[daemonconfig.FieldHostIds]
+	return ok
+}
+
+// ResetHostIds resets all changes to the "host_ids" field.
+func (m *DaemonConfigMutation) ResetHostIds() {
+	m.host_ids = nil
+	m.appendhost_ids = nil
+	delete(m.clearedFields, daemonconfig.FieldHostIds)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DaemonConfigMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DaemonConfigMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DaemonConfigMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DaemonConfigMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DaemonConfigMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DaemonConfig entity.
+// If the DaemonConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DaemonConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DaemonConfigMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DaemonConfigMutation builder.
+func (m *DaemonConfigMutation) Where(ps ...predicate.DaemonConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DaemonConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DaemonConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DaemonConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DaemonConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DaemonConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DaemonConfig).
+func (m *DaemonConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DaemonConfigMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name != nil {
+		fields = append(fields, daemonconfig.FieldName)
+	}
+	if m.daemon_id != nil {
+		fields = append(fields, daemonconfig.FieldDaemonID)
+	}
+	if m.match_labels != nil {
+		fields = append(fields, daemonconfig.FieldMatchLabels)
+	}
+	if m.priority != nil {
+		fields = append(fields, daemonconfig.FieldPriority)
+	}
+	if m.speedtest_interval_seconds != nil {
+		fields = append(fields, daemonconfig.FieldSpeedtestIntervalSeconds)
+	}
+	if m.iperf_interval_seconds != nil {
+		fields = append(fields, daemonconfig.FieldIperfIntervalSeconds)
+	}
+	if m.iperf_duration_seconds != nil {
+		fields = append(fields, daemonconfig.FieldIperfDurationSeconds)
+	}
+	if m.speedtest_enabled != nil {
+		fields = append(fields, daemonconfig.FieldSpeedtestEnabled)
+	}
+	if m.iperf_enabled != nil {
+		fields = append(fields, daemonconfig.FieldIperfEnabled)
+	}
+	if m.adaptive_enabled != nil {
+		fields = append(fields, daemonconfig.FieldAdaptiveEnabled)
+	}
+	if m.host_types != nil {
+		fields = append(fields, daemonconfig.FieldHostTypes)
+	}
+	if m.host_ids != nil {
+		fields = append(fields, daemonconfig.FieldHostIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, daemonconfig.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, daemonconfig.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DaemonConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case daemonconfig.FieldName:
+		return m.Name()
+	case daemonconfig.FieldDaemonID:
+		return m.DaemonID()
+	case daemonconfig.FieldMatchLabels:
+		return m.MatchLabels()
+	case daemonconfig.FieldPriority:
+		return m.Priority()
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		return m.SpeedtestIntervalSeconds()
+	case daemonconfig.FieldIperfIntervalSeconds:
+		return m.IperfIntervalSeconds()
+	case daemonconfig.FieldIperfDurationSeconds:
+		return m.IperfDurationSeconds()
+	case daemonconfig.FieldSpeedtestEnabled:
+		return m.SpeedtestEnabled()
+	case daemonconfig.FieldIperfEnabled:
+		return m.IperfEnabled()
+	case daemonconfig.FieldAdaptiveEnabled:
+		return m.AdaptiveEnabled()
+	case daemonconfig.FieldHostTypes:
+		return m.HostTypes()
+	case daemonconfig.FieldHostIds:
+		return m.HostIds()
+	case daemonconfig.FieldCreatedAt:
+		return m.CreatedAt()
+	case daemonconfig.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DaemonConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case daemonconfig.FieldName:
+		return m.OldName(ctx)
+	case daemonconfig.FieldDaemonID:
+		return m.OldDaemonID(ctx)
+	case daemonconfig.FieldMatchLabels:
+		return m.OldMatchLabels(ctx)
+	case daemonconfig.FieldPriority:
+		return m.OldPriority(ctx)
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		return m.OldSpeedtestIntervalSeconds(ctx)
+	case daemonconfig.FieldIperfIntervalSeconds:
+		return m.OldIperfIntervalSeconds(ctx)
+	case daemonconfig.FieldIperfDurationSeconds:
+		return m.OldIperfDurationSeconds(ctx)
+	case daemonconfig.FieldSpeedtestEnabled:
+		return m.OldSpeedtestEnabled(ctx)
+	case daemonconfig.FieldIperfEnabled:
+		return m.OldIperfEnabled(ctx)
+	case daemonconfig.FieldAdaptiveEnabled:
+		return m.OldAdaptiveEnabled(ctx)
+	case daemonconfig.FieldHostTypes:
+		return m.OldHostTypes(ctx)
+	case daemonconfig.FieldHostIds:
+		return m.OldHostIds(ctx)
+	case daemonconfig.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case daemonconfig.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DaemonConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DaemonConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case daemonconfig.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case daemonconfig.FieldDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonID(v)
+		return nil
+	case daemonconfig.FieldMatchLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchLabels(v)
+		return nil
+	case daemonconfig.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeedtestIntervalSeconds(v)
+		return nil
+	case daemonconfig.FieldIperfIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIperfIntervalSeconds(v)
+		return nil
+	case daemonconfig.FieldIperfDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIperfDurationSeconds(v)
+		return nil
+	case daemonconfig.FieldSpeedtestEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeedtestEnabled(v)
+		return nil
+	case daemonconfig.FieldIperfEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIperfEnabled(v)
+		return nil
+	case daemonconfig.FieldAdaptiveEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveEnabled(v)
+		return nil
+	case daemonconfig.FieldHostTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostTypes(v)
+		return nil
+	case daemonconfig.FieldHostIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostIds(v)
+		return nil
+	case daemonconfig.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case daemonconfig.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DaemonConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DaemonConfigMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, daemonconfig.FieldPriority)
+	}
+	if m.addspeedtest_interval_seconds != nil {
+		fields = append(fields, daemonconfig.FieldSpeedtestIntervalSeconds)
+	}
+	if m.addiperf_interval_seconds != nil {
+		fields = append(fields, daemonconfig.FieldIperfIntervalSeconds)
+	}
+	if m.addiperf_duration_seconds != nil {
+		fields = append(fields, daemonconfig.FieldIperfDurationSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DaemonConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case daemonconfig.FieldPriority:
+		return m.AddedPriority()
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		return m.AddedSpeedtestIntervalSeconds()
+	case daemonconfig.FieldIperfIntervalSeconds:
+		return m.AddedIperfIntervalSeconds()
+	case daemonconfig.FieldIperfDurationSeconds:
+		return m.AddedIperfDurationSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DaemonConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case daemonconfig.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeedtestIntervalSeconds(v)
+		return nil
+	case daemonconfig.FieldIperfIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIperfIntervalSeconds(v)
+		return nil
+	case daemonconfig.FieldIperfDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIperfDurationSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DaemonConfig numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DaemonConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(daemonconfig.FieldDaemonID) {
+		fields = append(fields, daemonconfig.FieldDaemonID)
+	}
+	if m.FieldCleared(daemonconfig.FieldMatchLabels) {
+		fields = append(fields, daemonconfig.FieldMatchLabels)
+	}
+	if m.FieldCleared(daemonconfig.FieldSpeedtestIntervalSeconds) {
+		fields = append(fields, daemonconfig.FieldSpeedtestIntervalSeconds)
+	}
+	if m.FieldCleared(daemonconfig.FieldIperfIntervalSeconds) {
+		fields = append(fields, daemonconfig.FieldIperfIntervalSeconds)
+	}
+	if m.FieldCleared(daemonconfig.FieldIperfDurationSeconds) {
+		fields = append(fields, daemonconfig.FieldIperfDurationSeconds)
+	}
+	if m.FieldCleared(daemonconfig.FieldSpeedtestEnabled) {
+		fields = append(fields, daemonconfig.FieldSpeedtestEnabled)
+	}
+	if m.FieldCleared(daemonconfig.FieldIperfEnabled) {
+		fields = append(fields, daemonconfig.FieldIperfEnabled)
+	}
+	if m.FieldCleared(daemonconfig.FieldAdaptiveEnabled) {
+		fields = append(fields, daemonconfig.FieldAdaptiveEnabled)
+	}
+	if m.FieldCleared(daemonconfig.FieldHostTypes) {
+		fields = append(fields, daemonconfig.FieldHostTypes)
+	}
+	if m.FieldCleared(daemonconfig.FieldHostIds) {
+		fields = append(fields, daemonconfig.FieldHostIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DaemonConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DaemonConfigMutation) ClearField(name string) error {
+	switch name {
+	case daemonconfig.FieldDaemonID:
+		m.ClearDaemonID()
+		return nil
+	case daemonconfig.FieldMatchLabels:
+		m.ClearMatchLabels()
+		return nil
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		m.ClearSpeedtestIntervalSeconds()
+		return nil
+	case daemonconfig.FieldIperfIntervalSeconds:
+		m.ClearIperfIntervalSeconds()
+		return nil
+	case daemonconfig.FieldIperfDurationSeconds:
+		m.ClearIperfDurationSeconds()
+		return nil
+	case daemonconfig.FieldSpeedtestEnabled:
+		m.ClearSpeedtestEnabled()
+		return nil
+	case daemonconfig.FieldIperfEnabled:
+		m.ClearIperfEnabled()
+		return nil
+	case daemonconfig.FieldAdaptiveEnabled:
+		m.ClearAdaptiveEnabled()
+		return nil
+	case daemonconfig.FieldHostTypes:
+		m.ClearHostTypes()
+		return nil
+	case daemonconfig.FieldHostIds:
+		m.ClearHostIds()
+		return nil
+	}
+	return fmt.Errorf("unknown DaemonConfig nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DaemonConfigMutation) ResetField(name string) error {
+	switch name {
+	case daemonconfig.FieldName:
+		m.ResetName()
+		return nil
+	case daemonconfig.FieldDaemonID:
+		m.ResetDaemonID()
+		return nil
+	case daemonconfig.FieldMatchLabels:
+		m.ResetMatchLabels()
+		return nil
+	case daemonconfig.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case daemonconfig.FieldSpeedtestIntervalSeconds:
+		m.ResetSpeedtestIntervalSeconds()
+		return nil
+	case daemonconfig.FieldIperfIntervalSeconds:
+		m.ResetIperfIntervalSeconds()
+		return nil
+	case daemonconfig.FieldIperfDurationSeconds:
+		m.ResetIperfDurationSeconds()
+		return nil
+	case daemonconfig.FieldSpeedtestEnabled:
+		m.ResetSpeedtestEnabled()
+		return nil
+	case daemonconfig.FieldIperfEnabled:
+		m.ResetIperfEnabled()
+		return nil
+	case daemonconfig.FieldAdaptiveEnabled:
+		m.ResetAdaptiveEnabled()
+		return nil
+	case daemonconfig.FieldHostTypes:
+		m.ResetHostTypes()
+		return nil
+	case daemonconfig.FieldHostIds:
+		m.ResetHostIds()
+		return nil
+	case daemonconfig.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case daemonconfig.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DaemonConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DaemonConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DaemonConfigMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DaemonConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DaemonConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DaemonConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DaemonConfigMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DaemonConfigMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DaemonConfig unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DaemonConfigMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DaemonConfig edge %s", name)
+}
+
+// HostMutation represents an operation that mutates the Host nodes in the graph.
+type HostMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	hostname           *string
+	port               *int
+	addport            *int
+	_type              *host.Type
+	active             *bool
+	description        *string
+	daemon_selector    *map[string]string
+	daemon_ids         *[]string
+	appenddaemon_ids   []string
+	clearedFields      map[string]struct{}
+	iperf_tests        map[int]struct{}
+	removediperf_tests map[int]struct{}
+	clearediperf_tests bool
+	jobs               map[int]struct{}
+	removedjobs        map[int]struct{}
+	clearedjobs        bool
+	test_runs          map[int]struct{}
+	removedtest_runs   map[int]struct{}
+	clearedtest_runs   bool
+	done               bool
+	oldValue           func(context.Context) (*Host, error)
+	predicates         []predicate.Host
+}
+
+var _ ent.Mutation = (*HostMutation)(nil)
+
+// hostOption allows management of the mutation configuration using functional options.
+type hostOption func(*HostMutation)
+
+// newHostMutation creates new mutation for the Host entity.
+func newHostMutation(c config, op Op, opts ...hostOption) *HostMutation {
+	m := &HostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHostID sets the ID field of the mutation.
+func withHostID(id int) hostOption {
+	return func(m *HostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Host
+		)
+		m.oldValue = func(ctx context.Context) (*Host, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Host.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHost sets the old Host of the mutation.
+func withHost(node *Host) hostOption {
+	return func(m *HostMutation) {
+		m.oldValue = func(context.Context) (*Host, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HostMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HostMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Host.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *HostMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *HostMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *HostMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHostname sets the "hostname" field.
+func (m *HostMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *HostMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *HostMutation) ResetHostname() {
+	m.hostname = nil
+}
+
+// SetPort sets the "port" field.
+func (m *HostMutation) SetPort(i int) {
+	m.port = &i
+	m.addport = nil
+}
+
+// Port returns the value of the "port" field in the mutation.
+func (m *HostMutation) Port() (r int, exists bool) {
+	v := m.port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPort returns the old "port" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+	}
+	return oldValue.Port, nil
+}
+
+// AddPort adds i to the "port" field.
+func (m *HostMutation) AddPort(i int) {
+	if m.addport != nil {
+		*m.addport += i
+	} else {
+		m.addport = &i
+	}
+}
+
+// AddedPort returns the value that was added to the "port" field in this mutation.
+func (m *HostMutation) AddedPort() (r int, exists bool) {
+	v := m.addport
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPort resets all changes to the "port" field.
+func (m *HostMutation) ResetPort() {
+	m.port = nil
+	m.addport = nil
+}
+
+// SetType sets the "type" field.
+func (m *HostMutation) SetType(h host.Type) {
+	m._type = &h
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *HostMutation) GetType() (r host.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldType(ctx context.Context) (v host.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *HostMutation) ResetType() {
+	m._type = nil
+}
+
+// SetActive sets the "active" field.
+func (m *HostMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *HostMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *HostMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *HostMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *HostMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *HostMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[host.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *HostMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[host.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *HostMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, host.FieldDescription)
+}
+
+// SetDaemonSelector sets the "daemon_selector" field.
+func (m *HostMutation) SetDaemonSelector(value map[string]string) {
+	m.daemon_selector = &value
+}
+
+// DaemonSelector returns the value of the "daemon_selector" field in the mutation.
+func (m *HostMutation) DaemonSelector() (r map[string]string, exists bool) {
+	v := m.daemon_selector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaemonSelector returns the old "daemon_selector" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldDaemonSelector(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaemonSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaemonSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaemonSelector: %w", err)
+	}
+	return oldValue.DaemonSelector, nil
+}
+
+// ClearDaemonSelector clears the value of the "daemon_selector" field.
+func (m *HostMutation) ClearDaemonSelector() {
+	m.daemon_selector = nil
+	m.clearedFields[host.FieldDaemonSelector] = struct{}{}
+}
+
+// DaemonSelectorCleared returns if the "daemon_selector" field was cleared in this mutation.
+func (m *HostMutation) DaemonSelectorCleared() bool {
+	_, ok := m.clearedFields[host.FieldDaemonSelector]
+	return ok
+}
+
+// ResetDaemonSelector resets all changes to the "daemon_selector" field.
+func (m *HostMutation) ResetDaemonSelector() {
+	m.daemon_selector = nil
+	delete(m.clearedFields, host.FieldDaemonSelector)
+}
+
+// SetDaemonIds sets the "daemon_ids" field.
+func (m *HostMutation) SetDaemonIds(s []string) {
+	m.daemon_ids = &s
+	m.appenddaemon_ids = nil
+}
+
+// DaemonIds returns the value of the "daemon_ids" field in the mutation.
+func (m *HostMutation) DaemonIds() (r []string, exists bool) {
+	v := m.daemon_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaemonIds returns the old "daemon_ids" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldDaemonIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaemonIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaemonIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaemonIds: %w", err)
+	}
+	return oldValue.DaemonIds, nil
+}
+
+// AppendDaemonIds adds s to the "daemon_ids" field.
+func (m *HostMutation) AppendDaemonIds(s []string) {
+	m.appenddaemon_ids = append(m.appenddaemon_ids, s...)
+}
+
+// AppendedDaemonIds returns the list of values that were appended to the "daemon_ids" field in this mutation.
+func (m *HostMutation) AppendedDaemonIds() ([]string, bool) {
+	if len(m.appenddaemon_ids) == 0 {
+		return nil, false
+	}
+	return m.appenddaemon_ids, true
+}
+
+// ClearDaemonIds clears the value of the "daemon_ids" field.
+func (m *HostMutation) ClearDaemonIds() {
+	m.daemon_ids = nil
+	m.appenddaemon_ids = nil
+	m.clearedFields[host.FieldDaemonIds] = struct{}{}
+}
+
+// DaemonIdsCleared returns if the "daemon_ids" field was cleared in this mutation.
+func (m *HostMutation) DaemonIdsCleared() bool {
+	_, ok := m.clearedFields[host.FieldDaemonIds]
+	return ok
+}
+
+// ResetDaemonIds resets all changes to the "daemon_ids" field.
+func (m *HostMutation) ResetDaemonIds() {
+	m.daemon_ids = nil
+	m.appenddaemon_ids = nil
+	delete(m.clearedFields, host.FieldDaemonIds)
+}
+
+// AddIperfTestIDs adds the "iperf_tests" edge to the IperfTest entity by ids.
+func (m *HostMutation) AddIperfTestIDs(ids ...int) {
+	if m.iperf_tests == nil {
+		m.iperf_tests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.iperf_tests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIperfTests clears the "iperf_tests" edge to the IperfTest entity.
+func (m *HostMutation) ClearIperfTests() {
+	m.clearediperf_tests = true
+}
+
+// IperfTestsCleared reports if the "iperf_tests" edge to the IperfTest entity was cleared.
+func (m *HostMutation) IperfTestsCleared() bool {
+	return m.clearediperf_tests
+}
+
+// RemoveIperfTestIDs removes the "iperf_tests" edge to the IperfTest entity by IDs.
+func (m *HostMutation) RemoveIperfTestIDs(ids ...int) {
+	if m.removediperf_tests == nil {
+		m.removediperf_tests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.iperf_tests, ids[i])
+		m.removediperf_tests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIperfTests returns the removed IDs of the "iperf_tests" edge to the IperfTest entity.
+func (m *HostMutation) RemovedIperfTestsIDs() (ids []int) {
+	for id := range m.removediperf_tests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IperfTestsIDs returns the "iperf_tests" edge IDs in the mutation.
+func (m *HostMutation) IperfTestsIDs() (ids []int) {
+	for id := range m.iperf_tests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIperfTests resets all changes to the "iperf_tests" edge.
+func (m *HostMutation) ResetIperfTests() {
+	m.iperf_tests = nil
+	m.clearediperf_tests = false
+	m.removediperf_tests = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by ids.
+func (m *HostMutation) AddJobIDs(ids ...int) {
+	if m.jobs == nil {
+		m.jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the Job entity.
+func (m *HostMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
+func (m *HostMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
+func (m *HostMutation) RemoveJobIDs(ids ...int) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
+func (m *HostMutation) RemovedJobsIDs() (ids []int) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *HostMutation) JobsIDs() (ids []int) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *HostMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// AddTestRunIDs adds the "test_runs" edge to the TestRun entity by ids.
+func (m *HostMutation) AddTestRunIDs(ids ...int) {
+	if m.test_runs == nil {
+		m.test_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.test_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTestRuns clears the "test_runs" edge to the TestRun entity.
+func (m *HostMutation) ClearTestRuns() {
+	m.clearedtest_runs = true
+}
+
+// TestRunsCleared reports if the "test_runs" edge to the TestRun entity was cleared.
+func (m *HostMutation) TestRunsCleared() bool {
+	return m.clearedtest_runs
+}
+
+// RemoveTestRunIDs removes the "test_runs" edge to the TestRun entity by IDs.
+func (m *HostMutation) RemoveTestRunIDs(ids ...int) {
+	if m.removedtest_runs == nil {
+		m.removedtest_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.test_runs, ids[i])
+		m.removedtest_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTestRuns returns the removed IDs of the "test_runs" edge to the TestRun entity.
+func (m *HostMutation) RemovedTestRunsIDs() (ids []int) {
+	for id := range m.removedtest_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TestRunsIDs returns the "test_runs" edge IDs in the mutation.
+func (m *HostMutation) TestRunsIDs() (ids []int) {
+	for id := range m.test_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTestRuns resets all changes to the "test_runs" edge.
+func (m *HostMutation) ResetTestRuns() {
+	m.test_runs = nil
+	m.clearedtest_runs = false
+	m.removedtest_runs = nil
+}
+
+// Where appends a list predicates to the HostMutation builder.
+func (m *HostMutation) Where(ps ...predicate.Host) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Host, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Host).
+func (m *HostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HostMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, host.FieldName)
+	}
+	if m.hostname != nil {
+		fields = append(fields, host.FieldHostname)
+	}
+	if m.port != nil {
+		fields = append(fields, host.FieldPort)
+	}
+	if m._type != nil {
+		fields = append(fields, host.FieldType)
+	}
+	if m.active != nil {
+		fields = append(fields, host.FieldActive)
+	}
+	if m.description != nil {
+		fields = append(fields, host.FieldDescription)
+	}
+	if m.daemon_selector != nil {
+		fields = append(fields, host.FieldDaemonSelector)
+	}
+	if m.daemon_ids != nil {
+		fields = append(fields, host.FieldDaemonIds)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case host.FieldName:
+		return m.Name()
+	case host.FieldHostname:
+		return m.Hostname()
+	case host.FieldPort:
+		return m.Port()
+	case host.FieldType:
+		return m.GetType()
+	case host.FieldActive:
+		return m.Active()
+	case host.FieldDescription:
+		return m.Description()
+	case host.FieldDaemonSelector:
+		return m.DaemonSelector()
+	case host.FieldDaemonIds:
+		return m.DaemonIds()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case host.FieldName:
+		return m.OldName(ctx)
+	case host.FieldHostname:
+		return m.OldHostname(ctx)
+	case host.FieldPort:
+		return m.OldPort(ctx)
+	case host.FieldType:
+		return m.OldType(ctx)
+	case host.FieldActive:
+		return m.OldActive(ctx)
+	case host.FieldDescription:
+		return m.OldDescription(ctx)
+	case host.FieldDaemonSelector:
+		return m.OldDaemonSelector(ctx)
+	case host.FieldDaemonIds:
+		return m.OldDaemonIds(ctx)
+	}
+	return nil, fmt.Errorf("unknown Host field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case host.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case host.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case host.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPort(v)
+		return nil
+	case host.FieldType:
+		v, ok := value.(host.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case host.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case host.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case host.FieldDaemonSelector:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonSelector(v)
+		return nil
+	case host.FieldDaemonIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonIds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Host field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HostMutation) AddedFields() []string {
+	var fields []string
+	if m.addport != nil {
+		fields = append(fields, host.FieldPort)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case host.FieldPort:
+		return m.AddedPort()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case host.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPort(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Host numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(host.FieldDescription) {
+		fields = append(fields, host.FieldDescription)
+	}
+	if m.FieldCleared(host.FieldDaemonSelector) {
+		fields = append(fields, host.FieldDaemonSelector)
+	}
+	if m.FieldCleared(host.FieldDaemonIds) {
+		fields = append(fields, host.FieldDaemonIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HostMutation) ClearField(name string) error {
+	switch name {
+	case host.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case host.FieldDaemonSelector:
+		m.ClearDaemonSelector()
+		return nil
+	case host.FieldDaemonIds:
+		m.ClearDaemonIds()
+		return nil
+	}
+	return fmt.Errorf("unknown Host nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HostMutation) ResetField(name string) error {
+	switch name {
+	case host.FieldName:
+		m.ResetName()
+		return nil
+	case host.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case host.FieldPort:
+		m.ResetPort()
+		return nil
+	case host.FieldType:
+		m.ResetType()
+		return nil
+	case host.FieldActive:
+		m.ResetActive()
+		return nil
+	case host.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case host.FieldDaemonSelector:
+		m.ResetDaemonSelector()
+		return nil
+	case host.FieldDaemonIds:
+		m.ResetDaemonIds()
+		return nil
+	}
+	return fmt.Errorf("unknown Host field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.iperf_tests != nil {
+		edges = append(edges, host.EdgeIperfTests)
+	}
+	if m.jobs != nil {
+		edges = append(edges, host.EdgeJobs)
+	}
+	if m.test_runs != nil {
+		edges = append(edges, host.EdgeTestRuns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case host.EdgeIperfTests:
+		ids := make([]ent.Value, 0, len(m.iperf_tests))
+		for id := range m.iperf_tests {
+			ids = append(ids, id)
+		}
+		return ids
+	case host.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case host.EdgeTestRuns:
+		ids := make([]ent.Value, 0, len(m.test_runs))
+		for id := range m.test_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removediperf_tests != nil {
+		edges = append(edges, host.EdgeIperfTests)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, host.EdgeJobs)
+	}
+	if m.removedtest_runs != nil {
+		edges = append(edges, host.EdgeTestRuns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case host.EdgeIperfTests:
+		ids := make([]ent.Value, 0, len(m.removediperf_tests))
+		for id := range m.removediperf_tests {
+			ids = append(ids, id)
+		}
+		return ids
+	case host.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case host.EdgeTestRuns:
+		ids := make([]ent.Value, 0, len(m.removedtest_runs))
+		for id := range m.removedtest_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearediperf_tests {
+		edges = append(edges, host.EdgeIperfTests)
+	}
+	if m.clearedjobs {
+		edges = append(edges, host.EdgeJobs)
+	}
+	if m.clearedtest_runs {
+		edges = append(edges, host.EdgeTestRuns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case host.EdgeIperfTests:
+		return m.clearediperf_tests
+	case host.EdgeJobs:
+		return m.clearedjobs
+	case host.EdgeTestRuns:
+		return m.clearedtest_runs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HostMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Host unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HostMutation) ResetEdge(name string) error {
+	switch name {
+	case host.EdgeIperfTests:
+		m.ResetIperfTests()
+		return nil
+	case host.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	case host.EdgeTestRuns:
+		m.ResetTestRuns()
+		return nil
+	}
+	return fmt.Errorf("unknown Host edge %s", name)
+}
+
+// IperfTestMutation represents an operation that mutates the IperfTest nodes in the graph.
+type IperfTestMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	timestamp           *time.Time
+	sent_mbps           *float64
+	addsent_mbps        *float64
+	received_mbps       *float64
+	addreceived_mbps    *float64
+	retransmits         *float64
+	addretransmits      *float64
+	mean_rtt_ms         *float64
+	addmean_rtt_ms      *float64
+	duration_seconds    *int
+	addduration_seconds *int
+	protocol            *string
+	success             *bool
+	error_message       *string
+	daemon_id           *string
+	submission_id       *uuid.UUID
+	trigger             *iperftest.Trigger
+	blocked_by          *iperftest.BlockedBy
+	received_at         *time.Time
+	clock_offset_ms     *int64
+	addclock_offset_ms  *int64
+	clock_corrected     *bool
+	campaign_id         *int
+	addcampaign_id      *int
+	quarantined         *bool
+	clearedFields       map[string]struct{}
+	host                *int
+	clearedhost         bool
+	done                bool
+	oldValue            func(context.Context) (*IperfTest, error)
+	predicates          []predicate.IperfTest
+}
+
+var _ ent.Mutation = (*IperfTestMutation)(nil)
+
+// iperftestOption allows management of the mutation configuration using functional options.
+type iperftestOption func(*IperfTestMutation)
+
+// newIperfTestMutation creates new mutation for the IperfTest entity.
+func newIperfTestMutation(c config, op Op, opts ...iperftestOption) *IperfTestMutation {
+	m := &IperfTestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIperfTest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIperfTestID sets the ID field of the mutation.
+func withIperfTestID(id int) iperftestOption {
+	return func(m *IperfTestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IperfTest
+		)
+		m.oldValue = func(ctx context.Context) (*IperfTest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IperfTest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIperfTest sets the old IperfTest of the mutation.
+func withIperfTest(node *IperfTest) iperftestOption {
+	return func(m *IperfTestMutation) {
+		m.oldValue = func(context.Context) (*IperfTest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IperfTestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IperfTestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IperfTestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IperfTestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IperfTest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *IperfTestMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *IperfTestMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *IperfTestMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetSentMbps sets the "sent_mbps" field.
+func (m *IperfTestMutation) SetSentMbps(f float64) {
+	m.sent_mbps = &f
+	m.addsent_mbps = nil
+}
+
+// SentMbps returns the value of the "sent_mbps" field in the mutation.
+func (m *IperfTestMutation) SentMbps() (r float64, exists bool) {
+	v := m.sent_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentMbps returns the old "sent_mbps" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldSentMbps(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentMbps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentMbps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentMbps: %w", err)
+	}
+	return oldValue.SentMbps, nil
+}
+
+// AddSentMbps adds f to the "sent_mbps" field.
+func (m *IperfTestMutation) AddSentMbps(f float64) {
+	if m.addsent_mbps != nil {
+		*m.addsent_mbps += f
+	} else {
+		m.addsent_mbps = &f
+	}
+}
+
+// AddedSentMbps returns the value that was added to the "sent_mbps" field in this mutation.
+func (m *IperfTestMutation) AddedSentMbps() (r float64, exists bool) {
+	v := m.addsent_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSentMbps resets all changes to the "sent_mbps" field.
+func (m *IperfTestMutation) ResetSentMbps() {
+	m.sent_mbps = nil
+	m.addsent_mbps = nil
+}
+
+// SetReceivedMbps sets the "received_mbps" field.
+func (m *IperfTestMutation) SetReceivedMbps(f float64) {
+	m.received_mbps = &f
+	m.addreceived_mbps = nil
+}
+
+// ReceivedMbps returns the value of the "received_mbps" field in the mutation.
+func (m *IperfTestMutation) ReceivedMbps() (r float64, exists bool) {
+	v := m.received_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedMbps returns the old "received_mbps" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldReceivedMbps(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedMbps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedMbps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedMbps: %w", err)
+	}
+	return oldValue.ReceivedMbps, nil
+}
+
+// AddReceivedMbps adds f to the "received_mbps" field.
+func (m *IperfTestMutation) AddReceivedMbps(f float64) {
+	if m.addreceived_mbps != nil {
+		*m.addreceived_mbps += f
+	} else {
+		m.addreceived_mbps = &f
+	}
+}
+
+// AddedReceivedMbps returns the value that was added to the "received_mbps" field in this mutation.
+func (m *IperfTestMutation) AddedReceivedMbps() (r float64, exists bool) {
+	v := m.addreceived_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReceivedMbps resets all changes to the "received_mbps" field.
+func (m *IperfTestMutation) ResetReceivedMbps() {
+	m.received_mbps = nil
+	m.addreceived_mbps = nil
+}
+
+// SetRetransmits sets the "retransmits" field.
+func (m *IperfTestMutation) SetRetransmits(f float64) {
+	m.retransmits = &f
+	m.addretransmits = nil
+}
+
+// Retransmits returns the value of the "retransmits" field in the mutation.
+func (m *IperfTestMutation) Retransmits() (r float64, exists bool) {
+	v := m.retransmits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetransmits returns the old "retransmits" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldRetransmits(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetransmits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetransmits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetransmits: %w", err)
+	}
+	return oldValue.Retransmits, nil
+}
+
+// AddRetransmits adds f to the "retransmits" field.
+func (m *IperfTestMutation) AddRetransmits(f float64) {
+	if m.addretransmits != nil {
+		*m.addretransmits += f
+	} else {
+		m.addretransmits = &f
+	}
+}
+
+// AddedRetransmits returns the value that was added to the "retransmits" field in this mutation.
+func (m *IperfTestMutation) AddedRetransmits() (r float64, exists bool) {
+	v := m.addretransmits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetransmits clears the value of the "retransmits" field.
+func (m *IperfTestMutation) ClearRetransmits() {
+	m.retransmits = nil
+	m.addretransmits = nil
+	m.clearedFields[iperftest.FieldRetransmits] = struct{}{}
+}
+
+// RetransmitsCleared returns if the "retransmits" field was cleared in this mutation.
+func (m *IperfTestMutation) RetransmitsCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldRetransmits]
+	return ok
+}
+
+// ResetRetransmits resets all changes to the "retransmits" field.
+func (m *IperfTestMutation) ResetRetransmits() {
+	m.retransmits = nil
+	m.addretransmits = nil
+	delete(m.clearedFields, iperftest.FieldRetransmits)
+}
+
+// SetMeanRttMs sets the "mean_rtt_ms" field.
+func (m *IperfTestMutation) SetMeanRttMs(f float64) {
+	m.mean_rtt_ms = &f
+	m.addmean_rtt_ms = nil
+}
+
+// MeanRttMs returns the value of the "mean_rtt_ms" field in the mutation.
+func (m *IperfTestMutation) MeanRttMs() (r float64, exists bool) {
+	v := m.mean_rtt_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeanRttMs returns the old "mean_rtt_ms" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldMeanRttMs(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeanRttMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeanRttMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeanRttMs: %w", err)
+	}
+	return oldValue.MeanRttMs, nil
+}
+
+// AddMeanRttMs adds f to the "mean_rtt_ms" field.
+func (m *IperfTestMutation) AddMeanRttMs(f float64) {
+	if m.addmean_rtt_ms != nil {
+		*m.addmean_rtt_ms += f
+	} else {
+		m.addmean_rtt_ms = &f
+	}
+}
+
+// AddedMeanRttMs returns the value that was added to the "mean_rtt_ms" field in this mutation.
+func (m *IperfTestMutation) AddedMeanRttMs() (r float64, exists bool) {
+	v := m.addmean_rtt_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMeanRttMs clears the value of the "mean_rtt_ms" field.
+func (m *IperfTestMutation) ClearMeanRttMs() {
+	m.mean_rtt_ms = nil
+	m.addmean_rtt_ms = nil
+	m.clearedFields[iperftest.FieldMeanRttMs] = struct{}{}
+}
+
+// MeanRttMsCleared returns if the "mean_rtt_ms" field was cleared in this mutation.
+func (m *IperfTestMutation) MeanRttMsCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldMeanRttMs]
+	return ok
+}
+
+// ResetMeanRttMs resets all changes to the "mean_rtt_ms" field.
+func (m *IperfTestMutation) ResetMeanRttMs() {
+	m.mean_rtt_ms = nil
+	m.addmean_rtt_ms = nil
+	delete(m.clearedFields, iperftest.FieldMeanRttMs)
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (m *IperfTestMutation) SetDurationSeconds(i int) {
+	m.duration_seconds = &i
+	m.addduration_seconds = nil
+}
+
+// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
+func (m *IperfTestMutation) DurationSeconds() (r int, exists bool) {
+	v := m.duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationSeconds returns the old "duration_seconds" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+	}
+	return oldValue.DurationSeconds, nil
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (m *IperfTestMutation) AddDurationSeconds(i int) {
+	if m.addduration_seconds != nil {
+		*m.addduration_seconds += i
+	} else {
+		m.addduration_seconds = &i
+	}
+}
+
+// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
+func (m *IperfTestMutation) AddedDurationSeconds() (r int, exists bool) {
+	v := m.addduration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationSeconds resets all changes to the "duration_seconds" field.
+func (m *IperfTestMutation) ResetDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+}
+
+// SetProtocol sets the "protocol" field.
+func (m *IperfTestMutation) SetProtocol(s string) {
+	m.protocol = &s
+}
+
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *IperfTestMutation) Protocol() (r string, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProtocol returns the old "protocol" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+	}
+	return oldValue.Protocol, nil
+}
+
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *IperfTestMutation) ResetProtocol() {
+	m.protocol = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *IperfTestMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *IperfTestMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *IperfTestMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *IperfTestMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *IperfTestMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *IperfTestMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[iperftest.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *IperfTestMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *IperfTestMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, iperftest.FieldErrorMessage)
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (m *IperfTestMutation) SetDaemonID(s string) {
+	m.daemon_id = &s
+}
+
+// DaemonID returns the value of the "daemon_id" field in the mutation.
+func (m *IperfTestMutation) DaemonID() (r string, exists bool) {
+	v := m.daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaemonID returns the old "daemon_id" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldDaemonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaemonID: %w", err)
+	}
+	return oldValue.DaemonID, nil
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (m *IperfTestMutation) ClearDaemonID() {
+	m.daemon_id = nil
+	m.clearedFields[iperftest.FieldDaemonID] = struct{}{}
+}
+
+// DaemonIDCleared returns if the "daemon_id" field was cleared in this mutation.
+func (m *IperfTestMutation) DaemonIDCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldDaemonID]
+	return ok
+}
+
+// ResetDaemonID resets all changes to the "daemon_id" field.
+func (m *IperfTestMutation) ResetDaemonID() {
+	m.daemon_id = nil
+	delete(m.clearedFields, iperftest.FieldDaemonID)
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (m *IperfTestMutation) SetSubmissionID(u uuid.UUID) {
+	m.submission_id = &u
+}
+
+// SubmissionID returns the value of the "submission_id" field in the mutation.
+func (m *IperfTestMutation) SubmissionID() (r uuid.UUID, exists bool) {
+	v := m.submission_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionID returns the old "submission_id" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldSubmissionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionID: %w", err)
+	}
+	return oldValue.SubmissionID, nil
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (m *IperfTestMutation) ClearSubmissionID() {
+	m.submission_id = nil
+	m.clearedFields[iperftest.FieldSubmissionID] = struct{}{}
+}
+
+// SubmissionIDCleared returns if the "submission_id" field was cleared in this mutation.
+func (m *IperfTestMutation) SubmissionIDCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldSubmissionID]
+	return ok
+}
+
+// ResetSubmissionID resets all changes to the "submission_id" field.
+func (m *IperfTestMutation) ResetSubmissionID() {
+	m.submission_id = nil
+	delete(m.clearedFields, iperftest.FieldSubmissionID)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *IperfTestMutation) SetTrigger(i iperftest.Trigger) {
+	m.trigger = &i
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *IperfTestMutation) Trigger() (r iperftest.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldTrigger(ctx context.Context) (v iperftest.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *IperfTestMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetBlockedBy sets the "blocked_by" field.
+func (m *IperfTestMutation) SetBlockedBy(ib iperftest.BlockedBy) {
+	m.blocked_by = &ib
+}
+
+// BlockedBy returns the value of the "blocked_by" field in the mutation.
+func (m *IperfTestMutation) BlockedBy() (r iperftest.BlockedBy, exists bool) {
+	v := m.blocked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockedBy returns the old "blocked_by" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldBlockedBy(ctx context.Context) (v iperftest.BlockedBy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockedBy: %w", err)
+	}
+	return oldValue.BlockedBy, nil
+}
+
+// ClearBlockedBy clears the value of the "blocked_by" field.
+func (m *IperfTestMutation) ClearBlockedBy() {
+	m.blocked_by = nil
+	m.clearedFields[iperftest.FieldBlockedBy] = struct{}{}
+}
+
+// BlockedByCleared returns if the "blocked_by" field was cleared in this mutation.
+func (m *IperfTestMutation) BlockedByCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldBlockedBy]
+	return ok
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" field.
+func (m *IperfTestMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	delete(m.clearedFields, iperftest.FieldBlockedBy)
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (m *IperfTestMutation) SetReceivedAt(t time.Time) {
+	m.received_at = &t
+}
+
+// ReceivedAt returns the value of the "received_at" field in the mutation.
+func (m *IperfTestMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.received_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedAt returns the old "received_at" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldReceivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
+	}
+	return oldValue.ReceivedAt, nil
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (m *IperfTestMutation) ClearReceivedAt() {
+	m.received_at = nil
+	m.clearedFields[iperftest.FieldReceivedAt] = struct{}{}
+}
+
+// ReceivedAtCleared returns if the "received_at" field was cleared in this mutation.
+func (m *IperfTestMutation) ReceivedAtCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldReceivedAt]
+	return ok
+}
+
+// ResetReceivedAt resets all changes to the "received_at" field.
+func (m *IperfTestMutation) ResetReceivedAt() {
+	m.received_at = nil
+	delete(m.clearedFields, iperftest.FieldReceivedAt)
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (m *IperfTestMutation) SetClockOffsetMs(i int64) {
+	m.clock_offset_ms = &i
+	m.addclock_offset_ms = nil
+}
+
+// ClockOffsetMs returns the value of the "clock_offset_ms" field in the mutation.
+func (m *IperfTestMutation) ClockOffsetMs() (r int64, exists bool) {
+	v := m.clock_offset_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClockOffsetMs returns the old "clock_offset_ms" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldClockOffsetMs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClockOffsetMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClockOffsetMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClockOffsetMs: %w", err)
+	}
+	return oldValue.ClockOffsetMs, nil
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (m *IperfTestMutation) AddClockOffsetMs(i int64) {
+	if m.addclock_offset_ms != nil {
+		*m.addclock_offset_ms += i
+	} else {
+		m.addclock_offset_ms = &i
+	}
+}
+
+// AddedClockOffsetMs returns the value that was added to the "clock_offset_ms" field in this mutation.
+func (m *IperfTestMutation) AddedClockOffsetMs() (r int64, exists bool) {
+	v := m.addclock_offset_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (m *IperfTestMutation) ClearClockOffsetMs() {
+	m.clock_offset_ms = nil
+	m.addclock_offset_ms = nil
+	m.clearedFields[iperftest.FieldClockOffsetMs] = struct{}{}
+}
+
+// ClockOffsetMsCleared returns if the "clock_offset_ms" field was cleared in this mutation.
+func (m *IperfTestMutation) ClockOffsetMsCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldClockOffsetMs]
+	return ok
+}
+
+// ResetClockOffsetMs resets all changes to the "clock_offset_ms" field.
+func (m *IperfTestMutation) ResetClockOffsetMs() {
+	m.clock_offset_ms = nil
+	m.addclock_offset_ms = nil
+	delete(m.clearedFields, iperftest.FieldClockOffsetMs)
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (m *IperfTestMutation) SetClockCorrected(b bool) {
+	m.clock_corrected = &b
+}
+
+// ClockCorrected returns the value of the "clock_corrected" field in the mutation.
+func (m *IperfTestMutation) ClockCorrected() (r bool, exists bool) {
+	v := m.clock_corrected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClockCorrected returns the old "clock_corrected" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldClockCorrected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClockCorrected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClockCorrected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClockCorrected: %w", err)
+	}
+	return oldValue.ClockCorrected, nil
+}
+
+// ResetClockCorrected resets all changes to the "clock_corrected" field.
+func (m *IperfTestMutation) ResetClockCorrected() {
+	m.clock_corrected = nil
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (m *IperfTestMutation) SetCampaignID(i int) {
+	m.campaign_id = &i
+	m.addcampaign_id = nil
+}
+
+// CampaignID returns the value of the "campaign_id" field in the mutation.
+func (m *IperfTestMutation) CampaignID() (r int, exists bool) {
+	v := m.campaign_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignID returns the old "campaign_id" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldCampaignID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignID: %w", err)
+	}
+	return oldValue.CampaignID, nil
+}
+
+// AddCampaignID adds i to the "campaign_id" field.
+func (m *IperfTestMutation) AddCampaignID(i int) {
+	if m.addcampaign_id != nil {
+		*m.addcampaign_id += i
+	} else {
+		m.addcampaign_id = &i
+	}
+}
+
+// AddedCampaignID returns the value that was added to the "campaign_id" field in this mutation.
+func (m *IperfTestMutation) AddedCampaignID() (r int, exists bool) {
+	v := m.addcampaign_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (m *IperfTestMutation) ClearCampaignID() {
+	m.campaign_id = nil
+	m.addcampaign_id = nil
+	m.clearedFields[iperftest.FieldCampaignID] = struct{}{}
+}
+
+// CampaignIDCleared returns if the "campaign_id" field was cleared in this mutation.
+func (m *IperfTestMutation) CampaignIDCleared() bool {
+	_, ok := m.clearedFields[iperftest.FieldCampaignID]
+	return ok
+}
+
+// ResetCampaignID resets all changes to the "campaign_id" field.
+func (m *IperfTestMutation) ResetCampaignID() {
+	m.campaign_id = nil
+	m.addcampaign_id = nil
+	delete(m.clearedFields, iperftest.FieldCampaignID)
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (m *IperfTestMutation) SetQuarantined(b bool) {
+	m.quarantined = &b
+}
+
+// Quarantined returns the value of the "quarantined" field in the mutation.
+func (m *IperfTestMutation) Quarantined() (r bool, exists bool) {
+	v := m.quarantined
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuarantined returns the old "quarantined" field's value of the IperfTest entity.
+// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IperfTestMutation) OldQuarantined(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuarantined is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuarantined requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuarantined: %w", err)
+	}
+	return oldValue.Quarantined, nil
+}
+
+// ResetQuarantined resets all changes to the "quarantined" field.
+func (m *IperfTestMutation) ResetQuarantined() {
+	m.quarantined = nil
+}
+
+// SetHostID sets the "host" edge to the Host entity by id.
+func (m *IperfTestMutation) SetHostID(id int) {
+	m.host = &id
+}
+
+// ClearHost clears the "host" edge to the Host entity.
+func (m *IperfTestMutation) ClearHost() {
+	m.clearedhost = true
+}
+
+// HostCleared reports if the "host" edge to the Host entity was cleared.
+func (m *IperfTestMutation) HostCleared() bool {
+	return m.clearedhost
+}
+
+// HostID returns the "host" edge ID in the mutation.
+func (m *IperfTestMutation) HostID() (id int, exists bool) {
+	if m.host != nil {
+		return *m.host, true
+	}
+	return
+}
+
+// HostIDs returns the "host" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HostID instead. It exists only for internal usage by the builders.
+func (m *IperfTestMutation) HostIDs() (ids []int) {
+	if id := m.host; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHost resets all changes to the "host" edge.
+func (m *IperfTestMutation) ResetHost() {
+	m.host = nil
+	m.clearedhost = false
+}
+
+// Where appends a list predicates to the IperfTestMutation builder.
+func (m *IperfTestMutation) Where(ps ...predicate.IperfTest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IperfTestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IperfTestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IperfTest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IperfTestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IperfTestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IperfTest).
+func (m *IperfTestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IperfTestMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.timestamp != nil {
+		fields = append(fields, iperftest.FieldTimestamp)
+	}
+	if m.sent_mbps != nil {
+		fields = append(fields, iperftest.FieldSentMbps)
+	}
+	if m.received_mbps != nil {
+		fields = append(fields, iperftest.FieldReceivedMbps)
+	}
+	if m.retransmits != nil {
+		fields = append(fields, iperftest.FieldRetransmits)
+	}
+	if m.mean_rtt_ms != nil {
+		fields = append(fields, iperftest.FieldMeanRttMs)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, iperftest.FieldDurationSeconds)
+	}
+	if m.protocol != nil {
+		fields = append(fields, iperftest.FieldProtocol)
+	}
+	if m.success != nil {
+		fields = append(fields, iperftest.FieldSuccess)
+	}
+	if m.error_message != nil {
+		fields = append(fields, iperftest.FieldErrorMessage)
+	}
+	if m.daemon_id != nil {
+		fields = append(fields, iperftest.FieldDaemonID)
+	}
+	if m.submission_id != nil {
+		fields = append(fields, iperftest.FieldSubmissionID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, iperftest.FieldTrigger)
+	}
+	if m.blocked_by != nil {
+		fields = append(fields, iperftest.FieldBlockedBy)
+	}
+	if m.received_at != nil {
+		fields = append(fields, iperftest.FieldReceivedAt)
+	}
+	if m.clock_offset_ms != nil {
+		fields = append(fields, iperftest.FieldClockOffsetMs)
+	}
+	if m.clock_corrected != nil {
+		fields = append(fields, iperftest.FieldClockCorrected)
+	}
+	if m.campaign_id != nil {
+		fields = append(fields, iperftest.FieldCampaignID)
+	}
+	if m.quarantined != nil {
+		fields = append(fields, iperftest.FieldQuarantined)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IperfTestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case iperftest.FieldTimestamp:
+		return m.Timestamp()
+	case iperftest.FieldSentMbps:
+		return m.SentMbps()
+	case iperftest.FieldReceivedMbps:
+		return m.ReceivedMbps()
+	case iperftest.FieldRetransmits:
+		return m.Retransmits()
+	case iperftest.FieldMeanRttMs:
+		return m.MeanRttMs()
+	case iperftest.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case iperftest.FieldProtocol:
+		return m.Protocol()
+	case iperftest.FieldSuccess:
+		return m.Success()
+	case iperftest.FieldErrorMessage:
+		return m.ErrorMessage()
+	case iperftest.FieldDaemonID:
+		return m.DaemonID()
+	case iperftest.FieldSubmissionID:
+		return m.SubmissionID()
+	case iperftest.FieldTrigger:
+		return m.Trigger()
+	case iperftest.FieldBlockedBy:
+		return m.BlockedBy()
+	case iperftest.FieldReceivedAt:
+		return m.ReceivedAt()
+	case iperftest.FieldClockOffsetMs:
+		return m.ClockOffsetMs()
+	case iperftest.FieldClockCorrected:
+		return m.ClockCorrected()
+	case iperftest.FieldCampaignID:
+		return m.CampaignID()
+	case iperftest.FieldQuarantined:
+		return m.Quarantined()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IperfTestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case iperftest.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case iperftest.FieldSentMbps:
+		return m.OldSentMbps(ctx)
+	case iperftest.FieldReceivedMbps:
+		return m.OldReceivedMbps(ctx)
+	case iperftest.FieldRetransmits:
+		return m.OldRetransmits(ctx)
+	case iperftest.FieldMeanRttMs:
+		return m.OldMeanRttMs(ctx)
+	case iperftest.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case iperftest.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case iperftest.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case iperftest.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case iperftest.FieldDaemonID:
+		return m.OldDaemonID(ctx)
+	case iperftest.FieldSubmissionID:
+		return m.OldSubmissionID(ctx)
+	case iperftest.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case iperftest.FieldBlockedBy:
+		return m.OldBlockedBy(ctx)
+	case iperftest.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
+	case iperftest.FieldClockOffsetMs:
+		return m.OldClockOffsetMs(ctx)
+	case iperftest.FieldClockCorrected:
+		return m.OldClockCorrected(ctx)
+	case iperftest.FieldCampaignID:
+		return m.OldCampaignID(ctx)
+	case iperftest.FieldQuarantined:
+		return m.OldQuarantined(ctx)
+	}
+	return nil, fmt.Errorf("unknown IperfTest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IperfTestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case iperftest.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case iperftest.FieldSentMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentMbps(v)
+		return nil
+	case iperftest.FieldReceivedMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedMbps(v)
+		return nil
+	case iperftest.FieldRetransmits:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetransmits(v)
+		return nil
+	case iperftest.FieldMeanRttMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeanRttMs(v)
+		return nil
+	case iperftest.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case iperftest.FieldProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case iperftest.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case iperftest.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case iperftest.FieldDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonID(v)
+		return nil
+	case iperftest.FieldSubmissionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionID(v)
+		return nil
+	case iperftest.FieldTrigger:
+		v, ok := value.(iperftest.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case iperftest.FieldBlockedBy:
+		v, ok := value.(iperftest.BlockedBy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockedBy(v)
+		return nil
+	case iperftest.FieldReceivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedAt(v)
+		return nil
+	case iperftest.FieldClockOffsetMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClockOffsetMs(v)
+		return nil
+	case iperftest.FieldClockCorrected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClockCorrected(v)
+		return nil
+	case iperftest.FieldCampaignID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaignID(v)
+		return nil
+	case iperftest.FieldQuarantined:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuarantined(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IperfTest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IperfTestMutation) AddedFields() []string {
+	var fields []string
+	if m.addsent_mbps != nil {
+		fields = append(fields, iperftest.FieldSentMbps)
+	}
+	if m.addreceived_mbps != nil {
+		fields = append(fields, iperftest.FieldReceivedMbps)
+	}
+	if m.addretransmits != nil {
+		fields = append(fields, iperftest.FieldRetransmits)
+	}
+	if m.addmean_rtt_ms != nil {
+		fields = append(fields, iperftest.FieldMeanRttMs)
+	}
+	if m.addduration_seconds != nil {
+		fields = append(fields, iperftest.FieldDurationSeconds)
+	}
+	if m.addclock_offset_ms != nil {
+		fields = append(fields, iperftest.FieldClockOffsetMs)
+	}
+	if m.addcampaign_id != nil {
+		fields = append(fields, iperftest.FieldCampaignID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IperfTestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case iperftest.FieldSentMbps:
+		return m.AddedSentMbps()
+	case iperftest.FieldReceivedMbps:
+		return m.AddedReceivedMbps()
+	case iperftest.FieldRetransmits:
+		return m.AddedRetransmits()
+	case iperftest.FieldMeanRttMs:
+		return m.AddedMeanRttMs()
+	case iperftest.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	case iperftest.FieldClockOffsetMs:
+		return m.AddedClockOffsetMs()
+	case iperftest.FieldCampaignID:
+		return m.AddedCampaignID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IperfTestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case iperftest.FieldSentMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSentMbps(v)
+		return nil
+	case iperftest.FieldReceivedMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReceivedMbps(v)
+		return nil
+	case iperftest.FieldRetransmits:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetransmits(v)
+		return nil
+	case iperftest.FieldMeanRttMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMeanRttMs(v)
+		return nil
+	case iperftest.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	case iperftest.FieldClockOffsetMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClockOffsetMs(v)
+		return nil
+	case iperftest.FieldCampaignID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCampaignID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IperfTest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IperfTestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(iperftest.FieldRetransmits) {
+		fields = append(fields, iperftest.FieldRetransmits)
+	}
+	if m.FieldCleared(iperftest.FieldMeanRttMs) {
+		fields = append(fields, iperftest.FieldMeanRttMs)
+	}
+	if m.FieldCleared(iperftest.FieldErrorMessage) {
+		fields = append(fields, iperftest.FieldErrorMessage)
+	}
+	if m.FieldCleared(iperftest.FieldDaemonID) {
+		fields = append(fields, iperftest.FieldDaemonID)
+	}
+	if m.FieldCleared(iperftest.FieldSubmissionID) {
+		fields = append(fields, iperftest.FieldSubmissionID)
+	}
+	if m.FieldCleared(iperftest.FieldBlockedBy) {
+		fields = append(fields, iperftest.FieldBlockedBy)
+	}
+	if m.FieldCleared(iperftest.FieldReceivedAt) {
+		fields = append(fields, iperftest.FieldReceivedAt)
+	}
+	if m.FieldCleared(iperftest.FieldClockOffsetMs) {
+		fields = append(fields, iperftest.FieldClockOffsetMs)
+	}
+	if m.FieldCleared(iperftest.FieldCampaignID) {
+		fields = append(fields, iperftest.FieldCampaignID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IperfTestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IperfTestMutation) ClearField(name string) error {
+	switch name {
+	case iperftest.FieldRetransmits:
+		m.ClearRetransmits()
+		return nil
+	case iperftest.FieldMeanRttMs:
+		m.ClearMeanRttMs()
+		return nil
+	case iperftest.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case iperftest.FieldDaemonID:
+		m.ClearDaemonID()
+		return nil
+	case iperftest.FieldSubmissionID:
+		m.ClearSubmissionID()
+		return nil
+	case iperftest.FieldBlockedBy:
+		m.ClearBlockedBy()
+		return nil
+	case iperftest.FieldReceivedAt:
+		m.ClearReceivedAt()
+		return nil
+	case iperftest.FieldClockOffsetMs:
+		m.ClearClockOffsetMs()
+		return nil
+	case iperftest.FieldCampaignID:
+		m.ClearCampaignID()
+		return nil
+	}
+	return fmt.Errorf("unknown IperfTest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IperfTestMutation) ResetField(name string) error {
+	switch name {
+	case iperftest.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case iperftest.FieldSentMbps:
+		m.ResetSentMbps()
+		return nil
+	case iperftest.FieldReceivedMbps:
+		m.ResetReceivedMbps()
+		return nil
+	case iperftest.FieldRetransmits:
+		m.ResetRetransmits()
+		return nil
+	case iperftest.FieldMeanRttMs:
+		m.ResetMeanRttMs()
+		return nil
+	case iperftest.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case iperftest.FieldProtocol:
+		m.ResetProtocol()
+		return nil
+	case iperftest.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case iperftest.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case iperftest.FieldDaemonID:
+		m.ResetDaemonID()
+		return nil
+	case iperftest.FieldSubmissionID:
+		m.ResetSubmissionID()
+		return nil
+	case iperftest.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case iperftest.FieldBlockedBy:
+		m.ResetBlockedBy()
+		return nil
+	case iperftest.FieldReceivedAt:
+		m.ResetReceivedAt()
+		return nil
+	case iperftest.FieldClockOffsetMs:
+		m.ResetClockOffsetMs()
+		return nil
+	case iperftest.FieldClockCorrected:
+		m.ResetClockCorrected()
+		return nil
+	case iperftest.FieldCampaignID:
+		m.ResetCampaignID()
+		return nil
+	case iperftest.FieldQuarantined:
+		m.ResetQuarantined()
+		return nil
+	}
+	return fmt.Errorf("unknown IperfTest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IperfTestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.host != nil {
+		edges = append(edges, iperftest.EdgeHost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IperfTestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case iperftest.EdgeHost:
+		if id := m.host; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IperfTestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IperfTestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IperfTestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedhost {
+		edges = append(edges, iperftest.EdgeHost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IperfTestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case iperftest.EdgeHost:
+		return m.clearedhost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IperfTestMutation) ClearEdge(name string) error {
+	switch name {
+	case iperftest.EdgeHost:
+		m.ClearHost()
+		return nil
+	}
+	return fmt.Errorf("unknown IperfTest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IperfTestMutation) ResetEdge(name string) error {
+	switch name {
+	case iperftest.EdgeHost:
+		m.ResetHost()
+		return nil
+	}
+	return fmt.Errorf("unknown IperfTest edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	_type               *job.Type
+	status              *job.Status
+	daemon_id           *string
+	target_daemon_id    *string
+	target_address      *string
+	duration_seconds    *int
+	addduration_seconds *int
+	trigger             *job.Trigger
+	priority            *int
+	addpriority         *int
+	attempts            *int
+	addattempts         *int
+	max_attempts        *int
+	addmax_attempts     *int
+	leased_by           *string
+	lease_expires_at    *time.Time
+	scheduled_at        *time.Time
+	created_at          *time.Time
+	completed_at        *time.Time
+	result_id           *int
+	addresult_id        *int
+	error_message       *string
+	clearedFields       map[string]struct{}
+	host                *int
+	clearedhost         bool
+	campaign            *int
+	clearedcampaign     bool
+	done                bool
+	oldValue            func(context.Context) (*Job, error)
+	predicates          []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id int) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *JobMutation) SetType(j job.Type) {
+	m._type = &j
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *JobMutation) GetType() (r job.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldType(ctx context.Context) (v job.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *JobMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (m *JobMutation) SetDaemonID(s string) {
+	m.daemon_id = &s
+}
+
+// DaemonID returns the value of the "daemon_id" field in the mutation.
+func (m *JobMutation) DaemonID() (r string, exists bool) {
+	v := m.daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaemonID returns the old "daemon_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldDaemonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDaemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDaemonID: %w", err)
+	}
+	return oldValue.DaemonID, nil
+}
+
+// ClearDaemonID clears the value of the "daemon_id" field.
+func (m *JobMutation) ClearDaemonID() {
+	m.daemon_id = nil
+	m.clearedFields[job.FieldDaemonID] = struct{}{}
+}
+
+// DaemonIDCleared returns if the "daemon_id" field was cleared in this mutation.
+func (m *JobMutation) DaemonIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldDaemonID]
+	return ok
+}
+
+// ResetDaemonID resets all changes to the "daemon_id" field.
+func (m *JobMutation) ResetDaemonID() {
+	m.daemon_id = nil
+	delete(m.clearedFields, job.FieldDaemonID)
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (m *JobMutation) SetTargetDaemonID(s string) {
+	m.target_daemon_id = &s
+}
+
+// TargetDaemonID returns the value of the "target_daemon_id" field in the mutation.
+func (m *JobMutation) TargetDaemonID() (r string, exists bool) {
+	v := m.target_daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetDaemonID returns the old "target_daemon_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTargetDaemonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetDaemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetDaemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetDaemonID: %w", err)
+	}
+	return oldValue.TargetDaemonID, nil
+}
+
+// ClearTargetDaemonID clears the value of the "target_daemon_id" field.
+func (m *JobMutation) ClearTargetDaemonID() {
+	m.target_daemon_id = nil
+	m.clearedFields[job.FieldTargetDaemonID] = struct{}{}
+}
+
+// TargetDaemonIDCleared returns if the "target_daemon_id" field was cleared in this mutation.
+func (m *JobMutation) TargetDaemonIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldTargetDaemonID]
+	return ok
+}
+
+// ResetTargetDaemonID resets all changes to the "target_daemon_id" field.
+func (m *JobMutation) ResetTargetDaemonID() {
+	m.target_daemon_id = nil
+	delete(m.clearedFields, job.FieldTargetDaemonID)
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (m *JobMutation) SetTargetAddress(s string) {
+	m.target_address = &s
+}
+
+// TargetAddress returns the value of the "target_address" field in the mutation.
+func (m *JobMutation) TargetAddress() (r string, exists bool) {
+	v := m.target_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetAddress returns the old "target_address" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTargetAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetAddress: %w", err)
+	}
+	return oldValue.TargetAddress, nil
+}
+
+// ClearTargetAddress clears the value of the "target_address" field.
+func (m *JobMutation) ClearTargetAddress() {
+	m.target_address = nil
+	m.clearedFields[job.FieldTargetAddress] = struct{}{}
+}
+
+// TargetAddressCleared returns if the "target_address" field was cleared in this mutation.
+func (m *JobMutation) TargetAddressCleared() bool {
+	_, ok := m.clearedFields[job.FieldTargetAddress]
+	return ok
+}
+
+// ResetTargetAddress resets all changes to the "target_address" field.
+func (m *JobMutation) ResetTargetAddress() {
+	m.target_address = nil
+	delete(m.clearedFields, job.FieldTargetAddress)
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (m *JobMutation) SetDurationSeconds(i int) {
+	m.duration_seconds = &i
+	m.addduration_seconds = nil
+}
+
+// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
+func (m *JobMutation) DurationSeconds() (r int, exists bool) {
+	v := m.duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationSeconds returns the old "duration_seconds" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+	}
+	return oldValue.DurationSeconds, nil
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (m *JobMutation) AddDurationSeconds(i int) {
+	if m.addduration_seconds != nil {
+		*m.addduration_seconds += i
+	} else {
+		m.addduration_seconds = &i
+	}
+}
+
+// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
+func (m *JobMutation) AddedDurationSeconds() (r int, exists bool) {
+	v := m.addduration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationSeconds clears the value of the "duration_seconds" field.
+func (m *JobMutation) ClearDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+	m.clearedFields[job.FieldDurationSeconds] = struct{}{}
+}
+
+// DurationSecondsCleared returns if the "duration_seconds" field was cleared in this mutation.
+func (m *JobMutation) DurationSecondsCleared() bool {
+	_, ok := m.clearedFields[job.FieldDurationSeconds]
+	return ok
+}
+
+// ResetDurationSeconds resets all changes to the "duration_seconds" field.
+func (m *JobMutation) ResetDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+	delete(m.clearedFields, job.FieldDurationSeconds)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *JobMutation) SetTrigger(j job.Trigger) {
+	m.trigger = &j
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *JobMutation) Trigger() (r job.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTrigger(ctx context.Context) (v job.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *JobMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *JobMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *JobMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *JobMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *JobMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *JobMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *JobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *JobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *JobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *JobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *JobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetLeasedBy sets the "leased_by" field.
+func (m *JobMutation) SetLeasedBy(s string) {
+	m.leased_by = &s
+}
+
+// LeasedBy returns the value of the "leased_by" field in the mutation.
+func (m *JobMutation) LeasedBy() (r string, exists bool) {
+	v := m.leased_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeasedBy returns the old "leased_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLeasedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeasedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeasedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeasedBy: %w", err)
+	}
+	return oldValue.LeasedBy, nil
+}
+
+// ClearLeasedBy clears the value of the "leased_by" field.
+func (m *JobMutation) ClearLeasedBy() {
+	m.leased_by = nil
+	m.clearedFields[job.FieldLeasedBy] = struct{}{}
+}
+
+// LeasedByCleared returns if the "leased_by" field was cleared in this mutation.
+func (m *JobMutation) LeasedByCleared() bool {
+	_, ok := m.clearedFields[job.FieldLeasedBy]
+	return ok
+}
+
+// ResetLeasedBy resets all changes to the "leased_by" field.
+func (m *JobMutation) ResetLeasedBy() {
+	m.leased_by = nil
+	delete(m.clearedFields, job.FieldLeasedBy)
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *JobMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *JobMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *JobMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[job.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *JobMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *JobMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, job.FieldLeaseExpiresAt)
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *JobMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *JobMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+	}
+	return oldValue.ScheduledAt, nil
+}
+
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *JobMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *JobMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *JobMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *JobMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[job.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *JobMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *JobMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, job.FieldCompletedAt)
+}
+
+// SetResultID sets the "result_id" field.
+func (m *JobMutation) SetResultID(i int) {
+	m.result_id = &i
+	m.addresult_id = nil
+}
+
+// ResultID returns the value of the "result_id" field in the mutation.
+func (m *JobMutation) ResultID() (r int, exists bool) {
+	v := m.result_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultID returns the old "result_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldResultID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultID: %w", err)
+	}
+	return oldValue.ResultID, nil
+}
+
+// AddResultID adds i to the "result_id" field.
+func (m *JobMutation) AddResultID(i int) {
+	if m.addresult_id != nil {
+		*m.addresult_id += i
+	} else {
+		m.addresult_id = &i
+	}
+}
+
+// AddedResultID returns the value that was added to the "result_id" field in this mutation.
+func (m *JobMutation) AddedResultID() (r int, exists bool) {
+	v := m.addresult_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResultID clears the value of the "result_id" field.
+func (m *JobMutation) ClearResultID() {
+	m.result_id = nil
+	m.addresult_id = nil
+	m.clearedFields[job.FieldResultID] = struct{}{}
+}
+
+// ResultIDCleared returns if the "result_id" field was cleared in this mutation.
+func (m *JobMutation) ResultIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldResultID]
+	return ok
+}
+
+// ResetResultID resets all changes to the "result_id" field.
+func (m *JobMutation) ResetResultID() {
+	m.result_id = nil
+	m.addresult_id = nil
+	delete(m.clearedFields, job.FieldResultID)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *JobMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *JobMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *JobMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[job.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *JobMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[job.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *JobMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, job.FieldErrorMessage)
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (m *JobMutation) SetCampaignID(i int) {
+	m.campaign = &i
+}
+
+// CampaignID returns the value of the "campaign_id" field in the mutation.
+func (m *JobMutation) CampaignID() (r int, exists bool) {
+	v := m.campaign
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignID returns the old "campaign_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCampaignID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignID: %w", err)
+	}
+	return oldValue.CampaignID, nil
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (m *JobMutation) ClearCampaignID() {
+	m.campaign = nil
+	m.clearedFields[job.FieldCampaignID] = struct{}{}
+}
+
+// CampaignIDCleared returns if the "campaign_id" field was cleared in this mutation.
+func (m *JobMutation) CampaignIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldCampaignID]
+	return ok
+}
+
+// ResetCampaignID resets all changes to the "campaign_id" field.
+func (m *JobMutation) ResetCampaignID() {
+	m.campaign = nil
+	delete(m.clearedFields, job.FieldCampaignID)
+}
+
+// SetHostID sets the "host" edge to the Host entity by id.
+func (m *JobMutation) SetHostID(id int) {
+	m.host = &id
+}
+
+// ClearHost clears the "host" edge to the Host entity.
+func (m *JobMutation) ClearHost() {
+	m.clearedhost = true
+}
+
+// HostCleared reports if the "host" edge to the Host entity was cleared.
+func (m *JobMutation) HostCleared() bool {
+	return m.clearedhost
+}
+
+// HostID returns the "host" edge ID in the mutation.
+func (m *JobMutation) HostID() (id int, exists bool) {
+	if m.host != nil {
+		return *m.host, true
+	}
+	return
+}
+
+// HostIDs returns the "host" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HostID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) HostIDs() (ids []int) {
+	if id := m.host; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHost resets all changes to the "host" edge.
+func (m *JobMutation) ResetHost() {
+	m.host = nil
+	m.clearedhost = false
+}
+
+// ClearCampaign clears the "campaign" edge to the Campaign entity.
+func (m *JobMutation) ClearCampaign() {
+	m.clearedcampaign = true
+	m.clearedFields[job.FieldCampaignID] = struct{}{}
+}
+
+// CampaignCleared reports if the "campaign" edge to the Campaign entity was cleared.
+func (m *JobMutation) CampaignCleared() bool {
+	return m.CampaignIDCleared() || m.clearedcampaign
+}
+
+// CampaignIDs returns the "campaign" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CampaignID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) CampaignIDs() (ids []int) {
+	if id := m.campaign; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCampaign resets all changes to the "campaign" edge.
+func (m *JobMutation) ResetCampaign() {
+	m.campaign = nil
+	m.clearedcampaign = false
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m._type != nil {
+		fields = append(fields, job.FieldType)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.daemon_id != nil {
+		fields = append(fields, job.FieldDaemonID)
+	}
+	if m.target_daemon_id != nil {
+		fields = append(fields, job.FieldTargetDaemonID)
+	}
+	if m.target_address != nil {
+		fields = append(fields, job.FieldTargetAddress)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, job.FieldDurationSeconds)
+	}
+	if m.trigger != nil {
+		fields = append(fields, job.FieldTrigger)
+	}
+	if m.priority != nil {
+		fields = append(fields, job.FieldPriority)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.leased_by != nil {
+		fields = append(fields, job.FieldLeasedBy)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, job.FieldLeaseExpiresAt)
+	}
+	if m.scheduled_at != nil {
+		fields = append(fields, job.FieldScheduledAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, job.FieldCompletedAt)
+	}
+	if m.result_id != nil {
+		fields = append(fields, job.FieldResultID)
+	}
+	if m.error_message != nil {
+		fields = append(fields, job.FieldErrorMessage)
+	}
+	if m.campaign != nil {
+		fields = append(fields, job.FieldCampaignID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldType:
+		return m.GetType()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldDaemonID:
+		return m.DaemonID()
+	case job.FieldTargetDaemonID:
+		return m.TargetDaemonID()
+	case job.FieldTargetAddress:
+		return m.TargetAddress()
+	case job.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case job.FieldTrigger:
+		return m.Trigger()
+	case job.FieldPriority:
+		return m.Priority()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case job.FieldLeasedBy:
+		return m.LeasedBy()
+	case job.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	case job.FieldScheduledAt:
+		return m.ScheduledAt()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldCompletedAt:
+		return m.CompletedAt()
+	case job.FieldResultID:
+		return m.ResultID()
+	case job.FieldErrorMessage:
+		return m.ErrorMessage()
+	case job.FieldCampaignID:
+		return m.CampaignID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldType:
+		return m.OldType(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldDaemonID:
+		return m.OldDaemonID(ctx)
+	case job.FieldTargetDaemonID:
+		return m.OldTargetDaemonID(ctx)
+	case job.FieldTargetAddress:
+		return m.OldTargetAddress(ctx)
+	case job.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case job.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case job.FieldPriority:
+		return m.OldPriority(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case job.FieldLeasedBy:
+		return m.OldLeasedBy(ctx)
+	case job.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	case job.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case job.FieldResultID:
+		return m.OldResultID(ctx)
+	case job.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case job.FieldCampaignID:
+		return m.OldCampaignID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldType:
+		v, ok := value.(job.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonID(v)
+		return nil
+	case job.FieldTargetDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetDaemonID(v)
+		return nil
+	case job.FieldTargetAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAddress(v)
+		return nil
+	case job.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case job.FieldTrigger:
+		v, ok := value.(job.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case job.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case job.FieldLeasedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeasedBy(v)
+		return nil
+	case job.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	case job.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case job.FieldResultID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultID(v)
+		return nil
+	case job.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case job.FieldCampaignID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCampaignID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_seconds != nil {
+		fields = append(fields, job.FieldDurationSeconds)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, job.FieldPriority)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.addresult_id != nil {
+		fields = append(fields, job.FieldResultID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	case job.FieldPriority:
+		return m.AddedPriority()
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	case job.FieldResultID:
+		return m.AddedResultID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	case job.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	case job.FieldResultID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResultID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldDaemonID) {
+		fields = append(fields, job.FieldDaemonID)
+	}
+	if m.FieldCleared(job.FieldTargetDaemonID) {
+		fields = append(fields, job.FieldTargetDaemonID)
+	}
+	if m.FieldCleared(job.FieldTargetAddress) {
+		fields = append(fields, job.FieldTargetAddress)
+	}
+	if m.FieldCleared(job.FieldDurationSeconds) {
+		fields = append(fields, job.FieldDurationSeconds)
+	}
+	if m.FieldCleared(job.FieldLeasedBy) {
+		fields = append(fields, job.FieldLeasedBy)
+	}
+	if m.FieldCleared(job.FieldLeaseExpiresAt) {
+		fields = append(fields, job.FieldLeaseExpiresAt)
+	}
+	if m.FieldCleared(job.FieldCompletedAt) {
+		fields = append(fields, job.FieldCompletedAt)
+	}
+	if m.FieldCleared(job.FieldResultID) {
+		fields = append(fields, job.FieldResultID)
+	}
+	if m.FieldCleared(job.FieldErrorMessage) {
+		fields = append(fields, job.FieldErrorMessage)
+	}
+	if m.FieldCleared(job.FieldCampaignID) {
+		fields = append(fields, job.FieldCampaignID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldDaemonID:
+		m.ClearDaemonID()
+		return nil
+	case job.FieldTargetDaemonID:
+		m.ClearTargetDaemonID()
+		return nil
+	case job.FieldTargetAddress:
+		m.ClearTargetAddress()
+		return nil
+	case job.FieldDurationSeconds:
+		m.ClearDurationSeconds()
+		return nil
+	case job.FieldLeasedBy:
+		m.ClearLeasedBy()
+		return nil
+	case job.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	case job.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case job.FieldResultID:
+		m.ClearResultID()
+		return nil
+	case job.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case job.FieldCampaignID:
+		m.ClearCampaignID()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldType:
+		m.ResetType()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldDaemonID:
+		m.ResetDaemonID()
+		return nil
+	case job.FieldTargetDaemonID:
+		m.ResetTargetDaemonID()
+		return nil
+	case job.FieldTargetAddress:
+		m.ResetTargetAddress()
+		return nil
+	case job.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case job.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case job.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case job.FieldLeasedBy:
+		m.ResetLeasedBy()
+		return nil
+	case job.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	case job.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case job.FieldResultID:
+		m.ResetResultID()
+		return nil
+	case job.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case job.FieldCampaignID:
+		m.ResetCampaignID()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.host != nil {
+		edges = append(edges, job.EdgeHost)
+	}
+	if m.campaign != nil {
+		edges = append(edges, job.EdgeCampaign)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeHost:
+		if id := m.host; id != nil {
+			return []ent.Value{*id}
+		}
+	case job.EdgeCampaign:
+		if id := m.campaign; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedhost {
+		edges = append(edges, job.EdgeHost)
+	}
+	if m.clearedcampaign {
+		edges = append(edges, job.EdgeCampaign)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case job.EdgeHost:
+		return m.clearedhost
+	case job.EdgeCampaign:
+		return m.clearedcampaign
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	switch name {
+	case job.EdgeHost:
+		m.ClearHost()
+		return nil
+	case job.EdgeCampaign:
+		m.ClearCampaign()
+		return nil
+	}
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	switch name {
+	case job.EdgeHost:
+		m.ResetHost()
+		return nil
+	case job.EdgeCampaign:
+		m.ResetCampaign()
+		return nil
+	}
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// MeshTestMutation represents an operation that mutates the MeshTest nodes in the graph.
+type MeshTestMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	timestamp           *time.Time
+	source_daemon_id    *string
+	target_daemon_id    *string
+	target_address      *string
+	sent_mbps           *float64
+	addsent_mbps        *float64
+	received_mbps       *float64
+	addreceived_mbps    *float64
+	retransmits         *float64
+	addretransmits      *float64
+	mean_rtt_ms         *float64
+	addmean_rtt_ms      *float64
+	duration_seconds    *int
+	addduration_seconds *int
+	success             *bool
+	error_message       *string
+	submission_id       *uuid.UUID
+	trigger             *meshtest.Trigger
+	received_at         *time.Time
+	clock_offset_ms     *int64
+	addclock_offset_ms  *int64
+	clock_corrected     *bool
+	quarantined         *bool
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*MeshTest, error)
+	predicates          []predicate.MeshTest
+}
+
+var _ ent.Mutation = (*MeshTestMutation)(nil)
+
+// meshtestOption allows management of the mutation configuration using functional options.
+type meshtestOption func(*MeshTestMutation)
+
+// newMeshTestMutation creates new mutation for the MeshTest entity.
+func newMeshTestMutation(c config, op Op, opts ...meshtestOption) *MeshTestMutation {
+	m := &MeshTestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMeshTest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMeshTestID sets the ID field of the mutation.
+func withMeshTestID(id int) meshtestOption {
+	return func(m *MeshTestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MeshTest
+		)
+		m.oldValue = func(ctx context.Context) (*MeshTest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MeshTest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMeshTest sets the old MeshTest of the mutation.
+func withMeshTest(node *MeshTest) meshtestOption {
+	return func(m *MeshTestMutation) {
+		m.oldValue = func(context.Context) (*MeshTest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MeshTestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MeshTestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MeshTestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MeshTestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MeshTest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *MeshTestMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *MeshTestMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *MeshTestMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetSourceDaemonID sets the "source_daemon_id" field.
+func (m *MeshTestMutation) SetSourceDaemonID(s string) {
+	m.source_daemon_id = &s
+}
+
+// SourceDaemonID returns the value of the "source_daemon_id" field in the mutation.
+func (m *MeshTestMutation) SourceDaemonID() (r string, exists bool) {
+	v := m.source_daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceDaemonID returns the old "source_daemon_id" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldSourceDaemonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceDaemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceDaemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceDaemonID: %w", err)
+	}
+	return oldValue.SourceDaemonID, nil
+}
+
+// ResetSourceDaemonID resets all changes to the "source_daemon_id" field.
+func (m *MeshTestMutation) ResetSourceDaemonID() {
+	m.source_daemon_id = nil
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (m *MeshTestMutation) SetTargetDaemonID(s string) {
+	m.target_daemon_id = &s
+}
+
+// TargetDaemonID returns the value of the "target_daemon_id" field in the mutation.
+func (m *MeshTestMutation) TargetDaemonID() (r string, exists bool) {
+	v := m.target_daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetDaemonID returns the old "target_daemon_id" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldTargetDaemonID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetDaemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetDaemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetDaemonID: %w", err)
+	}
+	return oldValue.TargetDaemonID, nil
+}
+
+// ResetTargetDaemonID resets all changes to the "target_daemon_id" field.
+func (m *MeshTestMutation) ResetTargetDaemonID() {
+	m.target_daemon_id = nil
+}
+
+// SetTargetAddress sets the "target_address" field.
+func (m *MeshTestMutation) SetTargetAddress(s string) {
+	m.target_address = &s
+}
+
+// TargetAddress returns the value of the "target_address" field in the mutation.
+func (m *MeshTestMutation) TargetAddress() (r string, exists bool) {
+	v := m.target_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetAddress returns the old "target_address" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldTargetAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetAddress: %w", err)
+	}
+	return oldValue.TargetAddress, nil
+}
+
+// ClearTargetAddress clears the value of the "target_address" field.
+func (m *MeshTestMutation) ClearTargetAddress() {
+	m.target_address = nil
+	m.clearedFields[meshtest.FieldTargetAddress] = struct{}{}
+}
+
+// TargetAddressCleared returns if the "target_address" field was cleared in this mutation.
+func (m *MeshTestMutation) TargetAddressCleared() bool {
+	_, ok := m.clearedFields[meshtest.FieldTargetAddress]
+	return ok
+}
+
+// ResetTargetAddress resets all changes to the "target_address" field.
+func (m *MeshTestMutation) ResetTargetAddress() {
+	m.target_address = nil
+	delete(m.clearedFields, meshtest.FieldTargetAddress)
+}
+
+// SetSentMbps sets the "sent_mbps" field.
+func (m *MeshTestMutation) SetSentMbps(f float64) {
+	m.sent_mbps = &f
+	m.addsent_mbps = nil
+}
+
+// SentMbps returns the value of the "sent_mbps" field in the mutation.
+func (m *MeshTestMutation) SentMbps() (r float64, exists bool) {
+	v := m.sent_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentMbps returns the old "sent_mbps" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldSentMbps(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentMbps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentMbps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentMbps: %w", err)
+	}
+	return oldValue.SentMbps, nil
+}
+
+// AddSentMbps adds f to the "sent_mbps" field.
+func (m *MeshTestMutation) AddSentMbps(f float64) {
+	if m.addsent_mbps != nil {
+		*m.addsent_mbps += f
+	} else {
+		m.addsent_mbps = &f
+	}
+}
+
+// AddedSentMbps returns the value that was added to the "sent_mbps" field in this mutation.
+func (m *MeshTestMutation) AddedSentMbps() (r float64, exists bool) {
+	v := m.addsent_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSentMbps resets all changes to the "sent_mbps" field.
+func (m *MeshTestMutation) ResetSentMbps() {
+	m.sent_mbps = nil
+	m.addsent_mbps = nil
+}
+
+// SetReceivedMbps sets the "received_mbps" field.
+func (m *MeshTestMutation) SetReceivedMbps(f float64) {
+	m.received_mbps = &f
+	m.addreceived_mbps = nil
+}
+
+// ReceivedMbps returns the value of the "received_mbps" field in the mutation.
+func (m *MeshTestMutation) ReceivedMbps() (r float64, exists bool) {
+	v := m.received_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedMbps returns the old "received_mbps" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldReceivedMbps(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedMbps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedMbps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedMbps: %w", err)
+	}
+	return oldValue.ReceivedMbps, nil
+}
+
+// AddReceivedMbps adds f to the "received_mbps" field.
+func (m *MeshTestMutation) AddReceivedMbps(f float64) {
+	if m.addreceived_mbps != nil {
+		*m.addreceived_mbps += f
+	} else {
+		m.addreceived_mbps = &f
+	}
+}
+
+// AddedReceivedMbps returns the value that was added to the "received_mbps" field in this mutation.
+func (m *MeshTestMutation) AddedReceivedMbps() (r float64, exists bool) {
+	v := m.addreceived_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReceivedMbps resets all changes to the "received_mbps" field.
+func (m *MeshTestMutation) ResetReceivedMbps() {
+	m.received_mbps = nil
+	m.addreceived_mbps = nil
+}
+
+// SetRetransmits sets the "retransmits" field.
+func (m *MeshTestMutation) SetRetransmits(f float64) {
+	m.retransmits = &f
+	m.addretransmits = nil
+}
+
+// Retransmits returns the value of the "retransmits" field in the mutation.
+func (m *MeshTestMutation) Retransmits() (r float64, exists bool) {
+	v := m.retransmits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetransmits returns the old "retransmits" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldRetransmits(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetransmits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetransmits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetransmits: %w", err)
+	}
+	return oldValue.Retransmits, nil
+}
+
+// AddRetransmits adds f to the "retransmits" field.
+func (m *MeshTestMutation) AddRetransmits(f float64) {
+	if m.addretransmits != nil {
+		*m.addretransmits += f
+	} else {
+		m.addretransmits = &f
+	}
+}
+
+// AddedRetransmits returns the value that was added to the "retransmits" field in this mutation.
+func (m *MeshTestMutation) AddedRetransmits() (r float64, exists bool) {
+	v := m.addretransmits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetransmits clears the value of the "retransmits" field.
+func (m *MeshTestMutation) ClearRetransmits() {
+	m.retransmits = nil
+	m.addretransmits = nil
+	m.clearedFields[meshtest.FieldRetransmits] = struct{}{}
+}
+
+// RetransmitsCleared returns if the "retransmits" field was cleared in this mutation.
+func (m *MeshTestMutation) RetransmitsCleared() bool {
+	_, ok := m.clearedFields[meshtest.FieldRetransmits]
+	return ok
+}
+
+// ResetRetransmits resets all changes to the "retransmits" field.
+func (m *MeshTestMutation) ResetRetransmits() {
+	m.retransmits = nil
+	m.addretransmits = nil
+	delete(m.clearedFields, meshtest.FieldRetransmits)
+}
+
+// SetMeanRttMs sets the "mean_rtt_ms" field.
+func (m *MeshTestMutation) SetMeanRttMs(f float64) {
+	m.mean_rtt_ms = &f
+	m.addmean_rtt_ms = nil
+}
+
+// MeanRttMs returns the value of the "mean_rtt_ms" field in the mutation.
+func (m *MeshTestMutation) MeanRttMs() (r float64, exists bool) {
+	v := m.mean_rtt_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeanRttMs returns the old "mean_rtt_ms" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldMeanRttMs(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeanRttMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeanRttMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeanRttMs: %w", err)
+	}
+	return oldValue.MeanRttMs, nil
+}
+
+// AddMeanRttMs adds f to the "mean_rtt_ms" field.
+func (m *MeshTestMutation) AddMeanRttMs(f float64) {
+	if m.addmean_rtt_ms != nil {
+		*m.addmean_rtt_ms += f
+	} else {
+		m.addmean_rtt_ms = &f
+	}
+}
+
+// AddedMeanRttMs returns the value that was added to the "mean_rtt_ms" field in this mutation.
+func (m *MeshTestMutation) AddedMeanRttMs() (r float64, exists bool) {
+	v := m.addmean_rtt_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMeanRttMs clears the value of the "mean_rtt_ms" field.
+func (m *MeshTestMutation) ClearMeanRttMs() {
+	m.mean_rtt_ms = nil
+	m.addmean_rtt_ms = nil
+	m.clearedFields[meshtest.FieldMeanRttMs] = struct{}{}
+}
+
+// MeanRttMsCleared returns if the "mean_rtt_ms" field was cleared in this mutation.
+func (m *MeshTestMutation) MeanRttMsCleared() bool {
+	_, ok := m.clearedFields[meshtest.FieldMeanRttMs]
+	return ok
+}
+
+// ResetMeanRttMs resets all changes to the "mean_rtt_ms" field.
+func (m *MeshTestMutation) ResetMeanRttMs() {
+	m.mean_rtt_ms = nil
+	m.addmean_rtt_ms = nil
+	delete(m.clearedFields, meshtest.FieldMeanRttMs)
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (m *MeshTestMutation) SetDurationSeconds(i int) {
+	m.duration_seconds = &i
+	m.addduration_seconds = nil
+}
+
+// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
+func (m *MeshTestMutation) DurationSeconds() (r int, exists bool) {
+	v := m.duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationSeconds returns the old "duration_seconds" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+	}
+	return oldValue.DurationSeconds, nil
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (m *MeshTestMutation) AddDurationSeconds(i int) {
+	if m.addduration_seconds != nil {
+		*m.addduration_seconds += i
+	} else {
+		m.addduration_seconds = &i
+	}
+}
+
+// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
+func (m *MeshTestMutation) AddedDurationSeconds() (r int, exists bool) {
+	v := m.addduration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationSeconds resets all changes to the "duration_seconds" field.
+func (m *MeshTestMutation) ResetDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+}
+
+// SetSuccess sets the "success" field.
+func (m *MeshTestMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *MeshTestMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *MeshTestMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *MeshTestMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *MeshTestMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *MeshTestMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[meshtest.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *MeshTestMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[meshtest.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *MeshTestMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, meshtest.FieldErrorMessage)
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (m *MeshTestMutation) SetSubmissionID(u uuid.UUID) {
+	m.submission_id = &u
+}
+
+// SubmissionID returns the value of the "submission_id" field in the mutation.
+func (m *MeshTestMutation) SubmissionID() (r uuid.UUID, exists bool) {
+	v := m.submission_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionID returns the old "submission_id" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldSubmissionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionID: %w", err)
+	}
+	return oldValue.SubmissionID, nil
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (m *MeshTestMutation) ClearSubmissionID() {
+	m.submission_id = nil
+	m.clearedFields[meshtest.FieldSubmissionID] = struct{}{}
+}
+
+// SubmissionIDCleared returns if the "submission_id" field was cleared in this mutation.
+func (m *MeshTestMutation) SubmissionIDCleared() bool {
+	_, ok := m.clearedFields[meshtest.FieldSubmissionID]
+	return ok
+}
+
+// ResetSubmissionID resets all changes to the "submission_id" field.
+func (m *MeshTestMutation) ResetSubmissionID() {
+	m.submission_id = nil
+	delete(m.clearedFields, meshtest.FieldSubmissionID)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *MeshTestMutation) SetTrigger(value meshtest.Trigger) {
+	m.trigger = &value
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *MeshTestMutation) Trigger() (r meshtest.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldTrigger(ctx context.Context) (v meshtest.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *MeshTestMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (m *MeshTestMutation) SetReceivedAt(t time.Time) {
+	m.received_at = &t
+}
+
+// ReceivedAt returns the value of the "received_at" field in the mutation.
+func (m *MeshTestMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.received_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedAt returns the old "received_at" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldReceivedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
+	}
+	return oldValue.ReceivedAt, nil
+}
+
+// ResetReceivedAt resets all changes to the "received_at" field.
+func (m *MeshTestMutation) ResetReceivedAt() {
+	m.received_at = nil
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (m *MeshTestMutation) SetClockOffsetMs(i int64) {
+	m.clock_offset_ms = &i
+	m.addclock_offset_ms = nil
+}
+
+// ClockOffsetMs returns the value of the "clock_offset_ms" field in the mutation.
+func (m *MeshTestMutation) ClockOffsetMs() (r int64, exists bool) {
+	v := m.clock_offset_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClockOffsetMs returns the old "clock_offset_ms" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldClockOffsetMs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClockOffsetMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClockOffsetMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClockOffsetMs: %w", err)
+	}
+	return oldValue.ClockOffsetMs, nil
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (m *MeshTestMutation) AddClockOffsetMs(i int64) {
+	if m.addclock_offset_ms != nil {
+		*m.addclock_offset_ms += i
+	} else {
+		m.addclock_offset_ms = &i
+	}
+}
+
+// AddedClockOffsetMs returns the value that was added to the "clock_offset_ms" field in this mutation.
+func (m *MeshTestMutation) AddedClockOffsetMs() (r int64, exists bool) {
+	v := m.addclock_offset_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (m *MeshTestMutation) ClearClockOffsetMs() {
+	m.clock_offset_ms = nil
+	m.addclock_offset_ms = nil
+	m.clearedFields[meshtest.FieldClockOffsetMs] = struct{}{}
+}
+
+// ClockOffsetMsCleared returns if the "clock_offset_ms" field was cleared in this mutation.
+func (m *MeshTestMutation) ClockOffsetMsCleared() bool {
+	_, ok := m.clearedFields[meshtest.FieldClockOffsetMs]
+	return ok
+}
+
+// ResetClockOffsetMs resets all changes to the "clock_offset_ms" field.
+func (m *MeshTestMutation) ResetClockOffsetMs() {
+	m.clock_offset_ms = nil
+	m.addclock_offset_ms = nil
+	delete(m.clearedFields, meshtest.FieldClockOffsetMs)
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (m *MeshTestMutation) SetClockCorrected(b bool) {
+	m.clock_corrected = &b
+}
+
+// ClockCorrected returns the value of the "clock_corrected" field in the mutation.
+func (m *MeshTestMutation) ClockCorrected() (r bool, exists bool) {
+	v := m.clock_corrected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClockCorrected returns the old "clock_corrected" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldClockCorrected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClockCorrected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClockCorrected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClockCorrected: %w", err)
+	}
+	return oldValue.ClockCorrected, nil
+}
+
+// ResetClockCorrected resets all changes to the "clock_corrected" field.
+func (m *MeshTestMutation) ResetClockCorrected() {
+	m.clock_corrected = nil
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (m *MeshTestMutation) SetQuarantined(b bool) {
+	m.quarantined = &b
+}
+
+// Quarantined returns the value of the "quarantined" field in the mutation.
+func (m *MeshTestMutation) Quarantined() (r bool, exists bool) {
+	v := m.quarantined
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuarantined returns the old "quarantined" field's value of the MeshTest entity.
+// If the MeshTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MeshTestMutation) OldQuarantined(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuarantined is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuarantined requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuarantined: %w", err)
+	}
+	return oldValue.Quarantined, nil
+}
+
+// ResetQuarantined resets all changes to the "quarantined" field.
+func (m *MeshTestMutation) ResetQuarantined() {
+	m.quarantined = nil
+}
+
+// Where appends a list predicates to the MeshTestMutation builder.
+func (m *MeshTestMutation) Where(ps ...predicate.MeshTest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MeshTestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MeshTestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MeshTest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MeshTestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MeshTestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MeshTest).
+func (m *MeshTestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MeshTestMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.timestamp != nil {
+		fields = append(fields, meshtest.FieldTimestamp)
+	}
+	if m.source_daemon_id != nil {
+		fields = append(fields, meshtest.FieldSourceDaemonID)
+	}
+	if m.target_daemon_id != nil {
+		fields = append(fields, meshtest.FieldTargetDaemonID)
+	}
+	if m.target_address != nil {
+		fields = append(fields, meshtest.FieldTargetAddress)
+	}
+	if m.sent_mbps != nil {
+		fields = append(fields, meshtest.FieldSentMbps)
+	}
+	if m.received_mbps != nil {
+		fields = append(fields, meshtest.FieldReceivedMbps)
+	}
+	if m.retransmits != nil {
+		fields = append(fields, meshtest.FieldRetransmits)
+	}
+	if m.mean_rtt_ms != nil {
+		fields = append(fields, meshtest.FieldMeanRttMs)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, meshtest.FieldDurationSeconds)
+	}
+	if m.success != nil {
+		fields = append(fields, meshtest.FieldSuccess)
+	}
+	if m.error_message != nil {
+		fields = append(fields, meshtest.FieldErrorMessage)
+	}
+	if m.submission_id != nil {
+		fields = append(fields, meshtest.FieldSubmissionID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, meshtest.FieldTrigger)
+	}
+	if m.received_at != nil {
+		fields = append(fields, meshtest.FieldReceivedAt)
+	}
+	if m.clock_offset_ms != nil {
+		fields = append(fields, meshtest.FieldClockOffsetMs)
+	}
+	if m.clock_corrected != nil {
+		fields = append(fields, meshtest.FieldClockCorrected)
+	}
+	if m.quarantined != nil {
+		fields = append(fields, meshtest.FieldQuarantined)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MeshTestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case meshtest.FieldTimestamp:
+		return m.Timestamp()
+	case meshtest.FieldSourceDaemonID:
+		return m.SourceDaemonID()
+	case meshtest.FieldTargetDaemonID:
+		return m.TargetDaemonID()
+	case meshtest.FieldTargetAddress:
+		return m.TargetAddress()
+	case meshtest.FieldSentMbps:
+		return m.SentMbps()
+	case meshtest.FieldReceivedMbps:
+		return m.ReceivedMbps()
+	case meshtest.FieldRetransmits:
+		return m.Retransmits()
+	case meshtest.FieldMeanRttMs:
+		return m.MeanRttMs()
+	case meshtest.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case meshtest.FieldSuccess:
+		return m.Success()
+	case meshtest.FieldErrorMessage:
+		return m.ErrorMessage()
+	case meshtest.FieldSubmissionID:
+		return m.SubmissionID()
+	case meshtest.FieldTrigger:
+		return m.Trigger()
+	case meshtest.FieldReceivedAt:
+		return m.ReceivedAt()
+	case meshtest.FieldClockOffsetMs:
+		return m.ClockOffsetMs()
+	case meshtest.FieldClockCorrected:
+		return m.ClockCorrected()
+	case meshtest.FieldQuarantined:
+		return m.Quarantined()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MeshTestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case meshtest.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case meshtest.FieldSourceDaemonID:
+		return m.OldSourceDaemonID(ctx)
+	case meshtest.FieldTargetDaemonID:
+		return m.OldTargetDaemonID(ctx)
+	case meshtest.FieldTargetAddress:
+		return m.OldTargetAddress(ctx)
+	case meshtest.FieldSentMbps:
+		return m.OldSentMbps(ctx)
+	case meshtest.FieldReceivedMbps:
+		return m.OldReceivedMbps(ctx)
+	case meshtest.FieldRetransmits:
+		return m.OldRetransmits(ctx)
+	case meshtest.FieldMeanRttMs:
+		return m.OldMeanRttMs(ctx)
+	case meshtest.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case meshtest.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case meshtest.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case meshtest.FieldSubmissionID:
+		return m.OldSubmissionID(ctx)
+	case meshtest.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case meshtest.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
+	case meshtest.FieldClockOffsetMs:
+		return m.OldClockOffsetMs(ctx)
+	case meshtest.FieldClockCorrected:
+		return m.OldClockCorrected(ctx)
+	case meshtest.FieldQuarantined:
+		return m.OldQuarantined(ctx)
+	}
+	return nil, fmt.Errorf("unknown MeshTest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MeshTestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case meshtest.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case meshtest.FieldSourceDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceDaemonID(v)
+		return nil
+	case meshtest.FieldTargetDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetDaemonID(v)
+		return nil
+	case meshtest.FieldTargetAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAddress(v)
+		return nil
+	case meshtest.FieldSentMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentMbps(v)
+		return nil
+	case meshtest.FieldReceivedMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedMbps(v)
+		return nil
+	case meshtest.FieldRetransmits:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetransmits(v)
+		return nil
+	case meshtest.FieldMeanRttMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeanRttMs(v)
+		return nil
+	case meshtest.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case meshtest.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case meshtest.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case meshtest.FieldSubmissionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionID(v)
+		return nil
+	case meshtest.FieldTrigger:
+		v, ok := value.(meshtest.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case meshtest.FieldReceivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedAt(v)
+		return nil
+	case meshtest.FieldClockOffsetMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClockOffsetMs(v)
+		return nil
+	case meshtest.FieldClockCorrected:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClockCorrected(v)
+		return nil
+	case meshtest.FieldQuarantined:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuarantined(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MeshTest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MeshTestMutation) AddedFields() []string {
+	var fields []string
+	if m.addsent_mbps != nil {
+		fields = append(fields, meshtest.FieldSentMbps)
+	}
+	if m.addreceived_mbps != nil {
+		fields = append(fields, meshtest.FieldReceivedMbps)
+	}
+	if m.addretransmits != nil {
+		fields = append(fields, meshtest.FieldRetransmits)
+	}
+	if m.addmean_rtt_ms != nil {
+		fields = append(fields, meshtest.FieldMeanRttMs)
+	}
+	if m.addduration_seconds != nil {
+		fields = append(fields, meshtest.FieldDurationSeconds)
+	}
+	if m.addclock_offset_ms != nil {
+		fields = append(fields, meshtest.FieldClockOffsetMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MeshTestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case meshtest.FieldSentMbps:
+		return m.AddedSentMbps()
+	case meshtest.FieldReceivedMbps:
+		return m.AddedReceivedMbps()
+	case meshtest.FieldRetransmits:
+		return m.AddedRetransmits()
+	case meshtest.FieldMeanRttMs:
+		return m.AddedMeanRttMs()
+	case meshtest.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	case meshtest.FieldClockOffsetMs:
+		return m.AddedClockOffsetMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MeshTestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case meshtest.FieldSentMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSentMbps(v)
+		return nil
+	case meshtest.FieldReceivedMbps:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReceivedMbps(v)
+		return nil
+	case meshtest.FieldRetransmits:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetransmits(v)
+		return nil
+	case meshtest.FieldMeanRttMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMeanRttMs(v)
+		return nil
+	case meshtest.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	case meshtest.FieldClockOffsetMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClockOffsetMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MeshTest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MeshTestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(meshtest.FieldTargetAddress) {
+		fields = append(fields, meshtest.FieldTargetAddress)
+	}
+	if m.FieldCleared(meshtest.FieldRetransmits) {
+		fields = append(fields, meshtest.FieldRetransmits)
+	}
+	if m.FieldCleared(meshtest.FieldMeanRttMs) {
+		fields = append(fields, meshtest.FieldMeanRttMs)
+	}
+	if m.FieldCleared(meshtest.FieldErrorMessage) {
+		fields = append(fields, meshtest.FieldErrorMessage)
+	}
+	if m.FieldCleared(meshtest.FieldSubmissionID) {
+		fields = append(fields, meshtest.FieldSubmissionID)
+	}
+	if m.FieldCleared(meshtest.FieldClockOffsetMs) {
+		fields = append(fields, meshtest.FieldClockOffsetMs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MeshTestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MeshTestMutation) ClearField(name string) error {
+	switch name {
+	case meshtest.FieldTargetAddress:
+		m.ClearTargetAddress()
+		return nil
+	case meshtest.FieldRetransmits:
+		m.ClearRetransmits()
+		return nil
+	case meshtest.FieldMeanRttMs:
+		m.ClearMeanRttMs()
+		return nil
+	case meshtest.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case meshtest.FieldSubmissionID:
+		m.ClearSubmissionID()
+		return nil
+	case meshtest.FieldClockOffsetMs:
+		m.ClearClockOffsetMs()
+		return nil
+	}
+	return fmt.Errorf("unknown MeshTest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MeshTestMutation) ResetField(name string) error {
+	switch name {
+	case meshtest.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case meshtest.FieldSourceDaemonID:
+		m.ResetSourceDaemonID()
+		return nil
+	case meshtest.FieldTargetDaemonID:
+		m.ResetTargetDaemonID()
+		return nil
+	case meshtest.FieldTargetAddress:
+		m.ResetTargetAddress()
+		return nil
+	case meshtest.FieldSentMbps:
+		m.ResetSentMbps()
+		return nil
+	case meshtest.FieldReceivedMbps:
+		m.ResetReceivedMbps()
+		return nil
+	case meshtest.FieldRetransmits:
+		m.ResetRetransmits()
+		return nil
+	case meshtest.FieldMeanRttMs:
+		m.ResetMeanRttMs()
+		return nil
+	case meshtest.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case meshtest.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case meshtest.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case meshtest.FieldSubmissionID:
+		m.ResetSubmissionID()
+		return nil
+	case meshtest.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case meshtest.FieldReceivedAt:
+		m.ResetReceivedAt()
+		return nil
+	case meshtest.FieldClockOffsetMs:
+		m.ResetClockOffsetMs()
+		return nil
+	case meshtest.FieldClockCorrected:
+		m.ResetClockCorrected()
+		return nil
+	case meshtest.FieldQuarantined:
+		m.ResetQuarantined()
+		return nil
+	}
+	return fmt.Errorf("unknown MeshTest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MeshTestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MeshTestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MeshTestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MeshTestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MeshTestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MeshTestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MeshTestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MeshTest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MeshTestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MeshTest edge %s", name)
+}
+
+// SpeedTestMutation represents an operation that mutates the SpeedTest nodes in the graph.
+type SpeedTestMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	timestamp          *time.Time
+	download_mbps      *float64
+	adddownload_mbps   *float64
+	upload_mbps        *float64
+	addupload_mbps     *float64
+	ping_ms            *float64
+	addping_ms         *float64
+	jitter_ms          *float64
+	addjitter_ms       *float64
+	server_name        *string
+	server_id          *string
+	isp                *string
+	external_ip        *string
+	result_url         *string
+	daemon_id          *string
+	submission_id      *uuid.UUID
+	trigger            *speedtest.Trigger
+	received_at        *time.Time
+	clock_offset_ms    *int64
+	addclock_offset_ms *int64
+	clock_corrected    *bool
+	campaign_id        *int
+	addcampaign_id     *int
+	quarantined        *bool
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*SpeedTest, error)
+	predicates         []predicate.SpeedTest
+}
+
+var _ ent.Mutation = (*SpeedTestMutation)(nil)
+
+// speedtestOption allows management of the mutation configuration using functional options.
+type speedtestOption func(*SpeedTestMutation)
+
+// newSpeedTestMutation creates new mutation for the SpeedTest entity.
+func newSpeedTestMutation(c config, op Op, opts ...speedtestOption) *SpeedTestMutation {
+	m := &SpeedTestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSpeedTest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSpeedTestID sets the ID field of the mutation.
+func withSpeedTestID(id int) speedtestOption {
+	return func(m *SpeedTestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SpeedTest
+		)
+		m.oldValue = func(ctx context.Context) (*SpeedTest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SpeedTest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSpeedTest sets the old SpeedTest of the mutation.
+func withSpeedTest(node *SpeedTest) speedtestOption {
+	return func(m *SpeedTestMutation) {
+		m.oldValue = func(context.Context) (*SpeedTest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SpeedTestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SpeedTestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SpeedTestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SpeedTestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SpeedTest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *SpeedTestMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *SpeedTestMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *SpeedTestMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetDownloadMbps sets the "download_mbps" field.
+func (m *SpeedTestMutation) SetDownloadMbps(f float64) {
+	m.download_mbps = &f
+	m.adddownload_mbps = nil
+}
+
+// DownloadMbps returns the value of the "download_mbps" field in the mutation.
+func (m *SpeedTestMutation) DownloadMbps() (r float64, exists bool) {
+	v := m.download_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadMbps returns the old "download_mbps" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldDownloadMbps(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadMbps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadMbps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadMbps: %w", err)
+	}
+	return oldValue.DownloadMbps, nil
+}
+
+// AddDownloadMbps adds f to the "download_mbps" field.
+func (m *SpeedTestMutation) AddDownloadMbps(f float64) {
+	if m.adddownload_mbps != nil {
+		*m.adddownload_mbps += f
+	} else {
+		m.adddownload_mbps = &f
+	}
+}
+
+// AddedDownloadMbps returns the value that was added to the "download_mbps" field in this mutation.
+func (m *SpeedTestMutation) AddedDownloadMbps() (r float64, exists bool) {
+	v := m.adddownload_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloadMbps resets all changes to the "download_mbps" field.
+func (m *SpeedTestMutation) ResetDownloadMbps() {
+	m.download_mbps = nil
+	m.adddownload_mbps = nil
+}
+
+// SetUploadMbps sets the "upload_mbps" field.
+func (m *SpeedTestMutation) SetUploadMbps(f float64) {
+	m.upload_mbps = &f
+	m.addupload_mbps = nil
+}
+
+// UploadMbps returns the value of the "upload_mbps" field in the mutation.
+func (m *SpeedTestMutation) UploadMbps() (r float64, exists bool) {
+	v := m.upload_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadMbps returns the old "upload_mbps" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldUploadMbps(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadMbps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadMbps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadMbps: %w", err)
+	}
+	return oldValue.UploadMbps, nil
+}
+
+// AddUploadMbps adds f to the "upload_mbps" field.
+func (m *SpeedTestMutation) AddUploadMbps(f float64) {
+	if m.addupload_mbps != nil {
+		*m.addupload_mbps += f
+	} else {
+		m.addupload_mbps = &f
+	}
+}
+
+// AddedUploadMbps returns the value that was added to the "upload_mbps" field in this mutation.
+func (m *SpeedTestMutation) AddedUploadMbps() (r float64, exists bool) {
+	v := m.addupload_mbps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUploadMbps resets all changes to the "upload_mbps" field.
+func (m *SpeedTestMutation) ResetUploadMbps() {
+	m.upload_mbps = nil
+	m.addupload_mbps = nil
+}
+
+// SetPingMs sets the "ping_ms" field.
+func (m *SpeedTestMutation) SetPingMs(f float64) {
+	m.ping_ms = &f
+	m.addping_ms = nil
+}
+
+// PingMs returns the value of the "ping_ms" field in the mutation.
+func (m *SpeedTestMutation) PingMs() (r float64, exists bool) {
+	v := m.ping_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPingMs returns the old "ping_ms" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldPingMs(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPingMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPingMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPingMs: %w", err)
+	}
+	return oldValue.PingMs, nil
+}
+
+// AddPingMs adds f to the "ping_ms" field.
+func (m *SpeedTestMutation) AddPingMs(f float64) {
+	if m.addping_ms != nil {
+		*m.addping_ms += f
+	} else {
+		m.addping_ms = &f
+	}
+}
+
+// AddedPingMs returns the value that was added to the "ping_ms" field in this mutation.
+func (m *SpeedTestMutation) AddedPingMs() (r float64, exists bool) {
+	v := m.addping_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPingMs resets all changes to the "ping_ms" field.
+func (m *SpeedTestMutation) ResetPingMs() {
+	m.ping_ms = nil
+	m.addping_ms = nil
+}
+
+// SetJitterMs sets the "jitter_ms" field.
+func (m *SpeedTestMutation) SetJitterMs(f float64) {
+	m.jitter_ms = &f
+	m.addjitter_ms = nil
+}
+
+// JitterMs returns the value of the "jitter_ms" field in the mutation.
+func (m *SpeedTestMutation) JitterMs() (r float64, exists bool) {
+	v := m.jitter_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJitterMs returns the old "jitter_ms" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldJitterMs(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJitterMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJitterMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJitterMs: %w", err)
+	}
+	return oldValue.JitterMs, nil
+}
+
+// AddJitterMs adds f to the "jitter_ms" field.
+func (m *SpeedTestMutation) AddJitterMs(f float64) {
+	if m.addjitter_ms != nil {
+		*m.addjitter_ms += f
+	} else {
+		m.addjitter_ms = &f
+	}
+}
+
+// AddedJitterMs returns the value that was added to the "jitter_ms" field in this mutation.
+func (m *SpeedTestMutation) AddedJitterMs() (r float64, exists bool) {
+	v := m.addjitter_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearJitterMs clears the value of the "jitter_ms" field.
+func (m *SpeedTestMutation) ClearJitterMs() {
+	m.jitter_ms = nil
+	m.addjitter_ms = nil
+	m.clearedFields[speedtest.FieldJitterMs] = struct{}{}
+}
+
+// JitterMsCleared returns if the "jitter_ms" field was cleared in this mutation.
+func (m *SpeedTestMutation) JitterMsCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldJitterMs]
+	return ok
+}
+
+// ResetJitterMs resets all changes to the "jitter_ms" field.
+func (m *SpeedTestMutation) ResetJitterMs() {
+	m.jitter_ms = nil
+	m.addjitter_ms = nil
+	delete(m.clearedFields, speedtest.FieldJitterMs)
+}
+
+// SetServerName sets the "server_name" field.
+func (m *SpeedTestMutation) SetServerName(s string) {
+	m.server_name = &s
+}
+
+// ServerName returns the value of the "server_name" field in the mutation.
+func (m *SpeedTestMutation) ServerName() (r string, exists bool) {
+	v := m.server_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServerName returns the old "server_name" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldServerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServerName: %w", err)
+	}
+	return oldValue.ServerName, nil
+}
+
+// ClearServerName clears the value of the "server_name" field.
+func (m *SpeedTestMutation) ClearServerName() {
+	m.server_name = nil
+	m.clearedFields[speedtest.FieldServerName] = struct{}{}
+}
+
+// ServerNameCleared returns if the "server_name" field was cleared in this mutation.
+func (m *SpeedTestMutation) ServerNameCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldServerName]
+	return ok
+}
+
+// ResetServerName resets all changes to the "server_name" field.
+func (m *SpeedTestMutation) ResetServerName() {
+	m.server_name = nil
+	delete(m.clearedFields, speedtest.FieldServerName)
+}
+
+// SetServerID sets the "server_id" field.
+func (m *SpeedTestMutation) SetServerID(s string) {
+	m.server_id = &s
+}
+
+// ServerID returns the value of the "server_id" field in the mutation.
+func (m *SpeedTestMutation) ServerID() (r string, exists bool) {
+	v := m.server_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServerID returns the old "server_id" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldServerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServerID: %w", err)
+	}
+	return oldValue.ServerID, nil
+}
+
+// ClearServerID clears the value of the "server_id" field.
+func (m *SpeedTestMutation) ClearServerID() {
+	m.server_id = nil
+	m.clearedFields[speedtest.FieldServerID] = struct{}{}
+}
+
+// ServerIDCleared returns if the "server_id" field was cleared in this mutation.
+func (m *SpeedTestMutation) ServerIDCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldServerID]
+	return ok
+}
+
+// ResetServerID resets all changes to the "server_id" field.
+func (m *SpeedTestMutation) ResetServerID() {
+	m.server_id = nil
+	delete(m.clearedFields, speedtest.FieldServerID)
+}
+
+// SetIsp sets the "isp" field.
+func (m *SpeedTestMutation) SetIsp(s string) {
+	m.isp = &s
+}
+
+// Isp returns the value of the "isp" field in the mutation.
+func (m *SpeedTestMutation) Isp() (r string, exists bool) {
+	v := m.isp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsp returns the old "isp" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldIsp(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsp: %w", err)
+	}
+	return oldValue.Isp, nil
+}
+
+// ClearIsp clears the value of the "isp" field.
+func (m *SpeedTestMutation) ClearIsp() {
+	m.isp = nil
+	m.clearedFields[speedtest.FieldIsp] = struct{}{}
+}
+
+// IspCleared returns if the "isp" field was cleared in this mutation.
+func (m *SpeedTestMutation) IspCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldIsp]
+	return ok
+}
+
+// ResetIsp resets all changes to the "isp" field.
+func (m *SpeedTestMutation) ResetIsp() {
+	m.isp = nil
+	delete(m.clearedFields, speedtest.FieldIsp)
+}
+
+// SetExternalIP sets the "external_ip" field.
+func (m *SpeedTestMutation) SetExternalIP(s string) {
+	m.external_ip = &s
+}
+
+// ExternalIP returns the value of the "external_ip" field in the mutation.
+func (m *SpeedTestMutation) ExternalIP() (r string, exists bool) {
+	v := m.external_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalIP returns the old "external_ip" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldExternalIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalIP: %w", err)
+	}
+	return oldValue.ExternalIP, nil
+}
+
+// ClearExternalIP clears the value of the "external_ip" field.
+func (m *SpeedTestMutation) ClearExternalIP() {
+	m.external_ip = nil
+	m.clearedFields[speedtest.FieldExternalIP] = struct{}{}
+}
+
+// ExternalIPCleared returns if the "external_ip" field was cleared in this mutation.
+func (m *SpeedTestMutation) ExternalIPCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldExternalIP]
+	return ok
+}
+
+// ResetExternalIP resets all changes to the "external_ip" field.
+func (m *SpeedTestMutation) ResetExternalIP() {
+	m.external_ip = nil
+	delete(m.clearedFields, speedtest.FieldExternalIP)
+}
+
+// SetResultURL sets the "result_url" field.
+func (m *SpeedTestMutation) SetResultURL(s string) {
+	m.result_url = &s
+}
+
+// ResultURL returns the value of the "result_url" field in the mutation.
+func (m *SpeedTestMutation) ResultURL() (r string, exists bool) {
+	v := m.result_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDaemonID returns the old "daemon_id" field's value of the IperfTest entity.
-// If the IperfTest object wasn't provided to the builder, the object is fetched from the database.
+// OldResultURL returns the old "result_url" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IperfTestMutation) OldDaemonID(ctx context.Context) (v string, err error) {
+func (m *SpeedTestMutation) OldResultURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultURL: %w", err)
+	}
+	return oldValue.ResultURL, nil
+}
+
+// ClearResultURL clears the value of the "result_url" field.
+func (m *SpeedTestMutation) ClearResultURL() {
+	m.result_url = nil
+	m.clearedFields[speedtest.FieldResultURL] = struct{}{}
+}
+
+// ResultURLCleared returns if the "result_url" field was cleared in this mutation.
+func (m *SpeedTestMutation) ResultURLCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldResultURL]
+	return ok
+}
+
+// ResetResultURL resets all changes to the "result_url" field.
+func (m *SpeedTestMutation) ResetResultURL() {
+	m.result_url = nil
+	delete(m.clearedFields, speedtest.FieldResultURL)
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (m *SpeedTestMutation) SetDaemonID(s string) {
+	m.daemon_id = &s
+}
+
+// DaemonID returns the value of the "daemon_id" field in the mutation.
+func (m *SpeedTestMutation) DaemonID() (r string, exists bool) {
+	v := m.daemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDaemonID returns the old "daemon_id" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldDaemonID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
 	}
@@ -1402,71 +11052,378 @@ func (m *IperfTestMutation) OldDaemonID(ctx context.Context) (v string, err erro
 }
 
 // ClearDaemonID clears the value of the "daemon_id" field.
-func (m *IperfTestMutation) ClearDaemonID() {
+func (m *SpeedTestMutation) ClearDaemonID() {
 	m.daemon_id = nil
-	m.clearedFields[iperftest.FieldDaemonID] = struct{}{}
+	m.clearedFields[speedtest.FieldDaemonID] = struct{}{}
 }
 
 // DaemonIDCleared returns if the "daemon_id" field was cleared in this mutation.
-func (m *IperfTestMutation) DaemonIDCleared() bool {
-	_, ok := m.clearedFields[iperftest.FieldDaemonID]
+func (m *SpeedTestMutation) DaemonIDCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldDaemonID]
 	return ok
 }
 
 // ResetDaemonID resets all changes to the "daemon_id" field.
-func (m *IperfTestMutation) ResetDaemonID() {
+func (m *SpeedTestMutation) ResetDaemonID() {
 	m.daemon_id = nil
-	delete(m.clearedFields, iperftest.FieldDaemonID)
+	delete(m.clearedFields, speedtest.FieldDaemonID)
 }
 
-// SetHostID sets the "host" edge to the Host entity by id.
-func (m *IperfTestMutation) SetHostID(id int) {
-	m.host = &id
+// SetSubmissionID sets the "submission_id" field.
+func (m *SpeedTestMutation) SetSubmissionID(u uuid.UUID) {
+	m.submission_id = &u
+}
+
+// SubmissionID returns the value of the "submission_id" field in the mutation.
+func (m *SpeedTestMutation) SubmissionID() (r uuid.UUID, exists bool) {
+	v := m.submission_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmissionID returns the old "submission_id" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldSubmissionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmissionID: %w", err)
+	}
+	return oldValue.SubmissionID, nil
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (m *SpeedTestMutation) ClearSubmissionID() {
+	m.submission_id = nil
+	m.clearedFields[speedtest.FieldSubmissionID] = struct{}{}
+}
+
+// SubmissionIDCleared returns if the "submission_id" field was cleared in this mutation.
+func (m *SpeedTestMutation) SubmissionIDCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldSubmissionID]
+	return ok
+}
+
+// ResetSubmissionID resets all changes to the "submission_id" field.
+func (m *SpeedTestMutation) ResetSubmissionID() {
+	m.submission_id = nil
+	delete(m.clearedFields, speedtest.FieldSubmissionID)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *SpeedTestMutation) SetTrigger(s speedtest.Trigger) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *SpeedTestMutation) Trigger() (r speedtest.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldTrigger(ctx context.Context) (v speedtest.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *SpeedTestMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (m *SpeedTestMutation) SetReceivedAt(t time.Time) {
+	m.received_at = &t
+}
+
+// ReceivedAt returns the value of the "received_at" field in the mutation.
+func (m *SpeedTestMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.received_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedAt returns the old "received_at" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldReceivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
+	}
+	return oldValue.ReceivedAt, nil
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (m *SpeedTestMutation) ClearReceivedAt() {
+	m.received_at = nil
+	m.clearedFields[speedtest.FieldReceivedAt] = struct{}{}
+}
+
+// ReceivedAtCleared returns if the "received_at" field was cleared in this mutation.
+func (m *SpeedTestMutation) ReceivedAtCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldReceivedAt]
+	return ok
+}
+
+// ResetReceivedAt resets all changes to the "received_at" field.
+func (m *SpeedTestMutation) ResetReceivedAt() {
+	m.received_at = nil
+	delete(m.clearedFields, speedtest.FieldReceivedAt)
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (m *SpeedTestMutation) SetClockOffsetMs(i int64) {
+	m.clock_offset_ms = &i
+	m.addclock_offset_ms = nil
+}
+
+// ClockOffsetMs returns the value of the "clock_offset_ms" field in the mutation.
+func (m *SpeedTestMutation) ClockOffsetMs() (r int64, exists bool) {
+	v := m.clock_offset_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClockOffsetMs returns the old "clock_offset_ms" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldClockOffsetMs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClockOffsetMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClockOffsetMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClockOffsetMs: %w", err)
+	}
+	return oldValue.ClockOffsetMs, nil
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (m *SpeedTestMutation) AddClockOffsetMs(i int64) {
+	if m.addclock_offset_ms != nil {
+		*m.addclock_offset_ms += i
+	} else {
+		m.addclock_offset_ms = &i
+	}
+}
+
+// AddedClockOffsetMs returns the value that was added to the "clock_offset_ms" field in this mutation.
+func (m *SpeedTestMutation) AddedClockOffsetMs() (r int64, exists bool) {
+	v := m.addclock_offset_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (m *SpeedTestMutation) ClearClockOffsetMs() {
+	m.clock_offset_ms = nil
+	m.addclock_offset_ms = nil
+	m.clearedFields[speedtest.FieldClockOffsetMs] = struct{}{}
+}
+
+// ClockOffsetMsCleared returns if the "clock_offset_ms" field was cleared in this mutation.
+func (m *SpeedTestMutation) ClockOffsetMsCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldClockOffsetMs]
+	return ok
+}
+
+// ResetClockOffsetMs resets all changes to the "clock_offset_ms" field.
+func (m *SpeedTestMutation) ResetClockOffsetMs() {
+	m.clock_offset_ms = nil
+	m.addclock_offset_ms = nil
+	delete(m.clearedFields, speedtest.FieldClockOffsetMs)
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (m *SpeedTestMutation) SetClockCorrected(b bool) {
+	m.clock_corrected = &b
+}
+
+// ClockCorrected returns the value of the "clock_corrected" field in the mutation.
+func (m *SpeedTestMutation) ClockCorrected() (r bool, exists bool) {
+	v := m.clock_corrected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClockCorrected returns the old "clock_corrected" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldClockCorrected(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClockCorrected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClockCorrected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClockCorrected: %w", err)
+	}
+	return oldValue.ClockCorrected, nil
+}
+
+// ResetClockCorrected resets all changes to the "clock_corrected" field.
+func (m *SpeedTestMutation) ResetClockCorrected() {
+	m.clock_corrected = nil
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (m *SpeedTestMutation) SetCampaignID(i int) {
+	m.campaign_id = &i
+	m.addcampaign_id = nil
+}
+
+// CampaignID returns the value of the "campaign_id" field in the mutation.
+func (m *SpeedTestMutation) CampaignID() (r int, exists bool) {
+	v := m.campaign_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCampaignID returns the old "campaign_id" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldCampaignID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCampaignID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCampaignID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCampaignID: %w", err)
+	}
+	return oldValue.CampaignID, nil
+}
+
+// AddCampaignID adds i to the "campaign_id" field.
+func (m *SpeedTestMutation) AddCampaignID(i int) {
+	if m.addcampaign_id != nil {
+		*m.addcampaign_id += i
+	} else {
+		m.addcampaign_id = &i
+	}
+}
+
+// AddedCampaignID returns the value that was added to the "campaign_id" field in this mutation.
+func (m *SpeedTestMutation) AddedCampaignID() (r int, exists bool) {
+	v := m.addcampaign_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (m *SpeedTestMutation) ClearCampaignID() {
+	m.campaign_id = nil
+	m.addcampaign_id = nil
+	m.clearedFields[speedtest.FieldCampaignID] = struct{}{}
+}
+
+// CampaignIDCleared returns if the "campaign_id" field was cleared in this mutation.
+func (m *SpeedTestMutation) CampaignIDCleared() bool {
+	_, ok := m.clearedFields[speedtest.FieldCampaignID]
+	return ok
 }
 
-// ClearHost clears the "host" edge to the Host entity.
-func (m *IperfTestMutation) ClearHost() {
-	m.clearedhost = true
+// ResetCampaignID resets all changes to the "campaign_id" field.
+func (m *SpeedTestMutation) ResetCampaignID() {
+	m.campaign_id = nil
+	m.addcampaign_id = nil
+	delete(m.clearedFields, speedtest.FieldCampaignID)
 }
 
-// HostCleared reports if the "host" edge to the Host entity was cleared.
-func (m *IperfTestMutation) HostCleared() bool {
-	return m.clearedhost
+// SetQuarantined sets the "quarantined" field.
+func (m *SpeedTestMutation) SetQuarantined(b bool) {
+	m.quarantined = &b
 }
 
-// HostID returns the "host" edge ID in the mutation.
-func (m *IperfTestMutation) HostID() (id int, exists bool) {
-	if m.host != nil {
-		return *m.host, true
+// Quarantined returns the value of the "quarantined" field in the mutation.
+func (m *SpeedTestMutation) Quarantined() (r bool, exists bool) {
+	v := m.quarantined
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// HostIDs returns the "host" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HostID instead. It exists only for internal usage by the builders.
-func (m *IperfTestMutation) HostIDs() (ids []int) {
-	if id := m.host; id != nil {
-		ids = append(ids, *id)
+// OldQuarantined returns the old "quarantined" field's value of the SpeedTest entity.
+// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpeedTestMutation) OldQuarantined(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuarantined is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuarantined requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuarantined: %w", err)
+	}
+	return oldValue.Quarantined, nil
 }
 
-// ResetHost resets all changes to the "host" edge.
-func (m *IperfTestMutation) ResetHost() {
-	m.host = nil
-	m.clearedhost = false
+// ResetQuarantined resets all changes to the "quarantined" field.
+func (m *SpeedTestMutation) ResetQuarantined() {
+	m.quarantined = nil
 }
 
-// Where appends a list predicates to the IperfTestMutation builder.
-func (m *IperfTestMutation) Where(ps ...predicate.IperfTest) {
+// Where appends a list predicates to the SpeedTestMutation builder.
+func (m *SpeedTestMutation) Where(ps ...predicate.SpeedTest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IperfTestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SpeedTestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IperfTestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.IperfTest, len(ps))
+func (m *SpeedTestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SpeedTest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1474,54 +11431,78 @@ func (m *IperfTestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IperfTestMutation) Op() Op {
+func (m *SpeedTestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IperfTestMutation) SetOp(op Op) {
+func (m *SpeedTestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (IperfTest).
-func (m *IperfTestMutation) Type() string {
+// Type returns the node type of this mutation (SpeedTest).
+func (m *SpeedTestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IperfTestMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *SpeedTestMutation) Fields() []string {
+	fields := make([]string, 0, 18)
 	if m.timestamp != nil {
-		fields = append(fields, iperftest.FieldTimestamp)
+		fields = append(fields, speedtest.FieldTimestamp)
 	}
-	if m.sent_mbps != nil {
-		fields = append(fields, iperftest.FieldSentMbps)
+	if m.download_mbps != nil {
+		fields = append(fields, speedtest.FieldDownloadMbps)
 	}
-	if m.received_mbps != nil {
-		fields = append(fields, iperftest.FieldReceivedMbps)
+	if m.upload_mbps != nil {
+		fields = append(fields, speedtest.FieldUploadMbps)
 	}
-	if m.retransmits != nil {
-		fields = append(fields, iperftest.FieldRetransmits)
+	if m.ping_ms != nil {
+		fields = append(fields, speedtest.FieldPingMs)
 	}
-	if m.mean_rtt_ms != nil {
-		fields = append(fields, iperftest.FieldMeanRttMs)
+	if m.jitter_ms != nil {
+		fields = append(fields, speedtest.FieldJitterMs)
 	}
-	if m.duration_seconds != nil {
-		fields = append(fields, iperftest.FieldDurationSeconds)
+	if m.server_name != nil {
+		fields = append(fields, speedtest.FieldServerName)
 	}
-	if m.protocol != nil {
-		fields = append(fields, iperftest.FieldProtocol)
+	if m.server_id != nil {
+		fields = append(fields, speedtest.FieldServerID)
 	}
-	if m.success != nil {
-		fields = append(fields, iperftest.FieldSuccess)
+	if m.isp != nil {
+		fields = append(fields, speedtest.FieldIsp)
 	}
-	if m.error_message != nil {
-		fields = append(fields, iperftest.FieldErrorMessage)
+	if m.external_ip != nil {
+		fields = append(fields, speedtest.FieldExternalIP)
+	}
+	if m.result_url != nil {
+		fields = append(fields, speedtest.FieldResultURL)
 	}
 	if m.daemon_id != nil {
-		fields = append(fields, iperftest.FieldDaemonID)
+		fields = append(fields, speedtest.FieldDaemonID)
+	}
+	if m.submission_id != nil {
+		fields = append(fields, speedtest.FieldSubmissionID)
+	}
+	if m.trigger != nil {
+		fields = append(fields, speedtest.FieldTrigger)
+	}
+	if m.received_at != nil {
+		fields = append(fields, speedtest.FieldReceivedAt)
+	}
+	if m.clock_offset_ms != nil {
+		fields = append(fields, speedtest.FieldClockOffsetMs)
+	}
+	if m.clock_corrected != nil {
+		fields = append(fields, speedtest.FieldClockCorrected)
+	}
+	if m.campaign_id != nil {
+		fields = append(fields, speedtest.FieldCampaignID)
+	}
+	if m.quarantined != nil {
+		fields = append(fields, speedtest.FieldQuarantined)
 	}
 	return fields
 }
@@ -1529,28 +11510,44 @@ func (m *IperfTestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IperfTestMutation) Field(name string) (ent.Value, bool) {
+func (m *SpeedTestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case iperftest.FieldTimestamp:
+	case speedtest.FieldTimestamp:
 		return m.Timestamp()
-	case iperftest.FieldSentMbps:
-		return m.SentMbps()
-	case iperftest.FieldReceivedMbps:
-		return m.ReceivedMbps()
-	case iperftest.FieldRetransmits:
-		return m.Retransmits()
-	case iperftest.FieldMeanRttMs:
-		return m.MeanRttMs()
-	case iperftest.FieldDurationSeconds:
-		return m.DurationSeconds()
-	case iperftest.FieldProtocol:
-		return m.Protocol()
-	case iperftest.FieldSuccess:
-		return m.Success()
-	case iperftest.FieldErrorMessage:
-		return m.ErrorMessage()
-	case iperftest.FieldDaemonID:
+	case speedtest.FieldDownloadMbps:
+		return m.DownloadMbps()
+	case speedtest.FieldUploadMbps:
+		return m.UploadMbps()
+	case speedtest.FieldPingMs:
+		return m.PingMs()
+	case speedtest.FieldJitterMs:
+		return m.JitterMs()
+	case speedtest.FieldServerName:
+		return m.ServerName()
+	case speedtest.FieldServerID:
+		return m.ServerID()
+	case speedtest.FieldIsp:
+		return m.Isp()
+	case speedtest.FieldExternalIP:
+		return m.ExternalIP()
+	case speedtest.FieldResultURL:
+		return m.ResultURL()
+	case speedtest.FieldDaemonID:
 		return m.DaemonID()
+	case speedtest.FieldSubmissionID:
+		return m.SubmissionID()
+	case speedtest.FieldTrigger:
+		return m.Trigger()
+	case speedtest.FieldReceivedAt:
+		return m.ReceivedAt()
+	case speedtest.FieldClockOffsetMs:
+		return m.ClockOffsetMs()
+	case speedtest.FieldClockCorrected:
+		return m.ClockCorrected()
+	case speedtest.FieldCampaignID:
+		return m.CampaignID()
+	case speedtest.FieldQuarantined:
+		return m.Quarantined()
 	}
 	return nil, false
 }
@@ -1558,129 +11555,204 @@ func (m *IperfTestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IperfTestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SpeedTestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case iperftest.FieldTimestamp:
+	case speedtest.FieldTimestamp:
 		return m.OldTimestamp(ctx)
-	case iperftest.FieldSentMbps:
-		return m.OldSentMbps(ctx)
-	case iperftest.FieldReceivedMbps:
-		return m.OldReceivedMbps(ctx)
-	case iperftest.FieldRetransmits:
-		return m.OldRetransmits(ctx)
-	case iperftest.FieldMeanRttMs:
-		return m.OldMeanRttMs(ctx)
-	case iperftest.FieldDurationSeconds:
-		return m.OldDurationSeconds(ctx)
-	case iperftest.FieldProtocol:
-		return m.OldProtocol(ctx)
-	case iperftest.FieldSuccess:
-		return m.OldSuccess(ctx)
-	case iperftest.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case iperftest.FieldDaemonID:
+	case speedtest.FieldDownloadMbps:
+		return m.OldDownloadMbps(ctx)
+	case speedtest.FieldUploadMbps:
+		return m.OldUploadMbps(ctx)
+	case speedtest.FieldPingMs:
+		return m.OldPingMs(ctx)
+	case speedtest.FieldJitterMs:
+		return m.OldJitterMs(ctx)
+	case speedtest.FieldServerName:
+		return m.OldServerName(ctx)
+	case speedtest.FieldServerID:
+		return m.OldServerID(ctx)
+	case speedtest.FieldIsp:
+		return m.OldIsp(ctx)
+	case speedtest.FieldExternalIP:
+		return m.OldExternalIP(ctx)
+	case speedtest.FieldResultURL:
+		return m.OldResultURL(ctx)
+	case speedtest.FieldDaemonID:
 		return m.OldDaemonID(ctx)
+	case speedtest.FieldSubmissionID:
+		return m.OldSubmissionID(ctx)
+	case speedtest.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case speedtest.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
+	case speedtest.FieldClockOffsetMs:
+		return m.OldClockOffsetMs(ctx)
+	case speedtest.FieldClockCorrected:
+		return m.OldClockCorrected(ctx)
+	case speedtest.FieldCampaignID:
+		return m.OldCampaignID(ctx)
+	case speedtest.FieldQuarantined:
+		return m.OldQuarantined(ctx)
 	}
-	return nil, fmt.Errorf("unknown IperfTest field %s", name)
+	return nil, fmt.Errorf("unknown SpeedTest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IperfTestMutation) SetField(name string, value ent.Value) error {
+func (m *SpeedTestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case iperftest.FieldTimestamp:
+	case speedtest.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTimestamp(v)
 		return nil
-	case iperftest.FieldSentMbps:
+	case speedtest.FieldDownloadMbps:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentMbps(v)
+		m.SetDownloadMbps(v)
 		return nil
-	case iperftest.FieldReceivedMbps:
+	case speedtest.FieldUploadMbps:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReceivedMbps(v)
+		m.SetUploadMbps(v)
 		return nil
-	case iperftest.FieldRetransmits:
+	case speedtest.FieldPingMs:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRetransmits(v)
+		m.SetPingMs(v)
 		return nil
-	case iperftest.FieldMeanRttMs:
+	case speedtest.FieldJitterMs:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMeanRttMs(v)
+		m.SetJitterMs(v)
 		return nil
-	case iperftest.FieldDurationSeconds:
-		v, ok := value.(int)
+	case speedtest.FieldServerName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDurationSeconds(v)
+		m.SetServerName(v)
 		return nil
-	case iperftest.FieldProtocol:
+	case speedtest.FieldServerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServerID(v)
+		return nil
+	case speedtest.FieldIsp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsp(v)
+		return nil
+	case speedtest.FieldExternalIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalIP(v)
+		return nil
+	case speedtest.FieldResultURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProtocol(v)
+		m.SetResultURL(v)
+		return nil
+	case speedtest.FieldDaemonID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaemonID(v)
+		return nil
+	case speedtest.FieldSubmissionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmissionID(v)
+		return nil
+	case speedtest.FieldTrigger:
+		v, ok := value.(speedtest.Trigger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case speedtest.FieldReceivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedAt(v)
+		return nil
+	case speedtest.FieldClockOffsetMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClockOffsetMs(v)
 		return nil
-	case iperftest.FieldSuccess:
+	case speedtest.FieldClockCorrected:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuccess(v)
+		m.SetClockCorrected(v)
 		return nil
-	case iperftest.FieldErrorMessage:
-		v, ok := value.(string)
+	case speedtest.FieldCampaignID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetCampaignID(v)
 		return nil
-	case iperftest.FieldDaemonID:
-		v, ok := value.(string)
+	case speedtest.FieldQuarantined:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDaemonID(v)
+		m.SetQuarantined(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IperfTest field %s", name)
+	return fmt.Errorf("unknown SpeedTest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IperfTestMutation) AddedFields() []string {
+func (m *SpeedTestMutation) AddedFields() []string {
 	var fields []string
-	if m.addsent_mbps != nil {
-		fields = append(fields, iperftest.FieldSentMbps)
+	if m.adddownload_mbps != nil {
+		fields = append(fields, speedtest.FieldDownloadMbps)
 	}
-	if m.addreceived_mbps != nil {
-		fields = append(fields, iperftest.FieldReceivedMbps)
+	if m.addupload_mbps != nil {
+		fields = append(fields, speedtest.FieldUploadMbps)
 	}
-	if m.addretransmits != nil {
-		fields = append(fields, iperftest.FieldRetransmits)
+	if m.addping_ms != nil {
+		fields = append(fields, speedtest.FieldPingMs)
 	}
-	if m.addmean_rtt_ms != nil {
-		fields = append(fields, iperftest.FieldMeanRttMs)
+	if m.addjitter_ms != nil {
+		fields = append(fields, speedtest.FieldJitterMs)
 	}
-	if m.addduration_seconds != nil {
-		fields = append(fields, iperftest.FieldDurationSeconds)
+	if m.addclock_offset_ms != nil {
+		fields = append(fields, speedtest.FieldClockOffsetMs)
+	}
+	if m.addcampaign_id != nil {
+		fields = append(fields, speedtest.FieldCampaignID)
 	}
 	return fields
 }
@@ -1688,18 +11760,20 @@ func (m *IperfTestMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IperfTestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SpeedTestMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case iperftest.FieldSentMbps:
-		return m.AddedSentMbps()
-	case iperftest.FieldReceivedMbps:
-		return m.AddedReceivedMbps()
-	case iperftest.FieldRetransmits:
-		return m.AddedRetransmits()
-	case iperftest.FieldMeanRttMs:
-		return m.AddedMeanRttMs()
-	case iperftest.FieldDurationSeconds:
-		return m.AddedDurationSeconds()
+	case speedtest.FieldDownloadMbps:
+		return m.AddedDownloadMbps()
+	case speedtest.FieldUploadMbps:
+		return m.AddedUploadMbps()
+	case speedtest.FieldPingMs:
+		return m.AddedPingMs()
+	case speedtest.FieldJitterMs:
+		return m.AddedJitterMs()
+	case speedtest.FieldClockOffsetMs:
+		return m.AddedClockOffsetMs()
+	case speedtest.FieldCampaignID:
+		return m.AddedCampaignID()
 	}
 	return nil, false
 }
@@ -1707,243 +11781,292 @@ func (m *IperfTestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IperfTestMutation) AddField(name string, value ent.Value) error {
+func (m *SpeedTestMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case iperftest.FieldSentMbps:
+	case speedtest.FieldDownloadMbps:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSentMbps(v)
+		m.AddDownloadMbps(v)
 		return nil
-	case iperftest.FieldReceivedMbps:
+	case speedtest.FieldUploadMbps:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddReceivedMbps(v)
+		m.AddUploadMbps(v)
 		return nil
-	case iperftest.FieldRetransmits:
+	case speedtest.FieldPingMs:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRetransmits(v)
+		m.AddPingMs(v)
 		return nil
-	case iperftest.FieldMeanRttMs:
+	case speedtest.FieldJitterMs:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMeanRttMs(v)
+		m.AddJitterMs(v)
 		return nil
-	case iperftest.FieldDurationSeconds:
+	case speedtest.FieldClockOffsetMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClockOffsetMs(v)
+		return nil
+	case speedtest.FieldCampaignID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDurationSeconds(v)
+		m.AddCampaignID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown IperfTest numeric field %s", name)
+	return fmt.Errorf("unknown SpeedTest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IperfTestMutation) ClearedFields() []string {
+func (m *SpeedTestMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(iperftest.FieldRetransmits) {
-		fields = append(fields, iperftest.FieldRetransmits)
+	if m.FieldCleared(speedtest.FieldJitterMs) {
+		fields = append(fields, speedtest.FieldJitterMs)
 	}
-	if m.FieldCleared(iperftest.FieldMeanRttMs) {
-		fields = append(fields, iperftest.FieldMeanRttMs)
+	if m.FieldCleared(speedtest.FieldServerName) {
+		fields = append(fields, speedtest.FieldServerName)
 	}
-	if m.FieldCleared(iperftest.FieldErrorMessage) {
-		fields = append(fields, iperftest.FieldErrorMessage)
+	if m.FieldCleared(speedtest.FieldServerID) {
+		fields = append(fields, speedtest.FieldServerID)
 	}
-	if m.FieldCleared(iperftest.FieldDaemonID) {
-		fields = append(fields, iperftest.FieldDaemonID)
+	if m.FieldCleared(speedtest.FieldIsp) {
+		fields = append(fields, speedtest.FieldIsp)
+	}
+	if m.FieldCleared(speedtest.FieldExternalIP) {
+		fields = append(fields, speedtest.FieldExternalIP)
+	}
+	if m.FieldCleared(speedtest.FieldResultURL) {
+		fields = append(fields, speedtest.FieldResultURL)
+	}
+	if m.FieldCleared(speedtest.FieldDaemonID) {
+		fields = append(fields, speedtest.FieldDaemonID)
+	}
+	if m.FieldCleared(speedtest.FieldSubmissionID) {
+		fields = append(fields, speedtest.FieldSubmissionID)
+	}
+	if m.FieldCleared(speedtest.FieldReceivedAt) {
+		fields = append(fields, speedtest.FieldReceivedAt)
+	}
+	if m.FieldCleared(speedtest.FieldClockOffsetMs) {
+		fields = append(fields, speedtest.FieldClockOffsetMs)
+	}
+	if m.FieldCleared(speedtest.FieldCampaignID) {
+		fields = append(fields, speedtest.FieldCampaignID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IperfTestMutation) FieldCleared(name string) bool {
+func (m *SpeedTestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IperfTestMutation) ClearField(name string) error {
+func (m *SpeedTestMutation) ClearField(name string) error {
 	switch name {
-	case iperftest.FieldRetransmits:
-		m.ClearRetransmits()
+	case speedtest.FieldJitterMs:
+		m.ClearJitterMs()
 		return nil
-	case iperftest.FieldMeanRttMs:
-		m.ClearMeanRttMs()
+	case speedtest.FieldServerName:
+		m.ClearServerName()
 		return nil
-	case iperftest.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case speedtest.FieldServerID:
+		m.ClearServerID()
 		return nil
-	case iperftest.FieldDaemonID:
+	case speedtest.FieldIsp:
+		m.ClearIsp()
+		return nil
+	case speedtest.FieldExternalIP:
+		m.ClearExternalIP()
+		return nil
+	case speedtest.FieldResultURL:
+		m.ClearResultURL()
+		return nil
+	case speedtest.FieldDaemonID:
 		m.ClearDaemonID()
 		return nil
+	case speedtest.FieldSubmissionID:
+		m.ClearSubmissionID()
+		return nil
+	case speedtest.FieldReceivedAt:
+		m.ClearReceivedAt()
+		return nil
+	case speedtest.FieldClockOffsetMs:
+		m.ClearClockOffsetMs()
+		return nil
+	case speedtest.FieldCampaignID:
+		m.ClearCampaignID()
+		return nil
 	}
-	return fmt.Errorf("unknown IperfTest nullable field %s", name)
+	return fmt.Errorf("unknown SpeedTest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IperfTestMutation) ResetField(name string) error {
+func (m *SpeedTestMutation) ResetField(name string) error {
 	switch name {
-	case iperftest.FieldTimestamp:
+	case speedtest.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
-	case iperftest.FieldSentMbps:
-		m.ResetSentMbps()
+	case speedtest.FieldDownloadMbps:
+		m.ResetDownloadMbps()
 		return nil
-	case iperftest.FieldReceivedMbps:
-		m.ResetReceivedMbps()
+	case speedtest.FieldUploadMbps:
+		m.ResetUploadMbps()
 		return nil
-	case iperftest.FieldRetransmits:
-		m.ResetRetransmits()
+	case speedtest.FieldPingMs:
+		m.ResetPingMs()
 		return nil
-	case iperftest.FieldMeanRttMs:
-		m.ResetMeanRttMs()
+	case speedtest.FieldJitterMs:
+		m.ResetJitterMs()
 		return nil
-	case iperftest.FieldDurationSeconds:
-		m.ResetDurationSeconds()
+	case speedtest.FieldServerName:
+		m.ResetServerName()
 		return nil
-	case iperftest.FieldProtocol:
-		m.ResetProtocol()
+	case speedtest.FieldServerID:
+		m.ResetServerID()
 		return nil
-	case iperftest.FieldSuccess:
-		m.ResetSuccess()
+	case speedtest.FieldIsp:
+		m.ResetIsp()
 		return nil
-	case iperftest.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case speedtest.FieldExternalIP:
+		m.ResetExternalIP()
 		return nil
-	case iperftest.FieldDaemonID:
+	case speedtest.FieldResultURL:
+		m.ResetResultURL()
+		return nil
+	case speedtest.FieldDaemonID:
 		m.ResetDaemonID()
 		return nil
+	case speedtest.FieldSubmissionID:
+		m.ResetSubmissionID()
+		return nil
+	case speedtest.FieldTrigger:
+		m.ResetTrigger()
+		return nil
+	case speedtest.FieldReceivedAt:
+		m.ResetReceivedAt()
+		return nil
+	case speedtest.FieldClockOffsetMs:
+		m.ResetClockOffsetMs()
+		return nil
+	case speedtest.FieldClockCorrected:
+		m.ResetClockCorrected()
+		return nil
+	case speedtest.FieldCampaignID:
+		m.ResetCampaignID()
+		return nil
+	case speedtest.FieldQuarantined:
+		m.ResetQuarantined()
+		return nil
 	}
-	return fmt.Errorf("unknown IperfTest field %s", name)
+	return fmt.Errorf("unknown SpeedTest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IperfTestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.host != nil {
-		edges = append(edges, iperftest.EdgeHost)
-	}
+func (m *SpeedTestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IperfTestMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case iperftest.EdgeHost:
-		if id := m.host; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SpeedTestMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IperfTestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SpeedTestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IperfTestMutation) RemovedIDs(name string) []ent.Value {
+func (m *SpeedTestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IperfTestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedhost {
-		edges = append(edges, iperftest.EdgeHost)
-	}
+func (m *SpeedTestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IperfTestMutation) EdgeCleared(name string) bool {
-	switch name {
-	case iperftest.EdgeHost:
-		return m.clearedhost
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *IperfTestMutation) ClearEdge(name string) error {
-	switch name {
-	case iperftest.EdgeHost:
-		m.ClearHost()
-		return nil
-	}
-	return fmt.Errorf("unknown IperfTest unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *IperfTestMutation) ResetEdge(name string) error {
-	switch name {
-	case iperftest.EdgeHost:
-		m.ResetHost()
-		return nil
-	}
-	return fmt.Errorf("unknown IperfTest edge %s", name)
-}
-
-// SpeedTestMutation represents an operation that mutates the SpeedTest nodes in the graph.
-type SpeedTestMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	timestamp        *time.Time
-	download_mbps    *float64
-	adddownload_mbps *float64
-	upload_mbps      *float64
-	addupload_mbps   *float64
-	ping_ms          *float64
-	addping_ms       *float64
-	jitter_ms        *float64
-	addjitter_ms     *float64
-	server_name      *string
-	server_id        *string
-	isp              *string
-	external_ip      *string
-	result_url       *string
-	daemon_id        *string
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*SpeedTest, error)
-	predicates       []predicate.SpeedTest
+func (m *SpeedTestMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-var _ ent.Mutation = (*SpeedTestMutation)(nil)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SpeedTestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SpeedTest unique edge %s", name)
+}
 
-// speedtestOption allows management of the mutation configuration using functional options.
-type speedtestOption func(*SpeedTestMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SpeedTestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SpeedTest edge %s", name)
+}
 
-// newSpeedTestMutation creates new mutation for the SpeedTest entity.
-func newSpeedTestMutation(c config, op Op, opts ...speedtestOption) *SpeedTestMutation {
-	m := &SpeedTestMutation{
+// TestRunMutation represents an operation that mutates the TestRun nodes in the graph.
+type TestRunMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	daemon_id         *string
+	submission_id     *uuid.UUID
+	_type             *testrun.Type
+	trigger           *testrun.Trigger
+	outcome           *testrun.Outcome
+	started_at        *time.Time
+	finished_at       *time.Time
+	error_message     *string
+	target_daemon_id  *string
+	clearedFields     map[string]struct{}
+	host              *int
+	clearedhost       bool
+	speed_test        *int
+	clearedspeed_test bool
+	iperf_test        *int
+	clearediperf_test bool
+	mesh_test         *int
+	clearedmesh_test  bool
+	done              bool
+	oldValue          func(context.Context) (*TestRun, error)
+	predicates        []predicate.TestRun
+}
+
+var _ ent.Mutation = (*TestRunMutation)(nil)
+
+// testrunOption allows management of the mutation configuration using functional options.
+type testrunOption func(*TestRunMutation)
+
+// newTestRunMutation creates new mutation for the TestRun entity.
+func newTestRunMutation(c config, op Op, opts ...testrunOption) *TestRunMutation {
+	m := &TestRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSpeedTest,
+		typ:           TypeTestRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1952,20 +12075,20 @@ func newSpeedTestMutation(c config, op Op, opts ...speedtestOption) *SpeedTestMu
 	return m
 }
 
-// withSpeedTestID sets the ID field of the mutation.
-func withSpeedTestID(id int) speedtestOption {
-	return func(m *SpeedTestMutation) {
+// withTestRunID sets the ID field of the mutation.
+func withTestRunID(id int) testrunOption {
+	return func(m *TestRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SpeedTest
+			value *TestRun
 		)
-		m.oldValue = func(ctx context.Context) (*SpeedTest, error) {
+		m.oldValue = func(ctx context.Context) (*TestRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SpeedTest.Get(ctx, id)
+					value, err = m.Client().TestRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1974,10 +12097,10 @@ func withSpeedTestID(id int) speedtestOption {
 	}
 }
 
-// withSpeedTest sets the old SpeedTest of the mutation.
-func withSpeedTest(node *SpeedTest) speedtestOption {
-	return func(m *SpeedTestMutation) {
-		m.oldValue = func(context.Context) (*SpeedTest, error) {
+// withTestRun sets the old TestRun of the mutation.
+func withTestRun(node *TestRun) testrunOption {
+	return func(m *TestRunMutation) {
+		m.oldValue = func(context.Context) (*TestRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1986,7 +12109,7 @@ func withSpeedTest(node *SpeedTest) speedtestOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SpeedTestMutation) Client() *Client {
+func (m TestRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1994,7 +12117,7 @@ func (m SpeedTestMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SpeedTestMutation) Tx() (*Tx, error) {
+func (m TestRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2005,7 +12128,7 @@ func (m SpeedTestMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SpeedTestMutation) ID() (id int, exists bool) {
+func (m *TestRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2016,7 +12139,7 @@ func (m *SpeedTestMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SpeedTestMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TestRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2025,589 +12148,688 @@ func (m *SpeedTestMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SpeedTest.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TestRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *SpeedTestMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetDaemonID sets the "daemon_id" field.
+func (m *TestRunMutation) SetDaemonID(s string) {
+	m.daemon_id = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *SpeedTestMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// DaemonID returns the value of the "daemon_id" field in the mutation.
+func (m *TestRunMutation) DaemonID() (r string, exists bool) {
+	v := m.daemon_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldDaemonID returns the old "daemon_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *TestRunMutation) OldDaemonID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldDaemonID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldDaemonID: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.DaemonID, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *SpeedTestMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetDaemonID resets all changes to the "daemon_id" field.
+func (m *TestRunMutation) ResetDaemonID() {
+	m.daemon_id = nil
 }
 
-// SetDownloadMbps sets the "download_mbps" field.
-func (m *SpeedTestMutation) SetDownloadMbps(f float64) {
-	m.download_mbps = &f
-	m.adddownload_mbps = nil
+// SetSubmissionID sets the "submission_id" field.
+func (m *TestRunMutation) SetSubmissionID(u uuid.UUID) {
+	m.submission_id = &u
 }
 
-// DownloadMbps returns the value of the "download_mbps" field in the mutation.
-func (m *SpeedTestMutation) DownloadMbps() (r float64, exists bool) {
-	v := m.download_mbps
+// SubmissionID returns the value of the "submission_id" field in the mutation.
+func (m *TestRunMutation) SubmissionID() (r uuid.UUID, exists bool) {
+	v := m.submission_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDownloadMbps returns the old "download_mbps" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldSubmissionID returns the old "submission_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldDownloadMbps(ctx context.Context) (v float64, err error) {
+func (m *TestRunMutation) OldSubmissionID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloadMbps is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubmissionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloadMbps requires an ID field in the mutation")
+		return v, errors.New("OldSubmissionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloadMbps: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubmissionID: %w", err)
 	}
-	return oldValue.DownloadMbps, nil
+	return oldValue.SubmissionID, nil
 }
 
-// AddDownloadMbps adds f to the "download_mbps" field.
-func (m *SpeedTestMutation) AddDownloadMbps(f float64) {
-	if m.adddownload_mbps != nil {
-		*m.adddownload_mbps += f
-	} else {
-		m.adddownload_mbps = &f
-	}
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (m *TestRunMutation) ClearSubmissionID() {
+	m.submission_id = nil
+	m.clearedFields[testrun.FieldSubmissionID] = struct{}{}
 }
 
-// AddedDownloadMbps returns the value that was added to the "download_mbps" field in this mutation.
-func (m *SpeedTestMutation) AddedDownloadMbps() (r float64, exists bool) {
-	v := m.adddownload_mbps
-	if v == nil {
-		return
-	}
-	return *v, true
+// SubmissionIDCleared returns if the "submission_id" field was cleared in this mutation.
+func (m *TestRunMutation) SubmissionIDCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldSubmissionID]
+	return ok
 }
 
-// ResetDownloadMbps resets all changes to the "download_mbps" field.
-func (m *SpeedTestMutation) ResetDownloadMbps() {
-	m.download_mbps = nil
-	m.adddownload_mbps = nil
+// ResetSubmissionID resets all changes to the "submission_id" field.
+func (m *TestRunMutation) ResetSubmissionID() {
+	m.submission_id = nil
+	delete(m.clearedFields, testrun.FieldSubmissionID)
 }
 
-// SetUploadMbps sets the "upload_mbps" field.
-func (m *SpeedTestMutation) SetUploadMbps(f float64) {
-	m.upload_mbps = &f
-	m.addupload_mbps = nil
+// SetType sets the "type" field.
+func (m *TestRunMutation) SetType(t testrun.Type) {
+	m._type = &t
 }
 
-// UploadMbps returns the value of the "upload_mbps" field in the mutation.
-func (m *SpeedTestMutation) UploadMbps() (r float64, exists bool) {
-	v := m.upload_mbps
+// GetType returns the value of the "type" field in the mutation.
+func (m *TestRunMutation) GetType() (r testrun.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUploadMbps returns the old "upload_mbps" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldUploadMbps(ctx context.Context) (v float64, err error) {
+func (m *TestRunMutation) OldType(ctx context.Context) (v testrun.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadMbps is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadMbps requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadMbps: %w", err)
-	}
-	return oldValue.UploadMbps, nil
-}
-
-// AddUploadMbps adds f to the "upload_mbps" field.
-func (m *SpeedTestMutation) AddUploadMbps(f float64) {
-	if m.addupload_mbps != nil {
-		*m.addupload_mbps += f
-	} else {
-		m.addupload_mbps = &f
-	}
-}
-
-// AddedUploadMbps returns the value that was added to the "upload_mbps" field in this mutation.
-func (m *SpeedTestMutation) AddedUploadMbps() (r float64, exists bool) {
-	v := m.addupload_mbps
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return *v, true
+	return oldValue.Type, nil
 }
 
-// ResetUploadMbps resets all changes to the "upload_mbps" field.
-func (m *SpeedTestMutation) ResetUploadMbps() {
-	m.upload_mbps = nil
-	m.addupload_mbps = nil
+// ResetType resets all changes to the "type" field.
+func (m *TestRunMutation) ResetType() {
+	m._type = nil
 }
 
-// SetPingMs sets the "ping_ms" field.
-func (m *SpeedTestMutation) SetPingMs(f float64) {
-	m.ping_ms = &f
-	m.addping_ms = nil
+// SetTrigger sets the "trigger" field.
+func (m *TestRunMutation) SetTrigger(t testrun.Trigger) {
+	m.trigger = &t
 }
 
-// PingMs returns the value of the "ping_ms" field in the mutation.
-func (m *SpeedTestMutation) PingMs() (r float64, exists bool) {
-	v := m.ping_ms
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *TestRunMutation) Trigger() (r testrun.Trigger, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPingMs returns the old "ping_ms" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldPingMs(ctx context.Context) (v float64, err error) {
+func (m *TestRunMutation) OldTrigger(ctx context.Context) (v testrun.Trigger, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPingMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPingMs requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPingMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.PingMs, nil
+	return oldValue.Trigger, nil
 }
 
-// AddPingMs adds f to the "ping_ms" field.
-func (m *SpeedTestMutation) AddPingMs(f float64) {
-	if m.addping_ms != nil {
-		*m.addping_ms += f
-	} else {
-		m.addping_ms = &f
-	}
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *TestRunMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// AddedPingMs returns the value that was added to the "ping_ms" field in this mutation.
-func (m *SpeedTestMutation) AddedPingMs() (r float64, exists bool) {
-	v := m.addping_ms
+// SetOutcome sets the "outcome" field.
+func (m *TestRunMutation) SetOutcome(t testrun.Outcome) {
+	m.outcome = &t
+}
+
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *TestRunMutation) Outcome() (r testrun.Outcome, exists bool) {
+	v := m.outcome
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPingMs resets all changes to the "ping_ms" field.
-func (m *SpeedTestMutation) ResetPingMs() {
-	m.ping_ms = nil
-	m.addping_ms = nil
+// OldOutcome returns the old "outcome" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TestRunMutation) OldOutcome(ctx context.Context) (v testrun.Outcome, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
+	}
+	return oldValue.Outcome, nil
 }
 
-// SetJitterMs sets the "jitter_ms" field.
-func (m *SpeedTestMutation) SetJitterMs(f float64) {
-	m.jitter_ms = &f
-	m.addjitter_ms = nil
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *TestRunMutation) ResetOutcome() {
+	m.outcome = nil
 }
 
-// JitterMs returns the value of the "jitter_ms" field in the mutation.
-func (m *SpeedTestMutation) JitterMs() (r float64, exists bool) {
-	v := m.jitter_ms
+// SetStartedAt sets the "started_at" field.
+func (m *TestRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *TestRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJitterMs returns the old "jitter_ms" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldJitterMs(ctx context.Context) (v float64, err error) {
+func (m *TestRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJitterMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJitterMs requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJitterMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.JitterMs, nil
+	return oldValue.StartedAt, nil
 }
 
-// AddJitterMs adds f to the "jitter_ms" field.
-func (m *SpeedTestMutation) AddJitterMs(f float64) {
-	if m.addjitter_ms != nil {
-		*m.addjitter_ms += f
-	} else {
-		m.addjitter_ms = &f
-	}
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *TestRunMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// AddedJitterMs returns the value that was added to the "jitter_ms" field in this mutation.
-func (m *SpeedTestMutation) AddedJitterMs() (r float64, exists bool) {
-	v := m.addjitter_ms
+// SetFinishedAt sets the "finished_at" field.
+func (m *TestRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *TestRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearJitterMs clears the value of the "jitter_ms" field.
-func (m *SpeedTestMutation) ClearJitterMs() {
-	m.jitter_ms = nil
-	m.addjitter_ms = nil
-	m.clearedFields[speedtest.FieldJitterMs] = struct{}{}
-}
-
-// JitterMsCleared returns if the "jitter_ms" field was cleared in this mutation.
-func (m *SpeedTestMutation) JitterMsCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldJitterMs]
-	return ok
+// OldFinishedAt returns the old "finished_at" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TestRunMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
 }
 
-// ResetJitterMs resets all changes to the "jitter_ms" field.
-func (m *SpeedTestMutation) ResetJitterMs() {
-	m.jitter_ms = nil
-	m.addjitter_ms = nil
-	delete(m.clearedFields, speedtest.FieldJitterMs)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *TestRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
 }
 
-// SetServerName sets the "server_name" field.
-func (m *SpeedTestMutation) SetServerName(s string) {
-	m.server_name = &s
+// SetErrorMessage sets the "error_message" field.
+func (m *TestRunMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// ServerName returns the value of the "server_name" field in the mutation.
-func (m *SpeedTestMutation) ServerName() (r string, exists bool) {
-	v := m.server_name
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *TestRunMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServerName returns the old "server_name" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldServerName(ctx context.Context) (v string, err error) {
+func (m *TestRunMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServerName is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServerName requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServerName: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.ServerName, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearServerName clears the value of the "server_name" field.
-func (m *SpeedTestMutation) ClearServerName() {
-	m.server_name = nil
-	m.clearedFields[speedtest.FieldServerName] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *TestRunMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[testrun.FieldErrorMessage] = struct{}{}
 }
 
-// ServerNameCleared returns if the "server_name" field was cleared in this mutation.
-func (m *SpeedTestMutation) ServerNameCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldServerName]
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *TestRunMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldErrorMessage]
 	return ok
 }
 
-// ResetServerName resets all changes to the "server_name" field.
-func (m *SpeedTestMutation) ResetServerName() {
-	m.server_name = nil
-	delete(m.clearedFields, speedtest.FieldServerName)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *TestRunMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, testrun.FieldErrorMessage)
 }
 
-// SetServerID sets the "server_id" field.
-func (m *SpeedTestMutation) SetServerID(s string) {
-	m.server_id = &s
+// SetHostID sets the "host_id" field.
+func (m *TestRunMutation) SetHostID(i int) {
+	m.host = &i
 }
 
-// ServerID returns the value of the "server_id" field in the mutation.
-func (m *SpeedTestMutation) ServerID() (r string, exists bool) {
-	v := m.server_id
+// HostID returns the value of the "host_id" field in the mutation.
+func (m *TestRunMutation) HostID() (r int, exists bool) {
+	v := m.host
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldServerID returns the old "server_id" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldHostID returns the old "host_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldServerID(ctx context.Context) (v string, err error) {
+func (m *TestRunMutation) OldHostID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldServerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHostID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldServerID requires an ID field in the mutation")
+		return v, errors.New("OldHostID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldServerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHostID: %w", err)
 	}
-	return oldValue.ServerID, nil
+	return oldValue.HostID, nil
 }
 
-// ClearServerID clears the value of the "server_id" field.
-func (m *SpeedTestMutation) ClearServerID() {
-	m.server_id = nil
-	m.clearedFields[speedtest.FieldServerID] = struct{}{}
+// ClearHostID clears the value of the "host_id" field.
+func (m *TestRunMutation) ClearHostID() {
+	m.host = nil
+	m.clearedFields[testrun.FieldHostID] = struct{}{}
 }
 
-// ServerIDCleared returns if the "server_id" field was cleared in this mutation.
-func (m *SpeedTestMutation) ServerIDCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldServerID]
+// HostIDCleared returns if the "host_id" field was cleared in this mutation.
+func (m *TestRunMutation) HostIDCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldHostID]
 	return ok
 }
 
-// ResetServerID resets all changes to the "server_id" field.
-func (m *SpeedTestMutation) ResetServerID() {
-	m.server_id = nil
-	delete(m.clearedFields, speedtest.FieldServerID)
+// ResetHostID resets all changes to the "host_id" field.
+func (m *TestRunMutation) ResetHostID() {
+	m.host = nil
+	delete(m.clearedFields, testrun.FieldHostID)
 }
 
-// SetIsp sets the "isp" field.
-func (m *SpeedTestMutation) SetIsp(s string) {
-	m.isp = &s
+// SetSpeedTestID sets the "speed_test_id" field.
+func (m *TestRunMutation) SetSpeedTestID(i int) {
+	m.speed_test = &i
 }
 
-// Isp returns the value of the "isp" field in the mutation.
-func (m *SpeedTestMutation) Isp() (r string, exists bool) {
-	v := m.isp
+// SpeedTestID returns the value of the "speed_test_id" field in the mutation.
+func (m *TestRunMutation) SpeedTestID() (r int, exists bool) {
+	v := m.speed_test
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsp returns the old "isp" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldSpeedTestID returns the old "speed_test_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldIsp(ctx context.Context) (v string, err error) {
+func (m *TestRunMutation) OldSpeedTestID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsp is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpeedTestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsp requires an ID field in the mutation")
+		return v, errors.New("OldSpeedTestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsp: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpeedTestID: %w", err)
 	}
-	return oldValue.Isp, nil
+	return oldValue.SpeedTestID, nil
 }
 
-// ClearIsp clears the value of the "isp" field.
-func (m *SpeedTestMutation) ClearIsp() {
-	m.isp = nil
-	m.clearedFields[speedtest.FieldIsp] = struct{}{}
+// ClearSpeedTestID clears the value of the "speed_test_id" field.
+func (m *TestRunMutation) ClearSpeedTestID() {
+	m.speed_test = nil
+	m.clearedFields[testrun.FieldSpeedTestID] = struct{}{}
 }
 
-// IspCleared returns if the "isp" field was cleared in this mutation.
-func (m *SpeedTestMutation) IspCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldIsp]
+// SpeedTestIDCleared returns if the "speed_test_id" field was cleared in this mutation.
+func (m *TestRunMutation) SpeedTestIDCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldSpeedTestID]
 	return ok
 }
 
-// ResetIsp resets all changes to the "isp" field.
-func (m *SpeedTestMutation) ResetIsp() {
-	m.isp = nil
-	delete(m.clearedFields, speedtest.FieldIsp)
+// ResetSpeedTestID resets all changes to the "speed_test_id" field.
+func (m *TestRunMutation) ResetSpeedTestID() {
+	m.speed_test = nil
+	delete(m.clearedFields, testrun.FieldSpeedTestID)
 }
 
-// SetExternalIP sets the "external_ip" field.
-func (m *SpeedTestMutation) SetExternalIP(s string) {
-	m.external_ip = &s
+// SetIperfTestID sets the "iperf_test_id" field.
+func (m *TestRunMutation) SetIperfTestID(i int) {
+	m.iperf_test = &i
 }
 
-// ExternalIP returns the value of the "external_ip" field in the mutation.
-func (m *SpeedTestMutation) ExternalIP() (r string, exists bool) {
-	v := m.external_ip
+// IperfTestID returns the value of the "iperf_test_id" field in the mutation.
+func (m *TestRunMutation) IperfTestID() (r int, exists bool) {
+	v := m.iperf_test
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExternalIP returns the old "external_ip" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldIperfTestID returns the old "iperf_test_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldExternalIP(ctx context.Context) (v string, err error) {
+func (m *TestRunMutation) OldIperfTestID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExternalIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldIperfTestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExternalIP requires an ID field in the mutation")
+		return v, errors.New("OldIperfTestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExternalIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldIperfTestID: %w", err)
 	}
-	return oldValue.ExternalIP, nil
+	return oldValue.IperfTestID, nil
 }
 
-// ClearExternalIP clears the value of the "external_ip" field.
-func (m *SpeedTestMutation) ClearExternalIP() {
-	m.external_ip = nil
-	m.clearedFields[speedtest.FieldExternalIP] = struct{}{}
+// ClearIperfTestID clears the value of the "iperf_test_id" field.
+func (m *TestRunMutation) ClearIperfTestID() {
+	m.iperf_test = nil
+	m.clearedFields[testrun.FieldIperfTestID] = struct{}{}
 }
 
-// ExternalIPCleared returns if the "external_ip" field was cleared in this mutation.
-func (m *SpeedTestMutation) ExternalIPCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldExternalIP]
+// IperfTestIDCleared returns if the "iperf_test_id" field was cleared in this mutation.
+func (m *TestRunMutation) IperfTestIDCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldIperfTestID]
 	return ok
 }
 
-// ResetExternalIP resets all changes to the "external_ip" field.
-func (m *SpeedTestMutation) ResetExternalIP() {
-	m.external_ip = nil
-	delete(m.clearedFields, speedtest.FieldExternalIP)
+// ResetIperfTestID resets all changes to the "iperf_test_id" field.
+func (m *TestRunMutation) ResetIperfTestID() {
+	m.iperf_test = nil
+	delete(m.clearedFields, testrun.FieldIperfTestID)
 }
 
-// SetResultURL sets the "result_url" field.
-func (m *SpeedTestMutation) SetResultURL(s string) {
-	m.result_url = &s
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (m *TestRunMutation) SetTargetDaemonID(s string) {
+	m.target_daemon_id = &s
 }
 
-// ResultURL returns the value of the "result_url" field in the mutation.
-func (m *SpeedTestMutation) ResultURL() (r string, exists bool) {
-	v := m.result_url
+// TargetDaemonID returns the value of the "target_daemon_id" field in the mutation.
+func (m *TestRunMutation) TargetDaemonID() (r string, exists bool) {
+	v := m.target_daemon_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResultURL returns the old "result_url" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetDaemonID returns the old "target_daemon_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldResultURL(ctx context.Context) (v string, err error) {
+func (m *TestRunMutation) OldTargetDaemonID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResultURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetDaemonID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResultURL requires an ID field in the mutation")
+		return v, errors.New("OldTargetDaemonID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResultURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetDaemonID: %w", err)
 	}
-	return oldValue.ResultURL, nil
+	return oldValue.TargetDaemonID, nil
 }
 
-// ClearResultURL clears the value of the "result_url" field.
-func (m *SpeedTestMutation) ClearResultURL() {
-	m.result_url = nil
-	m.clearedFields[speedtest.FieldResultURL] = struct{}{}
+// ClearTargetDaemonID clears the value of the "target_daemon_id" field.
+func (m *TestRunMutation) ClearTargetDaemonID() {
+	m.target_daemon_id = nil
+	m.clearedFields[testrun.FieldTargetDaemonID] = struct{}{}
 }
 
-// ResultURLCleared returns if the "result_url" field was cleared in this mutation.
-func (m *SpeedTestMutation) ResultURLCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldResultURL]
+// TargetDaemonIDCleared returns if the "target_daemon_id" field was cleared in this mutation.
+func (m *TestRunMutation) TargetDaemonIDCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldTargetDaemonID]
 	return ok
 }
 
-// ResetResultURL resets all changes to the "result_url" field.
-func (m *SpeedTestMutation) ResetResultURL() {
-	m.result_url = nil
-	delete(m.clearedFields, speedtest.FieldResultURL)
+// ResetTargetDaemonID resets all changes to the "target_daemon_id" field.
+func (m *TestRunMutation) ResetTargetDaemonID() {
+	m.target_daemon_id = nil
+	delete(m.clearedFields, testrun.FieldTargetDaemonID)
 }
 
-// SetDaemonID sets the "daemon_id" field.
-func (m *SpeedTestMutation) SetDaemonID(s string) {
-	m.daemon_id = &s
+// SetMeshTestID sets the "mesh_test_id" field.
+func (m *TestRunMutation) SetMeshTestID(i int) {
+	m.mesh_test = &i
 }
 
-// DaemonID returns the value of the "daemon_id" field in the mutation.
-func (m *SpeedTestMutation) DaemonID() (r string, exists bool) {
-	v := m.daemon_id
+// MeshTestID returns the value of the "mesh_test_id" field in the mutation.
+func (m *TestRunMutation) MeshTestID() (r int, exists bool) {
+	v := m.mesh_test
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDaemonID returns the old "daemon_id" field's value of the SpeedTest entity.
-// If the SpeedTest object wasn't provided to the builder, the object is fetched from the database.
+// OldMeshTestID returns the old "mesh_test_id" field's value of the TestRun entity.
+// If the TestRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SpeedTestMutation) OldDaemonID(ctx context.Context) (v string, err error) {
+func (m *TestRunMutation) OldMeshTestID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDaemonID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMeshTestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDaemonID requires an ID field in the mutation")
+		return v, errors.New("OldMeshTestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDaemonID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMeshTestID: %w", err)
 	}
-	return oldValue.DaemonID, nil
+	return oldValue.MeshTestID, nil
 }
 
-// ClearDaemonID clears the value of the "daemon_id" field.
-func (m *SpeedTestMutation) ClearDaemonID() {
-	m.daemon_id = nil
-	m.clearedFields[speedtest.FieldDaemonID] = struct{}{}
+// ClearMeshTestID clears the value of the "mesh_test_id" field.
+func (m *TestRunMutation) ClearMeshTestID() {
+	m.mesh_test = nil
+	m.clearedFields[testrun.FieldMeshTestID] = struct{}{}
 }
 
-// DaemonIDCleared returns if the "daemon_id" field was cleared in this mutation.
-func (m *SpeedTestMutation) DaemonIDCleared() bool {
-	_, ok := m.clearedFields[speedtest.FieldDaemonID]
+// MeshTestIDCleared returns if the "mesh_test_id" field was cleared in this mutation.
+func (m *TestRunMutation) MeshTestIDCleared() bool {
+	_, ok := m.clearedFields[testrun.FieldMeshTestID]
 	return ok
 }
 
-// ResetDaemonID resets all changes to the "daemon_id" field.
-func (m *SpeedTestMutation) ResetDaemonID() {
-	m.daemon_id = nil
-	delete(m.clearedFields, speedtest.FieldDaemonID)
+// ResetMeshTestID resets all changes to the "mesh_test_id" field.
+func (m *TestRunMutation) ResetMeshTestID() {
+	m.mesh_test = nil
+	delete(m.clearedFields, testrun.FieldMeshTestID)
 }
 
-// Where appends a list predicates to the SpeedTestMutation builder.
-func (m *SpeedTestMutation) Where(ps ...predicate.SpeedTest) {
+// ClearHost clears the "host" edge to the Host entity.
+func (m *TestRunMutation) ClearHost() {
+	m.clearedhost = true
+	m.clearedFields[testrun.FieldHostID] = struct{}{}
+}
+
+// HostCleared reports if the "host" edge to the Host entity was cleared.
+func (m *TestRunMutation) HostCleared() bool {
+	return m.HostIDCleared() || m.clearedhost
+}
+
+// HostIDs returns the "host" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HostID instead. It exists only for internal usage by the builders.
+func (m *TestRunMutation) HostIDs() (ids []int) {
+	if id := m.host; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHost resets all changes to the "host" edge.
+func (m *TestRunMutation) ResetHost() {
+	m.host = nil
+	m.clearedhost = false
+}
+
+// ClearSpeedTest clears the "speed_test" edge to the SpeedTest entity.
+func (m *TestRunMutation) ClearSpeedTest() {
+	m.clearedspeed_test = true
+	m.clearedFields[testrun.FieldSpeedTestID] = struct{}{}
+}
+
+// SpeedTestCleared reports if the "speed_test" edge to the SpeedTest entity was cleared.
+func (m *TestRunMutation) SpeedTestCleared() bool {
+	return m.SpeedTestIDCleared() || m.clearedspeed_test
+}
+
+// SpeedTestIDs returns the "speed_test" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SpeedTestID instead. It exists only for internal usage by the builders.
+func (m *TestRunMutation) SpeedTestIDs() (ids []int) {
+	if id := m.speed_test; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSpeedTest resets all changes to the "speed_test" edge.
+func (m *TestRunMutation) ResetSpeedTest() {
+	m.speed_test = nil
+	m.clearedspeed_test = false
+}
+
+// ClearIperfTest clears the "iperf_test" edge to the IperfTest entity.
+func (m *TestRunMutation) ClearIperfTest() {
+	m.clearediperf_test = true
+	m.clearedFields[testrun.FieldIperfTestID] = struct{}{}
+}
+
+// IperfTestCleared reports if the "iperf_test" edge to the IperfTest entity was cleared.
+func (m *TestRunMutation) IperfTestCleared() bool {
+	return m.IperfTestIDCleared() || m.clearediperf_test
+}
+
+// IperfTestIDs returns the "iperf_test" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IperfTestID instead. It exists only for internal usage by the builders.
+func (m *TestRunMutation) IperfTestIDs() (ids []int) {
+	if id := m.iperf_test; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIperfTest resets all changes to the "iperf_test" edge.
+func (m *TestRunMutation) ResetIperfTest() {
+	m.iperf_test = nil
+	m.clearediperf_test = false
+}
+
+// ClearMeshTest clears the "mesh_test" edge to the MeshTest entity.
+func (m *TestRunMutation) ClearMeshTest() {
+	m.clearedmesh_test = true
+	m.clearedFields[testrun.FieldMeshTestID] = struct{}{}
+}
+
+// MeshTestCleared reports if the "mesh_test" edge to the MeshTest entity was cleared.
+func (m *TestRunMutation) MeshTestCleared() bool {
+	return m.MeshTestIDCleared() || m.clearedmesh_test
+}
+
+// MeshTestIDs returns the "mesh_test" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MeshTestID instead. It exists only for internal usage by the builders.
+func (m *TestRunMutation) MeshTestIDs() (ids []int) {
+	if id := m.mesh_test; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMeshTest resets all changes to the "mesh_test" edge.
+func (m *TestRunMutation) ResetMeshTest() {
+	m.mesh_test = nil
+	m.clearedmesh_test = false
+}
+
+// Where appends a list predicates to the TestRunMutation builder.
+func (m *TestRunMutation) Where(ps ...predicate.TestRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SpeedTestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TestRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SpeedTestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SpeedTest, len(ps))
+func (m *TestRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TestRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2615,88 +12837,98 @@ func (m *SpeedTestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SpeedTestMutation) Op() Op {
+func (m *TestRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SpeedTestMutation) SetOp(op Op) {
+func (m *TestRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SpeedTest).
-func (m *SpeedTestMutation) Type() string {
+// Type returns the node type of this mutation (TestRun).
+func (m *TestRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SpeedTestMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.timestamp != nil {
-		fields = append(fields, speedtest.FieldTimestamp)
-	}
-	if m.download_mbps != nil {
-		fields = append(fields, speedtest.FieldDownloadMbps)
-	}
-	if m.upload_mbps != nil {
-		fields = append(fields, speedtest.FieldUploadMbps)
-	}
-	if m.ping_ms != nil {
-		fields = append(fields, speedtest.FieldPingMs)
+func (m *TestRunMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.daemon_id != nil {
+		fields = append(fields, testrun.FieldDaemonID)
 	}
-	if m.jitter_ms != nil {
-		fields = append(fields, speedtest.FieldJitterMs)
+	if m.submission_id != nil {
+		fields = append(fields, testrun.FieldSubmissionID)
 	}
-	if m.server_name != nil {
-		fields = append(fields, speedtest.FieldServerName)
+	if m._type != nil {
+		fields = append(fields, testrun.FieldType)
 	}
-	if m.server_id != nil {
-		fields = append(fields, speedtest.FieldServerID)
+	if m.trigger != nil {
+		fields = append(fields, testrun.FieldTrigger)
 	}
-	if m.isp != nil {
-		fields = append(fields, speedtest.FieldIsp)
+	if m.outcome != nil {
+		fields = append(fields, testrun.FieldOutcome)
 	}
-	if m.external_ip != nil {
-		fields = append(fields, speedtest.FieldExternalIP)
+	if m.started_at != nil {
+		fields = append(fields, testrun.FieldStartedAt)
 	}
-	if m.result_url != nil {
-		fields = append(fields, speedtest.FieldResultURL)
+	if m.finished_at != nil {
+		fields = append(fields, testrun.FieldFinishedAt)
 	}
-	if m.daemon_id != nil {
-		fields = append(fields, speedtest.FieldDaemonID)
+	if m.error_message != nil {
+		fields = append(fields, testrun.FieldErrorMessage)
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *SpeedTestMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case speedtest.FieldTimestamp:
-		return m.Timestamp()
-	case speedtest.FieldDownloadMbps:
-		return m.DownloadMbps()
-	case speedtest.FieldUploadMbps:
-		return m.UploadMbps()
-	case speedtest.FieldPingMs:
-		return m.PingMs()
-	case speedtest.FieldJitterMs:
-		return m.JitterMs()
-	case speedtest.FieldServerName:
-		return m.ServerName()
-	case speedtest.FieldServerID:
-		return m.ServerID()
-	case speedtest.FieldIsp:
-		return m.Isp()
-	case speedtest.FieldExternalIP:
-		return m.ExternalIP()
-	case speedtest.FieldResultURL:
-		return m.ResultURL()
-	case speedtest.FieldDaemonID:
+	if m.host != nil {
+		fields = append(fields, testrun.FieldHostID)
+	}
+	if m.speed_test != nil {
+		fields = append(fields, testrun.FieldSpeedTestID)
+	}
+	if m.iperf_test != nil {
+		fields = append(fields, testrun.FieldIperfTestID)
+	}
+	if m.target_daemon_id != nil {
+		fields = append(fields, testrun.FieldTargetDaemonID)
+	}
+	if m.mesh_test != nil {
+		fields = append(fields, testrun.FieldMeshTestID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TestRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case testrun.FieldDaemonID:
 		return m.DaemonID()
+	case testrun.FieldSubmissionID:
+		return m.SubmissionID()
+	case testrun.FieldType:
+		return m.GetType()
+	case testrun.FieldTrigger:
+		return m.Trigger()
+	case testrun.FieldOutcome:
+		return m.Outcome()
+	case testrun.FieldStartedAt:
+		return m.StartedAt()
+	case testrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case testrun.FieldErrorMessage:
+		return m.ErrorMessage()
+	case testrun.FieldHostID:
+		return m.HostID()
+	case testrun.FieldSpeedTestID:
+		return m.SpeedTestID()
+	case testrun.FieldIperfTestID:
+		return m.IperfTestID()
+	case testrun.FieldTargetDaemonID:
+		return m.TargetDaemonID()
+	case testrun.FieldMeshTestID:
+		return m.MeshTestID()
 	}
 	return nil, false
 }
@@ -2704,152 +12936,150 @@ func (m *SpeedTestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SpeedTestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TestRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case speedtest.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case speedtest.FieldDownloadMbps:
-		return m.OldDownloadMbps(ctx)
-	case speedtest.FieldUploadMbps:
-		return m.OldUploadMbps(ctx)
-	case speedtest.FieldPingMs:
-		return m.OldPingMs(ctx)
-	case speedtest.FieldJitterMs:
-		return m.OldJitterMs(ctx)
-	case speedtest.FieldServerName:
-		return m.OldServerName(ctx)
-	case speedtest.FieldServerID:
-		return m.OldServerID(ctx)
-	case speedtest.FieldIsp:
-		return m.OldIsp(ctx)
-	case speedtest.FieldExternalIP:
-		return m.OldExternalIP(ctx)
-	case speedtest.FieldResultURL:
-		return m.OldResultURL(ctx)
-	case speedtest.FieldDaemonID:
+	case testrun.FieldDaemonID:
 		return m.OldDaemonID(ctx)
+	case testrun.FieldSubmissionID:
+		return m.OldSubmissionID(ctx)
+	case testrun.FieldType:
+		return m.OldType(ctx)
+	case testrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case testrun.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case testrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case testrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case testrun.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case testrun.FieldHostID:
+		return m.OldHostID(ctx)
+	case testrun.FieldSpeedTestID:
+		return m.OldSpeedTestID(ctx)
+	case testrun.FieldIperfTestID:
+		return m.OldIperfTestID(ctx)
+	case testrun.FieldTargetDaemonID:
+		return m.OldTargetDaemonID(ctx)
+	case testrun.FieldMeshTestID:
+		return m.OldMeshTestID(ctx)
 	}
-	return nil, fmt.Errorf("unknown SpeedTest field %s", name)
+	return nil, fmt.Errorf("unknown TestRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SpeedTestMutation) SetField(name string, value ent.Value) error {
+func (m *TestRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case speedtest.FieldTimestamp:
-		v, ok := value.(time.Time)
+	case testrun.FieldDaemonID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetDaemonID(v)
 		return nil
-	case speedtest.FieldDownloadMbps:
-		v, ok := value.(float64)
+	case testrun.FieldSubmissionID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDownloadMbps(v)
+		m.SetSubmissionID(v)
 		return nil
-	case speedtest.FieldUploadMbps:
-		v, ok := value.(float64)
+	case testrun.FieldType:
+		v, ok := value.(testrun.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUploadMbps(v)
+		m.SetType(v)
 		return nil
-	case speedtest.FieldPingMs:
-		v, ok := value.(float64)
+	case testrun.FieldTrigger:
+		v, ok := value.(testrun.Trigger)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPingMs(v)
+		m.SetTrigger(v)
 		return nil
-	case speedtest.FieldJitterMs:
-		v, ok := value.(float64)
+	case testrun.FieldOutcome:
+		v, ok := value.(testrun.Outcome)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJitterMs(v)
+		m.SetOutcome(v)
 		return nil
-	case speedtest.FieldServerName:
-		v, ok := value.(string)
+	case testrun.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetServerName(v)
+		m.SetStartedAt(v)
 		return nil
-	case speedtest.FieldServerID:
-		v, ok := value.(string)
+	case testrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetServerID(v)
+		m.SetFinishedAt(v)
 		return nil
-	case speedtest.FieldIsp:
+	case testrun.FieldErrorMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsp(v)
+		m.SetErrorMessage(v)
 		return nil
-	case speedtest.FieldExternalIP:
-		v, ok := value.(string)
+	case testrun.FieldHostID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExternalIP(v)
+		m.SetHostID(v)
 		return nil
-	case speedtest.FieldResultURL:
-		v, ok := value.(string)
+	case testrun.FieldSpeedTestID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResultURL(v)
+		m.SetSpeedTestID(v)
 		return nil
-	case speedtest.FieldDaemonID:
+	case testrun.FieldIperfTestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIperfTestID(v)
+		return nil
+	case testrun.FieldTargetDaemonID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDaemonID(v)
+		m.SetTargetDaemonID(v)
+		return nil
+	case testrun.FieldMeshTestID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeshTestID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SpeedTest field %s", name)
+	return fmt.Errorf("unknown TestRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SpeedTestMutation) AddedFields() []string {
+func (m *TestRunMutation) AddedFields() []string {
 	var fields []string
-	if m.adddownload_mbps != nil {
-		fields = append(fields, speedtest.FieldDownloadMbps)
-	}
-	if m.addupload_mbps != nil {
-		fields = append(fields, speedtest.FieldUploadMbps)
-	}
-	if m.addping_ms != nil {
-		fields = append(fields, speedtest.FieldPingMs)
-	}
-	if m.addjitter_ms != nil {
-		fields = append(fields, speedtest.FieldJitterMs)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SpeedTestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TestRunMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case speedtest.FieldDownloadMbps:
-		return m.AddedDownloadMbps()
-	case speedtest.FieldUploadMbps:
-		return m.AddedUploadMbps()
-	case speedtest.FieldPingMs:
-		return m.AddedPingMs()
-	case speedtest.FieldJitterMs:
-		return m.AddedJitterMs()
 	}
 	return nil, false
 }
@@ -2857,189 +13087,247 @@ func (m *SpeedTestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SpeedTestMutation) AddField(name string, value ent.Value) error {
+func (m *TestRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case speedtest.FieldDownloadMbps:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDownloadMbps(v)
-		return nil
-	case speedtest.FieldUploadMbps:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUploadMbps(v)
-		return nil
-	case speedtest.FieldPingMs:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPingMs(v)
-		return nil
-	case speedtest.FieldJitterMs:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddJitterMs(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SpeedTest numeric field %s", name)
+	return fmt.Errorf("unknown TestRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SpeedTestMutation) ClearedFields() []string {
+func (m *TestRunMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(speedtest.FieldJitterMs) {
-		fields = append(fields, speedtest.FieldJitterMs)
+	if m.FieldCleared(testrun.FieldSubmissionID) {
+		fields = append(fields, testrun.FieldSubmissionID)
 	}
-	if m.FieldCleared(speedtest.FieldServerName) {
-		fields = append(fields, speedtest.FieldServerName)
+	if m.FieldCleared(testrun.FieldErrorMessage) {
+		fields = append(fields, testrun.FieldErrorMessage)
 	}
-	if m.FieldCleared(speedtest.FieldServerID) {
-		fields = append(fields, speedtest.FieldServerID)
+	if m.FieldCleared(testrun.FieldHostID) {
+		fields = append(fields, testrun.FieldHostID)
 	}
-	if m.FieldCleared(speedtest.FieldIsp) {
-		fields = append(fields, speedtest.FieldIsp)
+	if m.FieldCleared(testrun.FieldSpeedTestID) {
+		fields = append(fields, testrun.FieldSpeedTestID)
 	}
-	if m.FieldCleared(speedtest.FieldExternalIP) {
-		fields = append(fields, speedtest.FieldExternalIP)
+	if m.FieldCleared(testrun.FieldIperfTestID) {
+		fields = append(fields, testrun.FieldIperfTestID)
 	}
-	if m.FieldCleared(speedtest.FieldResultURL) {
-		fields = append(fields, speedtest.FieldResultURL)
+	if m.FieldCleared(testrun.FieldTargetDaemonID) {
+		fields = append(fields, testrun.FieldTargetDaemonID)
 	}
-	if m.FieldCleared(speedtest.FieldDaemonID) {
-		fields = append(fields, speedtest.FieldDaemonID)
+	if m.FieldCleared(testrun.FieldMeshTestID) {
+		fields = append(fields, testrun.FieldMeshTestID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SpeedTestMutation) FieldCleared(name string) bool {
+func (m *TestRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SpeedTestMutation) ClearField(name string) error {
+func (m *TestRunMutation) ClearField(name string) error {
 	switch name {
-	case speedtest.FieldJitterMs:
-		m.ClearJitterMs()
+	case testrun.FieldSubmissionID:
+		m.ClearSubmissionID()
 		return nil
-	case speedtest.FieldServerName:
-		m.ClearServerName()
+	case testrun.FieldErrorMessage:
+		m.ClearErrorMessage()
 		return nil
-	case speedtest.FieldServerID:
-		m.ClearServerID()
+	case testrun.FieldHostID:
+		m.ClearHostID()
 		return nil
-	case speedtest.FieldIsp:
-		m.ClearIsp()
+	case testrun.FieldSpeedTestID:
+		m.ClearSpeedTestID()
 		return nil
-	case speedtest.FieldExternalIP:
-		m.ClearExternalIP()
+	case testrun.FieldIperfTestID:
+		m.ClearIperfTestID()
 		return nil
-	case speedtest.FieldResultURL:
-		m.ClearResultURL()
+	case testrun.FieldTargetDaemonID:
+		m.ClearTargetDaemonID()
 		return nil
-	case speedtest.FieldDaemonID:
-		m.ClearDaemonID()
+	case testrun.FieldMeshTestID:
+		m.ClearMeshTestID()
 		return nil
 	}
-	return fmt.Errorf("unknown SpeedTest nullable field %s", name)
+	return fmt.Errorf("unknown TestRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SpeedTestMutation) ResetField(name string) error {
+func (m *TestRunMutation) ResetField(name string) error {
 	switch name {
-	case speedtest.FieldTimestamp:
-		m.ResetTimestamp()
+	case testrun.FieldDaemonID:
+		m.ResetDaemonID()
 		return nil
-	case speedtest.FieldDownloadMbps:
-		m.ResetDownloadMbps()
+	case testrun.FieldSubmissionID:
+		m.ResetSubmissionID()
 		return nil
-	case speedtest.FieldUploadMbps:
-		m.ResetUploadMbps()
+	case testrun.FieldType:
+		m.ResetType()
 		return nil
-	case speedtest.FieldPingMs:
-		m.ResetPingMs()
+	case testrun.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case speedtest.FieldJitterMs:
-		m.ResetJitterMs()
+	case testrun.FieldOutcome:
+		m.ResetOutcome()
 		return nil
-	case speedtest.FieldServerName:
-		m.ResetServerName()
+	case testrun.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case speedtest.FieldServerID:
-		m.ResetServerID()
+	case testrun.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
-	case speedtest.FieldIsp:
-		m.ResetIsp()
+	case testrun.FieldErrorMessage:
+		m.ResetErrorMessage()
 		return nil
-	case speedtest.FieldExternalIP:
-		m.ResetExternalIP()
+	case testrun.FieldHostID:
+		m.ResetHostID()
 		return nil
-	case speedtest.FieldResultURL:
-		m.ResetResultURL()
+	case testrun.FieldSpeedTestID:
+		m.ResetSpeedTestID()
 		return nil
-	case speedtest.FieldDaemonID:
-		m.ResetDaemonID()
+	case testrun.FieldIperfTestID:
+		m.ResetIperfTestID()
+		return nil
+	case testrun.FieldTargetDaemonID:
+		m.ResetTargetDaemonID()
+		return nil
+	case testrun.FieldMeshTestID:
+		m.ResetMeshTestID()
 		return nil
 	}
-	return fmt.Errorf("unknown SpeedTest field %s", name)
+	return fmt.Errorf("unknown TestRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SpeedTestMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TestRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.host != nil {
+		edges = append(edges, testrun.EdgeHost)
+	}
+	if m.speed_test != nil {
+		edges = append(edges, testrun.EdgeSpeedTest)
+	}
+	if m.iperf_test != nil {
+		edges = append(edges, testrun.EdgeIperfTest)
+	}
+	if m.mesh_test != nil {
+		edges = append(edges, testrun.EdgeMeshTest)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SpeedTestMutation) AddedIDs(name string) []ent.Value {
+func (m *TestRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case testrun.EdgeHost:
+		if id := m.host; id != nil {
+			return []ent.Value{*id}
+		}
+	case testrun.EdgeSpeedTest:
+		if id := m.speed_test; id != nil {
+			return []ent.Value{*id}
+		}
+	case testrun.EdgeIperfTest:
+		if id := m.iperf_test; id != nil {
+			return []ent.Value{*id}
+		}
+	case testrun.EdgeMeshTest:
+		if id := m.mesh_test; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SpeedTestMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TestRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SpeedTestMutation) RemovedIDs(name string) []ent.Value {
+func (m *TestRunMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SpeedTestMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TestRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedhost {
+		edges = append(edges, testrun.EdgeHost)
+	}
+	if m.clearedspeed_test {
+		edges = append(edges, testrun.EdgeSpeedTest)
+	}
+	if m.clearediperf_test {
+		edges = append(edges, testrun.EdgeIperfTest)
+	}
+	if m.clearedmesh_test {
+		edges = append(edges, testrun.EdgeMeshTest)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SpeedTestMutation) EdgeCleared(name string) bool {
+func (m *TestRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case testrun.EdgeHost:
+		return m.clearedhost
+	case testrun.EdgeSpeedTest:
+		return m.clearedspeed_test
+	case testrun.EdgeIperfTest:
+		return m.clearediperf_test
+	case testrun.EdgeMeshTest:
+		return m.clearedmesh_test
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SpeedTestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SpeedTest unique edge %s", name)
+func (m *TestRunMutation) ClearEdge(name string) error {
+	switch name {
+	case testrun.EdgeHost:
+		m.ClearHost()
+		return nil
+	case testrun.EdgeSpeedTest:
+		m.ClearSpeedTest()
+		return nil
+	case testrun.EdgeIperfTest:
+		m.ClearIperfTest()
+		return nil
+	case testrun.EdgeMeshTest:
+		m.ClearMeshTest()
+		return nil
+	}
+	return fmt.Errorf("unknown TestRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SpeedTestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SpeedTest edge %s", name)
+func (m *TestRunMutation) ResetEdge(name string) error {
+	switch name {
+	case testrun.EdgeHost:
+		m.ResetHost()
+		return nil
+	case testrun.EdgeSpeedTest:
+		m.ResetSpeedTest()
+		return nil
+	case testrun.EdgeIperfTest:
+		m.ResetIperfTest()
+		return nil
+	case testrun.EdgeMeshTest:
+		m.ResetMeshTest()
+		return nil
+	}
+	return fmt.Errorf("unknown TestRun edge %s", name)
 }
@@ -6,11 +6,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// Campaign is the predicate function for campaign builders.
+type Campaign func(*sql.Selector)
+
+// Daemon is the predicate function for daemon builders.
+type Daemon func(*sql.Selector)
+
+// DaemonConfig is the predicate function for daemonconfig builders.
+type DaemonConfig func(*sql.Selector)
+
 // Host is the predicate function for host builders.
 type Host func(*sql.Selector)
 
 // IperfTest is the predicate function for iperftest builders.
 type IperfTest func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// MeshTest is the predicate function for meshtest builders.
+type MeshTest func(*sql.Selector)
+
 // SpeedTest is the predicate function for speedtest builders.
 type SpeedTest func(*sql.Selector)
+
+// TestRun is the predicate function for testrun builders.
+type TestRun func(*sql.Selector)
@@ -5,8 +5,14 @@ package ent
 import (
 	"time"
 
+	"github.com/bfirestone/speed-checker/ent/apikey"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
 	"github.com/bfirestone/speed-checker/ent/host"
 	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
 	"github.com/bfirestone/speed-checker/ent/schema"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
 )
@@ -15,6 +21,100 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[1].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[2].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[5].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	campaignFields := schema.Campaign{}.Fields()
+	_ = campaignFields
+	// campaignDescName is the schema descriptor for name field.
+	campaignDescName := campaignFields[0].Descriptor()
+	// campaign.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	campaign.NameValidator = campaignDescName.Validators[0].(func(string) error)
+	// campaignDescSpeedtest is the schema descriptor for speedtest field.
+	campaignDescSpeedtest := campaignFields[2].Descriptor()
+	// campaign.DefaultSpeedtest holds the default value on creation for the speedtest field.
+	campaign.DefaultSpeedtest = campaignDescSpeedtest.Default.(bool)
+	// campaignDescScheduledAt is the schema descriptor for scheduled_at field.
+	campaignDescScheduledAt := campaignFields[7].Descriptor()
+	// campaign.DefaultScheduledAt holds the default value on creation for the scheduled_at field.
+	campaign.DefaultScheduledAt = campaignDescScheduledAt.Default.(func() time.Time)
+	// campaignDescCreatedAt is the schema descriptor for created_at field.
+	campaignDescCreatedAt := campaignFields[8].Descriptor()
+	// campaign.DefaultCreatedAt holds the default value on creation for the created_at field.
+	campaign.DefaultCreatedAt = campaignDescCreatedAt.Default.(func() time.Time)
+	daemonFields := schema.Daemon{}.Fields()
+	_ = daemonFields
+	// daemonDescHasIperf3 is the schema descriptor for has_iperf3 field.
+	daemonDescHasIperf3 := daemonFields[7].Descriptor()
+	// daemon.DefaultHasIperf3 holds the default value on creation for the has_iperf3 field.
+	daemon.DefaultHasIperf3 = daemonDescHasIperf3.Default.(bool)
+	// daemonDescHasSpeedtest is the schema descriptor for has_speedtest field.
+	daemonDescHasSpeedtest := daemonFields[8].Descriptor()
+	// daemon.DefaultHasSpeedtest holds the default value on creation for the has_speedtest field.
+	daemon.DefaultHasSpeedtest = daemonDescHasSpeedtest.Default.(bool)
+	// daemonDescSpoolDepth is the schema descriptor for spool_depth field.
+	daemonDescSpoolDepth := daemonFields[10].Descriptor()
+	// daemon.DefaultSpoolDepth holds the default value on creation for the spool_depth field.
+	daemon.DefaultSpoolDepth = daemonDescSpoolDepth.Default.(int)
+	// daemonDescRegisteredAt is the schema descriptor for registered_at field.
+	daemonDescRegisteredAt := daemonFields[11].Descriptor()
+	// daemon.DefaultRegisteredAt holds the default value on creation for the registered_at field.
+	daemon.DefaultRegisteredAt = daemonDescRegisteredAt.Default.(func() time.Time)
+	// daemonDescLastSeenAt is the schema descriptor for last_seen_at field.
+	daemonDescLastSeenAt := daemonFields[12].Descriptor()
+	// daemon.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	daemon.DefaultLastSeenAt = daemonDescLastSeenAt.Default.(func() time.Time)
+	// daemonDescID is the schema descriptor for id field.
+	daemonDescID := daemonFields[0].Descriptor()
+	// daemon.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	daemon.IDValidator = daemonDescID.Validators[0].(func(string) error)
+	daemonconfigFields := schema.DaemonConfig{}.Fields()
+	_ = daemonconfigFields
+	// daemonconfigDescName is the schema descriptor for name field.
+	daemonconfigDescName := daemonconfigFields[0].Descriptor()
+	// daemonconfig.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	daemonconfig.NameValidator = daemonconfigDescName.Validators[0].(func(string) error)
+	// daemonconfigDescPriority is the schema descriptor for priority field.
+	daemonconfigDescPriority := daemonconfigFields[3].Descriptor()
+	// daemonconfig.DefaultPriority holds the default value on creation for the priority field.
+	daemonconfig.DefaultPriority = daemonconfigDescPriority.Default.(int)
+	// daemonconfigDescSpeedtestIntervalSeconds is the schema descriptor for speedtest_interval_seconds field.
+	daemonconfigDescSpeedtestIntervalSeconds := daemonconfigFields[4].Descriptor()
+	// daemonconfig.SpeedtestIntervalSecondsValidator is a validator for the "speedtest_interval_seconds" field. It is called by the builders before save.
+	daemonconfig.SpeedtestIntervalSecondsValidator = daemonconfigDescSpeedtestIntervalSeconds.Validators[0].(func(int) error)
+	// daemonconfigDescIperfIntervalSeconds is the schema descriptor for iperf_interval_seconds field.
+	daemonconfigDescIperfIntervalSeconds := daemonconfigFields[5].Descriptor()
+	// daemonconfig.IperfIntervalSecondsValidator is a validator for the "iperf_interval_seconds" field. It is called by the builders before save.
+	daemonconfig.IperfIntervalSecondsValidator = daemonconfigDescIperfIntervalSeconds.Validators[0].(func(int) error)
+	// daemonconfigDescIperfDurationSeconds is the schema descriptor for iperf_duration_seconds field.
+	daemonconfigDescIperfDurationSeconds := daemonconfigFields[6].Descriptor()
+	// daemonconfig.IperfDurationSecondsValidator is a validator for the "iperf_duration_seconds" field. It is called by the builders before save.
+	daemonconfig.IperfDurationSecondsValidator = daemonconfigDescIperfDurationSeconds.Validators[0].(func(int) error)
+	// daemonconfigDescCreatedAt is the schema descriptor for created_at field.
+	daemonconfigDescCreatedAt := daemonconfigFields[12].Descriptor()
+	// daemonconfig.DefaultCreatedAt holds the default value on creation for the created_at field.
+	daemonconfig.DefaultCreatedAt = daemonconfigDescCreatedAt.Default.(func() time.Time)
+	// daemonconfigDescUpdatedAt is the schema descriptor for updated_at field.
+	daemonconfigDescUpdatedAt := daemonconfigFields[13].Descriptor()
+	// daemonconfig.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	daemonconfig.DefaultUpdatedAt = daemonconfigDescUpdatedAt.Default.(func() time.Time)
+	// daemonconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	daemonconfig.UpdateDefaultUpdatedAt = daemonconfigDescUpdatedAt.UpdateDefault.(func() time.Time)
 	hostFields := schema.Host{}.Fields()
 	_ = hostFields
 	// hostDescPort is the schema descriptor for port field.
@@ -43,10 +143,92 @@ func init() {
 	iperftestDescSuccess := iperftestFields[7].Descriptor()
 	// iperftest.DefaultSuccess holds the default value on creation for the success field.
 	iperftest.DefaultSuccess = iperftestDescSuccess.Default.(bool)
+	// iperftestDescReceivedAt is the schema descriptor for received_at field.
+	iperftestDescReceivedAt := iperftestFields[13].Descriptor()
+	// iperftest.DefaultReceivedAt holds the default value on creation for the received_at field.
+	iperftest.DefaultReceivedAt = iperftestDescReceivedAt.Default.(func() time.Time)
+	// iperftestDescClockCorrected is the schema descriptor for clock_corrected field.
+	iperftestDescClockCorrected := iperftestFields[15].Descriptor()
+	// iperftest.DefaultClockCorrected holds the default value on creation for the clock_corrected field.
+	iperftest.DefaultClockCorrected = iperftestDescClockCorrected.Default.(bool)
+	// iperftestDescQuarantined is the schema descriptor for quarantined field.
+	iperftestDescQuarantined := iperftestFields[17].Descriptor()
+	// iperftest.DefaultQuarantined holds the default value on creation for the quarantined field.
+	iperftest.DefaultQuarantined = iperftestDescQuarantined.Default.(bool)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescPriority is the schema descriptor for priority field.
+	jobDescPriority := jobFields[7].Descriptor()
+	// job.DefaultPriority holds the default value on creation for the priority field.
+	job.DefaultPriority = jobDescPriority.Default.(int)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[8].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[9].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// jobDescScheduledAt is the schema descriptor for scheduled_at field.
+	jobDescScheduledAt := jobFields[12].Descriptor()
+	// job.DefaultScheduledAt holds the default value on creation for the scheduled_at field.
+	job.DefaultScheduledAt = jobDescScheduledAt.Default.(func() time.Time)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[13].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	meshtestFields := schema.MeshTest{}.Fields()
+	_ = meshtestFields
+	// meshtestDescTimestamp is the schema descriptor for timestamp field.
+	meshtestDescTimestamp := meshtestFields[0].Descriptor()
+	// meshtest.DefaultTimestamp holds the default value on creation for the timestamp field.
+	meshtest.DefaultTimestamp = meshtestDescTimestamp.Default.(func() time.Time)
+	// meshtestDescSourceDaemonID is the schema descriptor for source_daemon_id field.
+	meshtestDescSourceDaemonID := meshtestFields[1].Descriptor()
+	// meshtest.SourceDaemonIDValidator is a validator for the "source_daemon_id" field. It is called by the builders before save.
+	meshtest.SourceDaemonIDValidator = meshtestDescSourceDaemonID.Validators[0].(func(string) error)
+	// meshtestDescTargetDaemonID is the schema descriptor for target_daemon_id field.
+	meshtestDescTargetDaemonID := meshtestFields[2].Descriptor()
+	// meshtest.TargetDaemonIDValidator is a validator for the "target_daemon_id" field. It is called by the builders before save.
+	meshtest.TargetDaemonIDValidator = meshtestDescTargetDaemonID.Validators[0].(func(string) error)
+	// meshtestDescDurationSeconds is the schema descriptor for duration_seconds field.
+	meshtestDescDurationSeconds := meshtestFields[8].Descriptor()
+	// meshtest.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	meshtest.DefaultDurationSeconds = meshtestDescDurationSeconds.Default.(int)
+	// meshtestDescSuccess is the schema descriptor for success field.
+	meshtestDescSuccess := meshtestFields[9].Descriptor()
+	// meshtest.DefaultSuccess holds the default value on creation for the success field.
+	meshtest.DefaultSuccess = meshtestDescSuccess.Default.(bool)
+	// meshtestDescReceivedAt is the schema descriptor for received_at field.
+	meshtestDescReceivedAt := meshtestFields[13].Descriptor()
+	// meshtest.DefaultReceivedAt holds the default value on creation for the received_at field.
+	meshtest.DefaultReceivedAt = meshtestDescReceivedAt.Default.(func() time.Time)
+	// meshtestDescClockCorrected is the schema descriptor for clock_corrected field.
+	meshtestDescClockCorrected := meshtestFields[15].Descriptor()
+	// meshtest.DefaultClockCorrected holds the default value on creation for the clock_corrected field.
+	meshtest.DefaultClockCorrected = meshtestDescClockCorrected.Default.(bool)
+	// meshtestDescQuarantined is the schema descriptor for quarantined field.
+	meshtestDescQuarantined := meshtestFields[16].Descriptor()
+	// meshtest.DefaultQuarantined holds the default value on creation for the quarantined field.
+	meshtest.DefaultQuarantined = meshtestDescQuarantined.Default.(bool)
 	speedtestFields := schema.SpeedTest{}.Fields()
 	_ = speedtestFields
 	// speedtestDescTimestamp is the schema descriptor for timestamp field.
 	speedtestDescTimestamp := speedtestFields[0].Descriptor()
 	// speedtest.DefaultTimestamp holds the default value on creation for the timestamp field.
 	speedtest.DefaultTimestamp = speedtestDescTimestamp.Default.(func() time.Time)
+	// speedtestDescReceivedAt is the schema descriptor for received_at field.
+	speedtestDescReceivedAt := speedtestFields[13].Descriptor()
+	// speedtest.DefaultReceivedAt holds the default value on creation for the received_at field.
+	speedtest.DefaultReceivedAt = speedtestDescReceivedAt.Default.(func() time.Time)
+	// speedtestDescClockCorrected is the schema descriptor for clock_corrected field.
+	speedtestDescClockCorrected := speedtestFields[15].Descriptor()
+	// speedtest.DefaultClockCorrected holds the default value on creation for the clock_corrected field.
+	speedtest.DefaultClockCorrected = speedtestDescClockCorrected.Default.(bool)
+	// speedtestDescQuarantined is the schema descriptor for quarantined field.
+	speedtestDescQuarantined := speedtestFields[17].Descriptor()
+	// speedtest.DefaultQuarantined holds the default value on creation for the quarantined field.
+	speedtest.DefaultQuarantined = speedtestDescQuarantined.Default.(bool)
+	testrunFields := schema.TestRun{}.Fields()
+	_ = testrunFields
 }
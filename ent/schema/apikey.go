@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Human-friendly name, e.g. the daemon or service using the key"),
+		field.String("prefix").
+			NotEmpty().
+			Immutable().
+			Comment("First characters of the key, shown to identify it"),
+		field.String("key_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive().
+			Comment("SHA-256 hash of the key; the key itself is never stored"),
+		field.Strings("scopes").
+			Comment("Granted scopes: submit, read and/or admin"),
+		field.String("daemon_id").
+			Optional().
+			Nillable().
+			Comment("Daemon the key is bound to; bound keys may only act as that daemon"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("When the key was revoked; revoked keys are rejected"),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
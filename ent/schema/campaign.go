@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Campaign holds the schema definition for the Campaign entity, a run of
+// the same tests on many daemons at the same time.
+type Campaign struct {
+	ent.Schema
+}
+
+// Fields of the Campaign.
+func (Campaign) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Human-friendly campaign name"),
+		field.String("description").
+			Optional().
+			Comment("Why the campaign was run, e.g. the maintenance it follows"),
+		field.Bool("speedtest").
+			Default(false).
+			Comment("Whether every daemon runs a speed test"),
+		field.JSON("host_ids", []int{}).
+			Optional().
+			Comment("Hosts every daemon runs an iperf test against"),
+		field.JSON("daemon_selector", map[string]string{}).
+			Optional().
+			Comment("Daemons carrying all of these labels take part"),
+		field.JSON("daemon_ids", []string{}).
+			Optional().
+			Comment("Daemons that take part regardless of their labels"),
+		field.Int("duration_seconds").
+			Optional().
+			Comment("iperf test duration in seconds; daemon default when unset"),
+		field.Time("scheduled_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time the daemons start the campaign's tests"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Campaign.
+func (Campaign) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("jobs", Job.Type).
+			Comment("Jobs enqueued for the campaign, one per daemon and test"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Daemon holds the schema definition for the Daemon entity.
+type Daemon struct {
+	ent.Schema
+}
+
+// Fields of the Daemon.
+func (Daemon) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			Comment("Daemon identifier, matches daemon_id on results"),
+		field.String("name").
+			Optional().
+			Comment("Human-friendly name from the daemon configuration"),
+		field.String("hostname").
+			Optional().
+			Comment("Hostname of the machine running the daemon"),
+		field.String("version").
+			Optional().
+			Comment("speed-checker version the daemon runs"),
+		field.String("os").
+			Optional().
+			Comment("Operating system, e.g. linux"),
+		field.String("arch").
+			Optional().
+			Comment("CPU architecture, e.g. amd64"),
+		field.JSON("labels", map[string]string{}).
+			Optional().
+			Comment("Free-form labels describing the daemon"),
+		field.Bool("has_iperf3").
+			Default(false).
+			Comment("Whether iperf3 is installed"),
+		field.Bool("has_speedtest").
+			Default(false).
+			Comment("Whether the Ookla speedtest CLI is installed"),
+		field.String("mesh_address").
+			Optional().
+			Comment("host:port of the daemon's iperf3 server for mesh tests; empty when it does not take part"),
+		field.Int("spool_depth").
+			Default(0).
+			Comment("Results waiting in the daemon's offline spool at the last heartbeat"),
+		field.Time("registered_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_seen_at").
+			Default(time.Now).
+			Comment("Last registration or heartbeat"),
+	}
+}
+
+// Edges of the Daemon. Results reference daemons by daemon_id without a
+// foreign key, so results of daemons that never registered are kept; the
+// API resolves daemon_id to the registered daemon when listing results.
+func (Daemon) Edges() []ent.Edge {
+	return nil
+}
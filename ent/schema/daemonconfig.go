@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DaemonConfig holds the schema definition for the DaemonConfig entity.
+type DaemonConfig struct {
+	ent.Schema
+}
+
+// Fields of the DaemonConfig.
+func (DaemonConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Human-friendly name, e.g. what the override is for"),
+		field.String("daemon_id").
+			Optional().
+			Nillable().
+			Comment("Daemon the override applies to; unset for global defaults and label overrides"),
+		field.JSON("match_labels", map[string]string{}).
+			Optional().
+			Comment("Labels a daemon must all carry for the override to apply"),
+		field.Int("priority").
+			Default(0).
+			Comment("Order among configs of the same kind; higher priority wins"),
+		field.Int("speedtest_interval_seconds").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("iperf_interval_seconds").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("iperf_duration_seconds").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Bool("speedtest_enabled").
+			Optional().
+			Nillable(),
+		field.Bool("iperf_enabled").
+			Optional().
+			Nillable(),
+		field.Bool("adaptive_enabled").
+			Optional().
+			Nillable(),
+		field.Strings("host_types").
+			Optional().
+			Comment("Only test hosts of these types; empty leaves the filter unset"),
+		field.Ints("host_ids").
+			Optional().
+			Comment("Only test these hosts; empty leaves the filter unset"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DaemonConfig.
+func (DaemonConfig) Edges() []ent.Edge {
+	return nil
+}
@@ -30,6 +30,12 @@ func (Host) Fields() []ent.Field {
 		field.String("description").
 			Optional().
 			Comment("Optional description of the host"),
+		field.JSON("daemon_selector", map[string]string{}).
+			Optional().
+			Comment("Labels a daemon must all carry to test this host"),
+		field.Strings("daemon_ids").
+			Optional().
+			Comment("Daemons assigned to test this host regardless of their labels"),
 	}
 }
 
@@ -37,5 +43,7 @@ func (Host) Fields() []ent.Field {
 func (Host) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("iperf_tests", IperfTest.Type),
+		edge.To("jobs", Job.Type),
+		edge.To("test_runs", TestRun.Type),
 	}
 }
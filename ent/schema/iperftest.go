@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // IperfTest holds the schema definition for the IperfTest entity.
@@ -43,6 +44,39 @@ func (IperfTest) Fields() []ent.Field {
 		field.String("daemon_id").
 			Optional().
 			Comment("Identifier of the daemon that performed the test"),
+		field.UUID("submission_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Client-generated ID used to deduplicate retried submissions"),
+		field.Enum("trigger").
+			Values("scheduled", "manual", "adaptive").
+			Default("scheduled").
+			Comment("What caused the test to run"),
+		field.Enum("blocked_by").
+			Values("lan", "vpn", "remote").
+			Optional().
+			Comment("Upstream host type whose failure blocked this test; empty when the test ran"),
+		field.Time("received_at").
+			Optional().
+			Nillable().
+			Default(time.Now).
+			Immutable().
+			Comment("Server time the result was received; unset for results stored before it was recorded"),
+		field.Int64("clock_offset_ms").
+			Optional().
+			Nillable().
+			Comment("Server clock minus daemon clock in milliseconds as measured by the daemon"),
+		field.Bool("clock_corrected").
+			Default(false).
+			Comment("Whether the daemon adjusted the timestamp by clock_offset_ms"),
+		field.Int("campaign_id").
+			Optional().
+			Nillable().
+			Comment("Campaign the result was collected for"),
+		field.Bool("quarantined").
+			Default(false).
+			Comment("Timestamp was outside the clock skew window; kept out of listings and baselines"),
 	}
 }
 
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity.
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("speedtest", "iperf", "mesh").
+			Comment("Kind of test the job runs"),
+		field.Enum("status").
+			Values("pending", "leased", "completed", "failed").
+			Default("pending").
+			Comment("Lifecycle state of the job"),
+		field.String("daemon_id").
+			Optional().
+			Comment("Daemon the job is pinned to; empty means any daemon may lease it"),
+		field.String("target_daemon_id").
+			Optional().
+			Comment("Daemon whose iperf3 server a mesh job tests"),
+		field.String("target_address").
+			Optional().
+			Comment("host:port of the target daemon's iperf3 server, resolved when the mesh job is enqueued"),
+		field.Int("duration_seconds").
+			Optional().
+			Comment("iperf test duration in seconds; daemon default when unset"),
+		field.Enum("trigger").
+			Values("scheduled", "manual", "adaptive").
+			Default("scheduled").
+			Comment("What caused the job to be enqueued"),
+		field.Int("priority").
+			Default(0).
+			Comment("Higher priority jobs are leased first; on-demand runs use a raised priority"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Number of times the job has been leased"),
+		field.Int("max_attempts").
+			Default(3).
+			Comment("Maximum number of leases before the job is marked failed"),
+		field.String("leased_by").
+			Optional().
+			Comment("Daemon currently holding the lease"),
+		field.Time("lease_expires_at").
+			Optional().
+			Nillable().
+			Comment("When the current lease expires and the job becomes leasable again"),
+		field.Time("scheduled_at").
+			Default(time.Now).
+			Comment("Earliest time the job may be leased"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When the job reached a terminal state"),
+		field.Int("result_id").
+			Optional().
+			Nillable().
+			Comment("ID of the speed or iperf result produced by the job"),
+		field.String("error_message").
+			Optional().
+			Comment("Error reported by the last failed attempt"),
+		field.Int("campaign_id").
+			Optional().
+			Nillable().
+			Comment("Campaign the job belongs to"),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("host", Host.Type).
+			Ref("jobs").
+			Unique().
+			Comment("Target host for iperf jobs"),
+		edge.From("campaign", Campaign.Type).
+			Ref("jobs").
+			Field("campaign_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "priority", "scheduled_at"),
+	}
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MeshTest holds the schema definition for the MeshTest entity, an iperf
+// test run from one daemon against another daemon's iperf3 server.
+type MeshTest struct {
+	ent.Schema
+}
+
+// Fields of the MeshTest.
+func (MeshTest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("timestamp").
+			Default(time.Now),
+		field.String("source_daemon_id").
+			NotEmpty().
+			Comment("Daemon that ran the iperf3 client"),
+		field.String("target_daemon_id").
+			NotEmpty().
+			Comment("Daemon whose iperf3 server was tested"),
+		field.String("target_address").
+			Optional().
+			Comment("host:port of the target's iperf3 server at the time of the test"),
+		field.Float("sent_mbps").
+			Comment("Throughput from source to target in Mbps"),
+		field.Float("received_mbps").
+			Comment("Throughput received by the target in Mbps"),
+		field.Float("retransmits").
+			Optional().
+			Comment("Number of retransmits"),
+		field.Float("mean_rtt_ms").
+			Optional().
+			Comment("Mean round-trip time in milliseconds"),
+		field.Int("duration_seconds").
+			Default(10).
+			Comment("Test duration in seconds"),
+		field.Bool("success").
+			Default(true).
+			Comment("Whether the test completed successfully"),
+		field.String("error_message").
+			Optional().
+			Comment("Error message if test failed"),
+		field.UUID("submission_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Client-generated ID used to deduplicate retried submissions"),
+		field.Enum("trigger").
+			Values("scheduled", "manual", "adaptive").
+			Default("scheduled").
+			Comment("What caused the test to run"),
+		field.Time("received_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Server time the result was received"),
+		field.Int64("clock_offset_ms").
+			Optional().
+			Nillable().
+			Comment("Server clock minus daemon clock in milliseconds as measured by the daemon"),
+		field.Bool("clock_corrected").
+			Default(false).
+			Comment("Whether the daemon adjusted the timestamp by clock_offset_ms"),
+		field.Bool("quarantined").
+			Default(false).
+			Comment("Timestamp was outside the clock skew window; kept out of the matrix"),
+	}
+}
+
+// Edges of the MeshTest.
+func (MeshTest) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the MeshTest.
+func (MeshTest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_daemon_id", "target_daemon_id", "timestamp"),
+	}
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TestRun holds the schema definition for the TestRun entity.
+type TestRun struct {
+	ent.Schema
+}
+
+// Fields of the TestRun.
+func (TestRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("daemon_id").
+			Comment("Identifier of the daemon that attempted the run"),
+		field.UUID("submission_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Client-generated ID used to deduplicate retried submissions"),
+		field.Enum("type").
+			Values("speedtest", "iperf", "mesh").
+			Comment("Kind of test the run executed"),
+		field.Enum("trigger").
+			Values("scheduled", "manual", "adaptive").
+			Default("scheduled").
+			Comment("What caused the run"),
+		field.Enum("outcome").
+			Values("success", "failed", "skipped", "timeout", "aborted", "spooled").
+			Comment("How the run ended"),
+		field.Time("started_at").
+			Comment("When the daemon started the run"),
+		field.Time("finished_at").
+			Comment("When the run finished or was abandoned"),
+		field.String("error_message").
+			Optional().
+			Comment("Why the run failed, timed out, was skipped or aborted"),
+		field.Int("host_id").
+			Optional().
+			Nillable(),
+		field.Int("speed_test_id").
+			Optional().
+			Nillable(),
+		field.Int("iperf_test_id").
+			Optional().
+			Nillable(),
+		field.String("target_daemon_id").
+			Optional().
+			Comment("Daemon whose iperf3 server a mesh run tested"),
+		field.Int("mesh_test_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the TestRun.
+func (TestRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("host", Host.Type).
+			Ref("test_runs").
+			Field("host_id").
+			Unique().
+			Comment("Target host for iperf runs"),
+		edge.To("speed_test", SpeedTest.Type).
+			Field("speed_test_id").
+			Unique().
+			Comment("Speed test result produced by the run"),
+		edge.To("iperf_test", IperfTest.Type).
+			Field("iperf_test_id").
+			Unique().
+			Comment("Iperf test result produced by the run"),
+		edge.To("mesh_test", MeshTest.Type).
+			Field("mesh_test_id").
+			Unique().
+			Comment("Mesh test result produced by the run"),
+	}
+}
+
+// Indexes of the TestRun.
+func (TestRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("started_at"),
+		index.Fields("daemon_id", "started_at"),
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/google/uuid"
 )
 
 // SpeedTest is the model entity for the SpeedTest schema.
@@ -38,7 +39,21 @@ type SpeedTest struct {
 	// URL to full test results
 	ResultURL string `json:"result_url,omitempty"`
 	// Identifier of the daemon that performed the test
-	DaemonID     string `json:"daemon_id,omitempty"`
+	DaemonID string `json:"daemon_id,omitempty"`
+	// Client-generated ID used to deduplicate retried submissions
+	SubmissionID *uuid.UUID `json:"submission_id,omitempty"`
+	// What caused the test to run
+	Trigger speedtest.Trigger `json:"trigger,omitempty"`
+	// Server time the result was received; unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	// Server clock minus daemon clock in milliseconds as measured by the daemon
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+	// Whether the daemon adjusted the timestamp by clock_offset_ms
+	ClockCorrected bool `json:"clock_corrected,omitempty"`
+	// Campaign the result was collected for
+	CampaignID *int `json:"campaign_id,omitempty"`
+	// Timestamp was outside the clock skew window; kept out of listings and baselines
+	Quarantined  bool `json:"quarantined,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -47,13 +62,17 @@ func (*SpeedTest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case speedtest.FieldSubmissionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case speedtest.FieldClockCorrected, speedtest.FieldQuarantined:
+			values[i] = new(sql.NullBool)
 		case speedtest.FieldDownloadMbps, speedtest.FieldUploadMbps, speedtest.FieldPingMs, speedtest.FieldJitterMs:
 			values[i] = new(sql.NullFloat64)
-		case speedtest.FieldID:
+		case speedtest.FieldID, speedtest.FieldClockOffsetMs, speedtest.FieldCampaignID:
 			values[i] = new(sql.NullInt64)
-		case speedtest.FieldServerName, speedtest.FieldServerID, speedtest.FieldIsp, speedtest.FieldExternalIP, speedtest.FieldResultURL, speedtest.FieldDaemonID:
+		case speedtest.FieldServerName, speedtest.FieldServerID, speedtest.FieldIsp, speedtest.FieldExternalIP, speedtest.FieldResultURL, speedtest.FieldDaemonID, speedtest.FieldTrigger:
 			values[i] = new(sql.NullString)
-		case speedtest.FieldTimestamp:
+		case speedtest.FieldTimestamp, speedtest.FieldReceivedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -142,6 +161,52 @@ func (st *SpeedTest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				st.DaemonID = value.String
 			}
+		case speedtest.FieldSubmissionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_id", values[i])
+			} else if value.Valid {
+				st.SubmissionID = new(uuid.UUID)
+				*st.SubmissionID = *value.S.(*uuid.UUID)
+			}
+		case speedtest.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				st.Trigger = speedtest.Trigger(value.String)
+			}
+		case speedtest.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				st.ReceivedAt = new(time.Time)
+				*st.ReceivedAt = value.Time
+			}
+		case speedtest.FieldClockOffsetMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_offset_ms", values[i])
+			} else if value.Valid {
+				st.ClockOffsetMs = new(int64)
+				*st.ClockOffsetMs = value.Int64
+			}
+		case speedtest.FieldClockCorrected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field clock_corrected", values[i])
+			} else if value.Valid {
+				st.ClockCorrected = value.Bool
+			}
+		case speedtest.FieldCampaignID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field campaign_id", values[i])
+			} else if value.Valid {
+				st.CampaignID = new(int)
+				*st.CampaignID = int(value.Int64)
+			}
+		case speedtest.FieldQuarantined:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantined", values[i])
+			} else if value.Valid {
+				st.Quarantined = value.Bool
+			}
 		default:
 			st.selectValues.Set(columns[i], values[i])
 		}
@@ -210,6 +275,35 @@ func (st *SpeedTest) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("daemon_id=")
 	builder.WriteString(st.DaemonID)
+	builder.WriteString(", ")
+	if v := st.SubmissionID; v != nil {
+		builder.WriteString("submission_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", st.Trigger))
+	builder.WriteString(", ")
+	if v := st.ReceivedAt; v != nil {
+		builder.WriteString("received_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := st.ClockOffsetMs; v != nil {
+		builder.WriteString("clock_offset_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clock_corrected=")
+	builder.WriteString(fmt.Sprintf("%v", st.ClockCorrected))
+	builder.WriteString(", ")
+	if v := st.CampaignID; v != nil {
+		builder.WriteString("campaign_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quarantined=")
+	builder.WriteString(fmt.Sprintf("%v", st.Quarantined))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package speedtest
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -35,6 +36,20 @@ const (
 	FieldResultURL = "result_url"
 	// FieldDaemonID holds the string denoting the daemon_id field in the database.
 	FieldDaemonID = "daemon_id"
+	// FieldSubmissionID holds the string denoting the submission_id field in the database.
+	FieldSubmissionID = "submission_id"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldClockOffsetMs holds the string denoting the clock_offset_ms field in the database.
+	FieldClockOffsetMs = "clock_offset_ms"
+	// FieldClockCorrected holds the string denoting the clock_corrected field in the database.
+	FieldClockCorrected = "clock_corrected"
+	// FieldCampaignID holds the string denoting the campaign_id field in the database.
+	FieldCampaignID = "campaign_id"
+	// FieldQuarantined holds the string denoting the quarantined field in the database.
+	FieldQuarantined = "quarantined"
 	// Table holds the table name of the speedtest in the database.
 	Table = "speed_tests"
 )
@@ -53,6 +68,13 @@ var Columns = []string{
 	FieldExternalIP,
 	FieldResultURL,
 	FieldDaemonID,
+	FieldSubmissionID,
+	FieldTrigger,
+	FieldReceivedAt,
+	FieldClockOffsetMs,
+	FieldClockCorrected,
+	FieldCampaignID,
+	FieldQuarantined,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -68,8 +90,41 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
 	DefaultTimestamp func() time.Time
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultClockCorrected holds the default value on creation for the "clock_corrected" field.
+	DefaultClockCorrected bool
+	// DefaultQuarantined holds the default value on creation for the "quarantined" field.
+	DefaultQuarantined bool
 )
 
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerScheduled is the default value of the Trigger enum.
+const DefaultTrigger = TriggerScheduled
+
+// Trigger values.
+const (
+	TriggerScheduled Trigger = "scheduled"
+	TriggerManual    Trigger = "manual"
+	TriggerAdaptive  Trigger = "adaptive"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerScheduled, TriggerManual, TriggerAdaptive:
+		return nil
+	default:
+		return fmt.Errorf("speedtest: invalid enum value for trigger field: %q", t)
+	}
+}
+
 // OrderOption defines the ordering options for the SpeedTest queries.
 type OrderOption func(*sql.Selector)
 
@@ -132,3 +187,38 @@ func ByResultURL(opts ...sql.OrderTermOption) OrderOption {
 func ByDaemonID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDaemonID, opts...).ToFunc()
 }
+
+// BySubmissionID orders the results by the submission_id field.
+func BySubmissionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionID, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByClockOffsetMs orders the results by the clock_offset_ms field.
+func ByClockOffsetMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockOffsetMs, opts...).ToFunc()
+}
+
+// ByClockCorrected orders the results by the clock_corrected field.
+func ByClockCorrected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClockCorrected, opts...).ToFunc()
+}
+
+// ByCampaignID orders the results by the campaign_id field.
+func ByCampaignID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCampaignID, opts...).ToFunc()
+}
+
+// ByQuarantined orders the results by the quarantined field.
+func ByQuarantined(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantined, opts...).ToFunc()
+}
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/google/uuid"
 )
 
 // ID filters vertices based on their ID field.
@@ -109,6 +110,36 @@ func DaemonID(v string) predicate.SpeedTest {
 	return predicate.SpeedTest(sql.FieldEQ(FieldDaemonID, v))
 }
 
+// SubmissionID applies equality check predicate on the "submission_id" field. It's identical to SubmissionIDEQ.
+func SubmissionID(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ClockOffsetMs applies equality check predicate on the "clock_offset_ms" field. It's identical to ClockOffsetMsEQ.
+func ClockOffsetMs(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldClockOffsetMs, v))
+}
+
+// ClockCorrected applies equality check predicate on the "clock_corrected" field. It's identical to ClockCorrectedEQ.
+func ClockCorrected(v bool) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldClockCorrected, v))
+}
+
+// CampaignID applies equality check predicate on the "campaign_id" field. It's identical to CampaignIDEQ.
+func CampaignID(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// Quarantined applies equality check predicate on the "quarantined" field. It's identical to QuarantinedEQ.
+func Quarantined(v bool) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldQuarantined, v))
+}
+
 // TimestampEQ applies the EQ predicate on the "timestamp" field.
 func TimestampEQ(v time.Time) predicate.SpeedTest {
 	return predicate.SpeedTest(sql.FieldEQ(FieldTimestamp, v))
@@ -769,6 +800,246 @@ func DaemonIDContainsFold(v string) predicate.SpeedTest {
 	return predicate.SpeedTest(sql.FieldContainsFold(FieldDaemonID, v))
 }
 
+// SubmissionIDEQ applies the EQ predicate on the "submission_id" field.
+func SubmissionIDEQ(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDNEQ applies the NEQ predicate on the "submission_id" field.
+func SubmissionIDNEQ(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDIn applies the In predicate on the "submission_id" field.
+func SubmissionIDIn(vs ...uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDNotIn applies the NotIn predicate on the "submission_id" field.
+func SubmissionIDNotIn(vs ...uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDGT applies the GT predicate on the "submission_id" field.
+func SubmissionIDGT(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGT(FieldSubmissionID, v))
+}
+
+// SubmissionIDGTE applies the GTE predicate on the "submission_id" field.
+func SubmissionIDGTE(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDLT applies the LT predicate on the "submission_id" field.
+func SubmissionIDLT(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLT(FieldSubmissionID, v))
+}
+
+// SubmissionIDLTE applies the LTE predicate on the "submission_id" field.
+func SubmissionIDLTE(v uuid.UUID) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDIsNil applies the IsNil predicate on the "submission_id" field.
+func SubmissionIDIsNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIsNull(FieldSubmissionID))
+}
+
+// SubmissionIDNotNil applies the NotNil predicate on the "submission_id" field.
+func SubmissionIDNotNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotNull(FieldSubmissionID))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtIsNil applies the IsNil predicate on the "received_at" field.
+func ReceivedAtIsNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIsNull(FieldReceivedAt))
+}
+
+// ReceivedAtNotNil applies the NotNil predicate on the "received_at" field.
+func ReceivedAtNotNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotNull(FieldReceivedAt))
+}
+
+// ClockOffsetMsEQ applies the EQ predicate on the "clock_offset_ms" field.
+func ClockOffsetMsEQ(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsNEQ applies the NEQ predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNEQ(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsIn applies the In predicate on the "clock_offset_ms" field.
+func ClockOffsetMsIn(vs ...int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIn(FieldClockOffsetMs, vs...))
+}
+
+// ClockOffsetMsNotIn applies the NotIn predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNotIn(vs ...int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotIn(FieldClockOffsetMs, vs...))
+}
+
+// ClockOffsetMsGT applies the GT predicate on the "clock_offset_ms" field.
+func ClockOffsetMsGT(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGT(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsGTE applies the GTE predicate on the "clock_offset_ms" field.
+func ClockOffsetMsGTE(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGTE(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsLT applies the LT predicate on the "clock_offset_ms" field.
+func ClockOffsetMsLT(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLT(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsLTE applies the LTE predicate on the "clock_offset_ms" field.
+func ClockOffsetMsLTE(v int64) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLTE(FieldClockOffsetMs, v))
+}
+
+// ClockOffsetMsIsNil applies the IsNil predicate on the "clock_offset_ms" field.
+func ClockOffsetMsIsNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIsNull(FieldClockOffsetMs))
+}
+
+// ClockOffsetMsNotNil applies the NotNil predicate on the "clock_offset_ms" field.
+func ClockOffsetMsNotNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotNull(FieldClockOffsetMs))
+}
+
+// ClockCorrectedEQ applies the EQ predicate on the "clock_corrected" field.
+func ClockCorrectedEQ(v bool) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldClockCorrected, v))
+}
+
+// ClockCorrectedNEQ applies the NEQ predicate on the "clock_corrected" field.
+func ClockCorrectedNEQ(v bool) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldClockCorrected, v))
+}
+
+// CampaignIDEQ applies the EQ predicate on the "campaign_id" field.
+func CampaignIDEQ(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldCampaignID, v))
+}
+
+// CampaignIDNEQ applies the NEQ predicate on the "campaign_id" field.
+func CampaignIDNEQ(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldCampaignID, v))
+}
+
+// CampaignIDIn applies the In predicate on the "campaign_id" field.
+func CampaignIDIn(vs ...int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDNotIn applies the NotIn predicate on the "campaign_id" field.
+func CampaignIDNotIn(vs ...int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotIn(FieldCampaignID, vs...))
+}
+
+// CampaignIDGT applies the GT predicate on the "campaign_id" field.
+func CampaignIDGT(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGT(FieldCampaignID, v))
+}
+
+// CampaignIDGTE applies the GTE predicate on the "campaign_id" field.
+func CampaignIDGTE(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldGTE(FieldCampaignID, v))
+}
+
+// CampaignIDLT applies the LT predicate on the "campaign_id" field.
+func CampaignIDLT(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLT(FieldCampaignID, v))
+}
+
+// CampaignIDLTE applies the LTE predicate on the "campaign_id" field.
+func CampaignIDLTE(v int) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldLTE(FieldCampaignID, v))
+}
+
+// CampaignIDIsNil applies the IsNil predicate on the "campaign_id" field.
+func CampaignIDIsNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldIsNull(FieldCampaignID))
+}
+
+// CampaignIDNotNil applies the NotNil predicate on the "campaign_id" field.
+func CampaignIDNotNil() predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNotNull(FieldCampaignID))
+}
+
+// QuarantinedEQ applies the EQ predicate on the "quarantined" field.
+func QuarantinedEQ(v bool) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldEQ(FieldQuarantined, v))
+}
+
+// QuarantinedNEQ applies the NEQ predicate on the "quarantined" field.
+func QuarantinedNEQ(v bool) predicate.SpeedTest {
+	return predicate.SpeedTest(sql.FieldNEQ(FieldQuarantined, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SpeedTest) predicate.SpeedTest {
 	return predicate.SpeedTest(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/google/uuid"
 )
 
 // SpeedTestCreate is the builder for creating a SpeedTest entity.
@@ -150,6 +151,104 @@ func (stc *SpeedTestCreate) SetNillableDaemonID(s *string) *SpeedTestCreate {
 	return stc
 }
 
+// SetSubmissionID sets the "submission_id" field.
+func (stc *SpeedTestCreate) SetSubmissionID(u uuid.UUID) *SpeedTestCreate {
+	stc.mutation.SetSubmissionID(u)
+	return stc
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableSubmissionID(u *uuid.UUID) *SpeedTestCreate {
+	if u != nil {
+		stc.SetSubmissionID(*u)
+	}
+	return stc
+}
+
+// SetTrigger sets the "trigger" field.
+func (stc *SpeedTestCreate) SetTrigger(s speedtest.Trigger) *SpeedTestCreate {
+	stc.mutation.SetTrigger(s)
+	return stc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableTrigger(s *speedtest.Trigger) *SpeedTestCreate {
+	if s != nil {
+		stc.SetTrigger(*s)
+	}
+	return stc
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (stc *SpeedTestCreate) SetReceivedAt(t time.Time) *SpeedTestCreate {
+	stc.mutation.SetReceivedAt(t)
+	return stc
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableReceivedAt(t *time.Time) *SpeedTestCreate {
+	if t != nil {
+		stc.SetReceivedAt(*t)
+	}
+	return stc
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (stc *SpeedTestCreate) SetClockOffsetMs(i int64) *SpeedTestCreate {
+	stc.mutation.SetClockOffsetMs(i)
+	return stc
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableClockOffsetMs(i *int64) *SpeedTestCreate {
+	if i != nil {
+		stc.SetClockOffsetMs(*i)
+	}
+	return stc
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (stc *SpeedTestCreate) SetClockCorrected(b bool) *SpeedTestCreate {
+	stc.mutation.SetClockCorrected(b)
+	return stc
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableClockCorrected(b *bool) *SpeedTestCreate {
+	if b != nil {
+		stc.SetClockCorrected(*b)
+	}
+	return stc
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (stc *SpeedTestCreate) SetCampaignID(i int) *SpeedTestCreate {
+	stc.mutation.SetCampaignID(i)
+	return stc
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableCampaignID(i *int) *SpeedTestCreate {
+	if i != nil {
+		stc.SetCampaignID(*i)
+	}
+	return stc
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (stc *SpeedTestCreate) SetQuarantined(b bool) *SpeedTestCreate {
+	stc.mutation.SetQuarantined(b)
+	return stc
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (stc *SpeedTestCreate) SetNillableQuarantined(b *bool) *SpeedTestCreate {
+	if b != nil {
+		stc.SetQuarantined(*b)
+	}
+	return stc
+}
+
 // Mutation returns the SpeedTestMutation object of the builder.
 func (stc *SpeedTestCreate) Mutation() *SpeedTestMutation {
 	return stc.mutation
@@ -189,6 +288,22 @@ func (stc *SpeedTestCreate) defaults() {
 		v := speedtest.DefaultTimestamp()
 		stc.mutation.SetTimestamp(v)
 	}
+	if _, ok := stc.mutation.Trigger(); !ok {
+		v := speedtest.DefaultTrigger
+		stc.mutation.SetTrigger(v)
+	}
+	if _, ok := stc.mutation.ReceivedAt(); !ok {
+		v := speedtest.DefaultReceivedAt()
+		stc.mutation.SetReceivedAt(v)
+	}
+	if _, ok := stc.mutation.ClockCorrected(); !ok {
+		v := speedtest.DefaultClockCorrected
+		stc.mutation.SetClockCorrected(v)
+	}
+	if _, ok := stc.mutation.Quarantined(); !ok {
+		v := speedtest.DefaultQuarantined
+		stc.mutation.SetQuarantined(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -205,6 +320,20 @@ func (stc *SpeedTestCreate) check() error {
 	if _, ok := stc.mutation.PingMs(); !ok {
 		return &ValidationError{Name: "ping_ms", err: errors.New(`ent: missing required field "SpeedTest.ping_ms"`)}
 	}
+	if _, ok := stc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "SpeedTest.trigger"`)}
+	}
+	if v, ok := stc.mutation.Trigger(); ok {
+		if err := speedtest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "SpeedTest.trigger": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.ClockCorrected(); !ok {
+		return &ValidationError{Name: "clock_corrected", err: errors.New(`ent: missing required field "SpeedTest.clock_corrected"`)}
+	}
+	if _, ok := stc.mutation.Quarantined(); !ok {
+		return &ValidationError{Name: "quarantined", err: errors.New(`ent: missing required field "SpeedTest.quarantined"`)}
+	}
 	return nil
 }
 
@@ -275,6 +404,34 @@ func (stc *SpeedTestCreate) createSpec() (*SpeedTest, *sqlgraph.CreateSpec) {
 		_spec.SetField(speedtest.FieldDaemonID, field.TypeString, value)
 		_node.DaemonID = value
 	}
+	if value, ok := stc.mutation.SubmissionID(); ok {
+		_spec.SetField(speedtest.FieldSubmissionID, field.TypeUUID, value)
+		_node.SubmissionID = &value
+	}
+	if value, ok := stc.mutation.Trigger(); ok {
+		_spec.SetField(speedtest.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := stc.mutation.ReceivedAt(); ok {
+		_spec.SetField(speedtest.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = &value
+	}
+	if value, ok := stc.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(speedtest.FieldClockOffsetMs, field.TypeInt64, value)
+		_node.ClockOffsetMs = &value
+	}
+	if value, ok := stc.mutation.ClockCorrected(); ok {
+		_spec.SetField(speedtest.FieldClockCorrected, field.TypeBool, value)
+		_node.ClockCorrected = value
+	}
+	if value, ok := stc.mutation.CampaignID(); ok {
+		_spec.SetField(speedtest.FieldCampaignID, field.TypeInt, value)
+		_node.CampaignID = &value
+	}
+	if value, ok := stc.mutation.Quarantined(); ok {
+		_spec.SetField(speedtest.FieldQuarantined, field.TypeBool, value)
+		_node.Quarantined = value
+	}
 	return _node, _spec
 }
 
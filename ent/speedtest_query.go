@@ -22,6 +22,7 @@ type SpeedTestQuery struct {
 	order      []speedtest.OrderOption
 	inters     []Interceptor
 	predicates []predicate.SpeedTest
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -251,8 +252,9 @@ func (stq *SpeedTestQuery) Clone() *SpeedTestQuery {
 		inters:     append([]Interceptor{}, stq.inters...),
 		predicates: append([]predicate.SpeedTest{}, stq.predicates...),
 		// clone intermediate query.
-		sql:  stq.sql.Clone(),
-		path: stq.path,
+		sql:       stq.sql.Clone(),
+		path:      stq.path,
+		modifiers: append([]func(*sql.Selector){}, stq.modifiers...),
 	}
 }
 
@@ -343,6 +345,9 @@ func (stq *SpeedTestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (stq *SpeedTestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S
 
 func (stq *SpeedTestQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := stq.querySpec()
+	if len(stq.modifiers) > 0 {
+		_spec.Modifiers = stq.modifiers
+	}
 	_spec.Node.Columns = stq.ctx.Fields
 	if len(stq.ctx.Fields) > 0 {
 		_spec.Unique = stq.ctx.Unique != nil && *stq.ctx.Unique
@@ -419,6 +427,9 @@ func (stq *SpeedTestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if stq.ctx.Unique != nil && *stq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range stq.modifiers {
+		m(selector)
+	}
 	for _, p := range stq.predicates {
 		p(selector)
 	}
@@ -436,6 +447,12 @@ func (stq *SpeedTestQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (stq *SpeedTestQuery) Modify(modifiers ...func(s *sql.Selector)) *SpeedTestSelect {
+	stq.modifiers = append(stq.modifiers, modifiers...)
+	return stq.Select()
+}
+
 // SpeedTestGroupBy is the group-by builder for SpeedTest entities.
 type SpeedTestGroupBy struct {
 	selector
@@ -525,3 +542,9 @@ func (sts *SpeedTestSelect) sqlScan(ctx context.Context, root *SpeedTestQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sts *SpeedTestSelect) Modify(modifiers ...func(s *sql.Selector)) *SpeedTestSelect {
+	sts.modifiers = append(sts.modifiers, modifiers...)
+	return sts
+}
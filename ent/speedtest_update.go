@@ -13,13 +13,15 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/bfirestone/speed-checker/ent/predicate"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/google/uuid"
 )
 
 // SpeedTestUpdate is the builder for updating SpeedTest entities.
 type SpeedTestUpdate struct {
 	config
-	hooks    []Hook
-	mutation *SpeedTestMutation
+	hooks     []Hook
+	mutation  *SpeedTestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the SpeedTestUpdate builder.
@@ -252,6 +254,122 @@ func (stu *SpeedTestUpdate) ClearDaemonID() *SpeedTestUpdate {
 	return stu
 }
 
+// SetSubmissionID sets the "submission_id" field.
+func (stu *SpeedTestUpdate) SetSubmissionID(u uuid.UUID) *SpeedTestUpdate {
+	stu.mutation.SetSubmissionID(u)
+	return stu
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (stu *SpeedTestUpdate) SetNillableSubmissionID(u *uuid.UUID) *SpeedTestUpdate {
+	if u != nil {
+		stu.SetSubmissionID(*u)
+	}
+	return stu
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (stu *SpeedTestUpdate) ClearSubmissionID() *SpeedTestUpdate {
+	stu.mutation.ClearSubmissionID()
+	return stu
+}
+
+// SetTrigger sets the "trigger" field.
+func (stu *SpeedTestUpdate) SetTrigger(s speedtest.Trigger) *SpeedTestUpdate {
+	stu.mutation.SetTrigger(s)
+	return stu
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (stu *SpeedTestUpdate) SetNillableTrigger(s *speedtest.Trigger) *SpeedTestUpdate {
+	if s != nil {
+		stu.SetTrigger(*s)
+	}
+	return stu
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (stu *SpeedTestUpdate) SetClockOffsetMs(i int64) *SpeedTestUpdate {
+	stu.mutation.ResetClockOffsetMs()
+	stu.mutation.SetClockOffsetMs(i)
+	return stu
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (stu *SpeedTestUpdate) SetNillableClockOffsetMs(i *int64) *SpeedTestUpdate {
+	if i != nil {
+		stu.SetClockOffsetMs(*i)
+	}
+	return stu
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (stu *SpeedTestUpdate) AddClockOffsetMs(i int64) *SpeedTestUpdate {
+	stu.mutation.AddClockOffsetMs(i)
+	return stu
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (stu *SpeedTestUpdate) ClearClockOffsetMs() *SpeedTestUpdate {
+	stu.mutation.ClearClockOffsetMs()
+	return stu
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (stu *SpeedTestUpdate) SetClockCorrected(b bool) *SpeedTestUpdate {
+	stu.mutation.SetClockCorrected(b)
+	return stu
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (stu *SpeedTestUpdate) SetNillableClockCorrected(b *bool) *SpeedTestUpdate {
+	if b != nil {
+		stu.SetClockCorrected(*b)
+	}
+	return stu
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (stu *SpeedTestUpdate) SetCampaignID(i int) *SpeedTestUpdate {
+	stu.mutation.ResetCampaignID()
+	stu.mutation.SetCampaignID(i)
+	return stu
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (stu *SpeedTestUpdate) SetNillableCampaignID(i *int) *SpeedTestUpdate {
+	if i != nil {
+		stu.SetCampaignID(*i)
+	}
+	return stu
+}
+
+// AddCampaignID adds i to the "campaign_id" field.
+func (stu *SpeedTestUpdate) AddCampaignID(i int) *SpeedTestUpdate {
+	stu.mutation.AddCampaignID(i)
+	return stu
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (stu *SpeedTestUpdate) ClearCampaignID() *SpeedTestUpdate {
+	stu.mutation.ClearCampaignID()
+	return stu
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (stu *SpeedTestUpdate) SetQuarantined(b bool) *SpeedTestUpdate {
+	stu.mutation.SetQuarantined(b)
+	return stu
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (stu *SpeedTestUpdate) SetNillableQuarantined(b *bool) *SpeedTestUpdate {
+	if b != nil {
+		stu.SetQuarantined(*b)
+	}
+	return stu
+}
+
 // Mutation returns the SpeedTestMutation object of the builder.
 func (stu *SpeedTestUpdate) Mutation() *SpeedTestMutation {
 	return stu.mutation
@@ -284,7 +402,26 @@ func (stu *SpeedTestUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (stu *SpeedTestUpdate) check() error {
+	if v, ok := stu.mutation.Trigger(); ok {
+		if err := speedtest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "SpeedTest.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (stu *SpeedTestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SpeedTestUpdate {
+	stu.modifiers = append(stu.modifiers, modifiers...)
+	return stu
+}
+
 func (stu *SpeedTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(speedtest.Table, speedtest.Columns, sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt))
 	if ps := stu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -359,6 +496,43 @@ func (stu *SpeedTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if stu.mutation.DaemonIDCleared() {
 		_spec.ClearField(speedtest.FieldDaemonID, field.TypeString)
 	}
+	if value, ok := stu.mutation.SubmissionID(); ok {
+		_spec.SetField(speedtest.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if stu.mutation.SubmissionIDCleared() {
+		_spec.ClearField(speedtest.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := stu.mutation.Trigger(); ok {
+		_spec.SetField(speedtest.FieldTrigger, field.TypeEnum, value)
+	}
+	if stu.mutation.ReceivedAtCleared() {
+		_spec.ClearField(speedtest.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := stu.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(speedtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if value, ok := stu.mutation.AddedClockOffsetMs(); ok {
+		_spec.AddField(speedtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if stu.mutation.ClockOffsetMsCleared() {
+		_spec.ClearField(speedtest.FieldClockOffsetMs, field.TypeInt64)
+	}
+	if value, ok := stu.mutation.ClockCorrected(); ok {
+		_spec.SetField(speedtest.FieldClockCorrected, field.TypeBool, value)
+	}
+	if value, ok := stu.mutation.CampaignID(); ok {
+		_spec.SetField(speedtest.FieldCampaignID, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedCampaignID(); ok {
+		_spec.AddField(speedtest.FieldCampaignID, field.TypeInt, value)
+	}
+	if stu.mutation.CampaignIDCleared() {
+		_spec.ClearField(speedtest.FieldCampaignID, field.TypeInt)
+	}
+	if value, ok := stu.mutation.Quarantined(); ok {
+		_spec.SetField(speedtest.FieldQuarantined, field.TypeBool, value)
+	}
+	_spec.AddModifiers(stu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{speedtest.Label}
@@ -374,9 +548,10 @@ func (stu *SpeedTestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // SpeedTestUpdateOne is the builder for updating a single SpeedTest entity.
 type SpeedTestUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *SpeedTestMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *SpeedTestMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTimestamp sets the "timestamp" field.
@@ -603,6 +778,122 @@ func (stuo *SpeedTestUpdateOne) ClearDaemonID() *SpeedTestUpdateOne {
 	return stuo
 }
 
+// SetSubmissionID sets the "submission_id" field.
+func (stuo *SpeedTestUpdateOne) SetSubmissionID(u uuid.UUID) *SpeedTestUpdateOne {
+	stuo.mutation.SetSubmissionID(u)
+	return stuo
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (stuo *SpeedTestUpdateOne) SetNillableSubmissionID(u *uuid.UUID) *SpeedTestUpdateOne {
+	if u != nil {
+		stuo.SetSubmissionID(*u)
+	}
+	return stuo
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (stuo *SpeedTestUpdateOne) ClearSubmissionID() *SpeedTestUpdateOne {
+	stuo.mutation.ClearSubmissionID()
+	return stuo
+}
+
+// SetTrigger sets the "trigger" field.
+func (stuo *SpeedTestUpdateOne) SetTrigger(s speedtest.Trigger) *SpeedTestUpdateOne {
+	stuo.mutation.SetTrigger(s)
+	return stuo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (stuo *SpeedTestUpdateOne) SetNillableTrigger(s *speedtest.Trigger) *SpeedTestUpdateOne {
+	if s != nil {
+		stuo.SetTrigger(*s)
+	}
+	return stuo
+}
+
+// SetClockOffsetMs sets the "clock_offset_ms" field.
+func (stuo *SpeedTestUpdateOne) SetClockOffsetMs(i int64) *SpeedTestUpdateOne {
+	stuo.mutation.ResetClockOffsetMs()
+	stuo.mutation.SetClockOffsetMs(i)
+	return stuo
+}
+
+// SetNillableClockOffsetMs sets the "clock_offset_ms" field if the given value is not nil.
+func (stuo *SpeedTestUpdateOne) SetNillableClockOffsetMs(i *int64) *SpeedTestUpdateOne {
+	if i != nil {
+		stuo.SetClockOffsetMs(*i)
+	}
+	return stuo
+}
+
+// AddClockOffsetMs adds i to the "clock_offset_ms" field.
+func (stuo *SpeedTestUpdateOne) AddClockOffsetMs(i int64) *SpeedTestUpdateOne {
+	stuo.mutation.AddClockOffsetMs(i)
+	return stuo
+}
+
+// ClearClockOffsetMs clears the value of the "clock_offset_ms" field.
+func (stuo *SpeedTestUpdateOne) ClearClockOffsetMs() *SpeedTestUpdateOne {
+	stuo.mutation.ClearClockOffsetMs()
+	return stuo
+}
+
+// SetClockCorrected sets the "clock_corrected" field.
+func (stuo *SpeedTestUpdateOne) SetClockCorrected(b bool) *SpeedTestUpdateOne {
+	stuo.mutation.SetClockCorrected(b)
+	return stuo
+}
+
+// SetNillableClockCorrected sets the "clock_corrected" field if the given value is not nil.
+func (stuo *SpeedTestUpdateOne) SetNillableClockCorrected(b *bool) *SpeedTestUpdateOne {
+	if b != nil {
+		stuo.SetClockCorrected(*b)
+	}
+	return stuo
+}
+
+// SetCampaignID sets the "campaign_id" field.
+func (stuo *SpeedTestUpdateOne) SetCampaignID(i int) *SpeedTestUpdateOne {
+	stuo.mutation.ResetCampaignID()
+	stuo.mutation.SetCampaignID(i)
+	return stuo
+}
+
+// SetNillableCampaignID sets the "campaign_id" field if the given value is not nil.
+func (stuo *SpeedTestUpdateOne) SetNillableCampaignID(i *int) *SpeedTestUpdateOne {
+	if i != nil {
+		stuo.SetCampaignID(*i)
+	}
+	return stuo
+}
+
+// AddCampaignID adds i to the "campaign_id" field.
+func (stuo *SpeedTestUpdateOne) AddCampaignID(i int) *SpeedTestUpdateOne {
+	stuo.mutation.AddCampaignID(i)
+	return stuo
+}
+
+// ClearCampaignID clears the value of the "campaign_id" field.
+func (stuo *SpeedTestUpdateOne) ClearCampaignID() *SpeedTestUpdateOne {
+	stuo.mutation.ClearCampaignID()
+	return stuo
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (stuo *SpeedTestUpdateOne) SetQuarantined(b bool) *SpeedTestUpdateOne {
+	stuo.mutation.SetQuarantined(b)
+	return stuo
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (stuo *SpeedTestUpdateOne) SetNillableQuarantined(b *bool) *SpeedTestUpdateOne {
+	if b != nil {
+		stuo.SetQuarantined(*b)
+	}
+	return stuo
+}
+
 // Mutation returns the SpeedTestMutation object of the builder.
 func (stuo *SpeedTestUpdateOne) Mutation() *SpeedTestMutation {
 	return stuo.mutation
@@ -648,7 +939,26 @@ func (stuo *SpeedTestUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (stuo *SpeedTestUpdateOne) check() error {
+	if v, ok := stuo.mutation.Trigger(); ok {
+		if err := speedtest.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "SpeedTest.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (stuo *SpeedTestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SpeedTestUpdateOne {
+	stuo.modifiers = append(stuo.modifiers, modifiers...)
+	return stuo
+}
+
 func (stuo *SpeedTestUpdateOne) sqlSave(ctx context.Context) (_node *SpeedTest, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(speedtest.Table, speedtest.Columns, sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt))
 	id, ok := stuo.mutation.ID()
 	if !ok {
@@ -740,6 +1050,43 @@ func (stuo *SpeedTestUpdateOne) sqlSave(ctx context.Context) (_node *SpeedTest,
 	if stuo.mutation.DaemonIDCleared() {
 		_spec.ClearField(speedtest.FieldDaemonID, field.TypeString)
 	}
+	if value, ok := stuo.mutation.SubmissionID(); ok {
+		_spec.SetField(speedtest.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if stuo.mutation.SubmissionIDCleared() {
+		_spec.ClearField(speedtest.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := stuo.mutation.Trigger(); ok {
+		_spec.SetField(speedtest.FieldTrigger, field.TypeEnum, value)
+	}
+	if stuo.mutation.ReceivedAtCleared() {
+		_spec.ClearField(speedtest.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := stuo.mutation.ClockOffsetMs(); ok {
+		_spec.SetField(speedtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if value, ok := stuo.mutation.AddedClockOffsetMs(); ok {
+		_spec.AddField(speedtest.FieldClockOffsetMs, field.TypeInt64, value)
+	}
+	if stuo.mutation.ClockOffsetMsCleared() {
+		_spec.ClearField(speedtest.FieldClockOffsetMs, field.TypeInt64)
+	}
+	if value, ok := stuo.mutation.ClockCorrected(); ok {
+		_spec.SetField(speedtest.FieldClockCorrected, field.TypeBool, value)
+	}
+	if value, ok := stuo.mutation.CampaignID(); ok {
+		_spec.SetField(speedtest.FieldCampaignID, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedCampaignID(); ok {
+		_spec.AddField(speedtest.FieldCampaignID, field.TypeInt, value)
+	}
+	if stuo.mutation.CampaignIDCleared() {
+		_spec.ClearField(speedtest.FieldCampaignID, field.TypeInt)
+	}
+	if value, ok := stuo.mutation.Quarantined(); ok {
+		_spec.SetField(speedtest.FieldQuarantined, field.TypeBool, value)
+	}
+	_spec.AddModifiers(stuo.modifiers...)
 	_node = &SpeedTest{config: stuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+	"github.com/google/uuid"
+)
+
+// TestRun is the model entity for the TestRun schema.
+type TestRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Identifier of the daemon that attempted the run
+	DaemonID string `json:"daemon_id,omitempty"`
+	// Client-generated ID used to deduplicate retried submissions
+	SubmissionID *uuid.UUID `json:"submission_id,omitempty"`
+	// Kind of test the run executed
+	Type testrun.Type `json:"type,omitempty"`
+	// What caused the run
+	Trigger testrun.Trigger `json:"trigger,omitempty"`
+	// How the run ended
+	Outcome testrun.Outcome `json:"outcome,omitempty"`
+	// When the daemon started the run
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Why the run failed, timed out, was skipped or aborted
+	ErrorMessage string `json:"error_message,omitempty"`
+	// HostID holds the value of the "host_id" field.
+	HostID *int `json:"host_id,omitempty"`
+	// SpeedTestID holds the value of the "speed_test_id" field.
+	SpeedTestID *int `json:"speed_test_id,omitempty"`
+	// IperfTestID holds the value of the "iperf_test_id" field.
+	IperfTestID *int `json:"iperf_test_id,omitempty"`
+	// Daemon whose iperf3 server a mesh run tested
+	TargetDaemonID string `json:"target_daemon_id,omitempty"`
+	// MeshTestID holds the value of the "mesh_test_id" field.
+	MeshTestID *int `json:"mesh_test_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TestRunQuery when eager-loading is set.
+	Edges        TestRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TestRunEdges holds the relations/edges for other nodes in the graph.
+type TestRunEdges struct {
+	// Target host for iperf runs
+	Host *Host `json:"host,omitempty"`
+	// Speed test result produced by the run
+	SpeedTest *SpeedTest `json:"speed_test,omitempty"`
+	// Iperf test result produced by the run
+	IperfTest *IperfTest `json:"iperf_test,omitempty"`
+	// Mesh test result produced by the run
+	MeshTest *MeshTest `json:"mesh_test,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// HostOrErr returns the Host value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestRunEdges) HostOrErr() (*Host, error) {
+	if e.Host != nil {
+		return e.Host, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: host.Label}
+	}
+	return nil, &NotLoadedError{edge: "host"}
+}
+
+// SpeedTestOrErr returns the SpeedTest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestRunEdges) SpeedTestOrErr() (*SpeedTest, error) {
+	if e.SpeedTest != nil {
+		return e.SpeedTest, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: speedtest.Label}
+	}
+	return nil, &NotLoadedError{edge: "speed_test"}
+}
+
+// IperfTestOrErr returns the IperfTest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestRunEdges) IperfTestOrErr() (*IperfTest, error) {
+	if e.IperfTest != nil {
+		return e.IperfTest, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: iperftest.Label}
+	}
+	return nil, &NotLoadedError{edge: "iperf_test"}
+}
+
+// MeshTestOrErr returns the MeshTest value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestRunEdges) MeshTestOrErr() (*MeshTest, error) {
+	if e.MeshTest != nil {
+		return e.MeshTest, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: meshtest.Label}
+	}
+	return nil, &NotLoadedError{edge: "mesh_test"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TestRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case testrun.FieldSubmissionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case testrun.FieldID, testrun.FieldHostID, testrun.FieldSpeedTestID, testrun.FieldIperfTestID, testrun.FieldMeshTestID:
+			values[i] = new(sql.NullInt64)
+		case testrun.FieldDaemonID, testrun.FieldType, testrun.FieldTrigger, testrun.FieldOutcome, testrun.FieldErrorMessage, testrun.FieldTargetDaemonID:
+			values[i] = new(sql.NullString)
+		case testrun.FieldStartedAt, testrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TestRun fields.
+func (tr *TestRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case testrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int(value.Int64)
+		case testrun.FieldDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field daemon_id", values[i])
+			} else if value.Valid {
+				tr.DaemonID = value.String
+			}
+		case testrun.FieldSubmissionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submission_id", values[i])
+			} else if value.Valid {
+				tr.SubmissionID = new(uuid.UUID)
+				*tr.SubmissionID = *value.S.(*uuid.UUID)
+			}
+		case testrun.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				tr.Type = testrun.Type(value.String)
+			}
+		case testrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				tr.Trigger = testrun.Trigger(value.String)
+			}
+		case testrun.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				tr.Outcome = testrun.Outcome(value.String)
+			}
+		case testrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				tr.StartedAt = value.Time
+			}
+		case testrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				tr.FinishedAt = value.Time
+			}
+		case testrun.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				tr.ErrorMessage = value.String
+			}
+		case testrun.FieldHostID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field host_id", values[i])
+			} else if value.Valid {
+				tr.HostID = new(int)
+				*tr.HostID = int(value.Int64)
+			}
+		case testrun.FieldSpeedTestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field speed_test_id", values[i])
+			} else if value.Valid {
+				tr.SpeedTestID = new(int)
+				*tr.SpeedTestID = int(value.Int64)
+			}
+		case testrun.FieldIperfTestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field iperf_test_id", values[i])
+			} else if value.Valid {
+				tr.IperfTestID = new(int)
+				*tr.IperfTestID = int(value.Int64)
+			}
+		case testrun.FieldTargetDaemonID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_daemon_id", values[i])
+			} else if value.Valid {
+				tr.TargetDaemonID = value.String
+			}
+		case testrun.FieldMeshTestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mesh_test_id", values[i])
+			} else if value.Valid {
+				tr.MeshTestID = new(int)
+				*tr.MeshTestID = int(value.Int64)
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TestRun.
+// This includes values selected through modifiers, order, etc.
+func (tr *TestRun) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// QueryHost queries the "host" edge of the TestRun entity.
+func (tr *TestRun) QueryHost() *HostQuery {
+	return NewTestRunClient(tr.config).QueryHost(tr)
+}
+
+// QuerySpeedTest queries the "speed_test" edge of the TestRun entity.
+func (tr *TestRun) QuerySpeedTest() *SpeedTestQuery {
+	return NewTestRunClient(tr.config).QuerySpeedTest(tr)
+}
+
+// QueryIperfTest queries the "iperf_test" edge of the TestRun entity.
+func (tr *TestRun) QueryIperfTest() *IperfTestQuery {
+	return NewTestRunClient(tr.config).QueryIperfTest(tr)
+}
+
+// QueryMeshTest queries the "mesh_test" edge of the TestRun entity.
+func (tr *TestRun) QueryMeshTest() *MeshTestQuery {
+	return NewTestRunClient(tr.config).QueryMeshTest(tr)
+}
+
+// Update returns a builder for updating this TestRun.
+// Note that you need to call TestRun.Unwrap() before calling this method if this TestRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TestRun) Update() *TestRunUpdateOne {
+	return NewTestRunClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TestRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TestRun) Unwrap() *TestRun {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TestRun is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TestRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TestRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("daemon_id=")
+	builder.WriteString(tr.DaemonID)
+	builder.WriteString(", ")
+	if v := tr.SubmissionID; v != nil {
+		builder.WriteString("submission_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Type))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Outcome))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(tr.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(tr.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(tr.ErrorMessage)
+	builder.WriteString(", ")
+	if v := tr.HostID; v != nil {
+		builder.WriteString("host_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tr.SpeedTestID; v != nil {
+		builder.WriteString("speed_test_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := tr.IperfTestID; v != nil {
+		builder.WriteString("iperf_test_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_daemon_id=")
+	builder.WriteString(tr.TargetDaemonID)
+	builder.WriteString(", ")
+	if v := tr.MeshTestID; v != nil {
+		builder.WriteString("mesh_test_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TestRuns is a parsable slice of TestRun.
+type TestRuns []*TestRun
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testrun
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the testrun type in the database.
+	Label = "test_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDaemonID holds the string denoting the daemon_id field in the database.
+	FieldDaemonID = "daemon_id"
+	// FieldSubmissionID holds the string denoting the submission_id field in the database.
+	FieldSubmissionID = "submission_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldOutcome holds the string denoting the outcome field in the database.
+	FieldOutcome = "outcome"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldHostID holds the string denoting the host_id field in the database.
+	FieldHostID = "host_id"
+	// FieldSpeedTestID holds the string denoting the speed_test_id field in the database.
+	FieldSpeedTestID = "speed_test_id"
+	// FieldIperfTestID holds the string denoting the iperf_test_id field in the database.
+	FieldIperfTestID = "iperf_test_id"
+	// FieldTargetDaemonID holds the string denoting the target_daemon_id field in the database.
+	FieldTargetDaemonID = "target_daemon_id"
+	// FieldMeshTestID holds the string denoting the mesh_test_id field in the database.
+	FieldMeshTestID = "mesh_test_id"
+	// EdgeHost holds the string denoting the host edge name in mutations.
+	EdgeHost = "host"
+	// EdgeSpeedTest holds the string denoting the speed_test edge name in mutations.
+	EdgeSpeedTest = "speed_test"
+	// EdgeIperfTest holds the string denoting the iperf_test edge name in mutations.
+	EdgeIperfTest = "iperf_test"
+	// EdgeMeshTest holds the string denoting the mesh_test edge name in mutations.
+	EdgeMeshTest = "mesh_test"
+	// Table holds the table name of the testrun in the database.
+	Table = "test_runs"
+	// HostTable is the table that holds the host relation/edge.
+	HostTable = "test_runs"
+	// HostInverseTable is the table name for the Host entity.
+	// It exists in this package in order to avoid circular dependency with the "host" package.
+	HostInverseTable = "hosts"
+	// HostColumn is the table column denoting the host relation/edge.
+	HostColumn = "host_id"
+	// SpeedTestTable is the table that holds the speed_test relation/edge.
+	SpeedTestTable = "test_runs"
+	// SpeedTestInverseTable is the table name for the SpeedTest entity.
+	// It exists in this package in order to avoid circular dependency with the "speedtest" package.
+	SpeedTestInverseTable = "speed_tests"
+	// SpeedTestColumn is the table column denoting the speed_test relation/edge.
+	SpeedTestColumn = "speed_test_id"
+	// IperfTestTable is the table that holds the iperf_test relation/edge.
+	IperfTestTable = "test_runs"
+	// IperfTestInverseTable is the table name for the IperfTest entity.
+	// It exists in this package in order to avoid circular dependency with the "iperftest" package.
+	IperfTestInverseTable = "iperf_tests"
+	// IperfTestColumn is the table column denoting the iperf_test relation/edge.
+	IperfTestColumn = "iperf_test_id"
+	// MeshTestTable is the table that holds the mesh_test relation/edge.
+	MeshTestTable = "test_runs"
+	// MeshTestInverseTable is the table name for the MeshTest entity.
+	// It exists in this package in order to avoid circular dependency with the "meshtest" package.
+	MeshTestInverseTable = "mesh_tests"
+	// MeshTestColumn is the table column denoting the mesh_test relation/edge.
+	MeshTestColumn = "mesh_test_id"
+)
+
+// Columns holds all SQL columns for testrun fields.
+var Columns = []string{
+	FieldID,
+	FieldDaemonID,
+	FieldSubmissionID,
+	FieldType,
+	FieldTrigger,
+	FieldOutcome,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldErrorMessage,
+	FieldHostID,
+	FieldSpeedTestID,
+	FieldIperfTestID,
+	FieldTargetDaemonID,
+	FieldMeshTestID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeSpeedtest Type = "speedtest"
+	TypeIperf     Type = "iperf"
+	TypeMesh      Type = "mesh"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeSpeedtest, TypeIperf, TypeMesh:
+		return nil
+	default:
+		return fmt.Errorf("testrun: invalid enum value for type field: %q", _type)
+	}
+}
+
+// Trigger defines the type for the "trigger" enum field.
+type Trigger string
+
+// TriggerScheduled is the default value of the Trigger enum.
+const DefaultTrigger = TriggerScheduled
+
+// Trigger values.
+const (
+	TriggerScheduled Trigger = "scheduled"
+	TriggerManual    Trigger = "manual"
+	TriggerAdaptive  Trigger = "adaptive"
+)
+
+func (t Trigger) String() string {
+	return string(t)
+}
+
+// TriggerValidator is a validator for the "trigger" field enum values. It is called by the builders before save.
+func TriggerValidator(t Trigger) error {
+	switch t {
+	case TriggerScheduled, TriggerManual, TriggerAdaptive:
+		return nil
+	default:
+		return fmt.Errorf("testrun: invalid enum value for trigger field: %q", t)
+	}
+}
+
+// Outcome defines the type for the "outcome" enum field.
+type Outcome string
+
+// Outcome values.
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailed  Outcome = "failed"
+	OutcomeSkipped Outcome = "skipped"
+	OutcomeTimeout Outcome = "timeout"
+	OutcomeAborted Outcome = "aborted"
+	OutcomeSpooled Outcome = "spooled"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// OutcomeValidator is a validator for the "outcome" field enum values. It is called by the builders before save.
+func OutcomeValidator(o Outcome) error {
+	switch o {
+	case OutcomeSuccess, OutcomeFailed, OutcomeSkipped, OutcomeTimeout, OutcomeAborted, OutcomeSpooled:
+		return nil
+	default:
+		return fmt.Errorf("testrun: invalid enum value for outcome field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the TestRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDaemonID orders the results by the daemon_id field.
+func ByDaemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDaemonID, opts...).ToFunc()
+}
+
+// BySubmissionID orders the results by the submission_id field.
+func BySubmissionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmissionID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByOutcome orders the results by the outcome field.
+func ByOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcome, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByHostID orders the results by the host_id field.
+func ByHostID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostID, opts...).ToFunc()
+}
+
+// BySpeedTestID orders the results by the speed_test_id field.
+func BySpeedTestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpeedTestID, opts...).ToFunc()
+}
+
+// ByIperfTestID orders the results by the iperf_test_id field.
+func ByIperfTestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIperfTestID, opts...).ToFunc()
+}
+
+// ByTargetDaemonID orders the results by the target_daemon_id field.
+func ByTargetDaemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetDaemonID, opts...).ToFunc()
+}
+
+// ByMeshTestID orders the results by the mesh_test_id field.
+func ByMeshTestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeshTestID, opts...).ToFunc()
+}
+
+// ByHostField orders the results by host field.
+func ByHostField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHostStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySpeedTestField orders the results by speed_test field.
+func BySpeedTestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSpeedTestStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIperfTestField orders the results by iperf_test field.
+func ByIperfTestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIperfTestStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMeshTestField orders the results by mesh_test field.
+func ByMeshTestField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMeshTestStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHostStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HostInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HostTable, HostColumn),
+	)
+}
+func newSpeedTestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SpeedTestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SpeedTestTable, SpeedTestColumn),
+	)
+}
+func newIperfTestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IperfTestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, IperfTestTable, IperfTestColumn),
+	)
+}
+func newMeshTestStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MeshTestInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MeshTestTable, MeshTestColumn),
+	)
+}
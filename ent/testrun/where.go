@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldID, id))
+}
+
+// DaemonID applies equality check predicate on the "daemon_id" field. It's identical to DaemonIDEQ.
+func DaemonID(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldDaemonID, v))
+}
+
+// SubmissionID applies equality check predicate on the "submission_id" field. It's identical to SubmissionIDEQ.
+func SubmissionID(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// HostID applies equality check predicate on the "host_id" field. It's identical to HostIDEQ.
+func HostID(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldHostID, v))
+}
+
+// SpeedTestID applies equality check predicate on the "speed_test_id" field. It's identical to SpeedTestIDEQ.
+func SpeedTestID(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldSpeedTestID, v))
+}
+
+// IperfTestID applies equality check predicate on the "iperf_test_id" field. It's identical to IperfTestIDEQ.
+func IperfTestID(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldIperfTestID, v))
+}
+
+// TargetDaemonID applies equality check predicate on the "target_daemon_id" field. It's identical to TargetDaemonIDEQ.
+func TargetDaemonID(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldTargetDaemonID, v))
+}
+
+// MeshTestID applies equality check predicate on the "mesh_test_id" field. It's identical to MeshTestIDEQ.
+func MeshTestID(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldMeshTestID, v))
+}
+
+// DaemonIDEQ applies the EQ predicate on the "daemon_id" field.
+func DaemonIDEQ(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldDaemonID, v))
+}
+
+// DaemonIDNEQ applies the NEQ predicate on the "daemon_id" field.
+func DaemonIDNEQ(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldDaemonID, v))
+}
+
+// DaemonIDIn applies the In predicate on the "daemon_id" field.
+func DaemonIDIn(vs ...string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldDaemonID, vs...))
+}
+
+// DaemonIDNotIn applies the NotIn predicate on the "daemon_id" field.
+func DaemonIDNotIn(vs ...string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldDaemonID, vs...))
+}
+
+// DaemonIDGT applies the GT predicate on the "daemon_id" field.
+func DaemonIDGT(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldDaemonID, v))
+}
+
+// DaemonIDGTE applies the GTE predicate on the "daemon_id" field.
+func DaemonIDGTE(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldDaemonID, v))
+}
+
+// DaemonIDLT applies the LT predicate on the "daemon_id" field.
+func DaemonIDLT(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldDaemonID, v))
+}
+
+// DaemonIDLTE applies the LTE predicate on the "daemon_id" field.
+func DaemonIDLTE(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldDaemonID, v))
+}
+
+// DaemonIDContains applies the Contains predicate on the "daemon_id" field.
+func DaemonIDContains(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldContains(FieldDaemonID, v))
+}
+
+// DaemonIDHasPrefix applies the HasPrefix predicate on the "daemon_id" field.
+func DaemonIDHasPrefix(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldHasPrefix(FieldDaemonID, v))
+}
+
+// DaemonIDHasSuffix applies the HasSuffix predicate on the "daemon_id" field.
+func DaemonIDHasSuffix(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldHasSuffix(FieldDaemonID, v))
+}
+
+// DaemonIDEqualFold applies the EqualFold predicate on the "daemon_id" field.
+func DaemonIDEqualFold(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEqualFold(FieldDaemonID, v))
+}
+
+// DaemonIDContainsFold applies the ContainsFold predicate on the "daemon_id" field.
+func DaemonIDContainsFold(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldContainsFold(FieldDaemonID, v))
+}
+
+// SubmissionIDEQ applies the EQ predicate on the "submission_id" field.
+func SubmissionIDEQ(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDNEQ applies the NEQ predicate on the "submission_id" field.
+func SubmissionIDNEQ(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldSubmissionID, v))
+}
+
+// SubmissionIDIn applies the In predicate on the "submission_id" field.
+func SubmissionIDIn(vs ...uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDNotIn applies the NotIn predicate on the "submission_id" field.
+func SubmissionIDNotIn(vs ...uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldSubmissionID, vs...))
+}
+
+// SubmissionIDGT applies the GT predicate on the "submission_id" field.
+func SubmissionIDGT(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldSubmissionID, v))
+}
+
+// SubmissionIDGTE applies the GTE predicate on the "submission_id" field.
+func SubmissionIDGTE(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDLT applies the LT predicate on the "submission_id" field.
+func SubmissionIDLT(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldSubmissionID, v))
+}
+
+// SubmissionIDLTE applies the LTE predicate on the "submission_id" field.
+func SubmissionIDLTE(v uuid.UUID) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldSubmissionID, v))
+}
+
+// SubmissionIDIsNil applies the IsNil predicate on the "submission_id" field.
+func SubmissionIDIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldSubmissionID))
+}
+
+// SubmissionIDNotNil applies the NotNil predicate on the "submission_id" field.
+func SubmissionIDNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldSubmissionID))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// HostIDEQ applies the EQ predicate on the "host_id" field.
+func HostIDEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldHostID, v))
+}
+
+// HostIDNEQ applies the NEQ predicate on the "host_id" field.
+func HostIDNEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldHostID, v))
+}
+
+// HostIDIn applies the In predicate on the "host_id" field.
+func HostIDIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldHostID, vs...))
+}
+
+// HostIDNotIn applies the NotIn predicate on the "host_id" field.
+func HostIDNotIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldHostID, vs...))
+}
+
+// HostIDIsNil applies the IsNil predicate on the "host_id" field.
+func HostIDIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldHostID))
+}
+
+// HostIDNotNil applies the NotNil predicate on the "host_id" field.
+func HostIDNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldHostID))
+}
+
+// SpeedTestIDEQ applies the EQ predicate on the "speed_test_id" field.
+func SpeedTestIDEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldSpeedTestID, v))
+}
+
+// SpeedTestIDNEQ applies the NEQ predicate on the "speed_test_id" field.
+func SpeedTestIDNEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldSpeedTestID, v))
+}
+
+// SpeedTestIDIn applies the In predicate on the "speed_test_id" field.
+func SpeedTestIDIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldSpeedTestID, vs...))
+}
+
+// SpeedTestIDNotIn applies the NotIn predicate on the "speed_test_id" field.
+func SpeedTestIDNotIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldSpeedTestID, vs...))
+}
+
+// SpeedTestIDIsNil applies the IsNil predicate on the "speed_test_id" field.
+func SpeedTestIDIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldSpeedTestID))
+}
+
+// SpeedTestIDNotNil applies the NotNil predicate on the "speed_test_id" field.
+func SpeedTestIDNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldSpeedTestID))
+}
+
+// IperfTestIDEQ applies the EQ predicate on the "iperf_test_id" field.
+func IperfTestIDEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldIperfTestID, v))
+}
+
+// IperfTestIDNEQ applies the NEQ predicate on the "iperf_test_id" field.
+func IperfTestIDNEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldIperfTestID, v))
+}
+
+// IperfTestIDIn applies the In predicate on the "iperf_test_id" field.
+func IperfTestIDIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldIperfTestID, vs...))
+}
+
+// IperfTestIDNotIn applies the NotIn predicate on the "iperf_test_id" field.
+func IperfTestIDNotIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldIperfTestID, vs...))
+}
+
+// IperfTestIDIsNil applies the IsNil predicate on the "iperf_test_id" field.
+func IperfTestIDIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldIperfTestID))
+}
+
+// IperfTestIDNotNil applies the NotNil predicate on the "iperf_test_id" field.
+func IperfTestIDNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldIperfTestID))
+}
+
+// TargetDaemonIDEQ applies the EQ predicate on the "target_daemon_id" field.
+func TargetDaemonIDEQ(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDNEQ applies the NEQ predicate on the "target_daemon_id" field.
+func TargetDaemonIDNEQ(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDIn applies the In predicate on the "target_daemon_id" field.
+func TargetDaemonIDIn(vs ...string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldTargetDaemonID, vs...))
+}
+
+// TargetDaemonIDNotIn applies the NotIn predicate on the "target_daemon_id" field.
+func TargetDaemonIDNotIn(vs ...string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldTargetDaemonID, vs...))
+}
+
+// TargetDaemonIDGT applies the GT predicate on the "target_daemon_id" field.
+func TargetDaemonIDGT(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGT(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDGTE applies the GTE predicate on the "target_daemon_id" field.
+func TargetDaemonIDGTE(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldGTE(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDLT applies the LT predicate on the "target_daemon_id" field.
+func TargetDaemonIDLT(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLT(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDLTE applies the LTE predicate on the "target_daemon_id" field.
+func TargetDaemonIDLTE(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldLTE(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDContains applies the Contains predicate on the "target_daemon_id" field.
+func TargetDaemonIDContains(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldContains(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDHasPrefix applies the HasPrefix predicate on the "target_daemon_id" field.
+func TargetDaemonIDHasPrefix(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldHasPrefix(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDHasSuffix applies the HasSuffix predicate on the "target_daemon_id" field.
+func TargetDaemonIDHasSuffix(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldHasSuffix(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDIsNil applies the IsNil predicate on the "target_daemon_id" field.
+func TargetDaemonIDIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldTargetDaemonID))
+}
+
+// TargetDaemonIDNotNil applies the NotNil predicate on the "target_daemon_id" field.
+func TargetDaemonIDNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldTargetDaemonID))
+}
+
+// TargetDaemonIDEqualFold applies the EqualFold predicate on the "target_daemon_id" field.
+func TargetDaemonIDEqualFold(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEqualFold(FieldTargetDaemonID, v))
+}
+
+// TargetDaemonIDContainsFold applies the ContainsFold predicate on the "target_daemon_id" field.
+func TargetDaemonIDContainsFold(v string) predicate.TestRun {
+	return predicate.TestRun(sql.FieldContainsFold(FieldTargetDaemonID, v))
+}
+
+// MeshTestIDEQ applies the EQ predicate on the "mesh_test_id" field.
+func MeshTestIDEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldEQ(FieldMeshTestID, v))
+}
+
+// MeshTestIDNEQ applies the NEQ predicate on the "mesh_test_id" field.
+func MeshTestIDNEQ(v int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNEQ(FieldMeshTestID, v))
+}
+
+// MeshTestIDIn applies the In predicate on the "mesh_test_id" field.
+func MeshTestIDIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldIn(FieldMeshTestID, vs...))
+}
+
+// MeshTestIDNotIn applies the NotIn predicate on the "mesh_test_id" field.
+func MeshTestIDNotIn(vs ...int) predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotIn(FieldMeshTestID, vs...))
+}
+
+// MeshTestIDIsNil applies the IsNil predicate on the "mesh_test_id" field.
+func MeshTestIDIsNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldIsNull(FieldMeshTestID))
+}
+
+// MeshTestIDNotNil applies the NotNil predicate on the "mesh_test_id" field.
+func MeshTestIDNotNil() predicate.TestRun {
+	return predicate.TestRun(sql.FieldNotNull(FieldMeshTestID))
+}
+
+// HasHost applies the HasEdge predicate on the "host" edge.
+func HasHost() predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HostTable, HostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHostWith applies the HasEdge predicate on the "host" edge with a given conditions (other predicates).
+func HasHostWith(preds ...predicate.Host) predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := newHostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSpeedTest applies the HasEdge predicate on the "speed_test" edge.
+func HasSpeedTest() predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SpeedTestTable, SpeedTestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpeedTestWith applies the HasEdge predicate on the "speed_test" edge with a given conditions (other predicates).
+func HasSpeedTestWith(preds ...predicate.SpeedTest) predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := newSpeedTestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIperfTest applies the HasEdge predicate on the "iperf_test" edge.
+func HasIperfTest() predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, IperfTestTable, IperfTestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIperfTestWith applies the HasEdge predicate on the "iperf_test" edge with a given conditions (other predicates).
+func HasIperfTestWith(preds ...predicate.IperfTest) predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := newIperfTestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMeshTest applies the HasEdge predicate on the "mesh_test" edge.
+func HasMeshTest() predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MeshTestTable, MeshTestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMeshTestWith applies the HasEdge predicate on the "mesh_test" edge with a given conditions (other predicates).
+func HasMeshTestWith(preds ...predicate.MeshTest) predicate.TestRun {
+	return predicate.TestRun(func(s *sql.Selector) {
+		step := newMeshTestStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TestRun) predicate.TestRun {
+	return predicate.TestRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TestRun) predicate.TestRun {
+	return predicate.TestRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TestRun) predicate.TestRun {
+	return predicate.TestRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+	"github.com/google/uuid"
+)
+
+// TestRunCreate is the builder for creating a TestRun entity.
+type TestRunCreate struct {
+	config
+	mutation *TestRunMutation
+	hooks    []Hook
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (trc *TestRunCreate) SetDaemonID(s string) *TestRunCreate {
+	trc.mutation.SetDaemonID(s)
+	return trc
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (trc *TestRunCreate) SetSubmissionID(u uuid.UUID) *TestRunCreate {
+	trc.mutation.SetSubmissionID(u)
+	return trc
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableSubmissionID(u *uuid.UUID) *TestRunCreate {
+	if u != nil {
+		trc.SetSubmissionID(*u)
+	}
+	return trc
+}
+
+// SetType sets the "type" field.
+func (trc *TestRunCreate) SetType(t testrun.Type) *TestRunCreate {
+	trc.mutation.SetType(t)
+	return trc
+}
+
+// SetTrigger sets the "trigger" field.
+func (trc *TestRunCreate) SetTrigger(t testrun.Trigger) *TestRunCreate {
+	trc.mutation.SetTrigger(t)
+	return trc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableTrigger(t *testrun.Trigger) *TestRunCreate {
+	if t != nil {
+		trc.SetTrigger(*t)
+	}
+	return trc
+}
+
+// SetOutcome sets the "outcome" field.
+func (trc *TestRunCreate) SetOutcome(t testrun.Outcome) *TestRunCreate {
+	trc.mutation.SetOutcome(t)
+	return trc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (trc *TestRunCreate) SetStartedAt(t time.Time) *TestRunCreate {
+	trc.mutation.SetStartedAt(t)
+	return trc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (trc *TestRunCreate) SetFinishedAt(t time.Time) *TestRunCreate {
+	trc.mutation.SetFinishedAt(t)
+	return trc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (trc *TestRunCreate) SetErrorMessage(s string) *TestRunCreate {
+	trc.mutation.SetErrorMessage(s)
+	return trc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableErrorMessage(s *string) *TestRunCreate {
+	if s != nil {
+		trc.SetErrorMessage(*s)
+	}
+	return trc
+}
+
+// SetHostID sets the "host_id" field.
+func (trc *TestRunCreate) SetHostID(i int) *TestRunCreate {
+	trc.mutation.SetHostID(i)
+	return trc
+}
+
+// SetNillableHostID sets the "host_id" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableHostID(i *int) *TestRunCreate {
+	if i != nil {
+		trc.SetHostID(*i)
+	}
+	return trc
+}
+
+// SetSpeedTestID sets the "speed_test_id" field.
+func (trc *TestRunCreate) SetSpeedTestID(i int) *TestRunCreate {
+	trc.mutation.SetSpeedTestID(i)
+	return trc
+}
+
+// SetNillableSpeedTestID sets the "speed_test_id" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableSpeedTestID(i *int) *TestRunCreate {
+	if i != nil {
+		trc.SetSpeedTestID(*i)
+	}
+	return trc
+}
+
+// SetIperfTestID sets the "iperf_test_id" field.
+func (trc *TestRunCreate) SetIperfTestID(i int) *TestRunCreate {
+	trc.mutation.SetIperfTestID(i)
+	return trc
+}
+
+// SetNillableIperfTestID sets the "iperf_test_id" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableIperfTestID(i *int) *TestRunCreate {
+	if i != nil {
+		trc.SetIperfTestID(*i)
+	}
+	return trc
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (trc *TestRunCreate) SetTargetDaemonID(s string) *TestRunCreate {
+	trc.mutation.SetTargetDaemonID(s)
+	return trc
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableTargetDaemonID(s *string) *TestRunCreate {
+	if s != nil {
+		trc.SetTargetDaemonID(*s)
+	}
+	return trc
+}
+
+// SetMeshTestID sets the "mesh_test_id" field.
+func (trc *TestRunCreate) SetMeshTestID(i int) *TestRunCreate {
+	trc.mutation.SetMeshTestID(i)
+	return trc
+}
+
+// SetNillableMeshTestID sets the "mesh_test_id" field if the given value is not nil.
+func (trc *TestRunCreate) SetNillableMeshTestID(i *int) *TestRunCreate {
+	if i != nil {
+		trc.SetMeshTestID(*i)
+	}
+	return trc
+}
+
+// SetHost sets the "host" edge to the Host entity.
+func (trc *TestRunCreate) SetHost(h *Host) *TestRunCreate {
+	return trc.SetHostID(h.ID)
+}
+
+// SetSpeedTest sets the "speed_test" edge to the SpeedTest entity.
+func (trc *TestRunCreate) SetSpeedTest(s *SpeedTest) *TestRunCreate {
+	return trc.SetSpeedTestID(s.ID)
+}
+
+// SetIperfTest sets the "iperf_test" edge to the IperfTest entity.
+func (trc *TestRunCreate) SetIperfTest(i *IperfTest) *TestRunCreate {
+	return trc.SetIperfTestID(i.ID)
+}
+
+// SetMeshTest sets the "mesh_test" edge to the MeshTest entity.
+func (trc *TestRunCreate) SetMeshTest(m *MeshTest) *TestRunCreate {
+	return trc.SetMeshTestID(m.ID)
+}
+
+// Mutation returns the TestRunMutation object of the builder.
+func (trc *TestRunCreate) Mutation() *TestRunMutation {
+	return trc.mutation
+}
+
+// Save creates the TestRun in the database.
+func (trc *TestRunCreate) Save(ctx context.Context) (*TestRun, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TestRunCreate) SaveX(ctx context.Context) *TestRun {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TestRunCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TestRunCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TestRunCreate) defaults() {
+	if _, ok := trc.mutation.Trigger(); !ok {
+		v := testrun.DefaultTrigger
+		trc.mutation.SetTrigger(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TestRunCreate) check() error {
+	if _, ok := trc.mutation.DaemonID(); !ok {
+		return &ValidationError{Name: "daemon_id", err: errors.New(`ent: missing required field "TestRun.daemon_id"`)}
+	}
+	if _, ok := trc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TestRun.type"`)}
+	}
+	if v, ok := trc.mutation.GetType(); ok {
+		if err := testrun.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TestRun.type": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "TestRun.trigger"`)}
+	}
+	if v, ok := trc.mutation.Trigger(); ok {
+		if err := testrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TestRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "TestRun.outcome"`)}
+	}
+	if v, ok := trc.mutation.Outcome(); ok {
+		if err := testrun.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "TestRun.outcome": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "TestRun.started_at"`)}
+	}
+	if _, ok := trc.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`ent: missing required field "TestRun.finished_at"`)}
+	}
+	return nil
+}
+
+func (trc *TestRunCreate) sqlSave(ctx context.Context) (*TestRun, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TestRunCreate) createSpec() (*TestRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TestRun{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(testrun.Table, sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt))
+	)
+	if value, ok := trc.mutation.DaemonID(); ok {
+		_spec.SetField(testrun.FieldDaemonID, field.TypeString, value)
+		_node.DaemonID = value
+	}
+	if value, ok := trc.mutation.SubmissionID(); ok {
+		_spec.SetField(testrun.FieldSubmissionID, field.TypeUUID, value)
+		_node.SubmissionID = &value
+	}
+	if value, ok := trc.mutation.GetType(); ok {
+		_spec.SetField(testrun.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := trc.mutation.Trigger(); ok {
+		_spec.SetField(testrun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := trc.mutation.Outcome(); ok {
+		_spec.SetField(testrun.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := trc.mutation.StartedAt(); ok {
+		_spec.SetField(testrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := trc.mutation.FinishedAt(); ok {
+		_spec.SetField(testrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := trc.mutation.ErrorMessage(); ok {
+		_spec.SetField(testrun.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := trc.mutation.TargetDaemonID(); ok {
+		_spec.SetField(testrun.FieldTargetDaemonID, field.TypeString, value)
+		_node.TargetDaemonID = value
+	}
+	if nodes := trc.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testrun.HostTable,
+			Columns: []string{testrun.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.HostID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := trc.mutation.SpeedTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.SpeedTestTable,
+			Columns: []string{testrun.SpeedTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SpeedTestID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := trc.mutation.IperfTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.IperfTestTable,
+			Columns: []string{testrun.IperfTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IperfTestID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := trc.mutation.MeshTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.MeshTestTable,
+			Columns: []string{testrun.MeshTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MeshTestID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TestRunCreateBulk is the builder for creating many TestRun entities in bulk.
+type TestRunCreateBulk struct {
+	config
+	err      error
+	builders []*TestRunCreate
+}
+
+// Save creates the TestRun entities in the database.
+func (trcb *TestRunCreateBulk) Save(ctx context.Context) ([]*TestRun, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TestRun, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TestRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TestRunCreateBulk) SaveX(ctx context.Context) []*TestRun {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TestRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TestRunCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+)
+
+// TestRunDelete is the builder for deleting a TestRun entity.
+type TestRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *TestRunMutation
+}
+
+// Where appends a list predicates to the TestRunDelete builder.
+func (trd *TestRunDelete) Where(ps ...predicate.TestRun) *TestRunDelete {
+	trd.mutation.Where(ps...)
+	return trd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trd *TestRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trd.sqlExec, trd.mutation, trd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trd *TestRunDelete) ExecX(ctx context.Context) int {
+	n, err := trd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trd *TestRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(testrun.Table, sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt))
+	if ps := trd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trd.mutation.done = true
+	return affected, err
+}
+
+// TestRunDeleteOne is the builder for deleting a single TestRun entity.
+type TestRunDeleteOne struct {
+	trd *TestRunDelete
+}
+
+// Where appends a list predicates to the TestRunDelete builder.
+func (trdo *TestRunDeleteOne) Where(ps ...predicate.TestRun) *TestRunDeleteOne {
+	trdo.trd.mutation.Where(ps...)
+	return trdo
+}
+
+// Exec executes the deletion query.
+func (trdo *TestRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := trdo.trd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{testrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trdo *TestRunDeleteOne) ExecX(ctx context.Context) {
+	if err := trdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
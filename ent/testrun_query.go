@@ -0,0 +1,866 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+)
+
+// TestRunQuery is the builder for querying TestRun entities.
+type TestRunQuery struct {
+	config
+	ctx           *QueryContext
+	order         []testrun.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.TestRun
+	withHost      *HostQuery
+	withSpeedTest *SpeedTestQuery
+	withIperfTest *IperfTestQuery
+	withMeshTest  *MeshTestQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TestRunQuery builder.
+func (trq *TestRunQuery) Where(ps ...predicate.TestRun) *TestRunQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit the number of records to be returned by this query.
+func (trq *TestRunQuery) Limit(limit int) *TestRunQuery {
+	trq.ctx.Limit = &limit
+	return trq
+}
+
+// Offset to start from.
+func (trq *TestRunQuery) Offset(offset int) *TestRunQuery {
+	trq.ctx.Offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TestRunQuery) Unique(unique bool) *TestRunQuery {
+	trq.ctx.Unique = &unique
+	return trq
+}
+
+// Order specifies how the records should be ordered.
+func (trq *TestRunQuery) Order(o ...testrun.OrderOption) *TestRunQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// QueryHost chains the current query on the "host" edge.
+func (trq *TestRunQuery) QueryHost() *HostQuery {
+	query := (&HostClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, selector),
+			sqlgraph.To(host.Table, host.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testrun.HostTable, testrun.HostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySpeedTest chains the current query on the "speed_test" edge.
+func (trq *TestRunQuery) QuerySpeedTest() *SpeedTestQuery {
+	query := (&SpeedTestClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, selector),
+			sqlgraph.To(speedtest.Table, speedtest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testrun.SpeedTestTable, testrun.SpeedTestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIperfTest chains the current query on the "iperf_test" edge.
+func (trq *TestRunQuery) QueryIperfTest() *IperfTestQuery {
+	query := (&IperfTestClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, selector),
+			sqlgraph.To(iperftest.Table, iperftest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testrun.IperfTestTable, testrun.IperfTestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMeshTest chains the current query on the "mesh_test" edge.
+func (trq *TestRunQuery) QueryMeshTest() *MeshTestQuery {
+	query := (&MeshTestClient{config: trq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := trq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := trq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testrun.Table, testrun.FieldID, selector),
+			sqlgraph.To(meshtest.Table, meshtest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, testrun.MeshTestTable, testrun.MeshTestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(trq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TestRun entity from the query.
+// Returns a *NotFoundError when no TestRun was found.
+func (trq *TestRunQuery) First(ctx context.Context) (*TestRun, error) {
+	nodes, err := trq.Limit(1).All(setContextOp(ctx, trq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{testrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TestRunQuery) FirstX(ctx context.Context) *TestRun {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TestRun ID from the query.
+// Returns a *NotFoundError when no TestRun ID was found.
+func (trq *TestRunQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(1).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{testrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TestRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TestRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TestRun entity is found.
+// Returns a *NotFoundError when no TestRun entities are found.
+func (trq *TestRunQuery) Only(ctx context.Context) (*TestRun, error) {
+	nodes, err := trq.Limit(2).All(setContextOp(ctx, trq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{testrun.Label}
+	default:
+		return nil, &NotSingularError{testrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TestRunQuery) OnlyX(ctx context.Context) *TestRun {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TestRun ID in the query.
+// Returns a *NotSingularError when more than one TestRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TestRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = trq.Limit(2).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{testrun.Label}
+	default:
+		err = &NotSingularError{testrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TestRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TestRuns.
+func (trq *TestRunQuery) All(ctx context.Context) ([]*TestRun, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryAll)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TestRun, *TestRunQuery]()
+	return withInterceptors[[]*TestRun](ctx, trq, qr, trq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TestRunQuery) AllX(ctx context.Context) []*TestRun {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TestRun IDs.
+func (trq *TestRunQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if trq.ctx.Unique == nil && trq.path != nil {
+		trq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryIDs)
+	if err = trq.Select(testrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TestRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TestRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryCount)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trq, querierCount[*TestRunQuery](), trq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TestRunQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TestRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryExist)
+	switch _, err := trq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TestRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TestRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TestRunQuery) Clone() *TestRunQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TestRunQuery{
+		config:        trq.config,
+		ctx:           trq.ctx.Clone(),
+		order:         append([]testrun.OrderOption{}, trq.order...),
+		inters:        append([]Interceptor{}, trq.inters...),
+		predicates:    append([]predicate.TestRun{}, trq.predicates...),
+		withHost:      trq.withHost.Clone(),
+		withSpeedTest: trq.withSpeedTest.Clone(),
+		withIperfTest: trq.withIperfTest.Clone(),
+		withMeshTest:  trq.withMeshTest.Clone(),
+		// clone intermediate query.
+		sql:       trq.sql.Clone(),
+		path:      trq.path,
+		modifiers: append([]func(*sql.Selector){}, trq.modifiers...),
+	}
+}
+
+// WithHost tells the query-builder to eager-load the nodes that are connected to
+// the "host" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TestRunQuery) WithHost(opts ...func(*HostQuery)) *TestRunQuery {
+	query := (&HostClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withHost = query
+	return trq
+}
+
+// WithSpeedTest tells the query-builder to eager-load the nodes that are connected to
+// the "speed_test" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TestRunQuery) WithSpeedTest(opts ...func(*SpeedTestQuery)) *TestRunQuery {
+	query := (&SpeedTestClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withSpeedTest = query
+	return trq
+}
+
+// WithIperfTest tells the query-builder to eager-load the nodes that are connected to
+// the "iperf_test" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TestRunQuery) WithIperfTest(opts ...func(*IperfTestQuery)) *TestRunQuery {
+	query := (&IperfTestClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withIperfTest = query
+	return trq
+}
+
+// WithMeshTest tells the query-builder to eager-load the nodes that are connected to
+// the "mesh_test" edge. The optional arguments are used to configure the query builder of the edge.
+func (trq *TestRunQuery) WithMeshTest(opts ...func(*MeshTestQuery)) *TestRunQuery {
+	query := (&MeshTestClient{config: trq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	trq.withMeshTest = query
+	return trq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DaemonID string `json:"daemon_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TestRun.Query().
+//		GroupBy(testrun.FieldDaemonID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TestRunQuery) GroupBy(field string, fields ...string) *TestRunGroupBy {
+	trq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TestRunGroupBy{build: trq}
+	grbuild.flds = &trq.ctx.Fields
+	grbuild.label = testrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DaemonID string `json:"daemon_id,omitempty"`
+//	}
+//
+//	client.TestRun.Query().
+//		Select(testrun.FieldDaemonID).
+//		Scan(ctx, &v)
+func (trq *TestRunQuery) Select(fields ...string) *TestRunSelect {
+	trq.ctx.Fields = append(trq.ctx.Fields, fields...)
+	sbuild := &TestRunSelect{TestRunQuery: trq}
+	sbuild.label = testrun.Label
+	sbuild.flds, sbuild.scan = &trq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TestRunSelect configured with the given aggregations.
+func (trq *TestRunQuery) Aggregate(fns ...AggregateFunc) *TestRunSelect {
+	return trq.Select().Aggregate(fns...)
+}
+
+func (trq *TestRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trq.ctx.Fields {
+		if !testrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TestRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TestRun, error) {
+	var (
+		nodes       = []*TestRun{}
+		_spec       = trq.querySpec()
+		loadedTypes = [4]bool{
+			trq.withHost != nil,
+			trq.withSpeedTest != nil,
+			trq.withIperfTest != nil,
+			trq.withMeshTest != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TestRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TestRun{config: trq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := trq.withHost; query != nil {
+		if err := trq.loadHost(ctx, query, nodes, nil,
+			func(n *TestRun, e *Host) { n.Edges.Host = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := trq.withSpeedTest; query != nil {
+		if err := trq.loadSpeedTest(ctx, query, nodes, nil,
+			func(n *TestRun, e *SpeedTest) { n.Edges.SpeedTest = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := trq.withIperfTest; query != nil {
+		if err := trq.loadIperfTest(ctx, query, nodes, nil,
+			func(n *TestRun, e *IperfTest) { n.Edges.IperfTest = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := trq.withMeshTest; query != nil {
+		if err := trq.loadMeshTest(ctx, query, nodes, nil,
+			func(n *TestRun, e *MeshTest) { n.Edges.MeshTest = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (trq *TestRunQuery) loadHost(ctx context.Context, query *HostQuery, nodes []*TestRun, init func(*TestRun), assign func(*TestRun, *Host)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TestRun)
+	for i := range nodes {
+		if nodes[i].HostID == nil {
+			continue
+		}
+		fk := *nodes[i].HostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(host.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "host_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (trq *TestRunQuery) loadSpeedTest(ctx context.Context, query *SpeedTestQuery, nodes []*TestRun, init func(*TestRun), assign func(*TestRun, *SpeedTest)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TestRun)
+	for i := range nodes {
+		if nodes[i].SpeedTestID == nil {
+			continue
+		}
+		fk := *nodes[i].SpeedTestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(speedtest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "speed_test_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (trq *TestRunQuery) loadIperfTest(ctx context.Context, query *IperfTestQuery, nodes []*TestRun, init func(*TestRun), assign func(*TestRun, *IperfTest)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TestRun)
+	for i := range nodes {
+		if nodes[i].IperfTestID == nil {
+			continue
+		}
+		fk := *nodes[i].IperfTestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(iperftest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "iperf_test_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (trq *TestRunQuery) loadMeshTest(ctx context.Context, query *MeshTestQuery, nodes []*TestRun, init func(*TestRun), assign func(*TestRun, *MeshTest)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TestRun)
+	for i := range nodes {
+		if nodes[i].MeshTestID == nil {
+			continue
+		}
+		fk := *nodes[i].MeshTestID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(meshtest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mesh_test_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (trq *TestRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	if len(trq.modifiers) > 0 {
+		_spec.Modifiers = trq.modifiers
+	}
+	_spec.Node.Columns = trq.ctx.Fields
+	if len(trq.ctx.Fields) > 0 {
+		_spec.Unique = trq.ctx.Unique != nil && *trq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TestRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(testrun.Table, testrun.Columns, sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt))
+	_spec.From = trq.sql
+	if unique := trq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testrun.FieldID)
+		for i := range fields {
+			if fields[i] != testrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if trq.withHost != nil {
+			_spec.Node.AddColumnOnce(testrun.FieldHostID)
+		}
+		if trq.withSpeedTest != nil {
+			_spec.Node.AddColumnOnce(testrun.FieldSpeedTestID)
+		}
+		if trq.withIperfTest != nil {
+			_spec.Node.AddColumnOnce(testrun.FieldIperfTestID)
+		}
+		if trq.withMeshTest != nil {
+			_spec.Node.AddColumnOnce(testrun.FieldMeshTestID)
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TestRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(testrun.Table)
+	columns := trq.ctx.Fields
+	if len(columns) == 0 {
+		columns = testrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.ctx.Unique != nil && *trq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range trq.modifiers {
+		m(selector)
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (trq *TestRunQuery) Modify(modifiers ...func(s *sql.Selector)) *TestRunSelect {
+	trq.modifiers = append(trq.modifiers, modifiers...)
+	return trq.Select()
+}
+
+// TestRunGroupBy is the group-by builder for TestRun entities.
+type TestRunGroupBy struct {
+	selector
+	build *TestRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TestRunGroupBy) Aggregate(fns ...AggregateFunc) *TestRunGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trgb *TestRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestRunQuery, *TestRunGroupBy](ctx, trgb.build, trgb, trgb.build.inters, v)
+}
+
+func (trgb *TestRunGroupBy) sqlScan(ctx context.Context, root *TestRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trgb.flds)+len(trgb.fns))
+		for _, f := range *trgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TestRunSelect is the builder for selecting fields of TestRun entities.
+type TestRunSelect struct {
+	*TestRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trs *TestRunSelect) Aggregate(fns ...AggregateFunc) *TestRunSelect {
+	trs.fns = append(trs.fns, fns...)
+	return trs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TestRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trs.ctx, ent.OpQuerySelect)
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestRunQuery, *TestRunSelect](ctx, trs.TestRunQuery, trs, trs.inters, v)
+}
+
+func (trs *TestRunSelect) sqlScan(ctx context.Context, root *TestRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trs.fns))
+	for _, fn := range trs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (trs *TestRunSelect) Modify(modifiers ...func(s *sql.Selector)) *TestRunSelect {
+	trs.modifiers = append(trs.modifiers, modifiers...)
+	return trs
+}
@@ -0,0 +1,1083 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+	"github.com/google/uuid"
+)
+
+// TestRunUpdate is the builder for updating TestRun entities.
+type TestRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TestRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TestRunUpdate builder.
+func (tru *TestRunUpdate) Where(ps ...predicate.TestRun) *TestRunUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (tru *TestRunUpdate) SetDaemonID(s string) *TestRunUpdate {
+	tru.mutation.SetDaemonID(s)
+	return tru
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableDaemonID(s *string) *TestRunUpdate {
+	if s != nil {
+		tru.SetDaemonID(*s)
+	}
+	return tru
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (tru *TestRunUpdate) SetSubmissionID(u uuid.UUID) *TestRunUpdate {
+	tru.mutation.SetSubmissionID(u)
+	return tru
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableSubmissionID(u *uuid.UUID) *TestRunUpdate {
+	if u != nil {
+		tru.SetSubmissionID(*u)
+	}
+	return tru
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (tru *TestRunUpdate) ClearSubmissionID() *TestRunUpdate {
+	tru.mutation.ClearSubmissionID()
+	return tru
+}
+
+// SetType sets the "type" field.
+func (tru *TestRunUpdate) SetType(t testrun.Type) *TestRunUpdate {
+	tru.mutation.SetType(t)
+	return tru
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableType(t *testrun.Type) *TestRunUpdate {
+	if t != nil {
+		tru.SetType(*t)
+	}
+	return tru
+}
+
+// SetTrigger sets the "trigger" field.
+func (tru *TestRunUpdate) SetTrigger(t testrun.Trigger) *TestRunUpdate {
+	tru.mutation.SetTrigger(t)
+	return tru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableTrigger(t *testrun.Trigger) *TestRunUpdate {
+	if t != nil {
+		tru.SetTrigger(*t)
+	}
+	return tru
+}
+
+// SetOutcome sets the "outcome" field.
+func (tru *TestRunUpdate) SetOutcome(t testrun.Outcome) *TestRunUpdate {
+	tru.mutation.SetOutcome(t)
+	return tru
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableOutcome(t *testrun.Outcome) *TestRunUpdate {
+	if t != nil {
+		tru.SetOutcome(*t)
+	}
+	return tru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tru *TestRunUpdate) SetStartedAt(t time.Time) *TestRunUpdate {
+	tru.mutation.SetStartedAt(t)
+	return tru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableStartedAt(t *time.Time) *TestRunUpdate {
+	if t != nil {
+		tru.SetStartedAt(*t)
+	}
+	return tru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (tru *TestRunUpdate) SetFinishedAt(t time.Time) *TestRunUpdate {
+	tru.mutation.SetFinishedAt(t)
+	return tru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableFinishedAt(t *time.Time) *TestRunUpdate {
+	if t != nil {
+		tru.SetFinishedAt(*t)
+	}
+	return tru
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (tru *TestRunUpdate) SetErrorMessage(s string) *TestRunUpdate {
+	tru.mutation.SetErrorMessage(s)
+	return tru
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableErrorMessage(s *string) *TestRunUpdate {
+	if s != nil {
+		tru.SetErrorMessage(*s)
+	}
+	return tru
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (tru *TestRunUpdate) ClearErrorMessage() *TestRunUpdate {
+	tru.mutation.ClearErrorMessage()
+	return tru
+}
+
+// SetHostID sets the "host_id" field.
+func (tru *TestRunUpdate) SetHostID(i int) *TestRunUpdate {
+	tru.mutation.SetHostID(i)
+	return tru
+}
+
+// SetNillableHostID sets the "host_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableHostID(i *int) *TestRunUpdate {
+	if i != nil {
+		tru.SetHostID(*i)
+	}
+	return tru
+}
+
+// ClearHostID clears the value of the "host_id" field.
+func (tru *TestRunUpdate) ClearHostID() *TestRunUpdate {
+	tru.mutation.ClearHostID()
+	return tru
+}
+
+// SetSpeedTestID sets the "speed_test_id" field.
+func (tru *TestRunUpdate) SetSpeedTestID(i int) *TestRunUpdate {
+	tru.mutation.SetSpeedTestID(i)
+	return tru
+}
+
+// SetNillableSpeedTestID sets the "speed_test_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableSpeedTestID(i *int) *TestRunUpdate {
+	if i != nil {
+		tru.SetSpeedTestID(*i)
+	}
+	return tru
+}
+
+// ClearSpeedTestID clears the value of the "speed_test_id" field.
+func (tru *TestRunUpdate) ClearSpeedTestID() *TestRunUpdate {
+	tru.mutation.ClearSpeedTestID()
+	return tru
+}
+
+// SetIperfTestID sets the "iperf_test_id" field.
+func (tru *TestRunUpdate) SetIperfTestID(i int) *TestRunUpdate {
+	tru.mutation.SetIperfTestID(i)
+	return tru
+}
+
+// SetNillableIperfTestID sets the "iperf_test_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableIperfTestID(i *int) *TestRunUpdate {
+	if i != nil {
+		tru.SetIperfTestID(*i)
+	}
+	return tru
+}
+
+// ClearIperfTestID clears the value of the "iperf_test_id" field.
+func (tru *TestRunUpdate) ClearIperfTestID() *TestRunUpdate {
+	tru.mutation.ClearIperfTestID()
+	return tru
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (tru *TestRunUpdate) SetTargetDaemonID(s string) *TestRunUpdate {
+	tru.mutation.SetTargetDaemonID(s)
+	return tru
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableTargetDaemonID(s *string) *TestRunUpdate {
+	if s != nil {
+		tru.SetTargetDaemonID(*s)
+	}
+	return tru
+}
+
+// ClearTargetDaemonID clears the value of the "target_daemon_id" field.
+func (tru *TestRunUpdate) ClearTargetDaemonID() *TestRunUpdate {
+	tru.mutation.ClearTargetDaemonID()
+	return tru
+}
+
+// SetMeshTestID sets the "mesh_test_id" field.
+func (tru *TestRunUpdate) SetMeshTestID(i int) *TestRunUpdate {
+	tru.mutation.SetMeshTestID(i)
+	return tru
+}
+
+// SetNillableMeshTestID sets the "mesh_test_id" field if the given value is not nil.
+func (tru *TestRunUpdate) SetNillableMeshTestID(i *int) *TestRunUpdate {
+	if i != nil {
+		tru.SetMeshTestID(*i)
+	}
+	return tru
+}
+
+// ClearMeshTestID clears the value of the "mesh_test_id" field.
+func (tru *TestRunUpdate) ClearMeshTestID() *TestRunUpdate {
+	tru.mutation.ClearMeshTestID()
+	return tru
+}
+
+// SetHost sets the "host" edge to the Host entity.
+func (tru *TestRunUpdate) SetHost(h *Host) *TestRunUpdate {
+	return tru.SetHostID(h.ID)
+}
+
+// SetSpeedTest sets the "speed_test" edge to the SpeedTest entity.
+func (tru *TestRunUpdate) SetSpeedTest(s *SpeedTest) *TestRunUpdate {
+	return tru.SetSpeedTestID(s.ID)
+}
+
+// SetIperfTest sets the "iperf_test" edge to the IperfTest entity.
+func (tru *TestRunUpdate) SetIperfTest(i *IperfTest) *TestRunUpdate {
+	return tru.SetIperfTestID(i.ID)
+}
+
+// SetMeshTest sets the "mesh_test" edge to the MeshTest entity.
+func (tru *TestRunUpdate) SetMeshTest(m *MeshTest) *TestRunUpdate {
+	return tru.SetMeshTestID(m.ID)
+}
+
+// Mutation returns the TestRunMutation object of the builder.
+func (tru *TestRunUpdate) Mutation() *TestRunMutation {
+	return tru.mutation
+}
+
+// ClearHost clears the "host" edge to the Host entity.
+func (tru *TestRunUpdate) ClearHost() *TestRunUpdate {
+	tru.mutation.ClearHost()
+	return tru
+}
+
+// ClearSpeedTest clears the "speed_test" edge to the SpeedTest entity.
+func (tru *TestRunUpdate) ClearSpeedTest() *TestRunUpdate {
+	tru.mutation.ClearSpeedTest()
+	return tru
+}
+
+// ClearIperfTest clears the "iperf_test" edge to the IperfTest entity.
+func (tru *TestRunUpdate) ClearIperfTest() *TestRunUpdate {
+	tru.mutation.ClearIperfTest()
+	return tru
+}
+
+// ClearMeshTest clears the "mesh_test" edge to the MeshTest entity.
+func (tru *TestRunUpdate) ClearMeshTest() *TestRunUpdate {
+	tru.mutation.ClearMeshTest()
+	return tru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TestRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TestRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TestRunUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TestRunUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TestRunUpdate) check() error {
+	if v, ok := tru.mutation.GetType(); ok {
+		if err := testrun.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TestRun.type": %w`, err)}
+		}
+	}
+	if v, ok := tru.mutation.Trigger(); ok {
+		if err := testrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TestRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := tru.mutation.Outcome(); ok {
+		if err := testrun.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "TestRun.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tru *TestRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TestRunUpdate {
+	tru.modifiers = append(tru.modifiers, modifiers...)
+	return tru
+}
+
+func (tru *TestRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testrun.Table, testrun.Columns, sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.DaemonID(); ok {
+		_spec.SetField(testrun.FieldDaemonID, field.TypeString, value)
+	}
+	if value, ok := tru.mutation.SubmissionID(); ok {
+		_spec.SetField(testrun.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if tru.mutation.SubmissionIDCleared() {
+		_spec.ClearField(testrun.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := tru.mutation.GetType(); ok {
+		_spec.SetField(testrun.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := tru.mutation.Trigger(); ok {
+		_spec.SetField(testrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := tru.mutation.Outcome(); ok {
+		_spec.SetField(testrun.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := tru.mutation.StartedAt(); ok {
+		_spec.SetField(testrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.FinishedAt(); ok {
+		_spec.SetField(testrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.ErrorMessage(); ok {
+		_spec.SetField(testrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if tru.mutation.ErrorMessageCleared() {
+		_spec.ClearField(testrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := tru.mutation.TargetDaemonID(); ok {
+		_spec.SetField(testrun.FieldTargetDaemonID, field.TypeString, value)
+	}
+	if tru.mutation.TargetDaemonIDCleared() {
+		_spec.ClearField(testrun.FieldTargetDaemonID, field.TypeString)
+	}
+	if tru.mutation.HostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testrun.HostTable,
+			Columns: []string{testrun.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testrun.HostTable,
+			Columns: []string{testrun.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tru.mutation.SpeedTestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.SpeedTestTable,
+			Columns: []string{testrun.SpeedTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.SpeedTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.SpeedTestTable,
+			Columns: []string{testrun.SpeedTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tru.mutation.IperfTestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.IperfTestTable,
+			Columns: []string{testrun.IperfTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.IperfTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.IperfTestTable,
+			Columns: []string{testrun.IperfTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tru.mutation.MeshTestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.MeshTestTable,
+			Columns: []string{testrun.MeshTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tru.mutation.MeshTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.MeshTestTable,
+			Columns: []string{testrun.MeshTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TestRunUpdateOne is the builder for updating a single TestRun entity.
+type TestRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TestRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDaemonID sets the "daemon_id" field.
+func (truo *TestRunUpdateOne) SetDaemonID(s string) *TestRunUpdateOne {
+	truo.mutation.SetDaemonID(s)
+	return truo
+}
+
+// SetNillableDaemonID sets the "daemon_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableDaemonID(s *string) *TestRunUpdateOne {
+	if s != nil {
+		truo.SetDaemonID(*s)
+	}
+	return truo
+}
+
+// SetSubmissionID sets the "submission_id" field.
+func (truo *TestRunUpdateOne) SetSubmissionID(u uuid.UUID) *TestRunUpdateOne {
+	truo.mutation.SetSubmissionID(u)
+	return truo
+}
+
+// SetNillableSubmissionID sets the "submission_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableSubmissionID(u *uuid.UUID) *TestRunUpdateOne {
+	if u != nil {
+		truo.SetSubmissionID(*u)
+	}
+	return truo
+}
+
+// ClearSubmissionID clears the value of the "submission_id" field.
+func (truo *TestRunUpdateOne) ClearSubmissionID() *TestRunUpdateOne {
+	truo.mutation.ClearSubmissionID()
+	return truo
+}
+
+// SetType sets the "type" field.
+func (truo *TestRunUpdateOne) SetType(t testrun.Type) *TestRunUpdateOne {
+	truo.mutation.SetType(t)
+	return truo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableType(t *testrun.Type) *TestRunUpdateOne {
+	if t != nil {
+		truo.SetType(*t)
+	}
+	return truo
+}
+
+// SetTrigger sets the "trigger" field.
+func (truo *TestRunUpdateOne) SetTrigger(t testrun.Trigger) *TestRunUpdateOne {
+	truo.mutation.SetTrigger(t)
+	return truo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableTrigger(t *testrun.Trigger) *TestRunUpdateOne {
+	if t != nil {
+		truo.SetTrigger(*t)
+	}
+	return truo
+}
+
+// SetOutcome sets the "outcome" field.
+func (truo *TestRunUpdateOne) SetOutcome(t testrun.Outcome) *TestRunUpdateOne {
+	truo.mutation.SetOutcome(t)
+	return truo
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableOutcome(t *testrun.Outcome) *TestRunUpdateOne {
+	if t != nil {
+		truo.SetOutcome(*t)
+	}
+	return truo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (truo *TestRunUpdateOne) SetStartedAt(t time.Time) *TestRunUpdateOne {
+	truo.mutation.SetStartedAt(t)
+	return truo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableStartedAt(t *time.Time) *TestRunUpdateOne {
+	if t != nil {
+		truo.SetStartedAt(*t)
+	}
+	return truo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (truo *TestRunUpdateOne) SetFinishedAt(t time.Time) *TestRunUpdateOne {
+	truo.mutation.SetFinishedAt(t)
+	return truo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableFinishedAt(t *time.Time) *TestRunUpdateOne {
+	if t != nil {
+		truo.SetFinishedAt(*t)
+	}
+	return truo
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (truo *TestRunUpdateOne) SetErrorMessage(s string) *TestRunUpdateOne {
+	truo.mutation.SetErrorMessage(s)
+	return truo
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableErrorMessage(s *string) *TestRunUpdateOne {
+	if s != nil {
+		truo.SetErrorMessage(*s)
+	}
+	return truo
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (truo *TestRunUpdateOne) ClearErrorMessage() *TestRunUpdateOne {
+	truo.mutation.ClearErrorMessage()
+	return truo
+}
+
+// SetHostID sets the "host_id" field.
+func (truo *TestRunUpdateOne) SetHostID(i int) *TestRunUpdateOne {
+	truo.mutation.SetHostID(i)
+	return truo
+}
+
+// SetNillableHostID sets the "host_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableHostID(i *int) *TestRunUpdateOne {
+	if i != nil {
+		truo.SetHostID(*i)
+	}
+	return truo
+}
+
+// ClearHostID clears the value of the "host_id" field.
+func (truo *TestRunUpdateOne) ClearHostID() *TestRunUpdateOne {
+	truo.mutation.ClearHostID()
+	return truo
+}
+
+// SetSpeedTestID sets the "speed_test_id" field.
+func (truo *TestRunUpdateOne) SetSpeedTestID(i int) *TestRunUpdateOne {
+	truo.mutation.SetSpeedTestID(i)
+	return truo
+}
+
+// SetNillableSpeedTestID sets the "speed_test_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableSpeedTestID(i *int) *TestRunUpdateOne {
+	if i != nil {
+		truo.SetSpeedTestID(*i)
+	}
+	return truo
+}
+
+// ClearSpeedTestID clears the value of the "speed_test_id" field.
+func (truo *TestRunUpdateOne) ClearSpeedTestID() *TestRunUpdateOne {
+	truo.mutation.ClearSpeedTestID()
+	return truo
+}
+
+// SetIperfTestID sets the "iperf_test_id" field.
+func (truo *TestRunUpdateOne) SetIperfTestID(i int) *TestRunUpdateOne {
+	truo.mutation.SetIperfTestID(i)
+	return truo
+}
+
+// SetNillableIperfTestID sets the "iperf_test_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableIperfTestID(i *int) *TestRunUpdateOne {
+	if i != nil {
+		truo.SetIperfTestID(*i)
+	}
+	return truo
+}
+
+// ClearIperfTestID clears the value of the "iperf_test_id" field.
+func (truo *TestRunUpdateOne) ClearIperfTestID() *TestRunUpdateOne {
+	truo.mutation.ClearIperfTestID()
+	return truo
+}
+
+// SetTargetDaemonID sets the "target_daemon_id" field.
+func (truo *TestRunUpdateOne) SetTargetDaemonID(s string) *TestRunUpdateOne {
+	truo.mutation.SetTargetDaemonID(s)
+	return truo
+}
+
+// SetNillableTargetDaemonID sets the "target_daemon_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableTargetDaemonID(s *string) *TestRunUpdateOne {
+	if s != nil {
+		truo.SetTargetDaemonID(*s)
+	}
+	return truo
+}
+
+// ClearTargetDaemonID clears the value of the "target_daemon_id" field.
+func (truo *TestRunUpdateOne) ClearTargetDaemonID() *TestRunUpdateOne {
+	truo.mutation.ClearTargetDaemonID()
+	return truo
+}
+
+// SetMeshTestID sets the "mesh_test_id" field.
+func (truo *TestRunUpdateOne) SetMeshTestID(i int) *TestRunUpdateOne {
+	truo.mutation.SetMeshTestID(i)
+	return truo
+}
+
+// SetNillableMeshTestID sets the "mesh_test_id" field if the given value is not nil.
+func (truo *TestRunUpdateOne) SetNillableMeshTestID(i *int) *TestRunUpdateOne {
+	if i != nil {
+		truo.SetMeshTestID(*i)
+	}
+	return truo
+}
+
+// ClearMeshTestID clears the value of the "mesh_test_id" field.
+func (truo *TestRunUpdateOne) ClearMeshTestID() *TestRunUpdateOne {
+	truo.mutation.ClearMeshTestID()
+	return truo
+}
+
+// SetHost sets the "host" edge to the Host entity.
+func (truo *TestRunUpdateOne) SetHost(h *Host) *TestRunUpdateOne {
+	return truo.SetHostID(h.ID)
+}
+
+// SetSpeedTest sets the "speed_test" edge to the SpeedTest entity.
+func (truo *TestRunUpdateOne) SetSpeedTest(s *SpeedTest) *TestRunUpdateOne {
+	return truo.SetSpeedTestID(s.ID)
+}
+
+// SetIperfTest sets the "iperf_test" edge to the IperfTest entity.
+func (truo *TestRunUpdateOne) SetIperfTest(i *IperfTest) *TestRunUpdateOne {
+	return truo.SetIperfTestID(i.ID)
+}
+
+// SetMeshTest sets the "mesh_test" edge to the MeshTest entity.
+func (truo *TestRunUpdateOne) SetMeshTest(m *MeshTest) *TestRunUpdateOne {
+	return truo.SetMeshTestID(m.ID)
+}
+
+// Mutation returns the TestRunMutation object of the builder.
+func (truo *TestRunUpdateOne) Mutation() *TestRunMutation {
+	return truo.mutation
+}
+
+// ClearHost clears the "host" edge to the Host entity.
+func (truo *TestRunUpdateOne) ClearHost() *TestRunUpdateOne {
+	truo.mutation.ClearHost()
+	return truo
+}
+
+// ClearSpeedTest clears the "speed_test" edge to the SpeedTest entity.
+func (truo *TestRunUpdateOne) ClearSpeedTest() *TestRunUpdateOne {
+	truo.mutation.ClearSpeedTest()
+	return truo
+}
+
+// ClearIperfTest clears the "iperf_test" edge to the IperfTest entity.
+func (truo *TestRunUpdateOne) ClearIperfTest() *TestRunUpdateOne {
+	truo.mutation.ClearIperfTest()
+	return truo
+}
+
+// ClearMeshTest clears the "mesh_test" edge to the MeshTest entity.
+func (truo *TestRunUpdateOne) ClearMeshTest() *TestRunUpdateOne {
+	truo.mutation.ClearMeshTest()
+	return truo
+}
+
+// Where appends a list predicates to the TestRunUpdate builder.
+func (truo *TestRunUpdateOne) Where(ps ...predicate.TestRun) *TestRunUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TestRunUpdateOne) Select(field string, fields ...string) *TestRunUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TestRun entity.
+func (truo *TestRunUpdateOne) Save(ctx context.Context) (*TestRun, error) {
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TestRunUpdateOne) SaveX(ctx context.Context) *TestRun {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TestRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TestRunUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TestRunUpdateOne) check() error {
+	if v, ok := truo.mutation.GetType(); ok {
+		if err := testrun.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TestRun.type": %w`, err)}
+		}
+	}
+	if v, ok := truo.mutation.Trigger(); ok {
+		if err := testrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TestRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := truo.mutation.Outcome(); ok {
+		if err := testrun.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "TestRun.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (truo *TestRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TestRunUpdateOne {
+	truo.modifiers = append(truo.modifiers, modifiers...)
+	return truo
+}
+
+func (truo *TestRunUpdateOne) sqlSave(ctx context.Context) (_node *TestRun, err error) {
+	if err := truo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testrun.Table, testrun.Columns, sqlgraph.NewFieldSpec(testrun.FieldID, field.TypeInt))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TestRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testrun.FieldID)
+		for _, f := range fields {
+			if !testrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != testrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.DaemonID(); ok {
+		_spec.SetField(testrun.FieldDaemonID, field.TypeString, value)
+	}
+	if value, ok := truo.mutation.SubmissionID(); ok {
+		_spec.SetField(testrun.FieldSubmissionID, field.TypeUUID, value)
+	}
+	if truo.mutation.SubmissionIDCleared() {
+		_spec.ClearField(testrun.FieldSubmissionID, field.TypeUUID)
+	}
+	if value, ok := truo.mutation.GetType(); ok {
+		_spec.SetField(testrun.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := truo.mutation.Trigger(); ok {
+		_spec.SetField(testrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := truo.mutation.Outcome(); ok {
+		_spec.SetField(testrun.FieldOutcome, field.TypeEnum, value)
+	}
+	if value, ok := truo.mutation.StartedAt(); ok {
+		_spec.SetField(testrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.FinishedAt(); ok {
+		_spec.SetField(testrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.ErrorMessage(); ok {
+		_spec.SetField(testrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if truo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(testrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := truo.mutation.TargetDaemonID(); ok {
+		_spec.SetField(testrun.FieldTargetDaemonID, field.TypeString, value)
+	}
+	if truo.mutation.TargetDaemonIDCleared() {
+		_spec.ClearField(testrun.FieldTargetDaemonID, field.TypeString)
+	}
+	if truo.mutation.HostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testrun.HostTable,
+			Columns: []string{testrun.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.HostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testrun.HostTable,
+			Columns: []string{testrun.HostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(host.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if truo.mutation.SpeedTestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.SpeedTestTable,
+			Columns: []string{testrun.SpeedTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.SpeedTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.SpeedTestTable,
+			Columns: []string{testrun.SpeedTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(speedtest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if truo.mutation.IperfTestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.IperfTestTable,
+			Columns: []string{testrun.IperfTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.IperfTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.IperfTestTable,
+			Columns: []string{testrun.IperfTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iperftest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if truo.mutation.MeshTestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.MeshTestTable,
+			Columns: []string{testrun.MeshTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := truo.mutation.MeshTestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   testrun.MeshTestTable,
+			Columns: []string{testrun.MeshTestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(meshtest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(truo.modifiers...)
+	_node = &TestRun{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
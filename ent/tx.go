@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,12 +14,26 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// Campaign is the client for interacting with the Campaign builders.
+	Campaign *CampaignClient
+	// Daemon is the client for interacting with the Daemon builders.
+	Daemon *DaemonClient
+	// DaemonConfig is the client for interacting with the DaemonConfig builders.
+	DaemonConfig *DaemonConfigClient
 	// Host is the client for interacting with the Host builders.
 	Host *HostClient
 	// IperfTest is the client for interacting with the IperfTest builders.
 	IperfTest *IperfTestClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// MeshTest is the client for interacting with the MeshTest builders.
+	MeshTest *MeshTestClient
 	// SpeedTest is the client for interacting with the SpeedTest builders.
 	SpeedTest *SpeedTestClient
+	// TestRun is the client for interacting with the TestRun builders.
+	TestRun *TestRunClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +165,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.Campaign = NewCampaignClient(tx.config)
+	tx.Daemon = NewDaemonClient(tx.config)
+	tx.DaemonConfig = NewDaemonConfigClient(tx.config)
 	tx.Host = NewHostClient(tx.config)
 	tx.IperfTest = NewIperfTestClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.MeshTest = NewMeshTestClient(tx.config)
 	tx.SpeedTest = NewSpeedTestClient(tx.config)
+	tx.TestRun = NewTestRunClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +184,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Host.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -214,3 +237,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -17,12 +17,50 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 )
 
+// Defines values for AlertKind.
+const (
+	AlertKindIperfFailed       AlertKind = "iperf_failed"
+	AlertKindResultQuarantined AlertKind = "result_quarantined"
+)
+
+// Defines values for CampaignStatus.
+const (
+	CampaignStatusCompleted CampaignStatus = "completed"
+	CampaignStatusRunning   CampaignStatus = "running"
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+)
+
+// Defines values for DaemonStatus.
+const (
+	Dead   DaemonStatus = "dead"
+	Online DaemonStatus = "online"
+	Stale  DaemonStatus = "stale"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeAlert           EventType = "alert"
+	EventTypeDaemonHeartbeat EventType = "daemon_heartbeat"
+	EventTypeHostCreated     EventType = "host_created"
+	EventTypeHostDeleted     EventType = "host_deleted"
+	EventTypeHostUpdated     EventType = "host_updated"
+	EventTypeIperfResult     EventType = "iperf_result"
+	EventTypeSpeedtestResult EventType = "speedtest_result"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv    ExportFormat = "csv"
+	ExportFormatNdjson ExportFormat = "ndjson"
+)
+
 // Defines values for HostType.
 const (
 	Lan    HostType = "lan"
@@ -30,23 +68,465 @@ const (
 	Vpn    HostType = "vpn"
 )
 
+// Defines values for IperfStatsGroupBy.
+const (
+	IperfStatsGroupByDaemon   IperfStatsGroupBy = "daemon"
+	IperfStatsGroupByHost     IperfStatsGroupBy = "host"
+	IperfStatsGroupByHostType IperfStatsGroupBy = "host_type"
+)
+
 // Defines values for IperfTestResultProtocol.
 const (
 	IperfTestResultProtocolTCP IperfTestResultProtocol = "TCP"
 	IperfTestResultProtocolUDP IperfTestResultProtocol = "UDP"
 )
 
+// Defines values for IperfTestSort.
+const (
+	IperfTestSortDurationSeconds     IperfTestSort = "duration_seconds"
+	IperfTestSortDurationSecondsDesc IperfTestSort = "-duration_seconds"
+	IperfTestSortMeanRttMs           IperfTestSort = "mean_rtt_ms"
+	IperfTestSortMeanRttMsDesc       IperfTestSort = "-mean_rtt_ms"
+	IperfTestSortReceivedMbps        IperfTestSort = "received_mbps"
+	IperfTestSortReceivedMbpsDesc    IperfTestSort = "-received_mbps"
+	IperfTestSortRetransmits         IperfTestSort = "retransmits"
+	IperfTestSortRetransmitsDesc     IperfTestSort = "-retransmits"
+	IperfTestSortSentMbps            IperfTestSort = "sent_mbps"
+	IperfTestSortSentMbpsDesc        IperfTestSort = "-sent_mbps"
+	IperfTestSortTimestamp           IperfTestSort = "timestamp"
+	IperfTestSortTimestampDesc       IperfTestSort = "-timestamp"
+)
+
 // Defines values for IperfTestSubmissionProtocol.
 const (
 	IperfTestSubmissionProtocolTCP IperfTestSubmissionProtocol = "TCP"
 	IperfTestSubmissionProtocolUDP IperfTestSubmissionProtocol = "UDP"
 )
 
+// Defines values for JobStatus.
+const (
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusLeased    JobStatus = "leased"
+	JobStatusPending   JobStatus = "pending"
+)
+
+// Defines values for JobType.
+const (
+	Iperf     JobType = "iperf"
+	Mesh      JobType = "mesh"
+	Speedtest JobType = "speedtest"
+)
+
+// Defines values for ResultBatchItemStatus.
+const (
+	Created   ResultBatchItemStatus = "created"
+	Duplicate ResultBatchItemStatus = "duplicate"
+	Invalid   ResultBatchItemStatus = "invalid"
+)
+
+// Defines values for RunOutcome.
+const (
+	RunOutcomeAborted RunOutcome = "aborted"
+	RunOutcomeFailed  RunOutcome = "failed"
+	RunOutcomeSkipped RunOutcome = "skipped"
+	RunOutcomeSpooled RunOutcome = "spooled"
+	RunOutcomeSuccess RunOutcome = "success"
+	RunOutcomeTimeout RunOutcome = "timeout"
+)
+
+// Defines values for SpeedTestSort.
+const (
+	SpeedTestSortDownloadMbps     SpeedTestSort = "download_mbps"
+	SpeedTestSortDownloadMbpsDesc SpeedTestSort = "-download_mbps"
+	SpeedTestSortJitterMs         SpeedTestSort = "jitter_ms"
+	SpeedTestSortJitterMsDesc     SpeedTestSort = "-jitter_ms"
+	SpeedTestSortPingMs           SpeedTestSort = "ping_ms"
+	SpeedTestSortPingMsDesc       SpeedTestSort = "-ping_ms"
+	SpeedTestSortTimestamp        SpeedTestSort = "timestamp"
+	SpeedTestSortTimestampDesc    SpeedTestSort = "-timestamp"
+	SpeedTestSortUploadMbps       SpeedTestSort = "upload_mbps"
+	SpeedTestSortUploadMbpsDesc   SpeedTestSort = "-upload_mbps"
+)
+
+// Defines values for SpeedTestStatsGroupBy.
+const (
+	SpeedTestStatsGroupByDaemon SpeedTestStatsGroupBy = "daemon"
+	SpeedTestStatsGroupByIsp    SpeedTestStatsGroupBy = "isp"
+	SpeedTestStatsGroupByServer SpeedTestStatsGroupBy = "server"
+)
+
+// Defines values for StatsBucket.
+const (
+	StatsBucket1d StatsBucket = "1d"
+	StatsBucket1h StatsBucket = "1h"
+	StatsBucket5m StatsBucket = "5m"
+)
+
+// Defines values for TestTrigger.
+const (
+	Adaptive  TestTrigger = "adaptive"
+	Manual    TestTrigger = "manual"
+	Scheduled TestTrigger = "scheduled"
+)
+
+// Alert defines model for Alert.
+type Alert struct {
+	// Kind iperf_failed: an iperf test ran and failed (tests blocked by an
+	// upstream failure are not alerted on). result_quarantined: a result
+	// was quarantined for clock skew.
+	Kind    AlertKind `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// AlertKind iperf_failed: an iperf test ran and failed (tests blocked by an
+// upstream failure are not alerted on). result_quarantined: a result
+// was quarantined for clock skew.
+type AlertKind string
+
+// Baseline defines model for Baseline.
+type Baseline struct {
+	// DownloadMbps Median download (iperf received) throughput in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// PingMs Median latency (iperf mean RTT) in milliseconds
+	PingMs *float64 `json:"ping_ms,omitempty"`
+
+	// Samples Number of results the baseline was computed from
+	Samples int `json:"samples"`
+
+	// UploadMbps Median upload (iperf sent) throughput in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// Campaign defines model for Campaign.
+type Campaign struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DaemonIds Daemons that take part regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Daemons carrying all of these labels take part. Without a selector
+	// or daemon_ids every registered daemon that is not dead takes part.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
+	// Daemons Daemons the campaign's jobs were enqueued for
+	Daemons []string `json:"daemons"`
+
+	// Description Why the campaign was run
+	Description *string `json:"description,omitempty"`
+
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostIds Hosts every daemon runs an iperf test against, in order
+	HostIds *[]int            `json:"host_ids,omitempty"`
+	Id      int               `json:"id"`
+	Jobs    CampaignJobCounts `json:"jobs"`
+
+	// Name Human-friendly campaign name
+	Name string `json:"name"`
+
+	// ScheduledAt When the daemons start the tests (defaults to now)
+	ScheduledAt time.Time `json:"scheduled_at"`
+
+	// Speedtest Run a speed test on every daemon
+	Speedtest *bool `json:"speedtest,omitempty"`
+
+	// Status scheduled until scheduled_at, running while any of its jobs is
+	// pending or leased, completed once every job finished or failed
+	Status CampaignStatus `json:"status"`
+}
+
+// CampaignCreation defines model for CampaignCreation.
+type CampaignCreation struct {
+	// DaemonIds Daemons that take part regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Daemons carrying all of these labels take part. Without a selector
+	// or daemon_ids every registered daemon that is not dead takes part.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
+	// Description Why the campaign was run
+	Description *string `json:"description,omitempty"`
+
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostIds Hosts every daemon runs an iperf test against, in order
+	HostIds *[]int `json:"host_ids,omitempty"`
+
+	// Name Human-friendly campaign name
+	Name string `json:"name"`
+
+	// ScheduledAt When the daemons start the tests (defaults to now)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+
+	// Speedtest Run a speed test on every daemon
+	Speedtest *bool `json:"speedtest,omitempty"`
+}
+
+// CampaignDaemonReport defines model for CampaignDaemonReport.
+type CampaignDaemonReport struct {
+	DaemonId   string                   `json:"daemon_id"`
+	DaemonName *string                  `json:"daemon_name,omitempty"`
+	Iperf      []CampaignIperfResult    `json:"iperf"`
+	Speedtest  *CampaignSpeedTestResult `json:"speedtest,omitempty"`
+
+	// SpeedtestError Error reported by the speed test job
+	SpeedtestError *string `json:"speedtest_error,omitempty"`
+
+	// SpeedtestJobStatus Lifecycle state of a job
+	SpeedtestJobStatus *JobStatus `json:"speedtest_job_status,omitempty"`
+}
+
+// CampaignIperfResult defines model for CampaignIperfResult.
+type CampaignIperfResult struct {
+	// ErrorMessage Error reported by the job or the test
+	ErrorMessage *string `json:"error_message,omitempty"`
+	HostId       int     `json:"host_id"`
+	HostName     *string `json:"host_name,omitempty"`
+
+	// Id ID of the iperf result; unset until it was submitted
+	Id *int `json:"id,omitempty"`
+
+	// JobStatus Lifecycle state of a job
+	JobStatus    JobStatus  `json:"job_status"`
+	MeanRttMs    *float64   `json:"mean_rtt_ms,omitempty"`
+	Quarantined  *bool      `json:"quarantined,omitempty"`
+	ReceivedMbps *float64   `json:"received_mbps,omitempty"`
+	SentMbps     *float64   `json:"sent_mbps,omitempty"`
+	Success      *bool      `json:"success,omitempty"`
+	Timestamp    *time.Time `json:"timestamp,omitempty"`
+}
+
+// CampaignIperfSummary defines model for CampaignIperfSummary.
+type CampaignIperfSummary struct {
+	HostId       int            `json:"host_id"`
+	HostName     *string        `json:"host_name,omitempty"`
+	MeanRttMs    *MetricSummary `json:"mean_rtt_ms,omitempty"`
+	ReceivedMbps MetricSummary  `json:"received_mbps"`
+	SentMbps     MetricSummary  `json:"sent_mbps"`
+}
+
+// CampaignJobCounts defines model for CampaignJobCounts.
+type CampaignJobCounts struct {
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+	Leased    int `json:"leased"`
+	Pending   int `json:"pending"`
+}
+
+// CampaignReport defines model for CampaignReport.
+type CampaignReport struct {
+	Campaign Campaign `json:"campaign"`
+
+	// Daemons One row per daemon, sorted by daemon ID
+	Daemons []CampaignDaemonReport `json:"daemons"`
+
+	// IperfSummary One summary per host over successful results
+	IperfSummary     []CampaignIperfSummary    `json:"iperf_summary"`
+	SpeedtestSummary *CampaignSpeedTestSummary `json:"speedtest_summary,omitempty"`
+}
+
+// CampaignSpeedTestResult defines model for CampaignSpeedTestResult.
+type CampaignSpeedTestResult struct {
+	DownloadMbps float64   `json:"download_mbps"`
+	Id           int       `json:"id"`
+	PingMs       float64   `json:"ping_ms"`
+	Quarantined  bool      `json:"quarantined"`
+	ServerName   *string   `json:"server_name,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+	UploadMbps   float64   `json:"upload_mbps"`
+}
+
+// CampaignSpeedTestSummary defines model for CampaignSpeedTestSummary.
+type CampaignSpeedTestSummary struct {
+	DownloadMbps MetricSummary `json:"download_mbps"`
+	PingMs       MetricSummary `json:"ping_ms"`
+	UploadMbps   MetricSummary `json:"upload_mbps"`
+}
+
+// CampaignStatus scheduled until scheduled_at, running while any of its jobs is
+// pending or leased, completed once every job finished or failed
+type CampaignStatus string
+
+// Daemon defines model for Daemon.
+type Daemon struct {
+	// Arch CPU architecture
+	Arch         *string             `json:"arch,omitempty"`
+	Capabilities *DaemonCapabilities `json:"capabilities,omitempty"`
+
+	// ConfigVersion Version of the daemon's effective configuration, returned on
+	// registration and heartbeat. Daemons refetch their
+	// configuration when it changes.
+	ConfigVersion *string `json:"config_version,omitempty"`
+
+	// Hostname Hostname of the machine running the daemon
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Id Daemon identifier, used as daemon_id on results
+	Id string `json:"id"`
+
+	// Labels Free-form labels describing the daemon
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// LastSeenAt Last registration or heartbeat
+	LastSeenAt time.Time `json:"last_seen_at"`
+
+	// MeshAddress host:port of the iperf3 server the daemon runs for mesh tests
+	// (port 5201 when omitted). Daemons without one are not tested.
+	MeshAddress *string `json:"mesh_address,omitempty"`
+
+	// Name Human-friendly name for the daemon
+	Name *string `json:"name,omitempty"`
+
+	// Os Operating system
+	Os *string `json:"os,omitempty"`
+
+	// RegisteredAt When the daemon first registered
+	RegisteredAt time.Time `json:"registered_at"`
+
+	// SpoolDepth Results waiting in the daemon's offline spool at the last heartbeat
+	SpoolDepth *int `json:"spool_depth,omitempty"`
+
+	// Status Liveness of a daemon based on its last heartbeat
+	Status DaemonStatus `json:"status"`
+
+	// Version speed-checker version the daemon runs
+	Version *string `json:"version,omitempty"`
+}
+
+// DaemonCapabilities defines model for DaemonCapabilities.
+type DaemonCapabilities struct {
+	// Iperf3 Whether iperf3 is installed
+	Iperf3 *bool `json:"iperf3,omitempty"`
+
+	// Speedtest Whether the Ookla speedtest CLI is installed
+	Speedtest *bool `json:"speedtest,omitempty"`
+}
+
+// DaemonConfig defines model for DaemonConfig.
+type DaemonConfig struct {
+	// CreatedAt When the config was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// DaemonId Apply only to this daemon
+	DaemonId *string `json:"daemon_id,omitempty"`
+
+	// Id Unique identifier for the config
+	Id int `json:"id"`
+
+	// MatchLabels Apply to daemons carrying all of these labels
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+
+	// Name Human-friendly name for the config
+	Name string `json:"name"`
+
+	// Priority Order among configs of the same kind; higher priority wins
+	Priority *int `json:"priority,omitempty"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings DaemonSettings `json:"settings"`
+
+	// UpdatedAt When the config was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DaemonConfigCreation defines model for DaemonConfigCreation.
+type DaemonConfigCreation struct {
+	// DaemonId Apply only to this daemon
+	DaemonId *string `json:"daemon_id,omitempty"`
+
+	// MatchLabels Apply to daemons carrying all of these labels
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+
+	// Name Human-friendly name for the config
+	Name string `json:"name"`
+
+	// Priority Order among configs of the same kind; higher priority wins
+	Priority *int `json:"priority,omitempty"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings *DaemonSettings `json:"settings,omitempty"`
+}
+
+// DaemonHeartbeat defines model for DaemonHeartbeat.
+type DaemonHeartbeat struct {
+	// SpoolDepth Results waiting in the daemon's offline spool
+	SpoolDepth *int `json:"spool_depth,omitempty"`
+}
+
+// DaemonRegistration defines model for DaemonRegistration.
+type DaemonRegistration struct {
+	// Arch CPU architecture
+	Arch         *string             `json:"arch,omitempty"`
+	Capabilities *DaemonCapabilities `json:"capabilities,omitempty"`
+
+	// Hostname Hostname of the machine running the daemon
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Id Daemon identifier, used as daemon_id on results
+	Id string `json:"id"`
+
+	// Labels Free-form labels describing the daemon
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// MeshAddress host:port of the iperf3 server the daemon runs for mesh tests
+	// (port 5201 when omitted). Daemons without one are not tested.
+	MeshAddress *string `json:"mesh_address,omitempty"`
+
+	// Name Human-friendly name for the daemon
+	Name *string `json:"name,omitempty"`
+
+	// Os Operating system
+	Os *string `json:"os,omitempty"`
+
+	// Version speed-checker version the daemon runs
+	Version *string `json:"version,omitempty"`
+}
+
+// DaemonSettings Testing settings applied by daemons. Unset settings keep the daemon's
+// local configuration (testing.*).
+type DaemonSettings struct {
+	// AdaptiveEnabled Whether degraded results raise the test frequency
+	AdaptiveEnabled *bool `json:"adaptive_enabled,omitempty"`
+
+	// HostIds Only test these hosts
+	HostIds *[]int `json:"host_ids,omitempty"`
+
+	// HostTypes Only test hosts of these types
+	HostTypes *[]HostType `json:"host_types,omitempty"`
+
+	// IperfDurationSeconds Duration of each iperf test
+	IperfDurationSeconds *int `json:"iperf_duration_seconds,omitempty"`
+
+	// IperfEnabled Whether scheduled iperf tests run
+	IperfEnabled *bool `json:"iperf_enabled,omitempty"`
+
+	// IperfIntervalSeconds Interval between scheduled iperf tests
+	IperfIntervalSeconds *int `json:"iperf_interval_seconds,omitempty"`
+
+	// SpeedtestEnabled Whether scheduled speed tests run
+	SpeedtestEnabled *bool `json:"speedtest_enabled,omitempty"`
+
+	// SpeedtestIntervalSeconds Interval between scheduled speed tests
+	SpeedtestIntervalSeconds *int `json:"speedtest_interval_seconds,omitempty"`
+}
+
+// DaemonStatus Liveness of a daemon based on its last heartbeat
+type DaemonStatus string
+
 // DashboardData defines model for DashboardData.
 type DashboardData struct {
 	// ActiveHosts List of active hosts available for testing
 	ActiveHosts []Host `json:"active_hosts"`
 
+	// Daemons Registered daemons and their status
+	Daemons *[]Daemon `json:"daemons,omitempty"`
+
 	// RecentIperfTests Recent iperf test results
 	RecentIperfTests []IperfTestResult `json:"recent_iperf_tests"`
 
@@ -62,6 +542,12 @@ type DashboardData struct {
 		// AvgUploadMbps Average upload speed over last 24h
 		AvgUploadMbps *float64 `json:"avg_upload_mbps,omitempty"`
 
+		// BlockedIperfTests Iperf tests skipped over last 24h because an upstream host type failed
+		BlockedIperfTests *int `json:"blocked_iperf_tests,omitempty"`
+
+		// FailedIperfTests Iperf failures over last 24h, excluding tests blocked by an upstream failure
+		FailedIperfTests *int `json:"failed_iperf_tests,omitempty"`
+
 		// TotalIperfTests Total number of iperf tests
 		TotalIperfTests *int `json:"total_iperf_tests,omitempty"`
 
@@ -70,6 +556,33 @@ type DashboardData struct {
 	} `json:"statistics"`
 }
 
+// Distribution Distribution of a metric over a bucket. Values are absent when nothing was measured.
+type Distribution struct {
+	Avg *float64 `json:"avg,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	Min *float64 `json:"min,omitempty"`
+	P50 *float64 `json:"p50,omitempty"`
+	P90 *float64 `json:"p90,omitempty"`
+	P95 *float64 `json:"p95,omitempty"`
+	P99 *float64 `json:"p99,omitempty"`
+}
+
+// EffectiveDaemonConfig defines model for EffectiveDaemonConfig.
+type EffectiveDaemonConfig struct {
+	// Applied IDs of the daemon configs merged, in the order applied
+	Applied []int `json:"applied"`
+
+	// DaemonId Daemon the configuration was resolved for
+	DaemonId string `json:"daemon_id"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings DaemonSettings `json:"settings"`
+
+	// Version Version of the resolved settings, changes whenever they do
+	Version string `json:"version"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Details Additional error details
@@ -82,6 +595,12 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// EventType What a streamed event reports
+type EventType string
+
+// ExportFormat Export encoding, CSV with a header row or NDJSON with one object per line
+type ExportFormat string
+
 // Host defines model for Host.
 type Host struct {
 	// Active Whether the host is active for testing
@@ -90,6 +609,13 @@ type Host struct {
 	// CreatedAt When the host was created
 	CreatedAt time.Time `json:"created_at"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -117,6 +643,13 @@ type HostCreation struct {
 	// Active Whether the host is active for testing
 	Active *bool `json:"active,omitempty"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -141,6 +674,13 @@ type HostUpdate struct {
 	// Active Whether the host is active for testing
 	Active *bool `json:"active,omitempty"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -157,10 +697,26 @@ type HostUpdate struct {
 	Type HostType `json:"type"`
 }
 
+// IperfStatsGroupBy Dimension iperf statistics can be grouped by
+type IperfStatsGroupBy string
+
 // IperfTestResult defines model for IperfTestResult.
 type IperfTestResult struct {
-	// CreatedAt When the result was stored in the system
+	// BlockedBy Type of host for categorizing network tests
+	BlockedBy *HostType `json:"blocked_by,omitempty"`
+
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// CreatedAt When the result was stored in the system; the measurement timestamp for results stored before received_at was recorded
 	CreatedAt time.Time `json:"created_at"`
+	Daemon    *Daemon   `json:"daemon,omitempty"`
 
 	// DaemonId Identifier of the daemon that performed the test
 	DaemonId string `json:"daemon_id"`
@@ -184,6 +740,12 @@ type IperfTestResult struct {
 	// Protocol Protocol used for the test
 	Protocol IperfTestResultProtocol `json:"protocol"`
 
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of listings and baselines
+	Quarantined *bool `json:"quarantined,omitempty"`
+
+	// ReceivedAt Server time the result was received, unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+
 	// ReceivedMbps Received throughput in Mbps
 	ReceivedMbps float64 `json:"received_mbps"`
 
@@ -193,18 +755,40 @@ type IperfTestResult struct {
 	// SentMbps Sent throughput in Mbps
 	SentMbps float64 `json:"sent_mbps"`
 
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
 	// Success Whether the test was successful
 	Success *bool `json:"success,omitempty"`
 
 	// Timestamp When the test was performed (RFC3339)
 	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
 // IperfTestResultProtocol Protocol used for the test
 type IperfTestResultProtocol string
 
+// IperfTestSort Iperf test column to sort by, prefixed with - for descending order. Ties are broken by ID.
+type IperfTestSort string
+
 // IperfTestSubmission defines model for IperfTestSubmission.
 type IperfTestSubmission struct {
+	// BlockedBy Type of host for categorizing network tests
+	BlockedBy *HostType `json:"blocked_by,omitempty"`
+
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
 	// DaemonId Identifier of the daemon that performed the test
 	DaemonId string `json:"daemon_id"`
 
@@ -229,230 +813,1897 @@ type IperfTestSubmission struct {
 	// SentMbps Sent throughput in Mbps
 	SentMbps float64 `json:"sent_mbps"`
 
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
 	// Timestamp When the test was performed (RFC3339)
 	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
 // IperfTestSubmissionProtocol Protocol used for the test
 type IperfTestSubmissionProtocol string
 
-// SpeedTestResult defines model for SpeedTestResult.
-type SpeedTestResult struct {
-	// CreatedAt When the result was stored in the system
+// Job defines model for Job.
+type Job struct {
+	// Attempts Number of times the job has been leased
+	Attempts int `json:"attempts"`
+
+	// CampaignId Campaign the job belongs to
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// CompletedAt When the job reached a terminal state
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// CreatedAt When the job was enqueued
 	CreatedAt time.Time `json:"created_at"`
 
-	// DaemonId Identifier of the daemon that performed the test
-	DaemonId string `json:"daemon_id"`
+	// DaemonId Pin the job to a daemon; any daemon may lease it when omitted (required when type is mesh)
+	DaemonId *string `json:"daemon_id,omitempty"`
 
-	// DownloadMbps Download speed in Mbps
-	DownloadMbps float64 `json:"download_mbps"`
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
 
-	// ExternalIp External IP address used for the test
-	ExternalIp *string `json:"external_ip,omitempty"`
+	// ErrorMessage Error reported by the last failed attempt
+	ErrorMessage *string `json:"error_message,omitempty"`
+	Host         *Host   `json:"host,omitempty"`
 
-	// Id Unique identifier for the test result
+	// HostId Target host for iperf jobs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
+
+	// Id Unique identifier for the job
 	Id int `json:"id"`
 
-	// Isp Internet Service Provider
-	Isp *string `json:"isp,omitempty"`
+	// LeaseExpiresAt When the current lease expires
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
 
-	// JitterMs Jitter in milliseconds
-	JitterMs *float64 `json:"jitter_ms,omitempty"`
+	// LeasedBy Daemon currently holding the lease
+	LeasedBy *string `json:"leased_by,omitempty"`
 
-	// PingMs Ping latency in milliseconds
-	PingMs float64 `json:"ping_ms"`
+	// MaxAttempts Maximum number of leases before the job is marked failed
+	MaxAttempts int `json:"max_attempts"`
 
-	// ResultUrl URL to full test results
-	ResultUrl *string `json:"result_url,omitempty"`
+	// Priority Higher priority jobs are leased first
+	Priority *int `json:"priority,omitempty"`
 
-	// ServerId Speed test server ID
-	ServerId *string `json:"server_id,omitempty"`
+	// ResultId ID of the speed or iperf result produced by the job
+	ResultId *int `json:"result_id,omitempty"`
 
-	// ServerName Speed test server name
-	ServerName *string `json:"server_name,omitempty"`
+	// ScheduledAt Earliest time the job may be leased (defaults to now)
+	ScheduledAt time.Time `json:"scheduled_at"`
 
-	// Timestamp When the test was performed (RFC3339)
-	Timestamp time.Time `json:"timestamp"`
+	// Status Lifecycle state of a job
+	Status JobStatus `json:"status"`
 
-	// UploadMbps Upload speed in Mbps
-	UploadMbps float64 `json:"upload_mbps"`
+	// TargetAddress host:port of the target daemon's iperf3 server for mesh jobs
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh job tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-// SpeedTestSubmission defines model for SpeedTestSubmission.
-type SpeedTestSubmission struct {
-	// DaemonId Identifier of the daemon that performed the test
+// JobCompletion defines model for JobCompletion.
+type JobCompletion struct {
+	// DaemonId Daemon reporting the outcome; must hold the lease
 	DaemonId string `json:"daemon_id"`
 
-	// DownloadMbps Download speed in Mbps
-	DownloadMbps float64 `json:"download_mbps"`
+	// ErrorMessage Error message if the job failed
+	ErrorMessage *string `json:"error_message,omitempty"`
 
-	// ExternalIp External IP address used for the test
-	ExternalIp *string `json:"external_ip,omitempty"`
+	// ResultId ID of the submitted speed or iperf result
+	ResultId *int `json:"result_id,omitempty"`
 
-	// Isp Internet Service Provider
-	Isp *string `json:"isp,omitempty"`
+	// Success Whether the job ran successfully
+	Success bool `json:"success"`
+}
 
-	// JitterMs Jitter in milliseconds
-	JitterMs *float64 `json:"jitter_ms,omitempty"`
+// JobCreation defines model for JobCreation.
+type JobCreation struct {
+	// DaemonId Pin the job to a daemon; any daemon may lease it when omitted (required when type is mesh)
+	DaemonId *string `json:"daemon_id,omitempty"`
 
-	// PingMs Ping latency in milliseconds
-	PingMs float64 `json:"ping_ms"`
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
 
-	// ResultUrl URL to full test results
-	ResultUrl *string `json:"result_url,omitempty"`
+	// HostId Target host for iperf jobs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
 
-	// ServerId Speed test server ID
-	ServerId *string `json:"server_id,omitempty"`
+	// MaxAttempts Maximum number of leases before the job is marked failed
+	MaxAttempts *int `json:"max_attempts,omitempty"`
 
-	// ServerName Speed test server name
-	ServerName *string `json:"server_name,omitempty"`
+	// Priority Higher priority jobs are leased first
+	Priority *int `json:"priority,omitempty"`
 
-	// Timestamp When the test was performed (RFC3339)
-	Timestamp time.Time `json:"timestamp"`
+	// ScheduledAt Earliest time the job may be leased (defaults to now)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
 
-	// UploadMbps Upload speed in Mbps
-	UploadMbps float64 `json:"upload_mbps"`
+	// TargetDaemonId Daemon whose iperf3 server a mesh job tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-// GetHostsParams defines parameters for GetHosts.
-type GetHostsParams struct {
+// JobLeaseRequest defines model for JobLeaseRequest.
+type JobLeaseRequest struct {
+	// LeaseSeconds Lease duration in seconds (server default when omitted)
+	LeaseSeconds *int `json:"lease_seconds,omitempty"`
+
+	// MaxJobs Maximum number of jobs to lease
+	MaxJobs *int `json:"max_jobs,omitempty"`
+
+	// PinnedOnly Only lease jobs pinned to this daemon (e.g. on-demand runs)
+	PinnedOnly *bool `json:"pinned_only,omitempty"`
+
+	// WaitSeconds Long-poll for up to this many seconds when no job is due
+	WaitSeconds *int `json:"wait_seconds,omitempty"`
+}
+
+// JobStatus Lifecycle state of a job
+type JobStatus string
+
+// JobType Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+// is pinned to against the iperf3 server of target_daemon_id.
+type JobType string
+
+// MeshMatrix defines model for MeshMatrix.
+type MeshMatrix struct {
+	// Cells One cell per tested source and target pair, sorted by source then target
+	Cells []MeshMatrixCell `json:"cells"`
+
+	// Daemons IDs of the daemons taking part in the mesh or tested within the window, sorted
+	Daemons     []string  `json:"daemons"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Window Window the matrix covers
+	Window string `json:"window"`
+}
+
+// MeshMatrixCell defines model for MeshMatrixCell.
+type MeshMatrixCell struct {
+	// Failures Failed tests of the pair within the window
+	Failures int         `json:"failures"`
+	Latest   MeshSample  `json:"latest"`
+	Median   *MeshSample `json:"median,omitempty"`
+
+	// Samples Tests of the pair within the window
+	Samples        int    `json:"samples"`
+	SourceDaemonId string `json:"source_daemon_id"`
+	TargetDaemonId string `json:"target_daemon_id"`
+}
+
+// MeshSample defines model for MeshSample.
+type MeshSample struct {
+	// MeanRttMs Unset when no test reported an RTT
+	MeanRttMs    *float64 `json:"mean_rtt_ms,omitempty"`
+	ReceivedMbps float64  `json:"received_mbps"`
+	SentMbps     float64  `json:"sent_mbps"`
+
+	// Success Whether the test was successful; unset for medians
+	Success *bool `json:"success,omitempty"`
+
+	// Timestamp When the test was performed; unset for medians
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// MeshTestResult defines model for MeshTestResult.
+type MeshTestResult struct {
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DurationSeconds Test duration in seconds
+	DurationSeconds int `json:"duration_seconds"`
+
+	// ErrorMessage Why the test failed; a submission with an error is stored as unsuccessful
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// Id Unique identifier for the test result
+	Id int `json:"id"`
+
+	// MeanRttMs Mean round-trip time in milliseconds
+	MeanRttMs *float64 `json:"mean_rtt_ms,omitempty"`
+
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of the matrix
+	Quarantined bool `json:"quarantined"`
+
+	// ReceivedAt Server time the result was received
+	ReceivedAt time.Time `json:"received_at"`
+
+	// ReceivedMbps Received throughput in Mbps
+	ReceivedMbps float64 `json:"received_mbps"`
+
+	// Retransmits Number of retransmitted packets
+	Retransmits *float64 `json:"retransmits,omitempty"`
+
+	// SentMbps Sent throughput in Mbps
+	SentMbps float64 `json:"sent_mbps"`
+
+	// SourceDaemonId Daemon that ran the iperf3 client
+	SourceDaemonId string `json:"source_daemon_id"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Success Whether the test was successful
+	Success bool `json:"success"`
+
+	// TargetAddress host:port of the target's iperf3 server
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server was tested
+	TargetDaemonId string `json:"target_daemon_id"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+}
+
+// MeshTestSubmission defines model for MeshTestSubmission.
+type MeshTestSubmission struct {
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DurationSeconds Test duration in seconds
+	DurationSeconds int `json:"duration_seconds"`
+
+	// ErrorMessage Why the test failed; a submission with an error is stored as unsuccessful
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// MeanRttMs Mean round-trip time in milliseconds
+	MeanRttMs *float64 `json:"mean_rtt_ms,omitempty"`
+
+	// ReceivedMbps Received throughput in Mbps
+	ReceivedMbps float64 `json:"received_mbps"`
+
+	// Retransmits Number of retransmitted packets
+	Retransmits *float64 `json:"retransmits,omitempty"`
+
+	// SentMbps Sent throughput in Mbps
+	SentMbps float64 `json:"sent_mbps"`
+
+	// SourceDaemonId Daemon that ran the iperf3 client
+	SourceDaemonId string `json:"source_daemon_id"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetAddress host:port of the target's iperf3 server
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server was tested
+	TargetDaemonId string `json:"target_daemon_id"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+}
+
+// MetricSummary defines model for MetricSummary.
+type MetricSummary struct {
+	Max    float64 `json:"max"`
+	Median float64 `json:"median"`
+	Min    float64 `json:"min"`
+
+	// Samples Number of results the summary covers
+	Samples int `json:"samples"`
+}
+
+// ResultBatch defines model for ResultBatch.
+type ResultBatch struct {
+	Items []ResultBatchItem `json:"items"`
+}
+
+// ResultBatchItem A single result in a batch. Set the submission matching type.
+type ResultBatchItem struct {
+	Iperf     *IperfTestSubmission `json:"iperf,omitempty"`
+	Speedtest *SpeedTestSubmission `json:"speedtest,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// ResultBatchItemResult defines model for ResultBatchItemResult.
+type ResultBatchItemResult struct {
+	// Error Why an invalid item was rejected
+	Error *string `json:"error,omitempty"`
+
+	// Id ID of the stored result, set unless the item is invalid
+	Id *int `json:"id,omitempty"`
+
+	// Index Position of the item in the submitted batch
+	Index int `json:"index"`
+
+	// Status Outcome of a batch item. duplicate means a result with the same
+	// submission_id was already stored; invalid items, including those
+	// rejected for clock skew, were not stored.
+	Status ResultBatchItemStatus `json:"status"`
+}
+
+// ResultBatchItemStatus Outcome of a batch item. duplicate means a result with the same
+// submission_id was already stored; invalid items, including those
+// rejected for clock skew, were not stored.
+type ResultBatchItemStatus string
+
+// ResultBatchResponse defines model for ResultBatchResponse.
+type ResultBatchResponse struct {
+	Results []ResultBatchItemResult `json:"results"`
+}
+
+// RunOutcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+type RunOutcome string
+
+// RunRequest defines model for RunRequest.
+type RunRequest struct {
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostId Target host for iperf runs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// SpeedTestResult defines model for SpeedTestResult.
+type SpeedTestResult struct {
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// CreatedAt When the result was stored in the system; the measurement timestamp for results stored before received_at was recorded
+	CreatedAt time.Time `json:"created_at"`
+	Daemon    *Daemon   `json:"daemon,omitempty"`
+
+	// DaemonId Identifier of the daemon that performed the test
+	DaemonId string `json:"daemon_id"`
+
+	// DownloadMbps Download speed in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// ExternalIp External IP address used for the test
+	ExternalIp *string `json:"external_ip,omitempty"`
+
+	// Id Unique identifier for the test result
+	Id int `json:"id"`
+
+	// Isp Internet Service Provider
+	Isp *string `json:"isp,omitempty"`
+
+	// JitterMs Jitter in milliseconds
+	JitterMs *float64 `json:"jitter_ms,omitempty"`
+
+	// PingMs Ping latency in milliseconds
+	PingMs float64 `json:"ping_ms"`
+
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of listings and baselines
+	Quarantined *bool `json:"quarantined,omitempty"`
+
+	// ReceivedAt Server time the result was received, unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+
+	// ResultUrl URL to full test results
+	ResultUrl *string `json:"result_url,omitempty"`
+
+	// ServerId Speed test server ID
+	ServerId *string `json:"server_id,omitempty"`
+
+	// ServerName Speed test server name
+	ServerName *string `json:"server_name,omitempty"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// UploadMbps Upload speed in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// SpeedTestSort Speed test column to sort by, prefixed with - for descending order. Ties are broken by ID.
+type SpeedTestSort string
+
+// SpeedTestStatsGroupBy Dimension speed test statistics can be grouped by
+type SpeedTestStatsGroupBy string
+
+// SpeedTestSubmission defines model for SpeedTestSubmission.
+type SpeedTestSubmission struct {
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DaemonId Identifier of the daemon that performed the test
+	DaemonId string `json:"daemon_id"`
+
+	// DownloadMbps Download speed in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// ExternalIp External IP address used for the test
+	ExternalIp *string `json:"external_ip,omitempty"`
+
+	// Isp Internet Service Provider
+	Isp *string `json:"isp,omitempty"`
+
+	// JitterMs Jitter in milliseconds
+	JitterMs *float64 `json:"jitter_ms,omitempty"`
+
+	// PingMs Ping latency in milliseconds
+	PingMs float64 `json:"ping_ms"`
+
+	// ResultUrl URL to full test results
+	ResultUrl *string `json:"result_url,omitempty"`
+
+	// ServerId Speed test server ID
+	ServerId *string `json:"server_id,omitempty"`
+
+	// ServerName Speed test server name
+	ServerName *string `json:"server_name,omitempty"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// UploadMbps Upload speed in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+	// Bucket Width of a statistics bucket. Buckets are aligned to the Unix epoch, in UTC.
+	Bucket    *StatsBucket  `json:"bucket,omitempty"`
+	EndTime   time.Time     `json:"end_time"`
+	GroupBy   []string      `json:"group_by"`
+	Series    []StatsSeries `json:"series"`
+	StartTime time.Time     `json:"start_time"`
+}
+
+// StatsBucket Width of a statistics bucket. Buckets are aligned to the Unix epoch, in UTC.
+type StatsBucket string
+
+// StatsGroup Values of the grouped dimensions of a series; dimensions not grouped by are absent
+type StatsGroup struct {
+	DaemonId *string `json:"daemon_id,omitempty"`
+	HostId   *int    `json:"host_id,omitempty"`
+	HostName *string `json:"host_name,omitempty"`
+
+	// HostType Type of host for categorizing network tests
+	HostType   *HostType `json:"host_type,omitempty"`
+	Isp        *string   `json:"isp,omitempty"`
+	ServerName *string   `json:"server_name,omitempty"`
+}
+
+// StatsPoint defines model for StatsPoint.
+type StatsPoint struct {
+	// Count Number of results in the bucket
+	Count int `json:"count"`
+
+	// FailureRate Share of attempts that failed, between 0 and 1
+	FailureRate *float64 `json:"failure_rate,omitempty"`
+
+	// Metrics Distribution per metric name
+	Metrics map[string]Distribution `json:"metrics"`
+
+	// Start Start of the bucket, or of the range when not bucketed
+	Start time.Time `json:"start"`
+}
+
+// StatsSeries defines model for StatsSeries.
+type StatsSeries struct {
+	// Group Values of the grouped dimensions of a series; dimensions not grouped by are absent
+	Group StatsGroup `json:"group"`
+
+	// Points Buckets with results or attempts, oldest first
+	Points []StatsPoint `json:"points"`
+}
+
+// StreamEvent Data of a streamed event. speedtest is set for speedtest_result,
+// iperf for iperf_result, host for host_created and host_updated,
+// daemon for daemon_heartbeat and alert for alert, along with the
+// result that raised it. host_deleted only carries host_id.
+type StreamEvent struct {
+	Alert    *Alert  `json:"alert,omitempty"`
+	Daemon   *Daemon `json:"daemon,omitempty"`
+	DaemonId *string `json:"daemon_id,omitempty"`
+	Host     *Host   `json:"host,omitempty"`
+	HostId   *int    `json:"host_id,omitempty"`
+
+	// Id Sequence number of the event, increasing for the life of the server
+	Id        int64            `json:"id"`
+	Iperf     *IperfTestResult `json:"iperf,omitempty"`
+	Speedtest *SpeedTestResult `json:"speedtest,omitempty"`
+	Time      time.Time        `json:"time"`
+
+	// Type What a streamed event reports
+	Type EventType `json:"type"`
+}
+
+// TestRun defines model for TestRun.
+type TestRun struct {
+	// DaemonId Daemon that attempted the run
+	DaemonId string `json:"daemon_id"`
+
+	// ErrorMessage Why the run failed, timed out or was skipped
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// FinishedAt When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at"`
+	Host       *Host     `json:"host,omitempty"`
+
+	// HostId Target host for iperf runs
+	HostId *int `json:"host_id,omitempty"`
+
+	// Id Unique identifier for the run
+	Id int `json:"id"`
+
+	// IperfTestId ID of the iperf test result produced by the run
+	IperfTestId *int `json:"iperf_test_id,omitempty"`
+
+	// MeshTestId ID of the mesh test result produced by the run
+	MeshTestId *int `json:"mesh_test_id,omitempty"`
+
+	// Outcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+	Outcome RunOutcome `json:"outcome"`
+
+	// SpeedTestId ID of the speed test result produced by the run
+	SpeedTestId *int `json:"speed_test_id,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt time.Time `json:"started_at"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tested
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// TestRunSubmission defines model for TestRunSubmission.
+type TestRunSubmission struct {
+	// DaemonId Daemon that attempted the run
+	DaemonId string `json:"daemon_id"`
+
+	// ErrorMessage Why the run failed, timed out or was skipped
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// FinishedAt When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at"`
+
+	// HostId Target host for iperf runs
+	HostId *int `json:"host_id,omitempty"`
+
+	// IperfTestId ID of the iperf test result produced by the run
+	IperfTestId *int `json:"iperf_test_id,omitempty"`
+
+	// MeshTestId ID of the mesh test result produced by the run
+	MeshTestId *int `json:"mesh_test_id,omitempty"`
+
+	// Outcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+	Outcome RunOutcome `json:"outcome"`
+
+	// SpeedTestId ID of the speed test result produced by the run
+	SpeedTestId *int `json:"speed_test_id,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt time.Time `json:"started_at"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tested
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// TestTrigger What caused a test to run. Adaptive runs are extra tests scheduled
+// while a target performs below its baseline.
+type TestTrigger string
+
+// StatsDaemonId defines model for StatsDaemonId.
+type StatsDaemonId = string
+
+// StatsEndTime defines model for StatsEndTime.
+type StatsEndTime = time.Time
+
+// StatsStartTime defines model for StatsStartTime.
+type StatsStartTime = time.Time
+
+// GetCampaignsParams defines parameters for GetCampaigns.
+type GetCampaignsParams struct {
+	// Limit Maximum number of campaigns to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDaemonsParams defines parameters for GetDaemons.
+type GetDaemonsParams struct {
+	// Status Filter by daemon status
+	Status *DaemonStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// Type Only stream these event types
+	Type *[]EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DaemonId Only stream events about this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostId Only stream events about this host
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+}
+
+// ExportIperfTestsParams defines parameters for ExportIperfTests.
+type ExportIperfTestsParams struct {
+	// Format Output format. Without it the Accept header picks, defaulting to CSV.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostId Filter by host ID
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// HostName Filter by host name (partial match)
+	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+
+	// HostType Filter by host type
+	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order. Defaults to timestamp, oldest first.
+	Sort *IperfTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Quarantined Export quarantined results instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+
+	// Limit Maximum number of results to export; all matching results when absent
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ExportSpeedTestsParams defines parameters for ExportSpeedTests.
+type ExportSpeedTestsParams struct {
+	// Format Output format. Without it the Accept header picks, defaulting to CSV.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// ServerName Filter by server name (partial match)
+	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order. Defaults to timestamp, oldest first.
+	Sort *SpeedTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Quarantined Export quarantined results instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+
+	// Limit Maximum number of results to export; all matching results when absent
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetHostsParams defines parameters for GetHosts.
+type GetHostsParams struct {
 	// Type Filter by host type
 	Type *HostType `form:"type,omitempty" json:"type,omitempty"`
 
-	// Active Filter by active status
-	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+	// Active Filter by active status
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// DaemonId Only return hosts this daemon may test, based on each host's
+	// daemon_selector and daemon_ids and the labels the daemon
+	// registered with
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Limit Maximum number of hosts to return; all hosts without it
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue the listing after the page whose X-Next-Cursor header returned this cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetIperfBaselineParams defines parameters for GetIperfBaseline.
+type GetIperfBaselineParams struct {
+	// HostId Host the baseline is computed for
+	HostId int `form:"host_id" json:"host_id"`
+
+	// DaemonId Restrict the baseline to results from this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Samples Number of recent results to include
+	Samples *int `form:"samples,omitempty" json:"samples,omitempty"`
+}
+
+// GetIperfTestsParams defines parameters for GetIperfTests.
+type GetIperfTestsParams struct {
+	// Limit Maximum number of results to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip. Ignored when a cursor is given.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue the listing after the page that returned this
+	// next_cursor. Unlike offset, cursors do not skip or repeat
+	// results while new ones arrive. Keep the same filters and sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// HostId Filter by host ID
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostName Filter by host name (partial match)
+	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+
+	// HostType Filter by host type
+	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order
+	Sort *IperfTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Slowest Sort by slowest received speed first, the same as sort=received_mbps. Ignored when sort is given.
+	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+
+	// Quarantined List quarantined results, whose timestamps were outside the clock skew window, instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+}
+
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Status Filter by job status
+	Status *JobStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Type Filter by job type
+	Type *JobType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DaemonId Filter by pinned or leasing daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Limit Maximum number of jobs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetJobParams defines parameters for GetJob.
+type GetJobParams struct {
+	// WaitSeconds Long-poll for up to this many seconds until the job finishes
+	WaitSeconds *int `form:"wait_seconds,omitempty" json:"wait_seconds,omitempty"`
+}
+
+// GetMeshMatrixParams defines parameters for GetMeshMatrix.
+type GetMeshMatrixParams struct {
+	// Window How far back to look, as a Go duration (e.g. 24h, 168h)
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetRunsParams defines parameters for GetRuns.
+type GetRunsParams struct {
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Type Filter by test type
+	Type *JobType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Trigger Filter by what caused the run
+	Trigger *TestTrigger `form:"trigger,omitempty" json:"trigger,omitempty"`
+
+	// Outcome Filter by run outcome
+	Outcome *RunOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// HostId Filter by target host
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// StartTime Filter runs started after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter runs started before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSpeedTestBaselineParams defines parameters for GetSpeedTestBaseline.
+type GetSpeedTestBaselineParams struct {
+	// DaemonId Restrict the baseline to results from this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Samples Number of recent results to include
+	Samples *int `form:"samples,omitempty" json:"samples,omitempty"`
+}
+
+// GetSpeedTestsParams defines parameters for GetSpeedTests.
+type GetSpeedTestsParams struct {
+	// Limit Maximum number of results to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip. Ignored when a cursor is given.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue the listing after the page that returned this
+	// next_cursor. Unlike offset, cursors do not skip or repeat
+	// results while new ones arrive. Keep the same filters and sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// ServerName Filter by server name (partial match)
+	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order
+	Sort *SpeedTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Slowest Sort by slowest download first, the same as sort=download_mbps. Ignored when sort is given.
+	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+
+	// Quarantined List quarantined results, whose timestamps were outside the clock skew window, instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+}
+
+// GetIperfStatsParams defines parameters for GetIperfStats.
+type GetIperfStatsParams struct {
+	// StartTime Start of the range (RFC3339), defaults to 24 hours before end_time
+	StartTime *StatsStartTime `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime End of the range (RFC3339), exclusive, defaults to now
+	EndTime *StatsEndTime `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Bucket Width of each point. Without a bucket the whole range is one point.
+	Bucket *StatsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy Split the results into one series per value of these dimensions
+	GroupBy *[]IperfStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DaemonId Only aggregate results from this daemon
+	DaemonId *StatsDaemonId `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+}
+
+// GetSpeedTestStatsParams defines parameters for GetSpeedTestStats.
+type GetSpeedTestStatsParams struct {
+	// StartTime Start of the range (RFC3339), defaults to 24 hours before end_time
+	StartTime *StatsStartTime `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime End of the range (RFC3339), exclusive, defaults to now
+	EndTime *StatsEndTime `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Bucket Width of each point. Without a bucket the whole range is one point.
+	Bucket *StatsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy Split the results into one series per value of these dimensions
+	GroupBy *[]SpeedTestStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DaemonId Only aggregate results from this daemon
+	DaemonId *StatsDaemonId `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+}
+
+// CreateCampaignJSONRequestBody defines body for CreateCampaign for application/json ContentType.
+type CreateCampaignJSONRequestBody = CampaignCreation
+
+// CreateDaemonConfigJSONRequestBody defines body for CreateDaemonConfig for application/json ContentType.
+type CreateDaemonConfigJSONRequestBody = DaemonConfigCreation
+
+// UpdateDaemonConfigJSONRequestBody defines body for UpdateDaemonConfig for application/json ContentType.
+type UpdateDaemonConfigJSONRequestBody = DaemonConfigCreation
+
+// RegisterDaemonJSONRequestBody defines body for RegisterDaemon for application/json ContentType.
+type RegisterDaemonJSONRequestBody = DaemonRegistration
+
+// HeartbeatDaemonJSONRequestBody defines body for HeartbeatDaemon for application/json ContentType.
+type HeartbeatDaemonJSONRequestBody = DaemonHeartbeat
+
+// LeaseJobsJSONRequestBody defines body for LeaseJobs for application/json ContentType.
+type LeaseJobsJSONRequestBody = JobLeaseRequest
+
+// RunOnDaemonJSONRequestBody defines body for RunOnDaemon for application/json ContentType.
+type RunOnDaemonJSONRequestBody = RunRequest
+
+// AddHostJSONRequestBody defines body for AddHost for application/json ContentType.
+type AddHostJSONRequestBody = HostCreation
+
+// UpdateHostJSONRequestBody defines body for UpdateHost for application/json ContentType.
+type UpdateHostJSONRequestBody = HostUpdate
+
+// SubmitIperfTestJSONRequestBody defines body for SubmitIperfTest for application/json ContentType.
+type SubmitIperfTestJSONRequestBody = IperfTestSubmission
+
+// CreateJobJSONRequestBody defines body for CreateJob for application/json ContentType.
+type CreateJobJSONRequestBody = JobCreation
+
+// CompleteJobJSONRequestBody defines body for CompleteJob for application/json ContentType.
+type CompleteJobJSONRequestBody = JobCompletion
+
+// SubmitMeshTestJSONRequestBody defines body for SubmitMeshTest for application/json ContentType.
+type SubmitMeshTestJSONRequestBody = MeshTestSubmission
+
+// SubmitResultBatchJSONRequestBody defines body for SubmitResultBatch for application/json ContentType.
+type SubmitResultBatchJSONRequestBody = ResultBatch
+
+// SubmitRunJSONRequestBody defines body for SubmitRun for application/json ContentType.
+type SubmitRunJSONRequestBody = TestRunSubmission
+
+// SubmitSpeedTestJSONRequestBody defines body for SubmitSpeedTest for application/json ContentType.
+type SubmitSpeedTestJSONRequestBody = SpeedTestSubmission
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get campaigns
+	// (GET /campaigns)
+	GetCampaigns(ctx echo.Context, params GetCampaignsParams) error
+	// Create a campaign
+	// (POST /campaigns)
+	CreateCampaign(ctx echo.Context) error
+	// Get a campaign
+	// (GET /campaigns/{campaignId})
+	GetCampaign(ctx echo.Context, campaignId int) error
+	// Get a campaign comparison report
+	// (GET /campaigns/{campaignId}/report)
+	GetCampaignReport(ctx echo.Context, campaignId int) error
+	// Get daemon configs
+	// (GET /daemon-configs)
+	GetDaemonConfigs(ctx echo.Context) error
+	// Add daemon config
+	// (POST /daemon-configs)
+	CreateDaemonConfig(ctx echo.Context) error
+	// Delete daemon config
+	// (DELETE /daemon-configs/{configId})
+	DeleteDaemonConfig(ctx echo.Context, configId int) error
+	// Get daemon config by ID
+	// (GET /daemon-configs/{configId})
+	GetDaemonConfig(ctx echo.Context, configId int) error
+	// Update daemon config
+	// (PUT /daemon-configs/{configId})
+	UpdateDaemonConfig(ctx echo.Context, configId int) error
+	// Get daemons
+	// (GET /daemons)
+	GetDaemons(ctx echo.Context, params GetDaemonsParams) error
+	// Register a daemon
+	// (POST /daemons/register)
+	RegisterDaemon(ctx echo.Context) error
+	// Get daemon by ID
+	// (GET /daemons/{daemonId})
+	GetDaemon(ctx echo.Context, daemonId string) error
+	// Get a daemon's effective configuration
+	// (GET /daemons/{daemonId}/config)
+	GetEffectiveDaemonConfig(ctx echo.Context, daemonId string) error
+	// Daemon heartbeat
+	// (POST /daemons/{daemonId}/heartbeat)
+	HeartbeatDaemon(ctx echo.Context, daemonId string) error
+	// Lease jobs
+	// (POST /daemons/{daemonId}/jobs/lease)
+	LeaseJobs(ctx echo.Context, daemonId string) error
+	// Run a test on a daemon now
+	// (POST /daemons/{daemonId}/run)
+	RunOnDaemon(ctx echo.Context, daemonId string) error
+	// Get dashboard data
+	// (GET /dashboard)
+	GetDashboard(ctx echo.Context) error
+	// Stream events
+	// (GET /events/stream)
+	StreamEvents(ctx echo.Context, params StreamEventsParams) error
+	// Export iperf test results
+	// (GET /export/iperf)
+	ExportIperfTests(ctx echo.Context, params ExportIperfTestsParams) error
+	// Export speed test results
+	// (GET /export/speedtest)
+	ExportSpeedTests(ctx echo.Context, params ExportSpeedTestsParams) error
+	// Get iperf test hosts
+	// (GET /hosts)
+	GetHosts(ctx echo.Context, params GetHostsParams) error
+	// Add new host
+	// (POST /hosts)
+	AddHost(ctx echo.Context) error
+	// Delete host
+	// (DELETE /hosts/{hostId})
+	DeleteHost(ctx echo.Context, hostId int) error
+	// Get host by ID
+	// (GET /hosts/{hostId})
+	GetHost(ctx echo.Context, hostId int) error
+	// Update host
+	// (PUT /hosts/{hostId})
+	UpdateHost(ctx echo.Context, hostId int) error
+	// Get iperf baseline
+	// (GET /iperf/baseline)
+	GetIperfBaseline(ctx echo.Context, params GetIperfBaselineParams) error
+	// Get iperf test results
+	// (GET /iperf/results)
+	GetIperfTests(ctx echo.Context, params GetIperfTestsParams) error
+	// Submit iperf test results
+	// (POST /iperf/results)
+	SubmitIperfTest(ctx echo.Context) error
+	// Delete iperf test result
+	// (DELETE /iperf/results/{testId})
+	DeleteIperfTest(ctx echo.Context, testId int) error
+	// Get jobs
+	// (GET /jobs)
+	GetJobs(ctx echo.Context, params GetJobsParams) error
+	// Enqueue a job
+	// (POST /jobs)
+	CreateJob(ctx echo.Context) error
+	// Get job by ID
+	// (GET /jobs/{jobId})
+	GetJob(ctx echo.Context, jobId int, params GetJobParams) error
+	// Complete a leased job
+	// (POST /jobs/{jobId}/complete)
+	CompleteJob(ctx echo.Context, jobId int) error
+	// Get the mesh throughput matrix
+	// (GET /mesh/matrix)
+	GetMeshMatrix(ctx echo.Context, params GetMeshMatrixParams) error
+	// Submit a mesh test result
+	// (POST /mesh/results)
+	SubmitMeshTest(ctx echo.Context) error
+	// Submit a batch of results
+	// (POST /results/batch)
+	SubmitResultBatch(ctx echo.Context) error
+	// Get test runs
+	// (GET /runs)
+	GetRuns(ctx echo.Context, params GetRunsParams) error
+	// Record a test run
+	// (POST /runs)
+	SubmitRun(ctx echo.Context) error
+	// Get speed test baseline
+	// (GET /speedtest/baseline)
+	GetSpeedTestBaseline(ctx echo.Context, params GetSpeedTestBaselineParams) error
+	// Get speed test results
+	// (GET /speedtest/results)
+	GetSpeedTests(ctx echo.Context, params GetSpeedTestsParams) error
+	// Submit speed test results
+	// (POST /speedtest/results)
+	SubmitSpeedTest(ctx echo.Context) error
+	// Delete speed test result
+	// (DELETE /speedtest/results/{testId})
+	DeleteSpeedTest(ctx echo.Context, testId int) error
+	// Get iperf statistics
+	// (GET /stats/iperf)
+	GetIperfStats(ctx echo.Context, params GetIperfStatsParams) error
+	// Get speed test statistics
+	// (GET /stats/speedtest)
+	GetSpeedTestStats(ctx echo.Context, params GetSpeedTestStatsParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetCampaigns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCampaigns(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCampaignsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCampaigns(ctx, params)
+	return err
+}
+
+// CreateCampaign converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCampaign(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCampaign(ctx)
+	return err
+}
+
+// GetCampaign converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCampaign(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "campaignId" -------------
+	var campaignId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "campaignId", ctx.Param("campaignId"), &campaignId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter campaignId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCampaign(ctx, campaignId)
+	return err
+}
+
+// GetCampaignReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCampaignReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "campaignId" -------------
+	var campaignId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "campaignId", ctx.Param("campaignId"), &campaignId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter campaignId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCampaignReport(ctx, campaignId)
+	return err
+}
+
+// GetDaemonConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDaemonConfigs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDaemonConfigs(ctx)
+	return err
+}
+
+// CreateDaemonConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDaemonConfig(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDaemonConfig(ctx)
+	return err
+}
+
+// DeleteDaemonConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDaemonConfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "configId" -------------
+	var configId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "configId", ctx.Param("configId"), &configId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter configId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDaemonConfig(ctx, configId)
+	return err
+}
+
+// GetDaemonConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDaemonConfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "configId" -------------
+	var configId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "configId", ctx.Param("configId"), &configId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter configId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDaemonConfig(ctx, configId)
+	return err
+}
+
+// UpdateDaemonConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDaemonConfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "configId" -------------
+	var configId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "configId", ctx.Param("configId"), &configId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter configId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDaemonConfig(ctx, configId)
+	return err
+}
+
+// GetDaemons converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDaemons(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDaemonsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDaemons(ctx, params)
+	return err
+}
+
+// RegisterDaemon converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterDaemon(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegisterDaemon(ctx)
+	return err
+}
+
+// GetDaemon converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDaemon(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "daemonId" -------------
+	var daemonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "daemonId", ctx.Param("daemonId"), &daemonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemonId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDaemon(ctx, daemonId)
+	return err
+}
+
+// GetEffectiveDaemonConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEffectiveDaemonConfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "daemonId" -------------
+	var daemonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "daemonId", ctx.Param("daemonId"), &daemonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemonId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEffectiveDaemonConfig(ctx, daemonId)
+	return err
+}
+
+// HeartbeatDaemon converts echo context to params.
+func (w *ServerInterfaceWrapper) HeartbeatDaemon(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "daemonId" -------------
+	var daemonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "daemonId", ctx.Param("daemonId"), &daemonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemonId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HeartbeatDaemon(ctx, daemonId)
+	return err
+}
+
+// LeaseJobs converts echo context to params.
+func (w *ServerInterfaceWrapper) LeaseJobs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "daemonId" -------------
+	var daemonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "daemonId", ctx.Param("daemonId"), &daemonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemonId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LeaseJobs(ctx, daemonId)
+	return err
+}
+
+// RunOnDaemon converts echo context to params.
+func (w *ServerInterfaceWrapper) RunOnDaemon(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "daemonId" -------------
+	var daemonId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "daemonId", ctx.Param("daemonId"), &daemonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemonId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RunOnDaemon(ctx, daemonId)
+	return err
+}
+
+// GetDashboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboard(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDashboard(ctx)
+	return err
+}
+
+// StreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_id", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamEvents(ctx, params)
+	return err
+}
+
+// ExportIperfTests converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportIperfTests(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportIperfTestsParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_id", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_name", ctx.QueryParams(), &params.HostName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_type", ctx.QueryParams(), &params.HostType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "quarantined" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "quarantined", ctx.QueryParams(), &params.Quarantined)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter quarantined: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportIperfTests(ctx, params)
+	return err
+}
+
+// ExportSpeedTests converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportSpeedTests(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportSpeedTestsParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "server_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "server_name", ctx.QueryParams(), &params.ServerName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter server_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "quarantined" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "quarantined", ctx.QueryParams(), &params.Quarantined)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter quarantined: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportSpeedTests(ctx, params)
+	return err
+}
+
+// GetHosts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHosts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHostsParams
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHosts(ctx, params)
+	return err
 }
 
-// GetIperfTestsParams defines parameters for GetIperfTests.
-type GetIperfTestsParams struct {
-	// Limit Maximum number of results to return
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// AddHost converts echo context to params.
+func (w *ServerInterfaceWrapper) AddHost(ctx echo.Context) error {
+	var err error
 
-	// Offset Number of results to skip
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	// StartTime Filter results after this timestamp (RFC3339)
-	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddHost(ctx)
+	return err
+}
 
-	// EndTime Filter results before this timestamp (RFC3339)
-	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+// DeleteHost converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostId" -------------
+	var hostId int
 
-	// HostId Filter by host ID
-	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "hostId", ctx.Param("hostId"), &hostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+	}
 
-	// DaemonId Filter by daemon ID
-	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	// HostName Filter by host name (partial match)
-	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteHost(ctx, hostId)
+	return err
+}
 
-	// HostType Filter by host type
-	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+// GetHost converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostId" -------------
+	var hostId int
 
-	// Slowest Sort by slowest results first
-	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "hostId", ctx.Param("hostId"), &hostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHost(ctx, hostId)
+	return err
 }
 
-// GetSpeedTestsParams defines parameters for GetSpeedTests.
-type GetSpeedTestsParams struct {
-	// Limit Maximum number of results to return
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// UpdateHost converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateHost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostId" -------------
+	var hostId int
 
-	// Offset Number of results to skip
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "hostId", ctx.Param("hostId"), &hostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+	}
 
-	// StartTime Filter results after this timestamp (RFC3339)
-	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	// EndTime Filter results before this timestamp (RFC3339)
-	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateHost(ctx, hostId)
+	return err
+}
 
-	// DaemonId Filter by daemon ID
-	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+// GetIperfBaseline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIperfBaseline(ctx echo.Context) error {
+	var err error
 
-	// ServerName Filter by server name (partial match)
-	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	// Slowest Sort by slowest results first
-	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIperfBaselineParams
+	// ------------- Required query parameter "host_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "host_id", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "samples" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "samples", ctx.QueryParams(), &params.Samples)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter samples: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetIperfBaseline(ctx, params)
+	return err
 }
 
-// AddHostJSONRequestBody defines body for AddHost for application/json ContentType.
-type AddHostJSONRequestBody = HostCreation
+// GetIperfTests converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIperfTests(ctx echo.Context) error {
+	var err error
 
-// UpdateHostJSONRequestBody defines body for UpdateHost for application/json ContentType.
-type UpdateHostJSONRequestBody = HostUpdate
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-// SubmitIperfTestJSONRequestBody defines body for SubmitIperfTest for application/json ContentType.
-type SubmitIperfTestJSONRequestBody = IperfTestSubmission
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIperfTestsParams
+	// ------------- Optional query parameter "limit" -------------
 
-// SubmitSpeedTestJSONRequestBody defines body for SubmitSpeedTest for application/json ContentType.
-type SubmitSpeedTestJSONRequestBody = SpeedTestSubmission
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get dashboard data
-	// (GET /dashboard)
-	GetDashboard(ctx echo.Context) error
-	// Get iperf test hosts
-	// (GET /hosts)
-	GetHosts(ctx echo.Context, params GetHostsParams) error
-	// Add new host
-	// (POST /hosts)
-	AddHost(ctx echo.Context) error
-	// Delete host
-	// (DELETE /hosts/{hostId})
-	DeleteHost(ctx echo.Context, hostId int) error
-	// Get host by ID
-	// (GET /hosts/{hostId})
-	GetHost(ctx echo.Context, hostId int) error
-	// Update host
-	// (PUT /hosts/{hostId})
-	UpdateHost(ctx echo.Context, hostId int) error
-	// Get iperf test results
-	// (GET /iperf/results)
-	GetIperfTests(ctx echo.Context, params GetIperfTestsParams) error
-	// Submit iperf test results
-	// (POST /iperf/results)
-	SubmitIperfTest(ctx echo.Context) error
-	// Delete iperf test result
-	// (DELETE /iperf/results/{testId})
-	DeleteIperfTest(ctx echo.Context, testId int) error
-	// Get speed test results
-	// (GET /speedtest/results)
-	GetSpeedTests(ctx echo.Context, params GetSpeedTestsParams) error
-	// Submit speed test results
-	// (POST /speedtest/results)
-	SubmitSpeedTest(ctx echo.Context) error
-	// Delete speed test result
-	// (DELETE /speedtest/results/{testId})
-	DeleteSpeedTest(ctx echo.Context, testId int) error
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_id", ctx.QueryParams(), &params.HostId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_name", ctx.QueryParams(), &params.HostName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "host_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "host_type", ctx.QueryParams(), &params.HostType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "slowest" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "slowest", ctx.QueryParams(), &params.Slowest)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slowest: %s", err))
+	}
+
+	// ------------- Optional query parameter "quarantined" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "quarantined", ctx.QueryParams(), &params.Quarantined)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter quarantined: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetIperfTests(ctx, params)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// SubmitIperfTest converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitIperfTest(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitIperfTest(ctx)
+	return err
 }
 
-// GetDashboard converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDashboard(ctx echo.Context) error {
+// DeleteIperfTest converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteIperfTest(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "testId" -------------
+	var testId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "testId", ctx.Param("testId"), &testId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter testId: %s", err))
+	}
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDashboard(ctx)
+	err = w.Handler.DeleteIperfTest(ctx, testId)
 	return err
 }
 
-// GetHosts converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHosts(ctx echo.Context) error {
+// GetJobs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJobs(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetHostsParams
+	var params GetJobsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
 	// ------------- Optional query parameter "type" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
@@ -460,117 +2711,157 @@ func (w *ServerInterfaceWrapper) GetHosts(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
 	}
 
-	// ------------- Optional query parameter "active" -------------
+	// ------------- Optional query parameter "daemon_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHosts(ctx, params)
+	err = w.Handler.GetJobs(ctx, params)
 	return err
 }
 
-// AddHost converts echo context to params.
-func (w *ServerInterfaceWrapper) AddHost(ctx echo.Context) error {
+// CreateJob converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateJob(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AddHost(ctx)
+	err = w.Handler.CreateJob(ctx)
 	return err
 }
 
-// DeleteHost converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteHost(ctx echo.Context) error {
+// GetJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJob(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "hostId" -------------
-	var hostId int
+	// ------------- Path parameter "jobId" -------------
+	var jobId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hostId", ctx.Param("hostId"), &hostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", ctx.Param("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobId: %s", err))
 	}
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobParams
+	// ------------- Optional query parameter "wait_seconds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait_seconds", ctx.QueryParams(), &params.WaitSeconds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait_seconds: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteHost(ctx, hostId)
+	err = w.Handler.GetJob(ctx, jobId, params)
 	return err
 }
 
-// GetHost converts echo context to params.
-func (w *ServerInterfaceWrapper) GetHost(ctx echo.Context) error {
+// CompleteJob converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteJob(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "hostId" -------------
-	var hostId int
+	// ------------- Path parameter "jobId" -------------
+	var jobId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hostId", ctx.Param("hostId"), &hostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", ctx.Param("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobId: %s", err))
 	}
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHost(ctx, hostId)
+	err = w.Handler.CompleteJob(ctx, jobId)
 	return err
 }
 
-// UpdateHost converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateHost(ctx echo.Context) error {
+// GetMeshMatrix converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMeshMatrix(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "hostId" -------------
-	var hostId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "hostId", ctx.Param("hostId"), &hostId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeshMatrixParams
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMeshMatrix(ctx, params)
+	return err
+}
+
+// SubmitMeshTest converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitMeshTest(ctx echo.Context) error {
+	var err error
+
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateHost(ctx, hostId)
+	err = w.Handler.SubmitMeshTest(ctx)
 	return err
 }
 
-// GetIperfTests converts echo context to params.
-func (w *ServerInterfaceWrapper) GetIperfTests(ctx echo.Context) error {
+// SubmitResultBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitResultBatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SubmitResultBatch(ctx)
+	return err
+}
+
+// GetRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRuns(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetIperfTestsParams
-	// ------------- Optional query parameter "limit" -------------
+	var params GetRunsParams
+	// ------------- Optional query parameter "daemon_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "type" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
 	}
 
-	// ------------- Optional query parameter "start_time" -------------
+	// ------------- Optional query parameter "trigger" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
+	err = runtime.BindQueryParameter("form", true, false, "trigger", ctx.QueryParams(), &params.Trigger)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trigger: %s", err))
 	}
 
-	// ------------- Optional query parameter "end_time" -------------
+	// ------------- Optional query parameter "outcome" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
+	err = runtime.BindQueryParameter("form", true, false, "outcome", ctx.QueryParams(), &params.Outcome)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter outcome: %s", err))
 	}
 
 	// ------------- Optional query parameter "host_id" -------------
@@ -580,65 +2871,67 @@ func (w *ServerInterfaceWrapper) GetIperfTests(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "daemon_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
-	}
-
-	// ------------- Optional query parameter "host_name" -------------
+	// ------------- Optional query parameter "start_time" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "host_name", ctx.QueryParams(), &params.HostName)
+	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
 	}
 
-	// ------------- Optional query parameter "host_type" -------------
+	// ------------- Optional query parameter "end_time" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "host_type", ctx.QueryParams(), &params.HostType)
+	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter host_type: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
 	}
 
-	// ------------- Optional query parameter "slowest" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "slowest", ctx.QueryParams(), &params.Slowest)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slowest: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetIperfTests(ctx, params)
+	err = w.Handler.GetRuns(ctx, params)
 	return err
 }
 
-// SubmitIperfTest converts echo context to params.
-func (w *ServerInterfaceWrapper) SubmitIperfTest(ctx echo.Context) error {
+// SubmitRun converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitRun(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SubmitIperfTest(ctx)
+	err = w.Handler.SubmitRun(ctx)
 	return err
 }
 
-// DeleteIperfTest converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteIperfTest(ctx echo.Context) error {
+// GetSpeedTestBaseline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSpeedTestBaseline(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "testId" -------------
-	var testId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "testId", ctx.Param("testId"), &testId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpeedTestBaselineParams
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter testId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
 	}
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// ------------- Optional query parameter "samples" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "samples", ctx.QueryParams(), &params.Samples)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter samples: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteIperfTest(ctx, testId)
+	err = w.Handler.GetSpeedTestBaseline(ctx, params)
 	return err
 }
 
@@ -664,6 +2957,13 @@ func (w *ServerInterfaceWrapper) GetSpeedTests(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// ------------- Optional query parameter "start_time" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
@@ -692,6 +2992,13 @@ func (w *ServerInterfaceWrapper) GetSpeedTests(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter server_name: %s", err))
 	}
 
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
 	// ------------- Optional query parameter "slowest" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "slowest", ctx.QueryParams(), &params.Slowest)
@@ -699,6 +3006,13 @@ func (w *ServerInterfaceWrapper) GetSpeedTests(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slowest: %s", err))
 	}
 
+	// ------------- Optional query parameter "quarantined" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "quarantined", ctx.QueryParams(), &params.Quarantined)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter quarantined: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetSpeedTests(ctx, params)
 	return err
@@ -733,6 +3047,102 @@ func (w *ServerInterfaceWrapper) DeleteSpeedTest(ctx echo.Context) error {
 	return err
 }
 
+// GetIperfStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIperfStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIperfStatsParams
+	// ------------- Optional query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetIperfStats(ctx, params)
+	return err
+}
+
+// GetSpeedTestStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSpeedTestStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpeedTestStatsParams
+	// ------------- Optional query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_time: %s", err))
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "daemon_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "daemon_id", ctx.QueryParams(), &params.DaemonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter daemon_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSpeedTestStats(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -761,75 +3171,286 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/campaigns", wrapper.GetCampaigns)
+	router.POST(baseURL+"/campaigns", wrapper.CreateCampaign)
+	router.GET(baseURL+"/campaigns/:campaignId", wrapper.GetCampaign)
+	router.GET(baseURL+"/campaigns/:campaignId/report", wrapper.GetCampaignReport)
+	router.GET(baseURL+"/daemon-configs", wrapper.GetDaemonConfigs)
+	router.POST(baseURL+"/daemon-configs", wrapper.CreateDaemonConfig)
+	router.DELETE(baseURL+"/daemon-configs/:configId", wrapper.DeleteDaemonConfig)
+	router.GET(baseURL+"/daemon-configs/:configId", wrapper.GetDaemonConfig)
+	router.PUT(baseURL+"/daemon-configs/:configId", wrapper.UpdateDaemonConfig)
+	router.GET(baseURL+"/daemons", wrapper.GetDaemons)
+	router.POST(baseURL+"/daemons/register", wrapper.RegisterDaemon)
+	router.GET(baseURL+"/daemons/:daemonId", wrapper.GetDaemon)
+	router.GET(baseURL+"/daemons/:daemonId/config", wrapper.GetEffectiveDaemonConfig)
+	router.POST(baseURL+"/daemons/:daemonId/heartbeat", wrapper.HeartbeatDaemon)
+	router.POST(baseURL+"/daemons/:daemonId/jobs/lease", wrapper.LeaseJobs)
+	router.POST(baseURL+"/daemons/:daemonId/run", wrapper.RunOnDaemon)
 	router.GET(baseURL+"/dashboard", wrapper.GetDashboard)
+	router.GET(baseURL+"/events/stream", wrapper.StreamEvents)
+	router.GET(baseURL+"/export/iperf", wrapper.ExportIperfTests)
+	router.GET(baseURL+"/export/speedtest", wrapper.ExportSpeedTests)
 	router.GET(baseURL+"/hosts", wrapper.GetHosts)
 	router.POST(baseURL+"/hosts", wrapper.AddHost)
 	router.DELETE(baseURL+"/hosts/:hostId", wrapper.DeleteHost)
 	router.GET(baseURL+"/hosts/:hostId", wrapper.GetHost)
 	router.PUT(baseURL+"/hosts/:hostId", wrapper.UpdateHost)
+	router.GET(baseURL+"/iperf/baseline", wrapper.GetIperfBaseline)
 	router.GET(baseURL+"/iperf/results", wrapper.GetIperfTests)
 	router.POST(baseURL+"/iperf/results", wrapper.SubmitIperfTest)
 	router.DELETE(baseURL+"/iperf/results/:testId", wrapper.DeleteIperfTest)
+	router.GET(baseURL+"/jobs", wrapper.GetJobs)
+	router.POST(baseURL+"/jobs", wrapper.CreateJob)
+	router.GET(baseURL+"/jobs/:jobId", wrapper.GetJob)
+	router.POST(baseURL+"/jobs/:jobId/complete", wrapper.CompleteJob)
+	router.GET(baseURL+"/mesh/matrix", wrapper.GetMeshMatrix)
+	router.POST(baseURL+"/mesh/results", wrapper.SubmitMeshTest)
+	router.POST(baseURL+"/results/batch", wrapper.SubmitResultBatch)
+	router.GET(baseURL+"/runs", wrapper.GetRuns)
+	router.POST(baseURL+"/runs", wrapper.SubmitRun)
+	router.GET(baseURL+"/speedtest/baseline", wrapper.GetSpeedTestBaseline)
 	router.GET(baseURL+"/speedtest/results", wrapper.GetSpeedTests)
 	router.POST(baseURL+"/speedtest/results", wrapper.SubmitSpeedTest)
 	router.DELETE(baseURL+"/speedtest/results/:testId", wrapper.DeleteSpeedTest)
+	router.GET(baseURL+"/stats/iperf", wrapper.GetIperfStats)
+	router.GET(baseURL+"/stats/speedtest", wrapper.GetSpeedTestStats)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbe28bNxL/KgTvgLaALK0kq3X01/nstvE1aY3YQQ+XGgK1O5LY7JIbkitHF/i7H/jY",
-	"N7UrO3bqAwIEiKUlOcOZ37xXn3DIk5QzYEri+Scsww0kxPx5TuRmyYmIzoki+otU8BSEomAek1DRLSw2",
-	"XNqdEchQ0FRRzvAcv6JSIb5CdhUyqxDZEhqTZQxoxQVSIBVlazzAVEFizvi7gBWe47+NSqZGjqPRSy4V",
-	"vhtgtUsBzzERguz0ZwEhMLWgKYjVQp/p4eaNWYPMGkMXCZBZrOShxC/0zmuQ6o3Z18GHTAGiHj7Mmgfx",
-	"caV3dvMhFVFUKhrK++rs1yxZgmhqDQ8wfCRJGgOezwpylClYg9AEyXa9iPgtizmJFsky9Zx8ugVB1oDy",
-	"ZU4CfAsCxUQqNDneVOkcz06GkwFecZEQhec44tkyBlxQZ4bTnHiWHkDaLuojPD55MfzhIMKKKxJ3o+5a",
-	"L0GskGoJv5pQpyeTsU+ulkInnpoUSmDVKIxnx5M2hRI7fPknhMp8I+BDRgVEeP7Oh2ivuQ3quKpB8KZF",
-	"ZIB/FIKLNjgjUITGFqdRRPUVSXxZWaJEBoOmeouVCPSxKD/FQxdyuvUjDDso5BEg7ZX0rors8JbENCJ6",
-	"7cIeUJwsldD+626AE5CSrKF99sssIexIAImM27Ms5qurVK43gL6pWdE3aEUhjhCVKFcKIixCSSYVWgIi",
-	"KOWSGjt1qGwx1tBnzn5O36cb42W1BuL4txWev+v3yWcCjHTw3aCp0VA/gmhBVFsyv2+AIbWxTgbdEonc",
-	"6ppYJsHk+CgYH41n1+NgHuh//8FV+yQKjhRNwKcVGrXJvmX0QwaIRsAUXVEQNhQ5PmpG47PJLI3ucSPj",
-	"YdyWjmtN73Othk6pPrgi5xqLbQXfOBUXStsTIuztVkTHGK/d/b4BtYFSchqmLmrUY7tjYMl5DESDpH5Q",
-	"U4i/pc6aK19rz+bTEL4UNCFih16d/ookiK3TpnZNWpIshAojCfn4CthabfB8FgQeuOjjGUl8VuyeaP9w",
-	"cYlIFAmQNQ+Lxy8mw/H3J8PxcBwEdWqT2WyAE8ryz2MP7T10697DsLAPrvg1oQxdGSnU6Y/1bXvop1x4",
-	"EH3Jhcqji6ZrnP7UibqWF0yCsaFJkyzB8+9ns6m9s/3sD2/mm37/cq3XNWFv5FVRmTvOXWSfY7t2JBtB",
-	"dJeChpiBsb5mSBSsuaD/pWyNGKhbLt6XQZXpG73DMWF4gLcpMzEx4arqT0vBarJvjUU+mletWuijmOSd",
-	"1000U96D2S82XmXLhEr5GbHBZsfGl0rFdQSk9oHcSQVJj0+dHR4qTGhc7A3jP1bjNqKuhlgRGjcc+xln",
-	"DELjtDQpnim8x9McWu7cL4hVaopaLJtMj725u8zCULuyTjCZQ40O7OpVFlcPt+HBA6ruOGWEcNMNvQqC",
-	"2ukigYSzhU8+F6VgXOiwi5HaEJWHB50ou8vVVGiXHgXB2Ke6KBM2F5QQchb5cnItrXyZxmu+sqqPoM87",
-	"aun473ae30kRsQZ1WN6SAGELodQi8XD8GghDgmcsOlKCpga5mvGExjH1ce+vzYoLBZ5yKRVc8ZDHniDj",
-	"nqBMQlQDcsXbXp9d4gF+e36pEVPJms3XLSXpGoVuYV9J+MY9RmojeLbepJnS132tF1dOf3EyHY7vfVEB",
-	"ShAmE9pdYZfLFEQoJeF7qNdswb0pS12X+W98BUz13nb2ALVqqEhFkrTDiRfuozS8b9/8dDadTl9890RZ",
-	"cclWaUlVATUhUsGnx8QHFV/jSy2aTZmDI2Wx8f8oUj5lPOqJGf5g4RPicwkW3V2x83o3zGeU4x+Gkxf3",
-	"tkr4qEAw057y5DLuYaWS8bveCl6mw2A4Hk+H3ltS6aFywTQVUKYioSGgS8G3NKqXDfiMJ6Gukc9IrctW",
-	"nv2n9o/CG7X+ZR51xanJA9x3StnaS+5SFwMxUcDCXWdwnA1fHD8gamgTWWTCEyDfvnmFFEerLI6bPeNS",
-	"khulUjkfjW5vb4cGTnrlkIEa2dUjY3JVK88E9UncFndeS7kq29au2r44rxfBjsa+Q/1VbvtYV9K1cOI7",
-	"+rlEoAHubEO/TbstfTqbDmf3jb8dUa/ueurMlSjvjmxGcWEmqNpd6YhlXelpSn+B3WmmNp5m++UFeg87",
-	"40qkNfwjxY/cn4hkaqOdbWhL2wGmetMGiPULFh7430enlxdHv8CuFDIxNPGdZomyFTdhkTNFQhMTISE0",
-	"1rrIUl3//8NJdRjypDzWwuxsA+F7EOj08qLVizLsG9Z1JFG6WjYdV52rUdhWuwGVCY5e0R4sDf9g1xsq",
-	"NR2LaomINGAMgSlBYhQRRVBMdi5a2hNDx55VizSH38ISRfksbviHlltMQ2DS2JK73euLa61l7T8KX8BT",
-	"YJJnIoQhF+uR2yRHeq2xHBX7BTPAWxA2kOLxMBgGerk+jaQUz7GOBlONIqI2BhKjgj39aQ3Kl3MbGUJ5",
-	"EySzxHTvjCAoC+Ms0iK2AwbbbzH3r0wTDBc2O7uI8Bz/DKqYUpqkTqZcX1HTnwRBDhNgNilL09hhb/Sn",
-	"tGmCTcX6ivH6KNTAsBHKi1uZ2zjEQFQpmeOdtSh7a8t8RRp6nwY8WUtty6VEb/SuUTGy65ZuyNmKrjOT",
-	"/5WYzOcyLeG9dA9SIkgCCoQ0OWv98J9orAPtcme7Sq7RZkz3QwZiV5qYe3SYTKu9vf0kXQNLYyCTe8i6",
-	"hliVcKsbcfOZ6PiMEXUbLUbsh4PEo8kcJvbzjengSg8wTqMIEcTgtnlICw2nUfTSfq9DCkj1Tx7tHs2A",
-	"6i3NeuBSIoO7lnrGj0p7nxbyoVNDBQN8/Ijew847PRxcMDNcRE7i1gfU9a8VqNXndNbUe+EaRp/0fxfR",
-	"ncVADMqTaZ2b7xFxrW7Bk3qJWEeEXV2AoqadY/+QBFnKPnEeP704DQeMK7TiGYsagnR33yPHQY9fJTo+",
-	"h3RFQyu75Q5RJW3+6/WqTxmNOgG9z6s8Bx387NqVWnxGdB431hWJzOFmowkDOv8oo4DFP256lmpU6Gq5",
-	"6giRZsqXueusHxGG4CM1IxR7hzzU5rlsHQZ21xN7VDdYOsiffiH4uenzc/KnzwH5DkQdXtzE51Fe2vcm",
-	"eu2KA91StUE8n5+vTAplB34tD1UMWHqTv9d2plt5xygnp7j2NZlge7KymCZU1ZKy4oUCO5HOp8XjIOgZ",
-	"h7QTxF997Mj3NN3DDF+tJOzhJvDW2B3EXXaaUyYrZQZlVKKiAq/1F3wMSUWEWrhWQsnUYb3uHoaWsOIC",
-	"7ssRsOiR+ckrhtJjN0hWWvPNzL1f/Mtd3qjde37Z3PBQOJh989rFtykRipIYJUSFm++6LuS6Vw8n2FFh",
-	"GQKPVmZdcWFisYz5bdWTrKgwfsoLXLvWb0orEkvfLPhzq696M996Fg9WBrmde59VfOtjvfNrXtHsfy3T",
-	"YMb2NvLO7QEvY7bDW9vnP6SGrDDh4pB52FFFmsmKapaRBVp0HUGcMbaijd1byPKJsiHvOydftsxsweUA",
-	"BeadxueWMM2+DHE3iHJdf3ALq9B1yDsIva0savRJ7zi0Ji4KvBaxjmrP7q6ju69QbqPgL62a2+z0lNAt",
-	"+fg9SVdW2aa5r6qzGvyMqk6johiIHZ5ft3+icZ/8uphJf82vv+bXT5lfP3kGXJnIHpgDV2e99yL6NRv9",
-	"zF9+fdls9KrtIbt6j18wmzEYQRW3206Hvb/Ay4NYES76U2I7W2mddlBOXGj0iXJi79tlXzYnboH2ABh9",
-	"zYkPy4kPhrA3A3pgbtxGel9uXEd5X27cRsNfmhu32enJjVvy2e9WurLCNt2ny48rb/kYTqrv97y70cHS",
-	"YtHH5ysemp9qbSHmaWJ+2pz/Nqh8FWU+GsV63YZLNT8JToIRSeloO8btFOBS8CizP5/wHCTnIwtk85bM",
-	"sPKWT3HiTSHufpEWaJWVfKLQUZu5dtHiO8FWQO3dZjyQEEbWYATl22vnAe29jbdLfFvL90Xubu7+FwAA",
-	"//8er0nu1T8AAA==",
+	"H4sIAAAAAAAC/+y9C3PcNrIo/FdQ831VsW9Ro5eVh1yn7nVsZ6NsHPtY8p5Td8elYEjMDGwOyACgHifl",
+	"/34L3QAIkiCHI0uyNuutrVhDEq9Go9Hv/nOSFuuyEExoNTn+c1JSSddMMwm/TjXV6scq/ci0+ZkxlUpe",
+	"al6IyfHkv3imV6RYEEbTFSkLLvSU/BfXq6LShJI5NCN6xcjlqsgZkVQsGeGKFILZzyfJhJuu/qiYvJ4k",
+	"E0HXbHI8waaTZKLSFVtTM/T/L9licjz5/3br6e7iW7UbzvLTpwRn/YKydSFOsu68X4v8mtDlUrIl1YxI",
+	"pqpcK7KQxZroFVckg6Y9c8OX5zxrTE9fl+al0pKLZT2JlyI742vWncNLkRnI6ZUDy6O3Pz0/PDz84XFC",
+	"2FWaV4pfsIRkbEFhcrogorjsmRIT2bk2w4QzWhRyTTVMWLMd+7pnmqeaSh2fKLzqnWo4v4MnZFVUUpE5",
+	"WxSSkWBWsUkr0/ENp/3JtQAkfZYzCehZyqJkUnMGjz9ykW3CHGj6d/Php2SyZkrRJcCAXdF1mZsxT0om",
+	"F0QzpQldUi6UJq8oF+SUyQsmyYLynGVRyEr2R8UlyybH/8S51CO8998X8w8s1Wb0eiqdPeBmDuc41DGh",
+	"gvB6UpIKQkVmJ0IemYeKzPMi/cgyMr8mVMxEVSotGV3DV5VkhEpGRKEJNYOyjBTi8dQehPM/Kiqp0FzA",
+	"YPbpTFxSRYJXZFFIkpphiPrILqczc16YqNZmueGEJ8kEuwg7nrxvQyyZXO2Y5jsXVBoMUaYfDxPYhZ9c",
+	"f/7xW+j4P8N+PyWTH6liOResixJZcSnygmbn63mpunB+xTJOBXFfkUcIZ8lSxi9Y9pjolSyq5aqsNOGC",
+	"vDKdJDWu/LD/3fQwCTC4qOZ5gL6iWs+ZNHtdcrE8X/fPIKeaifTaTWDNqCBvz84em1HXPM+5Ymkhssbo",
+	"+0fTH0YNrqBBZPDf4BNz1h1JNGd+bqFJDAKYI1QZhDHEMhz9YM+PxIVmSxyqKjdDG79xS1VM6E1wPjw6",
+	"nB6NWGrrDLp1Jy00aE4zdjSf03VJ+VKYNdA8f72YHP9zmK64Fs8lo7DcT0kbF1PzimXnVI8le4m9eyKw",
+	"xMsONyy1Y3+jyIdirsglA2L8R8UqPLaTZMI1Q/TrDGEfUCnptfnNswY9/C62zWaYyfE4iPxSzJ8XldDK",
+	"NFSa6mp001P8ur2veBPjO7wXsipHwCYhlGvw2Rl3t/p9sNl+67pUxHEAgxtBNdH0IyOluT8NqyGznCll",
+	"r1IuSU7nLFdbbYYdWbGcpbqQgI9Zxs3YNH/TmGQXd6LzTKmU11wsCc1zOzXF7NTq+YdsnRt8JgpJalAQ",
+	"dsHktVkoV5pJltl3CAmu4LrJGM2gV4Xd4qXhcOvPiSxy2I7Fgqds8qmzP61VdBjS1XXjBADJkpWIHqVK",
+	"wvaeO1Iav3XxknUfG2oUI717yWTNBV+b228/dkJWhdJxhPm5UNoBz0JMVkK1bnnLeiRmAoXMmAyH/+d+",
+	"cvi+i0bB8G08QhasM5VqTcXOQnImsvy6hiJ8HYw3ebbQTJKT0zdkTc0ogoo0Sq8ahzGyXUzAftlzSYAl",
+	"hCfIxTxqsb+PJ8lIQqlKxjLTCY4KvUyOFzRXrH0S3lbCYLVpgLAuRGM76u7nRZEzKjoECOAzdG/gYXvL",
+	"yiLGp9YCRfTY4lu3Y533gCTmjd/+MaQU+CnknmL40QDfKNJsGpwxpes+fR/nTEqkVS0RyDwmEqCCrKrZ",
+	"+WAjPhTzwb09/1DMz8ddIb8U857bIxTnEJZDOxnCrbORsM7zQIoYs94PxZwU0iN9bMGWeMSPNrzsx46I",
+	"NHHywolzjr01y3lKKqGYJpXQPCdcA+1U1XzNtQ4FnOa1vz34k4nhZs+l1pYBHsGxhoLD8Z+d45hMHIfu",
+	"Oc0RnSom9FbfV2nKlIpPQPM1U5quyy0k7xAL3RY3gLoREU+r9ZrK6y4mfgbGtHZnaFdfMS156iYR24at",
+	"Wjc2ZIuWvaCsO2xPbQiyNYPaAauZS8406wGslXej73JGVd+7konMQD/ysrU296XvLwnm5CcwtLq+SygN",
+	"RJwxBH9QGnktGJHFJSmZ4xATojzVwyfk5EXI+o4Zs3GNxkQV0Dqo+lB0Z2VfwswMopDCKHHs0V5UuZN8",
+	"t51a4zgOXajh9La6WPvQ3W9cKN40ITGED+2Le7PKZASp7KM8gdrjNqi+AhVcPynbmih3FBbbqhiA7NTD",
+	"Dmsaang01zpqu3pJf2e/tqLBwQ5t1a4Ft5tT73EAGwSR50iax9/LIpbFCWWTxMhcwojBlyueM0LFteGR",
+	"uLYqFK5mwtJew6wh8U2Ip72kECmzUoNh6BZccLUyz52GuKEe9UNPkokduEHJ30cwE4nfeA2UI5ZGHJe9",
+	"OqhCLPjy/IJJFRWo/4EvHL+IBOYbRdhiwVLNLxjBHqx4nBDJdCUFAGQmZDA46KhXjEo9Z1RPidM/SLZg",
+	"Ol2hSmQmGt2RSyMgck3SlbE7qJa6YHK4+IHup3vz77ID9oR+exQ70Dk1NJcxEZVAf6VKk8Y0C1nPcrS0",
+	"WSs9xsi5ZMGlH9Y02kKqLYr8PGOlXnVHeWt1tpeUa4OnXDT3rFgsQI0LnRCKcrYBT2PBHrpRfe44bh/3",
+	"tkfe8oq6JtBaOxVXzWHHz2lJ5zznDombKA1X32F0E/SKSZR5DglXhAulad6w3oSXS1ODEOvKAPB18TGn",
+	"xH9Mnv96sqnvmFrLrgywf9szjq1Ga5p7cBNPHir58fvRaBkTMt8J/kfFCM+Y0HzBmQR7UT1QQ30WNx1k",
+	"W00aMNk2GjnzqB6ZaXN6VFt1HExnEDebezGs62ku6llZ5tekMLZpXbTs0F0pjep0dW41yDfXAuOYuiDZ",
+	"CHVwU1WruAasllSkq6iqdpSS0Xw0gBqTH6F/ghphM4U1vfqViaWhgPt7qHf1vyOAKiUvJNfXDT3gXhsO",
+	"r2XGJKHrQiztJJyenigzQWO+fUpWfGmOveuSXHJgtiNk0iHROELpvh6tV8R2P3uy3UGz27soJoFmO3Il",
+	"9NOyBufRmSCVaWRmz9+8I+YN1yzVlWwqnalcf/skdhbS1n0wgmKGLaxGpAdb7RuHDWuargxsHLtYA64x",
+	"V0TXnZKPJZc4r4BcJqRSLCNU1SYWo5iuxdN6MPxgZ29vP84AfSaN+EkytmPIqbMK4et5//I9cRiw46yZ",
+	"Wp3TLJNWpdYc02zIsRHzG5rKQ4ISXzAs2koM/TAdotlgJh5B06ODvX1kIQtUYj6u2c5La84qRO0LYRqz",
+	"rM1k7u9N96d706Pjo4M4gLcncxGEeQ2QIm+iCFPEVCwlM2dLLIm6Vpo1bPGTnIvqKtZTL6MPDMxOumLp",
+	"RyaJ/awN5yZcpgfTvTF3aj8BOw3IZHM6RsKFtdkvCC3LnIfqIzUl70Bf7T/5yFjZIGQzkRcpzZsiCjrI",
+	"cLGc/q/HuNUtwpTR0gg250zQudXmxbm/jC0lzVjmnSUk5Yp5RT5ZGDgYL44oe9lvEATvNOgBL1/zZcxK",
+	"PGDeg87Nw8HuoeP6ksfvR+q9DF08M6P2quE221dfuC1xHoS1vbNjXKVXeAUd7m00teLwG7evVgfUw7aM",
+	"xcF2YadmFHlB8/41ndgvyJzpS8ZEfJhwed82VvRtXOyqTWnjl1Ub0vqXVXf8WUsLhmr4Q21cWj/70KfF",
+	"+ZVfMGHdKKgjTnOqQOUACpuuSGu1LoUApzCQYkGXlzHap2tRq3lBZfaCahphXkD1cY4HMzJDBdcWfmVP",
+	"Gb2gPDdbh5cAkqBtDlu/N4iKcXgtJwwF6hd0O/EC+KixcTdio0uWGvsKngzc+8hEzDcNd8Xt9OugV2/a",
+	"lXvmATi4YR6BbXnLeUTs2+15GLhypXmqtsWY2u8uxJnwJB3FqAK9WJ5vcGh8dsEkXbLaoxEhAEYPOCcH",
+	"T1bhOE+Ovp8ejHIiNINX5Yihq3LMwPvf/zD9btTA1rN1GO9OApKuPvKybI9N5iyllWIEHBCtcywYhMyA",
+	"tVdvDZmk1+A3ZirW71Y1Z2FdvUGtHPHbJW233YbDZWxCutA0H57PmfmECI9yPdfS4fcH+/0jDB629gg9",
+	"t8P+0ZODMTdCi6OMHPcoLUqah65xPqMsKVda8nkV9ywL3+LVswYzBu6ni3WYkn/QvGIKRAo6V4bmgAAi",
+	"Cr0C6wJVZM2oqiTLpl3e82I50jS2pldjv+Ri5Jfl0d7YL38Y/+XR6C9/GGtw62zdS2eNaGtRW+BFGSLm",
+	"EKOaFg6vA1ozuWRZ4lQkBaqJbD9bseQDir8Xzk+yZU0BbJFMFfmF99vtmgRuqGpKJmONPn4GbqjE2WMA",
+	"t5kVyK9JVmxpnRnwxHKTayhkHeBjx/el8zFr6VuZpnxI/aFl1fEIfOa/JODSRVwvkXEHfdvSImPg3GVa",
+	"hbC5oDnPUDzCDmIq3j4/MlQtSEYzYChxiu7rcJSzFSPfNDiEb8iCszwjXBEHeeAM15XSZM4IJWWhOPAg",
+	"9sBt2jQ3/aHIlpcXTKC0GBFbKPgUwzXHMmNCFdr6yKnQYuoFFWTcvI+D/wkyb225gJ+1OQB+Zsw5ylhU",
+	"C6UEiIQZGZbiF3TqpvXWTcO/Cj0Fg8eGl3/uZ9l4/K7MYo9f+Fn7x239b/AKY6GMQeLllQHiT5agdhAU",
+	"3hIm0sKwHwl5fvoP0IoRaoQnQ+eMB08hyW8vfjl9/Ru+KwQjuK/gQWPlKbdJqbqYJBORfVCFGAvJYJLP",
+	"oXn45Dfb1acEFLHjDWMeyp9hEANusGkOq4/Wwd7Bk529/Z39o7P9veM98///e0f2MjOP27GW+RW1bGV9",
+	"yzrcZlkxY9p2FrTGpvWIUA0zTox2h7ZZWC9XTqpqSt5dZcj4sA6rnMMBHlxoB2j4slHxHeFCpsRsgJqJ",
+	"y06oB2lGelDJrLLcyCmhr/x0FjcEZExcNPiikQEdr0t7DwePHV/SPheTN5KDb9+vz35zhgKz5YYOG/wV",
+	"KQu2PzAjHu3t9Thdb7IJSXLyhjgjRkM5/sPBdP/b76f70/29veZoB0dHG42WQyYFf+83TAodYAQxqjcw",
+	"mloX0eb4b8yFYcU6M27DJtPQVhzs7Qc622+Pjg6PNmlttWUPximdY4bSYMtsd3YhMZbEd9WVXo38Xyzw",
+	"bJtlplSzZSH5/5hTJJi+LOTHWpq1V19ODeJflAKE0XWhWVSxWF/zt3aXhXTxVgjhpyhxRgdXTbX6myyq",
+	"8sfrmIy8ZgIkBxvK6QVuklJh2MulaQpUIwCdt4ZZHPbGi5EsRGdmL1yHnTc/4wjR54ANfqVNj9hxG+Ub",
+	"npqoCaU+g/dArhZDMHQhWeYkUDT2PYW/rSJhzYQm3ucUNtdZpGxbG4/vXeCptqJlWshsIwdwNJ6xybyP",
+	"4jiV8qiAGfuacKtB7urmJs8LIViqwWTJ16yoesNoxqrat2PRAo1yg1M7OHwS1dy2PJtbBGjFgu00G1VU",
+	"WvEMTYtIbb9RQei98UPJisuEqCJEHm5MoqUmYOZekJwra0oVmY/pbtxaNjpuILomhrM2D4KZcRt1XbvE",
+	"hhb1oya/K4wMgnb6ySNsH4Y7uTiAcAbIZm6IAezyu4Bu7/uJKVAKf9Falnay0/Ae71Fqk7TIq7UguoCw",
+	"CjK/Tkgp2YJfsQwFtR0AtmnvvZYzJqfkjFvt5FwWH5kw/NvJi2lAjcPhG3MJg2l2+iNrkslO+0EYUZRM",
+	"dpo/JdOSCrXm2rYNf3ZsyMlkp/Nsi1vCgfwsWFf8xQum0vbLUya0TUUQfR5r89bCItYufBdr+4pR8Vbr",
+	"V6r3RXzEEIJ9r2ItnT3+1AN76DX00ETm+trrSG/OajO/3sazwMW2RNWmzt+/TXXSIjdSC6pME5fVBwMt",
+	"QXyTVBDeINTRpAZAYM/TQkrobXMgcUhSrB6Z5pLR7JrQ7EMF8lJN2OfXSMLPi8VCMXsaurS3/U0f/YXv",
+	"yJqLynmM2UetjCEJCUwQLgbVNvCg+u8d7HXHnAerDgrhtXP47Z75X0CFudChd14AxwHN90l9lTb177BP",
+	"VnBjWRgcO9btbbP7ydltBvYPRdlqKpdMj9PjtKIv2wlTqCCyqES2oyUv8c4dyggTt+VG/DkDO4wsdJEW",
+	"eUT8s2/QMXHRjFl218fZ8zeTZPLuxZvJ+2Ai9nEkbqIVL9q12pvXm9LufH843d96oeFNM5gJx31mjm9J",
+	"jZlPteIkthy5EefaPsxCb1zt0Q22VXnaHKelOWdC7yyZYJKahZ68wDO4hlwdBgicKcIzti4LzUyet7fM",
+	"Boc7P1BwlD55YUOAMBWN5fKQr4OgCEYh7xlwSqYlJVlV5twI2dPG8X6y+H5+kO6znR/od9nOE3Y43/k+",
+	"/Xaxc5Dt06P5D+y7xeFeyAZWFc9iONYIAuwRtzwDWJMcn+PstvSjyURLvjTHfMMFaOjSmf20zWWGDNmY",
+	"OOfgPEe5qZo2x5QkvxTz8dKvCZse0FJozdbl8FGDxfmrekUVmTMmiI90Hqac43kF0/uc5YURhnSxmQ9w",
+	"YXnDIrvpVRo3RpYRSjSTa24Ul0pTzUajyCjlgBnJIKtL6TS69xvlp8ipl7mJ3cX7Fa4xC0jtiBN1fAEk",
+	"OWdXJZdMbYgZqqQ0ZBaaENtkNAwRGy0fG7Xj2/7za7Iq8sxRR2i3Bf9iU+YNcxbWqUo2EnmQUhZZlTYy",
+	"jIzSStwglQeyNlv48WODOtak6dfv/fghPdcWXviDGcE87QGd9Hnwsz9XWFxuh9QQQA22De6yuIGHy+FE",
+	"Uem0WLOnaH432HIjVNlai2ZpSF/qyrHI53LDxNFwHMqNUc8AZaUi0M7k15uTMjXStNphei65G4XrveE1",
+	"NdaF90V+CrHj+DdZ02tLZrhuRKKQR26q+NjMinAFyP/4VqWcO0hiFhGlagmntgxh5HzPQuGLx5vFofC8",
+	"hgL4YVv4foXWpsDtEADvk9G6rTJAptK4WHr0H170uADCn1uhgbB2Ku1hzjDkOx4rOJgi7SWVOTeb5/Wr",
+	"ZhEGr+a+75tnSbPkezPdulwVqh2ERT2pdtnatsbpg9tik8fZD38p5lHzYcvY1KANvxoYvzWRPCoSZ4ls",
+	"R+/hg8axc0ceWSDarWuGqW3ESXMsXM5Nj5P7m48EYKUu/A3jTbT7m8NqSi4Ey85NfPJmVRi4ICDlgzGx",
+	"cSuumTxi0+WUFGInY2tjlZCVUI+jWjATqtoEc99B/LUQy52yyHMgRFXpx1wbuuzAb51yHUXIqgY0vt3b",
+	"Pui1Zou6WMAXLL1Oc4bCALoPW6bMqk22zetUnxaH051RTXpkMxSmj8RLtDJhc68cg2V+uzPtVX9Z7Yz6",
+	"oZjPBA83zyXA7kZkmoFaxKSZEbpOp+DS7GEMaHRRZoqvqJb8qnvkUpbnPSmnzCvwS7POKaqoZMow9AVv",
+	"qJJyGaaisl9ooFfwydiAkHqKz1mebxWd03E3hkSrhimEVLGWrwDiWvi1GKuOfVOb+2TLf+ufTXYhoLPv",
+	"t3FF8mqgrXIT47RiVQLMc1wTAIykxmm+yd8fPFntrffURs7eDtKaY5j6CvEjRs1bW9bBLBej0V0CJh63",
+	"15zdOoNK3V3ZyNHkdExaTTPVU+wGlMIZp2K7Nr1Jvs+2W8RBNPAFz02TcRjLs8aYjnG8QQsXOpOI9J0E",
+	"Wb/9/vpd6MOSU++/1sSQQe08hiO7m8W6BFhdCqZvbwY8TZ+Minfq6MhrTcj+3sh08w2Nc9D+YGTM1U2t",
+	"6E8Dwz8isdpsWL+pynbDYLfo27pdXkmDUDdxJ3LtBr2J/np+KvU9cS++Kf+qriZutCYENiUSfB/g45C5",
+	"/qvt+5Zs3/dkhd6gAnR58QP/uaeEktooaCM/hA0m4t4/iypDVaO4ajOtmSSI67oAzTFhV1zbIHOyHw9s",
+	"uk0bd889usH2ext25977917szof3bHfu5RaGx4wwij3Rj9YzJ5AtU7BKb6EO/Te2ct/MENO2wGyX+eiz",
+	"1IfmEkTZdhvd4F/VmD9Slhkw9A/4RYa8aJh7tyvfjA8r9yLprcagb1maymXz9gqFDenTa1nQTMmvAuPp",
+	"Y/BCnv1Hk2yxCy2vUBmlMAq6OtFsbdW4J9h0f88qYN3vtlKmtRAcccOMYZhuXg6iuFjmNfstTPoC8/2U",
+	"nNpyjQFjAIkmgfhdl6ybssBXH9k+MGJ8kZEg6XXY/k5U/i34DZb6iDNa1LBxEOBNzDZZaeMDMtIh/QFz",
+	"2XeQem5hGJ6xoaKBCdZdPGaSCcHyHRCJaN7C6Fy52WxUTXGRsatY2JfiYdwdditaNmDAoFvKH9zag76y",
+	"XzBd3+eIzexT0L9GOzwq5mEhsMhpfWlDBT7liyEiu+zYgZlo8B2w4U74wR162sAIlRAufNoXczWaVNkf",
+	"vM9yIDInWLzNoAj21FSq15HJfqaTZGLHimrWA4i8ZaoshIqouSyJvSlp60uR1EnkgqNEN64SdlNi4ZeX",
+	"hFpVRiUIE5kBMCQrZWhDqmU8v2HA5hkrkuqkO4WWNie8efHszQnmR6DpiuI95eBdC93eeGyzC9m0/xh5",
+	"ROdOM29nNTJGoV71qR+ofuarXwaf+cHrZ2d+GvWzZ35CQVs3NQR3r4nzQbsYwG5/novBbdjADR7erg38",
+	"Lq62SKmPcZrI+P37NbCxP7Dxa/jgXyF8cEOIX1y9Wh+WbWL7TuvMhF8gtq9ddGVnuArLTl8Vm536zw9c",
+	"aybt4/rHuIuwAcMwWC/+wgazNV6+sCuwaqzed7G278q+lu/KoXZvuFi+UvGnse9/AbC8Un3PfYhd/XZk",
+	"AH6Q6HLbKHyuMNrTHMuttysWhR99e6LKvlendujGuodsFV+DBL8GCd4wSHA4e+qLZtbUnorvBz9srRVn",
+	"V5pJAZk6Y5m58GWQWyYechdccYfTven+/uE0ukquIqNANmfBNOSIgdTzsrjgrS2cPC/WKTVVbWhDYVf3",
+	"XVP2zghIxYZsNwc3CNvrrZxvyKyvm7+hTP5NLEbgEV/JSGDku7e/mtva+KW3cwsHyh6tS3W8u3t5eTn1",
+	"iq+pYHoXv94Fbq+h6pc8nvARit7FTkrAROBXWGMx0OjbMfo6jecd6nbbKQht8eSrTeavY6zo1PRrIX05",
+	"TBkPjw6nR9vGqQ4YSMYWUhyOaAQ+I5KdALL3blRDm8Y/4qeGiIvsHAA+2kMSGC8bOjbeG1Mx2TY4bJzl",
+	"KbaJZymXeqt5d+u3uQ4CGASL8zPu3YAfPbhbp4JneoVa2IBrdamVsZXNrZzzpXcoZ+Sd4FeElUW6glS9",
+	"786eh2LP0XqSTPZX5j9jNXHBNKF18Ht/1fqNKrSag40k1MWk0JZ7cex35rh1ZZcMUHsaPjd635pbD7JK",
+	"d+wwwzXkP6McdJ2Na4u8HZbj2HTNdBEtji9vCi5iJYuLSugxpkKrYrKnvC+ReyXZubSZ2VoX4MpA3myS",
+	"DUXCGwp1wImvh7EHupH9hhFkuneUjLOnamkrB/RlfRzUPIXJyzsJIcO34B+Po5EwV14IdDjiETCYxw6L",
+	"EZgJKTxXLqlYMp/u3H5w01p8OIXE7nENn16acuqpZBNJlu5IbqSZeHgh+yEXMeccR4BACeNwq5AeKxJS",
+	"5Bl4WNlgr/H0GjF8k8UE1+InGIeFZHQN2YBjimxNHXkN8y1Pg/qVXBGna2vnXE5mArXuXv/uXtRq+TAL",
+	"M5Z8DfIwJzPhhMg6oajPwQyfQxZmeA1/JYSaOH1vb5sJHNHJ6FyxjHA9JWF+Z6yhmFIJnKOlfdFaT2aI",
+	"TfuDCZVvoAPeTJLHxssPku+oIICVp1gQ+mVOKGw2GB8lo8YLwIuUOV/4EnfeE2iE2L6d+b82DG5v+q/b",
+	"bsdzjbm66vzk8Xre5o0dN3bmYHrVFqWZbYNBa8rd5VPAWlCD+RTGFHF7X698SDs30vPP0lCWxab4efHv",
+	"zvnVGOnclW32MkPTBnqiBWbcdveulvcGw5bpPaj6bTqlcyqyQmyRoeMG5s/Nbh2+KMqGgP5OlaZOJonW",
+	"vvQaqKC444gRfc3Gzx2wqJ0FBh0VarcCR4TGTLNTNurG8wS2Zgwq2Q+3CD74N/d8vRX7/Xa+qPcep97w",
+	"DsVLySF+A7WaNKvvyjqr51+bKH02gkkSq9QBNbMy536jCwO5KXlma2YScMegkhF2pSV19bdclzNxueI5",
+	"M61tNC7qvBRkY7qE4n3OstwKHg5mtaaiovkk8ZU6I35OIGqmleT6+tTAFK+iZyX/O7t+VsVqIhunn4/s",
+	"Gvle1Ijv6GLH/klopVdMaIPGvBAJYWJRyNR7eRjG1GIUgACjKSq9mtp6jVPyd3aNoHHMsflmJn5vFl79",
+	"aL7CL34HfhjS92PZDUnWhWREpUXJ1PFM/C4ZzX6HGf/t5RnUczHwTsjveHB/D7jsIPHMIyu5JDNh5poQ",
+	"GRRqTurCiSoBY5jNogFz8alv1GPzYCZ+p9maCxwIygBglSuWK2ZXPDeOjWF+FMhdAQw6znImqBWn8f2U",
+	"vOJKoVWbSHZRmFQdABaDMU/29hP85YoUWKHAOt0AcEzLelxRYLVWHB07OZyS12az1pWuaE7Ofj0ldCYu",
+	"mOQLzjIbd0BSJg3rBP5/cy4yZY2IAGenerL9CpCm5tczYbDYhMTYhwA5m68Dzk5AJGmNcgALBKrZVr+b",
+	"eA44uPA7CxuqTyb/vfPszcnO31mQC4cChk8+fQJvzkWBWhKhaQr3DVtTnk+OJ6oqDTb8H0vppmmxrrtF",
+	"bf9zi5DP3pxEqsa/OQlmjWReZPYWuQjT5Ac3p/miWwdyOhNnK67MOEiTFaEI55QJLU3lByO05vTacrDY",
+	"ozsvYXXLSzYnmSvcOZ2JmXhpUJJI6+foJUIqyO8N4+XvruJN7dbZcJOZCaeTT4xu8bE/9R4Zmu4qKqyn",
+	"L5whlayKy5lYUGlrhBSX3voKNddxq3OeMuuUaTfk1cnZJJmA5clbkYqSCQwZmBZyuWsbqV3zLYhIOo/v",
+	"ZVD2a2KCO/bM56Y3WvLJ8cTYEQ8nyaSkegU0c9dZ1+HXkumeeqf+s4SssShKijnQ6iKxTitSYOXqQpxk",
+	"k+PJ35h+7ocwA0u6ZppJBVLUpoQmfli4i4Axccflj4rJ6xqtc74Gcz7esc3EKXt7zVQoG7wUP71PJg6p",
+	"ACwHe3vupFm9C1Qqw8tiF0oYHf8ZjDxKL+SgEtEKdQ6kh6A7g4YUhtmyPiWToy0nOSgwg/d7ZCJoVaa5",
+	"46+Y/TCZ2FAN3PF63yDOZanAmdk/ew9KOBVTQVpsqhlSm5NB2DI0PlzB+Vbo4FO+ZslMQNIZutDGPHr6",
+	"hqyp2VhBRcqm5JnLCONyK4K61PUlkJQlMxHms2Hhe5uSCQhEmNnJexSDC/dbqzyk0rPQnvQ4MBjfrZno",
+	"nBesHeaRAxlEpvSPRXZ9a/vruq8TejZZUS0r9qlzCPZvffwhXK/Ba7D7yf1gNzrxuy0C7bcoas5Gpyum",
+	"HtRhQ3Qh1M+558B9SgJSv/un+/Mk+xTQ/V7KPflMgvjZuNBP9p7sPbn7nfDzqGN5ujRv4x5suP2e14TB",
+	"3XHmmq6vuHrTJu3DGt573eusf/N3UW7ovfvPAoL1jaq9c3nGIL2S+bemoElN5ez9mswExuBZNhnv92JB",
+	"DKl0ZiqaykI5hzU1Jf9Zuz87e4DLtbdopJFA+HOmYoQ0QN+3uMh7QGI7UgSF8A3IWJV+SHj8wDiH+hQB",
+	"rKjkyou3D/FcWR2SLSjce5DeWgKGHgJ5MTeSj0vraM6GiXCVPGOqlnlkXS/YsNeyWMeQPKyJrCb3wbmG",
+	"I47hXl80ay730fIOKjRrNQebjy+G+MhnWdaB8iPBeKAqMJF8opB4q59jwcTHCaFYO3Em3IaQR40vwCAs",
+	"/HahKdOF3GLPM/HID/F4SlxpyZKnHyFh4YrZAssEE/FxSQS70rVqpp8vbID+bnjDcIgvxR82MWwDRnmN",
+	"W5ek3iPP6DQFRp3RwmWDjA1cjqJyl5js/ol/WCYNrd8R9Tc8rxVwfowmAuFnHQRq7OCT/qTnCGpngf8i",
+	"t1dzKn2smAXHZoAnGyg1JapkqVEQNjszjA/XCq+TQXJ8lxzHdkfkC/PP43auQ/MxwqmP8A/d+c0Bey9+",
+	"e7wGr/1NmqKyiiJRmdO0cyghAwOG2Xle1lzzEP827aATVhJ90DT/SyG09Th6SDT/QZ0lRJ2trp0RzCua",
+	"kphkmedSncTHpbH6cMGIr8vQQxs3qoF/4rlm0hx+O33fY0zx619ug2Y+5cT7+2OYx7PKW/PIatPW7rqd",
+	"M1ONs8xv7ReeZoH2C8+ZtYWAE4Cxa9UxbTVGON8/41lQGxwJzVVBwMsTLD90mMt1k/DBhXdH7cJZfhla",
+	"N3C6g4P2ULnaDr5sxME/8Y+mzrGX+erQmn6KMvmyW/UQWKsRPNXnMlN9XJTb1THKE+/A8T6OGLv2purH",
+	"D1XkF44erQtdq0iQ3KA4Xq94zeTSmItQGTATThuQoJgfqF3qRFhh8hj4qqWhmQnnamnH4VqxfJFgHALV",
+	"Ll6/yethjoOPjJXo01APUaQ0by6jVhosmMlWBAUOCus1hl4ExgLFLmygMTY+t6ZfTPlV+13XBnLQOvRp",
+	"S18uFgzqx9+XCBMfMILj/sPWZkvEho2XJK33k8W7eujHwm+maXT/s+pTs72i8mOMVJubXjEmrNsIaL45",
+	"ZPXADFZN3PvZLe4ebn0/lsGzL3PF/xwcTJvP5J4vC46RZ8G2mX1Sq6LKM/8Ui4G0lSvYQY2O4+/8XVOS",
+	"ZBcr0zwoJMYiPrZCCxZOCW+RKfkVbf7wBiq5zRnx1VtsipqZ8JXdXMnBhIBn2iVXcF8ZDzHIQmc+Mk6D",
+	"COAYMYYBf8ESeXdxFNplj27hKIySg0y90RFCkFm5c7QIKbvRxBsoErYu9fXjFmr+6qsRBUgJP3sxUlbi",
+	"YaGiddkFA4OvmgTudIXo6iOn5Mx6k3NFrDuLEbFmYsWXq52wVhmJObQk5HLF0xUYJhThGo0TkCPaUOuZ",
+	"yF2tJUi9YOBrhwRHLDwSxmOcK+/XfvLiKVkUObr7Wh9Y472Kp//PD8X8JPv0v8NyT//xW1QQrMRrcaf3",
+	"QZATb5T0d3Cbxy9qIa4EoWnKShdaZ7e0yWw+KBHQzDjAT+crW1z2HUHrvblZ/vOf+hzAZvwgryY6IFr3",
+	"MLi+fER5XFB0Q9/pnW8HeYGwity/blWwmi20PGG7xqVrX1gIQwSg2sXwz14oW9dYSAoPsXHKBowa5Y71",
+	"V0M/3Nqp13mVQ4jSTFhRIiFZiyNQdZinc/W9JitalkxMyUvj9QFzJFwZl3phU61yrTCXIrYgp6cv7Xfe",
+	"x5qSIPbVfPfL6evfjBil6ZSgylARQaW0tahwRU+xm9qf3GOpDWadCRSjQPwjNDAO2w/MnwvofkowzMaH",
+	"h5t8MEVBFlTOxJytuJmlZCTjKi2EwPRTDc7KPk6IZCDYoWc1etUDB3FNBDPRBM+LNWRHhCR80CnAqmSS",
+	"FxlPqbkNdYHSJM9yNhO2a0w3YIAdIaoBADcqYaHCn8UKvWLKRpfCLmFMWpkXWZ0WMKaatTEsW3IJQbRm",
+	"LL3FNXg+m7gSiKrsn7XdeDrHMAJflrBHj9zIw957dW85okGgnvGC8u4DLiYbqZVmVxqP/U596useWwf/",
+	"9CVZmE1XNloKiapqHq5I8H5XJAdksCM2CdZpCIyAVtkHllBdGaFw18cXx+mU70leh7EFDVXNwh59qsjz",
+	"039YFzRqPf5nQprMl4adV+S3F4ZkJBBtY/4iGC8FDmwQk+TJCMQ2UKNysdwLDL7raaA51ODBZoiNCzsw",
+	"NMxs6jfGNJx4wjUTkFmdhD7BEALCBeaiNlRUFQQhgrlCxDVR/H8Y6ntmgq/BiU6z/BqGrhSoL5SmQpM1",
+	"WxfyOnbcX0KPPjp785GvdFlB+Oma6in5L0sxObpYPwPWxMIVecbEOdfAXhQG/tMebMdOR1tpcOo/YaPI",
+	"qbMmIu8cCN7ecOLq3HphnqUew1Gd6Kae1rhkFhsm5IsSbzWjINvOLc2ntqGdvOgZ9IZ0rx4AYpZ7ux9F",
+	"5jb1DmfpUUml5jTH4/94aDz4+3OWY2+u3gE6N9u4zDndcZ/fNBnsi6AmtMewZoaSvrOo0JVy3OTr2g7o",
+	"2Jp00xmCei/IWRzk5fGhcKiwX1Y5NXZi1mfMDXqJx/L0JCH+lGyOJXKz0o7WPiU0t9iEEgW+h9gOn4Zp",
+	"XKzRLYYTXe2IrCt2RFIpmYs/VRfD33XFuk6IXuKzxdy7bAlwJcGl9JC8kS1id2MaQ54GvmnyNI3cJ5v5",
+	"miCE8v75Gj9Xx9tsw6fMRJNRIZ/Fp/hMMF/5lK98yl3wKUFu05G8RJjObqtBH/yt3kxi//VW/1e/1U/b",
+	"GWy+3urDt3on5U/vrW74/RGukUGkTsAxYOOIPvrnYsRFN14ouTV5pB6SopvEoO8lfhOjjQNnGDRmaEJC",
+	"+ITKOUilYUCXQKqYDCK/jaYFNSvObeZcsZylGmJxslpni8pnsMSi305tN5kJZ9j2uVlu96Lpkia7OJe4",
+	"AAkTPrz0PMt4YrRFAoPIhWTIb4WOU7bUi+dGGCnpklmN4H/v/Mau9M7zSqpCOi7KG/xgp1J41zNx/3LQ",
+	"/Hn3tmaXO2+Tsfln2I+4JSaB6xuzgFkmAAECozcgFclXTxUw7wgQgwZLW30Rgr0MyJ/aC8nGgZGcKnwx",
+	"CL5PX56edwxUEZLniCn+Hg7Vo0Swy3YnHbL5LMt+xud3YQ82XX+peDdE1jhy/kuEt5nts3vW3nd/h+7+",
+	"af4ZG8yGmRBdLRKsHtYT0+aRYlMsG4Dzi4awwQw2RK71wHGLcDWA3WCUWhxke/eD0F/YY3pwD/7mknC2",
+	"vaUDMjbEsv3cVIY3nYUQ/28/2swG+1BB2JW922ENbRfXWHTZHVNUHOS+4ysG0e/fN3RsEPMtEg1QcTSC",
+	"ugyO/SKRdRhzH1pvSouRNv2Fp+xBNrFgR5KZEIXYcSkg2z4okGjP64yAx86YZqmh7ktJM3Sij7u5gwL8",
+	"R7eIMcfZTNMvhqtgEb1ccG312iKfRNKl7UpLnrZmoAsHCAfFO3JtCAstwP4EuhZ0xOoTR+s67BFlz0GY",
+	"je3ozpOxDZ0UjwaRw+Le9aVs6eGB5zVmuTMELxpnKCjAPHypd40PqD8sSqwgYa0EWOckjuqjVOqDerV7",
+	"y7uXbC71oQtI5T0lJ0tR+DK81ElZXJElv2CiT0mKJfTi092Lli76TKEa6xiE4rNxOLvS5zjhKXkncv6R",
+	"EZxYYtehSFYAoTZrxUSpJaO6zoaEiXYN112gg5jkF5CSlZV1PjxvQRIZCLLTXpXHCKH9q2nj5qaNu/WQ",
+	"uB8Hj68uGC1jzZ05V5SSpVTXHENL049zJCovLpnSPiut1WiDiSipaQBVsKr/cJ9BQbUW9YRlb6KcdrzP",
+	"tN9ALtmIUSmx2kd/UBW5ZJI1SltHKlrfoynqc9mQZtUMvDWj9V6C2+HWlYqdHPf2PoxOJGBSRmlcI2Vg",
+	"2t67utA0UmDzzDwOeI+28W4Su43bSe/HuLoMqh4erEa1a6ByHGVvGl1MN95SqjakBtoXVY9t/WbekW6g",
+	"pnxBkZz7VRJ08LW7X89C8NlMJ1yRRjEOrARj02Fg8MRTOHheF+OqZyjPCBqEu0398YildA6Dy+7+kDQh",
+	"NrleJT4Kkz3dcUZmSgcHdz+ls1VwA5n9Grx+sGaCeTo1T88pRF/gNrs6HA/G7G0pwiiq0pFTd//UbLzm",
+	"3quhO4MN6KSxdZPqbFLnd1H6i+r2u9PZoOjvwCdO4Yfk9u6Yfbpn3MHP0D0brIBYvo1KC4xbTIishHC1",
+	"I3zpLNPFNjoMGwY90k/CBKTeZraoX4q5TxWVDA97W94ZQXGg/gFtOG8hISw3yId/21JgVzEEG/iXq8aw",
+	"TWj66LDNeDB6H9P2EksSuIhag1ONbDa6sPkFzHliVyytIrk0ME2sWc2dpQ74UkbynrDpX4p5Xc3hodrI",
+	"6739UMy7KOFoqwuQH5Mfy+CHv0/RC5qEcfWN+jU+kl/NRCU0z+Gt6QKLVijAOrnmwEZoqhl5VKe4KKQt",
+	"rAjZjyF7kRmJsJyWyrqFm5hUJrEgjk0A0MhiICtR13Tiy5WeCZtZoMdOgzg8SPd/dauCg1KVmN6AK7IG",
+	"x3QLh+Z67UXUdz+EEOxXEzvq9e0mnfFdGi8GzsMXtrCbKQwZ2C3qNuzrNXEc2vFfIOFEnMOBo3MLDI47",
+	"hLvuAGzMEXKXs0p60zWCH6tBa1sjECuO5T5ZzZT8BIcWb2wM5zBokeGJsPHmEisx0Kvzusw16vIxOQh8",
+	"ZBqvqTSl2pAQRDOUW3Dd7eXja9Tdt7pg4Li5DXD5nP4NXQtaR96M+sP9jGqTWVm8d/VafTlElzWlWTXH",
+	"omrjvMSv5TVTq9011ZJf9VdIo74Cnq12YtPolBU+fXt2VtdQJFjYDV5goUxfNr2kXNpCpXBZclThor6h",
+	"URiFtOuizERR6SSYg7Jl0k3awgAhofu+FICvmFq9wrVudIy4JAsqyZymH4EzLYqPCSZ4/VtBMpeh7xEU",
+	"4zp4skrI/rff91qMcIHx+3Zy8GTVqNqKv2/bqXgI/wKwRJAQ3wxVdrnHw28h+dCqutTFoOuDsXaI5k6d",
+	"+SA8dYEBYlDNjQfefGuTE4eKlQrSgBairuJmsq7BaYX0bEEizkbp4FmfZtwgwx0qxl33X04v7mYwpBav",
+	"C3s/YK345oW8atcnf4A68a/a71vTftNOQfo49XFqbwh73kh+ejJlYRZeWxjIbuiUPMtzAgopc3HPBJ4M",
+	"82khGNGSCmUB6VPMLJnG3DLGIoLqy2PbBdjMZ2LTwbO8f4mxLFTVFdsVJqjB3mxSK54TQEKbvr3VlPt7",
+	"xqbTwny6Nn2VWbuvzgbQm5J3LV9NycqcXtsody6JKosiR5HDBJQbrKNLNu2lv3iaf4StuaPMgMEI90x7",
+	"g6Hf2jHivok6XZFSFoZEQUViBnX4dsxOWiT54kTry53+hIjCHRHmS7D20QTA08DHMKAJ7oklC5UYWeDN",
+	"cB0rboa9Nh03amWMM3u8rW5QJ+PuXMCAZN6rhePSUKOUgqe3BWnf2Jg0dfTwhiWwiVaHp2B20Yr2PUPX",
+	"b0fnHH1tmwyDG8TCz84e1zeCQWRM2cEypLwPwnEznNUX996MeERXf8Uy5MAgV6OK0xiaNN745cSvBj2t",
+	"Bqs2vkWRABgRWlf4BrWkwVQbGH65YlDHsZCg+eHa3IRZlZpPnIv0lJx+5GVpGTOz6Zk5y7iJyNRYXR01",
+	"l0SeE1WQJS0V4cKzMkYicGQ8pQJST1+VOeUirgG1DEp1VymL7V59OdHQI0tMJnQWngcqDQ7M3eQxfoi6",
+	"21a9HYAQ9Qere64Mk1JndNo+UGs4OqsRkFXnz5iJWwnL8hlpxoZmfQ2O+lcKjqrxJRYh5ZG2g8SjI6W6",
+	"CV22YbfHJx/7Gin1NVLqa6TU1yRwd5YE7s7Su20XV5QVlyIvaH9Ekfvga0TR14iiO4oo8mj8UCKKumn2",
+	"/oUiigZT3oUc2IaoIkzW1OltVFiR39E7kpBrwvfFZOQO0sZk5QB6D1hkHrGUzon4akL9S5tQRxORqBh3",
+	"w0CizqAbA4madGZTIFEXib9oIFF3OhsCiTrw6SfsQ6Jtd9w7DSZSmmq1oQLMC6605PPK/DT3OLAhWFTU",
+	"hr23PO3WjApwtyuZhKM4E/Mq/QgCo1NVWK86QxnmUNMuHEV1POZmoi4yZtoZE3klGZFUM1f6Tq2oBAdc",
+	"/NSb0kFPHLrtBV3BX3Nz/FFrRgWpSltJyI3R8O4bSmhkopQiuosY/tWf7EKrU02lPuNrBvWUxrR4KbKt",
+	"vv8RdiBSrum0zBv+W4pwoQvwhVAMiuqYbbygecUsR64YyfiaCXNLji1DtZRFVZ7Pr7cvRVWD9m+mjx+v",
+	"R5WkGgOSF65u5J2q8GCoKInxxfIeiN+iOapEUrFkCcEDSwpJYOesYPGg/BnR06dRctATXIC5vQDN3yMK",
+	"QrTJnJOtE1KV5l8gGAYQnqyRNlWbiQ5ZG3IXNpwJFvMMyZkpI7dmqtb/h44MZg7cehV7lyAQ9902kfm1",
+	"d2W2xjmqTdbjTTr/r9TrLqhXE7xfKdhXChbXBgyTMdOSpZXk+hoO5bOS/51dP6v0anL8z/dm83GkeIBe",
+	"SnOSsQuWFyUUtMRvJ8mkkvnkeLLSujze3c3Nd6tC6ePv977f26Ul373Yj5y4N2DnNz9iHanjXaS16Yql",
+	"H6c2YmCaFmvf43u/wM3MrydXqj6MNSnvTq4bix/rAfndbmvINLmmgi4ZACrWFpNxdtu2ysrGmtaFYiN0",
+	"DMvBgqBqQvMwVDXWC8TFxMYHkt/weou0Bit1f2tIz9/T1BWX77ZGV8hQ9wAalPgErMTaN4cdXey4MgTe",
+	"UTjWkXk7iWn8C5lxAdll16bckOsNOkrpuqR8GZ+aexmb3LPlUrIl9OrWWRO/KJYi0YzkE6U5GGVsLVdX",
+	"9DfSBXwQhXeVf3TTwDoZ8fbwavLp/af/NwD3YZm/gEIBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -15,12 +15,50 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 )
 
+// Defines values for AlertKind.
+const (
+	AlertKindIperfFailed       AlertKind = "iperf_failed"
+	AlertKindResultQuarantined AlertKind = "result_quarantined"
+)
+
+// Defines values for CampaignStatus.
+const (
+	CampaignStatusCompleted CampaignStatus = "completed"
+	CampaignStatusRunning   CampaignStatus = "running"
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+)
+
+// Defines values for DaemonStatus.
+const (
+	Dead   DaemonStatus = "dead"
+	Online DaemonStatus = "online"
+	Stale  DaemonStatus = "stale"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeAlert           EventType = "alert"
+	EventTypeDaemonHeartbeat EventType = "daemon_heartbeat"
+	EventTypeHostCreated     EventType = "host_created"
+	EventTypeHostDeleted     EventType = "host_deleted"
+	EventTypeHostUpdated     EventType = "host_updated"
+	EventTypeIperfResult     EventType = "iperf_result"
+	EventTypeSpeedtestResult EventType = "speedtest_result"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv    ExportFormat = "csv"
+	ExportFormatNdjson ExportFormat = "ndjson"
+)
+
 // Defines values for HostType.
 const (
 	Lan    HostType = "lan"
@@ -28,23 +66,465 @@ const (
 	Vpn    HostType = "vpn"
 )
 
+// Defines values for IperfStatsGroupBy.
+const (
+	IperfStatsGroupByDaemon   IperfStatsGroupBy = "daemon"
+	IperfStatsGroupByHost     IperfStatsGroupBy = "host"
+	IperfStatsGroupByHostType IperfStatsGroupBy = "host_type"
+)
+
 // Defines values for IperfTestResultProtocol.
 const (
 	IperfTestResultProtocolTCP IperfTestResultProtocol = "TCP"
 	IperfTestResultProtocolUDP IperfTestResultProtocol = "UDP"
 )
 
+// Defines values for IperfTestSort.
+const (
+	IperfTestSortDurationSeconds     IperfTestSort = "duration_seconds"
+	IperfTestSortDurationSecondsDesc IperfTestSort = "-duration_seconds"
+	IperfTestSortMeanRttMs           IperfTestSort = "mean_rtt_ms"
+	IperfTestSortMeanRttMsDesc       IperfTestSort = "-mean_rtt_ms"
+	IperfTestSortReceivedMbps        IperfTestSort = "received_mbps"
+	IperfTestSortReceivedMbpsDesc    IperfTestSort = "-received_mbps"
+	IperfTestSortRetransmits         IperfTestSort = "retransmits"
+	IperfTestSortRetransmitsDesc     IperfTestSort = "-retransmits"
+	IperfTestSortSentMbps            IperfTestSort = "sent_mbps"
+	IperfTestSortSentMbpsDesc        IperfTestSort = "-sent_mbps"
+	IperfTestSortTimestamp           IperfTestSort = "timestamp"
+	IperfTestSortTimestampDesc       IperfTestSort = "-timestamp"
+)
+
 // Defines values for IperfTestSubmissionProtocol.
 const (
 	IperfTestSubmissionProtocolTCP IperfTestSubmissionProtocol = "TCP"
 	IperfTestSubmissionProtocolUDP IperfTestSubmissionProtocol = "UDP"
 )
 
+// Defines values for JobStatus.
+const (
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusLeased    JobStatus = "leased"
+	JobStatusPending   JobStatus = "pending"
+)
+
+// Defines values for JobType.
+const (
+	Iperf     JobType = "iperf"
+	Mesh      JobType = "mesh"
+	Speedtest JobType = "speedtest"
+)
+
+// Defines values for ResultBatchItemStatus.
+const (
+	Created   ResultBatchItemStatus = "created"
+	Duplicate ResultBatchItemStatus = "duplicate"
+	Invalid   ResultBatchItemStatus = "invalid"
+)
+
+// Defines values for RunOutcome.
+const (
+	RunOutcomeAborted RunOutcome = "aborted"
+	RunOutcomeFailed  RunOutcome = "failed"
+	RunOutcomeSkipped RunOutcome = "skipped"
+	RunOutcomeSpooled RunOutcome = "spooled"
+	RunOutcomeSuccess RunOutcome = "success"
+	RunOutcomeTimeout RunOutcome = "timeout"
+)
+
+// Defines values for SpeedTestSort.
+const (
+	SpeedTestSortDownloadMbps     SpeedTestSort = "download_mbps"
+	SpeedTestSortDownloadMbpsDesc SpeedTestSort = "-download_mbps"
+	SpeedTestSortJitterMs         SpeedTestSort = "jitter_ms"
+	SpeedTestSortJitterMsDesc     SpeedTestSort = "-jitter_ms"
+	SpeedTestSortPingMs           SpeedTestSort = "ping_ms"
+	SpeedTestSortPingMsDesc       SpeedTestSort = "-ping_ms"
+	SpeedTestSortTimestamp        SpeedTestSort = "timestamp"
+	SpeedTestSortTimestampDesc    SpeedTestSort = "-timestamp"
+	SpeedTestSortUploadMbps       SpeedTestSort = "upload_mbps"
+	SpeedTestSortUploadMbpsDesc   SpeedTestSort = "-upload_mbps"
+)
+
+// Defines values for SpeedTestStatsGroupBy.
+const (
+	SpeedTestStatsGroupByDaemon SpeedTestStatsGroupBy = "daemon"
+	SpeedTestStatsGroupByIsp    SpeedTestStatsGroupBy = "isp"
+	SpeedTestStatsGroupByServer SpeedTestStatsGroupBy = "server"
+)
+
+// Defines values for StatsBucket.
+const (
+	StatsBucket1d StatsBucket = "1d"
+	StatsBucket1h StatsBucket = "1h"
+	StatsBucket5m StatsBucket = "5m"
+)
+
+// Defines values for TestTrigger.
+const (
+	Adaptive  TestTrigger = "adaptive"
+	Manual    TestTrigger = "manual"
+	Scheduled TestTrigger = "scheduled"
+)
+
+// Alert defines model for Alert.
+type Alert struct {
+	// Kind iperf_failed: an iperf test ran and failed (tests blocked by an
+	// upstream failure are not alerted on). result_quarantined: a result
+	// was quarantined for clock skew.
+	Kind    AlertKind `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// AlertKind iperf_failed: an iperf test ran and failed (tests blocked by an
+// upstream failure are not alerted on). result_quarantined: a result
+// was quarantined for clock skew.
+type AlertKind string
+
+// Baseline defines model for Baseline.
+type Baseline struct {
+	// DownloadMbps Median download (iperf received) throughput in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// PingMs Median latency (iperf mean RTT) in milliseconds
+	PingMs *float64 `json:"ping_ms,omitempty"`
+
+	// Samples Number of results the baseline was computed from
+	Samples int `json:"samples"`
+
+	// UploadMbps Median upload (iperf sent) throughput in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// Campaign defines model for Campaign.
+type Campaign struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DaemonIds Daemons that take part regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Daemons carrying all of these labels take part. Without a selector
+	// or daemon_ids every registered daemon that is not dead takes part.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
+	// Daemons Daemons the campaign's jobs were enqueued for
+	Daemons []string `json:"daemons"`
+
+	// Description Why the campaign was run
+	Description *string `json:"description,omitempty"`
+
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostIds Hosts every daemon runs an iperf test against, in order
+	HostIds *[]int            `json:"host_ids,omitempty"`
+	Id      int               `json:"id"`
+	Jobs    CampaignJobCounts `json:"jobs"`
+
+	// Name Human-friendly campaign name
+	Name string `json:"name"`
+
+	// ScheduledAt When the daemons start the tests (defaults to now)
+	ScheduledAt time.Time `json:"scheduled_at"`
+
+	// Speedtest Run a speed test on every daemon
+	Speedtest *bool `json:"speedtest,omitempty"`
+
+	// Status scheduled until scheduled_at, running while any of its jobs is
+	// pending or leased, completed once every job finished or failed
+	Status CampaignStatus `json:"status"`
+}
+
+// CampaignCreation defines model for CampaignCreation.
+type CampaignCreation struct {
+	// DaemonIds Daemons that take part regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Daemons carrying all of these labels take part. Without a selector
+	// or daemon_ids every registered daemon that is not dead takes part.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
+	// Description Why the campaign was run
+	Description *string `json:"description,omitempty"`
+
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostIds Hosts every daemon runs an iperf test against, in order
+	HostIds *[]int `json:"host_ids,omitempty"`
+
+	// Name Human-friendly campaign name
+	Name string `json:"name"`
+
+	// ScheduledAt When the daemons start the tests (defaults to now)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+
+	// Speedtest Run a speed test on every daemon
+	Speedtest *bool `json:"speedtest,omitempty"`
+}
+
+// CampaignDaemonReport defines model for CampaignDaemonReport.
+type CampaignDaemonReport struct {
+	DaemonId   string                   `json:"daemon_id"`
+	DaemonName *string                  `json:"daemon_name,omitempty"`
+	Iperf      []CampaignIperfResult    `json:"iperf"`
+	Speedtest  *CampaignSpeedTestResult `json:"speedtest,omitempty"`
+
+	// SpeedtestError Error reported by the speed test job
+	SpeedtestError *string `json:"speedtest_error,omitempty"`
+
+	// SpeedtestJobStatus Lifecycle state of a job
+	SpeedtestJobStatus *JobStatus `json:"speedtest_job_status,omitempty"`
+}
+
+// CampaignIperfResult defines model for CampaignIperfResult.
+type CampaignIperfResult struct {
+	// ErrorMessage Error reported by the job or the test
+	ErrorMessage *string `json:"error_message,omitempty"`
+	HostId       int     `json:"host_id"`
+	HostName     *string `json:"host_name,omitempty"`
+
+	// Id ID of the iperf result; unset until it was submitted
+	Id *int `json:"id,omitempty"`
+
+	// JobStatus Lifecycle state of a job
+	JobStatus    JobStatus  `json:"job_status"`
+	MeanRttMs    *float64   `json:"mean_rtt_ms,omitempty"`
+	Quarantined  *bool      `json:"quarantined,omitempty"`
+	ReceivedMbps *float64   `json:"received_mbps,omitempty"`
+	SentMbps     *float64   `json:"sent_mbps,omitempty"`
+	Success      *bool      `json:"success,omitempty"`
+	Timestamp    *time.Time `json:"timestamp,omitempty"`
+}
+
+// CampaignIperfSummary defines model for CampaignIperfSummary.
+type CampaignIperfSummary struct {
+	HostId       int            `json:"host_id"`
+	HostName     *string        `json:"host_name,omitempty"`
+	MeanRttMs    *MetricSummary `json:"mean_rtt_ms,omitempty"`
+	ReceivedMbps MetricSummary  `json:"received_mbps"`
+	SentMbps     MetricSummary  `json:"sent_mbps"`
+}
+
+// CampaignJobCounts defines model for CampaignJobCounts.
+type CampaignJobCounts struct {
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+	Leased    int `json:"leased"`
+	Pending   int `json:"pending"`
+}
+
+// CampaignReport defines model for CampaignReport.
+type CampaignReport struct {
+	Campaign Campaign `json:"campaign"`
+
+	// Daemons One row per daemon, sorted by daemon ID
+	Daemons []CampaignDaemonReport `json:"daemons"`
+
+	// IperfSummary One summary per host over successful results
+	IperfSummary     []CampaignIperfSummary    `json:"iperf_summary"`
+	SpeedtestSummary *CampaignSpeedTestSummary `json:"speedtest_summary,omitempty"`
+}
+
+// CampaignSpeedTestResult defines model for CampaignSpeedTestResult.
+type CampaignSpeedTestResult struct {
+	DownloadMbps float64   `json:"download_mbps"`
+	Id           int       `json:"id"`
+	PingMs       float64   `json:"ping_ms"`
+	Quarantined  bool      `json:"quarantined"`
+	ServerName   *string   `json:"server_name,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+	UploadMbps   float64   `json:"upload_mbps"`
+}
+
+// CampaignSpeedTestSummary defines model for CampaignSpeedTestSummary.
+type CampaignSpeedTestSummary struct {
+	DownloadMbps MetricSummary `json:"download_mbps"`
+	PingMs       MetricSummary `json:"ping_ms"`
+	UploadMbps   MetricSummary `json:"upload_mbps"`
+}
+
+// CampaignStatus scheduled until scheduled_at, running while any of its jobs is
+// pending or leased, completed once every job finished or failed
+type CampaignStatus string
+
+// Daemon defines model for Daemon.
+type Daemon struct {
+	// Arch CPU architecture
+	Arch         *string             `json:"arch,omitempty"`
+	Capabilities *DaemonCapabilities `json:"capabilities,omitempty"`
+
+	// ConfigVersion Version of the daemon's effective configuration, returned on
+	// registration and heartbeat. Daemons refetch their
+	// configuration when it changes.
+	ConfigVersion *string `json:"config_version,omitempty"`
+
+	// Hostname Hostname of the machine running the daemon
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Id Daemon identifier, used as daemon_id on results
+	Id string `json:"id"`
+
+	// Labels Free-form labels describing the daemon
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// LastSeenAt Last registration or heartbeat
+	LastSeenAt time.Time `json:"last_seen_at"`
+
+	// MeshAddress host:port of the iperf3 server the daemon runs for mesh tests
+	// (port 5201 when omitted). Daemons without one are not tested.
+	MeshAddress *string `json:"mesh_address,omitempty"`
+
+	// Name Human-friendly name for the daemon
+	Name *string `json:"name,omitempty"`
+
+	// Os Operating system
+	Os *string `json:"os,omitempty"`
+
+	// RegisteredAt When the daemon first registered
+	RegisteredAt time.Time `json:"registered_at"`
+
+	// SpoolDepth Results waiting in the daemon's offline spool at the last heartbeat
+	SpoolDepth *int `json:"spool_depth,omitempty"`
+
+	// Status Liveness of a daemon based on its last heartbeat
+	Status DaemonStatus `json:"status"`
+
+	// Version speed-checker version the daemon runs
+	Version *string `json:"version,omitempty"`
+}
+
+// DaemonCapabilities defines model for DaemonCapabilities.
+type DaemonCapabilities struct {
+	// Iperf3 Whether iperf3 is installed
+	Iperf3 *bool `json:"iperf3,omitempty"`
+
+	// Speedtest Whether the Ookla speedtest CLI is installed
+	Speedtest *bool `json:"speedtest,omitempty"`
+}
+
+// DaemonConfig defines model for DaemonConfig.
+type DaemonConfig struct {
+	// CreatedAt When the config was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// DaemonId Apply only to this daemon
+	DaemonId *string `json:"daemon_id,omitempty"`
+
+	// Id Unique identifier for the config
+	Id int `json:"id"`
+
+	// MatchLabels Apply to daemons carrying all of these labels
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+
+	// Name Human-friendly name for the config
+	Name string `json:"name"`
+
+	// Priority Order among configs of the same kind; higher priority wins
+	Priority *int `json:"priority,omitempty"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings DaemonSettings `json:"settings"`
+
+	// UpdatedAt When the config was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DaemonConfigCreation defines model for DaemonConfigCreation.
+type DaemonConfigCreation struct {
+	// DaemonId Apply only to this daemon
+	DaemonId *string `json:"daemon_id,omitempty"`
+
+	// MatchLabels Apply to daemons carrying all of these labels
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+
+	// Name Human-friendly name for the config
+	Name string `json:"name"`
+
+	// Priority Order among configs of the same kind; higher priority wins
+	Priority *int `json:"priority,omitempty"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings *DaemonSettings `json:"settings,omitempty"`
+}
+
+// DaemonHeartbeat defines model for DaemonHeartbeat.
+type DaemonHeartbeat struct {
+	// SpoolDepth Results waiting in the daemon's offline spool
+	SpoolDepth *int `json:"spool_depth,omitempty"`
+}
+
+// DaemonRegistration defines model for DaemonRegistration.
+type DaemonRegistration struct {
+	// Arch CPU architecture
+	Arch         *string             `json:"arch,omitempty"`
+	Capabilities *DaemonCapabilities `json:"capabilities,omitempty"`
+
+	// Hostname Hostname of the machine running the daemon
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Id Daemon identifier, used as daemon_id on results
+	Id string `json:"id"`
+
+	// Labels Free-form labels describing the daemon
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// MeshAddress host:port of the iperf3 server the daemon runs for mesh tests
+	// (port 5201 when omitted). Daemons without one are not tested.
+	MeshAddress *string `json:"mesh_address,omitempty"`
+
+	// Name Human-friendly name for the daemon
+	Name *string `json:"name,omitempty"`
+
+	// Os Operating system
+	Os *string `json:"os,omitempty"`
+
+	// Version speed-checker version the daemon runs
+	Version *string `json:"version,omitempty"`
+}
+
+// DaemonSettings Testing settings applied by daemons. Unset settings keep the daemon's
+// local configuration (testing.*).
+type DaemonSettings struct {
+	// AdaptiveEnabled Whether degraded results raise the test frequency
+	AdaptiveEnabled *bool `json:"adaptive_enabled,omitempty"`
+
+	// HostIds Only test these hosts
+	HostIds *[]int `json:"host_ids,omitempty"`
+
+	// HostTypes Only test hosts of these types
+	HostTypes *[]HostType `json:"host_types,omitempty"`
+
+	// IperfDurationSeconds Duration of each iperf test
+	IperfDurationSeconds *int `json:"iperf_duration_seconds,omitempty"`
+
+	// IperfEnabled Whether scheduled iperf tests run
+	IperfEnabled *bool `json:"iperf_enabled,omitempty"`
+
+	// IperfIntervalSeconds Interval between scheduled iperf tests
+	IperfIntervalSeconds *int `json:"iperf_interval_seconds,omitempty"`
+
+	// SpeedtestEnabled Whether scheduled speed tests run
+	SpeedtestEnabled *bool `json:"speedtest_enabled,omitempty"`
+
+	// SpeedtestIntervalSeconds Interval between scheduled speed tests
+	SpeedtestIntervalSeconds *int `json:"speedtest_interval_seconds,omitempty"`
+}
+
+// DaemonStatus Liveness of a daemon based on its last heartbeat
+type DaemonStatus string
+
 // DashboardData defines model for DashboardData.
 type DashboardData struct {
 	// ActiveHosts List of active hosts available for testing
 	ActiveHosts []Host `json:"active_hosts"`
 
+	// Daemons Registered daemons and their status
+	Daemons *[]Daemon `json:"daemons,omitempty"`
+
 	// RecentIperfTests Recent iperf test results
 	RecentIperfTests []IperfTestResult `json:"recent_iperf_tests"`
 
@@ -60,6 +540,12 @@ type DashboardData struct {
 		// AvgUploadMbps Average upload speed over last 24h
 		AvgUploadMbps *float64 `json:"avg_upload_mbps,omitempty"`
 
+		// BlockedIperfTests Iperf tests skipped over last 24h because an upstream host type failed
+		BlockedIperfTests *int `json:"blocked_iperf_tests,omitempty"`
+
+		// FailedIperfTests Iperf failures over last 24h, excluding tests blocked by an upstream failure
+		FailedIperfTests *int `json:"failed_iperf_tests,omitempty"`
+
 		// TotalIperfTests Total number of iperf tests
 		TotalIperfTests *int `json:"total_iperf_tests,omitempty"`
 
@@ -68,6 +554,33 @@ type DashboardData struct {
 	} `json:"statistics"`
 }
 
+// Distribution Distribution of a metric over a bucket. Values are absent when nothing was measured.
+type Distribution struct {
+	Avg *float64 `json:"avg,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	Min *float64 `json:"min,omitempty"`
+	P50 *float64 `json:"p50,omitempty"`
+	P90 *float64 `json:"p90,omitempty"`
+	P95 *float64 `json:"p95,omitempty"`
+	P99 *float64 `json:"p99,omitempty"`
+}
+
+// EffectiveDaemonConfig defines model for EffectiveDaemonConfig.
+type EffectiveDaemonConfig struct {
+	// Applied IDs of the daemon configs merged, in the order applied
+	Applied []int `json:"applied"`
+
+	// DaemonId Daemon the configuration was resolved for
+	DaemonId string `json:"daemon_id"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings DaemonSettings `json:"settings"`
+
+	// Version Version of the resolved settings, changes whenever they do
+	Version string `json:"version"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Details Additional error details
@@ -80,6 +593,12 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// EventType What a streamed event reports
+type EventType string
+
+// ExportFormat Export encoding, CSV with a header row or NDJSON with one object per line
+type ExportFormat string
+
 // Host defines model for Host.
 type Host struct {
 	// Active Whether the host is active for testing
@@ -88,6 +607,13 @@ type Host struct {
 	// CreatedAt When the host was created
 	CreatedAt time.Time `json:"created_at"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -115,6 +641,13 @@ type HostCreation struct {
 	// Active Whether the host is active for testing
 	Active *bool `json:"active,omitempty"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -139,6 +672,13 @@ type HostUpdate struct {
 	// Active Whether the host is active for testing
 	Active *bool `json:"active,omitempty"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -155,10 +695,26 @@ type HostUpdate struct {
 	Type HostType `json:"type"`
 }
 
+// IperfStatsGroupBy Dimension iperf statistics can be grouped by
+type IperfStatsGroupBy string
+
 // IperfTestResult defines model for IperfTestResult.
 type IperfTestResult struct {
-	// CreatedAt When the result was stored in the system
+	// BlockedBy Type of host for categorizing network tests
+	BlockedBy *HostType `json:"blocked_by,omitempty"`
+
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// CreatedAt When the result was stored in the system; the measurement timestamp for results stored before received_at was recorded
 	CreatedAt time.Time `json:"created_at"`
+	Daemon    *Daemon   `json:"daemon,omitempty"`
 
 	// DaemonId Identifier of the daemon that performed the test
 	DaemonId string `json:"daemon_id"`
@@ -182,6 +738,12 @@ type IperfTestResult struct {
 	// Protocol Protocol used for the test
 	Protocol IperfTestResultProtocol `json:"protocol"`
 
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of listings and baselines
+	Quarantined *bool `json:"quarantined,omitempty"`
+
+	// ReceivedAt Server time the result was received, unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+
 	// ReceivedMbps Received throughput in Mbps
 	ReceivedMbps float64 `json:"received_mbps"`
 
@@ -191,18 +753,40 @@ type IperfTestResult struct {
 	// SentMbps Sent throughput in Mbps
 	SentMbps float64 `json:"sent_mbps"`
 
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
 	// Success Whether the test was successful
 	Success *bool `json:"success,omitempty"`
 
 	// Timestamp When the test was performed (RFC3339)
 	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
 // IperfTestResultProtocol Protocol used for the test
 type IperfTestResultProtocol string
 
+// IperfTestSort Iperf test column to sort by, prefixed with - for descending order. Ties are broken by ID.
+type IperfTestSort string
+
 // IperfTestSubmission defines model for IperfTestSubmission.
 type IperfTestSubmission struct {
+	// BlockedBy Type of host for categorizing network tests
+	BlockedBy *HostType `json:"blocked_by,omitempty"`
+
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
 	// DaemonId Identifier of the daemon that performed the test
 	DaemonId string `json:"daemon_id"`
 
@@ -227,1655 +811,7557 @@ type IperfTestSubmission struct {
 	// SentMbps Sent throughput in Mbps
 	SentMbps float64 `json:"sent_mbps"`
 
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
 	// Timestamp When the test was performed (RFC3339)
 	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
 // IperfTestSubmissionProtocol Protocol used for the test
 type IperfTestSubmissionProtocol string
 
-// SpeedTestResult defines model for SpeedTestResult.
-type SpeedTestResult struct {
-	// CreatedAt When the result was stored in the system
+// Job defines model for Job.
+type Job struct {
+	// Attempts Number of times the job has been leased
+	Attempts int `json:"attempts"`
+
+	// CampaignId Campaign the job belongs to
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// CompletedAt When the job reached a terminal state
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// CreatedAt When the job was enqueued
 	CreatedAt time.Time `json:"created_at"`
 
-	// DaemonId Identifier of the daemon that performed the test
-	DaemonId string `json:"daemon_id"`
+	// DaemonId Pin the job to a daemon; any daemon may lease it when omitted (required when type is mesh)
+	DaemonId *string `json:"daemon_id,omitempty"`
 
-	// DownloadMbps Download speed in Mbps
-	DownloadMbps float64 `json:"download_mbps"`
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
 
-	// ExternalIp External IP address used for the test
-	ExternalIp *string `json:"external_ip,omitempty"`
+	// ErrorMessage Error reported by the last failed attempt
+	ErrorMessage *string `json:"error_message,omitempty"`
+	Host         *Host   `json:"host,omitempty"`
 
-	// Id Unique identifier for the test result
+	// HostId Target host for iperf jobs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
+
+	// Id Unique identifier for the job
 	Id int `json:"id"`
 
-	// Isp Internet Service Provider
-	Isp *string `json:"isp,omitempty"`
+	// LeaseExpiresAt When the current lease expires
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
 
-	// JitterMs Jitter in milliseconds
-	JitterMs *float64 `json:"jitter_ms,omitempty"`
+	// LeasedBy Daemon currently holding the lease
+	LeasedBy *string `json:"leased_by,omitempty"`
 
-	// PingMs Ping latency in milliseconds
-	PingMs float64 `json:"ping_ms"`
+	// MaxAttempts Maximum number of leases before the job is marked failed
+	MaxAttempts int `json:"max_attempts"`
 
-	// ResultUrl URL to full test results
-	ResultUrl *string `json:"result_url,omitempty"`
+	// Priority Higher priority jobs are leased first
+	Priority *int `json:"priority,omitempty"`
 
-	// ServerId Speed test server ID
-	ServerId *string `json:"server_id,omitempty"`
+	// ResultId ID of the speed or iperf result produced by the job
+	ResultId *int `json:"result_id,omitempty"`
 
-	// ServerName Speed test server name
-	ServerName *string `json:"server_name,omitempty"`
+	// ScheduledAt Earliest time the job may be leased (defaults to now)
+	ScheduledAt time.Time `json:"scheduled_at"`
 
-	// Timestamp When the test was performed (RFC3339)
-	Timestamp time.Time `json:"timestamp"`
+	// Status Lifecycle state of a job
+	Status JobStatus `json:"status"`
 
-	// UploadMbps Upload speed in Mbps
-	UploadMbps float64 `json:"upload_mbps"`
+	// TargetAddress host:port of the target daemon's iperf3 server for mesh jobs
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh job tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-// SpeedTestSubmission defines model for SpeedTestSubmission.
-type SpeedTestSubmission struct {
-	// DaemonId Identifier of the daemon that performed the test
+// JobCompletion defines model for JobCompletion.
+type JobCompletion struct {
+	// DaemonId Daemon reporting the outcome; must hold the lease
 	DaemonId string `json:"daemon_id"`
 
-	// DownloadMbps Download speed in Mbps
-	DownloadMbps float64 `json:"download_mbps"`
+	// ErrorMessage Error message if the job failed
+	ErrorMessage *string `json:"error_message,omitempty"`
 
-	// ExternalIp External IP address used for the test
-	ExternalIp *string `json:"external_ip,omitempty"`
+	// ResultId ID of the submitted speed or iperf result
+	ResultId *int `json:"result_id,omitempty"`
 
-	// Isp Internet Service Provider
-	Isp *string `json:"isp,omitempty"`
+	// Success Whether the job ran successfully
+	Success bool `json:"success"`
+}
 
-	// JitterMs Jitter in milliseconds
-	JitterMs *float64 `json:"jitter_ms,omitempty"`
+// JobCreation defines model for JobCreation.
+type JobCreation struct {
+	// DaemonId Pin the job to a daemon; any daemon may lease it when omitted (required when type is mesh)
+	DaemonId *string `json:"daemon_id,omitempty"`
 
-	// PingMs Ping latency in milliseconds
-	PingMs float64 `json:"ping_ms"`
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
 
-	// ResultUrl URL to full test results
-	ResultUrl *string `json:"result_url,omitempty"`
+	// HostId Target host for iperf jobs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
 
-	// ServerId Speed test server ID
-	ServerId *string `json:"server_id,omitempty"`
+	// MaxAttempts Maximum number of leases before the job is marked failed
+	MaxAttempts *int `json:"max_attempts,omitempty"`
 
-	// ServerName Speed test server name
-	ServerName *string `json:"server_name,omitempty"`
+	// Priority Higher priority jobs are leased first
+	Priority *int `json:"priority,omitempty"`
 
-	// Timestamp When the test was performed (RFC3339)
-	Timestamp time.Time `json:"timestamp"`
+	// ScheduledAt Earliest time the job may be leased (defaults to now)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
 
-	// UploadMbps Upload speed in Mbps
-	UploadMbps float64 `json:"upload_mbps"`
+	// TargetDaemonId Daemon whose iperf3 server a mesh job tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-// GetHostsParams defines parameters for GetHosts.
-type GetHostsParams struct {
-	// Type Filter by host type
-	Type *HostType `form:"type,omitempty" json:"type,omitempty"`
+// JobLeaseRequest defines model for JobLeaseRequest.
+type JobLeaseRequest struct {
+	// LeaseSeconds Lease duration in seconds (server default when omitted)
+	LeaseSeconds *int `json:"lease_seconds,omitempty"`
 
-	// Active Filter by active status
-	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+	// MaxJobs Maximum number of jobs to lease
+	MaxJobs *int `json:"max_jobs,omitempty"`
+
+	// PinnedOnly Only lease jobs pinned to this daemon (e.g. on-demand runs)
+	PinnedOnly *bool `json:"pinned_only,omitempty"`
+
+	// WaitSeconds Long-poll for up to this many seconds when no job is due
+	WaitSeconds *int `json:"wait_seconds,omitempty"`
 }
 
-// GetIperfTestsParams defines parameters for GetIperfTests.
-type GetIperfTestsParams struct {
-	// Limit Maximum number of results to return
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// JobStatus Lifecycle state of a job
+type JobStatus string
 
-	// Offset Number of results to skip
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+// JobType Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+// is pinned to against the iperf3 server of target_daemon_id.
+type JobType string
 
-	// StartTime Filter results after this timestamp (RFC3339)
-	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+// MeshMatrix defines model for MeshMatrix.
+type MeshMatrix struct {
+	// Cells One cell per tested source and target pair, sorted by source then target
+	Cells []MeshMatrixCell `json:"cells"`
 
-	// EndTime Filter results before this timestamp (RFC3339)
-	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+	// Daemons IDs of the daemons taking part in the mesh or tested within the window, sorted
+	Daemons     []string  `json:"daemons"`
+	GeneratedAt time.Time `json:"generated_at"`
 
-	// HostId Filter by host ID
-	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+	// Window Window the matrix covers
+	Window string `json:"window"`
+}
 
-	// DaemonId Filter by daemon ID
-	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+// MeshMatrixCell defines model for MeshMatrixCell.
+type MeshMatrixCell struct {
+	// Failures Failed tests of the pair within the window
+	Failures int         `json:"failures"`
+	Latest   MeshSample  `json:"latest"`
+	Median   *MeshSample `json:"median,omitempty"`
+
+	// Samples Tests of the pair within the window
+	Samples        int    `json:"samples"`
+	SourceDaemonId string `json:"source_daemon_id"`
+	TargetDaemonId string `json:"target_daemon_id"`
 }
 
-// GetSpeedTestsParams defines parameters for GetSpeedTests.
-type GetSpeedTestsParams struct {
-	// Limit Maximum number of results to return
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// MeshSample defines model for MeshSample.
+type MeshSample struct {
+	// MeanRttMs Unset when no test reported an RTT
+	MeanRttMs    *float64 `json:"mean_rtt_ms,omitempty"`
+	ReceivedMbps float64  `json:"received_mbps"`
+	SentMbps     float64  `json:"sent_mbps"`
 
-	// Offset Number of results to skip
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// Success Whether the test was successful; unset for medians
+	Success *bool `json:"success,omitempty"`
 
-	// StartTime Filter results after this timestamp (RFC3339)
-	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	// Timestamp When the test was performed; unset for medians
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
 
-	// EndTime Filter results before this timestamp (RFC3339)
-	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+// MeshTestResult defines model for MeshTestResult.
+type MeshTestResult struct {
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
 
-	// DaemonId Filter by daemon ID
-	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
-}
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
 
-// AddHostJSONRequestBody defines body for AddHost for application/json ContentType.
-type AddHostJSONRequestBody = HostCreation
+	// DurationSeconds Test duration in seconds
+	DurationSeconds int `json:"duration_seconds"`
 
-// UpdateHostJSONRequestBody defines body for UpdateHost for application/json ContentType.
-type UpdateHostJSONRequestBody = HostUpdate
+	// ErrorMessage Why the test failed; a submission with an error is stored as unsuccessful
+	ErrorMessage *string `json:"error_message,omitempty"`
 
-// SubmitIperfTestJSONRequestBody defines body for SubmitIperfTest for application/json ContentType.
-type SubmitIperfTestJSONRequestBody = IperfTestSubmission
+	// Id Unique identifier for the test result
+	Id int `json:"id"`
 
-// SubmitSpeedTestJSONRequestBody defines body for SubmitSpeedTest for application/json ContentType.
-type SubmitSpeedTestJSONRequestBody = SpeedTestSubmission
+	// MeanRttMs Mean round-trip time in milliseconds
+	MeanRttMs *float64 `json:"mean_rtt_ms,omitempty"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of the matrix
+	Quarantined bool `json:"quarantined"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
-}
+	// ReceivedAt Server time the result was received
+	ReceivedAt time.Time `json:"received_at"`
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+	// ReceivedMbps Received throughput in Mbps
+	ReceivedMbps float64 `json:"received_mbps"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// Retransmits Number of retransmitted packets
+	Retransmits *float64 `json:"retransmits,omitempty"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
-}
+	// SentMbps Sent throughput in Mbps
+	SentMbps float64 `json:"sent_mbps"`
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+	// SourceDaemonId Daemon that ran the iperf3 client
+	SourceDaemonId string `json:"source_daemon_id"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
-}
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
-}
+	// Success Whether the test was successful
+	Success bool `json:"success"`
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
-}
+	// TargetAddress host:port of the target's iperf3 server
+	TargetAddress *string `json:"target_address,omitempty"`
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GetDashboard request
-	GetDashboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TargetDaemonId Daemon whose iperf3 server was tested
+	TargetDaemonId string `json:"target_daemon_id"`
 
-	// GetHosts request
-	GetHosts(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
 
-	// AddHostWithBody request with any body
-	AddHostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+}
 
-	AddHost(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// MeshTestSubmission defines model for MeshTestSubmission.
+type MeshTestSubmission struct {
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
 
-	// DeleteHost request
-	DeleteHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
 
-	// GetHost request
-	GetHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DurationSeconds Test duration in seconds
+	DurationSeconds int `json:"duration_seconds"`
 
-	// UpdateHostWithBody request with any body
-	UpdateHostWithBody(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ErrorMessage Why the test failed; a submission with an error is stored as unsuccessful
+	ErrorMessage *string `json:"error_message,omitempty"`
 
-	UpdateHost(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// MeanRttMs Mean round-trip time in milliseconds
+	MeanRttMs *float64 `json:"mean_rtt_ms,omitempty"`
 
-	// GetIperfTests request
-	GetIperfTests(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ReceivedMbps Received throughput in Mbps
+	ReceivedMbps float64 `json:"received_mbps"`
 
-	// SubmitIperfTestWithBody request with any body
-	SubmitIperfTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Retransmits Number of retransmitted packets
+	Retransmits *float64 `json:"retransmits,omitempty"`
 
-	SubmitIperfTest(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// SentMbps Sent throughput in Mbps
+	SentMbps float64 `json:"sent_mbps"`
 
-	// DeleteIperfTest request
-	DeleteIperfTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// SourceDaemonId Daemon that ran the iperf3 client
+	SourceDaemonId string `json:"source_daemon_id"`
 
-	// GetSpeedTests request
-	GetSpeedTests(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
 
-	// SubmitSpeedTestWithBody request with any body
-	SubmitSpeedTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TargetAddress host:port of the target's iperf3 server
+	TargetAddress *string `json:"target_address,omitempty"`
 
-	SubmitSpeedTest(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TargetDaemonId Daemon whose iperf3 server was tested
+	TargetDaemonId string `json:"target_daemon_id"`
 
-	// DeleteSpeedTest request
-	DeleteSpeedTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
 
-func (c *Client) GetDashboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDashboardRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
-func (c *Client) GetHosts(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHostsRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// MetricSummary defines model for MetricSummary.
+type MetricSummary struct {
+	Max    float64 `json:"max"`
+	Median float64 `json:"median"`
+	Min    float64 `json:"min"`
 
-func (c *Client) AddHostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddHostRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// Samples Number of results the summary covers
+	Samples int `json:"samples"`
 }
 
-func (c *Client) AddHost(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddHostRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ResultBatch defines model for ResultBatch.
+type ResultBatch struct {
+	Items []ResultBatchItem `json:"items"`
 }
 
-func (c *Client) DeleteHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteHostRequest(c.Server, hostId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ResultBatchItem A single result in a batch. Set the submission matching type.
+type ResultBatchItem struct {
+	Iperf     *IperfTestSubmission `json:"iperf,omitempty"`
+	Speedtest *SpeedTestSubmission `json:"speedtest,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-func (c *Client) GetHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHostRequest(c.Server, hostId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ResultBatchItemResult defines model for ResultBatchItemResult.
+type ResultBatchItemResult struct {
+	// Error Why an invalid item was rejected
+	Error *string `json:"error,omitempty"`
+
+	// Id ID of the stored result, set unless the item is invalid
+	Id *int `json:"id,omitempty"`
+
+	// Index Position of the item in the submitted batch
+	Index int `json:"index"`
+
+	// Status Outcome of a batch item. duplicate means a result with the same
+	// submission_id was already stored; invalid items, including those
+	// rejected for clock skew, were not stored.
+	Status ResultBatchItemStatus `json:"status"`
 }
 
-func (c *Client) UpdateHostWithBody(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateHostRequestWithBody(c.Server, hostId, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ResultBatchItemStatus Outcome of a batch item. duplicate means a result with the same
+// submission_id was already stored; invalid items, including those
+// rejected for clock skew, were not stored.
+type ResultBatchItemStatus string
+
+// ResultBatchResponse defines model for ResultBatchResponse.
+type ResultBatchResponse struct {
+	Results []ResultBatchItemResult `json:"results"`
 }
 
-func (c *Client) UpdateHost(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateHostRequest(c.Server, hostId, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// RunOutcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+type RunOutcome string
+
+// RunRequest defines model for RunRequest.
+type RunRequest struct {
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostId Target host for iperf runs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-func (c *Client) GetIperfTests(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// SpeedTestResult defines model for SpeedTestResult.
+type SpeedTestResult struct {
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// CreatedAt When the result was stored in the system; the measurement timestamp for results stored before received_at was recorded
+	CreatedAt time.Time `json:"created_at"`
+	Daemon    *Daemon   `json:"daemon,omitempty"`
+
+	// DaemonId Identifier of the daemon that performed the test
+	DaemonId string `json:"daemon_id"`
+
+	// DownloadMbps Download speed in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// ExternalIp External IP address used for the test
+	ExternalIp *string `json:"external_ip,omitempty"`
+
+	// Id Unique identifier for the test result
+	Id int `json:"id"`
+
+	// Isp Internet Service Provider
+	Isp *string `json:"isp,omitempty"`
+
+	// JitterMs Jitter in milliseconds
+	JitterMs *float64 `json:"jitter_ms,omitempty"`
+
+	// PingMs Ping latency in milliseconds
+	PingMs float64 `json:"ping_ms"`
+
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of listings and baselines
+	Quarantined *bool `json:"quarantined,omitempty"`
+
+	// ReceivedAt Server time the result was received, unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+
+	// ResultUrl URL to full test results
+	ResultUrl *string `json:"result_url,omitempty"`
+
+	// ServerId Speed test server ID
+	ServerId *string `json:"server_id,omitempty"`
+
+	// ServerName Speed test server name
+	ServerName *string `json:"server_name,omitempty"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// UploadMbps Upload speed in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// SpeedTestSort Speed test column to sort by, prefixed with - for descending order. Ties are broken by ID.
+type SpeedTestSort string
+
+// SpeedTestStatsGroupBy Dimension speed test statistics can be grouped by
+type SpeedTestStatsGroupBy string
+
+// SpeedTestSubmission defines model for SpeedTestSubmission.
+type SpeedTestSubmission struct {
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DaemonId Identifier of the daemon that performed the test
+	DaemonId string `json:"daemon_id"`
+
+	// DownloadMbps Download speed in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// ExternalIp External IP address used for the test
+	ExternalIp *string `json:"external_ip,omitempty"`
+
+	// Isp Internet Service Provider
+	Isp *string `json:"isp,omitempty"`
+
+	// JitterMs Jitter in milliseconds
+	JitterMs *float64 `json:"jitter_ms,omitempty"`
+
+	// PingMs Ping latency in milliseconds
+	PingMs float64 `json:"ping_ms"`
+
+	// ResultUrl URL to full test results
+	ResultUrl *string `json:"result_url,omitempty"`
+
+	// ServerId Speed test server ID
+	ServerId *string `json:"server_id,omitempty"`
+
+	// ServerName Speed test server name
+	ServerName *string `json:"server_name,omitempty"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// UploadMbps Upload speed in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+	// Bucket Width of a statistics bucket. Buckets are aligned to the Unix epoch, in UTC.
+	Bucket    *StatsBucket  `json:"bucket,omitempty"`
+	EndTime   time.Time     `json:"end_time"`
+	GroupBy   []string      `json:"group_by"`
+	Series    []StatsSeries `json:"series"`
+	StartTime time.Time     `json:"start_time"`
+}
+
+// StatsBucket Width of a statistics bucket. Buckets are aligned to the Unix epoch, in UTC.
+type StatsBucket string
+
+// StatsGroup Values of the grouped dimensions of a series; dimensions not grouped by are absent
+type StatsGroup struct {
+	DaemonId *string `json:"daemon_id,omitempty"`
+	HostId   *int    `json:"host_id,omitempty"`
+	HostName *string `json:"host_name,omitempty"`
+
+	// HostType Type of host for categorizing network tests
+	HostType   *HostType `json:"host_type,omitempty"`
+	Isp        *string   `json:"isp,omitempty"`
+	ServerName *string   `json:"server_name,omitempty"`
+}
+
+// StatsPoint defines model for StatsPoint.
+type StatsPoint struct {
+	// Count Number of results in the bucket
+	Count int `json:"count"`
+
+	// FailureRate Share of attempts that failed, between 0 and 1
+	FailureRate *float64 `json:"failure_rate,omitempty"`
+
+	// Metrics Distribution per metric name
+	Metrics map[string]Distribution `json:"metrics"`
+
+	// Start Start of the bucket, or of the range when not bucketed
+	Start time.Time `json:"start"`
+}
+
+// StatsSeries defines model for StatsSeries.
+type StatsSeries struct {
+	// Group Values of the grouped dimensions of a series; dimensions not grouped by are absent
+	Group StatsGroup `json:"group"`
+
+	// Points Buckets with results or attempts, oldest first
+	Points []StatsPoint `json:"points"`
+}
+
+// StreamEvent Data of a streamed event. speedtest is set for speedtest_result,
+// iperf for iperf_result, host for host_created and host_updated,
+// daemon for daemon_heartbeat and alert for alert, along with the
+// result that raised it. host_deleted only carries host_id.
+type StreamEvent struct {
+	Alert    *Alert  `json:"alert,omitempty"`
+	Daemon   *Daemon `json:"daemon,omitempty"`
+	DaemonId *string `json:"daemon_id,omitempty"`
+	Host     *Host   `json:"host,omitempty"`
+	HostId   *int    `json:"host_id,omitempty"`
+
+	// Id Sequence number of the event, increasing for the life of the server
+	Id        int64            `json:"id"`
+	Iperf     *IperfTestResult `json:"iperf,omitempty"`
+	Speedtest *SpeedTestResult `json:"speedtest,omitempty"`
+	Time      time.Time        `json:"time"`
+
+	// Type What a streamed event reports
+	Type EventType `json:"type"`
+}
+
+// TestRun defines model for TestRun.
+type TestRun struct {
+	// DaemonId Daemon that attempted the run
+	DaemonId string `json:"daemon_id"`
+
+	// ErrorMessage Why the run failed, timed out or was skipped
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// FinishedAt When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at"`
+	Host       *Host     `json:"host,omitempty"`
+
+	// HostId Target host for iperf runs
+	HostId *int `json:"host_id,omitempty"`
+
+	// Id Unique identifier for the run
+	Id int `json:"id"`
+
+	// IperfTestId ID of the iperf test result produced by the run
+	IperfTestId *int `json:"iperf_test_id,omitempty"`
+
+	// MeshTestId ID of the mesh test result produced by the run
+	MeshTestId *int `json:"mesh_test_id,omitempty"`
+
+	// Outcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+	Outcome RunOutcome `json:"outcome"`
+
+	// SpeedTestId ID of the speed test result produced by the run
+	SpeedTestId *int `json:"speed_test_id,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt time.Time `json:"started_at"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tested
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// TestRunSubmission defines model for TestRunSubmission.
+type TestRunSubmission struct {
+	// DaemonId Daemon that attempted the run
+	DaemonId string `json:"daemon_id"`
+
+	// ErrorMessage Why the run failed, timed out or was skipped
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// FinishedAt When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at"`
+
+	// HostId Target host for iperf runs
+	HostId *int `json:"host_id,omitempty"`
+
+	// IperfTestId ID of the iperf test result produced by the run
+	IperfTestId *int `json:"iperf_test_id,omitempty"`
+
+	// MeshTestId ID of the mesh test result produced by the run
+	MeshTestId *int `json:"mesh_test_id,omitempty"`
+
+	// Outcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+	Outcome RunOutcome `json:"outcome"`
+
+	// SpeedTestId ID of the speed test result produced by the run
+	SpeedTestId *int `json:"speed_test_id,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt time.Time `json:"started_at"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tested
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// TestTrigger What caused a test to run. Adaptive runs are extra tests scheduled
+// while a target performs below its baseline.
+type TestTrigger string
+
+// StatsDaemonId defines model for StatsDaemonId.
+type StatsDaemonId = string
+
+// StatsEndTime defines model for StatsEndTime.
+type StatsEndTime = time.Time
+
+// StatsStartTime defines model for StatsStartTime.
+type StatsStartTime = time.Time
+
+// GetCampaignsParams defines parameters for GetCampaigns.
+type GetCampaignsParams struct {
+	// Limit Maximum number of campaigns to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDaemonsParams defines parameters for GetDaemons.
+type GetDaemonsParams struct {
+	// Status Filter by daemon status
+	Status *DaemonStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// Type Only stream these event types
+	Type *[]EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DaemonId Only stream events about this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostId Only stream events about this host
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+}
+
+// ExportIperfTestsParams defines parameters for ExportIperfTests.
+type ExportIperfTestsParams struct {
+	// Format Output format. Without it the Accept header picks, defaulting to CSV.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostId Filter by host ID
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// HostName Filter by host name (partial match)
+	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+
+	// HostType Filter by host type
+	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order. Defaults to timestamp, oldest first.
+	Sort *IperfTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Quarantined Export quarantined results instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+
+	// Limit Maximum number of results to export; all matching results when absent
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ExportSpeedTestsParams defines parameters for ExportSpeedTests.
+type ExportSpeedTestsParams struct {
+	// Format Output format. Without it the Accept header picks, defaulting to CSV.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// ServerName Filter by server name (partial match)
+	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order. Defaults to timestamp, oldest first.
+	Sort *SpeedTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Quarantined Export quarantined results instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+
+	// Limit Maximum number of results to export; all matching results when absent
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetHostsParams defines parameters for GetHosts.
+type GetHostsParams struct {
+	// Type Filter by host type
+	Type *HostType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Active Filter by active status
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// DaemonId Only return hosts this daemon may test, based on each host's
+	// daemon_selector and daemon_ids and the labels the daemon
+	// registered with
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Limit Maximum number of hosts to return; all hosts without it
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue the listing after the page whose X-Next-Cursor header returned this cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetIperfBaselineParams defines parameters for GetIperfBaseline.
+type GetIperfBaselineParams struct {
+	// HostId Host the baseline is computed for
+	HostId int `form:"host_id" json:"host_id"`
+
+	// DaemonId Restrict the baseline to results from this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Samples Number of recent results to include
+	Samples *int `form:"samples,omitempty" json:"samples,omitempty"`
+}
+
+// GetIperfTestsParams defines parameters for GetIperfTests.
+type GetIperfTestsParams struct {
+	// Limit Maximum number of results to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip. Ignored when a cursor is given.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue the listing after the page that returned this
+	// next_cursor. Unlike offset, cursors do not skip or repeat
+	// results while new ones arrive. Keep the same filters and sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// HostId Filter by host ID
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostName Filter by host name (partial match)
+	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+
+	// HostType Filter by host type
+	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order
+	Sort *IperfTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Slowest Sort by slowest received speed first, the same as sort=received_mbps. Ignored when sort is given.
+	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+
+	// Quarantined List quarantined results, whose timestamps were outside the clock skew window, instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+}
+
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Status Filter by job status
+	Status *JobStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Type Filter by job type
+	Type *JobType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DaemonId Filter by pinned or leasing daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Limit Maximum number of jobs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetJobParams defines parameters for GetJob.
+type GetJobParams struct {
+	// WaitSeconds Long-poll for up to this many seconds until the job finishes
+	WaitSeconds *int `form:"wait_seconds,omitempty" json:"wait_seconds,omitempty"`
+}
+
+// GetMeshMatrixParams defines parameters for GetMeshMatrix.
+type GetMeshMatrixParams struct {
+	// Window How far back to look, as a Go duration (e.g. 24h, 168h)
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetRunsParams defines parameters for GetRuns.
+type GetRunsParams struct {
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Type Filter by test type
+	Type *JobType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Trigger Filter by what caused the run
+	Trigger *TestTrigger `form:"trigger,omitempty" json:"trigger,omitempty"`
+
+	// Outcome Filter by run outcome
+	Outcome *RunOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// HostId Filter by target host
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// StartTime Filter runs started after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter runs started before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSpeedTestBaselineParams defines parameters for GetSpeedTestBaseline.
+type GetSpeedTestBaselineParams struct {
+	// DaemonId Restrict the baseline to results from this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Samples Number of recent results to include
+	Samples *int `form:"samples,omitempty" json:"samples,omitempty"`
+}
+
+// GetSpeedTestsParams defines parameters for GetSpeedTests.
+type GetSpeedTestsParams struct {
+	// Limit Maximum number of results to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip. Ignored when a cursor is given.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Continue the listing after the page that returned this
+	// next_cursor. Unlike offset, cursors do not skip or repeat
+	// results while new ones arrive. Keep the same filters and sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// ServerName Filter by server name (partial match)
+	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order
+	Sort *SpeedTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Slowest Sort by slowest download first, the same as sort=download_mbps. Ignored when sort is given.
+	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+
+	// Quarantined List quarantined results, whose timestamps were outside the clock skew window, instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+}
+
+// GetIperfStatsParams defines parameters for GetIperfStats.
+type GetIperfStatsParams struct {
+	// StartTime Start of the range (RFC3339), defaults to 24 hours before end_time
+	StartTime *StatsStartTime `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime End of the range (RFC3339), exclusive, defaults to now
+	EndTime *StatsEndTime `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Bucket Width of each point. Without a bucket the whole range is one point.
+	Bucket *StatsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy Split the results into one series per value of these dimensions
+	GroupBy *[]IperfStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DaemonId Only aggregate results from this daemon
+	DaemonId *StatsDaemonId `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+}
+
+// GetSpeedTestStatsParams defines parameters for GetSpeedTestStats.
+type GetSpeedTestStatsParams struct {
+	// StartTime Start of the range (RFC3339), defaults to 24 hours before end_time
+	StartTime *StatsStartTime `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime End of the range (RFC3339), exclusive, defaults to now
+	EndTime *StatsEndTime `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Bucket Width of each point. Without a bucket the whole range is one point.
+	Bucket *StatsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy Split the results into one series per value of these dimensions
+	GroupBy *[]SpeedTestStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DaemonId Only aggregate results from this daemon
+	DaemonId *StatsDaemonId `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+}
+
+// CreateCampaignJSONRequestBody defines body for CreateCampaign for application/json ContentType.
+type CreateCampaignJSONRequestBody = CampaignCreation
+
+// CreateDaemonConfigJSONRequestBody defines body for CreateDaemonConfig for application/json ContentType.
+type CreateDaemonConfigJSONRequestBody = DaemonConfigCreation
+
+// UpdateDaemonConfigJSONRequestBody defines body for UpdateDaemonConfig for application/json ContentType.
+type UpdateDaemonConfigJSONRequestBody = DaemonConfigCreation
+
+// RegisterDaemonJSONRequestBody defines body for RegisterDaemon for application/json ContentType.
+type RegisterDaemonJSONRequestBody = DaemonRegistration
+
+// HeartbeatDaemonJSONRequestBody defines body for HeartbeatDaemon for application/json ContentType.
+type HeartbeatDaemonJSONRequestBody = DaemonHeartbeat
+
+// LeaseJobsJSONRequestBody defines body for LeaseJobs for application/json ContentType.
+type LeaseJobsJSONRequestBody = JobLeaseRequest
+
+// RunOnDaemonJSONRequestBody defines body for RunOnDaemon for application/json ContentType.
+type RunOnDaemonJSONRequestBody = RunRequest
+
+// AddHostJSONRequestBody defines body for AddHost for application/json ContentType.
+type AddHostJSONRequestBody = HostCreation
+
+// UpdateHostJSONRequestBody defines body for UpdateHost for application/json ContentType.
+type UpdateHostJSONRequestBody = HostUpdate
+
+// SubmitIperfTestJSONRequestBody defines body for SubmitIperfTest for application/json ContentType.
+type SubmitIperfTestJSONRequestBody = IperfTestSubmission
+
+// CreateJobJSONRequestBody defines body for CreateJob for application/json ContentType.
+type CreateJobJSONRequestBody = JobCreation
+
+// CompleteJobJSONRequestBody defines body for CompleteJob for application/json ContentType.
+type CompleteJobJSONRequestBody = JobCompletion
+
+// SubmitMeshTestJSONRequestBody defines body for SubmitMeshTest for application/json ContentType.
+type SubmitMeshTestJSONRequestBody = MeshTestSubmission
+
+// SubmitResultBatchJSONRequestBody defines body for SubmitResultBatch for application/json ContentType.
+type SubmitResultBatchJSONRequestBody = ResultBatch
+
+// SubmitRunJSONRequestBody defines body for SubmitRun for application/json ContentType.
+type SubmitRunJSONRequestBody = TestRunSubmission
+
+// SubmitSpeedTestJSONRequestBody defines body for SubmitSpeedTest for application/json ContentType.
+type SubmitSpeedTestJSONRequestBody = SpeedTestSubmission
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetCampaigns request
+	GetCampaigns(ctx context.Context, params *GetCampaignsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCampaignWithBody request with any body
+	CreateCampaignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCampaign(ctx context.Context, body CreateCampaignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCampaign request
+	GetCampaign(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCampaignReport request
+	GetCampaignReport(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDaemonConfigs request
+	GetDaemonConfigs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDaemonConfigWithBody request with any body
+	CreateDaemonConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDaemonConfig(ctx context.Context, body CreateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDaemonConfig request
+	DeleteDaemonConfig(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDaemonConfig request
+	GetDaemonConfig(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDaemonConfigWithBody request with any body
+	UpdateDaemonConfigWithBody(ctx context.Context, configId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDaemonConfig(ctx context.Context, configId int, body UpdateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDaemons request
+	GetDaemons(ctx context.Context, params *GetDaemonsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterDaemonWithBody request with any body
+	RegisterDaemonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterDaemon(ctx context.Context, body RegisterDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDaemon request
+	GetDaemon(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEffectiveDaemonConfig request
+	GetEffectiveDaemonConfig(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HeartbeatDaemonWithBody request with any body
+	HeartbeatDaemonWithBody(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	HeartbeatDaemon(ctx context.Context, daemonId string, body HeartbeatDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LeaseJobsWithBody request with any body
+	LeaseJobsWithBody(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LeaseJobs(ctx context.Context, daemonId string, body LeaseJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunOnDaemonWithBody request with any body
+	RunOnDaemonWithBody(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunOnDaemon(ctx context.Context, daemonId string, body RunOnDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboard request
+	GetDashboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportIperfTests request
+	ExportIperfTests(ctx context.Context, params *ExportIperfTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportSpeedTests request
+	ExportSpeedTests(ctx context.Context, params *ExportSpeedTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHosts request
+	GetHosts(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddHostWithBody request with any body
+	AddHostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddHost(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHost request
+	DeleteHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHost request
+	GetHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateHostWithBody request with any body
+	UpdateHostWithBody(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateHost(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIperfBaseline request
+	GetIperfBaseline(ctx context.Context, params *GetIperfBaselineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIperfTests request
+	GetIperfTests(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitIperfTestWithBody request with any body
+	SubmitIperfTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitIperfTest(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteIperfTest request
+	DeleteIperfTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobs request
+	GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateJobWithBody request with any body
+	CreateJobWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateJob(ctx context.Context, body CreateJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJob request
+	GetJob(ctx context.Context, jobId int, params *GetJobParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteJobWithBody request with any body
+	CompleteJobWithBody(ctx context.Context, jobId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompleteJob(ctx context.Context, jobId int, body CompleteJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMeshMatrix request
+	GetMeshMatrix(ctx context.Context, params *GetMeshMatrixParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitMeshTestWithBody request with any body
+	SubmitMeshTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitMeshTest(ctx context.Context, body SubmitMeshTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitResultBatchWithBody request with any body
+	SubmitResultBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitResultBatch(ctx context.Context, body SubmitResultBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRuns request
+	GetRuns(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitRunWithBody request with any body
+	SubmitRunWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitRun(ctx context.Context, body SubmitRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpeedTestBaseline request
+	GetSpeedTestBaseline(ctx context.Context, params *GetSpeedTestBaselineParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpeedTests request
+	GetSpeedTests(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitSpeedTestWithBody request with any body
+	SubmitSpeedTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitSpeedTest(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSpeedTest request
+	DeleteSpeedTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIperfStats request
+	GetIperfStats(ctx context.Context, params *GetIperfStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSpeedTestStats request
+	GetSpeedTestStats(ctx context.Context, params *GetSpeedTestStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetCampaigns(ctx context.Context, params *GetCampaignsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCampaignsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCampaignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCampaignRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCampaign(ctx context.Context, body CreateCampaignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCampaignRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCampaign(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCampaignRequest(c.Server, campaignId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCampaignReport(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCampaignReportRequest(c.Server, campaignId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDaemonConfigs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDaemonConfigsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDaemonConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDaemonConfigRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDaemonConfig(ctx context.Context, body CreateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDaemonConfigRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDaemonConfig(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDaemonConfigRequest(c.Server, configId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDaemonConfig(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDaemonConfigRequest(c.Server, configId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDaemonConfigWithBody(ctx context.Context, configId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDaemonConfigRequestWithBody(c.Server, configId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDaemonConfig(ctx context.Context, configId int, body UpdateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDaemonConfigRequest(c.Server, configId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDaemons(ctx context.Context, params *GetDaemonsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDaemonsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterDaemonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterDaemonRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterDaemon(ctx context.Context, body RegisterDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterDaemonRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDaemon(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDaemonRequest(c.Server, daemonId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEffectiveDaemonConfig(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEffectiveDaemonConfigRequest(c.Server, daemonId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HeartbeatDaemonWithBody(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHeartbeatDaemonRequestWithBody(c.Server, daemonId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HeartbeatDaemon(ctx context.Context, daemonId string, body HeartbeatDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHeartbeatDaemonRequest(c.Server, daemonId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LeaseJobsWithBody(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLeaseJobsRequestWithBody(c.Server, daemonId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LeaseJobs(ctx context.Context, daemonId string, body LeaseJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLeaseJobsRequest(c.Server, daemonId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunOnDaemonWithBody(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunOnDaemonRequestWithBody(c.Server, daemonId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunOnDaemon(ctx context.Context, daemonId string, body RunOnDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunOnDaemonRequest(c.Server, daemonId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDashboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportIperfTests(ctx context.Context, params *ExportIperfTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportIperfTestsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportSpeedTests(ctx context.Context, params *ExportSpeedTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportSpeedTestsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHosts(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHostsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddHostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddHostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddHost(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddHostRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHostRequest(c.Server, hostId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHost(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHostRequest(c.Server, hostId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateHostWithBody(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHostRequestWithBody(c.Server, hostId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateHost(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHostRequest(c.Server, hostId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIperfBaseline(ctx context.Context, params *GetIperfBaselineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIperfBaselineRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIperfTests(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetIperfTestsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitIperfTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitIperfTestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitIperfTest(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitIperfTestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteIperfTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteIperfTestRequest(c.Server, testId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateJobWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJobRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateJob(ctx context.Context, body CreateJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateJobRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJob(ctx context.Context, jobId int, params *GetJobParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobRequest(c.Server, jobId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteJobWithBody(ctx context.Context, jobId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteJobRequestWithBody(c.Server, jobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteJob(ctx context.Context, jobId int, body CompleteJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteJobRequest(c.Server, jobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMeshMatrix(ctx context.Context, params *GetMeshMatrixParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeshMatrixRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitMeshTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitMeshTestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitMeshTest(ctx context.Context, body SubmitMeshTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitMeshTestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitResultBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitResultBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitResultBatch(ctx context.Context, body SubmitResultBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitResultBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRuns(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRunsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitRunWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitRunRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitRun(ctx context.Context, body SubmitRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitRunRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpeedTestBaseline(ctx context.Context, params *GetSpeedTestBaselineParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpeedTestBaselineRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpeedTests(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpeedTestsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitSpeedTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitSpeedTestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitSpeedTest(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitSpeedTestRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSpeedTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSpeedTestRequest(c.Server, testId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIperfStats(ctx context.Context, params *GetIperfStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIperfStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSpeedTestStats(ctx context.Context, params *GetSpeedTestStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSpeedTestStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetCampaignsRequest generates requests for GetCampaigns
+func NewGetCampaignsRequest(server string, params *GetCampaignsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/campaigns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCampaignRequest calls the generic CreateCampaign builder with application/json body
+func NewCreateCampaignRequest(server string, body CreateCampaignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCampaignRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateCampaignRequestWithBody generates requests for CreateCampaign with any type of body
+func NewCreateCampaignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/campaigns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCampaignRequest generates requests for GetCampaign
+func NewGetCampaignRequest(server string, campaignId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "campaignId", runtime.ParamLocationPath, campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/campaigns/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCampaignReportRequest generates requests for GetCampaignReport
+func NewGetCampaignReportRequest(server string, campaignId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "campaignId", runtime.ParamLocationPath, campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/campaigns/%s/report", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDaemonConfigsRequest generates requests for GetDaemonConfigs
+func NewGetDaemonConfigsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemon-configs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDaemonConfigRequest calls the generic CreateDaemonConfig builder with application/json body
+func NewCreateDaemonConfigRequest(server string, body CreateDaemonConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDaemonConfigRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDaemonConfigRequestWithBody generates requests for CreateDaemonConfig with any type of body
+func NewCreateDaemonConfigRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemon-configs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDaemonConfigRequest generates requests for DeleteDaemonConfig
+func NewDeleteDaemonConfigRequest(server string, configId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "configId", runtime.ParamLocationPath, configId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemon-configs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDaemonConfigRequest generates requests for GetDaemonConfig
+func NewGetDaemonConfigRequest(server string, configId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "configId", runtime.ParamLocationPath, configId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemon-configs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDaemonConfigRequest calls the generic UpdateDaemonConfig builder with application/json body
+func NewUpdateDaemonConfigRequest(server string, configId int, body UpdateDaemonConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDaemonConfigRequestWithBody(server, configId, "application/json", bodyReader)
+}
+
+// NewUpdateDaemonConfigRequestWithBody generates requests for UpdateDaemonConfig with any type of body
+func NewUpdateDaemonConfigRequestWithBody(server string, configId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "configId", runtime.ParamLocationPath, configId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemon-configs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDaemonsRequest generates requests for GetDaemons
+func NewGetDaemonsRequest(server string, params *GetDaemonsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegisterDaemonRequest calls the generic RegisterDaemon builder with application/json body
+func NewRegisterDaemonRequest(server string, body RegisterDaemonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterDaemonRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterDaemonRequestWithBody generates requests for RegisterDaemon with any type of body
+func NewRegisterDaemonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDaemonRequest generates requests for GetDaemon
+func NewGetDaemonRequest(server string, daemonId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "daemonId", runtime.ParamLocationPath, daemonId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEffectiveDaemonConfigRequest generates requests for GetEffectiveDaemonConfig
+func NewGetEffectiveDaemonConfigRequest(server string, daemonId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "daemonId", runtime.ParamLocationPath, daemonId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons/%s/config", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHeartbeatDaemonRequest calls the generic HeartbeatDaemon builder with application/json body
+func NewHeartbeatDaemonRequest(server string, daemonId string, body HeartbeatDaemonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewHeartbeatDaemonRequestWithBody(server, daemonId, "application/json", bodyReader)
+}
+
+// NewHeartbeatDaemonRequestWithBody generates requests for HeartbeatDaemon with any type of body
+func NewHeartbeatDaemonRequestWithBody(server string, daemonId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "daemonId", runtime.ParamLocationPath, daemonId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons/%s/heartbeat", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLeaseJobsRequest calls the generic LeaseJobs builder with application/json body
+func NewLeaseJobsRequest(server string, daemonId string, body LeaseJobsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLeaseJobsRequestWithBody(server, daemonId, "application/json", bodyReader)
+}
+
+// NewLeaseJobsRequestWithBody generates requests for LeaseJobs with any type of body
+func NewLeaseJobsRequestWithBody(server string, daemonId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "daemonId", runtime.ParamLocationPath, daemonId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons/%s/jobs/lease", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRunOnDaemonRequest calls the generic RunOnDaemon builder with application/json body
+func NewRunOnDaemonRequest(server string, daemonId string, body RunOnDaemonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunOnDaemonRequestWithBody(server, daemonId, "application/json", bodyReader)
+}
+
+// NewRunOnDaemonRequestWithBody generates requests for RunOnDaemon with any type of body
+func NewRunOnDaemonRequestWithBody(server string, daemonId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "daemonId", runtime.ParamLocationPath, daemonId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/daemons/%s/run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDashboardRequest generates requests for GetDashboard
+func NewGetDashboardRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_id", runtime.ParamLocationQuery, *params.HostId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportIperfTestsRequest generates requests for ExportIperfTests
+func NewExportIperfTestsRequest(server string, params *ExportIperfTestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export/iperf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_id", runtime.ParamLocationQuery, *params.HostId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_name", runtime.ParamLocationQuery, *params.HostName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_type", runtime.ParamLocationQuery, *params.HostType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Quarantined != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quarantined", runtime.ParamLocationQuery, *params.Quarantined); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportSpeedTestsRequest generates requests for ExportSpeedTests
+func NewExportSpeedTestsRequest(server string, params *ExportSpeedTestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export/speedtest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServerName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "server_name", runtime.ParamLocationQuery, *params.ServerName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Quarantined != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quarantined", runtime.ParamLocationQuery, *params.Quarantined); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHostsRequest generates requests for GetHosts
+func NewGetHostsRequest(server string, params *GetHostsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddHostRequest calls the generic AddHost builder with application/json body
+func NewAddHostRequest(server string, body AddHostJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddHostRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddHostRequestWithBody generates requests for AddHost with any type of body
+func NewAddHostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteHostRequest generates requests for DeleteHost
+func NewDeleteHostRequest(server string, hostId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hostId", runtime.ParamLocationPath, hostId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hosts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHostRequest generates requests for GetHost
+func NewGetHostRequest(server string, hostId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hostId", runtime.ParamLocationPath, hostId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hosts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateHostRequest calls the generic UpdateHost builder with application/json body
+func NewUpdateHostRequest(server string, hostId int, body UpdateHostJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateHostRequestWithBody(server, hostId, "application/json", bodyReader)
+}
+
+// NewUpdateHostRequestWithBody generates requests for UpdateHost with any type of body
+func NewUpdateHostRequestWithBody(server string, hostId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hostId", runtime.ParamLocationPath, hostId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hosts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIperfBaselineRequest generates requests for GetIperfBaseline
+func NewGetIperfBaselineRequest(server string, params *GetIperfBaselineParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/iperf/baseline")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_id", runtime.ParamLocationQuery, params.HostId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Samples != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "samples", runtime.ParamLocationQuery, *params.Samples); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIperfTestsRequest generates requests for GetIperfTests
+func NewGetIperfTestsRequest(server string, params *GetIperfTestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/iperf/results")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_id", runtime.ParamLocationQuery, *params.HostId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_name", runtime.ParamLocationQuery, *params.HostName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_type", runtime.ParamLocationQuery, *params.HostType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Slowest != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "slowest", runtime.ParamLocationQuery, *params.Slowest); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Quarantined != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quarantined", runtime.ParamLocationQuery, *params.Quarantined); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitIperfTestRequest calls the generic SubmitIperfTest builder with application/json body
+func NewSubmitIperfTestRequest(server string, body SubmitIperfTestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitIperfTestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitIperfTestRequestWithBody generates requests for SubmitIperfTest with any type of body
+func NewSubmitIperfTestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/iperf/results")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteIperfTestRequest generates requests for DeleteIperfTest
+func NewDeleteIperfTestRequest(server string, testId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "testId", runtime.ParamLocationPath, testId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/iperf/results/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobsRequest generates requests for GetJobs
+func NewGetJobsRequest(server string, params *GetJobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateJobRequest calls the generic CreateJob builder with application/json body
+func NewCreateJobRequest(server string, body CreateJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateJobRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateJobRequestWithBody generates requests for CreateJob with any type of body
+func NewCreateJobRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJobRequest generates requests for GetJob
+func NewGetJobRequest(server string, jobId int, params *GetJobParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WaitSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait_seconds", runtime.ParamLocationQuery, *params.WaitSeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompleteJobRequest calls the generic CompleteJob builder with application/json body
+func NewCompleteJobRequest(server string, jobId int, body CompleteJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompleteJobRequestWithBody(server, jobId, "application/json", bodyReader)
+}
+
+// NewCompleteJobRequestWithBody generates requests for CompleteJob with any type of body
+func NewCompleteJobRequestWithBody(server string, jobId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMeshMatrixRequest generates requests for GetMeshMatrix
+func NewGetMeshMatrixRequest(server string, params *GetMeshMatrixParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mesh/matrix")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Window != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "window", runtime.ParamLocationQuery, *params.Window); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitMeshTestRequest calls the generic SubmitMeshTest builder with application/json body
+func NewSubmitMeshTestRequest(server string, body SubmitMeshTestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitMeshTestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitMeshTestRequestWithBody generates requests for SubmitMeshTest with any type of body
+func NewSubmitMeshTestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mesh/results")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSubmitResultBatchRequest calls the generic SubmitResultBatch builder with application/json body
+func NewSubmitResultBatchRequest(server string, body SubmitResultBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitResultBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitResultBatchRequestWithBody generates requests for SubmitResultBatch with any type of body
+func NewSubmitResultBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/results/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRunsRequest generates requests for GetRuns
+func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Trigger != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "trigger", runtime.ParamLocationQuery, *params.Trigger); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Outcome != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "outcome", runtime.ParamLocationQuery, *params.Outcome); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HostId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_id", runtime.ParamLocationQuery, *params.HostId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitRunRequest calls the generic SubmitRun builder with application/json body
+func NewSubmitRunRequest(server string, body SubmitRunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitRunRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitRunRequestWithBody generates requests for SubmitRun with any type of body
+func NewSubmitRunRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSpeedTestBaselineRequest generates requests for GetSpeedTestBaseline
+func NewGetSpeedTestBaselineRequest(server string, params *GetSpeedTestBaselineParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/speedtest/baseline")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Samples != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "samples", runtime.ParamLocationQuery, *params.Samples); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSpeedTestsRequest generates requests for GetSpeedTests
+func NewGetSpeedTestsRequest(server string, params *GetSpeedTestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/speedtest/results")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServerName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "server_name", runtime.ParamLocationQuery, *params.ServerName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Slowest != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "slowest", runtime.ParamLocationQuery, *params.Slowest); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Quarantined != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quarantined", runtime.ParamLocationQuery, *params.Quarantined); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitSpeedTestRequest calls the generic SubmitSpeedTest builder with application/json body
+func NewSubmitSpeedTestRequest(server string, body SubmitSpeedTestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitSpeedTestRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitSpeedTestRequestWithBody generates requests for SubmitSpeedTest with any type of body
+func NewSubmitSpeedTestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/speedtest/results")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSpeedTestRequest generates requests for DeleteSpeedTest
+func NewDeleteSpeedTestRequest(server string, testId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "testId", runtime.ParamLocationPath, testId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/speedtest/results/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIperfStatsRequest generates requests for GetIperfStats
+func NewGetIperfStatsRequest(server string, params *GetIperfStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/iperf")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSpeedTestStatsRequest generates requests for GetSpeedTestStats
+func NewGetSpeedTestStatsRequest(server string, params *GetSpeedTestStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stats/speedtest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DaemonId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetCampaignsWithResponse request
+	GetCampaignsWithResponse(ctx context.Context, params *GetCampaignsParams, reqEditors ...RequestEditorFn) (*GetCampaignsResponse, error)
+
+	// CreateCampaignWithBodyWithResponse request with any body
+	CreateCampaignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCampaignResponse, error)
+
+	CreateCampaignWithResponse(ctx context.Context, body CreateCampaignJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCampaignResponse, error)
+
+	// GetCampaignWithResponse request
+	GetCampaignWithResponse(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*GetCampaignResponse, error)
+
+	// GetCampaignReportWithResponse request
+	GetCampaignReportWithResponse(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*GetCampaignReportResponse, error)
+
+	// GetDaemonConfigsWithResponse request
+	GetDaemonConfigsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDaemonConfigsResponse, error)
+
+	// CreateDaemonConfigWithBodyWithResponse request with any body
+	CreateDaemonConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDaemonConfigResponse, error)
+
+	CreateDaemonConfigWithResponse(ctx context.Context, body CreateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDaemonConfigResponse, error)
+
+	// DeleteDaemonConfigWithResponse request
+	DeleteDaemonConfigWithResponse(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*DeleteDaemonConfigResponse, error)
+
+	// GetDaemonConfigWithResponse request
+	GetDaemonConfigWithResponse(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*GetDaemonConfigResponse, error)
+
+	// UpdateDaemonConfigWithBodyWithResponse request with any body
+	UpdateDaemonConfigWithBodyWithResponse(ctx context.Context, configId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDaemonConfigResponse, error)
+
+	UpdateDaemonConfigWithResponse(ctx context.Context, configId int, body UpdateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDaemonConfigResponse, error)
+
+	// GetDaemonsWithResponse request
+	GetDaemonsWithResponse(ctx context.Context, params *GetDaemonsParams, reqEditors ...RequestEditorFn) (*GetDaemonsResponse, error)
+
+	// RegisterDaemonWithBodyWithResponse request with any body
+	RegisterDaemonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterDaemonResponse, error)
+
+	RegisterDaemonWithResponse(ctx context.Context, body RegisterDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterDaemonResponse, error)
+
+	// GetDaemonWithResponse request
+	GetDaemonWithResponse(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*GetDaemonResponse, error)
+
+	// GetEffectiveDaemonConfigWithResponse request
+	GetEffectiveDaemonConfigWithResponse(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*GetEffectiveDaemonConfigResponse, error)
+
+	// HeartbeatDaemonWithBodyWithResponse request with any body
+	HeartbeatDaemonWithBodyWithResponse(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HeartbeatDaemonResponse, error)
+
+	HeartbeatDaemonWithResponse(ctx context.Context, daemonId string, body HeartbeatDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*HeartbeatDaemonResponse, error)
+
+	// LeaseJobsWithBodyWithResponse request with any body
+	LeaseJobsWithBodyWithResponse(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LeaseJobsResponse, error)
+
+	LeaseJobsWithResponse(ctx context.Context, daemonId string, body LeaseJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*LeaseJobsResponse, error)
+
+	// RunOnDaemonWithBodyWithResponse request with any body
+	RunOnDaemonWithBodyWithResponse(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunOnDaemonResponse, error)
+
+	RunOnDaemonWithResponse(ctx context.Context, daemonId string, body RunOnDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*RunOnDaemonResponse, error)
+
+	// GetDashboardWithResponse request
+	GetDashboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// ExportIperfTestsWithResponse request
+	ExportIperfTestsWithResponse(ctx context.Context, params *ExportIperfTestsParams, reqEditors ...RequestEditorFn) (*ExportIperfTestsResponse, error)
+
+	// ExportSpeedTestsWithResponse request
+	ExportSpeedTestsWithResponse(ctx context.Context, params *ExportSpeedTestsParams, reqEditors ...RequestEditorFn) (*ExportSpeedTestsResponse, error)
+
+	// GetHostsWithResponse request
+	GetHostsWithResponse(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*GetHostsResponse, error)
+
+	// AddHostWithBodyWithResponse request with any body
+	AddHostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHostResponse, error)
+
+	AddHostWithResponse(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHostResponse, error)
+
+	// DeleteHostWithResponse request
+	DeleteHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*DeleteHostResponse, error)
+
+	// GetHostWithResponse request
+	GetHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*GetHostResponse, error)
+
+	// UpdateHostWithBodyWithResponse request with any body
+	UpdateHostWithBodyWithResponse(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error)
+
+	UpdateHostWithResponse(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error)
+
+	// GetIperfBaselineWithResponse request
+	GetIperfBaselineWithResponse(ctx context.Context, params *GetIperfBaselineParams, reqEditors ...RequestEditorFn) (*GetIperfBaselineResponse, error)
+
+	// GetIperfTestsWithResponse request
+	GetIperfTestsWithResponse(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*GetIperfTestsResponse, error)
+
+	// SubmitIperfTestWithBodyWithResponse request with any body
+	SubmitIperfTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error)
+
+	SubmitIperfTestWithResponse(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error)
+
+	// DeleteIperfTestWithResponse request
+	DeleteIperfTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteIperfTestResponse, error)
+
+	// GetJobsWithResponse request
+	GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error)
+
+	// CreateJobWithBodyWithResponse request with any body
+	CreateJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJobResponse, error)
+
+	CreateJobWithResponse(ctx context.Context, body CreateJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJobResponse, error)
+
+	// GetJobWithResponse request
+	GetJobWithResponse(ctx context.Context, jobId int, params *GetJobParams, reqEditors ...RequestEditorFn) (*GetJobResponse, error)
+
+	// CompleteJobWithBodyWithResponse request with any body
+	CompleteJobWithBodyWithResponse(ctx context.Context, jobId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteJobResponse, error)
+
+	CompleteJobWithResponse(ctx context.Context, jobId int, body CompleteJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteJobResponse, error)
+
+	// GetMeshMatrixWithResponse request
+	GetMeshMatrixWithResponse(ctx context.Context, params *GetMeshMatrixParams, reqEditors ...RequestEditorFn) (*GetMeshMatrixResponse, error)
+
+	// SubmitMeshTestWithBodyWithResponse request with any body
+	SubmitMeshTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitMeshTestResponse, error)
+
+	SubmitMeshTestWithResponse(ctx context.Context, body SubmitMeshTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitMeshTestResponse, error)
+
+	// SubmitResultBatchWithBodyWithResponse request with any body
+	SubmitResultBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitResultBatchResponse, error)
+
+	SubmitResultBatchWithResponse(ctx context.Context, body SubmitResultBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitResultBatchResponse, error)
+
+	// GetRunsWithResponse request
+	GetRunsWithResponse(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*GetRunsResponse, error)
+
+	// SubmitRunWithBodyWithResponse request with any body
+	SubmitRunWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitRunResponse, error)
+
+	SubmitRunWithResponse(ctx context.Context, body SubmitRunJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitRunResponse, error)
+
+	// GetSpeedTestBaselineWithResponse request
+	GetSpeedTestBaselineWithResponse(ctx context.Context, params *GetSpeedTestBaselineParams, reqEditors ...RequestEditorFn) (*GetSpeedTestBaselineResponse, error)
+
+	// GetSpeedTestsWithResponse request
+	GetSpeedTestsWithResponse(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*GetSpeedTestsResponse, error)
+
+	// SubmitSpeedTestWithBodyWithResponse request with any body
+	SubmitSpeedTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error)
+
+	SubmitSpeedTestWithResponse(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error)
+
+	// DeleteSpeedTestWithResponse request
+	DeleteSpeedTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteSpeedTestResponse, error)
+
+	// GetIperfStatsWithResponse request
+	GetIperfStatsWithResponse(ctx context.Context, params *GetIperfStatsParams, reqEditors ...RequestEditorFn) (*GetIperfStatsResponse, error)
+
+	// GetSpeedTestStatsWithResponse request
+	GetSpeedTestStatsWithResponse(ctx context.Context, params *GetSpeedTestStatsParams, reqEditors ...RequestEditorFn) (*GetSpeedTestStatsResponse, error)
+}
+
+type GetCampaignsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Campaign
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCampaignsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCampaignsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCampaignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Campaign
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCampaignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCampaignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCampaignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Campaign
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCampaignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCampaignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCampaignReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CampaignReport
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCampaignReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCampaignReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDaemonConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DaemonConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDaemonConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDaemonConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDaemonConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DaemonConfig
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDaemonConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDaemonConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDaemonConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDaemonConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDaemonConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDaemonConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DaemonConfig
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDaemonConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDaemonConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDaemonConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DaemonConfig
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDaemonConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDaemonConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDaemonsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Daemon
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDaemonsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDaemonsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterDaemonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Daemon
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterDaemonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterDaemonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDaemonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Daemon
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDaemonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDaemonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEffectiveDaemonConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EffectiveDaemonConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEffectiveDaemonConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEffectiveDaemonConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HeartbeatDaemonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Daemon
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r HeartbeatDaemonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HeartbeatDaemonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LeaseJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Job
+}
+
+// Status returns HTTPResponse.Status
+func (r LeaseJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LeaseJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunOnDaemonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Job
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RunOnDaemonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunOnDaemonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DashboardData
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportIperfTestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportIperfTestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportIperfTestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportSpeedTestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportSpeedTestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportSpeedTestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHostsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Host
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHostsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHostsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddHostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Host
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddHostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddHostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Host
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateHostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Host
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateHostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateHostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIperfBaselineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Baseline
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIperfBaselineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIperfBaselineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIperfTestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Limit *int `json:"limit,omitempty"`
+
+		// NextCursor Pass as cursor to get the next page; absent on the last page
+		NextCursor *string            `json:"next_cursor,omitempty"`
+		Offset     *int               `json:"offset,omitempty"`
+		Results    *[]IperfTestResult `json:"results,omitempty"`
+
+		// Total Total number of matching results
+		Total *int `json:"total,omitempty"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIperfTestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIperfTestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitIperfTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IperfTestResult
+	JSON201      *IperfTestResult
+	JSON400      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitIperfTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitIperfTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteIperfTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteIperfTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteIperfTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Job
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Job
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeshMatrixResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeshMatrix
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeshMatrixResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeshMatrixResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitMeshTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeshTestResult
+	JSON201      *MeshTestResult
+	JSON400      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitMeshTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitMeshTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitResultBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResultBatchResponse
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitResultBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitResultBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TestRun
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestRun
+	JSON201      *TestRun
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpeedTestBaselineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Baseline
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpeedTestBaselineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpeedTestBaselineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpeedTestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Limit *int `json:"limit,omitempty"`
+
+		// NextCursor Pass as cursor to get the next page; absent on the last page
+		NextCursor *string            `json:"next_cursor,omitempty"`
+		Offset     *int               `json:"offset,omitempty"`
+		Results    *[]SpeedTestResult `json:"results,omitempty"`
+
+		// Total Total number of matching results
+		Total *int `json:"total,omitempty"`
+	}
+	JSON400 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpeedTestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpeedTestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitSpeedTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpeedTestResult
+	JSON201      *SpeedTestResult
+	JSON400      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitSpeedTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitSpeedTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSpeedTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSpeedTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpeedTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIperfStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIperfStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIperfStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpeedTestStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpeedTestStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpeedTestStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetCampaignsWithResponse request returning *GetCampaignsResponse
+func (c *ClientWithResponses) GetCampaignsWithResponse(ctx context.Context, params *GetCampaignsParams, reqEditors ...RequestEditorFn) (*GetCampaignsResponse, error) {
+	rsp, err := c.GetCampaigns(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCampaignsResponse(rsp)
+}
+
+// CreateCampaignWithBodyWithResponse request with arbitrary body returning *CreateCampaignResponse
+func (c *ClientWithResponses) CreateCampaignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCampaignResponse, error) {
+	rsp, err := c.CreateCampaignWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseCreateCampaignResponse(rsp)
 }
 
-func (c *Client) SubmitIperfTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitIperfTestRequestWithBody(c.Server, contentType, body)
+func (c *ClientWithResponses) CreateCampaignWithResponse(ctx context.Context, body CreateCampaignJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCampaignResponse, error) {
+	rsp, err := c.CreateCampaign(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseCreateCampaignResponse(rsp)
+}
+
+// GetCampaignWithResponse request returning *GetCampaignResponse
+func (c *ClientWithResponses) GetCampaignWithResponse(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*GetCampaignResponse, error) {
+	rsp, err := c.GetCampaign(ctx, campaignId, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseGetCampaignResponse(rsp)
 }
 
-func (c *Client) SubmitIperfTest(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitIperfTestRequest(c.Server, body)
+// GetCampaignReportWithResponse request returning *GetCampaignReportResponse
+func (c *ClientWithResponses) GetCampaignReportWithResponse(ctx context.Context, campaignId int, reqEditors ...RequestEditorFn) (*GetCampaignReportResponse, error) {
+	rsp, err := c.GetCampaignReport(ctx, campaignId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseGetCampaignReportResponse(rsp)
+}
+
+// GetDaemonConfigsWithResponse request returning *GetDaemonConfigsResponse
+func (c *ClientWithResponses) GetDaemonConfigsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDaemonConfigsResponse, error) {
+	rsp, err := c.GetDaemonConfigs(ctx, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseGetDaemonConfigsResponse(rsp)
 }
 
-func (c *Client) DeleteIperfTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteIperfTestRequest(c.Server, testId)
+// CreateDaemonConfigWithBodyWithResponse request with arbitrary body returning *CreateDaemonConfigResponse
+func (c *ClientWithResponses) CreateDaemonConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDaemonConfigResponse, error) {
+	rsp, err := c.CreateDaemonConfigWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseCreateDaemonConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDaemonConfigWithResponse(ctx context.Context, body CreateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDaemonConfigResponse, error) {
+	rsp, err := c.CreateDaemonConfig(ctx, body, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseCreateDaemonConfigResponse(rsp)
 }
 
-func (c *Client) GetSpeedTests(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSpeedTestsRequest(c.Server, params)
+// DeleteDaemonConfigWithResponse request returning *DeleteDaemonConfigResponse
+func (c *ClientWithResponses) DeleteDaemonConfigWithResponse(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*DeleteDaemonConfigResponse, error) {
+	rsp, err := c.DeleteDaemonConfig(ctx, configId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseDeleteDaemonConfigResponse(rsp)
+}
+
+// GetDaemonConfigWithResponse request returning *GetDaemonConfigResponse
+func (c *ClientWithResponses) GetDaemonConfigWithResponse(ctx context.Context, configId int, reqEditors ...RequestEditorFn) (*GetDaemonConfigResponse, error) {
+	rsp, err := c.GetDaemonConfig(ctx, configId, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseGetDaemonConfigResponse(rsp)
 }
 
-func (c *Client) SubmitSpeedTestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitSpeedTestRequestWithBody(c.Server, contentType, body)
+// UpdateDaemonConfigWithBodyWithResponse request with arbitrary body returning *UpdateDaemonConfigResponse
+func (c *ClientWithResponses) UpdateDaemonConfigWithBodyWithResponse(ctx context.Context, configId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDaemonConfigResponse, error) {
+	rsp, err := c.UpdateDaemonConfigWithBody(ctx, configId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseUpdateDaemonConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDaemonConfigWithResponse(ctx context.Context, configId int, body UpdateDaemonConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDaemonConfigResponse, error) {
+	rsp, err := c.UpdateDaemonConfig(ctx, configId, body, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseUpdateDaemonConfigResponse(rsp)
 }
 
-func (c *Client) SubmitSpeedTest(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitSpeedTestRequest(c.Server, body)
+// GetDaemonsWithResponse request returning *GetDaemonsResponse
+func (c *ClientWithResponses) GetDaemonsWithResponse(ctx context.Context, params *GetDaemonsParams, reqEditors ...RequestEditorFn) (*GetDaemonsResponse, error) {
+	rsp, err := c.GetDaemons(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseGetDaemonsResponse(rsp)
+}
+
+// RegisterDaemonWithBodyWithResponse request with arbitrary body returning *RegisterDaemonResponse
+func (c *ClientWithResponses) RegisterDaemonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterDaemonResponse, error) {
+	rsp, err := c.RegisterDaemonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseRegisterDaemonResponse(rsp)
 }
 
-func (c *Client) DeleteSpeedTest(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSpeedTestRequest(c.Server, testId)
+func (c *ClientWithResponses) RegisterDaemonWithResponse(ctx context.Context, body RegisterDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterDaemonResponse, error) {
+	rsp, err := c.RegisterDaemon(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	return ParseRegisterDaemonResponse(rsp)
+}
+
+// GetDaemonWithResponse request returning *GetDaemonResponse
+func (c *ClientWithResponses) GetDaemonWithResponse(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*GetDaemonResponse, error) {
+	rsp, err := c.GetDaemon(ctx, daemonId, reqEditors...)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return ParseGetDaemonResponse(rsp)
 }
 
-// NewGetDashboardRequest generates requests for GetDashboard
-func NewGetDashboardRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// GetEffectiveDaemonConfigWithResponse request returning *GetEffectiveDaemonConfigResponse
+func (c *ClientWithResponses) GetEffectiveDaemonConfigWithResponse(ctx context.Context, daemonId string, reqEditors ...RequestEditorFn) (*GetEffectiveDaemonConfigResponse, error) {
+	rsp, err := c.GetEffectiveDaemonConfig(ctx, daemonId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetEffectiveDaemonConfigResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/dashboard")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// HeartbeatDaemonWithBodyWithResponse request with arbitrary body returning *HeartbeatDaemonResponse
+func (c *ClientWithResponses) HeartbeatDaemonWithBodyWithResponse(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*HeartbeatDaemonResponse, error) {
+	rsp, err := c.HeartbeatDaemonWithBody(ctx, daemonId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseHeartbeatDaemonResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *ClientWithResponses) HeartbeatDaemonWithResponse(ctx context.Context, daemonId string, body HeartbeatDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*HeartbeatDaemonResponse, error) {
+	rsp, err := c.HeartbeatDaemon(ctx, daemonId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseHeartbeatDaemonResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// LeaseJobsWithBodyWithResponse request with arbitrary body returning *LeaseJobsResponse
+func (c *ClientWithResponses) LeaseJobsWithBodyWithResponse(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LeaseJobsResponse, error) {
+	rsp, err := c.LeaseJobsWithBody(ctx, daemonId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseLeaseJobsResponse(rsp)
 }
 
-// NewGetHostsRequest generates requests for GetHosts
-func NewGetHostsRequest(server string, params *GetHostsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *ClientWithResponses) LeaseJobsWithResponse(ctx context.Context, daemonId string, body LeaseJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*LeaseJobsResponse, error) {
+	rsp, err := c.LeaseJobs(ctx, daemonId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseLeaseJobsResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/hosts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// RunOnDaemonWithBodyWithResponse request with arbitrary body returning *RunOnDaemonResponse
+func (c *ClientWithResponses) RunOnDaemonWithBodyWithResponse(ctx context.Context, daemonId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunOnDaemonResponse, error) {
+	rsp, err := c.RunOnDaemonWithBody(ctx, daemonId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseRunOnDaemonResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *ClientWithResponses) RunOnDaemonWithResponse(ctx context.Context, daemonId string, body RunOnDaemonJSONRequestBody, reqEditors ...RequestEditorFn) (*RunOnDaemonResponse, error) {
+	rsp, err := c.RunOnDaemon(ctx, daemonId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseRunOnDaemonResponse(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Type != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Active != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+// GetDashboardWithResponse request returning *GetDashboardResponse
+func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
+	rsp, err := c.GetDashboard(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetDashboardResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseStreamEventsResponse(rsp)
 }
 
-// NewAddHostRequest calls the generic AddHost builder with application/json body
-func NewAddHostRequest(server string, body AddHostJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ExportIperfTestsWithResponse request returning *ExportIperfTestsResponse
+func (c *ClientWithResponses) ExportIperfTestsWithResponse(ctx context.Context, params *ExportIperfTestsParams, reqEditors ...RequestEditorFn) (*ExportIperfTestsResponse, error) {
+	rsp, err := c.ExportIperfTests(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddHostRequestWithBody(server, "application/json", bodyReader)
+	return ParseExportIperfTestsResponse(rsp)
 }
 
-// NewAddHostRequestWithBody generates requests for AddHost with any type of body
-func NewAddHostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// ExportSpeedTestsWithResponse request returning *ExportSpeedTestsResponse
+func (c *ClientWithResponses) ExportSpeedTestsWithResponse(ctx context.Context, params *ExportSpeedTestsParams, reqEditors ...RequestEditorFn) (*ExportSpeedTestsResponse, error) {
+	rsp, err := c.ExportSpeedTests(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseExportSpeedTestsResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/hosts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// GetHostsWithResponse request returning *GetHostsResponse
+func (c *ClientWithResponses) GetHostsWithResponse(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*GetHostsResponse, error) {
+	rsp, err := c.GetHosts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetHostsResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// AddHostWithBodyWithResponse request with arbitrary body returning *AddHostResponse
+func (c *ClientWithResponses) AddHostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHostResponse, error) {
+	rsp, err := c.AddHostWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseAddHostResponse(rsp)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *ClientWithResponses) AddHostWithResponse(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHostResponse, error) {
+	rsp, err := c.AddHost(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseAddHostResponse(rsp)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+// DeleteHostWithResponse request returning *DeleteHostResponse
+func (c *ClientWithResponses) DeleteHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*DeleteHostResponse, error) {
+	rsp, err := c.DeleteHost(ctx, hostId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHostResponse(rsp)
 }
 
-// NewDeleteHostRequest generates requests for DeleteHost
-func NewDeleteHostRequest(server string, hostId int) (*http.Request, error) {
-	var err error
+// GetHostWithResponse request returning *GetHostResponse
+func (c *ClientWithResponses) GetHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*GetHostResponse, error) {
+	rsp, err := c.GetHost(ctx, hostId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHostResponse(rsp)
+}
 
-	var pathParam0 string
+// UpdateHostWithBodyWithResponse request with arbitrary body returning *UpdateHostResponse
+func (c *ClientWithResponses) UpdateHostWithBodyWithResponse(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error) {
+	rsp, err := c.UpdateHostWithBody(ctx, hostId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateHostResponse(rsp)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hostId", runtime.ParamLocationPath, hostId)
+func (c *ClientWithResponses) UpdateHostWithResponse(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error) {
+	rsp, err := c.UpdateHost(ctx, hostId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseUpdateHostResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// GetIperfBaselineWithResponse request returning *GetIperfBaselineResponse
+func (c *ClientWithResponses) GetIperfBaselineWithResponse(ctx context.Context, params *GetIperfBaselineParams, reqEditors ...RequestEditorFn) (*GetIperfBaselineResponse, error) {
+	rsp, err := c.GetIperfBaseline(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetIperfBaselineResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/hosts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// GetIperfTestsWithResponse request returning *GetIperfTestsResponse
+func (c *ClientWithResponses) GetIperfTestsWithResponse(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*GetIperfTestsResponse, error) {
+	rsp, err := c.GetIperfTests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetIperfTestsResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// SubmitIperfTestWithBodyWithResponse request with arbitrary body returning *SubmitIperfTestResponse
+func (c *ClientWithResponses) SubmitIperfTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error) {
+	rsp, err := c.SubmitIperfTestWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSubmitIperfTestResponse(rsp)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *ClientWithResponses) SubmitIperfTestWithResponse(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error) {
+	rsp, err := c.SubmitIperfTest(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSubmitIperfTestResponse(rsp)
+}
 
-	return req, nil
+// DeleteIperfTestWithResponse request returning *DeleteIperfTestResponse
+func (c *ClientWithResponses) DeleteIperfTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteIperfTestResponse, error) {
+	rsp, err := c.DeleteIperfTest(ctx, testId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteIperfTestResponse(rsp)
 }
 
-// NewGetHostRequest generates requests for GetHost
-func NewGetHostRequest(server string, hostId int) (*http.Request, error) {
-	var err error
+// GetJobsWithResponse request returning *GetJobsResponse
+func (c *ClientWithResponses) GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error) {
+	rsp, err := c.GetJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsResponse(rsp)
+}
 
-	var pathParam0 string
+// CreateJobWithBodyWithResponse request with arbitrary body returning *CreateJobResponse
+func (c *ClientWithResponses) CreateJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateJobResponse, error) {
+	rsp, err := c.CreateJobWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateJobResponse(rsp)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hostId", runtime.ParamLocationPath, hostId)
+func (c *ClientWithResponses) CreateJobWithResponse(ctx context.Context, body CreateJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateJobResponse, error) {
+	rsp, err := c.CreateJob(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseCreateJobResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// GetJobWithResponse request returning *GetJobResponse
+func (c *ClientWithResponses) GetJobWithResponse(ctx context.Context, jobId int, params *GetJobParams, reqEditors ...RequestEditorFn) (*GetJobResponse, error) {
+	rsp, err := c.GetJob(ctx, jobId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetJobResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/hosts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+// CompleteJobWithBodyWithResponse request with arbitrary body returning *CompleteJobResponse
+func (c *ClientWithResponses) CompleteJobWithBodyWithResponse(ctx context.Context, jobId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteJobResponse, error) {
+	rsp, err := c.CompleteJobWithBody(ctx, jobId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseCompleteJobResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *ClientWithResponses) CompleteJobWithResponse(ctx context.Context, jobId int, body CompleteJobJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteJobResponse, error) {
+	rsp, err := c.CompleteJob(ctx, jobId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseCompleteJobResponse(rsp)
 }
 
-// NewUpdateHostRequest calls the generic UpdateHost builder with application/json body
-func NewUpdateHostRequest(server string, hostId int, body UpdateHostJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// GetMeshMatrixWithResponse request returning *GetMeshMatrixResponse
+func (c *ClientWithResponses) GetMeshMatrixWithResponse(ctx context.Context, params *GetMeshMatrixParams, reqEditors ...RequestEditorFn) (*GetMeshMatrixResponse, error) {
+	rsp, err := c.GetMeshMatrix(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateHostRequestWithBody(server, hostId, "application/json", bodyReader)
+	return ParseGetMeshMatrixResponse(rsp)
 }
 
-// NewUpdateHostRequestWithBody generates requests for UpdateHost with any type of body
-func NewUpdateHostRequestWithBody(server string, hostId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hostId", runtime.ParamLocationPath, hostId)
+// SubmitMeshTestWithBodyWithResponse request with arbitrary body returning *SubmitMeshTestResponse
+func (c *ClientWithResponses) SubmitMeshTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitMeshTestResponse, error) {
+	rsp, err := c.SubmitMeshTestWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSubmitMeshTestResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *ClientWithResponses) SubmitMeshTestWithResponse(ctx context.Context, body SubmitMeshTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitMeshTestResponse, error) {
+	rsp, err := c.SubmitMeshTest(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSubmitMeshTestResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/hosts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// SubmitResultBatchWithBodyWithResponse request with arbitrary body returning *SubmitResultBatchResponse
+func (c *ClientWithResponses) SubmitResultBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitResultBatchResponse, error) {
+	rsp, err := c.SubmitResultBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSubmitResultBatchResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *ClientWithResponses) SubmitResultBatchWithResponse(ctx context.Context, body SubmitResultBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitResultBatchResponse, error) {
+	rsp, err := c.SubmitResultBatch(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSubmitResultBatchResponse(rsp)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+// GetRunsWithResponse request returning *GetRunsResponse
+func (c *ClientWithResponses) GetRunsWithResponse(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*GetRunsResponse, error) {
+	rsp, err := c.GetRuns(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return ParseGetRunsResponse(rsp)
 }
 
-// NewGetIperfTestsRequest generates requests for GetIperfTests
-func NewGetIperfTestsRequest(server string, params *GetIperfTestsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// SubmitRunWithBodyWithResponse request with arbitrary body returning *SubmitRunResponse
+func (c *ClientWithResponses) SubmitRunWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitRunResponse, error) {
+	rsp, err := c.SubmitRunWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSubmitRunResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/iperf/results")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *ClientWithResponses) SubmitRunWithResponse(ctx context.Context, body SubmitRunJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitRunResponse, error) {
+	rsp, err := c.SubmitRun(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSubmitRunResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// GetSpeedTestBaselineWithResponse request returning *GetSpeedTestBaselineResponse
+func (c *ClientWithResponses) GetSpeedTestBaselineWithResponse(ctx context.Context, params *GetSpeedTestBaselineParams, reqEditors ...RequestEditorFn) (*GetSpeedTestBaselineResponse, error) {
+	rsp, err := c.GetSpeedTestBaseline(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetSpeedTestBaselineResponse(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.StartTime != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.EndTime != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.HostId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "host_id", runtime.ParamLocationQuery, *params.HostId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.DaemonId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+// GetSpeedTestsWithResponse request returning *GetSpeedTestsResponse
+func (c *ClientWithResponses) GetSpeedTestsWithResponse(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*GetSpeedTestsResponse, error) {
+	rsp, err := c.GetSpeedTests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetSpeedTestsResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// SubmitSpeedTestWithBodyWithResponse request with arbitrary body returning *SubmitSpeedTestResponse
+func (c *ClientWithResponses) SubmitSpeedTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error) {
+	rsp, err := c.SubmitSpeedTestWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseSubmitSpeedTestResponse(rsp)
 }
 
-// NewSubmitIperfTestRequest calls the generic SubmitIperfTest builder with application/json body
-func NewSubmitIperfTestRequest(server string, body SubmitIperfTestJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *ClientWithResponses) SubmitSpeedTestWithResponse(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error) {
+	rsp, err := c.SubmitSpeedTest(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSubmitIperfTestRequestWithBody(server, "application/json", bodyReader)
+	return ParseSubmitSpeedTestResponse(rsp)
 }
 
-// NewSubmitIperfTestRequestWithBody generates requests for SubmitIperfTest with any type of body
-func NewSubmitIperfTestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// DeleteSpeedTestWithResponse request returning *DeleteSpeedTestResponse
+func (c *ClientWithResponses) DeleteSpeedTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteSpeedTestResponse, error) {
+	rsp, err := c.DeleteSpeedTest(ctx, testId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeleteSpeedTestResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/iperf/results")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// GetIperfStatsWithResponse request returning *GetIperfStatsResponse
+func (c *ClientWithResponses) GetIperfStatsWithResponse(ctx context.Context, params *GetIperfStatsParams, reqEditors ...RequestEditorFn) (*GetIperfStatsResponse, error) {
+	rsp, err := c.GetIperfStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetIperfStatsResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// GetSpeedTestStatsWithResponse request returning *GetSpeedTestStatsResponse
+func (c *ClientWithResponses) GetSpeedTestStatsWithResponse(ctx context.Context, params *GetSpeedTestStatsParams, reqEditors ...RequestEditorFn) (*GetSpeedTestStatsResponse, error) {
+	rsp, err := c.GetSpeedTestStats(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetSpeedTestStatsResponse(rsp)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+// ParseGetCampaignsResponse parses an HTTP response from a GetCampaignsWithResponse call
+func ParseGetCampaignsResponse(rsp *http.Response) (*GetCampaignsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &GetCampaignsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Campaign
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewDeleteIperfTestRequest generates requests for DeleteIperfTest
-func NewDeleteIperfTestRequest(server string, testId int) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	var pathParam0 string
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "testId", runtime.ParamLocationPath, testId)
+	return response, nil
+}
+
+// ParseCreateCampaignResponse parses an HTTP response from a CreateCampaignWithResponse call
+func ParseCreateCampaignResponse(rsp *http.Response) (*CreateCampaignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &CreateCampaignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/iperf/results/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Campaign
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseGetCampaignResponse parses an HTTP response from a GetCampaignWithResponse call
+func ParseGetCampaignResponse(rsp *http.Response) (*GetCampaignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &GetCampaignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Campaign
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewGetSpeedTestsRequest generates requests for GetSpeedTests
-func NewGetSpeedTestsRequest(server string, params *GetSpeedTestsParams) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/speedtest/results")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseGetCampaignReportResponse parses an HTTP response from a GetCampaignReportWithResponse call
+func ParseGetCampaignReportResponse(rsp *http.Response) (*GetCampaignReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
+	response := &GetCampaignReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CampaignReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON404 = &dest
 
-		if params.Offset != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	}
 
-		}
+	return response, nil
+}
 
-		if params.StartTime != nil {
+// ParseGetDaemonConfigsResponse parses an HTTP response from a GetDaemonConfigsWithResponse call
+func ParseGetDaemonConfigsResponse(rsp *http.Response) (*GetDaemonConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	response := &GetDaemonConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DaemonConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
 
-		if params.EndTime != nil {
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return response, nil
+}
 
-		}
+// ParseCreateDaemonConfigResponse parses an HTTP response from a CreateDaemonConfigWithResponse call
+func ParseCreateDaemonConfigResponse(rsp *http.Response) (*CreateDaemonConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-		if params.DaemonId != nil {
+	response := &CreateDaemonConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "daemon_id", runtime.ParamLocationQuery, *params.DaemonId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DaemonConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON400 = &dest
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseDeleteDaemonConfigResponse parses an HTTP response from a DeleteDaemonConfigWithResponse call
+func ParseDeleteDaemonConfigResponse(rsp *http.Response) (*DeleteDaemonConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &DeleteDaemonConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// NewSubmitSpeedTestRequest calls the generic SubmitSpeedTest builder with application/json body
-func NewSubmitSpeedTestRequest(server string, body SubmitSpeedTestJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSubmitSpeedTestRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewSubmitSpeedTestRequestWithBody generates requests for SubmitSpeedTest with any type of body
-func NewSubmitSpeedTestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	return response, nil
+}
 
-	serverURL, err := url.Parse(server)
+// ParseGetDaemonConfigResponse parses an HTTP response from a GetDaemonConfigWithResponse call
+func ParseGetDaemonConfigResponse(rsp *http.Response) (*GetDaemonConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/speedtest/results")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &GetDaemonConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DaemonConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseUpdateDaemonConfigResponse parses an HTTP response from a UpdateDaemonConfigWithResponse call
+func ParseUpdateDaemonConfigResponse(rsp *http.Response) (*UpdateDaemonConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &UpdateDaemonConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DaemonConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewDeleteSpeedTestRequest generates requests for DeleteSpeedTest
-func NewDeleteSpeedTestRequest(server string, testId int) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	var pathParam0 string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "testId", runtime.ParamLocationPath, testId)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseGetDaemonsResponse parses an HTTP response from a GetDaemonsWithResponse call
+func ParseGetDaemonsResponse(rsp *http.Response) (*GetDaemonsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/speedtest/results/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &GetDaemonsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Daemon
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseRegisterDaemonResponse parses an HTTP response from a RegisterDaemonWithResponse call
+func ParseRegisterDaemonResponse(rsp *http.Response) (*RegisterDaemonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &RegisterDaemonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Daemon
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON400 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseGetDaemonResponse parses an HTTP response from a GetDaemonWithResponse call
+func ParseGetDaemonResponse(rsp *http.Response) (*GetDaemonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &GetDaemonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetDashboardWithResponse request
-	GetDashboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
 
-	// GetHostsWithResponse request
-	GetHostsWithResponse(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*GetHostsResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Daemon
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// AddHostWithBodyWithResponse request with any body
-	AddHostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHostResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	AddHostWithResponse(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHostResponse, error)
+	}
 
-	// DeleteHostWithResponse request
-	DeleteHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*DeleteHostResponse, error)
+	return response, nil
+}
 
-	// GetHostWithResponse request
-	GetHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*GetHostResponse, error)
+// ParseGetEffectiveDaemonConfigResponse parses an HTTP response from a GetEffectiveDaemonConfigWithResponse call
+func ParseGetEffectiveDaemonConfigResponse(rsp *http.Response) (*GetEffectiveDaemonConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateHostWithBodyWithResponse request with any body
-	UpdateHostWithBodyWithResponse(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error)
+	response := &GetEffectiveDaemonConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	UpdateHostWithResponse(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EffectiveDaemonConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// GetIperfTestsWithResponse request
-	GetIperfTestsWithResponse(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*GetIperfTestsResponse, error)
+	}
 
-	// SubmitIperfTestWithBodyWithResponse request with any body
-	SubmitIperfTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error)
+	return response, nil
+}
 
-	SubmitIperfTestWithResponse(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error)
+// ParseHeartbeatDaemonResponse parses an HTTP response from a HeartbeatDaemonWithResponse call
+func ParseHeartbeatDaemonResponse(rsp *http.Response) (*HeartbeatDaemonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteIperfTestWithResponse request
-	DeleteIperfTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteIperfTestResponse, error)
+	response := &HeartbeatDaemonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// GetSpeedTestsWithResponse request
-	GetSpeedTestsWithResponse(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*GetSpeedTestsResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Daemon
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// SubmitSpeedTestWithBodyWithResponse request with any body
-	SubmitSpeedTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	SubmitSpeedTestWithResponse(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error)
+	}
 
-	// DeleteSpeedTestWithResponse request
-	DeleteSpeedTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteSpeedTestResponse, error)
+	return response, nil
 }
 
-type GetDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DashboardData
-}
+// ParseLeaseJobsResponse parses an HTTP response from a LeaseJobsWithResponse call
+func ParseLeaseJobsResponse(rsp *http.Response) (*LeaseJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &LeaseJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type GetHostsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Host
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHostsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRunOnDaemonResponse parses an HTTP response from a RunOnDaemonWithResponse call
+func ParseRunOnDaemonResponse(rsp *http.Response) (*RunOnDaemonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHostsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RunOnDaemonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddHostResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Host
-	JSON400      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddHostResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddHostResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
-
-type DeleteHostResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteHostResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
+func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteHostResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetHostResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Host
-	JSON404      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DashboardData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetHostResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHostResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateHostResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Host
-	JSON400      *Error
-	JSON404      *Error
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateHostResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseExportIperfTestsResponse parses an HTTP response from a ExportIperfTestsWithResponse call
+func ParseExportIperfTestsResponse(rsp *http.Response) (*ExportIperfTestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateHostResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ExportIperfTestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetIperfTestsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Limit   *int               `json:"limit,omitempty"`
-		Offset  *int               `json:"offset,omitempty"`
-		Results *[]IperfTestResult `json:"results,omitempty"`
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-		// Total Total number of matching results
-		Total *int `json:"total,omitempty"`
 	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetIperfTestsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseExportSpeedTestsResponse parses an HTTP response from a ExportSpeedTestsWithResponse call
+func ParseExportSpeedTestsResponse(rsp *http.Response) (*ExportSpeedTestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetIperfTestsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ExportSpeedTestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SubmitIperfTestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IperfTestResult
-	JSON400      *Error
-	JSON500      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SubmitIperfTestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubmitIperfTestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteIperfTestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteIperfTestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetHostsResponse parses an HTTP response from a GetHostsWithResponse call
+func ParseGetHostsResponse(rsp *http.Response) (*GetHostsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteIperfTestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetHostsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSpeedTestsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Limit   *int               `json:"limit,omitempty"`
-		Offset  *int               `json:"offset,omitempty"`
-		Results *[]SpeedTestResult `json:"results,omitempty"`
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Host
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-		// Total Total number of matching results
-		Total *int `json:"total,omitempty"`
 	}
-	JSON400 *Error
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSpeedTestsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAddHostResponse parses an HTTP response from a AddHostWithResponse call
+func ParseAddHostResponse(rsp *http.Response) (*AddHostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSpeedTestsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AddHostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SubmitSpeedTestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SpeedTestResult
-	JSON400      *Error
-	JSON500      *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Host
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SubmitSpeedTestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubmitSpeedTestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteSpeedTestResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSpeedTestResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteHostResponse parses an HTTP response from a DeleteHostWithResponse call
+func ParseDeleteHostResponse(rsp *http.Response) (*DeleteHostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSpeedTestResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteHostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// GetDashboardWithResponse request returning *GetDashboardResponse
-func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
-	rsp, err := c.GetDashboard(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseGetDashboardResponse(rsp)
+
+	return response, nil
 }
 
-// GetHostsWithResponse request returning *GetHostsResponse
-func (c *ClientWithResponses) GetHostsWithResponse(ctx context.Context, params *GetHostsParams, reqEditors ...RequestEditorFn) (*GetHostsResponse, error) {
-	rsp, err := c.GetHosts(ctx, params, reqEditors...)
+// ParseGetHostResponse parses an HTTP response from a GetHostWithResponse call
+func ParseGetHostResponse(rsp *http.Response) (*GetHostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHostsResponse(rsp)
-}
 
-// AddHostWithBodyWithResponse request with arbitrary body returning *AddHostResponse
-func (c *ClientWithResponses) AddHostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHostResponse, error) {
-	rsp, err := c.AddHostWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetHostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddHostResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddHostWithResponse(ctx context.Context, body AddHostJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHostResponse, error) {
-	rsp, err := c.AddHost(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Host
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseAddHostResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteHostWithResponse request returning *DeleteHostResponse
-func (c *ClientWithResponses) DeleteHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*DeleteHostResponse, error) {
-	rsp, err := c.DeleteHost(ctx, hostId, reqEditors...)
+// ParseUpdateHostResponse parses an HTTP response from a UpdateHostWithResponse call
+func ParseUpdateHostResponse(rsp *http.Response) (*UpdateHostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteHostResponse(rsp)
-}
 
-// GetHostWithResponse request returning *GetHostResponse
-func (c *ClientWithResponses) GetHostWithResponse(ctx context.Context, hostId int, reqEditors ...RequestEditorFn) (*GetHostResponse, error) {
-	rsp, err := c.GetHost(ctx, hostId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateHostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetHostResponse(rsp)
-}
 
-// UpdateHostWithBodyWithResponse request with arbitrary body returning *UpdateHostResponse
-func (c *ClientWithResponses) UpdateHostWithBodyWithResponse(ctx context.Context, hostId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error) {
-	rsp, err := c.UpdateHostWithBody(ctx, hostId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Host
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUpdateHostResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateHostWithResponse(ctx context.Context, hostId int, body UpdateHostJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHostResponse, error) {
-	rsp, err := c.UpdateHost(ctx, hostId, body, reqEditors...)
+// ParseGetIperfBaselineResponse parses an HTTP response from a GetIperfBaselineWithResponse call
+func ParseGetIperfBaselineResponse(rsp *http.Response) (*GetIperfBaselineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateHostResponse(rsp)
-}
 
-// GetIperfTestsWithResponse request returning *GetIperfTestsResponse
-func (c *ClientWithResponses) GetIperfTestsWithResponse(ctx context.Context, params *GetIperfTestsParams, reqEditors ...RequestEditorFn) (*GetIperfTestsResponse, error) {
-	rsp, err := c.GetIperfTests(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetIperfBaselineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetIperfTestsResponse(rsp)
-}
 
-// SubmitIperfTestWithBodyWithResponse request with arbitrary body returning *SubmitIperfTestResponse
-func (c *ClientWithResponses) SubmitIperfTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error) {
-	rsp, err := c.SubmitIperfTestWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Baseline
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseSubmitIperfTestResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SubmitIperfTestWithResponse(ctx context.Context, body SubmitIperfTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitIperfTestResponse, error) {
-	rsp, err := c.SubmitIperfTest(ctx, body, reqEditors...)
+// ParseGetIperfTestsResponse parses an HTTP response from a GetIperfTestsWithResponse call
+func ParseGetIperfTestsResponse(rsp *http.Response) (*GetIperfTestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubmitIperfTestResponse(rsp)
-}
 
-// DeleteIperfTestWithResponse request returning *DeleteIperfTestResponse
-func (c *ClientWithResponses) DeleteIperfTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteIperfTestResponse, error) {
-	rsp, err := c.DeleteIperfTest(ctx, testId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetIperfTestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteIperfTestResponse(rsp)
-}
 
-// GetSpeedTestsWithResponse request returning *GetSpeedTestsResponse
-func (c *ClientWithResponses) GetSpeedTestsWithResponse(ctx context.Context, params *GetSpeedTestsParams, reqEditors ...RequestEditorFn) (*GetSpeedTestsResponse, error) {
-	rsp, err := c.GetSpeedTests(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Limit *int `json:"limit,omitempty"`
+
+			// NextCursor Pass as cursor to get the next page; absent on the last page
+			NextCursor *string            `json:"next_cursor,omitempty"`
+			Offset     *int               `json:"offset,omitempty"`
+			Results    *[]IperfTestResult `json:"results,omitempty"`
+
+			// Total Total number of matching results
+			Total *int `json:"total,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseGetSpeedTestsResponse(rsp)
+
+	return response, nil
 }
 
-// SubmitSpeedTestWithBodyWithResponse request with arbitrary body returning *SubmitSpeedTestResponse
-func (c *ClientWithResponses) SubmitSpeedTestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error) {
-	rsp, err := c.SubmitSpeedTestWithBody(ctx, contentType, body, reqEditors...)
+// ParseSubmitIperfTestResponse parses an HTTP response from a SubmitIperfTestWithResponse call
+func ParseSubmitIperfTestResponse(rsp *http.Response) (*SubmitIperfTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubmitSpeedTestResponse(rsp)
-}
 
-func (c *ClientWithResponses) SubmitSpeedTestWithResponse(ctx context.Context, body SubmitSpeedTestJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitSpeedTestResponse, error) {
-	rsp, err := c.SubmitSpeedTest(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SubmitIperfTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IperfTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IperfTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseSubmitSpeedTestResponse(rsp)
-}
 
-// DeleteSpeedTestWithResponse request returning *DeleteSpeedTestResponse
-func (c *ClientWithResponses) DeleteSpeedTestWithResponse(ctx context.Context, testId int, reqEditors ...RequestEditorFn) (*DeleteSpeedTestResponse, error) {
-	rsp, err := c.DeleteSpeedTest(ctx, testId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteSpeedTestResponse(rsp)
+	return response, nil
 }
 
-// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
-func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+// ParseDeleteIperfTestResponse parses an HTTP response from a DeleteIperfTestWithResponse call
+func ParseDeleteIperfTestResponse(rsp *http.Response) (*DeleteIperfTestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDashboardResponse{
+	response := &DeleteIperfTestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DashboardData
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetHostsResponse parses an HTTP response from a GetHostsWithResponse call
-func ParseGetHostsResponse(rsp *http.Response) (*GetHostsResponse, error) {
+// ParseGetJobsResponse parses an HTTP response from a GetJobsWithResponse call
+func ParseGetJobsResponse(rsp *http.Response) (*GetJobsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHostsResponse{
+	response := &GetJobsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Host
+		var dest []Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1886,22 +8372,22 @@ func ParseGetHostsResponse(rsp *http.Response) (*GetHostsResponse, error) {
 	return response, nil
 }
 
-// ParseAddHostResponse parses an HTTP response from a AddHostWithResponse call
-func ParseAddHostResponse(rsp *http.Response) (*AddHostResponse, error) {
+// ParseCreateJobResponse parses an HTTP response from a CreateJobWithResponse call
+func ParseCreateJobResponse(rsp *http.Response) (*CreateJobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddHostResponse{
+	response := &CreateJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Host
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1919,20 +8405,27 @@ func ParseAddHostResponse(rsp *http.Response) (*AddHostResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteHostResponse parses an HTTP response from a DeleteHostWithResponse call
-func ParseDeleteHostResponse(rsp *http.Response) (*DeleteHostResponse, error) {
+// ParseGetJobResponse parses an HTTP response from a GetJobWithResponse call
+func ParseGetJobResponse(rsp *http.Response) (*GetJobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteHostResponse{
+	response := &GetJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1945,27 +8438,34 @@ func ParseDeleteHostResponse(rsp *http.Response) (*DeleteHostResponse, error) {
 	return response, nil
 }
 
-// ParseGetHostResponse parses an HTTP response from a GetHostWithResponse call
-func ParseGetHostResponse(rsp *http.Response) (*GetHostResponse, error) {
+// ParseCompleteJobResponse parses an HTTP response from a CompleteJobWithResponse call
+func ParseCompleteJobResponse(rsp *http.Response) (*CompleteJobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHostResponse{
+	response := &CompleteJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Host
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1973,27 +8473,34 @@ func ParseGetHostResponse(rsp *http.Response) (*GetHostResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUpdateHostResponse parses an HTTP response from a UpdateHostWithResponse call
-func ParseUpdateHostResponse(rsp *http.Response) (*UpdateHostResponse, error) {
+// ParseGetMeshMatrixResponse parses an HTTP response from a GetMeshMatrixWithResponse call
+func ParseGetMeshMatrixResponse(rsp *http.Response) (*GetMeshMatrixResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateHostResponse{
+	response := &GetMeshMatrixResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Host
+		var dest MeshMatrix
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2006,71 +8513,92 @@ func ParseUpdateHostResponse(rsp *http.Response) (*UpdateHostResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetIperfTestsResponse parses an HTTP response from a GetIperfTestsWithResponse call
-func ParseGetIperfTestsResponse(rsp *http.Response) (*GetIperfTestsResponse, error) {
+// ParseSubmitMeshTestResponse parses an HTTP response from a SubmitMeshTestWithResponse call
+func ParseSubmitMeshTestResponse(rsp *http.Response) (*SubmitMeshTestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetIperfTestsResponse{
+	response := &SubmitMeshTestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Limit   *int               `json:"limit,omitempty"`
-			Offset  *int               `json:"offset,omitempty"`
-			Results *[]IperfTestResult `json:"results,omitempty"`
+		var dest MeshTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-			// Total Total number of matching results
-			Total *int `json:"total,omitempty"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MeshTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSubmitIperfTestResponse parses an HTTP response from a SubmitIperfTestWithResponse call
-func ParseSubmitIperfTestResponse(rsp *http.Response) (*SubmitIperfTestResponse, error) {
+// ParseSubmitResultBatchResponse parses an HTTP response from a SubmitResultBatchWithResponse call
+func ParseSubmitResultBatchResponse(rsp *http.Response) (*SubmitResultBatchResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubmitIperfTestResponse{
+	response := &SubmitResultBatchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest IperfTestResult
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResultBatchResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
@@ -2091,26 +8619,92 @@ func ParseSubmitIperfTestResponse(rsp *http.Response) (*SubmitIperfTestResponse,
 	return response, nil
 }
 
-// ParseDeleteIperfTestResponse parses an HTTP response from a DeleteIperfTestWithResponse call
-func ParseDeleteIperfTestResponse(rsp *http.Response) (*DeleteIperfTestResponse, error) {
+// ParseGetRunsResponse parses an HTTP response from a GetRunsWithResponse call
+func ParseGetRunsResponse(rsp *http.Response) (*GetRunsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteIperfTestResponse{
+	response := &GetRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TestRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitRunResponse parses an HTTP response from a SubmitRunWithResponse call
+func ParseSubmitRunResponse(rsp *http.Response) (*SubmitRunResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TestRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpeedTestBaselineResponse parses an HTTP response from a GetSpeedTestBaselineWithResponse call
+func ParseGetSpeedTestBaselineResponse(rsp *http.Response) (*GetSpeedTestBaselineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpeedTestBaselineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Baseline
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	}
 
@@ -2133,9 +8727,12 @@ func ParseGetSpeedTestsResponse(rsp *http.Response) (*GetSpeedTestsResponse, err
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Limit   *int               `json:"limit,omitempty"`
-			Offset  *int               `json:"offset,omitempty"`
-			Results *[]SpeedTestResult `json:"results,omitempty"`
+			Limit *int `json:"limit,omitempty"`
+
+			// NextCursor Pass as cursor to get the next page; absent on the last page
+			NextCursor *string            `json:"next_cursor,omitempty"`
+			Offset     *int               `json:"offset,omitempty"`
+			Results    *[]SpeedTestResult `json:"results,omitempty"`
 
 			// Total Total number of matching results
 			Total *int `json:"total,omitempty"`
@@ -2171,6 +8768,13 @@ func ParseSubmitSpeedTestResponse(rsp *http.Response) (*SubmitSpeedTestResponse,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SpeedTestResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest SpeedTestResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2185,6 +8789,13 @@ func ParseSubmitSpeedTestResponse(rsp *http.Response) (*SubmitSpeedTestResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2222,3 +8833,83 @@ func ParseDeleteSpeedTestResponse(rsp *http.Response) (*DeleteSpeedTestResponse,
 
 	return response, nil
 }
+
+// ParseGetIperfStatsResponse parses an HTTP response from a GetIperfStatsWithResponse call
+func ParseGetIperfStatsResponse(rsp *http.Response) (*GetIperfStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIperfStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSpeedTestStatsResponse parses an HTTP response from a GetSpeedTestStatsWithResponse call
+func ParseGetSpeedTestStatsResponse(rsp *http.Response) (*GetSpeedTestStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSpeedTestStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
@@ -10,14 +10,38 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Testing  TestingConfig  `mapstructure:"testing"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Testing   TestingConfig   `mapstructure:"testing"`
+	Scheduler SchedulerConfig `mapstructure:"scheduler"`
+	Daemon    DaemonConfig    `mapstructure:"daemon"`
+	Registry  RegistryConfig  `mapstructure:"registry"`
+	Auth      AuthConfig      `mapstructure:"auth"`
+	Clock     ClockConfig     `mapstructure:"clock"`
+	Mesh      MeshConfig      `mapstructure:"mesh"`
 }
 
 type ServerConfig struct {
-	Port string `mapstructure:"port"`
-	Host string `mapstructure:"host"`
+	Port string          `mapstructure:"port"`
+	Host string          `mapstructure:"host"`
+	TLS  ServerTLSConfig `mapstructure:"tls"`
+}
+
+// ServerTLSConfig enables HTTPS on the API server and, with a client CA,
+// verification of daemon client certificates
+type ServerTLSConfig struct {
+	CertFile          string `mapstructure:"cert_file"`
+	KeyFile           string `mapstructure:"key_file"`
+	ClientCAFile      string `mapstructure:"client_ca_file"`
+	RequireClientCert bool   `mapstructure:"require_client_cert"`
+}
+
+// ClientTLSConfig configures how a daemon verifies the API server and which
+// client certificate it presents
+type ClientTLSConfig struct {
+	CAFile   string `mapstructure:"ca_file"`
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
 }
 
 type DatabaseConfig struct {
@@ -37,9 +61,85 @@ func (d DatabaseConfig) DSN() string {
 }
 
 type TestingConfig struct {
-	SpeedTestInterval time.Duration `mapstructure:"speedtest_interval"`
-	IperfTestInterval time.Duration `mapstructure:"iperf_interval"`
-	IperfTestDuration int           `mapstructure:"iperf_duration"`
+	SpeedTestInterval time.Duration    `mapstructure:"speedtest_interval"`
+	IperfTestInterval time.Duration    `mapstructure:"iperf_interval"`
+	IperfTestDuration int              `mapstructure:"iperf_duration"`
+	Dependencies      HostDependencies `mapstructure:"dependencies"`
+	Adaptive          AdaptiveConfig   `mapstructure:"adaptive"`
+}
+
+// AdaptiveConfig controls temporary high-frequency testing after a result
+// falls below the recent baseline
+type AdaptiveConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	Interval   time.Duration `mapstructure:"interval"`
+	Duration   time.Duration `mapstructure:"duration"`
+	Threshold  float64       `mapstructure:"threshold"`
+	Samples    int           `mapstructure:"samples"`
+	MinSamples int           `mapstructure:"min_samples"`
+}
+
+// SchedulerConfig controls the server-side job queue in the API server
+type SchedulerConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	LeaseDuration time.Duration `mapstructure:"lease_duration"`
+}
+
+// DaemonConfig controls how an API-mode daemon obtains its work and how it
+// registers itself with the API server
+type DaemonConfig struct {
+	UseJobQueue         bool              `mapstructure:"use_job_queue"`
+	PollInterval        time.Duration     `mapstructure:"poll_interval"`
+	MaxJobs             int               `mapstructure:"max_jobs"`
+	HeartbeatInterval   time.Duration     `mapstructure:"heartbeat_interval"`
+	Labels              map[string]string `mapstructure:"labels"`
+	Name                string            `mapstructure:"name"`
+	StateFile           string            `mapstructure:"state_file"`
+	SpoolDir            string            `mapstructure:"spool_dir"`
+	SpoolMaxEntries     int               `mapstructure:"spool_max_entries"`
+	APIKey              string            `mapstructure:"api_key"`
+	TLS                 ClientTLSConfig   `mapstructure:"tls"`
+	StatusAddr          string            `mapstructure:"status_addr"`
+	ShutdownGracePeriod time.Duration     `mapstructure:"shutdown_grace_period"`
+	ClockCorrection     bool              `mapstructure:"clock_correction"`
+	MeshAddress         string            `mapstructure:"mesh_address"`
+}
+
+// RegistryConfig controls when the API server considers a daemon stale or
+// dead after its last heartbeat
+type RegistryConfig struct {
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+	DeadAfter  time.Duration `mapstructure:"dead_after"`
+}
+
+// ClockConfig controls how the API server treats results from daemons whose
+// clocks are off by more than MaxSkew, or whose timestamps are in the future
+// or older than MaxAge: "quarantine" stores them flagged and out of listings
+// and baselines, "reject" refuses them
+type ClockConfig struct {
+	MaxSkew    time.Duration `mapstructure:"max_skew"`
+	MaxAge     time.Duration `mapstructure:"max_age"`
+	SkewAction string        `mapstructure:"skew_action"`
+}
+
+// MeshConfig controls daemon-to-daemon iperf testing scheduled by the API
+// server. Every ordered pair of participating daemons is tested once per
+// Interval, with at most MaxConcurrent tests running at a time and no daemon
+// in more than one test. Selector and Daemons narrow the participants the
+// same way a host's daemon scope does.
+type MeshConfig struct {
+	Enabled       bool              `mapstructure:"enabled"`
+	Interval      time.Duration     `mapstructure:"interval"`
+	Duration      int               `mapstructure:"duration"`
+	MaxConcurrent int               `mapstructure:"max_concurrent"`
+	Selector      map[string]string `mapstructure:"selector"`
+	Daemons       []string          `mapstructure:"daemons"`
+}
+
+// AuthConfig controls enforcement of the X-API-Key security scheme
+type AuthConfig struct {
+	Enabled       bool `mapstructure:"enabled"`
+	AnonymousRead bool `mapstructure:"anonymous_read"`
 }
 
 func Load() (*Config, error) {
@@ -70,6 +170,49 @@ func Load() (*Config, error) {
 	v.SetDefault("testing.speedtest_interval", "15m")
 	v.SetDefault("testing.iperf_interval", "10m")
 	v.SetDefault("testing.iperf_duration", 10)
+	v.SetDefault("testing.dependencies", map[string][]string{
+		"vpn":    {"lan"},
+		"remote": {"vpn"},
+	})
+	v.SetDefault("testing.adaptive.enabled", false)
+	v.SetDefault("testing.adaptive.interval", "1m")
+	v.SetDefault("testing.adaptive.duration", "30m")
+	v.SetDefault("testing.adaptive.threshold", 0.7)
+	v.SetDefault("testing.adaptive.samples", 20)
+	v.SetDefault("testing.adaptive.min_samples", 5)
+	v.SetDefault("scheduler.enabled", false)
+	v.SetDefault("scheduler.lease_duration", "5m")
+	v.SetDefault("daemon.use_job_queue", false)
+	v.SetDefault("daemon.poll_interval", "30s")
+	v.SetDefault("daemon.max_jobs", 1)
+	v.SetDefault("daemon.heartbeat_interval", "1m")
+	v.SetDefault("daemon.name", "")
+	v.SetDefault("daemon.state_file", "./daemon-state.json")
+	v.SetDefault("daemon.spool_dir", "./spool")
+	v.SetDefault("daemon.spool_max_entries", 10000)
+	v.SetDefault("daemon.status_addr", "")
+	v.SetDefault("daemon.shutdown_grace_period", "2m")
+	v.SetDefault("daemon.clock_correction", true)
+	v.SetDefault("daemon.mesh_address", "")
+	v.SetDefault("registry.stale_after", "3m")
+	v.SetDefault("registry.dead_after", "15m")
+	v.SetDefault("auth.enabled", true)
+	v.SetDefault("auth.anonymous_read", true)
+	v.SetDefault("clock.max_skew", "10m")
+	v.SetDefault("clock.max_age", "720h")
+	v.SetDefault("clock.skew_action", "quarantine")
+	v.SetDefault("mesh.enabled", false)
+	v.SetDefault("mesh.interval", "1h")
+	v.SetDefault("mesh.duration", 10)
+	v.SetDefault("mesh.max_concurrent", 1)
+	v.SetDefault("daemon.api_key", "")
+	v.SetDefault("daemon.tls.ca_file", "")
+	v.SetDefault("daemon.tls.cert_file", "")
+	v.SetDefault("daemon.tls.key_file", "")
+	v.SetDefault("server.tls.cert_file", "")
+	v.SetDefault("server.tls.key_file", "")
+	v.SetDefault("server.tls.client_ca_file", "")
+	v.SetDefault("server.tls.require_client_cert", false)
 
 	// Try to read config file (optional)
 	v.SetConfigName("config")
@@ -94,10 +237,39 @@ func Load() (*Config, error) {
 	if err := v.Unmarshal(cfg); err != nil {
 		return nil, fmt.Errorf("unable to decode config into struct: %w", err)
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
 
 	return cfg, nil
 }
 
+// validate rejects settings that would otherwise fail at runtime, such as
+// intervals that tickers cannot run on
+func (c *Config) validate() error {
+	intervals := []struct {
+		key   string
+		value time.Duration
+	}{
+		{"testing.speedtest_interval", c.Testing.SpeedTestInterval},
+		{"testing.iperf_interval", c.Testing.IperfTestInterval},
+		{"testing.adaptive.interval", c.Testing.Adaptive.Interval},
+		{"testing.adaptive.duration", c.Testing.Adaptive.Duration},
+		{"scheduler.lease_duration", c.Scheduler.LeaseDuration},
+		{"daemon.poll_interval", c.Daemon.PollInterval},
+		{"daemon.heartbeat_interval", c.Daemon.HeartbeatInterval},
+		{"registry.stale_after", c.Registry.StaleAfter},
+		{"registry.dead_after", c.Registry.DeadAfter},
+		{"mesh.interval", c.Mesh.Interval},
+	}
+	for _, interval := range intervals {
+		if interval.value <= 0 {
+			return fmt.Errorf("invalid config: %s must be a positive duration, got %s", interval.key, interval.value)
+		}
+	}
+	return nil
+}
+
 // Legacy function for backward compatibility
 func Default() *Config {
 	cfg, err := Load()
@@ -0,0 +1,110 @@
+package config
+
+import "fmt"
+
+// HostDependencies maps a host type to the host types that must pass before
+// it is tested, e.g. remote depends on vpn and vpn depends on lan. When a
+// prerequisite fails, dependents are blocked instead of reporting failures of
+// their own.
+type HostDependencies map[string][]string
+
+// BlockedMessage is the error recorded for a test skipped because the
+// upstream host type failed. Daemons and the server share it so blocked
+// results read the same wherever they were produced.
+func BlockedMessage(upstream string) string {
+	return fmt.Sprintf("blocked by upstream %s failure", upstream)
+}
+
+// Order sorts host types so that every type comes after its prerequisites.
+// Types keep their given order where dependencies allow it. A dependency cycle
+// is broken at its first type in the given order, and types depending on the
+// cycle still come after it.
+func (d HostDependencies) Order(hostTypes []string) []string {
+	ordered := make([]string, 0, len(hostTypes))
+	placed := make(map[string]bool, len(hostTypes))
+	known := make(map[string]bool, len(hostTypes))
+	for _, hostType := range hostTypes {
+		known[hostType] = true
+	}
+
+	for len(ordered) < len(hostTypes) {
+		progressed := false
+		for _, hostType := range hostTypes {
+			if placed[hostType] || !d.satisfied(hostType, placed, known) {
+				continue
+			}
+			ordered = append(ordered, hostType)
+			placed[hostType] = true
+			progressed = true
+		}
+
+		if !progressed {
+			// Break the cycle at its first type and let its dependents
+			// follow through the normal loop
+			hostType := d.cycleStart(hostTypes, placed, known)
+			ordered = append(ordered, hostType)
+			placed[hostType] = true
+		}
+	}
+
+	return ordered
+}
+
+// BlockedBy returns the first prerequisite of hostType that failed or was
+// itself blocked, according to the given outcomes keyed by host type
+func (d HostDependencies) BlockedBy(hostType string, failed map[string]bool) (string, bool) {
+	for _, upstream := range d[hostType] {
+		if failed[upstream] {
+			return upstream, true
+		}
+	}
+	return "", false
+}
+
+func (d HostDependencies) satisfied(hostType string, placed, known map[string]bool) bool {
+	for _, upstream := range d[hostType] {
+		if known[upstream] && !placed[upstream] {
+			return false
+		}
+	}
+	return true
+}
+
+// cycleStart returns the first unplaced host type that lies on a dependency
+// cycle among the unplaced types
+func (d HostDependencies) cycleStart(hostTypes []string, placed, known map[string]bool) string {
+	for _, hostType := range hostTypes {
+		if !placed[hostType] && d.reaches(hostType, hostType, placed, known, map[string]bool{}) {
+			return hostType
+		}
+	}
+	// Unreachable when no type could be placed, as every unplaced type then
+	// waits on another one
+	for _, hostType := range hostTypes {
+		if !placed[hostType] {
+			return hostType
+		}
+	}
+	return ""
+}
+
+// reaches reports whether target is a direct or indirect unplaced
+// prerequisite of hostType
+func (d HostDependencies) reaches(hostType, target string, placed, known, visited map[string]bool) bool {
+	for _, upstream := range d[hostType] {
+		if !known[upstream] || placed[upstream] {
+			continue
+		}
+		if upstream == target {
+			return true
+		}
+		if visited[upstream] {
+			continue
+		}
+		visited[upstream] = true
+		if d.reaches(upstream, target, placed, known, visited) {
+			return true
+		}
+	}
+	return false
+}
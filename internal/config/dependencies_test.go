@@ -0,0 +1,94 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHostDependenciesOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		dependencies HostDependencies
+		hostTypes    []string
+		want         []string
+	}{
+		{
+			name:      "no dependencies keep their order",
+			hostTypes: []string{"remote", "lan", "vpn"},
+			want:      []string{"remote", "lan", "vpn"},
+		},
+		{
+			name:         "default chain",
+			dependencies: HostDependencies{"vpn": {"lan"}, "remote": {"vpn"}},
+			hostTypes:    []string{"remote", "vpn", "lan"},
+			want:         []string{"lan", "vpn", "remote"},
+		},
+		{
+			name:         "independent type keeps its place",
+			dependencies: HostDependencies{"vpn": {"lan"}, "remote": {}},
+			hostTypes:    []string{"vpn", "remote", "lan"},
+			want:         []string{"remote", "lan", "vpn"},
+		},
+		{
+			name:         "unknown prerequisites are ignored",
+			dependencies: HostDependencies{"vpn": {"wan"}},
+			hostTypes:    []string{"vpn", "lan"},
+			want:         []string{"vpn", "lan"},
+		},
+		{
+			name:         "cycle is appended in given order",
+			dependencies: HostDependencies{"lan": {"vpn"}, "vpn": {"lan"}},
+			hostTypes:    []string{"vpn", "lan", "remote"},
+			want:         []string{"remote", "vpn", "lan"},
+		},
+		{
+			name:         "self dependency",
+			dependencies: HostDependencies{"lan": {"lan"}},
+			hostTypes:    []string{"lan", "vpn"},
+			want:         []string{"vpn", "lan"},
+		},
+		{
+			name:         "dependents of a cycle follow it",
+			dependencies: HostDependencies{"lan": {"vpn"}, "vpn": {"lan"}, "remote": {"vpn"}},
+			hostTypes:    []string{"remote", "lan", "vpn"},
+			want:         []string{"lan", "vpn", "remote"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.dependencies.Order(tt.hostTypes)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Order(%v) = %v, want %v", tt.hostTypes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostDependenciesBlockedBy(t *testing.T) {
+	dependencies := HostDependencies{"vpn": {"lan"}, "remote": {"lan", "vpn"}}
+
+	tests := []struct {
+		name     string
+		hostType string
+		failed   map[string]bool
+		upstream string
+		blocked  bool
+	}{
+		{"no failures", "remote", map[string]bool{}, "", false},
+		{"prerequisite failed", "vpn", map[string]bool{"lan": true}, "lan", true},
+		{"first failed prerequisite wins", "remote", map[string]bool{"lan": true, "vpn": true}, "lan", true},
+		{"blocked prerequisite blocks dependents", "remote", map[string]bool{"vpn": true}, "vpn", true},
+		{"unrelated failure", "vpn", map[string]bool{"remote": true}, "", false},
+		{"type without dependencies", "lan", map[string]bool{"vpn": true}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upstream, blocked := dependencies.BlockedBy(tt.hostType, tt.failed)
+			if upstream != tt.upstream || blocked != tt.blocked {
+				t.Errorf("BlockedBy(%q) = %q, %v, want %q, %v", tt.hostType, upstream, blocked, tt.upstream, tt.blocked)
+			}
+		})
+	}
+}
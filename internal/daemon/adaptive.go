@@ -0,0 +1,204 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+const speedTestTarget = "speedtest"
+
+// adaptiveWindow is a period of raised test frequency for a single target
+type adaptiveWindow struct {
+	until   time.Time
+	host    *client.Host // nil for speed tests
+	running bool
+}
+
+// adaptiveTracker records which targets are currently degraded and should be
+// tested at the adaptive interval instead of the regular schedule
+type adaptiveTracker struct {
+	mu      sync.Mutex
+	windows map[string]*adaptiveWindow
+}
+
+func newAdaptiveTracker() *adaptiveTracker {
+	return &adaptiveTracker{windows: make(map[string]*adaptiveWindow)}
+}
+
+// adaptiveTicker ticks at the adaptive interval while adaptive testing is
+// enabled. Its channel is nil while it is stopped, so a select on it blocks.
+type adaptiveTicker struct {
+	interval time.Duration
+	ticker   *time.Ticker
+}
+
+// C returns the tick channel, or nil while the ticker is stopped
+func (t *adaptiveTicker) C() <-chan time.Time {
+	if t.ticker == nil {
+		return nil
+	}
+	return t.ticker.C
+}
+
+// setEnabled starts or stops the ticker
+func (t *adaptiveTicker) setEnabled(enabled bool) {
+	switch {
+	case enabled && t.ticker == nil:
+		t.ticker = time.NewTicker(t.interval)
+	case !enabled && t.ticker != nil:
+		t.ticker.Stop()
+		t.ticker = nil
+	}
+}
+
+func iperfTarget(hostID int) string {
+	return fmt.Sprintf("iperf:%d", hostID)
+}
+
+// extend opens a window for the target, or pushes back the end of an
+// existing one, so testing stays dense for as long as results stay degraded
+func (t *adaptiveTracker) extend(target string, host *client.Host, until time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if window, ok := t.windows[target]; ok {
+		window.until = until
+		return false
+	}
+
+	t.windows[target] = &adaptiveWindow{until: until, host: host}
+	return true
+}
+
+// due returns the targets that should be tested now and drops windows that
+// have expired, which returns those targets to the regular schedule
+func (t *adaptiveTracker) due(now time.Time) map[string]*client.Host {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	targets := make(map[string]*client.Host)
+	for target, window := range t.windows {
+		if now.After(window.until) {
+			if !window.running {
+				log.Printf("📉 Adaptive testing ended for %s, returning to normal schedule", target)
+				delete(t.windows, target)
+			}
+			continue
+		}
+		if window.running {
+			continue
+		}
+
+		window.running = true
+		targets[target] = window.host
+	}
+
+	return targets
+}
+
+func (t *adaptiveTracker) finish(target string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if window, ok := t.windows[target]; ok {
+		window.running = false
+	}
+}
+
+// runAdaptiveTests runs one test for every target inside an adaptive window
+func (d *APIClient) runAdaptiveTests() {
+	for target, host := range d.adaptive.due(time.Now()) {
+		started := d.goRun(func(ctx context.Context) {
+			defer d.adaptive.finish(target)
+
+			if host == nil {
+				log.Println("Running adaptive speed test...")
+				if _, err := d.runSpeedTest(ctx, client.Adaptive, nil); err != nil && !errors.Is(err, errSpooled) {
+					log.Printf("Adaptive speed test failed: %v", err)
+				}
+				return
+			}
+
+			log.Printf("Running adaptive iperf test against %s...", host.Name)
+			if _, err := d.runIperfTest(ctx, *host, d.settings().iperfDuration, client.Adaptive, nil); err != nil && !errors.Is(err, errSpooled) {
+				log.Printf("Adaptive iperf test failed: %v", err)
+			}
+		})
+		if !started {
+			d.adaptive.finish(target)
+		}
+	}
+}
+
+// checkSpeedTestDegradation compares a speed test result against this
+// daemon's baseline and opens an adaptive window when it falls below it
+func (d *APIClient) checkSpeedTestDegradation(ctx context.Context, downloadMbps, uploadMbps float64) {
+	if !d.settings().adaptiveEnabled {
+		return
+	}
+
+	samples := d.config.Testing.Adaptive.Samples
+	resp, err := d.client.GetSpeedTestBaselineWithResponse(ctx, &client.GetSpeedTestBaselineParams{
+		DaemonId: &d.daemonID,
+		Samples:  &samples,
+	})
+	if err != nil || resp.JSON200 == nil {
+		log.Printf("Failed to get speed test baseline: %v", err)
+		return
+	}
+
+	if d.belowBaseline(*resp.JSON200, downloadMbps, uploadMbps) {
+		d.startAdaptiveWindow(speedTestTarget, nil, *resp.JSON200)
+	}
+}
+
+// checkIperfDegradation compares an iperf result against the host's baseline
+// and opens an adaptive window when it falls below it. A failed test always
+// counts as degraded since the host may be unreachable.
+func (d *APIClient) checkIperfDegradation(ctx context.Context, host client.Host, sentMbps, receivedMbps float64, failed bool) {
+	if !d.settings().adaptiveEnabled {
+		return
+	}
+
+	samples := d.config.Testing.Adaptive.Samples
+	resp, err := d.client.GetIperfBaselineWithResponse(ctx, &client.GetIperfBaselineParams{
+		HostId:   host.Id,
+		DaemonId: &d.daemonID,
+		Samples:  &samples,
+	})
+	if err != nil || resp.JSON200 == nil {
+		log.Printf("Failed to get iperf baseline for %s: %v", host.Name, err)
+		return
+	}
+
+	if failed || d.belowBaseline(*resp.JSON200, receivedMbps, sentMbps) {
+		d.startAdaptiveWindow(iperfTarget(host.Id), &host, *resp.JSON200)
+	}
+}
+
+// belowBaseline reports whether either direction dropped under the configured
+// fraction of the baseline. Baselines with too few samples are ignored.
+func (d *APIClient) belowBaseline(baseline client.Baseline, downloadMbps, uploadMbps float64) bool {
+	adaptive := d.config.Testing.Adaptive
+	if baseline.Samples < adaptive.MinSamples {
+		return false
+	}
+
+	return downloadMbps < baseline.DownloadMbps*adaptive.Threshold ||
+		uploadMbps < baseline.UploadMbps*adaptive.Threshold
+}
+
+func (d *APIClient) startAdaptiveWindow(target string, host *client.Host, baseline client.Baseline) {
+	until := time.Now().Add(d.config.Testing.Adaptive.Duration)
+	if d.adaptive.extend(target, host, until) {
+		log.Printf("📈 Degradation detected for %s (baseline %.2f/%.2f Mbps), testing every %s until %s",
+			target, baseline.DownloadMbps, baseline.UploadMbps,
+			d.config.Testing.Adaptive.Interval, until.Format(time.Kitchen))
+	}
+}
@@ -3,8 +3,11 @@ package daemon
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand"
+	"net/http"
 	"os"
 	"os/exec"
 	"strconv"
@@ -12,31 +15,99 @@ import (
 
 	"github.com/bfirestone/speed-checker/internal/client"
 	"github.com/bfirestone/speed-checker/internal/config"
+	"github.com/bfirestone/speed-checker/internal/pki"
 )
 
 // APIClient handles communication with the Speed Checker API
 type APIClient struct {
 	client   *client.ClientWithResponses
 	daemonID string
+	version  string
 	config   *config.Config
+	adaptive *adaptiveTracker
+	spool    *spool
+	remote   *remoteConfig
+	status   *statusTracker
+	drain    *drainer
+	clock    *clockOffset
 }
 
 // NewAPIClient creates a new API-based daemon client
-func NewAPIClient(apiBaseURL string, cfg *config.Config) *APIClient {
-	// Create the API client
-	apiClient, err := client.NewClientWithResponses(apiBaseURL)
+func NewAPIClient(apiBaseURL string, cfg *config.Config, version string) *APIClient {
+	// Authenticate with the configured client certificate
+	tlsConfig, err := pki.ClientTLSConfig(cfg.Daemon.TLS)
+	if err != nil {
+		log.Fatalf("Failed to configure TLS: %v", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return NewAPIClientWithTransport(apiBaseURL, cfg, version, transport)
+}
+
+// NewAPIClientWithTransport creates a daemon client that sends its API
+// requests through transport, such as one serving them in-process
+func NewAPIClientWithTransport(apiBaseURL string, cfg *config.Config, version string, transport http.RoundTripper) *APIClient {
+	// Create the API client, authenticating with the configured key. Every
+	// request is tracked so the status endpoint can report whether the API
+	// server is reachable, and measures the clock offset from the server.
+	status := newStatusTracker()
+	clock := newClockOffset(cfg.Daemon.ClockCorrection)
+	opts := []client.ClientOption{
+		client.WithHTTPClient(&http.Client{Transport: &clockTransport{
+			next:  &reachabilityTransport{next: transport, status: status},
+			clock: clock,
+		}}),
+	}
+	if cfg.Daemon.APIKey != "" {
+		opts = append(opts, client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-API-Key", cfg.Daemon.APIKey)
+			return nil
+		}))
+	}
+	apiClient, err := client.NewClientWithResponses(apiBaseURL, opts...)
 	if err != nil {
 		log.Fatalf("Failed to create API client: %v", err)
 	}
 
-	// Generate a unique daemon ID
-	hostname, _ := os.Hostname()
-	daemonID := fmt.Sprintf("daemon-%s-%d", hostname, os.Getpid())
+	// A client certificate fixes the daemon ID to its common name, which the
+	// server verifies. Otherwise use the identity persisted in the state file
+	// so restarts keep the same daemon ID, falling back to a per-process ID
+	// when it is unavailable.
+	var daemonID string
+	if cfg.Daemon.TLS.CertFile != "" {
+		if daemonID, err = pki.CommonName(cfg.Daemon.TLS.CertFile); err != nil {
+			log.Fatalf("Failed to read daemon ID from client certificate: %v", err)
+		}
+	} else if daemonID, err = loadOrCreateIdentity(cfg.Daemon.StateFile); err != nil {
+		hostname, _ := os.Hostname()
+		daemonID = fmt.Sprintf("daemon-%s-%d", hostname, os.Getpid())
+		log.Printf("⚠️  Failed to load daemon identity, using %s for this run: %v", daemonID, err)
+	}
+
+	// Results are written to the spool before submission so they survive
+	// API outages and restarts
+	var resultSpool *spool
+	if cfg.Daemon.SpoolDir != "" {
+		resultSpool, err = newSpool(cfg.Daemon.SpoolDir, cfg.Daemon.SpoolMaxEntries)
+		if err != nil {
+			log.Printf("⚠️  Result spool disabled, results will be lost while the API is unreachable: %v", err)
+		}
+	}
 
 	return &APIClient{
 		client:   apiClient,
 		daemonID: daemonID,
+		version:  version,
 		config:   cfg,
+		adaptive: newAdaptiveTracker(),
+		spool:    resultSpool,
+		remote:   newRemoteConfig(),
+		status:   status,
+		drain:    newDrainer(),
+		clock:    clock,
 	}
 }
 
@@ -45,70 +116,137 @@ func (d *APIClient) StartBackgroundTesting(ctx context.Context) error {
 	log.Printf("Starting API-based daemon with ID: %s", d.daemonID)
 	log.Printf("API endpoint: %s", d.client.ClientInterface.(*client.Client).Server)
 
+	// Register with the API server first so the remote configuration is
+	// applied before the first tests, then keep sending heartbeats
+	if err := d.register(ctx); err != nil {
+		log.Printf("Failed to register daemon: %v", err)
+	}
+	go d.runHeartbeat(ctx)
+
+	d.status.setRunning(modeScheduled, true)
+	defer d.status.setRunning(modeScheduled, false)
+
+	settings := d.settings()
+
 	// Speed test ticker
-	speedTestTicker := time.NewTicker(d.config.Testing.SpeedTestInterval)
+	speedTestTicker := time.NewTicker(settings.speedTestInterval)
 	defer speedTestTicker.Stop()
 
 	// Iperf test ticker
-	iperfTestTicker := time.NewTicker(d.config.Testing.IperfTestInterval)
+	iperfTestTicker := time.NewTicker(settings.iperfInterval)
 	defer iperfTestTicker.Stop()
 
-	// Run initial tests
-	go func() {
-		log.Println("Running initial speed test...")
-		if err := d.runSpeedTest(ctx); err != nil {
-			log.Printf("Initial speed test failed: %v", err)
-		}
-	}()
+	// Adaptive ticker, only fires tests for targets that are degraded. It
+	// runs while adaptive testing is enabled, locally or remotely.
+	adaptive := &adaptiveTicker{interval: d.config.Testing.Adaptive.Interval}
+	adaptive.setEnabled(settings.adaptiveEnabled)
+	defer adaptive.setEnabled(false)
 
-	go func() {
-		log.Println("Running initial iperf tests...")
-		if err := d.runIperfTests(ctx); err != nil {
-			log.Printf("Initial iperf tests failed: %v", err)
-		}
-	}()
+	d.scheduleNext(settings)
+
+	// Deliver results spooled while the API was unreachable
+	go d.replaySpool(ctx)
+
+	// Pick up on-demand runs targeted at this daemon
+	go d.watchOnDemandRuns(ctx)
+
+	// Run initial tests. Tests run with the run context so they can finish
+	// while the daemon drains.
+	if settings.speedTestEnabled {
+		d.goRun(func(ctx context.Context) {
+			log.Println("Running initial speed test...")
+			if _, err := d.runSpeedTest(ctx, client.Scheduled, nil); err != nil && !errors.Is(err, errSpooled) {
+				log.Printf("Initial speed test failed: %v", err)
+			}
+		})
+	}
+
+	if settings.iperfEnabled {
+		d.goRun(func(ctx context.Context) {
+			log.Println("Running initial iperf tests...")
+			if err := d.runIperfTests(ctx); err != nil {
+				log.Printf("Initial iperf tests failed: %v", err)
+			}
+		})
+	}
 
 	// Handle scheduled tests
 	for {
 		select {
 		case <-ctx.Done():
+			d.shutdown()
 			log.Println("API daemon stopped")
 			return nil
 
+		case <-d.remote.changed:
+			// Apply new intervals without restarting
+			settings := d.settings()
+			speedTestTicker.Reset(settings.speedTestInterval)
+			iperfTestTicker.Reset(settings.iperfInterval)
+			adaptive.setEnabled(settings.adaptiveEnabled)
+			d.scheduleNext(settings)
+
 		case <-speedTestTicker.C:
-			go func() {
+			settings := d.settings()
+			d.scheduleNextSpeedTest(settings)
+			if !settings.speedTestEnabled {
+				continue
+			}
+			d.goRun(func(ctx context.Context) {
 				log.Println("Running scheduled speed test...")
-				if err := d.runSpeedTest(ctx); err != nil {
+				if _, err := d.runSpeedTest(ctx, client.Scheduled, nil); err != nil && !errors.Is(err, errSpooled) {
 					log.Printf("Scheduled speed test failed: %v", err)
 				}
-			}()
+			})
 
 		case <-iperfTestTicker.C:
-			go func() {
+			settings := d.settings()
+			d.scheduleNextIperf(settings)
+			if !settings.iperfEnabled {
+				continue
+			}
+			d.goRun(func(ctx context.Context) {
 				log.Println("Running scheduled iperf tests...")
 				if err := d.runIperfTests(ctx); err != nil {
 					log.Printf("Scheduled iperf tests failed: %v", err)
 				}
-			}()
+			})
+
+		case <-adaptive.C():
+			d.runAdaptiveTests()
 		}
 	}
 }
 
-// runSpeedTest executes a speed test and submits results via API
-func (d *APIClient) runSpeedTest(ctx context.Context) error {
+// runSpeedTest executes a speed test, submits results via API and returns
+// the ID of the stored result. The attempt is recorded in the run history.
+// Results of campaign jobs carry the campaign ID. A result that could not be
+// delivered is spooled and an error wrapping errSpooled is returned.
+func (d *APIClient) runSpeedTest(ctx context.Context, trigger client.TestTrigger, campaignID *int) (resultID int, err error) {
+	started := time.Now()
+	defer func() {
+		d.recordRun(ctx, client.Speedtest, trigger, nil, started, resultID, err)
+	}()
+
 	log.Println("🚀 Starting Ookla speed test...")
 
 	// Run the speedtest CLI
-	cmd := exec.CommandContext(ctx, "speedtest", "--format=json", "--accept-license", "--accept-gdpr")
+	cmdCtx, cancel := context.WithTimeout(ctx, speedTestTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(cmdCtx, "speedtest", "--format=json", "--accept-license", "--accept-gdpr")
 	output, err := cmd.Output()
+	if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+		return 0, fmt.Errorf("speedtest timed out after %s: %w", speedTestTimeout, context.DeadlineExceeded)
+	}
 	if err != nil {
-		return fmt.Errorf("speedtest command failed: %w", err)
+		return 0, fmt.Errorf("speedtest command failed: %w", err)
 	}
 
 	// Parse the speedtest output (we'll implement proper JSON parsing)
 	result, err := d.parseSpeedTestJSON(output)
 	if err != nil {
-		return fmt.Errorf("failed to parse speedtest output: %w", err)
+		return 0, fmt.Errorf("failed to parse speedtest output: %w", err)
 	}
 
 	// Submit results via API
@@ -124,25 +262,40 @@ func (d *APIClient) runSpeedTest(ctx context.Context) error {
 		Isp:          result.ISP,
 		ExternalIp:   result.ExternalIP,
 		ResultUrl:    result.ResultURL,
+		Trigger:      &trigger,
+		SubmissionId: newSubmissionID(),
+		CampaignId:   campaignID,
 	}
 
-	// Submit to API (this will return 501 Not Implemented for now)
-	resp, err := d.client.SubmitSpeedTestWithResponse(ctx, submission)
+	id, err := d.submit(ctx, spoolSpeedTest, submission)
+	if errors.Is(err, errSpooled) {
+		log.Printf("📥 Speed test spooled for replay - Download: %.2f Mbps, Upload: %.2f Mbps: %v",
+			result.DownloadMbps, result.UploadMbps, err)
+		// The API may still serve baselines when only the submission failed
+		d.checkSpeedTestDegradation(ctx, result.DownloadMbps, result.UploadMbps)
+		return 0, err
+	}
 	if err != nil {
-		return fmt.Errorf("failed to submit speed test: %w", err)
+		return 0, fmt.Errorf("failed to submit speed test: %w", err)
 	}
 
-	log.Printf("📊 Speed test submitted - Status: %d, Download: %.2f Mbps, Upload: %.2f Mbps",
-		resp.StatusCode(), result.DownloadMbps, result.UploadMbps)
+	log.Printf("📊 Speed test submitted - ID: %d, Download: %.2f Mbps, Upload: %.2f Mbps",
+		id, result.DownloadMbps, result.UploadMbps)
+
+	d.checkSpeedTestDegradation(ctx, result.DownloadMbps, result.UploadMbps)
 
-	return nil
+	return id, nil
 }
 
-// runIperfTests executes iperf tests against random hosts and submits results via API
+// runIperfTests executes an iperf test against a random host of each type
+// and submits results via API. Host types are tested in dependency order;
+// when a prerequisite fails, dependents are submitted as blocked instead of
+// being tested. Hosts outside the remote host filters are left out.
 func (d *APIClient) runIperfTests(ctx context.Context) error {
-	// Get available hosts from API
+	// Get the active hosts this daemon may test from API
 	hostsResp, err := d.client.GetHostsWithResponse(ctx, &client.GetHostsParams{
-		Active: &[]bool{true}[0], // Only get active hosts
+		Active:   &[]bool{true}[0], // Only get active hosts
+		DaemonId: &d.daemonID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to get hosts: %w", err)
@@ -155,16 +308,102 @@ func (d *APIClient) runIperfTests(ctx context.Context) error {
 	hosts := *hostsResp.JSON200
 	if len(hosts) == 0 {
 		log.Println("⚠️  No active hosts available for iperf testing")
+		d.recordSkippedRun(ctx, client.Iperf, client.Scheduled, nil, "no active hosts available")
 		return nil
 	}
 
-	// Select a random host
-	host := hosts[time.Now().Unix()%int64(len(hosts))]
+	settings := d.settings()
+	hostsByType := make(map[string][]client.Host)
+	for _, host := range hosts {
+		if settings.hostAllowed(host) {
+			hostsByType[string(host.Type)] = append(hostsByType[string(host.Type)], host)
+		}
+	}
+	if len(hostsByType) == 0 {
+		log.Println("⚠️  No active hosts match the configured host filters")
+		d.recordSkippedRun(ctx, client.Iperf, client.Scheduled, nil, "no active hosts match the host filters")
+		return nil
+	}
+
+	var errs []error
+	failed := make(map[string]bool)
+	dependencies := d.config.Testing.Dependencies
+	for _, hostType := range dependencies.Order([]string{string(client.Lan), string(client.Vpn), string(client.Remote)}) {
+		candidates := hostsByType[hostType]
+		if len(candidates) == 0 {
+			continue
+		}
+
+		// Select a random host
+		host := candidates[rand.Intn(len(candidates))]
+
+		if d.drain.isDraining() {
+			d.recordSkippedRun(ctx, client.Iperf, client.Scheduled, &host, errDraining.Error())
+			continue
+		}
+
+		if upstream, blocked := dependencies.BlockedBy(hostType, failed); blocked {
+			failed[hostType] = true
+			d.submitBlockedIperfTest(ctx, host, upstream, settings.iperfDuration)
+			continue
+		}
+
+		if _, err := d.runIperfTest(ctx, host, settings.iperfDuration, client.Scheduled, nil); err != nil && !errors.Is(err, errSpooled) {
+			failed[hostType] = true
+			errs = append(errs, fmt.Errorf("%s host %s: %w", hostType, host.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// submitBlockedIperfTest records a test that was skipped because an upstream
+// host type failed, so the history points at the root-cause segment
+func (d *APIClient) submitBlockedIperfTest(ctx context.Context, host client.Host, upstream string, duration int) {
+	reason := config.BlockedMessage(upstream)
+	log.Printf("⏭️  Skipping iperf test against %s: %s", host.Name, reason)
+
+	blockedBy := client.HostType(upstream)
+	trigger := client.Scheduled
+	submission := client.IperfTestSubmission{
+		Timestamp:       time.Now(),
+		HostId:          host.Id,
+		Protocol:        client.IperfTestSubmissionProtocolTCP,
+		DurationSeconds: duration,
+		DaemonId:        d.daemonID,
+		Trigger:         &trigger,
+		BlockedBy:       &blockedBy,
+		SubmissionId:    newSubmissionID(),
+	}
+
+	if _, err := d.submit(ctx, spoolIperf, submission); err != nil && !errors.Is(err, errSpooled) {
+		log.Printf("Failed to submit blocked iperf test: %v", err)
+	}
+
+	d.recordSkippedRun(ctx, client.Iperf, trigger, &host, reason)
+}
+
+// runIperfTest executes an iperf test against a single host, submits the
+// result via API and returns the ID of the stored result. Failed tests are
+// submitted as well so they show up in the history, and every attempt is
+// recorded in the run history. Results of campaign jobs carry the campaign ID.
+// A successful result that could not be delivered is spooled and an error
+// wrapping errSpooled is returned.
+func (d *APIClient) runIperfTest(ctx context.Context, host client.Host, duration int, trigger client.TestTrigger, campaignID *int) (resultID int, err error) {
+	started := time.Now()
+	defer func() {
+		d.recordRun(ctx, client.Iperf, trigger, &host, started, resultID, err)
+	}()
 
 	log.Printf("🔗 Running iperf test against %s (%s:%d)", host.Name, host.Hostname, host.Port)
 
 	// Run iperf test
-	result, err := d.runSingleIperfTest(ctx, host)
+	result, err := d.runSingleIperfTest(ctx, host.Hostname, host.Port, duration)
+	if err != nil && d.drain.isAborted() {
+		// Not a failure of the host, so no result is submitted
+		log.Printf("🛑 Iperf test against %s aborted by shutdown", host.Name)
+		return 0, err
+	}
 	if err != nil {
 		log.Printf("❌ Iperf test failed against %s: %v", host.Name, err)
 
@@ -175,16 +414,22 @@ func (d *APIClient) runIperfTests(ctx context.Context) error {
 			SentMbps:        0,
 			ReceivedMbps:    0,
 			Protocol:        client.IperfTestSubmissionProtocolTCP,
-			DurationSeconds: d.config.Testing.IperfTestDuration,
+			DurationSeconds: duration,
 			DaemonId:        d.daemonID,
+			Trigger:         &trigger,
+			SubmissionId:    newSubmissionID(),
+			CampaignId:      campaignID,
 		}
 
-		_, submitErr := d.client.SubmitIperfTestWithResponse(ctx, submission)
-		if submitErr != nil {
+		id, submitErr := d.submit(ctx, spoolIperf, submission)
+		if submitErr != nil && !errors.Is(submitErr, errSpooled) {
 			log.Printf("Failed to submit failed iperf test: %v", submitErr)
 		}
+		resultID = id
 
-		return err
+		d.checkIperfDegradation(ctx, host, 0, 0, true)
+
+		return resultID, err
 	}
 
 	// Submit successful test result
@@ -198,17 +443,28 @@ func (d *APIClient) runIperfTests(ctx context.Context) error {
 		DaemonId:        d.daemonID,
 		MeanRttMs:       result.MeanRTT,
 		Retransmits:     result.Retransmits,
+		Trigger:         &trigger,
+		SubmissionId:    newSubmissionID(),
+		CampaignId:      campaignID,
 	}
 
-	resp, err := d.client.SubmitIperfTestWithResponse(ctx, submission)
+	id, err := d.submit(ctx, spoolIperf, submission)
+	if errors.Is(err, errSpooled) {
+		log.Printf("📥 Iperf test spooled for replay - Sent: %.2f Mbps, Received: %.2f Mbps: %v",
+			result.SentMbps, result.ReceivedMbps, err)
+		d.checkIperfDegradation(ctx, host, result.SentMbps, result.ReceivedMbps, false)
+		return 0, err
+	}
 	if err != nil {
-		return fmt.Errorf("failed to submit iperf test: %w", err)
+		return 0, fmt.Errorf("failed to submit iperf test: %w", err)
 	}
 
-	log.Printf("📈 Iperf test submitted - Status: %d, Sent: %.2f Mbps, Received: %.2f Mbps",
-		resp.StatusCode(), result.SentMbps, result.ReceivedMbps)
+	log.Printf("📈 Iperf test submitted - ID: %d, Sent: %.2f Mbps, Received: %.2f Mbps",
+		id, result.SentMbps, result.ReceivedMbps)
 
-	return nil
+	d.checkIperfDegradation(ctx, host, result.SentMbps, result.ReceivedMbps, false)
+
+	return id, nil
 }
 
 // Placeholder structures for parsing (we'll implement proper parsing)
@@ -373,23 +629,31 @@ func (d *APIClient) parseSpeedTestJSON(jsonOutput []byte) (*SpeedTestResult, err
 	return result, nil
 }
 
-// runSingleIperfTest runs an iperf test against a specific host
-func (d *APIClient) runSingleIperfTest(ctx context.Context, host client.Host) (*IperfTestResult, error) {
+// runSingleIperfTest runs an iperf test against the iperf3 server at
+// hostname and port
+func (d *APIClient) runSingleIperfTest(ctx context.Context, hostname string, port int, duration int) (*IperfTestResult, error) {
 	// Build iperf command
-	cmd := exec.CommandContext(ctx, "iperf3",
-		"-c", host.Hostname,
-		"-p", strconv.Itoa(host.Port),
-		"-t", strconv.Itoa(d.config.Testing.IperfTestDuration),
+	timeout := time.Duration(duration)*time.Second + iperfTimeoutSlack
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(cmdCtx, "iperf3",
+		"-c", hostname,
+		"-p", strconv.Itoa(port),
+		"-t", strconv.Itoa(duration),
 		"-J", // JSON output
 	)
 
 	output, err := cmd.Output()
+	if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("iperf3 timed out after %s: %w", timeout, context.DeadlineExceeded)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("iperf3 command failed: %w", err)
 	}
 
 	// Parse iperf output
-	result, err := d.parseIperfJSON(output)
+	result, err := d.parseIperfJSON(output, duration)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse iperf output: %w", err)
 	}
@@ -398,7 +662,7 @@ func (d *APIClient) runSingleIperfTest(ctx context.Context, host client.Host) (*
 }
 
 // parseIperfJSON parses the iperf3 JSON output
-func (d *APIClient) parseIperfJSON(jsonOutput []byte) (*IperfTestResult, error) {
+func (d *APIClient) parseIperfJSON(jsonOutput []byte, duration int) (*IperfTestResult, error) {
 	var iperfResult IperfJSONResult
 	if err := json.Unmarshal(jsonOutput, &iperfResult); err != nil {
 		// Log the raw output for debugging
@@ -417,7 +681,7 @@ func (d *APIClient) parseIperfJSON(jsonOutput []byte) (*IperfTestResult, error)
 		SentMbps:     sentMbps,
 		ReceivedMbps: receivedMbps,
 		Protocol:     "TCP", // Default to TCP, could be extracted from Start.TestStart.Protocol
-		Duration:     d.config.Testing.IperfTestDuration,
+		Duration:     duration,
 	}
 
 	// Set optional fields
@@ -0,0 +1,158 @@
+package daemon
+
+import (
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+const (
+	// serverTimeHeader carries the API server's clock on every response
+	serverTimeHeader = "X-Server-Time"
+
+	// clientTimeHeader carries the daemon's clock, corrected when clock
+	// correction is enabled, so the server can check for skew
+	clientTimeHeader = "X-Client-Time"
+
+	// clockCorrectionThreshold is the smallest offset timestamps are
+	// corrected for, smaller offsets are within measurement noise
+	clockCorrectionThreshold = time.Second
+)
+
+// clockOffset tracks how far the daemon's clock is off the API server's,
+// measured from the X-Server-Time header of every response
+type clockOffset struct {
+	mu       sync.RWMutex
+	offset   time.Duration
+	measured bool
+	correct  bool
+	skewed   bool
+}
+
+func newClockOffset(correct bool) *clockOffset {
+	return &clockOffset{correct: correct}
+}
+
+// observe records the offset from a response to a request sent at sent.
+// The server stamps the time the request arrived, so the error is the
+// one-way latency to the server.
+func (c *clockOffset) observe(sent time.Time, serverTime string) {
+	server, err := time.Parse(time.RFC3339Nano, serverTime)
+	if err != nil {
+		return
+	}
+	offset := server.Sub(sent)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.offset = offset
+	c.measured = true
+
+	// Only log when the clock moves in or out of the correctable range
+	skewed := offset.Abs() >= clockCorrectionThreshold
+	if skewed == c.skewed {
+		return
+	}
+	c.skewed = skewed
+	switch {
+	case !skewed:
+		log.Println("⏱️  Clock is in sync with the API server")
+	case c.correct:
+		log.Printf("⏱️  Clock is %v off the API server, correcting timestamps", offset.Round(time.Millisecond))
+	default:
+		log.Printf("⚠️  Clock is %v off the API server and clock correction is disabled, results may be quarantined", offset.Round(time.Millisecond))
+	}
+}
+
+// current returns the last measured offset, server clock minus daemon clock
+func (c *clockOffset) current() (offset time.Duration, measured bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.offset, c.measured
+}
+
+// correction returns the offset to add to daemon timestamps, zero when
+// correction is disabled or the clock is close enough
+func (c *clockOffset) correction() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.correct || !c.measured || c.offset.Abs() < clockCorrectionThreshold {
+		return 0
+	}
+	return c.offset
+}
+
+// clockTransport sends the daemon's clock with every request and measures
+// the offset from the server's clock on every response
+type clockTransport struct {
+	next  http.RoundTripper
+	clock *clockOffset
+}
+
+func (t *clockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sent := time.Now()
+
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set(clientTimeHeader, sent.Add(t.clock.correction()).UTC().Format(time.RFC3339Nano))
+
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		if serverTime := resp.Header.Get(serverTimeHeader); serverTime != "" {
+			t.clock.observe(sent, serverTime)
+		}
+	}
+	return resp, err
+}
+
+// stampClock records the measured clock offset on a submission and, with
+// clock correction enabled, moves its timestamps onto the server's clock.
+// Submissions are stamped before they are spooled, so replays keep the
+// correction that applied when the test ran.
+func (d *APIClient) stampClock(submission any) any {
+	offset, measured := d.clock.current()
+	if !measured {
+		return submission
+	}
+	offsetMs := offset.Milliseconds()
+	correction := d.clock.correction()
+	corrected := correction != 0
+
+	switch s := submission.(type) {
+	case client.SpeedTestSubmission:
+		s.ClockOffsetMs = &offsetMs
+		if corrected {
+			s.Timestamp = s.Timestamp.Add(correction)
+			s.ClockCorrected = &corrected
+		}
+		return s
+
+	case client.IperfTestSubmission:
+		s.ClockOffsetMs = &offsetMs
+		if corrected {
+			s.Timestamp = s.Timestamp.Add(correction)
+			s.ClockCorrected = &corrected
+		}
+		return s
+
+	case client.MeshTestSubmission:
+		s.ClockOffsetMs = &offsetMs
+		if corrected {
+			s.Timestamp = s.Timestamp.Add(correction)
+			s.ClockCorrected = &corrected
+		}
+		return s
+
+	case client.TestRunSubmission:
+		s.StartedAt = s.StartedAt.Add(correction)
+		s.FinishedAt = s.FinishedAt.Add(correction)
+		return s
+	}
+
+	return submission
+}
@@ -0,0 +1,156 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// abortWait bounds how long aborted tests get to record their runs
+	// after the grace period has run out
+	abortWait = runRecordTimeout + 5*time.Second
+
+	// spoolFlushTimeout bounds the last spool replay before the daemon exits
+	spoolFlushTimeout = 30 * time.Second
+)
+
+// errDraining is recorded for runs that were due while the daemon was
+// shutting down
+var errDraining = errors.New("daemon shutting down")
+
+// drainer tracks in-flight tests so shutdown can wait for them. Tests run
+// with the run context, which outlives the daemon context and is only
+// cancelled when the shutdown grace period runs out.
+type drainer struct {
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	draining bool
+	aborted  bool
+	runCtx   context.Context
+	abort    context.CancelFunc
+}
+
+func newDrainer() *drainer {
+	runCtx, abort := context.WithCancel(context.Background())
+	return &drainer{runCtx: runCtx, abort: abort}
+}
+
+// begin registers an in-flight test and returns the context it must run
+// with, or false once the daemon is draining
+func (dr *drainer) begin() (context.Context, bool) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+
+	if dr.draining {
+		return nil, false
+	}
+	dr.wg.Add(1)
+	return dr.runCtx, true
+}
+
+func (dr *drainer) done() {
+	dr.wg.Done()
+}
+
+// stop makes begin refuse new tests
+func (dr *drainer) stop() {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	dr.draining = true
+}
+
+// abortRuns cancels the run context, killing in-flight test processes
+func (dr *drainer) abortRuns() {
+	dr.mu.Lock()
+	dr.aborted = true
+	dr.mu.Unlock()
+	dr.abort()
+}
+
+// isDraining reports whether shutdown has started
+func (dr *drainer) isDraining() bool {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	return dr.draining
+}
+
+// isAborted reports whether in-flight tests were cancelled after the grace
+// period ran out
+func (dr *drainer) isAborted() bool {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	return dr.aborted
+}
+
+// wait waits up to timeout for in-flight tests and reports whether they
+// all finished
+func (dr *drainer) wait(timeout time.Duration) bool {
+	finished := make(chan struct{})
+	go func() {
+		dr.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// goRun runs a test in the background as an in-flight run and reports
+// whether it was started, which it is not once the daemon is draining
+func (d *APIClient) goRun(run func(ctx context.Context)) bool {
+	runCtx, ok := d.drain.begin()
+	if !ok {
+		return false
+	}
+
+	go func() {
+		defer d.drain.done()
+		run(runCtx)
+	}()
+	return true
+}
+
+// shutdown stops new tests, waits up to the grace period for in-flight tests
+// to finish and aborts those still running, then makes a last attempt to
+// deliver the spool
+func (d *APIClient) shutdown() {
+	d.drain.stop()
+
+	grace := d.config.Daemon.ShutdownGracePeriod
+	log.Printf("🛑 Draining, waiting up to %v for in-flight tests...", grace)
+	if !d.drain.wait(grace) {
+		log.Println("⚠️  Shutdown grace period elapsed, aborting in-flight tests")
+		d.drain.abortRuns()
+		if !d.drain.wait(abortWait) {
+			log.Println("⚠️  Aborted tests did not finish, their runs may be missing")
+		}
+	}
+	d.drain.abort()
+
+	d.flushSpool()
+}
+
+// flushSpool makes a last attempt to deliver the spool. Entries left over
+// stay on disk and are replayed after the next start.
+func (d *APIClient) flushSpool() {
+	if d.spool == nil || d.spool.depth() == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), spoolFlushTimeout)
+	defer cancel()
+
+	delivered, _ := d.replayOnce(ctx)
+	if remaining := d.spool.depth(); remaining > 0 {
+		log.Printf("📥 Delivered %d spooled submissions, %d left for the next start", delivered, remaining)
+		return
+	}
+	log.Printf("📤 Delivered %d spooled submissions", delivered)
+}
@@ -0,0 +1,59 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// daemonState is persisted between restarts so the daemon keeps the same
+// identity and its history does not fragment
+type daemonState struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// loadOrCreateIdentity returns the daemon ID stored in the state file,
+// generating and persisting a new UUID on first start
+func loadOrCreateIdentity(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		var state daemonState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return "", fmt.Errorf("failed to parse daemon state file %s: %w", path, err)
+		}
+		if state.ID == "" {
+			return "", fmt.Errorf("daemon state file %s has no id", path)
+		}
+		return state.ID, nil
+
+	case !errors.Is(err, fs.ErrNotExist):
+		return "", fmt.Errorf("failed to read daemon state file %s: %w", path, err)
+	}
+
+	state := daemonState{
+		ID:        uuid.NewString(),
+		CreatedAt: time.Now(),
+	}
+
+	data, err = json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create daemon state directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write daemon state file %s: %w", path, err)
+	}
+
+	return state.ID, nil
+}
@@ -0,0 +1,179 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+// onDemandWait is how long a lease request long-polls for on-demand runs
+const onDemandWait = 30 * time.Second
+
+// StartJobProcessing leases jobs from the API server's queue and executes
+// them until the context is cancelled. The server owns the schedule, so no
+// local tickers are used in this mode. Lease requests long-poll for up to the
+// poll interval so on-demand runs start immediately.
+func (d *APIClient) StartJobProcessing(ctx context.Context) error {
+	log.Printf("Starting job-queue daemon with ID: %s", d.daemonID)
+	log.Printf("API endpoint: %s", d.client.ClientInterface.(*client.Client).Server)
+
+	// Register with the API server and keep sending heartbeats
+	if err := d.register(ctx); err != nil {
+		log.Printf("Failed to register daemon: %v", err)
+	}
+	go d.runHeartbeat(ctx)
+
+	d.status.setRunning(modeJobQueue, true)
+	defer d.status.setRunning(modeJobQueue, false)
+
+	// Deliver results spooled while the API was unreachable
+	go d.replaySpool(ctx)
+
+	// Lease jobs until shutdown, then drain the job in progress
+	go d.pollJobs(ctx, false, d.config.Daemon.PollInterval)
+	<-ctx.Done()
+	d.shutdown()
+
+	log.Println("Job-queue daemon stopped")
+	return nil
+}
+
+// watchOnDemandRuns long-polls for runs pinned to this daemon, e.g. those
+// triggered through POST /daemons/{id}/run, while the local tickers keep
+// handling the regular schedule
+func (d *APIClient) watchOnDemandRuns(ctx context.Context) {
+	d.pollJobs(ctx, true, onDemandWait)
+}
+
+// pollJobs repeatedly leases and runs jobs until the context is cancelled,
+// backing off for the poll interval when the API is unreachable
+func (d *APIClient) pollJobs(ctx context.Context, pinnedOnly bool, wait time.Duration) {
+	for ctx.Err() == nil {
+		if err := d.processLeasedJobs(ctx, pinnedOnly, wait); err != nil {
+			log.Printf("Failed to lease jobs: %v", err)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(d.config.Daemon.PollInterval):
+			}
+		}
+	}
+}
+
+// processLeasedJobs leases a batch of jobs and runs them one at a time so
+// tests from the same daemon never overlap
+func (d *APIClient) processLeasedJobs(ctx context.Context, pinnedOnly bool, wait time.Duration) error {
+	maxJobs := d.config.Daemon.MaxJobs
+	waitSeconds := max(int(wait.Seconds()), 1)
+	resp, err := d.client.LeaseJobsWithResponse(ctx, d.daemonID, client.JobLeaseRequest{
+		MaxJobs:     &maxJobs,
+		WaitSeconds: &waitSeconds,
+		PinnedOnly:  &pinnedOnly,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	if resp.StatusCode() != 200 || resp.JSON200 == nil {
+		return fmt.Errorf("unexpected response leasing jobs: %d", resp.StatusCode())
+	}
+
+	for _, job := range *resp.JSON200 {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// Jobs leased but not started are left for their leases to expire
+		// so they are retried once the daemon, or another one, is back
+		runCtx, ok := d.drain.begin()
+		if !ok {
+			return nil
+		}
+		d.runLeasedJob(runCtx, job)
+		d.drain.done()
+	}
+
+	return nil
+}
+
+// runLeasedJob runs a leased job and reports its outcome. It runs with the
+// run context so the job can finish while the daemon drains.
+func (d *APIClient) runLeasedJob(ctx context.Context, job client.Job) {
+	log.Printf("📋 Running leased job %d (%s)", job.Id, job.Type)
+	resultID, runErr := d.runJob(ctx, job)
+	if errors.Is(runErr, errSpooled) {
+		// The job ran; its result is delivered when the spool is replayed
+		runErr = nil
+	}
+
+	completion := client.JobCompletion{
+		DaemonId: d.daemonID,
+		Success:  runErr == nil,
+	}
+	if runErr != nil {
+		log.Printf("❌ Job %d failed: %v", job.Id, runErr)
+		errorMessage := runErr.Error()
+		completion.ErrorMessage = &errorMessage
+	} else if resultID != 0 {
+		// Spooled results have no ID until they are replayed
+		completion.ResultId = &resultID
+	}
+
+	// Aborted jobs are still reported so they are retried without waiting
+	// for their leases to expire
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), runRecordTimeout)
+	defer cancel()
+
+	completeResp, err := d.client.CompleteJobWithResponse(ctx, job.Id, completion)
+	if err != nil {
+		log.Printf("Failed to complete job %d: %v", job.Id, err)
+		return
+	}
+
+	log.Printf("✅ Job %d reported - Status: %d", job.Id, completeResp.StatusCode())
+}
+
+// runJob executes a single leased job and returns the submitted result ID
+func (d *APIClient) runJob(ctx context.Context, job client.Job) (int, error) {
+	trigger := client.Scheduled
+	if job.Trigger != nil {
+		trigger = *job.Trigger
+	}
+
+	switch job.Type {
+	case client.Speedtest:
+		return d.runSpeedTest(ctx, trigger, job.CampaignId)
+
+	case client.Iperf:
+		if job.Host == nil {
+			return 0, fmt.Errorf("iperf job %d has no target host", job.Id)
+		}
+
+		duration := d.settings().iperfDuration
+		if job.DurationSeconds != nil {
+			duration = *job.DurationSeconds
+		}
+		return d.runIperfTest(ctx, *job.Host, duration, trigger, job.CampaignId)
+
+	case client.Mesh:
+		if job.TargetDaemonId == nil || job.TargetAddress == nil {
+			return 0, fmt.Errorf("mesh job %d has no target daemon", job.Id)
+		}
+
+		duration := d.settings().iperfDuration
+		if job.DurationSeconds != nil {
+			duration = *job.DurationSeconds
+		}
+		return d.runMeshTest(ctx, *job.TargetDaemonId, *job.TargetAddress, duration, trigger)
+
+	default:
+		return 0, fmt.Errorf("unsupported job type: %s", job.Type)
+	}
+}
@@ -0,0 +1,104 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+// defaultIperfPort is used for mesh addresses without a port
+const defaultIperfPort = 5201
+
+// runMeshTest runs an iperf test against another daemon's iperf3 server,
+// submits the result via API and returns the ID of the stored result.
+// Failed tests are submitted as well so they show up in the matrix. A
+// successful result that could not be delivered is spooled and an error
+// wrapping errSpooled is returned.
+func (d *APIClient) runMeshTest(ctx context.Context, targetDaemonID, address string, duration int, trigger client.TestTrigger) (resultID int, err error) {
+	started := time.Now()
+	defer func() {
+		run := d.runSubmission(client.Mesh, trigger, nil, started, resultID, err)
+		run.TargetDaemonId = &targetDaemonID
+		d.submitRun(ctx, run)
+	}()
+
+	hostname, port, err := splitMeshAddress(address)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Printf("🕸️  Running mesh test against daemon %s (%s)", targetDaemonID, address)
+
+	submission := client.MeshTestSubmission{
+		SourceDaemonId:  d.daemonID,
+		TargetDaemonId:  targetDaemonID,
+		TargetAddress:   &address,
+		DurationSeconds: duration,
+		Trigger:         &trigger,
+		SubmissionId:    newSubmissionID(),
+	}
+
+	result, err := d.runSingleIperfTest(ctx, hostname, port, duration)
+	if err != nil && d.drain.isAborted() {
+		// Not a failure of the link, so no result is submitted
+		log.Printf("🛑 Mesh test against %s aborted by shutdown", targetDaemonID)
+		return 0, err
+	}
+	if err != nil {
+		log.Printf("❌ Mesh test failed against %s: %v", targetDaemonID, err)
+
+		errorMessage := err.Error()
+		submission.Timestamp = time.Now()
+		submission.ErrorMessage = &errorMessage
+
+		id, submitErr := d.submit(ctx, spoolMesh, submission)
+		if submitErr != nil && !errors.Is(submitErr, errSpooled) {
+			log.Printf("Failed to submit failed mesh test: %v", submitErr)
+		}
+		return id, err
+	}
+
+	submission.Timestamp = result.Timestamp
+	submission.SentMbps = result.SentMbps
+	submission.ReceivedMbps = result.ReceivedMbps
+	submission.DurationSeconds = result.Duration
+	submission.MeanRttMs = result.MeanRTT
+	submission.Retransmits = result.Retransmits
+
+	id, err := d.submit(ctx, spoolMesh, submission)
+	if errors.Is(err, errSpooled) {
+		log.Printf("📥 Mesh test spooled for replay - Sent: %.2f Mbps, Received: %.2f Mbps: %v",
+			result.SentMbps, result.ReceivedMbps, err)
+		return 0, err
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to submit mesh test: %w", err)
+	}
+
+	log.Printf("📈 Mesh test submitted - ID: %d, %s -> %s, Sent: %.2f Mbps, Received: %.2f Mbps",
+		id, d.daemonID, targetDaemonID, result.SentMbps, result.ReceivedMbps)
+
+	return id, nil
+}
+
+// splitMeshAddress splits a mesh address into hostname and port, defaulting
+// to the iperf3 port when none is given
+func splitMeshAddress(address string) (string, int, error) {
+	hostname, portText, err := net.SplitHostPort(address)
+	if err != nil {
+		// No port, e.g. "10.1.0.5" or "site-b.vpn"
+		return address, defaultIperfPort, nil
+	}
+
+	port, err := strconv.Atoi(portText)
+	if err != nil || port <= 0 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port in mesh address %q", address)
+	}
+	return hostname, port, nil
+}
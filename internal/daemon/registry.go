@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+// runHeartbeat keeps the daemon's registry record fresh until the context
+// is cancelled. A daemon the server no longer knows about registers again,
+// and a changed config version in the response triggers a fetch of the
+// remote configuration.
+func (d *APIClient) runHeartbeat(ctx context.Context) {
+	ticker := time.NewTicker(d.config.Daemon.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			spoolDepth := d.spool.depth()
+			resp, err := d.client.HeartbeatDaemonWithResponse(ctx, d.daemonID, client.DaemonHeartbeat{
+				SpoolDepth: &spoolDepth,
+			})
+			if err != nil {
+				log.Printf("Failed to send heartbeat: %v", err)
+				continue
+			}
+
+			if resp.StatusCode() == http.StatusNotFound {
+				if err := d.register(ctx); err != nil {
+					log.Printf("Failed to register daemon: %v", err)
+				}
+				continue
+			}
+
+			if resp.JSON200 != nil {
+				d.checkConfigVersion(ctx, resp.JSON200.ConfigVersion)
+			}
+		}
+	}
+}
+
+// register announces the daemon and its capabilities to the API server and
+// fetches the remote configuration when it changed
+func (d *APIClient) register(ctx context.Context) error {
+	hostname, _ := os.Hostname()
+	_, iperfErr := exec.LookPath("iperf3")
+	_, speedtestErr := exec.LookPath("speedtest")
+
+	hasIperf3 := iperfErr == nil
+	hasSpeedtest := speedtestErr == nil
+	goos := runtime.GOOS
+	goarch := runtime.GOARCH
+
+	registration := client.DaemonRegistration{
+		Id:       d.daemonID,
+		Hostname: &hostname,
+		Version:  &d.version,
+		Os:       &goos,
+		Arch:     &goarch,
+		Capabilities: &client.DaemonCapabilities{
+			Iperf3:    &hasIperf3,
+			Speedtest: &hasSpeedtest,
+		},
+	}
+	if d.config.Daemon.Name != "" {
+		registration.Name = &d.config.Daemon.Name
+	}
+	if len(d.config.Daemon.Labels) > 0 {
+		registration.Labels = &d.config.Daemon.Labels
+	}
+	if d.config.Daemon.MeshAddress != "" {
+		registration.MeshAddress = &d.config.Daemon.MeshAddress
+	}
+
+	resp, err := d.client.RegisterDaemonWithResponse(ctx, registration)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("📝 Daemon registered - Status: %d, iperf3: %t, speedtest: %t",
+		resp.StatusCode(), hasIperf3, hasSpeedtest)
+
+	if resp.JSON200 != nil {
+		d.checkConfigVersion(ctx, resp.JSON200.ConfigVersion)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+// testingSettings are the settings the daemon tests with: the local
+// testing.* configuration overridden by the remote configuration
+type testingSettings struct {
+	speedTestInterval time.Duration
+	iperfInterval     time.Duration
+	iperfDuration     int
+	speedTestEnabled  bool
+	iperfEnabled      bool
+	adaptiveEnabled   bool
+	hostTypes         []client.HostType
+	hostIDs           []int
+}
+
+// hostAllowed reports whether the host passes the remote host filters
+func (s testingSettings) hostAllowed(host client.Host) bool {
+	if len(s.hostTypes) > 0 && !slices.Contains(s.hostTypes, host.Type) {
+		return false
+	}
+	if len(s.hostIDs) > 0 && !slices.Contains(s.hostIDs, host.Id) {
+		return false
+	}
+	return true
+}
+
+// remoteConfig holds the configuration last fetched from the API server.
+// changed is signalled whenever a new version is applied so the scheduling
+// loop can reset its tickers.
+type remoteConfig struct {
+	mu       sync.RWMutex
+	version  string
+	settings client.DaemonSettings
+	changed  chan struct{}
+}
+
+func newRemoteConfig() *remoteConfig {
+	return &remoteConfig{changed: make(chan struct{}, 1)}
+}
+
+func (r *remoteConfig) current() (string, client.DaemonSettings) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.version, r.settings
+}
+
+// apply stores a new version and reports whether it differs from the one
+// already applied
+func (r *remoteConfig) apply(version string, settings client.DaemonSettings) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if version == r.version {
+		return false
+	}
+	r.version = version
+	r.settings = settings
+
+	select {
+	case r.changed <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// settings returns the testing settings currently in effect
+func (d *APIClient) settings() testingSettings {
+	_, remote := d.remote.current()
+	local := d.config.Testing
+
+	settings := testingSettings{
+		speedTestInterval: local.SpeedTestInterval,
+		iperfInterval:     local.IperfTestInterval,
+		iperfDuration:     local.IperfTestDuration,
+		speedTestEnabled:  true,
+		iperfEnabled:      true,
+		adaptiveEnabled:   local.Adaptive.Enabled,
+	}
+
+	if remote.SpeedtestIntervalSeconds != nil {
+		settings.speedTestInterval = time.Duration(*remote.SpeedtestIntervalSeconds) * time.Second
+	}
+	if remote.IperfIntervalSeconds != nil {
+		settings.iperfInterval = time.Duration(*remote.IperfIntervalSeconds) * time.Second
+	}
+	if remote.IperfDurationSeconds != nil {
+		settings.iperfDuration = *remote.IperfDurationSeconds
+	}
+	if remote.SpeedtestEnabled != nil {
+		settings.speedTestEnabled = *remote.SpeedtestEnabled
+	}
+	if remote.IperfEnabled != nil {
+		settings.iperfEnabled = *remote.IperfEnabled
+	}
+	if remote.AdaptiveEnabled != nil {
+		settings.adaptiveEnabled = *remote.AdaptiveEnabled
+	}
+	if remote.HostTypes != nil {
+		settings.hostTypes = *remote.HostTypes
+	}
+	if remote.HostIds != nil {
+		settings.hostIDs = *remote.HostIds
+	}
+
+	return settings
+}
+
+// checkConfigVersion fetches the remote configuration when the version the
+// API server reported differs from the one applied
+func (d *APIClient) checkConfigVersion(ctx context.Context, version *string) {
+	if version == nil {
+		return
+	}
+	if applied, _ := d.remote.current(); applied == *version {
+		return
+	}
+
+	if err := d.refreshConfig(ctx); err != nil {
+		log.Printf("Failed to fetch remote configuration: %v", err)
+	}
+}
+
+// refreshConfig fetches and applies the daemon's effective configuration
+func (d *APIClient) refreshConfig(ctx context.Context) error {
+	resp, err := d.client.GetEffectiveDaemonConfigWithResponse(ctx, d.daemonID)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return fmt.Errorf("unexpected response getting configuration: %d", resp.StatusCode())
+	}
+
+	if d.remote.apply(resp.JSON200.Version, resp.JSON200.Settings) {
+		settings := d.settings()
+		log.Printf("⚙️  Remote configuration %s applied - Speed: every %v (enabled: %t), Iperf: every %v for %ds (enabled: %t), Adaptive: %t",
+			resp.JSON200.Version, settings.speedTestInterval, settings.speedTestEnabled,
+			settings.iperfInterval, settings.iperfDuration, settings.iperfEnabled, settings.adaptiveEnabled)
+	}
+	return nil
+}
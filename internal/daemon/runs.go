@@ -0,0 +1,105 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+const (
+	// speedTestTimeout bounds a single speedtest CLI invocation
+	speedTestTimeout = 2 * time.Minute
+
+	// iperfTimeoutSlack is added to the iperf test duration to allow for
+	// connection setup and teardown
+	iperfTimeoutSlack = 30 * time.Second
+
+	// runRecordTimeout bounds reporting a run, which may happen after the
+	// daemon context has been cancelled
+	runRecordTimeout = 10 * time.Second
+)
+
+// recordRun reports a finished test run to the API so the run history shows
+// every attempt, including those that never produced a result
+func (d *APIClient) recordRun(ctx context.Context, testType client.JobType, trigger client.TestTrigger, host *client.Host, started time.Time, resultID int, runErr error) {
+	d.submitRun(ctx, d.runSubmission(testType, trigger, host, started, resultID, runErr))
+}
+
+// runSubmission builds the run record for a finished test run
+func (d *APIClient) runSubmission(testType client.JobType, trigger client.TestTrigger, host *client.Host, started time.Time, resultID int, runErr error) client.TestRunSubmission {
+	submission := client.TestRunSubmission{
+		DaemonId:     d.daemonID,
+		Type:         testType,
+		Trigger:      &trigger,
+		Outcome:      client.RunOutcomeSuccess,
+		StartedAt:    started,
+		FinishedAt:   time.Now(),
+		SubmissionId: newSubmissionID(),
+	}
+
+	if host != nil {
+		submission.HostId = &host.Id
+	}
+	if resultID != 0 {
+		switch testType {
+		case client.Speedtest:
+			submission.SpeedTestId = &resultID
+		case client.Iperf:
+			submission.IperfTestId = &resultID
+		case client.Mesh:
+			submission.MeshTestId = &resultID
+		}
+	}
+	if runErr != nil {
+		switch {
+		case errors.Is(runErr, errSpooled):
+			// The test measured a result, which waits in the spool
+			submission.Outcome = client.RunOutcomeSpooled
+		case d.drain.isAborted():
+			submission.Outcome = client.RunOutcomeAborted
+		case errors.Is(runErr, context.DeadlineExceeded):
+			submission.Outcome = client.RunOutcomeTimeout
+		default:
+			submission.Outcome = client.RunOutcomeFailed
+		}
+		errorMessage := runErr.Error()
+		submission.ErrorMessage = &errorMessage
+	}
+
+	return submission
+}
+
+// recordSkippedRun reports a run that was due but not attempted
+func (d *APIClient) recordSkippedRun(ctx context.Context, testType client.JobType, trigger client.TestTrigger, host *client.Host, reason string) {
+	now := time.Now()
+	submission := client.TestRunSubmission{
+		DaemonId:     d.daemonID,
+		Type:         testType,
+		Trigger:      &trigger,
+		Outcome:      client.RunOutcomeSkipped,
+		StartedAt:    now,
+		FinishedAt:   now,
+		ErrorMessage: &reason,
+		SubmissionId: newSubmissionID(),
+	}
+
+	if host != nil {
+		submission.HostId = &host.Id
+	}
+
+	d.submitRun(ctx, submission)
+}
+
+func (d *APIClient) submitRun(ctx context.Context, submission client.TestRunSubmission) {
+	d.status.recordRun(submission)
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), runRecordTimeout)
+	defer cancel()
+
+	if _, err := d.submit(ctx, spoolRun, submission); err != nil && !errors.Is(err, errSpooled) {
+		log.Printf("Failed to record %s run: %v", submission.Type, err)
+	}
+}
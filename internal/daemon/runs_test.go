@@ -0,0 +1,37 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+func TestRunSubmissionOutcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		outcome client.RunOutcome
+	}{
+		{"success", nil, client.RunOutcomeSuccess},
+		{"failed", errors.New("speedtest command failed"), client.RunOutcomeFailed},
+		{"timeout", fmt.Errorf("speedtest timed out: %w", context.DeadlineExceeded), client.RunOutcomeTimeout},
+		{"spooled", fmt.Errorf("%w: connection refused", errSpooled), client.RunOutcomeSpooled},
+	}
+
+	d := newTestAPIClient(t, "http://127.0.0.1:0")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submission := d.runSubmission(client.Speedtest, client.Scheduled, nil, time.Now(), 0, tt.err)
+			if submission.Outcome != tt.outcome {
+				t.Errorf("outcome = %s, want %s", submission.Outcome, tt.outcome)
+			}
+			if (submission.ErrorMessage != nil) != (tt.err != nil) {
+				t.Errorf("error message = %v for error %v", submission.ErrorMessage, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,503 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+// Kinds of submissions held in the spool
+const (
+	spoolSpeedTest = "speedtest"
+	spoolIperf     = "iperf"
+	spoolRun       = "run"
+	spoolMesh      = "mesh"
+)
+
+const (
+	// spoolMinBackoff and spoolMaxBackoff bound the delay between replay
+	// attempts while the API is unreachable
+	spoolMinBackoff = 5 * time.Second
+	spoolMaxBackoff = 5 * time.Minute
+
+	// spoolIdleInterval is how often an empty spool is rechecked
+	spoolIdleInterval = time.Minute
+
+	// spoolBatchSize is the number of results replayed per batch request
+	spoolBatchSize = 100
+)
+
+// errSpooled is returned when a submission could not be delivered and was
+// left in the spool for the replayer
+var errSpooled = errors.New("submission spooled for replay")
+
+// spoolEntry is a single submission persisted on disk. The payload is the
+// exact request body, so replays keep the original timestamps.
+type spoolEntry struct {
+	Kind      string          `json:"kind"`
+	SpooledAt time.Time       `json:"spooled_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// spool is a durable directory of pending submissions, one file per entry.
+// Entries being delivered are tracked in memory so the replayer never sends
+// the same entry twice concurrently.
+type spool struct {
+	dir        string
+	maxEntries int
+
+	mu       sync.Mutex
+	inflight map[string]bool
+	kick     chan struct{}
+}
+
+func newSpool(dir string, maxEntries int) (*spool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	return &spool{
+		dir:        dir,
+		maxEntries: maxEntries,
+		inflight:   make(map[string]bool),
+		kick:       make(chan struct{}, 1),
+	}, nil
+}
+
+// add persists a submission and marks it in flight for the caller, dropping
+// the oldest entries when the spool is full
+func (s *spool) add(kind string, payload []byte) (string, error) {
+	entry, err := json.Marshal(spoolEntry{
+		Kind:      kind,
+		SpooledAt: time.Now(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := fmt.Sprintf("%020d-%s.json", time.Now().UnixNano(), kind)
+	tmp := filepath.Join(s.dir, "."+name)
+	if err := os.WriteFile(tmp, entry, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write spool entry: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, name)); err != nil {
+		return "", fmt.Errorf("failed to write spool entry: %w", err)
+	}
+	s.inflight[name] = true
+
+	s.trim()
+	return name, nil
+}
+
+// trim drops the oldest idle entries beyond the size bound. Callers must
+// hold the lock.
+func (s *spool) trim() {
+	if s.maxEntries <= 0 {
+		return
+	}
+
+	names := s.list()
+	excess := len(names) - s.maxEntries
+	for _, name := range names {
+		if excess <= 0 {
+			return
+		}
+		if s.inflight[name] {
+			continue
+		}
+		log.Printf("⚠️  Spool full, dropping oldest entry %s", name)
+		os.Remove(filepath.Join(s.dir, name))
+		excess--
+	}
+}
+
+// list returns entry names oldest first. Callers must hold the lock.
+func (s *spool) list() []string {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".json") && !strings.HasPrefix(f.Name(), ".") {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// claim marks the oldest idle entries in flight and returns them
+func (s *spool) claim() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var claimed []string
+	for _, name := range s.list() {
+		if !s.inflight[name] {
+			s.inflight[name] = true
+			claimed = append(claimed, name)
+		}
+	}
+	return claimed
+}
+
+func (s *spool) read(name string) (*spoolEntry, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, err
+	}
+
+	var entry spoolEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// remove deletes a delivered or undeliverable entry
+func (s *spool) remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	os.Remove(filepath.Join(s.dir, name))
+	delete(s.inflight, name)
+}
+
+// release hands an entry back to the replayer
+func (s *spool) release(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.inflight, name)
+}
+
+// wake asks the replayer to retry without waiting for its backoff
+func (s *spool) wake() {
+	select {
+	case s.kick <- struct{}{}:
+	default:
+	}
+}
+
+// depth returns the number of entries waiting in the spool
+func (s *spool) depth() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.list())
+}
+
+// newSubmissionID returns a fresh ID for a submission. The API deduplicates
+// on it, so a submission that is retried or replayed is only stored once.
+func newSubmissionID() *uuid.UUID {
+	id := uuid.New()
+	return &id
+}
+
+// submit spools a submission and delivers it right away. When delivery fails
+// with a transient error the entry stays in the spool for the replayer and
+// errSpooled is returned.
+func (d *APIClient) submit(ctx context.Context, kind string, submission any) (int, error) {
+	payload, err := json.Marshal(d.stampClock(submission))
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode %s submission: %w", kind, err)
+	}
+
+	var name string
+	if d.spool != nil {
+		if name, err = d.spool.add(kind, payload); err != nil {
+			log.Printf("Failed to spool %s submission: %v", kind, err)
+		}
+	}
+
+	id, permanent, err := d.deliver(ctx, kind, payload)
+	if name == "" {
+		return id, err
+	}
+	if err == nil || permanent {
+		d.spool.remove(name)
+		return id, err
+	}
+
+	d.spool.release(name)
+	d.spool.wake()
+	return 0, fmt.Errorf("%w: %v", errSpooled, err)
+}
+
+// deliver sends a submission to the API and returns the ID of the stored
+// record. permanent reports whether a failed delivery would fail again.
+func (d *APIClient) deliver(ctx context.Context, kind string, payload []byte) (id int, permanent bool, err error) {
+	var status int
+	switch kind {
+	case spoolSpeedTest:
+		resp, err := d.client.SubmitSpeedTestWithBodyWithResponse(ctx, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return 0, false, err
+		}
+		if resp.JSON201 != nil {
+			return resp.JSON201.Id, false, nil
+		}
+		if resp.JSON200 != nil {
+			return resp.JSON200.Id, false, nil
+		}
+		status = resp.StatusCode()
+
+	case spoolIperf:
+		resp, err := d.client.SubmitIperfTestWithBodyWithResponse(ctx, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return 0, false, err
+		}
+		if resp.JSON201 != nil {
+			return resp.JSON201.Id, false, nil
+		}
+		if resp.JSON200 != nil {
+			return resp.JSON200.Id, false, nil
+		}
+		status = resp.StatusCode()
+
+	case spoolMesh:
+		resp, err := d.client.SubmitMeshTestWithBodyWithResponse(ctx, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return 0, false, err
+		}
+		if resp.JSON201 != nil {
+			return resp.JSON201.Id, false, nil
+		}
+		if resp.JSON200 != nil {
+			return resp.JSON200.Id, false, nil
+		}
+		status = resp.StatusCode()
+
+	case spoolRun:
+		resp, err := d.client.SubmitRunWithBodyWithResponse(ctx, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return 0, false, err
+		}
+		if resp.JSON201 != nil {
+			return resp.JSON201.Id, false, nil
+		}
+		if resp.JSON200 != nil {
+			return resp.JSON200.Id, false, nil
+		}
+		status = resp.StatusCode()
+
+	default:
+		return 0, true, fmt.Errorf("unknown spool entry kind: %s", kind)
+	}
+
+	return 0, permanentStatus(status), fmt.Errorf("unexpected response submitting %s: %d", kind, status)
+}
+
+// permanentStatus reports whether a response status means the submission
+// will never be accepted. Client errors are permanent, except rate limiting,
+// request timeouts and authentication failures, which are fixed by waiting
+// or by correcting the daemon's API key.
+func permanentStatus(status int) bool {
+	switch status {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests,
+		http.StatusUnauthorized, http.StatusForbidden:
+		return false
+	}
+	return status >= 400 && status < 500
+}
+
+// replaySpool delivers spooled submissions oldest first until the context is
+// cancelled, backing off exponentially while the API is unreachable
+func (d *APIClient) replaySpool(ctx context.Context) {
+	if d.spool == nil {
+		return
+	}
+
+	backoff := spoolMinBackoff
+	for {
+		wait := spoolIdleInterval
+		if delivered, failed := d.replayOnce(ctx); failed {
+			wait = backoff
+			backoff = min(backoff*2, spoolMaxBackoff)
+		} else {
+			if delivered > 0 {
+				log.Printf("📤 Replayed %d spooled submissions", delivered)
+			}
+			backoff = spoolMinBackoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.spool.kick:
+		case <-time.After(wait):
+		}
+	}
+}
+
+// replayOnce attempts every idle entry once, stopping at the first transient
+// failure so entries are delivered in order. Consecutive results are sent
+// in batches; runs are delivered one at a time.
+func (d *APIClient) replayOnce(ctx context.Context) (delivered int, failed bool) {
+	var batch []spooledResult
+	flush := func() {
+		if len(batch) > 0 {
+			n, ok := d.replayBatch(ctx, batch)
+			delivered += n
+			failed = failed || !ok
+			batch = nil
+		}
+	}
+
+	for _, name := range d.spool.claim() {
+		if ctx.Err() != nil || failed {
+			d.spool.release(name)
+			continue
+		}
+
+		entry, err := d.spool.read(name)
+		if err != nil {
+			log.Printf("Dropping unreadable spool entry %s: %v", name, err)
+			d.spool.remove(name)
+			continue
+		}
+
+		if item, ok := batchItem(entry); ok {
+			batch = append(batch, spooledResult{name: name, entry: entry, item: item})
+			if len(batch) >= spoolBatchSize {
+				flush()
+			}
+			continue
+		}
+
+		flush()
+		if failed {
+			d.spool.release(name)
+			continue
+		}
+		sent, ok := d.replayEntry(ctx, name, entry)
+		if sent {
+			delivered++
+		}
+		failed = !ok
+	}
+	flush()
+
+	return delivered, failed
+}
+
+// replayEntry delivers a single entry. ok reports whether the replayer may
+// move on to the next entry, which is also the case when the entry was
+// dropped as undeliverable.
+func (d *APIClient) replayEntry(ctx context.Context, name string, entry *spoolEntry) (delivered, ok bool) {
+	_, permanent, err := d.deliver(ctx, entry.Kind, entry.Payload)
+	switch {
+	case err == nil:
+		d.spool.remove(name)
+		return true, true
+	case permanent:
+		log.Printf("Dropping spooled %s submission from %s: %v",
+			entry.Kind, entry.SpooledAt.Format(time.RFC3339), err)
+		d.spool.remove(name)
+		return false, true
+	default:
+		d.spool.release(name)
+		return false, false
+	}
+}
+
+// spooledResult is a spool entry prepared for batch submission
+type spooledResult struct {
+	name  string
+	entry *spoolEntry
+	item  client.ResultBatchItem
+}
+
+// batchItem converts a result entry into a batch item. Runs and entries that
+// cannot be decoded are not batched.
+func batchItem(entry *spoolEntry) (client.ResultBatchItem, bool) {
+	switch entry.Kind {
+	case spoolSpeedTest:
+		var submission client.SpeedTestSubmission
+		if err := json.Unmarshal(entry.Payload, &submission); err != nil {
+			return client.ResultBatchItem{}, false
+		}
+		return client.ResultBatchItem{Type: client.Speedtest, Speedtest: &submission}, true
+
+	case spoolIperf:
+		var submission client.IperfTestSubmission
+		if err := json.Unmarshal(entry.Payload, &submission); err != nil {
+			return client.ResultBatchItem{}, false
+		}
+		return client.ResultBatchItem{Type: client.Iperf, Iperf: &submission}, true
+	}
+
+	return client.ResultBatchItem{}, false
+}
+
+// replayBatch submits spooled results in a single request. When the API
+// rejects the batch as a whole, e.g. a server without the batch endpoint,
+// the entries are delivered one at a time instead.
+func (d *APIClient) replayBatch(ctx context.Context, batch []spooledResult) (delivered int, ok bool) {
+	items := make([]client.ResultBatchItem, len(batch))
+	for i, result := range batch {
+		items[i] = result.item
+	}
+
+	resp, err := d.client.SubmitResultBatchWithResponse(ctx, client.ResultBatch{Items: items})
+	if err == nil && resp.JSON200 != nil && len(resp.JSON200.Results) == len(batch) {
+		for i, result := range resp.JSON200.Results {
+			if result.Status == client.Invalid {
+				reason := "rejected by the API"
+				if result.Error != nil {
+					reason = *result.Error
+				}
+				log.Printf("Dropping spooled %s submission from %s: %s",
+					batch[i].entry.Kind, batch[i].entry.SpooledAt.Format(time.RFC3339), reason)
+			} else {
+				delivered++
+			}
+			d.spool.remove(batch[i].name)
+		}
+		return delivered, true
+	}
+
+	if err == nil && permanentStatus(resp.StatusCode()) {
+		for i, result := range batch {
+			sent, ok := d.replayEntry(ctx, result.name, result.entry)
+			if sent {
+				delivered++
+			}
+			if !ok {
+				for _, rest := range batch[i+1:] {
+					d.spool.release(rest.name)
+				}
+				return delivered, false
+			}
+		}
+		return delivered, true
+	}
+
+	for _, result := range batch {
+		d.spool.release(result.name)
+	}
+	return 0, false
+}
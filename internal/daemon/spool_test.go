@@ -0,0 +1,176 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+	"github.com/bfirestone/speed-checker/internal/config"
+)
+
+func TestPermanentStatus(t *testing.T) {
+	tests := []struct {
+		status    int
+		permanent bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, true},
+		{http.StatusNotFound, true},
+		{http.StatusUnprocessableEntity, true},
+		{http.StatusUnauthorized, false},
+		{http.StatusForbidden, false},
+		{http.StatusRequestTimeout, false},
+		{http.StatusTooManyRequests, false},
+		{http.StatusInternalServerError, false},
+		{http.StatusBadGateway, false},
+		{http.StatusServiceUnavailable, false},
+	}
+
+	for _, tt := range tests {
+		if got := permanentStatus(tt.status); got != tt.permanent {
+			t.Errorf("permanentStatus(%d) = %v, want %v", tt.status, got, tt.permanent)
+		}
+	}
+}
+
+func TestSpoolTrimDropsOldestIdleEntries(t *testing.T) {
+	s, err := newSpool(t.TempDir(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for range 3 {
+		name, err := s.add(spoolSpeedTest, []byte(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+		s.release(name)
+	}
+
+	if depth := s.depth(); depth != 2 {
+		t.Fatalf("depth = %d, want 2", depth)
+	}
+	claimed := s.claim()
+	if len(claimed) != 2 || claimed[0] != names[1] || claimed[1] != names[2] {
+		t.Fatalf("claimed %v, want the two newest of %v", claimed, names)
+	}
+}
+
+func TestSpoolTrimKeepsInflightEntries(t *testing.T) {
+	s, err := newSpool(t.TempDir(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither entry is released, so both are still being delivered
+	for range 2 {
+		if _, err := s.add(spoolSpeedTest, []byte(`{}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if depth := s.depth(); depth != 2 {
+		t.Fatalf("depth = %d, want 2", depth)
+	}
+	if claimed := s.claim(); len(claimed) != 0 {
+		t.Fatalf("claimed in-flight entries %v", claimed)
+	}
+}
+
+func TestReplayOnce(t *testing.T) {
+	tests := []struct {
+		name      string
+		respond   func(w http.ResponseWriter, items int)
+		delivered int
+		failed    bool
+		remaining int
+	}{
+		{
+			name: "batch accepted",
+			respond: func(w http.ResponseWriter, items int) {
+				writeBatchResponse(w, items, client.Created)
+			},
+			delivered: 3,
+		},
+		{
+			name: "invalid items are dropped",
+			respond: func(w http.ResponseWriter, items int) {
+				writeBatchResponse(w, items, client.Invalid)
+			},
+		},
+		{
+			name: "server unavailable keeps entries",
+			respond: func(w http.ResponseWriter, items int) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			failed:    true,
+			remaining: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/results/batch" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				var batch client.ResultBatch
+				if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				tt.respond(w, len(batch.Items))
+			}))
+			defer server.Close()
+
+			d := newTestAPIClient(t, server.URL)
+			for range 3 {
+				payload, _ := json.Marshal(client.SpeedTestSubmission{DaemonId: d.daemonID, SubmissionId: newSubmissionID()})
+				name, err := d.spool.add(spoolSpeedTest, payload)
+				if err != nil {
+					t.Fatal(err)
+				}
+				d.spool.release(name)
+			}
+
+			delivered, failed := d.replayOnce(context.Background())
+			if delivered != tt.delivered || failed != tt.failed {
+				t.Errorf("replayOnce() = %d, %v, want %d, %v", delivered, failed, tt.delivered, tt.failed)
+			}
+			if depth := d.spool.depth(); depth != tt.remaining {
+				t.Errorf("depth = %d, want %d", depth, tt.remaining)
+			}
+			if claimed := d.spool.claim(); len(claimed) != tt.remaining {
+				t.Errorf("%d entries idle after replay, want %d", len(claimed), tt.remaining)
+			}
+		})
+	}
+}
+
+func writeBatchResponse(w http.ResponseWriter, items int, status client.ResultBatchItemStatus) {
+	response := client.ResultBatchResponse{Results: make([]client.ResultBatchItemResult, items)}
+	for i := range response.Results {
+		id := i + 1
+		response.Results[i] = client.ResultBatchItemResult{Index: i, Id: &id, Status: status}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func newTestAPIClient(t *testing.T, url string) *APIClient {
+	t.Helper()
+
+	dir := t.TempDir()
+	cfg := &config.Config{}
+	cfg.Daemon.StateFile = filepath.Join(dir, "daemon-state.json")
+	cfg.Daemon.SpoolDir = filepath.Join(dir, "spool")
+	cfg.Daemon.SpoolMaxEntries = 100
+	return NewAPIClientWithTransport(url, cfg, "test", http.DefaultTransport)
+}
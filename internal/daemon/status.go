@@ -0,0 +1,396 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/client"
+)
+
+// Daemon modes reported on /status
+const (
+	modeScheduled = "scheduled"
+	modeJobQueue  = "job_queue"
+)
+
+// statusShutdownTimeout bounds how long the status listener waits for
+// in-flight requests when the daemon stops
+const statusShutdownTimeout = 5 * time.Second
+
+// runStatus is the most recent run of a test type
+type runStatus struct {
+	Trigger    client.TestTrigger `json:"trigger"`
+	Outcome    client.RunOutcome  `json:"outcome"`
+	StartedAt  time.Time          `json:"started_at"`
+	FinishedAt time.Time          `json:"finished_at"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// runKey counts runs per test type and outcome
+type runKey struct {
+	testType client.JobType
+	outcome  client.RunOutcome
+}
+
+// statusTracker records what the daemon is doing for the local status
+// listener. Everything is kept in memory and starts empty on restart.
+type statusTracker struct {
+	mu           sync.Mutex
+	startedAt    time.Time
+	mode         string
+	running      bool
+	lastRun      map[client.JobType]runStatus
+	lastSuccess  map[client.JobType]time.Time
+	nextRun      map[client.JobType]time.Time
+	runs         map[runKey]int
+	apiReachable bool
+	apiCheckedAt time.Time
+	apiError     string
+}
+
+func newStatusTracker() *statusTracker {
+	return &statusTracker{
+		startedAt:   time.Now(),
+		lastRun:     make(map[client.JobType]runStatus),
+		lastSuccess: make(map[client.JobType]time.Time),
+		nextRun:     make(map[client.JobType]time.Time),
+		runs:        make(map[runKey]int),
+	}
+}
+
+// setRunning marks the daemon's main loop as started in the given mode, or
+// as stopped
+func (t *statusTracker) setRunning(mode string, running bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.mode = mode
+	t.running = running
+}
+
+// recordRun records a finished, failed or skipped run
+func (t *statusTracker) recordRun(submission client.TestRunSubmission) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	run := runStatus{
+		Outcome:    submission.Outcome,
+		StartedAt:  submission.StartedAt,
+		FinishedAt: submission.FinishedAt,
+	}
+	if submission.Trigger != nil {
+		run.Trigger = *submission.Trigger
+	}
+	if submission.ErrorMessage != nil {
+		run.Error = *submission.ErrorMessage
+	}
+
+	t.lastRun[submission.Type] = run
+	if submission.Outcome == client.RunOutcomeSuccess {
+		t.lastSuccess[submission.Type] = submission.FinishedAt
+	}
+	t.runs[runKey{submission.Type, submission.Outcome}]++
+}
+
+// scheduleNext records when the next scheduled run of a test type is due;
+// a zero time means none is scheduled
+func (t *statusTracker) scheduleNext(testType client.JobType, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if at.IsZero() {
+		delete(t.nextRun, testType)
+		return
+	}
+	t.nextRun[testType] = at
+}
+
+// apiResult records whether the last request reached the API server
+func (t *statusTracker) apiResult(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.apiReachable = err == nil
+	t.apiCheckedAt = time.Now()
+	t.apiError = ""
+	if err != nil {
+		t.apiError = err.Error()
+	}
+}
+
+// reachabilityTransport records on the status tracker whether requests
+// reach the API server. Server errors count as unreachable since nothing
+// can be stored; cancelled requests are not counted at all.
+type reachabilityTransport struct {
+	next   http.RoundTripper
+	status *statusTracker
+}
+
+func (t *reachabilityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	switch {
+	case err != nil:
+		if req.Context().Err() == nil {
+			t.status.apiResult(err)
+		}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		t.status.apiResult(fmt.Errorf("API server returned %s", resp.Status))
+	default:
+		t.status.apiResult(nil)
+	}
+	return resp, err
+}
+
+// scheduleNext records when the next scheduled tests are due, based on the
+// intervals the tickers were just (re)started with
+func (d *APIClient) scheduleNext(settings testingSettings) {
+	d.scheduleNextSpeedTest(settings)
+	d.scheduleNextIperf(settings)
+}
+
+func (d *APIClient) scheduleNextSpeedTest(settings testingSettings) {
+	var at time.Time
+	if settings.speedTestEnabled {
+		at = time.Now().Add(settings.speedTestInterval)
+	}
+	d.status.scheduleNext(client.Speedtest, at)
+}
+
+func (d *APIClient) scheduleNextIperf(settings testingSettings) {
+	var at time.Time
+	if settings.iperfEnabled {
+		at = time.Now().Add(settings.iperfInterval)
+	}
+	d.status.scheduleNext(client.Iperf, at)
+}
+
+// ServeStatus serves the local /healthz, /status and /metrics endpoints on
+// addr until the context is cancelled
+func (d *APIClient) ServeStatus(ctx context.Context, addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", d.handleHealthz)
+	mux.HandleFunc("GET /status", d.handleStatus)
+	mux.HandleFunc("GET /metrics", d.handleMetrics)
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), statusShutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("📡 Daemon status listening on %s (/healthz, /status, /metrics)", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// handleHealthz reports liveness: 200 while the daemon's main loop runs and
+// 503 before it starts and once it drains or stops. An unreachable API does
+// not make the daemon unhealthy, results are spooled until it is back.
+func (d *APIClient) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	d.status.mu.Lock()
+	running := d.status.running
+	d.status.mu.Unlock()
+
+	if d.drain.isDraining() {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "draining"})
+		return
+	}
+	if !running {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// testStatus is the state of one test type on /status
+type testStatus struct {
+	LastRun     *runStatus `json:"last_run,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	NextRun     *time.Time `json:"next_run,omitempty"`
+}
+
+// apiStatus is the API server's reachability on /status
+type apiStatus struct {
+	Endpoint  string     `json:"endpoint"`
+	Reachable bool       `json:"reachable"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// statusResponse is the body of /status
+type statusResponse struct {
+	DaemonID      string                        `json:"daemon_id"`
+	Version       string                        `json:"version"`
+	Mode          string                        `json:"mode"`
+	Running       bool                          `json:"running"`
+	Draining      bool                          `json:"draining"`
+	StartedAt     time.Time                     `json:"started_at"`
+	ConfigVersion string                        `json:"config_version,omitempty"`
+	SpoolDepth    int                           `json:"spool_depth"`
+	ClockOffsetMs *int64                        `json:"clock_offset_ms,omitempty"`
+	API           apiStatus                     `json:"api"`
+	Tests         map[client.JobType]testStatus `json:"tests"`
+}
+
+func (d *APIClient) handleStatus(w http.ResponseWriter, r *http.Request) {
+	configVersion, _ := d.remote.current()
+	spoolDepth := d.spool.depth()
+	offset, measured := d.clock.current()
+
+	d.status.mu.Lock()
+	response := statusResponse{
+		DaemonID:      d.daemonID,
+		Version:       d.version,
+		Mode:          d.status.mode,
+		Running:       d.status.running,
+		Draining:      d.drain.isDraining(),
+		StartedAt:     d.status.startedAt,
+		ConfigVersion: configVersion,
+		SpoolDepth:    spoolDepth,
+		API: apiStatus{
+			Endpoint:  d.client.ClientInterface.(*client.Client).Server,
+			Reachable: d.status.apiReachable,
+			Error:     d.status.apiError,
+		},
+		Tests: make(map[client.JobType]testStatus),
+	}
+	if measured {
+		offsetMs := offset.Milliseconds()
+		response.ClockOffsetMs = &offsetMs
+	}
+	if !d.status.apiCheckedAt.IsZero() {
+		checkedAt := d.status.apiCheckedAt
+		response.API.CheckedAt = &checkedAt
+	}
+	for _, testType := range d.testTypes() {
+		var test testStatus
+		if run, ok := d.status.lastRun[testType]; ok {
+			test.LastRun = &run
+		}
+		if at, ok := d.status.lastSuccess[testType]; ok {
+			test.LastSuccess = &at
+		}
+		if at, ok := d.status.nextRun[testType]; ok {
+			test.NextRun = &at
+		}
+		response.Tests[testType] = test
+	}
+	d.status.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleMetrics writes the daemon's state in the Prometheus text format
+func (d *APIClient) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	configVersion, _ := d.remote.current()
+	spoolDepth := d.spool.depth()
+
+	var b strings.Builder
+	metric := func(name, kind, help string) {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	d.status.mu.Lock()
+
+	metric("speed_checker_daemon_info", "gauge", "Daemon identity, version and applied remote configuration version.")
+	fmt.Fprintf(&b, "speed_checker_daemon_info{daemon_id=%q,version=%q,config_version=%q,mode=%q} 1\n",
+		escapeLabel(d.daemonID), escapeLabel(d.version), escapeLabel(configVersion), escapeLabel(d.status.mode))
+
+	metric("speed_checker_daemon_up", "gauge", "Whether the daemon's main loop is running.")
+	fmt.Fprintf(&b, "speed_checker_daemon_up %d\n", boolMetric(d.status.running))
+
+	metric("speed_checker_daemon_start_time_seconds", "gauge", "Start time of the daemon since the Unix epoch.")
+	fmt.Fprintf(&b, "speed_checker_daemon_start_time_seconds %d\n", d.status.startedAt.Unix())
+
+	metric("speed_checker_daemon_api_reachable", "gauge", "Whether the last request reached the API server.")
+	fmt.Fprintf(&b, "speed_checker_daemon_api_reachable %d\n", boolMetric(d.status.apiReachable))
+
+	metric("speed_checker_daemon_spool_depth", "gauge", "Results waiting in the offline spool.")
+	fmt.Fprintf(&b, "speed_checker_daemon_spool_depth %d\n", spoolDepth)
+
+	if offset, measured := d.clock.current(); measured {
+		metric("speed_checker_daemon_clock_offset_seconds", "gauge", "API server clock minus daemon clock.")
+		fmt.Fprintf(&b, "speed_checker_daemon_clock_offset_seconds %g\n", offset.Seconds())
+	}
+
+	metric("speed_checker_daemon_runs_total", "counter", "Test runs since the daemon started, by type and outcome.")
+	keys := make([]runKey, 0, len(d.status.runs))
+	for key := range d.status.runs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].testType != keys[j].testType {
+			return keys[i].testType < keys[j].testType
+		}
+		return keys[i].outcome < keys[j].outcome
+	})
+	for _, key := range keys {
+		fmt.Fprintf(&b, "speed_checker_daemon_runs_total{type=%q,outcome=%q} %d\n",
+			key.testType, key.outcome, d.status.runs[key])
+	}
+
+	timestamps := func(name, help string, values map[client.JobType]time.Time) {
+		metric(name, "gauge", help)
+		for _, testType := range d.testTypes() {
+			if at, ok := values[testType]; ok {
+				fmt.Fprintf(&b, "%s{type=%q} %d\n", name, testType, at.Unix())
+			}
+		}
+	}
+	lastRun := make(map[client.JobType]time.Time, len(d.status.lastRun))
+	for testType, run := range d.status.lastRun {
+		lastRun[testType] = run.FinishedAt
+	}
+	timestamps("speed_checker_daemon_last_run_timestamp_seconds", "Finish time of the last run since the Unix epoch.", lastRun)
+	timestamps("speed_checker_daemon_last_success_timestamp_seconds", "Finish time of the last successful run since the Unix epoch.", d.status.lastSuccess)
+	timestamps("speed_checker_daemon_next_run_timestamp_seconds", "Time the next scheduled run is due since the Unix epoch.", d.status.nextRun)
+
+	d.status.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write([]byte(b.String()))
+}
+
+// testTypes returns the test types reported on the status endpoints; mesh
+// tests only run on daemons that advertise a mesh address
+func (d *APIClient) testTypes() []client.JobType {
+	if d.config.Daemon.MeshAddress != "" {
+		return []client.JobType{client.Speedtest, client.Iperf, client.Mesh}
+	}
+	return []client.JobType{client.Speedtest, client.Iperf}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// escapeLabel escapes a Prometheus label value; %q adds the quotes and
+// escapes the backslashes and quotes, so only newlines are left
+func escapeLabel(value string) string {
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
+func boolMetric(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -204,6 +204,7 @@ func (h *APIHandler) AddHost(c echo.Context) error {
 		req.Type,
 		req.Description,
 		req.Port,
+		nil,
 	)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -240,6 +241,7 @@ func (h *APIHandler) UpdateHost(c echo.Context) error {
 		req.Description,
 		req.Port,
 		req.Active,
+		nil,
 	)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
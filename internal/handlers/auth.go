@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// apiKeyHeader is the header declared by the ApiKeyAuth security scheme
+const apiKeyHeader = "X-API-Key"
+
+// apiKeyContextKey holds the authenticated *ent.APIKey on the echo context
+const apiKeyContextKey = "api_key"
+
+// clientCertContextKey holds the daemon ID taken from a verified client
+// certificate on the echo context
+const clientCertContextKey = "client_cert_daemon_id"
+
+// submitRoutes are the routes daemons use to report their work. Reads need
+// the read scope and every other write needs admin.
+var submitRoutes = map[string]bool{
+	"/speedtest/results":            true,
+	"/iperf/results":                true,
+	"/mesh/results":                 true,
+	"/results/batch":                true,
+	"/runs":                         true,
+	"/daemons/register":             true,
+	"/daemons/:daemonId/heartbeat":  true,
+	"/daemons/:daemonId/jobs/lease": true,
+	"/jobs/:jobId/complete":         true,
+}
+
+// ClientCertIdentity binds requests made with a verified client certificate
+// to the daemon named by the certificate's common name. Those requests may
+// only act as that daemon, whatever daemon_id they claim.
+func ClientCertIdentity() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			state := ctx.Request().TLS
+			if state == nil || len(state.VerifiedChains) == 0 {
+				return next(ctx)
+			}
+
+			ctx.Set(clientCertContextKey, state.VerifiedChains[0][0].Subject.CommonName)
+
+			scope := requiredScope(ctx.Request().Method, ctx.Path())
+			if daemonID := ctx.Param("daemonId"); daemonID != "" && scope == services.ScopeSubmit && !daemonAllowed(ctx, daemonID) {
+				return forbiddenDaemon(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// APIKeyAuth enforces the X-API-Key security scheme. Each route requires a
+// scope, and keys bound to a daemon may only act as that daemon. Requests
+// with a verified client certificate may submit and read without a key.
+// With anonymousRead, requests without a key may still use read-only routes
+// so the dashboard keeps working.
+func APIKeyAuth(apiKeyService *services.APIKeyService, anonymousRead bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			scope := requiredScope(ctx.Request().Method, ctx.Path())
+
+			secret := ctx.Request().Header.Get(apiKeyHeader)
+			if secret == "" {
+				if anonymousRead && scope == services.ScopeRead {
+					return next(ctx)
+				}
+				if _, ok := ctx.Get(clientCertContextKey).(string); ok && scope != services.ScopeAdmin {
+					return next(ctx)
+				}
+				return ctx.JSON(http.StatusUnauthorized, api.Error{
+					Error:   "unauthorized",
+					Message: "Missing X-API-Key header",
+				})
+			}
+
+			key, err := apiKeyService.Authenticate(ctx.Request().Context(), secret)
+			if err != nil {
+				if errors.Is(err, services.ErrInvalidAPIKey) {
+					return ctx.JSON(http.StatusUnauthorized, api.Error{
+						Error:   "unauthorized",
+						Message: "Invalid or revoked API key",
+					})
+				}
+				log.Printf("Failed to authenticate API key: %v", err)
+				return ctx.JSON(http.StatusInternalServerError, api.Error{
+					Error:   "internal_error",
+					Message: "Failed to authenticate API key",
+				})
+			}
+
+			if !services.HasScope(key, scope) {
+				return ctx.JSON(http.StatusForbidden, api.Error{
+					Error:   "forbidden",
+					Message: "API key lacks the " + scope + " scope",
+				})
+			}
+
+			ctx.Set(apiKeyContextKey, key)
+
+			if daemonID := ctx.Param("daemonId"); daemonID != "" && scope == services.ScopeSubmit && !daemonAllowed(ctx, daemonID) {
+				return forbiddenDaemon(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// requiredScope returns the scope a request needs based on its route
+func requiredScope(method, route string) string {
+	if method == http.MethodGet || method == http.MethodHead {
+		return services.ScopeRead
+	}
+	if submitRoutes[strings.TrimPrefix(route, "/api/v1")] {
+		return services.ScopeSubmit
+	}
+	return services.ScopeAdmin
+}
+
+// daemonAllowed reports whether the request may act as the given daemon.
+// A verified client certificate limits the request to the daemon in its
+// common name, and a bound API key to the daemon it is bound to.
+func daemonAllowed(ctx echo.Context, daemonID string) bool {
+	if certDaemonID, ok := ctx.Get(clientCertContextKey).(string); ok && certDaemonID != daemonID {
+		return false
+	}
+
+	key, ok := ctx.Get(apiKeyContextKey).(*ent.APIKey)
+	return !ok || key.DaemonID == nil || *key.DaemonID == daemonID
+}
+
+func forbiddenDaemon(ctx echo.Context) error {
+	return ctx.JSON(http.StatusForbidden, api.Error{
+		Error:   "forbidden",
+		Message: "Credentials are bound to a different daemon",
+	})
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Campaign Endpoints
+
+// CreateCampaign implements POST /campaigns
+func (h *OpenAPIHandler) CreateCampaign(ctx echo.Context) error {
+	var creation api.CampaignCreation
+	if err := ctx.Bind(&creation); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	created, err := h.campaignService.Create(ctx.Request().Context(), creation)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCampaign) {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		}
+		log.Printf("Failed to create campaign: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "creation_failed",
+			Message: "Failed to create campaign",
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, entCampaignToAPI(created))
+}
+
+// GetCampaigns implements GET /campaigns
+func (h *OpenAPIHandler) GetCampaigns(ctx echo.Context, params api.GetCampaignsParams) error {
+	limit := 50
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	campaigns, err := h.campaignService.GetCampaigns(ctx.Request().Context(), limit)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve campaigns",
+		})
+	}
+
+	results := make([]api.Campaign, len(campaigns))
+	for i, c := range campaigns {
+		results[i] = entCampaignToAPI(c)
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
+
+// GetCampaign implements GET /campaigns/{campaignId}
+func (h *OpenAPIHandler) GetCampaign(ctx echo.Context, campaignId int) error {
+	found, err := h.campaignService.GetCampaign(ctx.Request().Context(), campaignId)
+	if err != nil {
+		return campaignError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, entCampaignToAPI(found))
+}
+
+// GetCampaignReport implements GET /campaigns/{campaignId}/report
+func (h *OpenAPIHandler) GetCampaignReport(ctx echo.Context, campaignId int) error {
+	report, err := h.campaignService.Report(ctx.Request().Context(), campaignId)
+	if err != nil {
+		return campaignError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, campaignReportToAPI(report))
+}
+
+func campaignError(ctx echo.Context, err error) error {
+	if errors.Is(err, services.ErrCampaignNotFound) {
+		return ctx.JSON(http.StatusNotFound, api.Error{
+			Error:   "not_found",
+			Message: "Campaign not found",
+		})
+	}
+	log.Printf("Failed to retrieve campaign: %v", err)
+	return ctx.JSON(http.StatusInternalServerError, api.Error{
+		Error:   "internal_error",
+		Message: "Failed to retrieve campaign",
+	})
+}
+
+func entCampaignToAPI(c *ent.Campaign) api.Campaign {
+	result := api.Campaign{
+		Id:          c.ID,
+		Name:        c.Name,
+		Speedtest:   &c.Speedtest,
+		ScheduledAt: c.ScheduledAt,
+		CreatedAt:   c.CreatedAt,
+		Status:      services.CampaignStatus(c),
+		Daemons:     []string{},
+	}
+
+	if c.Description != "" {
+		result.Description = &c.Description
+	}
+	if len(c.HostIds) > 0 {
+		result.HostIds = &c.HostIds
+	}
+	if len(c.DaemonSelector) > 0 {
+		result.DaemonSelector = &c.DaemonSelector
+	}
+	if len(c.DaemonIds) > 0 {
+		result.DaemonIds = &c.DaemonIds
+	}
+	if c.DurationSeconds != 0 {
+		result.DurationSeconds = &c.DurationSeconds
+	}
+
+	seen := map[string]bool{}
+	for _, j := range c.Edges.Jobs {
+		if !seen[j.DaemonID] {
+			seen[j.DaemonID] = true
+			result.Daemons = append(result.Daemons, j.DaemonID)
+		}
+
+		switch j.Status {
+		case job.StatusPending:
+			result.Jobs.Pending++
+		case job.StatusLeased:
+			result.Jobs.Leased++
+		case job.StatusCompleted:
+			result.Jobs.Completed++
+		case job.StatusFailed:
+			result.Jobs.Failed++
+		}
+	}
+
+	return result
+}
+
+func campaignReportToAPI(report *services.CampaignReport) api.CampaignReport {
+	result := api.CampaignReport{
+		Campaign:     entCampaignToAPI(report.Campaign),
+		Daemons:      make([]api.CampaignDaemonReport, len(report.Daemons)),
+		IperfSummary: []api.CampaignIperfSummary{},
+	}
+
+	for i, d := range report.Daemons {
+		row := api.CampaignDaemonReport{
+			DaemonId: d.DaemonID,
+			Iperf:    make([]api.CampaignIperfResult, len(d.Iperf)),
+		}
+		if d.DaemonName != "" {
+			row.DaemonName = &d.DaemonName
+		}
+		if d.SpeedTestJob != nil {
+			status := api.JobStatus(d.SpeedTestJob.Status)
+			row.SpeedtestJobStatus = &status
+			if d.SpeedTestJob.ErrorMessage != "" {
+				row.SpeedtestError = &d.SpeedTestJob.ErrorMessage
+			}
+		}
+		if t := d.SpeedTest; t != nil {
+			row.Speedtest = &api.CampaignSpeedTestResult{
+				Id:           t.ID,
+				Timestamp:    t.Timestamp,
+				DownloadMbps: t.DownloadMbps,
+				UploadMbps:   t.UploadMbps,
+				PingMs:       t.PingMs,
+				Quarantined:  t.Quarantined,
+			}
+			if t.ServerName != "" {
+				row.Speedtest.ServerName = &t.ServerName
+			}
+		}
+		for k, r := range d.Iperf {
+			row.Iperf[k] = campaignIperfResultToAPI(r)
+		}
+		result.Daemons[i] = row
+	}
+
+	if report.DownloadMbps != nil {
+		result.SpeedtestSummary = &api.CampaignSpeedTestSummary{
+			DownloadMbps: metricSummaryToAPI(*report.DownloadMbps),
+			UploadMbps:   metricSummaryToAPI(*report.UploadMbps),
+			PingMs:       metricSummaryToAPI(*report.PingMs),
+		}
+	}
+
+	// Hosts without a successful result have nothing to summarize
+	for _, h := range report.Hosts {
+		if h.SentMbps == nil {
+			continue
+		}
+		summary := api.CampaignIperfSummary{
+			HostId:       h.HostID,
+			SentMbps:     metricSummaryToAPI(*h.SentMbps),
+			ReceivedMbps: metricSummaryToAPI(*h.ReceivedMbps),
+		}
+		if h.Host != nil {
+			summary.HostName = &h.Host.Name
+		}
+		if h.MeanRttMs != nil {
+			rtt := metricSummaryToAPI(*h.MeanRttMs)
+			summary.MeanRttMs = &rtt
+		}
+		result.IperfSummary = append(result.IperfSummary, summary)
+	}
+
+	return result
+}
+
+func campaignIperfResultToAPI(r services.CampaignIperfResult) api.CampaignIperfResult {
+	result := api.CampaignIperfResult{
+		HostId:    r.HostID,
+		JobStatus: api.JobStatus(r.Job.Status),
+	}
+	if r.Host != nil {
+		result.HostName = &r.Host.Name
+	}
+	if r.Job.ErrorMessage != "" {
+		result.ErrorMessage = &r.Job.ErrorMessage
+	}
+
+	if t := r.Result; t != nil {
+		result.Id = &t.ID
+		result.Timestamp = &t.Timestamp
+		result.Success = &t.Success
+		result.SentMbps = &t.SentMbps
+		result.ReceivedMbps = &t.ReceivedMbps
+		result.Quarantined = &t.Quarantined
+		if t.MeanRttMs > 0 {
+			result.MeanRttMs = &t.MeanRttMs
+		}
+		if t.ErrorMessage != "" {
+			result.ErrorMessage = &t.ErrorMessage
+		}
+	}
+
+	return result
+}
+
+func metricSummaryToAPI(s services.MetricSummary) api.MetricSummary {
+	return api.MetricSummary{
+		Samples: s.Samples,
+		Min:     s.Min,
+		Median:  s.Median,
+		Max:     s.Max,
+	}
+}
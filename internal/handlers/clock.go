@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+const (
+	// serverTimeHeader carries the server's clock on every response so
+	// daemons can measure their clock offset
+	serverTimeHeader = "X-Server-Time"
+
+	// clientTimeHeader carries the daemon's clock when it sent the request
+	clientTimeHeader = "X-Client-Time"
+)
+
+// ServerTime adds the server's clock to every response. It is set before
+// the handler runs, so for long polls it is the time the request arrived.
+func ServerTime() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Response().Header().Set(serverTimeHeader, time.Now().UTC().Format(time.RFC3339Nano))
+			return next(c)
+		}
+	}
+}
+
+// clientTime returns the daemon's clock from the X-Client-Time header, or
+// nil when it is missing or malformed
+func clientTime(ctx echo.Context) *time.Time {
+	value := ctx.Request().Header.Get(clientTimeHeader)
+	if value == "" {
+		return nil
+	}
+
+	sent, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return &sent
+}
+
+func clockSkewError(ctx echo.Context, err error) error {
+	return ctx.JSON(http.StatusUnprocessableEntity, api.Error{
+		Error:   "clock_skew",
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Daemon Configuration Endpoints
+
+// GetDaemonConfigs implements GET /daemon-configs
+func (h *OpenAPIHandler) GetDaemonConfigs(ctx echo.Context) error {
+	configs, err := h.daemonConfigService.List(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve daemon configs",
+		})
+	}
+
+	results := make([]api.DaemonConfig, len(configs))
+	for i, c := range configs {
+		results[i] = entDaemonConfigToAPI(c)
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
+
+// CreateDaemonConfig implements POST /daemon-configs
+func (h *OpenAPIHandler) CreateDaemonConfig(ctx echo.Context) error {
+	var creation api.DaemonConfigCreation
+	if err := ctx.Bind(&creation); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	created, err := h.daemonConfigService.Create(ctx.Request().Context(), creation)
+	if err != nil {
+		return daemonConfigError(ctx, err, "Failed to create daemon config")
+	}
+
+	return ctx.JSON(http.StatusCreated, entDaemonConfigToAPI(created))
+}
+
+// GetDaemonConfig implements GET /daemon-configs/{configId}
+func (h *OpenAPIHandler) GetDaemonConfig(ctx echo.Context, configId int) error {
+	found, err := h.daemonConfigService.Get(ctx.Request().Context(), configId)
+	if err != nil {
+		return daemonConfigError(ctx, err, "Failed to retrieve daemon config")
+	}
+
+	return ctx.JSON(http.StatusOK, entDaemonConfigToAPI(found))
+}
+
+// UpdateDaemonConfig implements PUT /daemon-configs/{configId}
+func (h *OpenAPIHandler) UpdateDaemonConfig(ctx echo.Context, configId int) error {
+	var update api.DaemonConfigCreation
+	if err := ctx.Bind(&update); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	updated, err := h.daemonConfigService.Update(ctx.Request().Context(), configId, update)
+	if err != nil {
+		return daemonConfigError(ctx, err, "Failed to update daemon config")
+	}
+
+	return ctx.JSON(http.StatusOK, entDaemonConfigToAPI(updated))
+}
+
+// DeleteDaemonConfig implements DELETE /daemon-configs/{configId}
+func (h *OpenAPIHandler) DeleteDaemonConfig(ctx echo.Context, configId int) error {
+	if err := h.daemonConfigService.Delete(ctx.Request().Context(), configId); err != nil {
+		return daemonConfigError(ctx, err, "Failed to delete daemon config")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetEffectiveDaemonConfig implements GET /daemons/{daemonId}/config
+func (h *OpenAPIHandler) GetEffectiveDaemonConfig(ctx echo.Context, daemonId string) error {
+	effective, err := h.daemonConfigService.Resolve(ctx.Request().Context(), daemonId)
+	if err != nil {
+		log.Printf("Failed to resolve config for daemon %s: %v", daemonId, err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to resolve daemon config",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, api.EffectiveDaemonConfig{
+		DaemonId: daemonId,
+		Version:  effective.Version,
+		Settings: effective.Settings,
+		Applied:  effective.Applied,
+	})
+}
+
+// withConfigVersion adds the version of the daemon's effective config so
+// the daemon can tell when to fetch it again
+func (h *OpenAPIHandler) withConfigVersion(ctx echo.Context, result api.Daemon) api.Daemon {
+	effective, err := h.daemonConfigService.Resolve(ctx.Request().Context(), result.Id)
+	if err != nil {
+		log.Printf("Failed to resolve config for daemon %s: %v", result.Id, err)
+		return result
+	}
+
+	result.ConfigVersion = &effective.Version
+	return result
+}
+
+func daemonConfigError(ctx echo.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, services.ErrDaemonConfigNotFound):
+		return ctx.JSON(http.StatusNotFound, api.Error{
+			Error:   "not_found",
+			Message: "Daemon config not found",
+		})
+	case errors.Is(err, services.ErrInvalidDaemonConfig):
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	default:
+		log.Printf("%s: %v", message, err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
+
+func entDaemonConfigToAPI(c *ent.DaemonConfig) api.DaemonConfig {
+	result := api.DaemonConfig{
+		Id:        c.ID,
+		Name:      c.Name,
+		DaemonId:  c.DaemonID,
+		Priority:  &c.Priority,
+		Settings:  services.DaemonConfigSettings(c),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+
+	if len(c.MatchLabels) > 0 {
+		result.MatchLabels = &c.MatchLabels
+	}
+
+	return result
+}
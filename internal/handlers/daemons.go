@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Daemon Registry Endpoints
+
+// RegisterDaemon implements POST /daemons/register
+func (h *OpenAPIHandler) RegisterDaemon(ctx echo.Context) error {
+	var registration api.DaemonRegistration
+	if err := ctx.Bind(&registration); err != nil || registration.Id == "" {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if !daemonAllowed(ctx, registration.Id) {
+		return forbiddenDaemon(ctx)
+	}
+
+	registered, err := h.daemonService.Register(ctx.Request().Context(), registration)
+	if err != nil {
+		log.Printf("Failed to register daemon %s: %v", registration.Id, err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "registration_failed",
+			Message: "Failed to register daemon",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, h.withConfigVersion(ctx, h.entDaemonToAPI(registered)))
+}
+
+// HeartbeatDaemon implements POST /daemons/{daemonId}/heartbeat
+func (h *OpenAPIHandler) HeartbeatDaemon(ctx echo.Context, daemonId string) error {
+	var heartbeat api.DaemonHeartbeat
+	if ctx.Request().ContentLength != 0 {
+		if err := ctx.Bind(&heartbeat); err != nil {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	updated, err := h.daemonService.Heartbeat(ctx.Request().Context(), daemonId, heartbeat)
+	if err != nil {
+		if errors.Is(err, services.ErrDaemonNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.Error{
+				Error:   "not_found",
+				Message: "Daemon not registered",
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to record heartbeat",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, h.withConfigVersion(ctx, h.entDaemonToAPI(updated)))
+}
+
+// GetDaemons implements GET /daemons
+func (h *OpenAPIHandler) GetDaemons(ctx echo.Context, params api.GetDaemonsParams) error {
+	var status api.DaemonStatus
+	if params.Status != nil {
+		status = *params.Status
+	}
+
+	daemons, err := h.daemonService.GetDaemons(ctx.Request().Context(), status)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve daemons",
+		})
+	}
+
+	results := make([]api.Daemon, len(daemons))
+	for i, d := range daemons {
+		results[i] = h.entDaemonToAPI(d)
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
+
+// GetDaemon implements GET /daemons/{daemonId}
+func (h *OpenAPIHandler) GetDaemon(ctx echo.Context, daemonId string) error {
+	found, err := h.daemonService.GetDaemon(ctx.Request().Context(), daemonId)
+	if err != nil {
+		if errors.Is(err, services.ErrDaemonNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.Error{
+				Error:   "not_found",
+				Message: "Daemon not found",
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve daemon",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, h.entDaemonToAPI(found))
+}
+
+// resultDaemons resolves the daemon IDs of results to their registered
+// daemons, leaving out IDs that match no registered daemon
+func (h *OpenAPIHandler) resultDaemons(ctx context.Context, ids []string) (map[string]*api.Daemon, error) {
+	found, err := h.daemonService.LookupDaemons(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	daemons := make(map[string]*api.Daemon, len(found))
+	for id, d := range found {
+		converted := h.entDaemonToAPI(d)
+		daemons[id] = &converted
+	}
+	return daemons, nil
+}
+
+func (h *OpenAPIHandler) entDaemonToAPI(d *ent.Daemon) api.Daemon {
+	result := api.Daemon{
+		Id:           d.ID,
+		Status:       h.daemonService.Status(d),
+		RegisteredAt: d.RegisteredAt,
+		LastSeenAt:   d.LastSeenAt,
+		SpoolDepth:   &d.SpoolDepth,
+		Capabilities: &api.DaemonCapabilities{
+			Iperf3:    &d.HasIperf3,
+			Speedtest: &d.HasSpeedtest,
+		},
+	}
+
+	if d.Name != "" {
+		result.Name = &d.Name
+	}
+	if d.Hostname != "" {
+		result.Hostname = &d.Hostname
+	}
+	if d.Version != "" {
+		result.Version = &d.Version
+	}
+	if d.Os != "" {
+		result.Os = &d.Os
+	}
+	if d.Arch != "" {
+		result.Arch = &d.Arch
+	}
+	if len(d.Labels) > 0 {
+		result.Labels = &d.Labels
+	}
+	if d.MeshAddress != "" {
+		result.MeshAddress = &d.MeshAddress
+	}
+
+	return result
+}
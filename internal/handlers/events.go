@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// eventKeepAlive is how often an idle stream sends a comment so proxies do
+// not close the connection
+const eventKeepAlive = 15 * time.Second
+
+// Event Stream Endpoints
+
+// StreamEvents implements GET /events/stream
+func (h *OpenAPIHandler) StreamEvents(ctx echo.Context, params api.StreamEventsParams) error {
+	filter := services.EventFilter{
+		DaemonID: derefString(params.DaemonId, ""),
+		HostID:   params.HostId,
+	}
+	if params.Type != nil {
+		for _, t := range *params.Type {
+			filter.Types = append(filter.Types, services.EventType(t))
+		}
+	}
+
+	sub := h.events.Subscribe(filter)
+	defer h.events.Unsubscribe(sub)
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Keep reverse proxies such as nginx from buffering the stream
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+
+		case event, ok := <-sub.Events:
+			// The bus ended the subscription, the client reconnects
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(h.eventToAPI(event))
+			if err != nil {
+				log.Printf("Failed to encode event %d: %v", event.ID, err)
+				continue
+			}
+			if _, err := fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+func (h *OpenAPIHandler) eventToAPI(event services.Event) api.StreamEvent {
+	result := api.StreamEvent{
+		Id:   int64(event.ID),
+		Type: api.EventType(event.Type),
+		Time: event.Time,
+	}
+	if event.DaemonID != "" {
+		result.DaemonId = &event.DaemonID
+	}
+	if event.HostID != 0 {
+		result.HostId = &event.HostID
+	}
+
+	if event.SpeedTest != nil {
+		speedTest := entSpeedTestToAPI(event.SpeedTest)
+		result.Speedtest = &speedTest
+	}
+	if event.IperfTest != nil {
+		iperfTest := entIperfTestToAPI(event.IperfTest)
+		result.Iperf = &iperfTest
+	}
+	if event.Host != nil {
+		host := entHostToAPI(event.Host)
+		result.Host = &host
+	}
+	if event.Daemon != nil {
+		daemon := h.entDaemonToAPI(event.Daemon)
+		result.Daemon = &daemon
+	}
+	if event.Alert != nil {
+		result.Alert = &api.Alert{
+			Kind:    api.AlertKind(event.Alert.Kind),
+			Message: event.Alert.Message,
+		}
+	}
+
+	return result
+}
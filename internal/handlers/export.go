@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Export Endpoints
+
+// exportContentTypes are the media types of the export formats
+var exportContentTypes = map[services.ExportFormat]string{
+	services.ExportCSV:    "text/csv; charset=utf-8",
+	services.ExportNDJSON: "application/x-ndjson",
+}
+
+// ExportSpeedTests implements GET /export/speedtest
+func (h *OpenAPIHandler) ExportSpeedTests(ctx echo.Context, params api.ExportSpeedTestsParams) error {
+	format, err := exportFormat(ctx, params.Format)
+	if err != nil {
+		return exportError(ctx, err)
+	}
+
+	var sort string
+	if params.Sort != nil {
+		sort = string(*params.Sort)
+	}
+
+	filter := services.SpeedTestFilter{
+		StartTime:   params.StartTime,
+		EndTime:     params.EndTime,
+		DaemonID:    derefString(params.DaemonId, ""),
+		ServerName:  derefString(params.ServerName, ""),
+		Quarantined: derefBool(params.Quarantined, false),
+		Sort:        sort,
+		Limit:       derefInt(params.Limit, 0),
+	}
+
+	startExport(ctx, format, "speedtests")
+	err = h.speedTestService.Export(ctx.Request().Context(), filter, format, ctx.Response())
+	return finishExport(ctx, err)
+}
+
+// ExportIperfTests implements GET /export/iperf
+func (h *OpenAPIHandler) ExportIperfTests(ctx echo.Context, params api.ExportIperfTestsParams) error {
+	format, err := exportFormat(ctx, params.Format)
+	if err != nil {
+		return exportError(ctx, err)
+	}
+
+	var sort, hostType string
+	if params.Sort != nil {
+		sort = string(*params.Sort)
+	}
+	if params.HostType != nil {
+		hostType = string(*params.HostType)
+	}
+
+	filter := services.IperfTestFilter{
+		StartTime:   params.StartTime,
+		EndTime:     params.EndTime,
+		DaemonID:    derefString(params.DaemonId, ""),
+		HostID:      params.HostId,
+		HostName:    derefString(params.HostName, ""),
+		HostType:    hostType,
+		Quarantined: derefBool(params.Quarantined, false),
+		Sort:        sort,
+		Limit:       derefInt(params.Limit, 0),
+	}
+
+	startExport(ctx, format, "iperf-tests")
+	err = h.iperfService.Export(ctx.Request().Context(), filter, format, ctx.Response())
+	return finishExport(ctx, err)
+}
+
+// exportFormat picks the export format from the format parameter, falling
+// back to the Accept header and then CSV
+func exportFormat(ctx echo.Context, format *api.ExportFormat) (services.ExportFormat, error) {
+	if format != nil {
+		return services.ParseExportFormat(string(*format))
+	}
+
+	accept := ctx.Request().Header.Get(echo.HeaderAccept)
+	if strings.Contains(accept, "ndjson") {
+		return services.ExportNDJSON, nil
+	}
+	return services.ExportCSV, nil
+}
+
+// startExport sets the headers of an export download. They only reach the
+// client once the first rows are written.
+func startExport(ctx echo.Context, format services.ExportFormat, name string) {
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, exportContentTypes[format])
+	header.Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102"), format)))
+}
+
+// finishExport reports an export error. Errors after the first rows were
+// sent can only cut the download short.
+func finishExport(ctx echo.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctx.Response().Committed {
+		log.Printf("Export failed after streaming started: %v", err)
+		return nil
+	}
+
+	header := ctx.Response().Header()
+	header.Del(echo.HeaderContentType)
+	header.Del(echo.HeaderContentDisposition)
+	return exportError(ctx, err)
+}
+
+func exportError(ctx echo.Context, err error) error {
+	if errors.Is(err, services.ErrInvalidExport) || errors.Is(err, services.ErrInvalidSort) {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	}
+	log.Printf("Failed to export results: %v", err)
+	return ctx.JSON(http.StatusInternalServerError, api.Error{
+		Error:   "internal_error",
+		Message: "Failed to export results",
+	})
+}
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Job Queue Endpoints
+
+// CreateJob implements POST /jobs
+func (h *OpenAPIHandler) CreateJob(ctx echo.Context) error {
+	var creation api.JobCreation
+	if err := ctx.Bind(&creation); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	created, err := h.jobService.Enqueue(ctx.Request().Context(), creation)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidJob) {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		}
+		log.Printf("Failed to enqueue job: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "creation_failed",
+			Message: "Failed to enqueue job",
+		})
+	}
+
+	return ctx.JSON(http.StatusCreated, entJobToAPI(created))
+}
+
+// GetJobs implements GET /jobs
+func (h *OpenAPIHandler) GetJobs(ctx echo.Context, params api.GetJobsParams) error {
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var status, jobType string
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	if params.Type != nil {
+		jobType = string(*params.Type)
+	}
+
+	jobs, err := h.jobService.GetJobs(ctx.Request().Context(), status, jobType, derefString(params.DaemonId, ""), limit)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve jobs",
+		})
+	}
+
+	results := make([]api.Job, len(jobs))
+	for i, j := range jobs {
+		results[i] = entJobToAPI(j)
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
+
+// LeaseJobs implements POST /daemons/{daemonId}/jobs/lease
+func (h *OpenAPIHandler) LeaseJobs(ctx echo.Context, daemonId string) error {
+	var leaseRequest api.JobLeaseRequest
+	if ctx.Request().ContentLength != 0 {
+		if err := ctx.Bind(&leaseRequest); err != nil {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: "Invalid request body",
+			})
+		}
+	}
+
+	opts := services.LeaseOptions{
+		PinnedOnly: derefBool(leaseRequest.PinnedOnly, false),
+	}
+	if leaseRequest.MaxJobs != nil {
+		opts.MaxJobs = *leaseRequest.MaxJobs
+	}
+	if leaseRequest.LeaseSeconds != nil {
+		opts.LeaseDuration = time.Duration(*leaseRequest.LeaseSeconds) * time.Second
+	}
+	if leaseRequest.WaitSeconds != nil {
+		opts.Wait = time.Duration(clampWaitSeconds(*leaseRequest.WaitSeconds)) * time.Second
+	}
+
+	jobs, err := h.jobService.Lease(ctx.Request().Context(), daemonId, opts)
+	if err != nil {
+		log.Printf("Failed to lease jobs for daemon %s: %v", daemonId, err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to lease jobs",
+		})
+	}
+
+	results := make([]api.Job, len(jobs))
+	for i, j := range jobs {
+		results[i] = entJobToAPI(j)
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
+
+// GetJob implements GET /jobs/{jobId}
+func (h *OpenAPIHandler) GetJob(ctx echo.Context, jobId int, params api.GetJobParams) error {
+	var wait time.Duration
+	if params.WaitSeconds != nil {
+		wait = time.Duration(clampWaitSeconds(*params.WaitSeconds)) * time.Second
+	}
+
+	j, err := h.jobService.GetJob(ctx.Request().Context(), jobId, wait)
+	if err != nil {
+		if errors.Is(err, services.ErrJobNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.Error{
+				Error:   "not_found",
+				Message: "Job not found",
+			})
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve job",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, entJobToAPI(j))
+}
+
+// RunOnDaemon implements POST /daemons/{daemonId}/run
+func (h *OpenAPIHandler) RunOnDaemon(ctx echo.Context, daemonId string) error {
+	var runRequest api.RunRequest
+	if err := ctx.Bind(&runRequest); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	run, err := h.jobService.RunOnDaemon(ctx.Request().Context(), daemonId, runRequest)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidJob) {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		}
+		log.Printf("Failed to queue run on daemon %s: %v", daemonId, err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "creation_failed",
+			Message: "Failed to queue run",
+		})
+	}
+
+	return ctx.JSON(http.StatusAccepted, entJobToAPI(run))
+}
+
+// CompleteJob implements POST /jobs/{jobId}/complete
+func (h *OpenAPIHandler) CompleteJob(ctx echo.Context, jobId int) error {
+	var completion api.JobCompletion
+	if err := ctx.Bind(&completion); err != nil || completion.DaemonId == "" {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if !daemonAllowed(ctx, completion.DaemonId) {
+		return forbiddenDaemon(ctx)
+	}
+
+	completed, err := h.jobService.Complete(ctx.Request().Context(), jobId, completion)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrJobNotFound):
+			return ctx.JSON(http.StatusNotFound, api.Error{
+				Error:   "not_found",
+				Message: "Job not found",
+			})
+		case errors.Is(err, services.ErrJobNotLeased):
+			return ctx.JSON(http.StatusConflict, api.Error{
+				Error:   "lease_conflict",
+				Message: "Job is not leased by this daemon",
+			})
+		}
+		log.Printf("Failed to complete job %d: %v", jobId, err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to complete job",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, entJobToAPI(completed))
+}
+
+func entJobToAPI(j *ent.Job) api.Job {
+	trigger := api.TestTrigger(j.Trigger)
+	result := api.Job{
+		Id:             j.ID,
+		Type:           api.JobType(j.Type),
+		Status:         api.JobStatus(j.Status),
+		Priority:       &j.Priority,
+		Trigger:        &trigger,
+		Attempts:       j.Attempts,
+		MaxAttempts:    j.MaxAttempts,
+		ScheduledAt:    j.ScheduledAt,
+		CreatedAt:      j.CreatedAt,
+		LeaseExpiresAt: j.LeaseExpiresAt,
+		CompletedAt:    j.CompletedAt,
+		ResultId:       j.ResultID,
+		CampaignId:     j.CampaignID,
+	}
+
+	if j.DaemonID != "" {
+		result.DaemonId = &j.DaemonID
+	}
+	if j.DurationSeconds != 0 {
+		result.DurationSeconds = &j.DurationSeconds
+	}
+	if j.TargetDaemonID != "" {
+		result.TargetDaemonId = &j.TargetDaemonID
+	}
+	if j.TargetAddress != "" {
+		result.TargetAddress = &j.TargetAddress
+	}
+	if j.LeasedBy != "" {
+		result.LeasedBy = &j.LeasedBy
+	}
+	if j.ErrorMessage != "" {
+		result.ErrorMessage = &j.ErrorMessage
+	}
+
+	// Check if host edge is loaded
+	if j.Edges.Host != nil {
+		host := entHostToAPI(j.Edges.Host)
+		result.HostId = &host.Id
+		result.Host = &host
+	}
+
+	return result
+}
+
+// clampWaitSeconds bounds long-poll waits to what the spec allows
+func clampWaitSeconds(seconds int) int {
+	if seconds < 0 {
+		return 0
+	}
+	if seconds > 60 {
+		return 60
+	}
+	return seconds
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// defaultMeshWindow is the matrix window when none is requested
+const defaultMeshWindow = 24 * time.Hour
+
+// Mesh Endpoints
+
+// SubmitMeshTest implements POST /mesh/results
+func (h *OpenAPIHandler) SubmitMeshTest(ctx echo.Context) error {
+	var submission api.MeshTestSubmission
+	if err := ctx.Bind(&submission); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+	if !daemonAllowed(ctx, submission.SourceDaemonId) {
+		return forbiddenDaemon(ctx)
+	}
+
+	receipt := h.clock.Receive(submission.Timestamp, clientTime(ctx))
+	meshTest, created, err := h.meshService.CreateFromSubmission(ctx.Request().Context(), submission, receipt)
+	switch {
+	case errors.Is(err, services.ErrClockSkew):
+		return clockSkewError(ctx, err)
+	case errors.Is(err, services.ErrInvalidResult), ent.IsValidationError(err):
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case err != nil:
+		log.Printf("Failed to create mesh test from submission: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "creation_failed",
+			Message: "Failed to save mesh test result",
+		})
+	}
+
+	// Return the created mesh test result, or the stored one for a retry
+	result := entMeshTestToAPI(meshTest)
+	if !created {
+		return ctx.JSON(http.StatusOK, result)
+	}
+	return ctx.JSON(http.StatusCreated, result)
+}
+
+// GetMeshMatrix implements GET /mesh/matrix
+func (h *OpenAPIHandler) GetMeshMatrix(ctx echo.Context, params api.GetMeshMatrixParams) error {
+	window := defaultMeshWindow
+	if params.Window != nil {
+		parsed, err := time.ParseDuration(*params.Window)
+		if err != nil || parsed <= 0 {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: "window must be a positive duration such as 24h",
+			})
+		}
+		window = parsed
+	}
+
+	matrix, err := h.meshService.Matrix(ctx.Request().Context(), window)
+	if err != nil {
+		log.Printf("Failed to compute mesh matrix: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to compute mesh matrix",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, meshMatrixToAPI(matrix))
+}
+
+func entMeshTestToAPI(t *ent.MeshTest) api.MeshTestResult {
+	trigger := api.TestTrigger(t.Trigger)
+	result := api.MeshTestResult{
+		Id:              t.ID,
+		SubmissionId:    t.SubmissionID,
+		Timestamp:       t.Timestamp,
+		SourceDaemonId:  t.SourceDaemonID,
+		TargetDaemonId:  t.TargetDaemonID,
+		SentMbps:        t.SentMbps,
+		ReceivedMbps:    t.ReceivedMbps,
+		DurationSeconds: t.DurationSeconds,
+		Trigger:         &trigger,
+		Success:         t.Success,
+		ReceivedAt:      t.ReceivedAt,
+		Quarantined:     t.Quarantined,
+		ClockOffsetMs:   t.ClockOffsetMs,
+		ClockCorrected:  &t.ClockCorrected,
+	}
+
+	if t.TargetAddress != "" {
+		result.TargetAddress = &t.TargetAddress
+	}
+	if t.MeanRttMs != 0 {
+		result.MeanRttMs = &t.MeanRttMs
+	}
+	if t.Retransmits != 0 {
+		result.Retransmits = &t.Retransmits
+	}
+	if t.ErrorMessage != "" {
+		result.ErrorMessage = &t.ErrorMessage
+	}
+
+	return result
+}
+
+func meshMatrixToAPI(matrix *services.MeshMatrix) api.MeshMatrix {
+	result := api.MeshMatrix{
+		Window:      matrix.Window.String(),
+		GeneratedAt: matrix.GeneratedAt,
+		Daemons:     matrix.Daemons,
+		Cells:       make([]api.MeshMatrixCell, len(matrix.Cells)),
+	}
+
+	for i, cell := range matrix.Cells {
+		result.Cells[i] = api.MeshMatrixCell{
+			SourceDaemonId: cell.SourceDaemonID,
+			TargetDaemonId: cell.TargetDaemonID,
+			Samples:        cell.Samples,
+			Failures:       cell.Failures,
+			Latest:         meshSampleToAPI(cell.Latest),
+		}
+		if cell.Median != nil {
+			median := meshSampleToAPI(*cell.Median)
+			result.Cells[i].Median = &median
+		}
+	}
+
+	return result
+}
+
+func meshSampleToAPI(sample services.MeshSample) api.MeshSample {
+	return api.MeshSample{
+		Timestamp:    sample.Timestamp,
+		Success:      sample.Success,
+		SentMbps:     sample.SentMbps,
+		ReceivedMbps: sample.ReceivedMbps,
+		MeanRttMs:    sample.MeanRttMs,
+	}
+}
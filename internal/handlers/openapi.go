@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -9,21 +10,42 @@ import (
 	"log"
 
 	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
 	"github.com/bfirestone/speed-checker/internal/api"
 	"github.com/bfirestone/speed-checker/internal/services"
 )
 
 // OpenAPIHandler implements the generated ServerInterface
 type OpenAPIHandler struct {
-	speedTestService *services.SpeedTestService
-	iperfService     *services.IperfService
+	speedTestService    *services.SpeedTestService
+	iperfService        *services.IperfService
+	jobService          *services.JobService
+	testRunService      *services.TestRunService
+	daemonService       *services.DaemonService
+	resultService       *services.ResultService
+	daemonConfigService *services.DaemonConfigService
+	meshService         *services.MeshService
+	campaignService     *services.CampaignService
+	statsService        *services.StatsService
+	events              *services.EventBus
+	clock               services.ClockPolicy
 }
 
 // NewOpenAPIHandler creates a new OpenAPI handler
-func NewOpenAPIHandler(speedTestService *services.SpeedTestService, iperfService *services.IperfService) *OpenAPIHandler {
+func NewOpenAPIHandler(speedTestService *services.SpeedTestService, iperfService *services.IperfService, jobService *services.JobService, testRunService *services.TestRunService, daemonService *services.DaemonService, resultService *services.ResultService, daemonConfigService *services.DaemonConfigService, meshService *services.MeshService, campaignService *services.CampaignService, statsService *services.StatsService, events *services.EventBus, clock services.ClockPolicy) *OpenAPIHandler {
 	return &OpenAPIHandler{
-		speedTestService: speedTestService,
-		iperfService:     iperfService,
+		speedTestService:    speedTestService,
+		iperfService:        iperfService,
+		jobService:          jobService,
+		testRunService:      testRunService,
+		daemonService:       daemonService,
+		resultService:       resultService,
+		daemonConfigService: daemonConfigService,
+		meshService:         meshService,
+		campaignService:     campaignService,
+		statsService:        statsService,
+		events:              events,
+		clock:               clock,
 	}
 }
 
@@ -34,59 +56,74 @@ var _ api.ServerInterface = (*OpenAPIHandler)(nil)
 
 // GetSpeedTests implements GET /speedtest/results
 func (h *OpenAPIHandler) GetSpeedTests(ctx echo.Context, params api.GetSpeedTestsParams) error {
-	// Set defaults
-	limit := 100
-	offset := 0
-
-	if params.Limit != nil {
-		limit = *params.Limit
+	limit, offset := resultPage(params.Limit, params.Offset)
+
+	// slowest is the deprecated spelling of sort=download_mbps
+	var sort string
+	if params.Sort != nil {
+		sort = string(*params.Sort)
+	} else if derefBool(params.Slowest, false) {
+		sort = string(api.SpeedTestSortDownloadMbps)
+	}
+
+	// Every filter applies together, and the total counts the filtered set
+	page, err := h.speedTestService.Query(ctx.Request().Context(), services.SpeedTestFilter{
+		StartTime:   params.StartTime,
+		EndTime:     params.EndTime,
+		DaemonID:    derefString(params.DaemonId, ""),
+		ServerName:  derefString(params.ServerName, ""),
+		Quarantined: derefBool(params.Quarantined, false),
+		Sort:        sort,
+		Cursor:      derefString(params.Cursor, ""),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if errors.Is(err, services.ErrInvalidSort) || errors.Is(err, services.ErrInvalidCursor) {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
 	}
-	if params.Offset != nil {
-		offset = *params.Offset
+	if err != nil {
+		log.Printf("Failed to query speed tests: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve speed tests",
+		})
 	}
 
-	// Apply filtering logic similar to legacy API
-	var tests []*ent.SpeedTest
-	var err error
-
-	if params.ServerName != nil && *params.ServerName != "" {
-		tests, err = h.speedTestService.GetTestsByServerName(ctx.Request().Context(), *params.ServerName, limit)
-	} else if params.Slowest != nil && *params.Slowest {
-		tests, err = h.speedTestService.GetSlowestTests(ctx.Request().Context(), limit)
-	} else {
-		tests, err = h.speedTestService.GetRecentTests(ctx.Request().Context(), limit)
+	daemonIDs := make([]string, len(page.Tests))
+	for i, test := range page.Tests {
+		daemonIDs[i] = test.DaemonID
 	}
-
+	daemons, err := h.resultDaemons(ctx.Request().Context(), daemonIDs)
 	if err != nil {
+		log.Printf("Failed to look up daemons of speed tests: %v", err)
 		return ctx.JSON(http.StatusInternalServerError, api.Error{
 			Error:   "internal_error",
 			Message: "Failed to retrieve speed tests",
 		})
 	}
 
-	// Convert Ent models to OpenAPI models
-	results := make([]api.SpeedTestResult, len(tests))
-	for i, test := range tests {
+	// Convert Ent models to OpenAPI models, linking registered daemons
+	results := make([]api.SpeedTestResult, len(page.Tests))
+	for i, test := range page.Tests {
 		results[i] = entSpeedTestToAPI(test)
-	}
-
-	// Get total count for pagination
-	totalCount, err := h.speedTestService.GetTotalCount(ctx.Request().Context())
-	if err != nil {
-		// Log error but don't fail the request
-		totalCount = len(results)
+		results[i].Daemon = daemons[test.DaemonID]
 	}
 
 	response := struct {
-		Results []api.SpeedTestResult `json:"results"`
-		Total   int                   `json:"total"`
-		Limit   int                   `json:"limit"`
-		Offset  int                   `json:"offset"`
+		Results    []api.SpeedTestResult `json:"results"`
+		Total      int                   `json:"total"`
+		Limit      int                   `json:"limit"`
+		Offset     int                   `json:"offset"`
+		NextCursor string                `json:"next_cursor,omitempty"`
 	}{
-		Results: results,
-		Total:   totalCount,
-		Limit:   limit,
-		Offset:  offset,
+		Results:    results,
+		Total:      page.Total,
+		Limit:      limit,
+		Offset:     offset,
+		NextCursor: page.NextCursor,
 	}
 
 	return ctx.JSON(http.StatusOK, response)
@@ -101,10 +138,22 @@ func (h *OpenAPIHandler) SubmitSpeedTest(ctx echo.Context) error {
 			Message: "Invalid request body",
 		})
 	}
+	if !daemonAllowed(ctx, submission.DaemonId) {
+		return forbiddenDaemon(ctx)
+	}
 
 	// Create speed test via service
-	speedTest, err := h.speedTestService.CreateFromSubmission(ctx.Request().Context(), submission)
-	if err != nil {
+	receipt := h.clock.Receive(submission.Timestamp, clientTime(ctx))
+	speedTest, created, err := h.speedTestService.CreateFromSubmission(ctx.Request().Context(), submission, receipt)
+	switch {
+	case errors.Is(err, services.ErrClockSkew):
+		return clockSkewError(ctx, err)
+	case errors.Is(err, services.ErrInvalidResult), ent.IsValidationError(err):
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case err != nil:
 		log.Printf("Failed to create speed test from submission: %v", err)
 		return ctx.JSON(http.StatusInternalServerError, api.Error{
 			Error:   "creation_failed",
@@ -112,8 +161,11 @@ func (h *OpenAPIHandler) SubmitSpeedTest(ctx echo.Context) error {
 		})
 	}
 
-	// Return the created speed test result
+	// Return the created speed test result, or the stored one for a retry
 	result := entSpeedTestToAPI(speedTest)
+	if !created {
+		return ctx.JSON(http.StatusOK, result)
+	}
 	return ctx.JSON(http.StatusCreated, result)
 }
 
@@ -130,65 +182,103 @@ func (h *OpenAPIHandler) DeleteSpeedTest(ctx echo.Context, testId int) error {
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// GetSpeedTestBaseline implements GET /speedtest/baseline
+func (h *OpenAPIHandler) GetSpeedTestBaseline(ctx echo.Context, params api.GetSpeedTestBaselineParams) error {
+	samples := 20
+	if params.Samples != nil {
+		samples = *params.Samples
+	}
+
+	baseline, err := h.speedTestService.GetBaseline(ctx.Request().Context(), derefString(params.DaemonId, ""), samples)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to compute speed test baseline",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, baselineToAPI(baseline))
+}
+
 // Iperf Test Endpoints
 
 // GetIperfTests implements GET /iperf/results
 func (h *OpenAPIHandler) GetIperfTests(ctx echo.Context, params api.GetIperfTestsParams) error {
-	// Set defaults
-	limit := 100
-	offset := 0
-
-	if params.Limit != nil {
-		limit = *params.Limit
+	limit, offset := resultPage(params.Limit, params.Offset)
+
+	var hostType string
+	if params.HostType != nil {
+		hostType = string(*params.HostType)
+	}
+
+	// slowest is the deprecated spelling of sort=received_mbps
+	var sort string
+	if params.Sort != nil {
+		sort = string(*params.Sort)
+	} else if derefBool(params.Slowest, false) {
+		sort = string(api.IperfTestSortReceivedMbps)
+	}
+
+	// Every filter applies together, and the total counts the filtered set
+	page, err := h.iperfService.Query(ctx.Request().Context(), services.IperfTestFilter{
+		StartTime:   params.StartTime,
+		EndTime:     params.EndTime,
+		DaemonID:    derefString(params.DaemonId, ""),
+		HostID:      params.HostId,
+		HostName:    derefString(params.HostName, ""),
+		HostType:    hostType,
+		Quarantined: derefBool(params.Quarantined, false),
+		Sort:        sort,
+		Cursor:      derefString(params.Cursor, ""),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if errors.Is(err, services.ErrInvalidSort) || errors.Is(err, services.ErrInvalidCursor) {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
 	}
-	if params.Offset != nil {
-		offset = *params.Offset
+	if err != nil {
+		log.Printf("Failed to query iperf tests: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve iperf tests",
+		})
 	}
 
-	// Apply filtering logic similar to legacy API
-	var tests []*ent.IperfTest
-	var err error
-
-	if params.HostName != nil && *params.HostName != "" {
-		tests, err = h.iperfService.GetTestsByHostName(ctx.Request().Context(), *params.HostName, limit)
-	} else if params.HostType != nil && string(*params.HostType) != "" {
-		tests, err = h.iperfService.GetTestsByHostType(ctx.Request().Context(), string(*params.HostType), limit)
-	} else if params.Slowest != nil && *params.Slowest {
-		tests, err = h.iperfService.GetSlowestTests(ctx.Request().Context(), limit)
-	} else {
-		tests, err = h.iperfService.GetRecentTests(ctx.Request().Context(), limit)
+	daemonIDs := make([]string, len(page.Tests))
+	for i, test := range page.Tests {
+		daemonIDs[i] = test.DaemonID
 	}
-
+	daemons, err := h.resultDaemons(ctx.Request().Context(), daemonIDs)
 	if err != nil {
+		log.Printf("Failed to look up daemons of iperf tests: %v", err)
 		return ctx.JSON(http.StatusInternalServerError, api.Error{
 			Error:   "internal_error",
 			Message: "Failed to retrieve iperf tests",
 		})
 	}
 
-	// Convert Ent models to OpenAPI models
-	results := make([]api.IperfTestResult, len(tests))
-	for i, test := range tests {
+	// Convert Ent models to OpenAPI models, linking registered daemons
+	results := make([]api.IperfTestResult, len(page.Tests))
+	for i, test := range page.Tests {
 		results[i] = entIperfTestToAPI(test)
-	}
-
-	// Get total count for pagination
-	totalCount, err := h.iperfService.GetTotalCount(ctx.Request().Context())
-	if err != nil {
-		// Log error but don't fail the request
-		totalCount = len(results)
+		results[i].Daemon = daemons[test.DaemonID]
 	}
 
 	response := struct {
-		Results []api.IperfTestResult `json:"results"`
-		Total   int                   `json:"total"`
-		Limit   int                   `json:"limit"`
-		Offset  int                   `json:"offset"`
+		Results    []api.IperfTestResult `json:"results"`
+		Total      int                   `json:"total"`
+		Limit      int                   `json:"limit"`
+		Offset     int                   `json:"offset"`
+		NextCursor string                `json:"next_cursor,omitempty"`
 	}{
-		Results: results,
-		Total:   totalCount,
-		Limit:   limit,
-		Offset:  offset,
+		Results:    results,
+		Total:      page.Total,
+		Limit:      limit,
+		Offset:     offset,
+		NextCursor: page.NextCursor,
 	}
 
 	return ctx.JSON(http.StatusOK, response)
@@ -203,10 +293,22 @@ func (h *OpenAPIHandler) SubmitIperfTest(ctx echo.Context) error {
 			Message: "Invalid request body",
 		})
 	}
+	if !daemonAllowed(ctx, submission.DaemonId) {
+		return forbiddenDaemon(ctx)
+	}
 
 	// Create iperf test via service
-	iperfTest, err := h.iperfService.CreateFromSubmission(ctx.Request().Context(), submission)
-	if err != nil {
+	receipt := h.clock.Receive(submission.Timestamp, clientTime(ctx))
+	iperfTest, created, err := h.iperfService.CreateFromSubmission(ctx.Request().Context(), submission, receipt)
+	switch {
+	case errors.Is(err, services.ErrClockSkew):
+		return clockSkewError(ctx, err)
+	case errors.Is(err, services.ErrInvalidResult), errors.Is(err, services.ErrHostNotFound), ent.IsValidationError(err):
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case err != nil:
 		log.Printf("Failed to create iperf test from submission: %v", err)
 		return ctx.JSON(http.StatusInternalServerError, api.Error{
 			Error:   "creation_failed",
@@ -214,8 +316,11 @@ func (h *OpenAPIHandler) SubmitIperfTest(ctx echo.Context) error {
 		})
 	}
 
-	// Return the created iperf test result
+	// Return the created iperf test result, or the stored one for a retry
 	result := entIperfTestToAPI(iperfTest)
+	if !created {
+		return ctx.JSON(http.StatusOK, result)
+	}
 	return ctx.JSON(http.StatusCreated, result)
 }
 
@@ -232,11 +337,47 @@ func (h *OpenAPIHandler) DeleteIperfTest(ctx echo.Context, testId int) error {
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// GetIperfBaseline implements GET /iperf/baseline
+func (h *OpenAPIHandler) GetIperfBaseline(ctx echo.Context, params api.GetIperfBaselineParams) error {
+	samples := 20
+	if params.Samples != nil {
+		samples = *params.Samples
+	}
+
+	baseline, err := h.iperfService.GetBaseline(ctx.Request().Context(), params.HostId, derefString(params.DaemonId, ""), samples)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to compute iperf baseline",
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, baselineToAPI(baseline))
+}
+
 // Host Management Endpoints
 
 // GetHosts implements GET /hosts
 func (h *OpenAPIHandler) GetHosts(ctx echo.Context, params api.GetHostsParams) error {
-	hosts, err := h.iperfService.GetHosts(ctx.Request().Context())
+	filter := services.HostFilter{
+		Active:   params.Active,
+		DaemonID: derefString(params.DaemonId, ""),
+		Cursor:   derefString(params.Cursor, ""),
+	}
+	if params.Type != nil {
+		filter.Type = string(*params.Type)
+	}
+	if params.Limit != nil {
+		filter.Limit = min(max(*params.Limit, 1), 1000)
+	}
+
+	page, err := h.iperfService.QueryHosts(ctx.Request().Context(), filter)
+	if errors.Is(err, services.ErrInvalidCursor) {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, api.Error{
 			Error:   "internal_error",
@@ -245,11 +386,17 @@ func (h *OpenAPIHandler) GetHosts(ctx echo.Context, params api.GetHostsParams) e
 	}
 
 	// Convert Ent models to OpenAPI models
-	results := make([]api.Host, len(hosts))
-	for i, host := range hosts {
+	results := make([]api.Host, len(page.Hosts))
+	for i, host := range page.Hosts {
 		results[i] = entHostToAPI(host)
 	}
 
+	// The body stays a plain array for existing clients, so the cursor
+	// travels in a header
+	if page.NextCursor != "" {
+		ctx.Response().Header().Set("X-Next-Cursor", page.NextCursor)
+	}
+
 	return ctx.JSON(http.StatusOK, results)
 }
 
@@ -271,6 +418,7 @@ func (h *OpenAPIHandler) AddHost(ctx echo.Context) error {
 		string(hostCreation.Type),
 		derefString(hostCreation.Description, ""),
 		hostCreation.Port,
+		hostScopeFromAPI(hostCreation.DaemonSelector, hostCreation.DaemonIds),
 	)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.Error{
@@ -313,6 +461,7 @@ func (h *OpenAPIHandler) UpdateHost(ctx echo.Context, hostId int) error {
 		derefString(hostUpdate.Description, ""),
 		hostUpdate.Port,
 		derefBool(hostUpdate.Active, true),
+		hostScopeFromAPI(hostUpdate.DaemonSelector, hostUpdate.DaemonIds),
 	)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.Error{
@@ -369,10 +518,40 @@ func (h *OpenAPIHandler) GetDashboard(ctx echo.Context) error {
 		})
 	}
 
+	// Get registered daemons
+	daemons, err := h.daemonService.GetDaemons(ctx.Request().Context(), "")
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve dashboard data",
+		})
+	}
+
 	// Get total counts
 	totalSpeedTests, _ := h.speedTestService.GetTotalCount(ctx.Request().Context())
 	totalIperfTests, _ := h.iperfService.GetTotalCount(ctx.Request().Context())
 
+	// Failures blocked by an upstream host type are counted separately so
+	// the root-cause segment stands out
+	failedIperfTests, blockedIperfTests, _ := h.iperfService.GetFailureCounts(ctx.Request().Context(), time.Now().Add(-24*time.Hour))
+
+	// Averages come from the same aggregation as GET /stats/speedtest
+	var avgDownloadMbps, avgUploadMbps *float64
+	now := time.Now()
+	series, _ := h.statsService.SpeedTestStats(ctx.Request().Context(), services.StatsQuery{
+		Start: now.Add(-24 * time.Hour),
+		End:   now,
+	})
+	if len(series) > 0 && len(series[0].Points) > 0 {
+		metrics := series[0].Points[0].Metrics
+		if d := metrics[speedtest.FieldDownloadMbps]; d != nil {
+			avgDownloadMbps = d.Avg
+		}
+		if d := metrics[speedtest.FieldUploadMbps]; d != nil {
+			avgUploadMbps = d.Avg
+		}
+	}
+
 	// Convert to API models
 	recentSpeedTests := make([]api.SpeedTestResult, len(speedTests))
 	for i, test := range speedTests {
@@ -390,21 +569,34 @@ func (h *OpenAPIHandler) GetDashboard(ctx echo.Context) error {
 		activeHosts[i] = entHostToAPI(host)
 	}
 
+	// Convert daemons to API models
+	fleet := make([]api.Daemon, len(daemons))
+	for i, d := range daemons {
+		fleet[i] = h.entDaemonToAPI(d)
+	}
+
 	// Create dashboard response
 	dashboard := api.DashboardData{
 		RecentSpeedTests: recentSpeedTests,
 		RecentIperfTests: recentIperfTests,
 		ActiveHosts:      activeHosts,
+		Daemons:          &fleet,
 		Statistics: struct {
-			ActiveHosts     *int     `json:"active_hosts,omitempty"`
-			AvgDownloadMbps *float64 `json:"avg_download_mbps,omitempty"`
-			AvgUploadMbps   *float64 `json:"avg_upload_mbps,omitempty"`
-			TotalIperfTests *int     `json:"total_iperf_tests,omitempty"`
-			TotalSpeedTests *int     `json:"total_speed_tests,omitempty"`
+			ActiveHosts       *int     `json:"active_hosts,omitempty"`
+			AvgDownloadMbps   *float64 `json:"avg_download_mbps,omitempty"`
+			AvgUploadMbps     *float64 `json:"avg_upload_mbps,omitempty"`
+			BlockedIperfTests *int     `json:"blocked_iperf_tests,omitempty"`
+			FailedIperfTests  *int     `json:"failed_iperf_tests,omitempty"`
+			TotalIperfTests   *int     `json:"total_iperf_tests,omitempty"`
+			TotalSpeedTests   *int     `json:"total_speed_tests,omitempty"`
 		}{
-			ActiveHosts:     &[]int{len(hosts)}[0],
-			TotalSpeedTests: &totalSpeedTests,
-			TotalIperfTests: &totalIperfTests,
+			ActiveHosts:       &[]int{len(hosts)}[0],
+			TotalSpeedTests:   &totalSpeedTests,
+			TotalIperfTests:   &totalIperfTests,
+			FailedIperfTests:  &failedIperfTests,
+			BlockedIperfTests: &blockedIperfTests,
+			AvgDownloadMbps:   avgDownloadMbps,
+			AvgUploadMbps:     avgUploadMbps,
 		},
 	}
 
@@ -418,21 +610,38 @@ func entSpeedTestToAPI(test *ent.SpeedTest) api.SpeedTestResult {
 	if daemonId == "" {
 		daemonId = "daemon-legacy" // Fallback for tests without daemon_id
 	}
+	trigger := api.TestTrigger(test.Trigger)
 
 	return api.SpeedTestResult{
-		Id:           test.ID,
-		Timestamp:    test.Timestamp,
-		DownloadMbps: test.DownloadMbps,
-		UploadMbps:   test.UploadMbps,
-		PingMs:       test.PingMs,
-		DaemonId:     daemonId,
-		CreatedAt:    test.Timestamp, // Use timestamp as created_at for now
-		ServerName:   &test.ServerName,
-		ServerId:     &test.ServerID,
-		Isp:          &test.Isp,
-		ExternalIp:   &test.ExternalIP,
-		ResultUrl:    &test.ResultURL,
+		Id:             test.ID,
+		Timestamp:      test.Timestamp,
+		DownloadMbps:   test.DownloadMbps,
+		UploadMbps:     test.UploadMbps,
+		PingMs:         test.PingMs,
+		DaemonId:       daemonId,
+		CreatedAt:      createdAt(test.Timestamp, test.ReceivedAt),
+		ReceivedAt:     test.ReceivedAt,
+		ServerName:     &test.ServerName,
+		ServerId:       &test.ServerID,
+		Isp:            &test.Isp,
+		ExternalIp:     &test.ExternalIP,
+		ResultUrl:      &test.ResultURL,
+		Trigger:        &trigger,
+		SubmissionId:   test.SubmissionID,
+		ClockOffsetMs:  test.ClockOffsetMs,
+		ClockCorrected: &test.ClockCorrected,
+		Quarantined:    &test.Quarantined,
+		CampaignId:     test.CampaignID,
+	}
+}
+
+// createdAt is when a result was stored. Results stored before received_at
+// was recorded fall back to their measurement timestamp.
+func createdAt(timestamp time.Time, receivedAt *time.Time) time.Time {
+	if receivedAt != nil {
+		return *receivedAt
 	}
+	return timestamp
 }
 
 func entIperfTestToAPI(test *ent.IperfTest) api.IperfTestResult {
@@ -440,6 +649,7 @@ func entIperfTestToAPI(test *ent.IperfTest) api.IperfTestResult {
 	if daemonId == "" {
 		daemonId = "daemon-legacy" // Fallback for tests without daemon_id
 	}
+	trigger := api.TestTrigger(test.Trigger)
 
 	result := api.IperfTestResult{
 		Id:              test.ID,
@@ -449,10 +659,25 @@ func entIperfTestToAPI(test *ent.IperfTest) api.IperfTestResult {
 		Protocol:        api.IperfTestResultProtocol(test.Protocol),
 		DurationSeconds: test.DurationSeconds,
 		DaemonId:        daemonId,
-		CreatedAt:       test.Timestamp, // Use timestamp as created_at for now
+		CreatedAt:       createdAt(test.Timestamp, test.ReceivedAt),
+		ReceivedAt:      test.ReceivedAt,
 		Success:         &test.Success,
 		MeanRttMs:       &test.MeanRttMs,
 		Retransmits:     &test.Retransmits,
+		Trigger:         &trigger,
+		SubmissionId:    test.SubmissionID,
+		ClockOffsetMs:   test.ClockOffsetMs,
+		ClockCorrected:  &test.ClockCorrected,
+		Quarantined:     &test.Quarantined,
+		CampaignId:      test.CampaignID,
+	}
+
+	if test.ErrorMessage != "" {
+		result.ErrorMessage = &test.ErrorMessage
+	}
+	if test.BlockedBy != "" {
+		blockedBy := api.HostType(test.BlockedBy)
+		result.BlockedBy = &blockedBy
 	}
 
 	// Check if host edge is loaded
@@ -468,7 +693,7 @@ func entIperfTestToAPI(test *ent.IperfTest) api.IperfTestResult {
 func entHostToAPI(host *ent.Host) api.Host {
 	// TODO: Add timestamp fields to Host schema
 	now := time.Now()
-	return api.Host{
+	result := api.Host{
 		Id:          host.ID,
 		Name:        host.Name,
 		Hostname:    host.Hostname,
@@ -479,6 +704,39 @@ func entHostToAPI(host *ent.Host) api.Host {
 		CreatedAt:   now, // Placeholder until we add timestamps to schema
 		UpdatedAt:   now, // Placeholder until we add timestamps to schema
 	}
+	if len(host.DaemonSelector) > 0 {
+		result.DaemonSelector = &host.DaemonSelector
+	}
+	if len(host.DaemonIds) > 0 {
+		result.DaemonIds = &host.DaemonIds
+	}
+
+	return result
+}
+
+// hostScopeFromAPI builds the daemon scope of a host creation or update; a
+// host without a selector or daemon IDs is tested by every daemon
+func hostScopeFromAPI(selector *map[string]string, daemonIDs *[]string) *services.HostScope {
+	scope := &services.HostScope{}
+	if selector != nil {
+		scope.DaemonSelector = *selector
+	}
+	if daemonIDs != nil {
+		scope.DaemonIDs = *daemonIDs
+	}
+	return scope
+}
+
+func baselineToAPI(baseline *services.Baseline) api.Baseline {
+	result := api.Baseline{
+		Samples:      baseline.Samples,
+		DownloadMbps: baseline.DownloadMbps,
+		UploadMbps:   baseline.UploadMbps,
+	}
+	if baseline.PingMs > 0 {
+		result.PingMs = &baseline.PingMs
+	}
+	return result
 }
 
 // Helper functions for pointer dereferencing
@@ -489,9 +747,24 @@ func derefString(ptr *string, defaultValue string) string {
 	return defaultValue
 }
 
+func derefInt(ptr *int, defaultValue int) int {
+	if ptr != nil {
+		return *ptr
+	}
+	return defaultValue
+}
+
 func derefBool(ptr *bool, defaultValue bool) bool {
 	if ptr != nil {
 		return *ptr
 	}
 	return defaultValue
 }
+
+// resultPage applies the spec's defaults and bounds to a result listing's
+// limit and offset
+func resultPage(limitParam, offsetParam *int) (limit, offset int) {
+	limit = min(max(derefInt(limitParam, 100), 1), 1000)
+	offset = max(derefInt(offsetParam, 0), 0)
+	return limit, offset
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// maxBatchItems bounds a single batch so one request cannot hold a
+// transaction open indefinitely
+const maxBatchItems = 1000
+
+// Batch Result Endpoints
+
+// SubmitResultBatch implements POST /results/batch
+func (h *OpenAPIHandler) SubmitResultBatch(ctx echo.Context) error {
+	var batch api.ResultBatch
+	if err := ctx.Bind(&batch); err != nil || len(batch.Items) == 0 {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+	if len(batch.Items) > maxBatchItems {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: fmt.Sprintf("Batch exceeds the maximum of %d items", maxBatchItems),
+		})
+	}
+
+	for _, item := range batch.Items {
+		if item.Speedtest != nil && !daemonAllowed(ctx, item.Speedtest.DaemonId) ||
+			item.Iperf != nil && !daemonAllowed(ctx, item.Iperf.DaemonId) {
+			return forbiddenDaemon(ctx)
+		}
+	}
+
+	results, err := h.resultService.SubmitBatch(ctx.Request().Context(), batch.Items, clientTime(ctx))
+	if err != nil {
+		log.Printf("Failed to save result batch: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "creation_failed",
+			Message: "Failed to save result batch",
+		})
+	}
+
+	response := api.ResultBatchResponse{
+		Results: make([]api.ResultBatchItemResult, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = batchItemResultToAPI(i, result)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func batchItemResultToAPI(index int, result services.BatchItemResult) api.ResultBatchItemResult {
+	item := api.ResultBatchItemResult{
+		Index:  index,
+		Status: api.ResultBatchItemStatus(result.Status),
+	}
+
+	if result.Status != services.BatchInvalid {
+		id := result.ID
+		item.Id = &id
+	}
+	if result.Err != nil {
+		message := result.Err.Error()
+		item.Error = &message
+	}
+
+	return item
+}
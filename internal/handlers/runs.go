@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Run History Endpoints
+
+// SubmitRun implements POST /runs
+func (h *OpenAPIHandler) SubmitRun(ctx echo.Context) error {
+	var submission api.TestRunSubmission
+	if err := ctx.Bind(&submission); err != nil || submission.DaemonId == "" {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: "Invalid request body",
+		})
+	}
+
+	if !daemonAllowed(ctx, submission.DaemonId) {
+		return forbiddenDaemon(ctx)
+	}
+
+	run, created, err := h.testRunService.CreateFromSubmission(ctx.Request().Context(), submission)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRun) {
+			return ctx.JSON(http.StatusBadRequest, api.Error{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		}
+		log.Printf("Failed to record run: %v", err)
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "creation_failed",
+			Message: "Failed to record run",
+		})
+	}
+
+	if !created {
+		return ctx.JSON(http.StatusOK, entTestRunToAPI(run))
+	}
+	return ctx.JSON(http.StatusCreated, entTestRunToAPI(run))
+}
+
+// GetRuns implements GET /runs
+func (h *OpenAPIHandler) GetRuns(ctx echo.Context, params api.GetRunsParams) error {
+	limit := 100
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	runs, err := h.testRunService.GetRuns(ctx.Request().Context(), params, limit)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.Error{
+			Error:   "internal_error",
+			Message: "Failed to retrieve runs",
+		})
+	}
+
+	results := make([]api.TestRun, len(runs))
+	for i, r := range runs {
+		results[i] = entTestRunToAPI(r)
+	}
+
+	return ctx.JSON(http.StatusOK, results)
+}
+
+func entTestRunToAPI(r *ent.TestRun) api.TestRun {
+	trigger := api.TestTrigger(r.Trigger)
+	result := api.TestRun{
+		Id:           r.ID,
+		DaemonId:     r.DaemonID,
+		Type:         api.JobType(r.Type),
+		Trigger:      &trigger,
+		Outcome:      api.RunOutcome(r.Outcome),
+		StartedAt:    r.StartedAt,
+		FinishedAt:   r.FinishedAt,
+		HostId:       r.HostID,
+		SpeedTestId:  r.SpeedTestID,
+		IperfTestId:  r.IperfTestID,
+		MeshTestId:   r.MeshTestID,
+		SubmissionId: r.SubmissionID,
+	}
+
+	if r.ErrorMessage != "" {
+		result.ErrorMessage = &r.ErrorMessage
+	}
+	if r.TargetDaemonID != "" {
+		result.TargetDaemonId = &r.TargetDaemonID
+	}
+
+	// Check if host edge is loaded
+	if r.Edges.Host != nil {
+		host := entHostToAPI(r.Edges.Host)
+		result.Host = &host
+	}
+
+	return result
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/services"
+)
+
+// Stats Endpoints
+
+// statsBuckets maps bucket names to their widths
+var statsBuckets = map[api.StatsBucket]time.Duration{
+	api.StatsBucket5m: 5 * time.Minute,
+	api.StatsBucket1h: time.Hour,
+	api.StatsBucket1d: 24 * time.Hour,
+}
+
+// GetSpeedTestStats implements GET /stats/speedtest
+func (h *OpenAPIHandler) GetSpeedTestStats(ctx echo.Context, params api.GetSpeedTestStatsParams) error {
+	var groupBy []string
+	if params.GroupBy != nil {
+		for _, g := range *params.GroupBy {
+			groupBy = append(groupBy, string(g))
+		}
+	}
+
+	query, err := statsQuery(params.StartTime, params.EndTime, params.Bucket, groupBy, params.DaemonId)
+	if err != nil {
+		return statsError(ctx, err)
+	}
+
+	series, err := h.statsService.SpeedTestStats(ctx.Request().Context(), query)
+	if err != nil {
+		return statsError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, statsToAPI(query, params.Bucket, series))
+}
+
+// GetIperfStats implements GET /stats/iperf
+func (h *OpenAPIHandler) GetIperfStats(ctx echo.Context, params api.GetIperfStatsParams) error {
+	var groupBy []string
+	if params.GroupBy != nil {
+		for _, g := range *params.GroupBy {
+			groupBy = append(groupBy, string(g))
+		}
+	}
+
+	query, err := statsQuery(params.StartTime, params.EndTime, params.Bucket, groupBy, params.DaemonId)
+	if err != nil {
+		return statsError(ctx, err)
+	}
+
+	series, err := h.statsService.IperfStats(ctx.Request().Context(), query)
+	if err != nil {
+		return statsError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, statsToAPI(query, params.Bucket, series))
+}
+
+// statsQuery builds a stats query from request parameters. The range
+// defaults to the 24 hours before end_time, which defaults to now.
+func statsQuery(startTime, endTime *time.Time, bucket *api.StatsBucket, groupBy []string, daemonID *string) (services.StatsQuery, error) {
+	query := services.StatsQuery{
+		End:      time.Now().UTC(),
+		GroupBy:  groupBy,
+		DaemonID: derefString(daemonID, ""),
+	}
+	if endTime != nil {
+		query.End = *endTime
+	}
+	query.Start = query.End.Add(-24 * time.Hour)
+	if startTime != nil {
+		query.Start = *startTime
+	}
+
+	if bucket != nil {
+		width, ok := statsBuckets[*bucket]
+		if !ok {
+			return services.StatsQuery{}, fmt.Errorf("%w: bucket must be one of 5m, 1h, 1d", services.ErrInvalidStats)
+		}
+		query.Bucket = width
+	}
+
+	return query, nil
+}
+
+func statsError(ctx echo.Context, err error) error {
+	if errors.Is(err, services.ErrInvalidStats) {
+		return ctx.JSON(http.StatusBadRequest, api.Error{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	}
+	log.Printf("Failed to compute stats: %v", err)
+	return ctx.JSON(http.StatusInternalServerError, api.Error{
+		Error:   "internal_error",
+		Message: "Failed to compute statistics",
+	})
+}
+
+func statsToAPI(query services.StatsQuery, bucket *api.StatsBucket, series []services.StatsSeries) api.Stats {
+	result := api.Stats{
+		StartTime: query.Start,
+		EndTime:   query.End,
+		Bucket:    bucket,
+		GroupBy:   query.GroupBy,
+		Series:    make([]api.StatsSeries, len(series)),
+	}
+	if result.GroupBy == nil {
+		result.GroupBy = []string{}
+	}
+
+	for i, s := range series {
+		row := api.StatsSeries{
+			Group:  statsGroupToAPI(s.Group),
+			Points: make([]api.StatsPoint, len(s.Points)),
+		}
+		for j, p := range s.Points {
+			point := api.StatsPoint{
+				Start:       p.Start,
+				Count:       p.Count,
+				FailureRate: p.FailureRate,
+				Metrics:     map[string]api.Distribution{},
+			}
+			for name, d := range p.Metrics {
+				if d == nil {
+					continue
+				}
+				point.Metrics[name] = api.Distribution{
+					Min: d.Min,
+					Avg: d.Avg,
+					P50: d.P50,
+					P90: d.P90,
+					P95: d.P95,
+					P99: d.P99,
+					Max: d.Max,
+				}
+			}
+			row.Points[j] = point
+		}
+		result.Series[i] = row
+	}
+
+	return result
+}
+
+func statsGroupToAPI(g services.StatsGroup) api.StatsGroup {
+	var group api.StatsGroup
+	if g.DaemonID != "" {
+		group.DaemonId = &g.DaemonID
+	}
+	if g.HostID != 0 {
+		group.HostId = &g.HostID
+	}
+	if g.HostName != "" {
+		group.HostName = &g.HostName
+	}
+	if g.HostType != "" {
+		hostType := api.HostType(g.HostType)
+		group.HostType = &hostType
+	}
+	if g.ISP != "" {
+		group.Isp = &g.ISP
+	}
+	if g.ServerName != "" {
+		group.ServerName = &g.ServerName
+	}
+	return group
+}
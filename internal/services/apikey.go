@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/apikey"
+)
+
+// API key scopes. Admin implies every other scope.
+const (
+	ScopeSubmit = "submit"
+	ScopeRead   = "read"
+	ScopeAdmin  = "admin"
+)
+
+// apiKeyPrefix marks speed-checker keys so they are easy to recognise
+const apiKeyPrefix = "sck_"
+
+// lastUsedResolution limits how often last_used_at is written for a key
+// that is used on every request
+const lastUsedResolution = time.Minute
+
+var (
+	// ErrInvalidAPIKey is returned when a key is unknown or revoked
+	ErrInvalidAPIKey = errors.New("invalid API key")
+	// ErrAPIKeyNotFound is returned when a key ID does not exist
+	ErrAPIKeyNotFound = errors.New("API key not found")
+)
+
+// APIKeyService issues, verifies and revokes API keys. Only a hash of each
+// key is stored, so a key is shown once when it is created.
+type APIKeyService struct {
+	client *ent.Client
+}
+
+func NewAPIKeyService(client *ent.Client) *APIKeyService {
+	return &APIKeyService{
+		client: client,
+	}
+}
+
+// Create issues a new key with the given scopes, optionally bound to a
+// daemon ID, and returns the stored record along with the plaintext key
+func (s *APIKeyService) Create(ctx context.Context, name string, scopes []string, daemonID string) (*ent.APIKey, string, error) {
+	if name == "" {
+		return nil, "", fmt.Errorf("API key name is required")
+	}
+	if len(scopes) == 0 {
+		return nil, "", fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if scope != ScopeSubmit && scope != ScopeRead && scope != ScopeAdmin {
+			return nil, "", fmt.Errorf("invalid scope '%s'. Must be one of: submit, read, admin", scope)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key := apiKeyPrefix + hex.EncodeToString(secret)
+
+	builder := s.client.APIKey.
+		Create().
+		SetName(name).
+		SetPrefix(key[:len(apiKeyPrefix)+8]).
+		SetKeyHash(hashAPIKey(key)).
+		SetScopes(scopes)
+	if daemonID != "" {
+		builder.SetDaemonID(daemonID)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to save API key: %w", err)
+	}
+
+	log.Printf("API key created - ID: %d, Name: %s, Scopes: %v", created.ID, created.Name, created.Scopes)
+	return created, key, nil
+}
+
+// List returns every key, including revoked ones
+func (s *APIKeyService) List(ctx context.Context) ([]*ent.APIKey, error) {
+	return s.client.APIKey.Query().
+		Order(ent.Asc(apikey.FieldID)).
+		All(ctx)
+}
+
+// Revoke marks a key as revoked so it is rejected from now on
+func (s *APIKeyService) Revoke(ctx context.Context, id int) error {
+	err := s.client.APIKey.UpdateOneID(id).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key %d: %w", id, err)
+	}
+
+	log.Printf("API key revoked - ID: %d", id)
+	return nil
+}
+
+// Authenticate returns the active key matching the plaintext key
+func (s *APIKeyService) Authenticate(ctx context.Context, key string) (*ent.APIKey, error) {
+	found, err := s.client.APIKey.Query().
+		Where(apikey.KeyHash(hashAPIKey(key)), apikey.RevokedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+
+	if found.LastUsedAt == nil || time.Since(*found.LastUsedAt) > lastUsedResolution {
+		if err := s.client.APIKey.UpdateOne(found).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			log.Printf("Failed to update last use of API key %d: %v", found.ID, err)
+		}
+	}
+
+	return found, nil
+}
+
+// HasScope reports whether a key grants the scope
+func HasScope(key *ent.APIKey, scope string) bool {
+	return slices.Contains(key.Scopes, ScopeAdmin) || slices.Contains(key.Scopes, scope)
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
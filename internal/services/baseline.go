@@ -0,0 +1,28 @@
+package services
+
+import "sort"
+
+// Baseline is a rolling median of recent results for a target, used to
+// detect when a new result is degraded
+type Baseline struct {
+	Samples      int
+	DownloadMbps float64
+	UploadMbps   float64
+	PingMs       float64
+}
+
+// median returns the median of values, or 0 for an empty slice
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
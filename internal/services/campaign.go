@@ -0,0 +1,440 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/campaign"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+var (
+	// ErrInvalidCampaign is returned when a campaign cannot be scheduled
+	ErrInvalidCampaign = errors.New("invalid campaign")
+	// ErrCampaignNotFound is returned when a campaign ID does not exist
+	ErrCampaignNotFound = errors.New("campaign not found")
+)
+
+// CampaignService schedules the same tests on many daemons at the same time
+// and compares their results
+type CampaignService struct {
+	client  *ent.Client
+	jobs    *JobService
+	daemons *DaemonService
+}
+
+func NewCampaignService(client *ent.Client, jobs *JobService, daemons *DaemonService) *CampaignService {
+	return &CampaignService{
+		client:  client,
+		jobs:    jobs,
+		daemons: daemons,
+	}
+}
+
+// MetricSummary is the spread of a metric across the daemons of a campaign
+type MetricSummary struct {
+	Samples int
+	Min     float64
+	Median  float64
+	Max     float64
+}
+
+// CampaignIperfResult is a daemon's iperf job against one campaign host and
+// the result it submitted, if any
+type CampaignIperfResult struct {
+	HostID int
+	Host   *ent.Host
+	Job    *ent.Job
+	Result *ent.IperfTest
+}
+
+// CampaignDaemonResult is one daemon's jobs and results in a campaign
+type CampaignDaemonResult struct {
+	DaemonID     string
+	DaemonName   string
+	SpeedTestJob *ent.Job
+	SpeedTest    *ent.SpeedTest
+	Iperf        []CampaignIperfResult
+}
+
+// CampaignHostSummary summarizes the successful iperf results against a host
+type CampaignHostSummary struct {
+	HostID       int
+	Host         *ent.Host
+	SentMbps     *MetricSummary
+	ReceivedMbps *MetricSummary
+	MeanRttMs    *MetricSummary
+}
+
+// CampaignReport compares the results of a campaign across its daemons
+type CampaignReport struct {
+	Campaign     *ent.Campaign
+	Daemons      []CampaignDaemonResult
+	DownloadMbps *MetricSummary
+	UploadMbps   *MetricSummary
+	PingMs       *MetricSummary
+	Hosts        []CampaignHostSummary
+}
+
+// Create schedules a campaign. A pinned, single-attempt job is enqueued per
+// matching daemon and test, due at the campaign's scheduled time, so every
+// daemon leases its jobs at the same moment. Daemons only get the tests
+// they have the tools for, against the hosts whose scope allows them.
+func (s *CampaignService) Create(ctx context.Context, creation api.CampaignCreation) (*ent.Campaign, error) {
+	// Repeated hosts are tested once
+	var hostIDs []int
+	if creation.HostIds != nil {
+		hostIDs = uniqueInts(*creation.HostIds)
+	}
+	speedTest := creation.Speedtest != nil && *creation.Speedtest
+	if !speedTest && len(hostIDs) == 0 {
+		return nil, fmt.Errorf("%w: a campaign needs speedtest or host_ids", ErrInvalidCampaign)
+	}
+	if creation.DurationSeconds != nil && *creation.DurationSeconds < 1 {
+		return nil, fmt.Errorf("%w: duration_seconds must be at least 1", ErrInvalidCampaign)
+	}
+
+	hosts, err := s.client.Host.Query().Where(host.IDIn(hostIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign hosts: %w", err)
+	}
+	if len(hosts) != len(hostIDs) {
+		return nil, fmt.Errorf("%w: one or more host_ids do not exist", ErrInvalidCampaign)
+	}
+	hostsByID := make(map[int]*ent.Host, len(hosts))
+	for _, h := range hosts {
+		hostsByID[h.ID] = h
+	}
+
+	scope := HostScope{}
+	if creation.DaemonSelector != nil {
+		scope.DaemonSelector = *creation.DaemonSelector
+	}
+	if creation.DaemonIds != nil {
+		scope.DaemonIDs = *creation.DaemonIds
+	}
+
+	candidates, err := s.client.Daemon.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query daemons: %w", err)
+	}
+
+	scheduledAt := time.Now()
+	if creation.ScheduledAt != nil {
+		scheduledAt = *creation.ScheduledAt
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	builder := tx.Campaign.
+		Create().
+		SetName(creation.Name).
+		SetSpeedtest(speedTest).
+		SetHostIds(hostIDs).
+		SetDaemonSelector(scope.DaemonSelector).
+		SetDaemonIds(scope.DaemonIDs).
+		SetScheduledAt(scheduledAt)
+	if creation.Description != nil {
+		builder.SetDescription(*creation.Description)
+	}
+	if creation.DurationSeconds != nil {
+		builder.SetDurationSeconds(*creation.DurationSeconds)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCampaign, err)
+		}
+		return nil, fmt.Errorf("failed to create campaign: %w", err)
+	}
+
+	// Jobs are created speed test first, then hosts in the requested order;
+	// leases take equal-priority jobs in creation order
+	var jobs []*ent.JobCreate
+	newJob := func(daemonID string, jobType job.Type) *ent.JobCreate {
+		return tx.Job.
+			Create().
+			SetType(jobType).
+			SetDaemonID(daemonID).
+			SetCampaign(created).
+			SetTrigger(job.TriggerManual).
+			SetPriority(onDemandPriority).
+			SetMaxAttempts(1).
+			SetScheduledAt(scheduledAt)
+	}
+	for _, d := range candidates {
+		if !scope.Allows(d.ID, d.Labels) || s.daemons.Status(d) == api.Dead {
+			continue
+		}
+		if speedTest && d.HasSpeedtest {
+			jobs = append(jobs, newJob(d.ID, job.TypeSpeedtest))
+		}
+		if !d.HasIperf3 {
+			continue
+		}
+		for _, hostID := range hostIDs {
+			// Leases skip hosts scoped away from the daemon, so such a
+			// job would never run
+			if !HostScopeOf(hostsByID[hostID]).Allows(d.ID, d.Labels) {
+				continue
+			}
+			iperfJob := newJob(d.ID, job.TypeIperf).SetHostID(hostID)
+			if creation.DurationSeconds != nil {
+				iperfJob.SetDurationSeconds(*creation.DurationSeconds)
+			}
+			jobs = append(jobs, iperfJob)
+		}
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("%w: no daemon matches with the tools for the campaign's tests and can reach its hosts", ErrInvalidCampaign)
+	}
+
+	if _, err := tx.Job.CreateBulk(jobs...).Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to enqueue campaign jobs: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit campaign: %w", err)
+	}
+
+	log.Printf("Campaign scheduled - ID: %d, Name: %s, Jobs: %d, At: %s",
+		created.ID, created.Name, len(jobs), scheduledAt.Format(time.RFC3339))
+	s.jobs.notify()
+
+	return s.GetCampaign(ctx, created.ID)
+}
+
+// GetCampaign returns a campaign with its jobs
+func (s *CampaignService) GetCampaign(ctx context.Context, id int) (*ent.Campaign, error) {
+	found, err := s.client.Campaign.
+		Query().
+		Where(campaign.ID(id)).
+		WithJobs(func(q *ent.JobQuery) {
+			q.WithHost().Order(ent.Asc(job.FieldID))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrCampaignNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign %d: %w", id, err)
+	}
+	return found, nil
+}
+
+// GetCampaigns returns campaigns with their jobs, most recently scheduled
+// first
+func (s *CampaignService) GetCampaigns(ctx context.Context, limit int) ([]*ent.Campaign, error) {
+	return s.client.Campaign.
+		Query().
+		WithJobs(func(q *ent.JobQuery) {
+			q.WithHost().Order(ent.Asc(job.FieldID))
+		}).
+		Order(ent.Desc(campaign.FieldScheduledAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Report lines up each daemon's campaign results and summarizes every
+// metric across daemons. Quarantined results are shown but left out of the
+// summaries, as are failed iperf tests.
+func (s *CampaignService) Report(ctx context.Context, id int) (*CampaignReport, error) {
+	found, err := s.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	speedTests, err := s.client.SpeedTest.
+		Query().
+		Where(speedtest.CampaignID(id)).
+		Order(ent.Asc(speedtest.FieldTimestamp)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign speed tests: %w", err)
+	}
+
+	iperfTests, err := s.client.IperfTest.
+		Query().
+		Where(iperftest.CampaignID(id)).
+		WithHost().
+		Order(ent.Asc(iperftest.FieldTimestamp)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign iperf tests: %w", err)
+	}
+
+	hosts, err := s.client.Host.Query().Where(host.IDIn(found.HostIds...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query campaign hosts: %w", err)
+	}
+	hostsByID := make(map[int]*ent.Host, len(hosts))
+	for _, h := range hosts {
+		hostsByID[h.ID] = h
+	}
+
+	// Later results of the same daemon and test replace earlier ones
+	speedTestByDaemon := map[string]*ent.SpeedTest{}
+	for _, t := range speedTests {
+		speedTestByDaemon[t.DaemonID] = t
+	}
+	type daemonHost struct {
+		daemonID string
+		hostID   int
+	}
+	iperfByDaemonHost := map[daemonHost]*ent.IperfTest{}
+	for _, t := range iperfTests {
+		if t.Edges.Host != nil {
+			iperfByDaemonHost[daemonHost{t.DaemonID, t.Edges.Host.ID}] = t
+		}
+	}
+
+	rows := map[string]*CampaignDaemonResult{}
+	row := func(daemonID string) *CampaignDaemonResult {
+		if rows[daemonID] == nil {
+			rows[daemonID] = &CampaignDaemonResult{DaemonID: daemonID}
+		}
+		return rows[daemonID]
+	}
+	for _, j := range found.Edges.Jobs {
+		r := row(j.DaemonID)
+		switch j.Type {
+		case job.TypeSpeedtest:
+			r.SpeedTestJob = j
+			r.SpeedTest = speedTestByDaemon[j.DaemonID]
+		case job.TypeIperf:
+			hostID := 0
+			if j.Edges.Host != nil {
+				hostID = j.Edges.Host.ID
+			}
+			r.Iperf = append(r.Iperf, CampaignIperfResult{
+				HostID: hostID,
+				Host:   hostsByID[hostID],
+				Job:    j,
+				Result: iperfByDaemonHost[daemonHost{j.DaemonID, hostID}],
+			})
+		}
+	}
+
+	daemonIDs := make([]string, 0, len(rows))
+	for daemonID := range rows {
+		daemonIDs = append(daemonIDs, daemonID)
+	}
+	sort.Strings(daemonIDs)
+
+	daemons, err := s.client.Daemon.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query daemons: %w", err)
+	}
+	names := make(map[string]string, len(daemons))
+	for _, d := range daemons {
+		names[d.ID] = d.Name
+	}
+
+	report := &CampaignReport{Campaign: found}
+	var download, upload, ping []float64
+	sent := map[int][]float64{}
+	received := map[int][]float64{}
+	rtt := map[int][]float64{}
+	for _, daemonID := range daemonIDs {
+		r := rows[daemonID]
+		r.DaemonName = names[daemonID]
+		report.Daemons = append(report.Daemons, *r)
+
+		if t := r.SpeedTest; t != nil && !t.Quarantined {
+			download = append(download, t.DownloadMbps)
+			upload = append(upload, t.UploadMbps)
+			ping = append(ping, t.PingMs)
+		}
+		for _, result := range r.Iperf {
+			if t := result.Result; t != nil && t.Success && !t.Quarantined {
+				sent[result.HostID] = append(sent[result.HostID], t.SentMbps)
+				received[result.HostID] = append(received[result.HostID], t.ReceivedMbps)
+				if t.MeanRttMs > 0 {
+					rtt[result.HostID] = append(rtt[result.HostID], t.MeanRttMs)
+				}
+			}
+		}
+	}
+
+	report.DownloadMbps = summarize(download)
+	report.UploadMbps = summarize(upload)
+	report.PingMs = summarize(ping)
+	for _, hostID := range uniqueInts(found.HostIds) {
+		report.Hosts = append(report.Hosts, CampaignHostSummary{
+			HostID:       hostID,
+			Host:         hostsByID[hostID],
+			SentMbps:     summarize(sent[hostID]),
+			ReceivedMbps: summarize(received[hostID]),
+			MeanRttMs:    summarize(rtt[hostID]),
+		})
+	}
+
+	return report, nil
+}
+
+// CampaignStatus derives a campaign's status from its jobs
+func CampaignStatus(c *ent.Campaign) api.CampaignStatus {
+	outstanding := false
+	for _, j := range c.Edges.Jobs {
+		if j.Status == job.StatusLeased {
+			return api.CampaignStatusRunning
+		}
+		if j.Status == job.StatusPending {
+			outstanding = true
+		}
+	}
+
+	switch {
+	case !outstanding:
+		return api.CampaignStatusCompleted
+	case time.Now().Before(c.ScheduledAt):
+		return api.CampaignStatusScheduled
+	default:
+		return api.CampaignStatusRunning
+	}
+}
+
+// summarize returns the spread of values, or nil when there are none
+func summarize(values []float64) *MetricSummary {
+	if len(values) == 0 {
+		return nil
+	}
+
+	summary := &MetricSummary{
+		Samples: len(values),
+		Min:     values[0],
+		Max:     values[0],
+		Median:  median(values),
+	}
+	for _, v := range values[1:] {
+		summary.Min = min(summary.Min, v)
+		summary.Max = max(summary.Max, v)
+	}
+	return summary
+}
+
+// uniqueInts returns values without duplicates, keeping the first occurrence
+func uniqueInts(values []int) []int {
+	seen := make(map[int]bool, len(values))
+	unique := make([]int, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bfirestone/speed-checker/internal/config"
+)
+
+// Actions for results whose timestamps are outside the clock skew window
+const (
+	SkewQuarantine = "quarantine"
+	SkewReject     = "reject"
+)
+
+// ErrClockSkew is returned when a result's timestamp is outside the clock
+// skew window and skewed results are rejected
+var ErrClockSkew = errors.New("timestamp outside the clock skew window")
+
+// ClockPolicy decides what happens to results submitted by daemons whose
+// clocks are off, e.g. because they booted without NTP
+type ClockPolicy struct {
+	maxSkew time.Duration
+	maxAge  time.Duration
+	reject  bool
+}
+
+// NewClockPolicy builds the policy from the clock configuration. A zero
+// max_skew disables the check.
+func NewClockPolicy(cfg config.ClockConfig) (ClockPolicy, error) {
+	if cfg.MaxSkew < 0 || cfg.MaxAge < 0 {
+		return ClockPolicy{}, fmt.Errorf("clock.max_skew and clock.max_age must not be negative")
+	}
+
+	policy := ClockPolicy{maxSkew: cfg.MaxSkew, maxAge: cfg.MaxAge}
+	switch cfg.SkewAction {
+	case SkewQuarantine, "":
+	case SkewReject:
+		policy.reject = true
+	default:
+		return ClockPolicy{}, fmt.Errorf("clock.skew_action must be %q or %q, got %q", SkewQuarantine, SkewReject, cfg.SkewAction)
+	}
+	return policy, nil
+}
+
+// Receipt records when the server received a result and what the clock
+// policy decided about it
+type Receipt struct {
+	ReceivedAt  time.Time
+	Quarantined bool
+	Rejected    bool
+	Reason      string
+}
+
+// err returns ErrClockSkew for rejected results. It is checked after the
+// submission ID lookup so retries of stored results are still answered.
+func (r Receipt) err() error {
+	if !r.Rejected {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrClockSkew, r.Reason)
+}
+
+// Receive checks a result against the server's clock. clientTime is the
+// daemon's clock when it sent the request, from the X-Client-Time header,
+// and is nil for clients that do not send it. A result is skewed when the
+// daemon's clock is off by more than the skew window, when its timestamp is
+// in the future, or when it is older than max_age; replayed results are
+// legitimately old, so age alone is only checked against max_age.
+func (p ClockPolicy) Receive(timestamp time.Time, clientTime *time.Time) Receipt {
+	receipt := Receipt{ReceivedAt: time.Now()}
+	if p.maxSkew == 0 {
+		return receipt
+	}
+
+	switch {
+	case clientTime != nil && clientTime.Sub(receipt.ReceivedAt).Abs() > p.maxSkew:
+		receipt.Reason = fmt.Sprintf("daemon clock is %v off server time", clientTime.Sub(receipt.ReceivedAt).Round(time.Second))
+	case timestamp.Sub(receipt.ReceivedAt) > p.maxSkew:
+		receipt.Reason = fmt.Sprintf("%s is in the future", timestamp.Format(time.RFC3339))
+	case p.maxAge > 0 && receipt.ReceivedAt.Sub(timestamp) > p.maxAge:
+		receipt.Reason = fmt.Sprintf("%s is older than %v", timestamp.Format(time.RFC3339), p.maxAge)
+	default:
+		return receipt
+	}
+
+	if p.reject {
+		receipt.Rejected = true
+	} else {
+		receipt.Quarantined = true
+	}
+	return receipt
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	// ErrInvalidSort is returned for a sort on a column a listing cannot be
+	// sorted by
+	ErrInvalidSort = errors.New("invalid sort")
+	// ErrInvalidCursor is returned for a cursor that was not issued for the
+	// listing's sort
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// columnKind is how a sortable column's values are compared and encoded in
+// cursors
+type columnKind int
+
+const (
+	kindTime columnKind = iota
+	kindFloat
+	kindInt
+	kindString
+)
+
+// sortColumn is a column a listing can be sorted and paged by. Optional
+// columns may be NULL, which sorts and pages as zero like it reads in Go.
+type sortColumn struct {
+	kind     columnKind
+	optional bool
+}
+
+// ResultSort orders a listing by one column, ties broken by ID in the same
+// direction. It is written as the column name, prefixed with "-" for
+// descending order.
+type ResultSort struct {
+	Field string
+	Desc  bool
+}
+
+func (s ResultSort) String() string {
+	if s.Desc {
+		return "-" + s.Field
+	}
+	return s.Field
+}
+
+// parseSort parses a sort, falling back to def when it is empty
+func parseSort(value string, def ResultSort, columns map[string]sortColumn) (ResultSort, error) {
+	if value == "" {
+		return def, nil
+	}
+
+	sort := ResultSort{Field: strings.TrimPrefix(value, "-"), Desc: strings.HasPrefix(value, "-")}
+	if _, ok := columns[sort.Field]; !ok {
+		return ResultSort{}, fmt.Errorf("%w: cannot sort by %q", ErrInvalidSort, sort.Field)
+	}
+	return sort, nil
+}
+
+// cursor is the position after the last item of a page: its sort value and
+// ID. It is handed out base64-encoded and opaque.
+type cursor struct {
+	Sort  string          `json:"s"`
+	Value json.RawMessage `json:"v"`
+	ID    int             `json:"id"`
+}
+
+// encodeCursor returns the cursor continuing after an item with the given
+// sort value and ID
+func encodeCursor(sort ResultSort, value any, id int) string {
+	raw, _ := json.Marshal(value)
+	encoded, _ := json.Marshal(cursor{Sort: sort.String(), Value: raw, ID: id})
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// decodeCursor returns the sort value and ID a cursor continues after. A
+// cursor only continues the sort it was issued for.
+func decodeCursor(value string, sort ResultSort, column sortColumn) (any, int, error) {
+	encoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	var c cursor
+	if err := json.Unmarshal(encoded, &c); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if c.Sort != sort.String() {
+		return nil, 0, fmt.Errorf("%w: issued for sort %q, not %q", ErrInvalidCursor, c.Sort, sort.String())
+	}
+
+	var v any
+	switch column.kind {
+	case kindTime:
+		var t time.Time
+		err = json.Unmarshal(c.Value, &t)
+		v = t
+	case kindFloat:
+		var f float64
+		err = json.Unmarshal(c.Value, &f)
+		v = f
+	case kindInt:
+		var i int
+		err = json.Unmarshal(c.Value, &i)
+		v = i
+	case kindString:
+		var s string
+		err = json.Unmarshal(c.Value, &s)
+		v = s
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	return v, c.ID, nil
+}
+
+// sortExpr is the SQL expression a column sorts by
+func sortExpr(s *sql.Selector, field string, column sortColumn) string {
+	if column.optional {
+		return "COALESCE(" + s.C(field) + ", 0)"
+	}
+	return s.C(field)
+}
+
+// keysetOrder orders a query by the sort column, then ID
+func keysetOrder(sort ResultSort, column sortColumn) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if sort.Desc {
+			s.OrderBy(sql.Desc(sortExpr(s, sort.Field, column)), sql.Desc(s.C("id")))
+			return
+		}
+		s.OrderBy(sql.Asc(sortExpr(s, sort.Field, column)), sql.Asc(s.C("id")))
+	}
+}
+
+// keysetAfter selects the rows that come after a cursor position in the
+// sort's order
+func keysetAfter(sort ResultSort, column sortColumn, value any, id int) func(*sql.Selector) {
+	op := " > "
+	if sort.Desc {
+		op = " < "
+	}
+
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(" + sortExpr(s, sort.Field, column) + ", " + s.C("id") + ")" + op + "(")
+			b.Args(value, id)
+			b.WriteString(")")
+		}))
+	}
+}
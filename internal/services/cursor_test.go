@@ -0,0 +1,144 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		value string
+		want  ResultSort
+		err   error
+	}{
+		{"", defaultResultSort, nil},
+		{"timestamp", ResultSort{Field: "timestamp"}, nil},
+		{"-download_mbps", ResultSort{Field: "download_mbps", Desc: true}, nil},
+		{"jitter_ms", ResultSort{Field: "jitter_ms"}, nil},
+		{"server_name", ResultSort{}, ErrInvalidSort},
+		{"-", ResultSort{}, ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		got, err := parseSort(tt.value, defaultResultSort, speedTestSortColumns)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("parseSort(%q) error = %v, want %v", tt.value, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseSort(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	timestamp := time.Date(2025, 3, 14, 15, 9, 26, 535897000, time.UTC)
+
+	tests := []struct {
+		name   string
+		sort   ResultSort
+		column sortColumn
+		value  any
+	}{
+		{"time", ResultSort{Field: "timestamp", Desc: true}, sortColumn{kind: kindTime}, timestamp},
+		{"float", ResultSort{Field: "download_mbps"}, sortColumn{kind: kindFloat}, 941.25},
+		{"optional float", ResultSort{Field: "jitter_ms"}, sortColumn{kind: kindFloat, optional: true}, 0.0},
+		{"int", ResultSort{Field: "retransmits", Desc: true}, sortColumn{kind: kindInt}, 17},
+		{"string", ResultSort{Field: "name"}, sortColumn{kind: kindString}, "office, \"main\" link"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := encodeCursor(tt.sort, tt.value, 42)
+
+			value, id, err := decodeCursor(encoded, tt.sort, tt.column)
+			if err != nil {
+				t.Fatalf("decodeCursor() error = %v", err)
+			}
+			if id != 42 {
+				t.Errorf("id = %d, want 42", id)
+			}
+			if got, ok := value.(time.Time); ok {
+				if !got.Equal(tt.value.(time.Time)) {
+					t.Errorf("value = %v, want %v", got, tt.value)
+				}
+				return
+			}
+			if value != tt.value {
+				t.Errorf("value = %#v, want %#v", value, tt.value)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsTamperedCursors(t *testing.T) {
+	sort := ResultSort{Field: "download_mbps", Desc: true}
+	column := sortColumn{kind: kindFloat}
+	valid := encodeCursor(sort, 512.5, 7)
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   ResultSort
+		column sortColumn
+	}{
+		{"not base64", "!!" + valid, sort, column},
+		{"truncated", valid[:len(valid)-4], sort, column},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("download_mbps:512.5")), sort, column},
+		{"other sort field", valid, ResultSort{Field: "upload_mbps", Desc: true}, column},
+		{"other sort direction", valid, ResultSort{Field: "download_mbps"}, column},
+		{"value of another kind", base64.RawURLEncoding.EncodeToString([]byte(`{"s":"-download_mbps","v":"fast","id":7}`)), sort, column},
+		{"time that does not parse", encodeCursor(ResultSort{Field: "timestamp"}, "yesterday", 7), ResultSort{Field: "timestamp"}, sortColumn{kind: kindTime}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := decodeCursor(tt.cursor, tt.sort, tt.column)
+			if !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("decodeCursor() error = %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestKeysetAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		sort   ResultSort
+		column sortColumn
+		want   string
+	}{
+		{
+			name:   "ascending",
+			sort:   ResultSort{Field: "download_mbps"},
+			column: sortColumn{kind: kindFloat},
+			want:   `SELECT * FROM "speed_tests" WHERE ("speed_tests"."download_mbps", "speed_tests"."id") > ($1, $2)`,
+		},
+		{
+			name:   "descending optional",
+			sort:   ResultSort{Field: "jitter_ms", Desc: true},
+			column: sortColumn{kind: kindFloat, optional: true},
+			want:   `SELECT * FROM "speed_tests" WHERE (COALESCE("speed_tests"."jitter_ms", 0), "speed_tests"."id") < ($1, $2)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("speed_tests"))
+			keysetAfter(tt.sort, tt.column, 12.5, 3)(selector)
+
+			query, args := selector.Query()
+			if query != tt.want {
+				t.Errorf("query = %s, want %s", query, tt.want)
+			}
+			if len(args) != 2 || args[0] != 12.5 || args[1] != 3 {
+				t.Errorf("args = %v, want [12.5 3]", args)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/apikey"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+// ErrDaemonNotFound is returned when a daemon has not registered
+var ErrDaemonNotFound = errors.New("daemon not found")
+
+// DaemonService keeps the registry of daemons and derives their liveness
+// from the last heartbeat
+type DaemonService struct {
+	client     *ent.Client
+	staleAfter time.Duration
+	deadAfter  time.Duration
+	events     EventPublisher
+}
+
+// NewDaemonService creates the service. Heartbeats are published to events,
+// which may be nil.
+func NewDaemonService(client *ent.Client, staleAfter, deadAfter time.Duration, events EventPublisher) *DaemonService {
+	return &DaemonService{
+		client:     client,
+		staleAfter: staleAfter,
+		deadAfter:  deadAfter,
+		events:     events,
+	}
+}
+
+// Register creates the daemon record, or refreshes it when the daemon is
+// already known, and marks the daemon as seen
+func (s *DaemonService) Register(ctx context.Context, registration api.DaemonRegistration) (*ent.Daemon, error) {
+	var hasIperf3, hasSpeedtest bool
+	if registration.Capabilities != nil {
+		hasIperf3 = derefBoolOr(registration.Capabilities.Iperf3, false)
+		hasSpeedtest = derefBoolOr(registration.Capabilities.Speedtest, false)
+	}
+
+	labels := map[string]string{}
+	if registration.Labels != nil {
+		labels = *registration.Labels
+	}
+
+	existing, err := s.client.Daemon.Get(ctx, registration.Id)
+	switch {
+	case ent.IsNotFound(err):
+		created, err := s.client.Daemon.
+			Create().
+			SetID(registration.Id).
+			SetName(derefOr(registration.Name, "")).
+			SetHostname(derefOr(registration.Hostname, "")).
+			SetVersion(derefOr(registration.Version, "")).
+			SetOs(derefOr(registration.Os, "")).
+			SetArch(derefOr(registration.Arch, "")).
+			SetLabels(labels).
+			SetHasIperf3(hasIperf3).
+			SetHasSpeedtest(hasSpeedtest).
+			SetMeshAddress(derefOr(registration.MeshAddress, "")).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register daemon: %w", err)
+		}
+
+		log.Printf("Daemon registered - ID: %s, Hostname: %s", created.ID, created.Hostname)
+		return created, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("failed to look up daemon: %w", err)
+	}
+
+	return existing.Update().
+		SetName(derefOr(registration.Name, "")).
+		SetHostname(derefOr(registration.Hostname, "")).
+		SetVersion(derefOr(registration.Version, "")).
+		SetOs(derefOr(registration.Os, "")).
+		SetArch(derefOr(registration.Arch, "")).
+		SetLabels(labels).
+		SetHasIperf3(hasIperf3).
+		SetHasSpeedtest(hasSpeedtest).
+		SetMeshAddress(derefOr(registration.MeshAddress, "")).
+		SetLastSeenAt(time.Now()).
+		Save(ctx)
+}
+
+// Heartbeat marks a registered daemon as seen and records the state it
+// reported
+func (s *DaemonService) Heartbeat(ctx context.Context, id string, heartbeat api.DaemonHeartbeat) (*ent.Daemon, error) {
+	update := s.client.Daemon.
+		UpdateOneID(id).
+		SetLastSeenAt(time.Now())
+
+	if heartbeat.SpoolDepth != nil {
+		update.SetSpoolDepth(*heartbeat.SpoolDepth)
+	}
+
+	updated, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrDaemonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	publish(s.events, Event{Type: EventDaemonHeartbeat, DaemonID: updated.ID, Daemon: updated})
+	return updated, nil
+}
+
+// GetDaemon returns a single registered daemon
+func (s *DaemonService) GetDaemon(ctx context.Context, id string) (*ent.Daemon, error) {
+	found, err := s.client.Daemon.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrDaemonNotFound
+	}
+	return found, err
+}
+
+// LookupDaemons returns the registered daemons among the given IDs keyed by
+// ID. Results reference daemons by daemon_id alone, so IDs of daemons that
+// never registered, and the empty ID of server-side runs, are left out.
+func (s *DaemonService) LookupDaemons(ctx context.Context, ids []string) (map[string]*ent.Daemon, error) {
+	daemons, err := s.client.Daemon.
+		Query().
+		Where(daemon.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*ent.Daemon, len(daemons))
+	for _, d := range daemons {
+		byID[d.ID] = d
+	}
+	return byID, nil
+}
+
+// GetDaemons returns registered daemons, optionally only those with the
+// given status
+func (s *DaemonService) GetDaemons(ctx context.Context, status api.DaemonStatus) ([]*ent.Daemon, error) {
+	daemons, err := s.client.Daemon.
+		Query().
+		Order(ent.Asc(daemon.FieldID)).
+		All(ctx)
+	if err != nil || status == "" {
+		return daemons, err
+	}
+
+	filtered := make([]*ent.Daemon, 0, len(daemons))
+	for _, d := range daemons {
+		if s.Status(d) == status {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered, nil
+}
+
+// Status reports whether a daemon is online, stale or dead based on the time
+// since its last heartbeat
+func (s *DaemonService) Status(d *ent.Daemon) api.DaemonStatus {
+	since := time.Since(d.LastSeenAt)
+	switch {
+	case since >= s.deadAfter:
+		return api.Dead
+	case since >= s.staleAfter:
+		return api.Stale
+	default:
+		return api.Online
+	}
+}
+
+// daemonLabels returns the labels a daemon registered with, or nil when it
+// has not registered
+func daemonLabels(ctx context.Context, client *ent.Client, daemonID string) (map[string]string, error) {
+	registered, err := client.Daemon.Get(ctx, daemonID)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up daemon: %w", err)
+	}
+	return registered.Labels, nil
+}
+
+func derefBoolOr(ptr *bool, defaultValue bool) bool {
+	if ptr == nil {
+		return defaultValue
+	}
+	return *ptr
+}
+
+func derefIntOr(ptr *int, defaultValue int) int {
+	if ptr == nil {
+		return defaultValue
+	}
+	return *ptr
+}
+
+// MergeResult counts the records re-pointed by a daemon merge
+type MergeResult struct {
+	SpeedTests    int
+	IperfTests    int
+	MeshTests     int
+	TestRuns      int
+	Jobs          int
+	Hosts         int
+	DaemonConfigs int
+	APIKeys       int
+	Daemons       int
+}
+
+// LegacyDaemonIDs returns the per-process daemon IDs used before daemons had
+// a persistent identity, i.e. daemon-<hostname>-<pid>, found on any record
+func (s *DaemonService) LegacyDaemonIDs(ctx context.Context, hostname string) ([]string, error) {
+	prefix := fmt.Sprintf("daemon-%s-", hostname)
+
+	var ids []string
+	collect := func(found []string, err error) error {
+		if err != nil {
+			return err
+		}
+		ids = append(ids, found...)
+		return nil
+	}
+
+	if err := collect(s.client.SpeedTest.Query().
+		Where(speedtest.DaemonIDHasPrefix(prefix)).
+		GroupBy(speedtest.FieldDaemonID).
+		Strings(ctx)); err != nil {
+		return nil, err
+	}
+	if err := collect(s.client.IperfTest.Query().
+		Where(iperftest.DaemonIDHasPrefix(prefix)).
+		GroupBy(iperftest.FieldDaemonID).
+		Strings(ctx)); err != nil {
+		return nil, err
+	}
+	if err := collect(s.client.TestRun.Query().
+		Where(testrun.DaemonIDHasPrefix(prefix)).
+		GroupBy(testrun.FieldDaemonID).
+		Strings(ctx)); err != nil {
+		return nil, err
+	}
+	if err := collect(s.client.Daemon.Query().IDs(ctx)); err != nil {
+		return nil, err
+	}
+
+	// Only keep IDs whose suffix is a pid, so hostnames sharing a prefix
+	// (e.g. "office" and "office-2") are not swept up
+	seen := make(map[string]bool)
+	legacy := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if !strings.HasPrefix(id, prefix) {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimPrefix(id, prefix)); err == nil {
+			legacy = append(legacy, id)
+		}
+	}
+	sort.Strings(legacy)
+
+	return legacy, nil
+}
+
+// Merge re-points results, runs, jobs, host assignments, config overrides and
+// API key bindings recorded under the source daemon IDs to the target daemon
+// and removes the source registry records. With dryRun the changes are
+// counted and rolled back.
+func (s *DaemonService) Merge(ctx context.Context, target string, sources []string, dryRun bool) (*MergeResult, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var result MergeResult
+
+	if result.SpeedTests, err = tx.SpeedTest.Update().
+		Where(speedtest.DaemonIDIn(sources...)).
+		SetDaemonID(target).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to merge speed tests: %w", err)
+	}
+	if result.IperfTests, err = tx.IperfTest.Update().
+		Where(iperftest.DaemonIDIn(sources...)).
+		SetDaemonID(target).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to merge iperf tests: %w", err)
+	}
+	if result.TestRuns, err = tx.TestRun.Update().
+		Where(testrun.DaemonIDIn(sources...)).
+		SetDaemonID(target).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to merge runs: %w", err)
+	}
+
+	pinned, err := tx.Job.Update().
+		Where(job.DaemonIDIn(sources...)).
+		SetDaemonID(target).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge jobs: %w", err)
+	}
+	leased, err := tx.Job.Update().
+		Where(job.LeasedByIn(sources...)).
+		SetLeasedBy(target).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge jobs: %w", err)
+	}
+	targeted, err := tx.Job.Update().
+		Where(job.TargetDaemonIDIn(sources...)).
+		SetTargetDaemonID(target).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge jobs: %w", err)
+	}
+	result.Jobs = pinned + leased + targeted
+
+	if result.MeshTests, err = s.mergeMeshTests(ctx, tx, target, sources); err != nil {
+		return nil, err
+	}
+	if result.Hosts, err = s.mergeHostAssignments(ctx, tx, target, sources); err != nil {
+		return nil, err
+	}
+	if result.DaemonConfigs, err = tx.DaemonConfig.Update().
+		Where(daemonconfig.DaemonIDIn(sources...)).
+		SetDaemonID(target).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to merge daemon configs: %w", err)
+	}
+	if result.APIKeys, err = tx.APIKey.Update().
+		Where(apikey.DaemonIDIn(sources...)).
+		SetDaemonID(target).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("failed to merge API keys: %w", err)
+	}
+
+	if result.Daemons, err = tx.Daemon.Delete().
+		Where(daemon.IDIn(sources...), daemon.IDNEQ(target)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to remove merged daemons: %w", err)
+	}
+
+	if dryRun {
+		return &result, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit merge: %w", err)
+	}
+
+	log.Printf("Merged daemons %v into %s", sources, target)
+	return &result, nil
+}
+
+// mergeMeshTests re-points both ends of mesh results, counting each result
+// once even when it ran between two of the merged daemons
+func (s *DaemonService) mergeMeshTests(ctx context.Context, tx *ent.Tx, target string, sources []string) (int, error) {
+	merged, err := tx.MeshTest.Query().
+		Where(meshtest.Or(
+			meshtest.SourceDaemonIDIn(sources...),
+			meshtest.TargetDaemonIDIn(sources...),
+		)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count mesh tests: %w", err)
+	}
+
+	if _, err := tx.MeshTest.Update().
+		Where(meshtest.SourceDaemonIDIn(sources...)).
+		SetSourceDaemonID(target).
+		Save(ctx); err != nil {
+		return 0, fmt.Errorf("failed to merge mesh tests: %w", err)
+	}
+	if _, err := tx.MeshTest.Update().
+		Where(meshtest.TargetDaemonIDIn(sources...)).
+		SetTargetDaemonID(target).
+		Save(ctx); err != nil {
+		return 0, fmt.Errorf("failed to merge mesh tests: %w", err)
+	}
+
+	return merged, nil
+}
+
+// mergeHostAssignments replaces the source daemon IDs in the daemon_ids scope
+// of every host with the target daemon ID
+func (s *DaemonService) mergeHostAssignments(ctx context.Context, tx *ent.Tx, target string, sources []string) (int, error) {
+	hosts, err := tx.Host.Query().
+		Where(host.DaemonIdsNotNil()).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list hosts: %w", err)
+	}
+
+	merged := 0
+	for _, h := range hosts {
+		daemonIDs, changed := replaceDaemonIDs(h.DaemonIds, target, sources)
+		if !changed {
+			continue
+		}
+		if err := tx.Host.UpdateOne(h).SetDaemonIds(daemonIDs).Exec(ctx); err != nil {
+			return 0, fmt.Errorf("failed to merge daemons of host %d: %w", h.ID, err)
+		}
+		merged++
+	}
+
+	return merged, nil
+}
+
+// replaceDaemonIDs replaces any of sources in ids with target, keeping each
+// ID once, and reports whether anything was replaced
+func replaceDaemonIDs(ids []string, target string, sources []string) ([]string, bool) {
+	changed := false
+	seen := make(map[string]bool, len(ids))
+	replaced := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if slices.Contains(sources, id) {
+			id = target
+			changed = true
+		}
+		if !seen[id] {
+			seen[id] = true
+			replaced = append(replaced, id)
+		}
+	}
+	return replaced, changed
+}
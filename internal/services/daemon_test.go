@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+)
+
+func TestReplaceDaemonIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []string
+		want    []string
+		changed bool
+	}{
+		{"no sources", []string{"peer", "stable"}, []string{"peer", "stable"}, false},
+		{"source replaced in place", []string{"peer", "old-1"}, []string{"peer", "stable"}, true},
+		{"sources collapse into one", []string{"old-1", "peer", "old-2"}, []string{"stable", "peer"}, true},
+		{"target already assigned", []string{"stable", "old-1"}, []string{"stable"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := replaceDaemonIDs(tt.ids, "stable", []string{"old-1", "old-2"})
+			if !slices.Equal(got, tt.want) || changed != tt.changed {
+				t.Errorf("replaceDaemonIDs(%v) = %v, %v, want %v, %v", tt.ids, got, changed, tt.want, tt.changed)
+			}
+		})
+	}
+}
+
+func TestMergeMovesMeshResultsAndConfigOverrides(t *testing.T) {
+	client := openTestDatabase(t)
+	s := NewDaemonService(client, time.Minute, time.Hour, nil)
+	ctx := context.Background()
+
+	target := "test-" + uuid.NewString()
+	source := "test-" + uuid.NewString()
+	peer := "test-" + uuid.NewString()
+	t.Cleanup(func() {
+		ctx := context.Background()
+		ids := []string{target, source, peer}
+		client.MeshTest.Delete().Where(meshtest.SourceDaemonIDIn(ids...)).Exec(ctx)
+		client.DaemonConfig.Delete().Where(daemonconfig.DaemonIDIn(ids...)).Exec(ctx)
+	})
+
+	outbound := client.MeshTest.Create().
+		SetSourceDaemonID(source).
+		SetTargetDaemonID(peer).
+		SetSentMbps(900).
+		SetReceivedMbps(890).
+		SaveX(ctx)
+	inbound := client.MeshTest.Create().
+		SetSourceDaemonID(peer).
+		SetTargetDaemonID(source).
+		SetSentMbps(850).
+		SetReceivedMbps(845).
+		SaveX(ctx)
+	override := client.DaemonConfig.Create().
+		SetName("slow link").
+		SetDaemonID(source).
+		SetSpeedtestIntervalSeconds(3600).
+		SaveX(ctx)
+
+	// A dry run counts the changes without keeping them
+	result, err := s.Merge(ctx, target, []string{source}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.MeshTests != 2 || result.DaemonConfigs != 1 {
+		t.Fatalf("dry run merged %d mesh tests and %d configs, want 2 and 1", result.MeshTests, result.DaemonConfigs)
+	}
+	if got := client.DaemonConfig.GetX(ctx, override.ID); *got.DaemonID != source {
+		t.Fatalf("dry run moved the override to %s", *got.DaemonID)
+	}
+
+	result, err = s.Merge(ctx, target, []string{source}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.MeshTests != 2 || result.DaemonConfigs != 1 {
+		t.Errorf("merged %d mesh tests and %d configs, want 2 and 1", result.MeshTests, result.DaemonConfigs)
+	}
+
+	if got := client.MeshTest.GetX(ctx, outbound.ID); got.SourceDaemonID != target || got.TargetDaemonID != peer {
+		t.Errorf("outbound mesh test runs %s -> %s, want %s -> %s", got.SourceDaemonID, got.TargetDaemonID, target, peer)
+	}
+	if got := client.MeshTest.GetX(ctx, inbound.ID); got.SourceDaemonID != peer || got.TargetDaemonID != target {
+		t.Errorf("inbound mesh test runs %s -> %s, want %s -> %s", got.SourceDaemonID, got.TargetDaemonID, peer, target)
+	}
+	if got := client.DaemonConfig.GetX(ctx, override.ID); *got.DaemonID != target {
+		t.Errorf("override applies to %s, want %s", *got.DaemonID, target)
+	}
+
+	effective, err := NewDaemonConfigService(client).Resolve(ctx, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interval := effective.Settings.SpeedtestIntervalSeconds; interval == nil || *interval != 3600 {
+		t.Errorf("resolved speedtest interval = %v, want 3600", interval)
+	}
+}
@@ -0,0 +1,347 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/daemonconfig"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+var (
+	// ErrDaemonConfigNotFound is returned when a daemon config ID does not exist
+	ErrDaemonConfigNotFound = errors.New("daemon config not found")
+	// ErrInvalidDaemonConfig is returned when a daemon config targets both a
+	// daemon and labels, or has settings out of range
+	ErrInvalidDaemonConfig = errors.New("invalid daemon config")
+)
+
+// EffectiveConfig is the configuration resolved for a single daemon
+type EffectiveConfig struct {
+	Version  string
+	Settings api.DaemonSettings
+	Applied  []int
+}
+
+// DaemonConfigService stores the remote configuration daemons apply at
+// runtime: global defaults, label overrides and per-daemon overrides
+type DaemonConfigService struct {
+	client *ent.Client
+}
+
+func NewDaemonConfigService(client *ent.Client) *DaemonConfigService {
+	return &DaemonConfigService{
+		client: client,
+	}
+}
+
+// Create stores a new daemon config
+func (s *DaemonConfigService) Create(ctx context.Context, creation api.DaemonConfigCreation) (*ent.DaemonConfig, error) {
+	if err := validateDaemonConfig(creation); err != nil {
+		return nil, err
+	}
+
+	settings := derefSettings(creation.Settings)
+	builder := s.client.DaemonConfig.
+		Create().
+		SetName(creation.Name).
+		SetNillableDaemonID(creation.DaemonId).
+		SetPriority(derefIntOr(creation.Priority, 0)).
+		SetNillableSpeedtestIntervalSeconds(settings.SpeedtestIntervalSeconds).
+		SetNillableIperfIntervalSeconds(settings.IperfIntervalSeconds).
+		SetNillableIperfDurationSeconds(settings.IperfDurationSeconds).
+		SetNillableSpeedtestEnabled(settings.SpeedtestEnabled).
+		SetNillableIperfEnabled(settings.IperfEnabled).
+		SetNillableAdaptiveEnabled(settings.AdaptiveEnabled)
+	if creation.MatchLabels != nil && len(*creation.MatchLabels) > 0 {
+		builder.SetMatchLabels(*creation.MatchLabels)
+	}
+	if hostTypes := hostTypeStrings(settings.HostTypes); len(hostTypes) > 0 {
+		builder.SetHostTypes(hostTypes)
+	}
+	if settings.HostIds != nil && len(*settings.HostIds) > 0 {
+		builder.SetHostIds(*settings.HostIds)
+	}
+
+	created, err := builder.Save(ctx)
+	if ent.IsValidationError(err) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDaemonConfig, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save daemon config: %w", err)
+	}
+
+	log.Printf("Daemon config created - ID: %d, Name: %s", created.ID, created.Name)
+	return created, nil
+}
+
+// Update replaces a daemon config; settings left out are unset
+func (s *DaemonConfigService) Update(ctx context.Context, id int, creation api.DaemonConfigCreation) (*ent.DaemonConfig, error) {
+	if err := validateDaemonConfig(creation); err != nil {
+		return nil, err
+	}
+
+	settings := derefSettings(creation.Settings)
+	update := s.client.DaemonConfig.
+		UpdateOneID(id).
+		SetName(creation.Name).
+		SetPriority(derefIntOr(creation.Priority, 0))
+
+	if creation.DaemonId != nil {
+		update.SetDaemonID(*creation.DaemonId)
+	} else {
+		update.ClearDaemonID()
+	}
+	if creation.MatchLabels != nil && len(*creation.MatchLabels) > 0 {
+		update.SetMatchLabels(*creation.MatchLabels)
+	} else {
+		update.ClearMatchLabels()
+	}
+	if settings.SpeedtestIntervalSeconds != nil {
+		update.SetSpeedtestIntervalSeconds(*settings.SpeedtestIntervalSeconds)
+	} else {
+		update.ClearSpeedtestIntervalSeconds()
+	}
+	if settings.IperfIntervalSeconds != nil {
+		update.SetIperfIntervalSeconds(*settings.IperfIntervalSeconds)
+	} else {
+		update.ClearIperfIntervalSeconds()
+	}
+	if settings.IperfDurationSeconds != nil {
+		update.SetIperfDurationSeconds(*settings.IperfDurationSeconds)
+	} else {
+		update.ClearIperfDurationSeconds()
+	}
+	if settings.SpeedtestEnabled != nil {
+		update.SetSpeedtestEnabled(*settings.SpeedtestEnabled)
+	} else {
+		update.ClearSpeedtestEnabled()
+	}
+	if settings.IperfEnabled != nil {
+		update.SetIperfEnabled(*settings.IperfEnabled)
+	} else {
+		update.ClearIperfEnabled()
+	}
+	if settings.AdaptiveEnabled != nil {
+		update.SetAdaptiveEnabled(*settings.AdaptiveEnabled)
+	} else {
+		update.ClearAdaptiveEnabled()
+	}
+	if hostTypes := hostTypeStrings(settings.HostTypes); len(hostTypes) > 0 {
+		update.SetHostTypes(hostTypes)
+	} else {
+		update.ClearHostTypes()
+	}
+	if settings.HostIds != nil && len(*settings.HostIds) > 0 {
+		update.SetHostIds(*settings.HostIds)
+	} else {
+		update.ClearHostIds()
+	}
+
+	updated, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrDaemonConfigNotFound
+	}
+	if ent.IsValidationError(err) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDaemonConfig, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update daemon config %d: %w", id, err)
+	}
+
+	log.Printf("Daemon config updated - ID: %d, Name: %s", updated.ID, updated.Name)
+	return updated, nil
+}
+
+// Delete removes a daemon config
+func (s *DaemonConfigService) Delete(ctx context.Context, id int) error {
+	err := s.client.DaemonConfig.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ErrDaemonConfigNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete daemon config %d: %w", id, err)
+	}
+
+	log.Printf("Daemon config deleted - ID: %d", id)
+	return nil
+}
+
+// Get returns a single daemon config
+func (s *DaemonConfigService) Get(ctx context.Context, id int) (*ent.DaemonConfig, error) {
+	found, err := s.client.DaemonConfig.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrDaemonConfigNotFound
+	}
+	return found, err
+}
+
+// List returns every daemon config
+func (s *DaemonConfigService) List(ctx context.Context) ([]*ent.DaemonConfig, error) {
+	return s.client.DaemonConfig.Query().
+		Order(ent.Asc(daemonconfig.FieldID)).
+		All(ctx)
+}
+
+// Resolve merges the configs that apply to a daemon: global defaults first,
+// then label overrides matching the daemon's registered labels, then
+// overrides for the daemon itself. Within each kind, configs are applied by
+// ascending priority so the highest priority wins. The version is derived
+// from the merged settings, so it only changes when they do.
+func (s *DaemonConfigService) Resolve(ctx context.Context, daemonID string) (*EffectiveConfig, error) {
+	labels, err := daemonLabels(ctx, s.client, daemonID)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := s.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemon configs: %w", err)
+	}
+
+	// kind orders global defaults before label and daemon overrides
+	kind := func(c *ent.DaemonConfig) int {
+		switch {
+		case c.DaemonID != nil:
+			return 2
+		case len(c.MatchLabels) > 0:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	matching := make([]*ent.DaemonConfig, 0, len(configs))
+	for _, c := range configs {
+		if c.DaemonID != nil && *c.DaemonID != daemonID {
+			continue
+		}
+		if !labelsMatch(c.MatchLabels, labels) {
+			continue
+		}
+		matching = append(matching, c)
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		if kind(matching[i]) != kind(matching[j]) {
+			return kind(matching[i]) < kind(matching[j])
+		}
+		return matching[i].Priority < matching[j].Priority
+	})
+
+	effective := &EffectiveConfig{Applied: make([]int, 0, len(matching))}
+	for _, c := range matching {
+		mergeSettings(&effective.Settings, c)
+		effective.Applied = append(effective.Applied, c.ID)
+	}
+
+	encoded, err := json.Marshal(effective.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode settings: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	effective.Version = hex.EncodeToString(sum[:8])
+
+	return effective, nil
+}
+
+// DaemonConfigSettings returns the settings stored on a single daemon config
+func DaemonConfigSettings(c *ent.DaemonConfig) api.DaemonSettings {
+	var settings api.DaemonSettings
+	mergeSettings(&settings, c)
+	return settings
+}
+
+func mergeSettings(settings *api.DaemonSettings, c *ent.DaemonConfig) {
+	if c.SpeedtestIntervalSeconds != nil {
+		settings.SpeedtestIntervalSeconds = c.SpeedtestIntervalSeconds
+	}
+	if c.IperfIntervalSeconds != nil {
+		settings.IperfIntervalSeconds = c.IperfIntervalSeconds
+	}
+	if c.IperfDurationSeconds != nil {
+		settings.IperfDurationSeconds = c.IperfDurationSeconds
+	}
+	if c.SpeedtestEnabled != nil {
+		settings.SpeedtestEnabled = c.SpeedtestEnabled
+	}
+	if c.IperfEnabled != nil {
+		settings.IperfEnabled = c.IperfEnabled
+	}
+	if c.AdaptiveEnabled != nil {
+		settings.AdaptiveEnabled = c.AdaptiveEnabled
+	}
+	if len(c.HostTypes) > 0 {
+		hostTypes := make([]api.HostType, len(c.HostTypes))
+		for i, hostType := range c.HostTypes {
+			hostTypes[i] = api.HostType(hostType)
+		}
+		settings.HostTypes = &hostTypes
+	}
+	if len(c.HostIds) > 0 {
+		hostIDs := append([]int(nil), c.HostIds...)
+		settings.HostIds = &hostIDs
+	}
+}
+
+func validateDaemonConfig(creation api.DaemonConfigCreation) error {
+	if creation.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidDaemonConfig)
+	}
+	if creation.DaemonId != nil && creation.MatchLabels != nil && len(*creation.MatchLabels) > 0 {
+		return fmt.Errorf("%w: set either daemon_id or match_labels, not both", ErrInvalidDaemonConfig)
+	}
+
+	settings := derefSettings(creation.Settings)
+	if v := settings.SpeedtestIntervalSeconds; v != nil && *v < 60 {
+		return fmt.Errorf("%w: speedtest_interval_seconds must be at least 60", ErrInvalidDaemonConfig)
+	}
+	if v := settings.IperfIntervalSeconds; v != nil && *v < 60 {
+		return fmt.Errorf("%w: iperf_interval_seconds must be at least 60", ErrInvalidDaemonConfig)
+	}
+	if v := settings.IperfDurationSeconds; v != nil && (*v < 1 || *v > 300) {
+		return fmt.Errorf("%w: iperf_duration_seconds must be between 1 and 300", ErrInvalidDaemonConfig)
+	}
+	for _, hostType := range hostTypeStrings(settings.HostTypes) {
+		if hostType != "lan" && hostType != "vpn" && hostType != "remote" {
+			return fmt.Errorf("%w: invalid host type '%s'. Must be one of: lan, vpn, remote", ErrInvalidDaemonConfig, hostType)
+		}
+	}
+
+	return nil
+}
+
+// labelsMatch reports whether the daemon carries every selector label. An
+// empty selector matches every daemon.
+func labelsMatch(selector, labels map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func derefSettings(settings *api.DaemonSettings) api.DaemonSettings {
+	if settings == nil {
+		return api.DaemonSettings{}
+	}
+	return *settings
+}
+
+func hostTypeStrings(hostTypes *[]api.HostType) []string {
+	if hostTypes == nil {
+		return nil
+	}
+	result := make([]string, len(*hostTypes))
+	for i, hostType := range *hostTypes {
+		result[i] = string(hostType)
+	}
+	return result
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before
+// it is dropped
+const subscriberBuffer = 64
+
+// EventType names what an event reports
+type EventType string
+
+const (
+	EventSpeedTestResult EventType = "speedtest_result"
+	EventIperfResult     EventType = "iperf_result"
+	EventHostCreated     EventType = "host_created"
+	EventHostUpdated     EventType = "host_updated"
+	EventHostDeleted     EventType = "host_deleted"
+	EventDaemonHeartbeat EventType = "daemon_heartbeat"
+	EventAlert           EventType = "alert"
+)
+
+// Alert kinds
+const (
+	// AlertIperfFailed reports an iperf test that ran and failed. Tests
+	// blocked by an upstream failure are not alerted on again.
+	AlertIperfFailed = "iperf_failed"
+	// AlertResultQuarantined reports a result held back for clock skew
+	AlertResultQuarantined = "result_quarantined"
+)
+
+// Alert describes a condition worth attention
+type Alert struct {
+	Kind    string
+	Message string
+}
+
+// Event is a change published on the event bus. DaemonID and HostID are set
+// when the event concerns a daemon or host, and exactly one payload is set
+// for the event type; host deletions only carry the HostID.
+type Event struct {
+	ID       uint64
+	Type     EventType
+	Time     time.Time
+	DaemonID string
+	HostID   int
+
+	SpeedTest *ent.SpeedTest
+	IperfTest *ent.IperfTest
+	Host      *ent.Host
+	Daemon    *ent.Daemon
+	Alert     *Alert
+}
+
+// EventPublisher accepts events for delivery
+type EventPublisher interface {
+	Publish(event Event)
+}
+
+// EventFilter selects the events a subscriber receives. Every set field
+// narrows the selection; events without a daemon or host never match a
+// daemon or host filter.
+type EventFilter struct {
+	Types    []EventType
+	DaemonID string
+	HostID   *int
+}
+
+func (f EventFilter) matches(event Event) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+	if f.DaemonID != "" && event.DaemonID != f.DaemonID {
+		return false
+	}
+	if f.HostID != nil && event.HostID != *f.HostID {
+		return false
+	}
+	return true
+}
+
+// Subscription receives the events matching its filter until it is closed
+type Subscription struct {
+	// Events is closed when the subscription ends, either by Unsubscribe,
+	// the bus closing or the subscriber falling too far behind
+	Events <-chan Event
+
+	events chan Event
+	filter EventFilter
+}
+
+// EventBus fans published events out to subscribers in process. Publishing
+// never blocks: a subscriber that falls behind is dropped and has to
+// subscribe again.
+type EventBus struct {
+	mu          sync.Mutex
+	nextID      uint64
+	subscribers map[*Subscription]struct{}
+	closed      bool
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: map[*Subscription]struct{}{},
+	}
+}
+
+// Publish delivers an event to every matching subscriber. A nil bus
+// discards events, so services used outside the API server need none.
+func (b *EventBus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	event.ID = b.nextID
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	for sub := range b.subscribers {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Printf("Dropping event subscriber that fell %d events behind", subscriberBuffer)
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribe starts receiving the events matching the filter
+func (b *EventBus) Subscribe(filter EventFilter) *Subscription {
+	events := make(chan Event, subscriberBuffer)
+	sub := &Subscription{Events: events, events: events, filter: filter}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(events)
+		return sub
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe stops a subscription. It is safe to call more than once.
+func (b *EventBus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+// Close ends every subscription, letting streams finish so the server can
+// shut down
+func (b *EventBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// remove ends a subscription; the caller holds the lock
+func (b *EventBus) remove(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+// eventQueue holds events until a transaction commits, so subscribers never
+// see results that were rolled back
+type eventQueue struct {
+	events []Event
+}
+
+func (q *eventQueue) Publish(event Event) {
+	q.events = append(q.events, event)
+}
+
+// flush publishes the queued events
+func (q *eventQueue) flush(to EventPublisher) {
+	for _, event := range q.events {
+		to.Publish(event)
+	}
+	q.events = nil
+}
+
+// publish sends an event when a publisher is configured
+func publish(to EventPublisher, event Event) {
+	if to != nil {
+		to.Publish(event)
+	}
+}
@@ -0,0 +1,323 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+)
+
+// exportBatchSize is how many rows an export reads per query. Batches are
+// read by keyset, so memory stays flat however many rows match.
+const exportBatchSize = 1000
+
+// defaultExportSort lists results oldest first, the order analysis tools
+// expect a time series in
+const defaultExportSort = "timestamp"
+
+// ExportFormat is the encoding of an export
+type ExportFormat string
+
+const (
+	ExportCSV    ExportFormat = "csv"
+	ExportNDJSON ExportFormat = "ndjson"
+)
+
+// ErrInvalidExport is returned for an export format that is not supported
+var ErrInvalidExport = errors.New("invalid export")
+
+// ParseExportFormat validates an export format name
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch format := ExportFormat(name); format {
+	case ExportCSV, ExportNDJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: unknown format %q, use csv or ndjson", ErrInvalidExport, name)
+	}
+}
+
+// exportColumn is a named column of an export and how to read it from a
+// result. Values are nil when the result has none.
+type exportColumn[T any] struct {
+	name  string
+	value func(T) any
+}
+
+var speedTestExportColumns = []exportColumn[*ent.SpeedTest]{
+	{"id", func(t *ent.SpeedTest) any { return t.ID }},
+	{"timestamp", func(t *ent.SpeedTest) any { return t.Timestamp }},
+	{"daemon_id", func(t *ent.SpeedTest) any { return t.DaemonID }},
+	{"download_mbps", func(t *ent.SpeedTest) any { return t.DownloadMbps }},
+	{"upload_mbps", func(t *ent.SpeedTest) any { return t.UploadMbps }},
+	{"ping_ms", func(t *ent.SpeedTest) any { return t.PingMs }},
+	{"jitter_ms", func(t *ent.SpeedTest) any { return t.JitterMs }},
+	{"server_name", func(t *ent.SpeedTest) any { return t.ServerName }},
+	{"server_id", func(t *ent.SpeedTest) any { return t.ServerID }},
+	{"isp", func(t *ent.SpeedTest) any { return t.Isp }},
+	{"external_ip", func(t *ent.SpeedTest) any { return t.ExternalIP }},
+	{"result_url", func(t *ent.SpeedTest) any { return t.ResultURL }},
+	{"trigger", func(t *ent.SpeedTest) any { return string(t.Trigger) }},
+	{"campaign_id", func(t *ent.SpeedTest) any { return optional(t.CampaignID) }},
+	{"received_at", func(t *ent.SpeedTest) any { return optional(t.ReceivedAt) }},
+	{"clock_offset_ms", func(t *ent.SpeedTest) any { return optional(t.ClockOffsetMs) }},
+	{"quarantined", func(t *ent.SpeedTest) any { return t.Quarantined }},
+}
+
+var iperfTestExportColumns = []exportColumn[*ent.IperfTest]{
+	{"id", func(t *ent.IperfTest) any { return t.ID }},
+	{"timestamp", func(t *ent.IperfTest) any { return t.Timestamp }},
+	{"daemon_id", func(t *ent.IperfTest) any { return t.DaemonID }},
+	{"host_id", func(t *ent.IperfTest) any {
+		if t.Edges.Host == nil {
+			return nil
+		}
+		return t.Edges.Host.ID
+	}},
+	{"host_name", func(t *ent.IperfTest) any {
+		if t.Edges.Host == nil {
+			return nil
+		}
+		return t.Edges.Host.Name
+	}},
+	{"host_type", func(t *ent.IperfTest) any {
+		if t.Edges.Host == nil {
+			return nil
+		}
+		return string(t.Edges.Host.Type)
+	}},
+	{"protocol", func(t *ent.IperfTest) any { return t.Protocol }},
+	{"duration_seconds", func(t *ent.IperfTest) any { return t.DurationSeconds }},
+	{"sent_mbps", func(t *ent.IperfTest) any { return t.SentMbps }},
+	{"received_mbps", func(t *ent.IperfTest) any { return t.ReceivedMbps }},
+	{"mean_rtt_ms", func(t *ent.IperfTest) any { return t.MeanRttMs }},
+	{"retransmits", func(t *ent.IperfTest) any { return t.Retransmits }},
+	{"success", func(t *ent.IperfTest) any { return t.Success }},
+	{"blocked_by", func(t *ent.IperfTest) any { return string(t.BlockedBy) }},
+	{"error_message", func(t *ent.IperfTest) any { return t.ErrorMessage }},
+	{"trigger", func(t *ent.IperfTest) any { return string(t.Trigger) }},
+	{"campaign_id", func(t *ent.IperfTest) any { return optional(t.CampaignID) }},
+	{"received_at", func(t *ent.IperfTest) any { return optional(t.ReceivedAt) }},
+	{"clock_offset_ms", func(t *ent.IperfTest) any { return optional(t.ClockOffsetMs) }},
+	{"quarantined", func(t *ent.IperfTest) any { return t.Quarantined }},
+}
+
+// optional returns the value a pointer field holds, or nil
+func optional[T any](ptr *T) any {
+	if ptr == nil {
+		return nil
+	}
+	return *ptr
+}
+
+// exportEncoder writes rows as CSV with a header line, or as one JSON object
+// per line keyed by column name
+type exportEncoder struct {
+	format  ExportFormat
+	columns []string
+	w       io.Writer
+	csv     *csv.Writer
+}
+
+func newExportEncoder(format ExportFormat, w io.Writer, columns []string) (*exportEncoder, error) {
+	enc := &exportEncoder{format: format, columns: columns, w: w}
+	switch format {
+	case ExportCSV:
+		enc.csv = csv.NewWriter(w)
+		if err := enc.csv.Write(columns); err != nil {
+			return nil, err
+		}
+	case ExportNDJSON:
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidExport, format)
+	}
+	return enc, nil
+}
+
+func (e *exportEncoder) write(values []any) error {
+	if e.format == ExportNDJSON {
+		// Keys keep the column order, which a map would lose
+		line := []byte{'{'}
+		for i, v := range values {
+			value, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				line = append(line, ',')
+			}
+			line = strconv.AppendQuote(line, e.columns[i])
+			line = append(line, ':')
+			line = append(line, value...)
+		}
+		line = append(line, '}', '\n')
+		_, err := e.w.Write(line)
+		return err
+	}
+
+	fields := make([]string, len(values))
+	for i, v := range values {
+		fields[i] = csvField(v)
+	}
+	return e.csv.Write(fields)
+}
+
+// flush pushes buffered rows to the writer, and on to the client when the
+// writer is an HTTP response
+func (e *exportEncoder) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if f, ok := e.w.(interface{ Flush() }); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// csvField formats a value for a CSV cell. Times are RFC 3339 in UTC and
+// missing values are empty.
+func csvField(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// exportRows encodes every row yielded by each, flushing after each batch
+func exportRows[T any](format ExportFormat, w io.Writer, columns []exportColumn[T], each func(fn func([]T) error) error) error {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+
+	enc, err := newExportEncoder(format, w, names)
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, len(columns))
+	err = each(func(batch []T) error {
+		for _, row := range batch {
+			for i, c := range columns {
+				values[i] = c.value(row)
+			}
+			if err := enc.write(values); err != nil {
+				return err
+			}
+		}
+		return enc.flush()
+	})
+	if err != nil {
+		return err
+	}
+	return enc.flush()
+}
+
+// Export writes the speed tests matching the filter to w. The filter's sort
+// defaults to oldest first and its limit caps the rows written; cursor and
+// offset are ignored. Sort errors are returned before anything is written.
+func (s *SpeedTestService) Export(ctx context.Context, filter SpeedTestFilter, format ExportFormat, w io.Writer) error {
+	if filter.Sort == "" {
+		filter.Sort = defaultExportSort
+	}
+	sort, err := parseSort(filter.Sort, defaultResultSort, speedTestSortColumns)
+	if err != nil {
+		return err
+	}
+	column := speedTestSortColumns[sort.Field]
+
+	return exportRows(format, w, speedTestExportColumns, func(fn func([]*ent.SpeedTest) error) error {
+		var last *ent.SpeedTest
+		written := 0
+		for {
+			query := s.client.SpeedTest.Query().Where(filter.predicates()...)
+			if last != nil {
+				query.Where(keysetAfter(sort, column, speedTestSortValue(last, sort.Field), last.ID))
+			}
+
+			batch := exportBatchSize
+			if filter.Limit > 0 {
+				batch = min(batch, filter.Limit-written)
+			}
+			tests, err := query.Order(keysetOrder(sort, column)).Limit(batch).All(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to export speed tests: %w", err)
+			}
+			if len(tests) == 0 {
+				return nil
+			}
+			if err := fn(tests); err != nil {
+				return err
+			}
+
+			written += len(tests)
+			if len(tests) < batch || (filter.Limit > 0 && written >= filter.Limit) {
+				return nil
+			}
+			last = tests[len(tests)-1]
+		}
+	})
+}
+
+// Export writes the iperf tests matching the filter, with their hosts, to
+// w. The filter's sort defaults to oldest first and its limit caps the rows
+// written; cursor and offset are ignored. Sort errors are returned before
+// anything is written.
+func (s *IperfService) Export(ctx context.Context, filter IperfTestFilter, format ExportFormat, w io.Writer) error {
+	if filter.Sort == "" {
+		filter.Sort = defaultExportSort
+	}
+	sort, err := parseSort(filter.Sort, defaultResultSort, iperfTestSortColumns)
+	if err != nil {
+		return err
+	}
+	column := iperfTestSortColumns[sort.Field]
+
+	return exportRows(format, w, iperfTestExportColumns, func(fn func([]*ent.IperfTest) error) error {
+		var last *ent.IperfTest
+		written := 0
+		for {
+			query := s.client.IperfTest.Query().Where(filter.predicates()...)
+			if last != nil {
+				query.Where(keysetAfter(sort, column, iperfTestSortValue(last, sort.Field), last.ID))
+			}
+
+			batch := exportBatchSize
+			if filter.Limit > 0 {
+				batch = min(batch, filter.Limit-written)
+			}
+			tests, err := query.WithHost().Order(keysetOrder(sort, column)).Limit(batch).All(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to export iperf tests: %w", err)
+			}
+			if len(tests) == 0 {
+				return nil
+			}
+			if err := fn(tests); err != nil {
+				return err
+			}
+
+			written += len(tests)
+			if len(tests) < batch || (filter.Limit > 0 && written >= filter.Limit) {
+				return nil
+			}
+			last = tests[len(tests)-1]
+		}
+	})
+}
@@ -0,0 +1,357 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+)
+
+// defaultResultSort lists the most recent results first
+var defaultResultSort = ResultSort{Field: "timestamp", Desc: true}
+
+// speedTestSortColumns are the columns speed test listings can be sorted by
+var speedTestSortColumns = map[string]sortColumn{
+	speedtest.FieldTimestamp:    {kind: kindTime},
+	speedtest.FieldDownloadMbps: {kind: kindFloat},
+	speedtest.FieldUploadMbps:   {kind: kindFloat},
+	speedtest.FieldPingMs:       {kind: kindFloat},
+	speedtest.FieldJitterMs:     {kind: kindFloat, optional: true},
+}
+
+// SpeedTestFilter selects speed test results. Every set field narrows the
+// selection; zero values leave a filter unset.
+type SpeedTestFilter struct {
+	StartTime  *time.Time
+	EndTime    *time.Time
+	DaemonID   string
+	ServerName string
+
+	// Quarantined selects quarantined results instead of regular ones
+	Quarantined bool
+
+	// Sort is a column name, prefixed with "-" for descending order. It
+	// defaults to -timestamp.
+	Sort string
+	// Cursor continues a listing after the page that returned it. Offset is
+	// ignored when it is set.
+	Cursor string
+	// Limit caps the page size; zero returns every match
+	Limit  int
+	Offset int
+}
+
+// SpeedTestPage is a page of a speed test listing
+type SpeedTestPage struct {
+	Tests []*ent.SpeedTest
+	// Total counts the matching tests across all pages
+	Total int
+	// NextCursor continues the listing, empty on the last page
+	NextCursor string
+}
+
+func (f SpeedTestFilter) predicates() []predicate.SpeedTest {
+	where := []predicate.SpeedTest{speedtest.QuarantinedEQ(f.Quarantined)}
+
+	if f.StartTime != nil {
+		where = append(where, speedtest.TimestampGTE(*f.StartTime))
+	}
+	if f.EndTime != nil {
+		where = append(where, speedtest.TimestampLTE(*f.EndTime))
+	}
+	if f.DaemonID != "" {
+		where = append(where, speedtest.DaemonIDEQ(f.DaemonID))
+	}
+	if f.ServerName != "" {
+		where = append(where, speedtest.ServerNameContains(f.ServerName))
+	}
+
+	return where
+}
+
+func speedTestSortValue(t *ent.SpeedTest, field string) any {
+	switch field {
+	case speedtest.FieldDownloadMbps:
+		return t.DownloadMbps
+	case speedtest.FieldUploadMbps:
+		return t.UploadMbps
+	case speedtest.FieldPingMs:
+		return t.PingMs
+	case speedtest.FieldJitterMs:
+		return t.JitterMs
+	default:
+		return t.Timestamp
+	}
+}
+
+// Query returns a page of the speed tests matching the filter
+func (s *SpeedTestService) Query(ctx context.Context, filter SpeedTestFilter) (*SpeedTestPage, error) {
+	sort, err := parseSort(filter.Sort, defaultResultSort, speedTestSortColumns)
+	if err != nil {
+		return nil, err
+	}
+	column := speedTestSortColumns[sort.Field]
+
+	query := s.client.SpeedTest.Query().Where(filter.predicates()...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count speed tests: %w", err)
+	}
+
+	if filter.Cursor != "" {
+		value, id, err := decodeCursor(filter.Cursor, sort, column)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(keysetAfter(sort, column, value, id))
+	} else {
+		query.Offset(filter.Offset)
+	}
+
+	// One extra row tells whether another page follows
+	query.Order(keysetOrder(sort, column))
+	if filter.Limit > 0 {
+		query.Limit(filter.Limit + 1)
+	}
+
+	tests, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query speed tests: %w", err)
+	}
+
+	page := &SpeedTestPage{Tests: tests, Total: total}
+	if filter.Limit > 0 && len(tests) > filter.Limit {
+		page.Tests = tests[:filter.Limit]
+		last := page.Tests[filter.Limit-1]
+		page.NextCursor = encodeCursor(sort, speedTestSortValue(last, sort.Field), last.ID)
+	}
+
+	return page, nil
+}
+
+// iperfTestSortColumns are the columns iperf test listings can be sorted by
+var iperfTestSortColumns = map[string]sortColumn{
+	iperftest.FieldTimestamp:       {kind: kindTime},
+	iperftest.FieldSentMbps:        {kind: kindFloat},
+	iperftest.FieldReceivedMbps:    {kind: kindFloat},
+	iperftest.FieldMeanRttMs:       {kind: kindFloat, optional: true},
+	iperftest.FieldRetransmits:     {kind: kindFloat, optional: true},
+	iperftest.FieldDurationSeconds: {kind: kindInt},
+}
+
+// IperfTestFilter selects iperf test results. Every set field narrows the
+// selection; zero values leave a filter unset.
+type IperfTestFilter struct {
+	StartTime *time.Time
+	EndTime   *time.Time
+	DaemonID  string
+	HostID    *int
+	HostName  string
+	HostType  string
+
+	// Quarantined selects quarantined results instead of regular ones
+	Quarantined bool
+
+	// Sort is a column name, prefixed with "-" for descending order. It
+	// defaults to -timestamp.
+	Sort string
+	// Cursor continues a listing after the page that returned it. Offset is
+	// ignored when it is set.
+	Cursor string
+	// Limit caps the page size; zero returns every match
+	Limit  int
+	Offset int
+}
+
+// IperfTestPage is a page of an iperf test listing
+type IperfTestPage struct {
+	Tests []*ent.IperfTest
+	// Total counts the matching tests across all pages
+	Total int
+	// NextCursor continues the listing, empty on the last page
+	NextCursor string
+}
+
+func (f IperfTestFilter) predicates() []predicate.IperfTest {
+	where := []predicate.IperfTest{iperftest.QuarantinedEQ(f.Quarantined)}
+
+	if f.StartTime != nil {
+		where = append(where, iperftest.TimestampGTE(*f.StartTime))
+	}
+	if f.EndTime != nil {
+		where = append(where, iperftest.TimestampLTE(*f.EndTime))
+	}
+	if f.DaemonID != "" {
+		where = append(where, iperftest.DaemonIDEQ(f.DaemonID))
+	}
+
+	var hostWhere []predicate.Host
+	if f.HostID != nil {
+		hostWhere = append(hostWhere, host.ID(*f.HostID))
+	}
+	if f.HostName != "" {
+		hostWhere = append(hostWhere, host.NameContains(f.HostName))
+	}
+	if f.HostType != "" {
+		hostWhere = append(hostWhere, host.TypeEQ(host.Type(f.HostType)))
+	}
+	if len(hostWhere) > 0 {
+		where = append(where, iperftest.HasHostWith(hostWhere...))
+	}
+
+	return where
+}
+
+func iperfTestSortValue(t *ent.IperfTest, field string) any {
+	switch field {
+	case iperftest.FieldSentMbps:
+		return t.SentMbps
+	case iperftest.FieldReceivedMbps:
+		return t.ReceivedMbps
+	case iperftest.FieldMeanRttMs:
+		return t.MeanRttMs
+	case iperftest.FieldRetransmits:
+		return t.Retransmits
+	case iperftest.FieldDurationSeconds:
+		return t.DurationSeconds
+	default:
+		return t.Timestamp
+	}
+}
+
+// Query returns a page of the iperf tests matching the filter, with their
+// hosts
+func (s *IperfService) Query(ctx context.Context, filter IperfTestFilter) (*IperfTestPage, error) {
+	sort, err := parseSort(filter.Sort, defaultResultSort, iperfTestSortColumns)
+	if err != nil {
+		return nil, err
+	}
+	column := iperfTestSortColumns[sort.Field]
+
+	query := s.client.IperfTest.Query().Where(filter.predicates()...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count iperf tests: %w", err)
+	}
+
+	if filter.Cursor != "" {
+		value, id, err := decodeCursor(filter.Cursor, sort, column)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(keysetAfter(sort, column, value, id))
+	} else {
+		query.Offset(filter.Offset)
+	}
+
+	// One extra row tells whether another page follows
+	query.
+		WithHost().
+		Order(keysetOrder(sort, column))
+	if filter.Limit > 0 {
+		query.Limit(filter.Limit + 1)
+	}
+
+	tests, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query iperf tests: %w", err)
+	}
+
+	page := &IperfTestPage{Tests: tests, Total: total}
+	if filter.Limit > 0 && len(tests) > filter.Limit {
+		page.Tests = tests[:filter.Limit]
+		last := page.Tests[filter.Limit-1]
+		page.NextCursor = encodeCursor(sort, iperfTestSortValue(last, sort.Field), last.ID)
+	}
+
+	return page, nil
+}
+
+// hostSort lists hosts by name
+var (
+	hostSort       = ResultSort{Field: host.FieldName}
+	hostSortColumn = sortColumn{kind: kindString}
+)
+
+// HostFilter selects hosts. Every set field narrows the selection; zero
+// values leave a filter unset.
+type HostFilter struct {
+	Type   string
+	Active *bool
+	// DaemonID selects the hosts the daemon may test
+	DaemonID string
+
+	// Cursor continues a listing after the page that returned it
+	Cursor string
+	// Limit caps the page size; zero returns every match
+	Limit int
+}
+
+// HostPage is a page of a host listing
+type HostPage struct {
+	Hosts []*ent.Host
+	// NextCursor continues the listing, empty on the last page
+	NextCursor string
+}
+
+// QueryHosts returns a page of the hosts matching the filter, by name
+func (s *IperfService) QueryHosts(ctx context.Context, filter HostFilter) (*HostPage, error) {
+	query := s.client.Host.Query()
+
+	if filter.Type != "" {
+		query.Where(host.TypeEQ(host.Type(filter.Type)))
+	}
+	if filter.Active != nil {
+		query.Where(host.ActiveEQ(*filter.Active))
+	}
+	if filter.Cursor != "" {
+		value, id, err := decodeCursor(filter.Cursor, hostSort, hostSortColumn)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(keysetAfter(hostSort, hostSortColumn, value, id))
+	}
+
+	// Daemon scopes are checked after the query, so only unscoped listings
+	// are limited in SQL. One extra row tells whether another page follows.
+	query.Order(keysetOrder(hostSort, hostSortColumn))
+	if filter.Limit > 0 && filter.DaemonID == "" {
+		query.Limit(filter.Limit + 1)
+	}
+
+	hosts, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hosts: %w", err)
+	}
+
+	if filter.DaemonID != "" {
+		labels, err := daemonLabels(ctx, s.client, filter.DaemonID)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed := make([]*ent.Host, 0, len(hosts))
+		for _, h := range hosts {
+			if HostScopeOf(h).Allows(filter.DaemonID, labels) {
+				allowed = append(allowed, h)
+			}
+		}
+		hosts = allowed
+	}
+
+	page := &HostPage{Hosts: hosts}
+	if filter.Limit > 0 && len(hosts) > filter.Limit {
+		page.Hosts = hosts[:filter.Limit]
+		last := page.Hosts[filter.Limit-1]
+		page.NextCursor = encodeCursor(hostSort, last.Name, last.ID)
+	}
+
+	return page, nil
+}
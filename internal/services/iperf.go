@@ -3,19 +3,31 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
 	"os/exec"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/bfirestone/speed-checker/ent"
 	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
 	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/config"
 )
 
+// ErrHostNotFound is returned when a submission references an unknown host
+var ErrHostNotFound = errors.New("host not found")
+
 type IperfService struct {
-	client *ent.Client
+	client       *ent.Client
+	dependencies config.HostDependencies
+	events       EventPublisher
 }
 
 type IperfResult struct {
@@ -86,32 +98,82 @@ type IperfResult struct {
 	} `json:"end"`
 }
 
-func NewIperfService(client *ent.Client) *IperfService {
+// NewIperfService creates the service. Stored results and host changes are
+// published to events, which may be nil.
+func NewIperfService(client *ent.Client, dependencies config.HostDependencies, events EventPublisher) *IperfService {
 	return &IperfService{
-		client: client,
+		client:       client,
+		dependencies: dependencies,
+		events:       events,
 	}
 }
 
+// RunRandomTests tests a random active host of each type. Host types are
+// tested in dependency order, and a type whose prerequisite failed is
+// recorded as blocked instead of being tested.
 func (s *IperfService) RunRandomTests(ctx context.Context, duration int) error {
-	// Test against random LAN hosts
-	if err := s.runTestsForType(ctx, "lan", duration); err != nil {
-		log.Printf("LAN tests failed: %v", err)
+	failed := make(map[string]bool)
+
+	for _, hostType := range s.dependencies.Order(HostTypes()) {
+		if upstream, blocked := s.dependencies.BlockedBy(hostType, failed); blocked {
+			failed[hostType] = true
+			if err := s.recordBlockedTest(ctx, hostType, upstream, duration); err != nil {
+				log.Printf("Failed to record blocked %s test: %v", hostType, err)
+			}
+			continue
+		}
+
+		if err := s.runTestsForType(ctx, hostType, duration); err != nil {
+			log.Printf("%s tests failed: %v", strings.ToUpper(hostType), err)
+			failed[hostType] = true
+		}
 	}
 
-	// Test against random VPN hosts
-	if err := s.runTestsForType(ctx, "vpn", duration); err != nil {
-		log.Printf("VPN tests failed: %v", err)
+	return nil
+}
+
+// HostTypes returns every host type in its default test order
+func HostTypes() []string {
+	return []string{string(host.TypeLan), string(host.TypeVpn), string(host.TypeRemote)}
+}
+
+func (s *IperfService) runTestsForType(ctx context.Context, hostType string, duration int) error {
+	selectedHost, err := s.randomActiveHost(ctx, hostType)
+	if err != nil || selectedHost == nil {
+		return err
 	}
 
-	// Test against random remote hosts
-	if err := s.runTestsForType(ctx, "remote", duration); err != nil {
-		log.Printf("Remote tests failed: %v", err)
+	log.Printf("Running iperf3 test against %s host: %s (%s:%d)",
+		hostType, selectedHost.Name, selectedHost.Hostname, selectedHost.Port)
+
+	// Run the test
+	return s.runTest(ctx, selectedHost, duration)
+}
+
+// recordBlockedTest stores a failed result without running iperf3 so the
+// history shows which upstream segment caused the gap
+func (s *IperfService) recordBlockedTest(ctx context.Context, hostType, upstream string, duration int) error {
+	selectedHost, err := s.randomActiveHost(ctx, hostType)
+	if err != nil || selectedHost == nil {
+		return err
 	}
 
-	return nil
+	log.Printf("Skipping iperf3 test against %s host %s: %s",
+		hostType, selectedHost.Name, config.BlockedMessage(upstream))
+
+	_, err = s.create(ctx, selectedHost, s.client.IperfTest.
+		Create().
+		SetHost(selectedHost).
+		SetSuccess(false).
+		SetBlockedBy(iperftest.BlockedBy(upstream)).
+		SetErrorMessage(config.BlockedMessage(upstream)).
+		SetDurationSeconds(duration))
+	return err
 }
 
-func (s *IperfService) runTestsForType(ctx context.Context, hostType string, duration int) error {
+// randomActiveHost picks a random active host of the given type, returning
+// nil when there is none
+func (s *IperfService) randomActiveHost(ctx context.Context, hostType string) (*ent.Host, error) {
 	// Get active hosts of the specified type
 	hosts, err := s.client.Host.
 		Query().
@@ -124,21 +186,16 @@ func (s *IperfService) runTestsForType(ctx context.Context, hostType string, dur
 		All(ctx)
 
 	if err != nil {
-		return fmt.Errorf("failed to query %s hosts: %v", hostType, err)
+		return nil, fmt.Errorf("failed to query %s hosts: %v", hostType, err)
 	}
 
 	if len(hosts) == 0 {
 		log.Printf("No active %s hosts found", hostType)
-		return nil
+		return nil, nil
 	}
 
 	// Select a random host
-	selectedHost := hosts[rand.Intn(len(hosts))]
-	log.Printf("Running iperf3 test against %s host: %s (%s:%d)",
-		hostType, selectedHost.Name, selectedHost.Hostname, selectedHost.Port)
-
-	// Run the test
-	return s.runTest(ctx, selectedHost, duration)
+	return hosts[rand.Intn(len(hosts))], nil
 }
 
 func (s *IperfService) runTest(ctx context.Context, testHost *ent.Host, duration int) error {
@@ -157,13 +214,12 @@ func (s *IperfService) runTest(ctx context.Context, testHost *ent.Host, duration
 	output, err := cmd.Output()
 	if err != nil {
 		// Save failed test result
-		_, saveErr := s.client.IperfTest.
+		_, saveErr := s.create(ctx, testHost, s.client.IperfTest.
 			Create().
 			SetHost(testHost).
 			SetSuccess(false).
 			SetErrorMessage(err.Error()).
-			SetDurationSeconds(duration).
-			Save(ctx)
+			SetDurationSeconds(duration))
 		if saveErr != nil {
 			log.Printf("Failed to save error result: %v", saveErr)
 		}
@@ -187,7 +243,7 @@ func (s *IperfService) runTest(ctx context.Context, testHost *ent.Host, duration
 	}
 
 	// Save successful test result
-	iperfTest, err := s.client.IperfTest.
+	_, err = s.create(ctx, testHost, s.client.IperfTest.
 		Create().
 		SetHost(testHost).
 		SetSentMbps(sentMbps).
@@ -196,8 +252,7 @@ func (s *IperfService) runTest(ctx context.Context, testHost *ent.Host, duration
 		SetMeanRttMs(meanRtt).
 		SetDurationSeconds(duration).
 		SetProtocol("TCP").
-		SetSuccess(true).
-		Save(ctx)
+		SetSuccess(true))
 
 	if err != nil {
 		return fmt.Errorf("failed to save iperf test result: %v", err)
@@ -206,13 +261,13 @@ func (s *IperfService) runTest(ctx context.Context, testHost *ent.Host, duration
 	log.Printf("Iperf3 test completed - Sent: %.2f Mbps, Received: %.2f Mbps, RTT: %.2f ms",
 		sentMbps, receivedMbps, meanRtt)
 
-	_ = iperfTest
 	return nil
 }
 
 func (s *IperfService) GetRecentTests(ctx context.Context, limit int) ([]*ent.IperfTest, error) {
 	return s.client.IperfTest.
 		Query().
+		Where(iperftest.QuarantinedEQ(false)).
 		WithHost().
 		Order(ent.Desc("timestamp")).
 		Limit(limit).
@@ -220,25 +275,68 @@ func (s *IperfService) GetRecentTests(ctx context.Context, limit int) ([]*ent.Ip
 }
 
 func (s *IperfService) GetTestsInRange(ctx context.Context, start, end time.Time) ([]*ent.IperfTest, error) {
-	// TODO: Implement timestamp filtering once we add the iperftest predicate import
 	return s.client.IperfTest.
 		Query().
+		Where(
+			iperftest.TimestampGTE(start),
+			iperftest.TimestampLTE(end),
+			iperftest.QuarantinedEQ(false),
+		).
 		WithHost().
 		Order(ent.Desc("timestamp")).
 		All(ctx)
 }
 
+// HostScope limits which daemons test a host. A host with neither a
+// selector nor assigned daemons is tested by every daemon.
+type HostScope struct {
+	DaemonSelector map[string]string
+	DaemonIDs      []string
+}
+
+// Allows reports whether a daemon with the given ID and labels may test the
+// host: it is assigned explicitly, or carries every selector label
+func (s HostScope) Allows(daemonID string, labels map[string]string) bool {
+	if len(s.DaemonSelector) == 0 && len(s.DaemonIDs) == 0 {
+		return true
+	}
+	if slices.Contains(s.DaemonIDs, daemonID) {
+		return true
+	}
+	return len(s.DaemonSelector) > 0 && labelsMatch(s.DaemonSelector, labels)
+}
+
+// HostScopeOf returns the daemon scope stored on a host
+func HostScopeOf(h *ent.Host) HostScope {
+	return HostScope{DaemonSelector: h.DaemonSelector, DaemonIDs: h.DaemonIds}
+}
+
 // Host management methods
-func (s *IperfService) AddHost(ctx context.Context, name, hostname, hostType, description string, port int) (*ent.Host, error) {
-	return s.client.Host.
+func (s *IperfService) AddHost(ctx context.Context, name, hostname, hostType, description string, port int, scope *HostScope) (*ent.Host, error) {
+	builder := s.client.Host.
 		Create().
 		SetName(name).
 		SetHostname(hostname).
 		SetPort(port).
 		SetType(host.Type(hostType)).
 		SetDescription(description).
-		SetActive(true).
-		Save(ctx)
+		SetActive(true)
+	if scope != nil {
+		if len(scope.DaemonSelector) > 0 {
+			builder.SetDaemonSelector(scope.DaemonSelector)
+		}
+		if len(scope.DaemonIDs) > 0 {
+			builder.SetDaemonIds(scope.DaemonIDs)
+		}
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publish(s.events, Event{Type: EventHostCreated, HostID: created.ID, Host: created})
+	return created, nil
 }
 
 func (s *IperfService) GetHosts(ctx context.Context) ([]*ent.Host, error) {
@@ -256,25 +354,52 @@ func (s *IperfService) GetActiveHosts(ctx context.Context) ([]*ent.Host, error)
 		All(ctx)
 }
 
-func (s *IperfService) UpdateHost(ctx context.Context, id int, name, hostname, hostType, description string, port int, active bool) (*ent.Host, error) {
-	return s.client.Host.
+// UpdateHost replaces a host's settings. A nil scope leaves the host's
+// daemon scope unchanged.
+func (s *IperfService) UpdateHost(ctx context.Context, id int, name, hostname, hostType, description string, port int, active bool, scope *HostScope) (*ent.Host, error) {
+	update := s.client.Host.
 		UpdateOneID(id).
 		SetName(name).
 		SetHostname(hostname).
 		SetPort(port).
 		SetType(host.Type(hostType)).
 		SetDescription(description).
-		SetActive(active).
-		Save(ctx)
+		SetActive(active)
+	if scope != nil {
+		if len(scope.DaemonSelector) > 0 {
+			update.SetDaemonSelector(scope.DaemonSelector)
+		} else {
+			update.ClearDaemonSelector()
+		}
+		if len(scope.DaemonIDs) > 0 {
+			update.SetDaemonIds(scope.DaemonIDs)
+		} else {
+			update.ClearDaemonIds()
+		}
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publish(s.events, Event{Type: EventHostUpdated, HostID: updated.ID, Host: updated})
+	return updated, nil
 }
 
 func (s *IperfService) DeleteHost(ctx context.Context, id int) error {
-	return s.client.Host.DeleteOneID(id).Exec(ctx)
+	if err := s.client.Host.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+
+	publish(s.events, Event{Type: EventHostDeleted, HostID: id})
+	return nil
 }
 
 func (s *IperfService) GetTestsByHostName(ctx context.Context, hostName string, limit int) ([]*ent.IperfTest, error) {
 	return s.client.IperfTest.
 		Query().
+		Where(iperftest.QuarantinedEQ(false)).
 		WithHost(func(q *ent.HostQuery) {
 			q.Where(host.NameContains(hostName))
 		}).
@@ -286,6 +411,7 @@ func (s *IperfService) GetTestsByHostName(ctx context.Context, hostName string,
 func (s *IperfService) GetTestsByHostType(ctx context.Context, hostType string, limit int) ([]*ent.IperfTest, error) {
 	return s.client.IperfTest.
 		Query().
+		Where(iperftest.QuarantinedEQ(false)).
 		WithHost(func(q *ent.HostQuery) {
 			q.Where(host.TypeEQ(host.Type(hostType)))
 		}).
@@ -297,22 +423,106 @@ func (s *IperfService) GetTestsByHostType(ctx context.Context, hostType string,
 func (s *IperfService) GetSlowestTests(ctx context.Context, limit int) ([]*ent.IperfTest, error) {
 	return s.client.IperfTest.
 		Query().
+		Where(iperftest.QuarantinedEQ(false)).
 		WithHost().
 		Order(ent.Asc("received_mbps")). // Ascending order to get slowest first
 		Limit(limit).
 		All(ctx)
 }
 
+// GetFailureCounts returns the number of failed iperf tests since the given
+// time, split into root-cause failures and tests blocked by an upstream failure
+func (s *IperfService) GetFailureCounts(ctx context.Context, since time.Time) (failed, blocked int, err error) {
+	query := s.client.IperfTest.
+		Query().
+		Where(
+			iperftest.SuccessEQ(false),
+			iperftest.TimestampGTE(since),
+			iperftest.QuarantinedEQ(false),
+		)
+
+	failed, err = query.Clone().Where(iperftest.BlockedByIsNil()).Count(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	blocked, err = query.Clone().Where(iperftest.BlockedByNotNil()).Count(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return failed, blocked, nil
+}
+
 func (s *IperfService) GetTotalCount(ctx context.Context) (int, error) {
-	return s.client.IperfTest.Query().Count(ctx)
+	return s.client.IperfTest.Query().Where(iperftest.QuarantinedEQ(false)).Count(ctx)
+}
+
+// GetBaseline computes the rolling baseline for a host from the most recent
+// successful, non-adaptive iperf tests, optionally restricted to a daemon
+func (s *IperfService) GetBaseline(ctx context.Context, hostID int, daemonID string, samples int) (*Baseline, error) {
+	query := s.client.IperfTest.
+		Query().
+		Where(
+			iperftest.HasHostWith(host.ID(hostID)),
+			iperftest.SuccessEQ(true),
+			iperftest.TriggerNEQ(iperftest.TriggerAdaptive),
+			iperftest.QuarantinedEQ(false),
+		)
+
+	if daemonID != "" {
+		query.Where(iperftest.DaemonIDEQ(daemonID))
+	}
+
+	tests, err := query.
+		Order(ent.Desc("timestamp")).
+		Limit(samples).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query iperf baseline for host %d: %w", hostID, err)
+	}
+
+	download := make([]float64, len(tests))
+	upload := make([]float64, len(tests))
+	var rtt []float64
+	for i, test := range tests {
+		download[i] = test.ReceivedMbps
+		upload[i] = test.SentMbps
+		if test.MeanRttMs > 0 {
+			rtt = append(rtt, test.MeanRttMs)
+		}
+	}
+
+	return &Baseline{
+		Samples:      len(tests),
+		DownloadMbps: median(download),
+		UploadMbps:   median(upload),
+		PingMs:       median(rtt),
+	}, nil
 }
 
-// CreateFromSubmission creates an iperf test record from an API submission
-func (s *IperfService) CreateFromSubmission(ctx context.Context, submission api.IperfTestSubmission) (*ent.IperfTest, error) {
+// CreateFromSubmission creates an iperf test record from an API submission,
+// stamped with the receipt from the clock policy. When a record with the
+// same submission ID already exists it is returned instead and created is
+// false, so retried submissions are safe.
+func (s *IperfService) CreateFromSubmission(ctx context.Context, submission api.IperfTestSubmission, receipt Receipt) (iperfTest *ent.IperfTest, created bool, err error) {
+	if submission.SubmissionId != nil {
+		existing, err := s.findBySubmissionID(ctx, *submission.SubmissionId)
+		if err != nil || existing != nil {
+			return existing, false, err
+		}
+	}
+	if err := receipt.err(); err != nil {
+		return nil, false, err
+	}
+
 	// Get the host by ID
 	targetHost, err := s.client.Host.Get(ctx, submission.HostId)
+	if ent.IsNotFound(err) {
+		return nil, false, fmt.Errorf("%w: %d", ErrHostNotFound, submission.HostId)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to find host with ID %d: %w", submission.HostId, err)
+		return nil, false, fmt.Errorf("failed to find host with ID %d: %w", submission.HostId, err)
 	}
 
 	// Create the iperf test record using Ent
@@ -324,13 +534,32 @@ func (s *IperfService) CreateFromSubmission(ctx context.Context, submission api.
 		SetReceivedMbps(submission.ReceivedMbps).
 		SetProtocol(string(submission.Protocol)).
 		SetDurationSeconds(submission.DurationSeconds).
-		SetDaemonID(submission.DaemonId)
+		SetDaemonID(submission.DaemonId).
+		SetReceivedAt(receipt.ReceivedAt).
+		SetQuarantined(receipt.Quarantined).
+		SetNillableClockOffsetMs(submission.ClockOffsetMs).
+		SetNillableClockCorrected(submission.ClockCorrected).
+		SetNillableCampaignID(submission.CampaignId)
 
 	// Determine success based on whether we have meaningful throughput
 	success := submission.SentMbps > 0 || submission.ReceivedMbps > 0
 	builder.SetSuccess(success)
 
+	// Blocked tests never ran, record the upstream failure as the cause
+	if submission.BlockedBy != nil {
+		builder.
+			SetSuccess(false).
+			SetBlockedBy(iperftest.BlockedBy(*submission.BlockedBy)).
+			SetErrorMessage(config.BlockedMessage(string(*submission.BlockedBy)))
+	}
+
 	// Set optional fields if provided
+	if submission.SubmissionId != nil {
+		builder.SetSubmissionID(*submission.SubmissionId)
+	}
+	if submission.Trigger != nil {
+		builder.SetTrigger(iperftest.Trigger(*submission.Trigger))
+	}
 	if submission.MeanRttMs != nil {
 		builder.SetMeanRttMs(*submission.MeanRttMs)
 	}
@@ -338,17 +567,76 @@ func (s *IperfService) CreateFromSubmission(ctx context.Context, submission api.
 		builder.SetRetransmits(*submission.Retransmits)
 	}
 
+	iperfTest, err = s.create(ctx, targetHost, builder)
+	if err != nil {
+		// A concurrent retry stored the same submission first
+		if submission.SubmissionId != nil && ent.IsConstraintError(err) {
+			if existing, findErr := s.findBySubmissionID(ctx, *submission.SubmissionId); findErr == nil && existing != nil {
+				return existing, false, nil
+			}
+		}
+		return nil, false, fmt.Errorf("failed to save iperf test submission: %w", err)
+	}
+
+	log.Printf("Iperf test submission saved - ID: %d, Daemon: %s, Host: %s, Sent: %.2f Mbps, Received: %.2f Mbps",
+		iperfTest.ID, submission.DaemonId, targetHost.Name, submission.SentMbps, submission.ReceivedMbps)
+
+	return iperfTest, true, nil
+}
+
+// create stores an iperf test against testHost and publishes it with the
+// alerts it raises. Submissions and the server-side runner both store
+// results through it, so event subscribers see every result.
+func (s *IperfService) create(ctx context.Context, testHost *ent.Host, builder *ent.IperfTestCreate) (*ent.IperfTest, error) {
 	iperfTest, err := builder.Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save iperf test submission: %w", err)
+		return nil, err
 	}
 
 	// Set the host edge manually since we already have the host
-	iperfTest.Edges.Host = targetHost
+	iperfTest.Edges.Host = testHost
 
-	log.Printf("Iperf test submission saved - ID: %d, Daemon: %s, Host: %s, Sent: %.2f Mbps, Received: %.2f Mbps",
-		iperfTest.ID, submission.DaemonId, targetHost.Name, submission.SentMbps, submission.ReceivedMbps)
+	s.publishResult(iperfTest)
+	return iperfTest, nil
+}
+
+// publishResult publishes a stored result with the alerts it raises. Tests
+// blocked by an upstream failure already alerted through the failed test
+// that blocked them.
+func (s *IperfService) publishResult(iperfTest *ent.IperfTest) {
+	event := Event{DaemonID: iperfTest.DaemonID, HostID: iperfTest.Edges.Host.ID, IperfTest: iperfTest}
+
+	result := event
+	result.Type = EventIperfResult
+	publish(s.events, result)
+
+	if iperfTest.Quarantined {
+		alert := event
+		alert.Type = EventAlert
+		alert.Alert = &Alert{Kind: AlertResultQuarantined, Message: "Iperf result quarantined for clock skew"}
+		publish(s.events, alert)
+	}
+	if !iperfTest.Success && iperfTest.BlockedBy == "" {
+		alert := event
+		alert.Type = EventAlert
+		alert.Alert = &Alert{Kind: AlertIperfFailed, Message: "Iperf test against " + iperfTest.Edges.Host.Name + " failed"}
+		publish(s.events, alert)
+	}
+}
 
+// findBySubmissionID returns the iperf test stored for a submission ID, or
+// nil when there is none
+func (s *IperfService) findBySubmissionID(ctx context.Context, submissionID uuid.UUID) (*ent.IperfTest, error) {
+	iperfTest, err := s.client.IperfTest.Query().
+		Where(iperftest.SubmissionID(submissionID)).
+		WithHost().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up iperf test submission: %w", err)
+	}
 	return iperfTest, nil
 }
 
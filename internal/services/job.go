@@ -0,0 +1,734 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/predicate"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+const (
+	// jobRetryBackoff is multiplied by the attempt count to delay retried jobs
+	jobRetryBackoff = 30 * time.Second
+
+	// onDemandPriority ranks on-demand runs ahead of scheduled jobs
+	onDemandPriority = 100
+
+	// jobSchedulerTick is how often the job scheduler checks for due jobs
+	jobSchedulerTick = time.Minute
+)
+
+var (
+	// ErrInvalidJob is returned when a job submission is missing required data
+	ErrInvalidJob = errors.New("invalid job")
+	// ErrJobNotFound is returned when a job ID does not exist
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobNotLeased is returned when a daemon reports on a job it does not hold
+	ErrJobNotLeased = errors.New("job is not leased by this daemon")
+)
+
+// JobService manages the server-side job queue that daemons lease work from
+type JobService struct {
+	client        *ent.Client
+	leaseDuration time.Duration
+
+	// changed is closed and replaced whenever a job is enqueued or changes
+	// state, waking long-polling lease and status requests
+	mu      sync.Mutex
+	changed chan struct{}
+}
+
+// LeaseOptions tunes a lease request
+type LeaseOptions struct {
+	MaxJobs       int
+	LeaseDuration time.Duration
+	Wait          time.Duration
+	PinnedOnly    bool
+}
+
+func NewJobService(client *ent.Client, leaseDuration time.Duration) *JobService {
+	return &JobService{
+		client:        client,
+		leaseDuration: leaseDuration,
+		changed:       make(chan struct{}),
+	}
+}
+
+// watch returns a channel that is closed on the next job change
+func (s *JobService) watch() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.changed
+}
+
+// notify wakes every request waiting on a job change
+func (s *JobService) notify() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// Enqueue creates a pending job from an API submission
+func (s *JobService) Enqueue(ctx context.Context, submission api.JobCreation) (*ent.Job, error) {
+	if err := job.TypeValidator(job.Type(submission.Type)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJob, err)
+	}
+
+	builder := s.client.Job.
+		Create().
+		SetType(job.Type(submission.Type))
+
+	if submission.HostId != nil {
+		targetHost, err := s.client.Host.Get(ctx, *submission.HostId)
+		if err != nil {
+			return nil, fmt.Errorf("%w: host %d not found", ErrInvalidJob, *submission.HostId)
+		}
+		builder.SetHost(targetHost)
+	} else if submission.Type == api.JobType(job.TypeIperf) {
+		return nil, fmt.Errorf("%w: iperf jobs require a host_id", ErrInvalidJob)
+	}
+
+	if submission.Type == api.JobType(job.TypeMesh) {
+		if err := s.setMeshTarget(ctx, builder, submission); err != nil {
+			return nil, err
+		}
+	}
+
+	if submission.DaemonId != nil {
+		builder.SetDaemonID(*submission.DaemonId)
+	}
+	if submission.DurationSeconds != nil {
+		builder.SetDurationSeconds(*submission.DurationSeconds)
+	}
+	if submission.MaxAttempts != nil {
+		builder.SetMaxAttempts(*submission.MaxAttempts)
+	}
+	if submission.ScheduledAt != nil {
+		builder.SetScheduledAt(*submission.ScheduledAt)
+	}
+	if submission.Priority != nil {
+		builder.SetPriority(*submission.Priority)
+	}
+	if submission.Trigger != nil {
+		builder.SetTrigger(job.Trigger(*submission.Trigger))
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	log.Printf("Job enqueued - ID: %d, Type: %s", created.ID, created.Type)
+	s.notify()
+
+	return s.client.Job.Query().Where(job.ID(created.ID)).WithHost().Only(ctx)
+}
+
+// setMeshTarget resolves the iperf3 server of a mesh job's target daemon.
+// The address is copied onto the job so the source daemon does not need to
+// look it up.
+func (s *JobService) setMeshTarget(ctx context.Context, builder *ent.JobCreate, submission api.JobCreation) error {
+	if submission.DaemonId == nil || *submission.DaemonId == "" {
+		return fmt.Errorf("%w: mesh jobs must be pinned to a source daemon_id", ErrInvalidJob)
+	}
+	if submission.TargetDaemonId == nil || *submission.TargetDaemonId == "" {
+		return fmt.Errorf("%w: mesh jobs require a target_daemon_id", ErrInvalidJob)
+	}
+	if *submission.TargetDaemonId == *submission.DaemonId {
+		return fmt.Errorf("%w: mesh jobs cannot target their source daemon", ErrInvalidJob)
+	}
+
+	target, err := s.client.Daemon.Get(ctx, *submission.TargetDaemonId)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%w: daemon %s not found", ErrInvalidJob, *submission.TargetDaemonId)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get daemon %s: %w", *submission.TargetDaemonId, err)
+	}
+	if target.MeshAddress == "" {
+		return fmt.Errorf("%w: daemon %s has no mesh_address", ErrInvalidJob, target.ID)
+	}
+
+	builder.
+		SetTargetDaemonID(target.ID).
+		SetTargetAddress(target.MeshAddress)
+	return nil
+}
+
+// RunOnDaemon queues an on-demand run pinned to a daemon. The run is a
+// single-attempt, high-priority job so it is picked up ahead of scheduled work.
+func (s *JobService) RunOnDaemon(ctx context.Context, daemonID string, request api.RunRequest) (*ent.Job, error) {
+	maxAttempts := 1
+	priority := onDemandPriority
+	trigger := api.TestTrigger(job.TriggerManual)
+
+	return s.Enqueue(ctx, api.JobCreation{
+		Type:            request.Type,
+		HostId:          request.HostId,
+		TargetDaemonId:  request.TargetDaemonId,
+		DurationSeconds: request.DurationSeconds,
+		DaemonId:        &daemonID,
+		MaxAttempts:     &maxAttempts,
+		Priority:        &priority,
+		Trigger:         &trigger,
+	})
+}
+
+// GetJob returns a job by ID. With a positive wait it blocks until the job
+// reaches a terminal state or the wait elapses.
+func (s *JobService) GetJob(ctx context.Context, jobID int, wait time.Duration) (*ent.Job, error) {
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+
+	for {
+		changed := s.watch()
+
+		current, err := s.client.Job.Query().Where(job.ID(jobID)).WithHost().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrJobNotFound
+			}
+			return nil, fmt.Errorf("failed to get job %d: %w", jobID, err)
+		}
+
+		if wait <= 0 || current.Status == job.StatusCompleted || current.Status == job.StatusFailed {
+			return current, nil
+		}
+
+		select {
+		case <-changed:
+		case <-deadline.C:
+			return current, nil
+		case <-ctx.Done():
+			return current, nil
+		}
+	}
+}
+
+// GetJobs returns jobs matching the optional status, type and daemon filters
+func (s *JobService) GetJobs(ctx context.Context, status, jobType, daemonID string, limit int) ([]*ent.Job, error) {
+	query := s.client.Job.Query().WithHost()
+
+	if status != "" {
+		query.Where(job.StatusEQ(job.Status(status)))
+	}
+	if jobType != "" {
+		query.Where(job.TypeEQ(job.Type(jobType)))
+	}
+	if daemonID != "" {
+		query.Where(job.Or(job.DaemonIDEQ(daemonID), job.LeasedByEQ(daemonID)))
+	}
+
+	return query.
+		Order(ent.Desc(job.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Lease hands due jobs to a daemon. With a positive wait it long-polls until
+// a job becomes available or the wait elapses, returning an empty slice in
+// the latter case. A long-poll also wakes when a job pinned to the daemon
+// falls due, so campaign jobs start on time on every daemon.
+func (s *JobService) Lease(ctx context.Context, daemonID string, opts LeaseOptions) ([]*ent.Job, error) {
+	if opts.MaxJobs <= 0 {
+		opts.MaxJobs = 1
+	}
+	if opts.LeaseDuration <= 0 {
+		opts.LeaseDuration = s.leaseDuration
+	}
+
+	deadline := time.NewTimer(opts.Wait)
+	defer deadline.Stop()
+
+	for {
+		changed := s.watch()
+
+		leased, err := s.leaseOnce(ctx, daemonID, opts)
+		if err != nil || len(leased) > 0 || opts.Wait <= 0 {
+			return leased, err
+		}
+
+		select {
+		case <-changed:
+		case <-s.nextDue(ctx, daemonID):
+		case <-deadline.C:
+			return leased, nil
+		case <-ctx.Done():
+			return leased, nil
+		}
+	}
+}
+
+// nextDue returns a channel that fires when the next pending job pinned to
+// the daemon becomes due, or nil when none is waiting
+func (s *JobService) nextDue(ctx context.Context, daemonID string) <-chan time.Time {
+	next, err := s.client.Job.
+		Query().
+		Where(
+			job.StatusEQ(job.StatusPending),
+			job.DaemonIDEQ(daemonID),
+			job.ScheduledAtGT(time.Now()),
+		).
+		Order(ent.Asc(job.FieldScheduledAt)).
+		First(ctx)
+	if err != nil {
+		return nil
+	}
+	return time.After(time.Until(next.ScheduledAt))
+}
+
+// leaseOnce claims up to opts.MaxJobs due jobs. Each job is claimed with a
+// conditional update so concurrent daemons never lease the same job.
+func (s *JobService) leaseOnce(ctx context.Context, daemonID string, opts LeaseOptions) ([]*ent.Job, error) {
+	s.releaseExpiredLeases(ctx)
+
+	pinned := job.DaemonIDEQ(daemonID)
+	if !opts.PinnedOnly {
+		pinned = job.Or(
+			job.DaemonIDIsNil(),
+			job.DaemonIDEQ(""),
+			job.DaemonIDEQ(daemonID),
+		)
+	}
+
+	now := time.Now()
+	where := []predicate.Job{
+		job.StatusEQ(job.StatusPending),
+		job.ScheduledAtLTE(now),
+		pinned,
+	}
+
+	// Unpinned jobs against hosts scoped to other daemons are left for the
+	// daemons that can reach them
+	unreachable, err := s.unreachableHostIDs(ctx, daemonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(unreachable) > 0 {
+		where = append(where, job.Or(
+			job.DaemonIDEQ(daemonID),
+			job.Not(job.HasHostWith(host.IDIn(unreachable...))),
+		))
+	}
+
+	candidates, err := s.client.Job.
+		Query().
+		Where(where...).
+		Order(ent.Desc(job.FieldPriority), ent.Asc(job.FieldScheduledAt), ent.Asc(job.FieldID)).
+		Limit(opts.MaxJobs).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending jobs: %w", err)
+	}
+
+	var leasedIDs []int
+	for _, candidate := range candidates {
+		n, err := s.client.Job.
+			Update().
+			Where(job.ID(candidate.ID), job.StatusEQ(job.StatusPending)).
+			SetStatus(job.StatusLeased).
+			SetLeasedBy(daemonID).
+			SetLeaseExpiresAt(now.Add(opts.LeaseDuration)).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lease job %d: %w", candidate.ID, err)
+		}
+		if n == 0 {
+			// Another daemon claimed it first
+			continue
+		}
+		leasedIDs = append(leasedIDs, candidate.ID)
+	}
+
+	if len(leasedIDs) == 0 {
+		return []*ent.Job{}, nil
+	}
+
+	log.Printf("Leased %d job(s) to daemon %s", len(leasedIDs), daemonID)
+	s.notify()
+
+	return s.client.Job.
+		Query().
+		Where(job.IDIn(leasedIDs...)).
+		WithHost().
+		Order(ent.Desc(job.FieldPriority), ent.Asc(job.FieldScheduledAt), ent.Asc(job.FieldID)).
+		All(ctx)
+}
+
+// Complete records the outcome of a leased job, scheduling a retry for
+// failures that have attempts left
+func (s *JobService) Complete(ctx context.Context, jobID int, completion api.JobCompletion) (*ent.Job, error) {
+	current, err := s.client.Job.Get(ctx, jobID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get job %d: %w", jobID, err)
+	}
+
+	if current.Status != job.StatusLeased || current.LeasedBy != completion.DaemonId {
+		return nil, ErrJobNotLeased
+	}
+
+	update := s.client.Job.
+		UpdateOneID(jobID).
+		Where(job.StatusEQ(job.StatusLeased), job.LeasedByEQ(completion.DaemonId))
+
+	if completion.Success {
+		update.
+			SetStatus(job.StatusCompleted).
+			SetCompletedAt(time.Now()).
+			ClearLeaseExpiresAt().
+			ClearErrorMessage()
+		if completion.ResultId != nil {
+			update.SetResultID(*completion.ResultId)
+		}
+	} else {
+		applyJobFailure(update.Mutation(), current, derefOr(completion.ErrorMessage, "job failed"))
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrJobNotLeased
+		}
+		return nil, fmt.Errorf("failed to complete job %d: %w", jobID, err)
+	}
+
+	log.Printf("Job completed - ID: %d, Daemon: %s, Success: %t", jobID, completion.DaemonId, completion.Success)
+	s.notify()
+
+	return s.client.Job.Query().Where(job.ID(jobID)).WithHost().Only(ctx)
+}
+
+// ReleaseExpiredLeases returns jobs whose lease ran out to the queue, or
+// fails them once they have used up their attempts
+func (s *JobService) ReleaseExpiredLeases(ctx context.Context) (int, error) {
+	expired, err := s.client.Job.
+		Query().
+		Where(
+			job.StatusEQ(job.StatusLeased),
+			job.LeaseExpiresAtLT(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired leases: %w", err)
+	}
+
+	released := 0
+	for _, j := range expired {
+		update := s.client.Job.
+			Update().
+			Where(job.ID(j.ID), job.StatusEQ(job.StatusLeased), job.LeasedByEQ(j.LeasedBy))
+		applyJobFailure(update.Mutation(), j, fmt.Sprintf("lease held by %s expired", j.LeasedBy))
+
+		n, err := update.Save(ctx)
+		if err != nil {
+			return released, fmt.Errorf("failed to release job %d: %w", j.ID, err)
+		}
+		released += n
+	}
+
+	if released > 0 {
+		log.Printf("Released %d job(s) with expired leases", released)
+		s.notify()
+	}
+
+	return released, nil
+}
+
+// JobSchedule is the fleet-wide schedule the job scheduler applies to every
+// daemon. A daemon's remote configuration overrides it per daemon.
+type JobSchedule struct {
+	SpeedTestInterval time.Duration
+	IperfInterval     time.Duration
+	IperfDuration     int
+}
+
+// daemonSchedule is the schedule resolved for one daemon
+type daemonSchedule struct {
+	speedTestInterval time.Duration
+	iperfInterval     time.Duration
+	iperfDuration     int
+	speedTestEnabled  bool
+	iperfEnabled      bool
+	hostTypes         []host.Type
+	hostIDs           []int
+}
+
+// scheduleFor applies a daemon's effective settings to the fleet schedule
+func scheduleFor(schedule JobSchedule, settings api.DaemonSettings) daemonSchedule {
+	resolved := daemonSchedule{
+		speedTestInterval: schedule.SpeedTestInterval,
+		iperfInterval:     schedule.IperfInterval,
+		iperfDuration:     schedule.IperfDuration,
+		speedTestEnabled:  derefBoolOr(settings.SpeedtestEnabled, true),
+		iperfEnabled:      derefBoolOr(settings.IperfEnabled, true),
+		hostTypes:         []host.Type{host.TypeLan, host.TypeVpn, host.TypeRemote},
+	}
+	if settings.SpeedtestIntervalSeconds != nil {
+		resolved.speedTestInterval = time.Duration(*settings.SpeedtestIntervalSeconds) * time.Second
+	}
+	if settings.IperfIntervalSeconds != nil {
+		resolved.iperfInterval = time.Duration(*settings.IperfIntervalSeconds) * time.Second
+	}
+	if settings.IperfDurationSeconds != nil {
+		resolved.iperfDuration = *settings.IperfDurationSeconds
+	}
+	if settings.HostTypes != nil && len(*settings.HostTypes) > 0 {
+		resolved.hostTypes = resolved.hostTypes[:0]
+		for _, hostType := range *settings.HostTypes {
+			resolved.hostTypes = append(resolved.hostTypes, host.Type(hostType))
+		}
+	}
+	if settings.HostIds != nil {
+		resolved.hostIDs = *settings.HostIds
+	}
+	return resolved
+}
+
+// daemonJobKey identifies the scheduled jobs of one kind for one daemon
+type daemonJobKey struct {
+	daemonID string
+	jobType  job.Type
+}
+
+// RunScheduler enqueues speed and iperf jobs for every registered daemon
+// that is not dead until the context is cancelled. Jobs are pinned to their
+// daemon, and each daemon follows its own intervals from its remote
+// configuration, falling back to the fleet schedule. A daemon only gets a
+// new job of a kind once its previous one has finished and the interval has
+// passed, so a daemon that stops leasing does not build up a backlog.
+func (s *JobService) RunScheduler(ctx context.Context, daemons *DaemonService, configs *DaemonConfigService, schedule JobSchedule) error {
+	ticker := time.NewTicker(min(jobSchedulerTick, schedule.SpeedTestInterval, schedule.IperfInterval))
+	defer ticker.Stop()
+
+	for {
+		s.schedule(ctx, daemons, configs, schedule)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Job scheduler stopped")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *JobService) releaseExpiredLeases(ctx context.Context) {
+	if _, err := s.ReleaseExpiredLeases(ctx); err != nil {
+		log.Printf("Failed to release expired job leases: %v", err)
+	}
+}
+
+// schedule enqueues the jobs that have come due on each daemon
+func (s *JobService) schedule(ctx context.Context, daemons *DaemonService, configs *DaemonConfigService, schedule JobSchedule) {
+	s.releaseExpiredLeases(ctx)
+
+	registered, err := s.client.Daemon.
+		Query().
+		Order(ent.Asc(daemon.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to query daemons: %v", err)
+		return
+	}
+
+	outstanding, err := s.outstandingScheduledJobs(ctx)
+	if err != nil {
+		log.Printf("Failed to check outstanding scheduled jobs: %v", err)
+		return
+	}
+
+	lastScheduled, err := s.lastScheduledJobs(ctx)
+	if err != nil {
+		log.Printf("Failed to query scheduled job history: %v", err)
+		return
+	}
+
+	hosts, err := s.client.Host.
+		Query().
+		Where(host.ActiveEQ(true)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to query active hosts: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, d := range registered {
+		if daemons.Status(d) == api.Dead {
+			continue
+		}
+
+		effective, err := configs.Resolve(ctx, d.ID)
+		if err != nil {
+			log.Printf("Failed to resolve configuration of daemon %s: %v", d.ID, err)
+			continue
+		}
+		plan := scheduleFor(schedule, effective.Settings)
+
+		speedTest := daemonJobKey{daemonID: d.ID, jobType: job.TypeSpeedtest}
+		if d.HasSpeedtest && plan.speedTestEnabled && !outstanding[speedTest] &&
+			!lastScheduled[speedTest].After(now.Add(-plan.speedTestInterval)) {
+			s.scheduleSpeedTest(ctx, d.ID)
+		}
+
+		iperf := daemonJobKey{daemonID: d.ID, jobType: job.TypeIperf}
+		if d.HasIperf3 && plan.iperfEnabled && !outstanding[iperf] &&
+			!lastScheduled[iperf].After(now.Add(-plan.iperfInterval)) {
+			s.scheduleIperfTests(ctx, d, hosts, plan)
+		}
+	}
+}
+
+// outstandingScheduledJobs reports which daemons still have a speed or
+// iperf job pending or leased
+func (s *JobService) outstandingScheduledJobs(ctx context.Context) (map[daemonJobKey]bool, error) {
+	jobs, err := s.client.Job.
+		Query().
+		Where(
+			job.TypeIn(job.TypeSpeedtest, job.TypeIperf),
+			job.StatusIn(job.StatusPending, job.StatusLeased),
+			job.DaemonIDNEQ(""),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outstanding := make(map[daemonJobKey]bool, len(jobs))
+	for _, j := range jobs {
+		outstanding[daemonJobKey{daemonID: j.DaemonID, jobType: j.Type}] = true
+	}
+	return outstanding, nil
+}
+
+// lastScheduledJobs returns when each daemon was last given a scheduled job
+// of each kind. On-demand and campaign jobs do not move the schedule.
+func (s *JobService) lastScheduledJobs(ctx context.Context) (map[daemonJobKey]time.Time, error) {
+	var rows []struct {
+		DaemonID string    `json:"daemon_id"`
+		Type     job.Type  `json:"type"`
+		Max      time.Time `json:"max"`
+	}
+	err := s.client.Job.
+		Query().
+		Where(
+			job.TypeIn(job.TypeSpeedtest, job.TypeIperf),
+			job.TriggerEQ(job.TriggerScheduled),
+			job.CampaignIDIsNil(),
+			job.DaemonIDNEQ(""),
+		).
+		GroupBy(job.FieldDaemonID, job.FieldType).
+		Aggregate(ent.Max(job.FieldCreatedAt)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	lastScheduled := make(map[daemonJobKey]time.Time, len(rows))
+	for _, row := range rows {
+		lastScheduled[daemonJobKey{daemonID: row.DaemonID, jobType: row.Type}] = row.Max
+	}
+	return lastScheduled, nil
+}
+
+func (s *JobService) scheduleSpeedTest(ctx context.Context, daemonID string) {
+	if _, err := s.Enqueue(ctx, api.JobCreation{
+		Type:     api.JobType(job.TypeSpeedtest),
+		DaemonId: &daemonID,
+	}); err != nil {
+		log.Printf("Failed to enqueue scheduled speed test job for daemon %s: %v", daemonID, err)
+	}
+}
+
+// scheduleIperfTests enqueues one job per host type against a random active
+// host the daemon can reach, mirroring IperfService.RunRandomTests
+func (s *JobService) scheduleIperfTests(ctx context.Context, d *ent.Daemon, hosts []*ent.Host, plan daemonSchedule) {
+	for _, hostType := range plan.hostTypes {
+		var candidates []*ent.Host
+		for _, h := range hosts {
+			if h.Type != hostType || !HostScopeOf(h).Allows(d.ID, d.Labels) {
+				continue
+			}
+			if len(plan.hostIDs) > 0 && !slices.Contains(plan.hostIDs, h.ID) {
+				continue
+			}
+			candidates = append(candidates, h)
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		selectedHost := candidates[rand.Intn(len(candidates))]
+		if _, err := s.Enqueue(ctx, api.JobCreation{
+			Type:            api.JobType(job.TypeIperf),
+			HostId:          &selectedHost.ID,
+			DaemonId:        &d.ID,
+			DurationSeconds: &plan.iperfDuration,
+		}); err != nil {
+			log.Printf("Failed to enqueue scheduled iperf job for %s on daemon %s: %v", selectedHost.Name, d.ID, err)
+		}
+	}
+}
+
+// unreachableHostIDs returns the hosts whose scope excludes the daemon
+func (s *JobService) unreachableHostIDs(ctx context.Context, daemonID string) ([]int, error) {
+	labels, err := daemonLabels(ctx, s.client, daemonID)
+	if err != nil {
+		return nil, err
+	}
+
+	scoped, err := s.client.Host.
+		Query().
+		Where(host.Or(host.DaemonSelectorNotNil(), host.DaemonIdsNotNil())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scoped hosts: %w", err)
+	}
+
+	var unreachable []int
+	for _, h := range scoped {
+		if !HostScopeOf(h).Allows(daemonID, labels) {
+			unreachable = append(unreachable, h.ID)
+		}
+	}
+	return unreachable, nil
+}
+
+// applyJobFailure either requeues the job with backoff or marks it failed
+// when it has no attempts left
+func applyJobFailure(m *ent.JobMutation, j *ent.Job, errorMessage string) {
+	m.SetErrorMessage(errorMessage)
+	m.ClearLeaseExpiresAt()
+
+	if j.Attempts >= j.MaxAttempts {
+		m.SetStatus(job.StatusFailed)
+		m.SetCompletedAt(time.Now())
+		return
+	}
+
+	m.SetStatus(job.StatusPending)
+	m.ClearLeasedBy()
+	m.SetScheduledAt(time.Now().Add(time.Duration(j.Attempts) * jobRetryBackoff))
+}
+
+func derefOr(ptr *string, defaultValue string) string {
+	if ptr != nil && *ptr != "" {
+		return *ptr
+	}
+	return defaultValue
+}
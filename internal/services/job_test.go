@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+func TestApplyJobFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		attempts    int
+		maxAttempts int
+		status      job.Status
+		backoff     time.Duration
+	}{
+		{"first attempt is retried", 1, 3, job.StatusPending, jobRetryBackoff},
+		{"backoff grows with attempts", 2, 3, job.StatusPending, 2 * jobRetryBackoff},
+		{"last attempt fails the job", 3, 3, job.StatusFailed, 0},
+		{"single attempt job fails at once", 1, 1, job.StatusFailed, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := ent.NewClient().Job.UpdateOneID(1).Mutation()
+			before := time.Now()
+			applyJobFailure(m, &ent.Job{Attempts: tt.attempts, MaxAttempts: tt.maxAttempts}, "iperf3 exited")
+
+			if status, _ := m.Status(); status != tt.status {
+				t.Errorf("status = %s, want %s", status, tt.status)
+			}
+			if message, _ := m.ErrorMessage(); message != "iperf3 exited" {
+				t.Errorf("error message = %q", message)
+			}
+			if !m.LeaseExpiresAtCleared() {
+				t.Error("lease expiry was not cleared")
+			}
+
+			if tt.status == job.StatusFailed {
+				if _, ok := m.CompletedAt(); !ok {
+					t.Error("failed job has no completed_at")
+				}
+				if _, ok := m.ScheduledAt(); ok {
+					t.Error("failed job was rescheduled")
+				}
+				return
+			}
+
+			if !m.LeasedByCleared() {
+				t.Error("retried job is still leased")
+			}
+			scheduledAt, ok := m.ScheduledAt()
+			if !ok {
+				t.Fatal("retried job was not rescheduled")
+			}
+			if delay := scheduledAt.Sub(before); delay < tt.backoff || delay > tt.backoff+time.Second {
+				t.Errorf("retry delay = %v, want %v", delay, tt.backoff)
+			}
+		})
+	}
+}
+
+// openTestDatabase connects to the Postgres database named by
+// SPEED_CHECKER_TEST_DSN, skipping the test when it is unset
+func openTestDatabase(t *testing.T) *ent.Client {
+	t.Helper()
+
+	dsn := os.Getenv("SPEED_CHECKER_TEST_DSN")
+	if dsn == "" {
+		t.Skip("SPEED_CHECKER_TEST_DSN is not set")
+	}
+
+	client, err := ent.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// enqueueTestJob enqueues a speed test job pinned to a daemon of its own,
+// deleted again when the test ends
+func enqueueTestJob(t *testing.T, client *ent.Client, s *JobService, maxAttempts int) (*ent.Job, string) {
+	t.Helper()
+	ctx := context.Background()
+
+	daemonID := "test-" + uuid.NewString()
+	t.Cleanup(func() {
+		client.Job.Delete().Where(job.DaemonIDEQ(daemonID)).Exec(context.Background())
+	})
+
+	queued, err := s.Enqueue(ctx, api.JobCreation{
+		Type:        api.JobType(job.TypeSpeedtest),
+		DaemonId:    &daemonID,
+		MaxAttempts: &maxAttempts,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return queued, daemonID
+}
+
+func TestLeaseExpiryAndRetries(t *testing.T) {
+	client := openTestDatabase(t)
+	s := NewJobService(client, time.Minute)
+	ctx := context.Background()
+
+	queued, daemonID := enqueueTestJob(t, client, s, 2)
+	opts := LeaseOptions{PinnedOnly: true, LeaseDuration: 50 * time.Millisecond}
+
+	leased, err := s.Lease(ctx, daemonID, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leased) != 1 || leased[0].ID != queued.ID || leased[0].Attempts != 1 {
+		t.Fatalf("first lease = %+v, want job %d on attempt 1", leased, queued.ID)
+	}
+
+	// The lease runs out and the job is requeued behind its backoff
+	time.Sleep(100 * time.Millisecond)
+	if _, err := s.ReleaseExpiredLeases(ctx); err != nil {
+		t.Fatal(err)
+	}
+	requeued, err := client.Job.Get(ctx, queued.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requeued.Status != job.StatusPending || requeued.LeasedBy != "" || requeued.Attempts != 1 {
+		t.Fatalf("after expiry: status %s, leased by %q, attempts %d", requeued.Status, requeued.LeasedBy, requeued.Attempts)
+	}
+	if time.Until(requeued.ScheduledAt) < jobRetryBackoff-time.Second {
+		t.Errorf("retry scheduled in %v, want about %v", time.Until(requeued.ScheduledAt), jobRetryBackoff)
+	}
+
+	leased, err = s.Lease(ctx, daemonID, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leased) != 0 {
+		t.Fatalf("leased %d job(s) during the retry backoff", len(leased))
+	}
+
+	// Skip the backoff, then fail the last attempt
+	if err := client.Job.UpdateOneID(queued.ID).SetScheduledAt(time.Now()).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	leased, err = s.Lease(ctx, daemonID, LeaseOptions{PinnedOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leased) != 1 || leased[0].Attempts != 2 {
+		t.Fatalf("second lease = %+v, want the job on attempt 2", leased)
+	}
+
+	message := "speedtest exited"
+	failed, err := s.Complete(ctx, queued.ID, api.JobCompletion{DaemonId: daemonID, ErrorMessage: &message})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Status != job.StatusFailed || failed.ErrorMessage != message || failed.CompletedAt == nil {
+		t.Fatalf("after last attempt: status %s, error %q, completed at %v", failed.Status, failed.ErrorMessage, failed.CompletedAt)
+	}
+
+	if _, err := s.Complete(ctx, queued.ID, api.JobCompletion{DaemonId: daemonID, Success: true}); err != ErrJobNotLeased {
+		t.Errorf("completing a failed job: error = %v, want %v", err, ErrJobNotLeased)
+	}
+}
+
+func TestConcurrentLeasesClaimJobOnce(t *testing.T) {
+	client := openTestDatabase(t)
+	s := NewJobService(client, time.Minute)
+	ctx := context.Background()
+
+	queued, daemonID := enqueueTestJob(t, client, s, 3)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		claims int
+	)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			leased, err := s.Lease(ctx, daemonID, LeaseOptions{PinnedOnly: true})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			claims += len(leased)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if claims != 1 {
+		t.Fatalf("job %d was leased %d times, want once", queued.ID, claims)
+	}
+	leased, err := client.Job.Get(ctx, queued.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leased.Attempts != 1 {
+		t.Errorf("attempts = %d, want 1", leased.Attempts)
+	}
+}
+
+func TestCompleteRejectsOtherDaemons(t *testing.T) {
+	client := openTestDatabase(t)
+	s := NewJobService(client, time.Minute)
+	ctx := context.Background()
+
+	queued, daemonID := enqueueTestJob(t, client, s, 1)
+	if _, err := s.Lease(ctx, daemonID, LeaseOptions{PinnedOnly: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Complete(ctx, queued.ID, api.JobCompletion{DaemonId: "someone-else", Success: true}); err != ErrJobNotLeased {
+		t.Errorf("error = %v, want %v", err, ErrJobNotLeased)
+	}
+	if _, err := s.Complete(ctx, -1, api.JobCompletion{DaemonId: daemonID, Success: true}); err != ErrJobNotFound {
+		t.Errorf("error = %v, want %v", err, ErrJobNotFound)
+	}
+
+	completed, err := s.Complete(ctx, queued.ID, api.JobCompletion{DaemonId: daemonID, Success: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if completed.Status != job.StatusCompleted {
+		t.Errorf("status = %s, want %s", completed.Status, job.StatusCompleted)
+	}
+}
@@ -0,0 +1,432 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/daemon"
+	"github.com/bfirestone/speed-checker/ent/job"
+	"github.com/bfirestone/speed-checker/ent/meshtest"
+	"github.com/bfirestone/speed-checker/internal/api"
+	"github.com/bfirestone/speed-checker/internal/config"
+)
+
+// meshSchedulerTick is how often the mesh scheduler checks for due pairs
+// when no job changes wake it earlier
+const meshSchedulerTick = time.Minute
+
+// MeshService schedules iperf tests between daemons and stores and
+// summarizes their results
+type MeshService struct {
+	client  *ent.Client
+	jobs    *JobService
+	daemons *DaemonService
+	cfg     config.MeshConfig
+}
+
+func NewMeshService(client *ent.Client, jobs *JobService, daemons *DaemonService, cfg config.MeshConfig) *MeshService {
+	cfg.MaxConcurrent = max(cfg.MaxConcurrent, 1)
+	return &MeshService{
+		client:  client,
+		jobs:    jobs,
+		daemons: daemons,
+		cfg:     cfg,
+	}
+}
+
+// MeshSample is a single or median mesh measurement. Timestamp and Success
+// are only set for single tests.
+type MeshSample struct {
+	Timestamp    *time.Time
+	Success      *bool
+	SentMbps     float64
+	ReceivedMbps float64
+	MeanRttMs    *float64
+}
+
+// MeshCell summarizes the tests from one daemon to another within a window
+type MeshCell struct {
+	SourceDaemonID string
+	TargetDaemonID string
+	Samples        int
+	Failures       int
+	Latest         MeshSample
+	Median         *MeshSample
+}
+
+// MeshMatrix is the latest and median result of every tested daemon pair
+type MeshMatrix struct {
+	Window      time.Duration
+	GeneratedAt time.Time
+	Daemons     []string
+	Cells       []MeshCell
+}
+
+// meshPair is an ordered source and target daemon pair
+type meshPair struct {
+	source string
+	target string
+}
+
+// CreateFromSubmission stores a mesh test result reported by a daemon. When
+// a result with the same submission ID already exists it is returned instead
+// and created is false, so retried submissions are safe.
+func (s *MeshService) CreateFromSubmission(ctx context.Context, submission api.MeshTestSubmission, receipt Receipt) (meshTest *ent.MeshTest, created bool, err error) {
+	if submission.SubmissionId != nil {
+		existing, err := s.findBySubmissionID(ctx, *submission.SubmissionId)
+		if err != nil || existing != nil {
+			return existing, false, err
+		}
+	}
+	if err := receipt.err(); err != nil {
+		return nil, false, err
+	}
+	if submission.SourceDaemonId == submission.TargetDaemonId {
+		return nil, false, fmt.Errorf("%w: source and target daemon are the same", ErrInvalidResult)
+	}
+
+	builder := s.client.MeshTest.
+		Create().
+		SetTimestamp(submission.Timestamp).
+		SetSourceDaemonID(submission.SourceDaemonId).
+		SetTargetDaemonID(submission.TargetDaemonId).
+		SetSentMbps(submission.SentMbps).
+		SetReceivedMbps(submission.ReceivedMbps).
+		SetDurationSeconds(submission.DurationSeconds).
+		SetReceivedAt(receipt.ReceivedAt).
+		SetQuarantined(receipt.Quarantined).
+		SetNillableClockOffsetMs(submission.ClockOffsetMs).
+		SetNillableClockCorrected(submission.ClockCorrected)
+
+	// A test is successful when it reported no error and moved data
+	success := submission.ErrorMessage == nil && (submission.SentMbps > 0 || submission.ReceivedMbps > 0)
+	builder.SetSuccess(success)
+
+	if submission.SubmissionId != nil {
+		builder.SetSubmissionID(*submission.SubmissionId)
+	}
+	if submission.TargetAddress != nil {
+		builder.SetTargetAddress(*submission.TargetAddress)
+	}
+	if submission.Trigger != nil {
+		builder.SetTrigger(meshtest.Trigger(*submission.Trigger))
+	}
+	if submission.MeanRttMs != nil {
+		builder.SetMeanRttMs(*submission.MeanRttMs)
+	}
+	if submission.Retransmits != nil {
+		builder.SetRetransmits(*submission.Retransmits)
+	}
+	if submission.ErrorMessage != nil {
+		builder.SetErrorMessage(*submission.ErrorMessage)
+	}
+
+	meshTest, err = builder.Save(ctx)
+	if err != nil {
+		// A concurrent retry stored the same submission first
+		if submission.SubmissionId != nil && ent.IsConstraintError(err) {
+			if existing, findErr := s.findBySubmissionID(ctx, *submission.SubmissionId); findErr == nil && existing != nil {
+				return existing, false, nil
+			}
+		}
+		return nil, false, fmt.Errorf("failed to save mesh test submission: %w", err)
+	}
+
+	log.Printf("Mesh test submission saved - ID: %d, %s -> %s, Sent: %.2f Mbps, Received: %.2f Mbps",
+		meshTest.ID, submission.SourceDaemonId, submission.TargetDaemonId, submission.SentMbps, submission.ReceivedMbps)
+
+	return meshTest, true, nil
+}
+
+// findBySubmissionID returns the mesh test stored for a submission ID, or
+// nil when there is none
+func (s *MeshService) findBySubmissionID(ctx context.Context, submissionID uuid.UUID) (*ent.MeshTest, error) {
+	existing, err := s.client.MeshTest.
+		Query().
+		Where(meshtest.SubmissionID(submissionID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up submission %s: %w", submissionID, err)
+	}
+	return existing, nil
+}
+
+// Matrix summarizes the mesh tests within the window per source and target
+// daemon pair. Medians only cover successful tests and are unset for pairs
+// that never succeeded.
+func (s *MeshService) Matrix(ctx context.Context, window time.Duration) (*MeshMatrix, error) {
+	now := time.Now()
+	tests, err := s.client.MeshTest.
+		Query().
+		Where(
+			meshtest.TimestampGTE(now.Add(-window)),
+			meshtest.QuarantinedEQ(false),
+		).
+		Order(ent.Asc(meshtest.FieldTimestamp)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mesh tests: %w", err)
+	}
+
+	members, err := s.client.Daemon.
+		Query().
+		Where(daemon.MeshAddressNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query mesh daemons: %w", err)
+	}
+
+	daemonIDs := map[string]bool{}
+	for _, d := range members {
+		if s.scope().Allows(d.ID, d.Labels) {
+			daemonIDs[d.ID] = true
+		}
+	}
+
+	byPair := map[meshPair][]*ent.MeshTest{}
+	for _, t := range tests {
+		pair := meshPair{source: t.SourceDaemonID, target: t.TargetDaemonID}
+		byPair[pair] = append(byPair[pair], t)
+		daemonIDs[t.SourceDaemonID] = true
+		daemonIDs[t.TargetDaemonID] = true
+	}
+
+	matrix := &MeshMatrix{
+		Window:      window,
+		GeneratedAt: now,
+		Daemons:     make([]string, 0, len(daemonIDs)),
+		Cells:       make([]MeshCell, 0, len(byPair)),
+	}
+	for id := range daemonIDs {
+		matrix.Daemons = append(matrix.Daemons, id)
+	}
+	sort.Strings(matrix.Daemons)
+
+	for pair, pairTests := range byPair {
+		matrix.Cells = append(matrix.Cells, meshCell(pair, pairTests))
+	}
+	sort.Slice(matrix.Cells, func(i, j int) bool {
+		if matrix.Cells[i].SourceDaemonID != matrix.Cells[j].SourceDaemonID {
+			return matrix.Cells[i].SourceDaemonID < matrix.Cells[j].SourceDaemonID
+		}
+		return matrix.Cells[i].TargetDaemonID < matrix.Cells[j].TargetDaemonID
+	})
+
+	return matrix, nil
+}
+
+// meshCell summarizes the tests of a pair, ordered oldest first
+func meshCell(pair meshPair, tests []*ent.MeshTest) MeshCell {
+	latest := tests[len(tests)-1]
+	cell := MeshCell{
+		SourceDaemonID: pair.source,
+		TargetDaemonID: pair.target,
+		Samples:        len(tests),
+		Latest: MeshSample{
+			Timestamp:    &latest.Timestamp,
+			Success:      &latest.Success,
+			SentMbps:     latest.SentMbps,
+			ReceivedMbps: latest.ReceivedMbps,
+		},
+	}
+	if latest.MeanRttMs > 0 {
+		cell.Latest.MeanRttMs = &latest.MeanRttMs
+	}
+
+	var sent, received, rtt []float64
+	for _, t := range tests {
+		if !t.Success {
+			cell.Failures++
+			continue
+		}
+		sent = append(sent, t.SentMbps)
+		received = append(received, t.ReceivedMbps)
+		if t.MeanRttMs > 0 {
+			rtt = append(rtt, t.MeanRttMs)
+		}
+	}
+
+	if len(sent) > 0 {
+		cell.Median = &MeshSample{
+			SentMbps:     median(sent),
+			ReceivedMbps: median(received),
+		}
+		if len(rtt) > 0 {
+			medianRtt := median(rtt)
+			cell.Median.MeanRttMs = &medianRtt
+		}
+	}
+
+	return cell
+}
+
+// scope returns the daemons taking part in the mesh as a host-style scope
+func (s *MeshService) scope() HostScope {
+	return HostScope{DaemonSelector: s.cfg.Selector, DaemonIDs: s.cfg.Daemons}
+}
+
+// RunScheduler enqueues mesh jobs until the context is cancelled. It wakes
+// on every job change, so the next pair starts as soon as a test finishes,
+// and at least every meshSchedulerTick so pairs come due on time.
+func (s *MeshService) RunScheduler(ctx context.Context) error {
+	ticker := time.NewTicker(meshSchedulerTick)
+	defer ticker.Stop()
+
+	for {
+		changed := s.jobs.watch()
+		s.schedule(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Println("Mesh scheduler stopped")
+			return nil
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// schedule enqueues due pairs, least recently tested first. A pair is due
+// once per interval, and a pair is only started when neither daemon is in
+// an outstanding mesh job, so flows never overlap on a daemon.
+func (s *MeshService) schedule(ctx context.Context) {
+	s.jobs.releaseExpiredLeases(ctx)
+
+	members, err := s.members(ctx)
+	if err != nil {
+		log.Printf("Failed to find mesh daemons: %v", err)
+		return
+	}
+	if len(members) < 2 {
+		return
+	}
+
+	outstanding, err := s.client.Job.
+		Query().
+		Where(
+			job.TypeEQ(job.TypeMesh),
+			job.StatusIn(job.StatusPending, job.StatusLeased),
+		).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to check outstanding mesh jobs: %v", err)
+		return
+	}
+
+	running := len(outstanding)
+	if running >= s.cfg.MaxConcurrent {
+		return
+	}
+
+	busy := map[string]bool{}
+	for _, j := range outstanding {
+		busy[j.DaemonID] = true
+		busy[j.TargetDaemonID] = true
+	}
+
+	lastTested, err := s.lastTested(ctx)
+	if err != nil {
+		log.Printf("Failed to query mesh history: %v", err)
+		return
+	}
+
+	// Every ordered pair not tested within the interval, least recent first
+	dueBefore := time.Now().Add(-s.cfg.Interval)
+	var due []meshPair
+	for _, source := range members {
+		for _, target := range members {
+			pair := meshPair{source: source, target: target}
+			if source != target && !lastTested[pair].After(dueBefore) {
+				due = append(due, pair)
+			}
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return lastTested[due[i]].Before(lastTested[due[j]])
+	})
+
+	maxAttempts := 1
+	for _, pair := range due {
+		if running >= s.cfg.MaxConcurrent {
+			return
+		}
+		if busy[pair.source] || busy[pair.target] {
+			continue
+		}
+
+		if _, err := s.jobs.Enqueue(ctx, api.JobCreation{
+			Type:            api.JobType(job.TypeMesh),
+			DaemonId:        &pair.source,
+			TargetDaemonId:  &pair.target,
+			DurationSeconds: &s.cfg.Duration,
+			MaxAttempts:     &maxAttempts,
+		}); err != nil {
+			log.Printf("Failed to enqueue mesh job %s -> %s: %v", pair.source, pair.target, err)
+			continue
+		}
+
+		running++
+		busy[pair.source] = true
+		busy[pair.target] = true
+	}
+}
+
+// members returns the sorted IDs of online daemons with iperf3 and a mesh
+// address that match the mesh selector
+func (s *MeshService) members(ctx context.Context) ([]string, error) {
+	candidates, err := s.client.Daemon.
+		Query().
+		Where(
+			daemon.MeshAddressNEQ(""),
+			daemon.HasIperf3(true),
+		).
+		Order(ent.Asc(daemon.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := s.scope()
+	var members []string
+	for _, d := range candidates {
+		if s.daemons.Status(d) == api.Online && scope.Allows(d.ID, d.Labels) {
+			members = append(members, d.ID)
+		}
+	}
+	return members, nil
+}
+
+// lastTested returns when each pair was last scheduled. Jobs are used
+// rather than results so failed and lost tests also wait for the next
+// interval.
+func (s *MeshService) lastTested(ctx context.Context) (map[meshPair]time.Time, error) {
+	var rows []struct {
+		DaemonID       string    `json:"daemon_id"`
+		TargetDaemonID string    `json:"target_daemon_id"`
+		Max            time.Time `json:"max"`
+	}
+	err := s.client.Job.
+		Query().
+		Where(job.TypeEQ(job.TypeMesh)).
+		GroupBy(job.FieldDaemonID, job.FieldTargetDaemonID).
+		Aggregate(ent.Max(job.FieldCreatedAt)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	lastTested := make(map[meshPair]time.Time, len(rows))
+	for _, row := range rows {
+		lastTested[meshPair{source: row.DaemonID, target: row.TargetDaemonID}] = row.Max
+	}
+	return lastTested, nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+// ErrInvalidResult is returned when a batch item does not carry the
+// submission matching its type
+var ErrInvalidResult = errors.New("invalid result")
+
+// BatchStatus is the outcome of a single item in a result batch
+type BatchStatus string
+
+const (
+	BatchCreated   BatchStatus = "created"
+	BatchDuplicate BatchStatus = "duplicate"
+	BatchInvalid   BatchStatus = "invalid"
+)
+
+// BatchItemResult reports what happened to one item of a result batch
+type BatchItemResult struct {
+	Status BatchStatus
+	ID     int
+	Err    error
+}
+
+// ResultService stores speed and iperf results submitted together
+type ResultService struct {
+	client *ent.Client
+	clock  ClockPolicy
+	events EventPublisher
+}
+
+// NewResultService creates the service. Results stored by a batch are
+// published to events, which may be nil, once the batch commits.
+func NewResultService(client *ent.Client, clock ClockPolicy, events EventPublisher) *ResultService {
+	return &ResultService{
+		client: client,
+		clock:  clock,
+		events: events,
+	}
+}
+
+// SubmitBatch stores a batch of results in a single transaction. Items that
+// fail validation or are rejected for clock skew are reported as invalid and
+// skipped, items whose submission ID is already stored are reported as
+// duplicates, and any other error rolls back the whole batch. clientTime is
+// the daemon's clock when it sent the batch.
+func (s *ResultService) SubmitBatch(ctx context.Context, items []api.ResultBatchItem, clientTime *time.Time) ([]BatchItemResult, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The per-type services share the transaction, so deduplication also
+	// sees items stored earlier in the same batch. Their events wait for the
+	// commit.
+	queue := &eventQueue{}
+	txClient := tx.Client()
+	speedTestService := NewSpeedTestService(txClient, queue)
+	iperfService := NewIperfService(txClient, nil, queue)
+
+	results := make([]BatchItemResult, len(items))
+	created := 0
+	for i, item := range items {
+		id, isNew, err := s.submitItemInSavepoint(ctx, txClient, speedTestService, iperfService, item, clientTime)
+		switch {
+		case errors.Is(err, ErrInvalidResult), errors.Is(err, ErrHostNotFound), errors.Is(err, ErrClockSkew), ent.IsValidationError(err):
+			results[i] = BatchItemResult{Status: BatchInvalid, Err: err}
+		case err != nil:
+			return nil, fmt.Errorf("failed to store batch item %d: %w", i, err)
+		case isNew:
+			created++
+			results[i] = BatchItemResult{Status: BatchCreated, ID: id}
+		default:
+			results[i] = BatchItemResult{Status: BatchDuplicate, ID: id}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit result batch: %w", err)
+	}
+	if s.events != nil {
+		queue.flush(s.events)
+	}
+
+	log.Printf("Result batch saved - %d items, %d created", len(items), created)
+	return results, nil
+}
+
+// submitItemInSavepoint stores one batch item inside a savepoint. On
+// Postgres a failed statement aborts the transaction, so a unique violation
+// from a concurrent retry of the same submission would otherwise fail the
+// whole batch. Rolling back to the savepoint keeps the transaction usable,
+// and submitting the item again finds the stored duplicate.
+func (s *ResultService) submitItemInSavepoint(ctx context.Context, tx *ent.Client, speedTestService *SpeedTestService, iperfService *IperfService, item api.ResultBatchItem, clientTime *time.Time) (int, bool, error) {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_item"); err != nil {
+		return 0, false, fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	id, created, err := s.submitItem(ctx, speedTestService, iperfService, item, clientTime)
+	if ent.IsConstraintError(err) {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_item"); rollbackErr != nil {
+			return 0, false, fmt.Errorf("failed to roll back to savepoint: %w", rollbackErr)
+		}
+		id, created, err = s.submitItem(ctx, speedTestService, iperfService, item, clientTime)
+	}
+	if err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_item"); rollbackErr != nil {
+			return 0, false, fmt.Errorf("failed to roll back to savepoint: %w", rollbackErr)
+		}
+	}
+
+	// Rolling back keeps the savepoint, so it is released either way rather
+	// than stacking one per failed item
+	if _, releaseErr := tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_item"); releaseErr != nil {
+		return 0, false, fmt.Errorf("failed to release savepoint: %w", releaseErr)
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return id, created, nil
+}
+
+func (s *ResultService) submitItem(ctx context.Context, speedTestService *SpeedTestService, iperfService *IperfService, item api.ResultBatchItem, clientTime *time.Time) (int, bool, error) {
+	switch {
+	case item.Type == api.Speedtest && item.Speedtest != nil:
+		receipt := s.clock.Receive(item.Speedtest.Timestamp, clientTime)
+		speedTest, created, err := speedTestService.CreateFromSubmission(ctx, *item.Speedtest, receipt)
+		if err != nil {
+			return 0, false, err
+		}
+		return speedTest.ID, created, nil
+
+	case item.Type == api.Iperf && item.Iperf != nil:
+		receipt := s.clock.Receive(item.Iperf.Timestamp, clientTime)
+		iperfTest, created, err := iperfService.CreateFromSubmission(ctx, *item.Iperf, receipt)
+		if err != nil {
+			return 0, false, err
+		}
+		return iperfTest.ID, created, nil
+
+	default:
+		return 0, false, fmt.Errorf("%w: %s item without a %s submission", ErrInvalidResult, item.Type, item.Type)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"os/exec"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/bfirestone/speed-checker/ent"
 	"github.com/bfirestone/speed-checker/ent/speedtest"
 	"github.com/bfirestone/speed-checker/internal/api"
@@ -15,6 +17,7 @@ import (
 
 type SpeedTestService struct {
 	client *ent.Client
+	events EventPublisher
 }
 
 type OoklaResult struct {
@@ -42,9 +45,12 @@ type OoklaResult struct {
 	} `json:"interface"`
 }
 
-func NewSpeedTestService(client *ent.Client) *SpeedTestService {
+// NewSpeedTestService creates the service. Stored results are published
+// to events, which may be nil.
+func NewSpeedTestService(client *ent.Client, events EventPublisher) *SpeedTestService {
 	return &SpeedTestService{
 		client: client,
+		events: events,
 	}
 }
 
@@ -68,7 +74,7 @@ func (s *SpeedTestService) RunTest(ctx context.Context) (*ent.SpeedTest, error)
 	uploadMbps := float64(ooklaResult.Upload.Bandwidth) * 8 / 1000000
 
 	// Save to database using Ent
-	speedTest, err := s.client.SpeedTest.
+	speedTest, err := s.create(ctx, s.client.SpeedTest.
 		Create().
 		SetTimestamp(ooklaResult.Timestamp).
 		SetDownloadMbps(downloadMbps).
@@ -79,8 +85,7 @@ func (s *SpeedTestService) RunTest(ctx context.Context) (*ent.SpeedTest, error)
 		SetServerID(fmt.Sprintf("%d", ooklaResult.Server.ID)).
 		SetIsp(ooklaResult.ISP).
 		SetExternalIP(ooklaResult.Interface.ExternalIP).
-		SetResultURL(ooklaResult.Result.URL).
-		Save(ctx)
+		SetResultURL(ooklaResult.Result.URL))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to save speed test result: %v", err)
@@ -95,6 +100,7 @@ func (s *SpeedTestService) RunTest(ctx context.Context) (*ent.SpeedTest, error)
 func (s *SpeedTestService) GetRecentTests(ctx context.Context, limit int) ([]*ent.SpeedTest, error) {
 	return s.client.SpeedTest.
 		Query().
+		Where(speedtest.QuarantinedEQ(false)).
 		Order(ent.Desc("timestamp")).
 		Limit(limit).
 		All(ctx)
@@ -107,6 +113,7 @@ func (s *SpeedTestService) GetTestsInRange(ctx context.Context, start, end time.
 			speedtest.And(
 				speedtest.TimestampGTE(start),
 				speedtest.TimestampLTE(end),
+				speedtest.QuarantinedEQ(false),
 			),
 		).
 		Order(ent.Desc("timestamp")).
@@ -116,7 +123,10 @@ func (s *SpeedTestService) GetTestsInRange(ctx context.Context, start, end time.
 func (s *SpeedTestService) GetTestsByServerName(ctx context.Context, serverName string, limit int) ([]*ent.SpeedTest, error) {
 	return s.client.SpeedTest.
 		Query().
-		Where(speedtest.ServerNameContains(serverName)).
+		Where(
+			speedtest.ServerNameContains(serverName),
+			speedtest.QuarantinedEQ(false),
+		).
 		Order(ent.Desc("timestamp")).
 		Limit(limit).
 		All(ctx)
@@ -125,17 +135,70 @@ func (s *SpeedTestService) GetTestsByServerName(ctx context.Context, serverName
 func (s *SpeedTestService) GetSlowestTests(ctx context.Context, limit int) ([]*ent.SpeedTest, error) {
 	return s.client.SpeedTest.
 		Query().
+		Where(speedtest.QuarantinedEQ(false)).
 		Order(ent.Asc("download_mbps")). // Ascending order to get slowest first
 		Limit(limit).
 		All(ctx)
 }
 
 func (s *SpeedTestService) GetTotalCount(ctx context.Context) (int, error) {
-	return s.client.SpeedTest.Query().Count(ctx)
+	return s.client.SpeedTest.Query().Where(speedtest.QuarantinedEQ(false)).Count(ctx)
 }
 
-// CreateFromSubmission creates a speed test record from an API submission
-func (s *SpeedTestService) CreateFromSubmission(ctx context.Context, submission api.SpeedTestSubmission) (*ent.SpeedTest, error) {
+// GetBaseline computes the rolling baseline from the most recent non-adaptive
+// speed tests, optionally restricted to a single daemon
+func (s *SpeedTestService) GetBaseline(ctx context.Context, daemonID string, samples int) (*Baseline, error) {
+	query := s.client.SpeedTest.
+		Query().
+		Where(
+			speedtest.TriggerNEQ(speedtest.TriggerAdaptive),
+			speedtest.QuarantinedEQ(false),
+		)
+
+	if daemonID != "" {
+		query.Where(speedtest.DaemonIDEQ(daemonID))
+	}
+
+	tests, err := query.
+		Order(ent.Desc("timestamp")).
+		Limit(samples).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query speed test baseline: %w", err)
+	}
+
+	download := make([]float64, len(tests))
+	upload := make([]float64, len(tests))
+	ping := make([]float64, len(tests))
+	for i, test := range tests {
+		download[i] = test.DownloadMbps
+		upload[i] = test.UploadMbps
+		ping[i] = test.PingMs
+	}
+
+	return &Baseline{
+		Samples:      len(tests),
+		DownloadMbps: median(download),
+		UploadMbps:   median(upload),
+		PingMs:       median(ping),
+	}, nil
+}
+
+// CreateFromSubmission creates a speed test record from an API submission,
+// stamped with the receipt from the clock policy. When a record with the
+// same submission ID already exists it is returned instead and created is
+// false, so retried submissions are safe.
+func (s *SpeedTestService) CreateFromSubmission(ctx context.Context, submission api.SpeedTestSubmission, receipt Receipt) (speedTest *ent.SpeedTest, created bool, err error) {
+	if submission.SubmissionId != nil {
+		existing, err := s.findBySubmissionID(ctx, *submission.SubmissionId)
+		if err != nil || existing != nil {
+			return existing, false, err
+		}
+	}
+	if err := receipt.err(); err != nil {
+		return nil, false, err
+	}
+
 	// Create the speed test record using Ent
 	builder := s.client.SpeedTest.
 		Create().
@@ -143,9 +206,20 @@ func (s *SpeedTestService) CreateFromSubmission(ctx context.Context, submission
 		SetDownloadMbps(submission.DownloadMbps).
 		SetUploadMbps(submission.UploadMbps).
 		SetPingMs(submission.PingMs).
-		SetDaemonID(submission.DaemonId)
+		SetDaemonID(submission.DaemonId).
+		SetReceivedAt(receipt.ReceivedAt).
+		SetQuarantined(receipt.Quarantined).
+		SetNillableClockOffsetMs(submission.ClockOffsetMs).
+		SetNillableClockCorrected(submission.ClockCorrected).
+		SetNillableCampaignID(submission.CampaignId)
 
 	// Set optional fields if provided
+	if submission.SubmissionId != nil {
+		builder.SetSubmissionID(*submission.SubmissionId)
+	}
+	if submission.Trigger != nil {
+		builder.SetTrigger(speedtest.Trigger(*submission.Trigger))
+	}
 	if submission.JitterMs != nil {
 		builder.SetJitterMs(*submission.JitterMs)
 	}
@@ -165,14 +239,57 @@ func (s *SpeedTestService) CreateFromSubmission(ctx context.Context, submission
 		builder.SetResultURL(*submission.ResultUrl)
 	}
 
-	speedTest, err := builder.Save(ctx)
+	speedTest, err = s.create(ctx, builder)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save speed test submission: %w", err)
+		// A concurrent retry stored the same submission first
+		if submission.SubmissionId != nil && ent.IsConstraintError(err) {
+			if existing, findErr := s.findBySubmissionID(ctx, *submission.SubmissionId); findErr == nil && existing != nil {
+				return existing, false, nil
+			}
+		}
+		return nil, false, fmt.Errorf("failed to save speed test submission: %w", err)
 	}
 
 	log.Printf("Speed test submission saved - ID: %d, Daemon: %s, Download: %.2f Mbps, Upload: %.2f Mbps",
 		speedTest.ID, submission.DaemonId, submission.DownloadMbps, submission.UploadMbps)
 
+	return speedTest, true, nil
+}
+
+// create stores a speed test and publishes it with the alerts it raises.
+// Submissions and the server-side runner both store results through it, so
+// event subscribers see every result.
+func (s *SpeedTestService) create(ctx context.Context, builder *ent.SpeedTestCreate) (*ent.SpeedTest, error) {
+	speedTest, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publish(s.events, Event{Type: EventSpeedTestResult, DaemonID: speedTest.DaemonID, SpeedTest: speedTest})
+	if speedTest.Quarantined {
+		publish(s.events, Event{
+			Type:      EventAlert,
+			DaemonID:  speedTest.DaemonID,
+			SpeedTest: speedTest,
+			Alert:     &Alert{Kind: AlertResultQuarantined, Message: "Speed test result quarantined for clock skew"},
+		})
+	}
+
+	return speedTest, nil
+}
+
+// findBySubmissionID returns the speed test stored for a submission ID, or
+// nil when there is none
+func (s *SpeedTestService) findBySubmissionID(ctx context.Context, submissionID uuid.UUID) (*ent.SpeedTest, error) {
+	speedTest, err := s.client.SpeedTest.Query().
+		Where(speedtest.SubmissionID(submissionID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up speed test submission: %w", err)
+	}
 	return speedTest, nil
 }
 
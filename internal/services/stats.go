@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/host"
+	"github.com/bfirestone/speed-checker/ent/iperftest"
+	"github.com/bfirestone/speed-checker/ent/speedtest"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+)
+
+// maxStatsBuckets bounds the buckets per series so a fine bucket over a
+// long range cannot produce an unbounded response
+const maxStatsBuckets = 10000
+
+// Stats group-by dimensions
+const (
+	GroupByDaemon   = "daemon"
+	GroupByHost     = "host"
+	GroupByHostType = "host_type"
+	GroupByISP      = "isp"
+	GroupByServer   = "server"
+)
+
+// ErrInvalidStats is returned for a stats query that cannot be computed
+var ErrInvalidStats = errors.New("invalid stats query")
+
+// StatsService aggregates results into bucketed series in SQL
+type StatsService struct {
+	client *ent.Client
+}
+
+func NewStatsService(client *ent.Client) *StatsService {
+	return &StatsService{
+		client: client,
+	}
+}
+
+// StatsQuery selects the results to aggregate and how to split them
+type StatsQuery struct {
+	Start time.Time
+	End   time.Time
+	// Bucket is the width of each point; zero aggregates the whole range
+	// into one point
+	Bucket time.Duration
+	// GroupBy splits the results into one series per combination of the
+	// given dimensions
+	GroupBy  []string
+	DaemonID string
+}
+
+// Distribution summarizes the values of a metric. Fields are nil when no
+// value was recorded.
+type Distribution struct {
+	Min *float64 `json:"min"`
+	Avg *float64 `json:"avg"`
+	P50 *float64 `json:"p50"`
+	P90 *float64 `json:"p90"`
+	P95 *float64 `json:"p95"`
+	P99 *float64 `json:"p99"`
+	Max *float64 `json:"max"`
+}
+
+// StatsGroup identifies a series by the values of its group-by dimensions.
+// Dimensions that are not grouped by are left zero.
+type StatsGroup struct {
+	DaemonID   string
+	HostID     int
+	HostName   string
+	HostType   string
+	ISP        string
+	ServerName string
+}
+
+// StatsPoint aggregates the results of one bucket
+type StatsPoint struct {
+	Start time.Time
+	Count int
+	// FailureRate is the share of attempts that failed, nil when it is not
+	// known for the grouping
+	FailureRate *float64
+	Metrics     map[string]*Distribution
+}
+
+// StatsSeries is the bucketed points of one group, oldest first
+type StatsSeries struct {
+	Group  StatsGroup
+	Points []StatsPoint
+}
+
+// statsRow is one group and bucket as selected by a stats query
+type statsRow struct {
+	Bucket     *time.Time               `sql:"bucket"`
+	DaemonID   *string                  `sql:"daemon_id"`
+	HostID     *int                     `sql:"host_id"`
+	HostName   *string                  `sql:"host_name"`
+	HostType   *string                  `sql:"host_type"`
+	ISP        *string                  `sql:"isp"`
+	ServerName *string                  `sql:"server_name"`
+	Count      int                      `sql:"count"`
+	Failures   int                      `sql:"failures"`
+	Attempts   int                      `sql:"attempts"`
+	Metrics    map[string]*Distribution `sql:"metrics"`
+}
+
+func (r statsRow) group() StatsGroup {
+	return StatsGroup{
+		DaemonID:   derefOr(r.DaemonID, ""),
+		HostID:     derefIntOr(r.HostID, 0),
+		HostName:   derefOr(r.HostName, ""),
+		HostType:   derefOr(r.HostType, ""),
+		ISP:        derefOr(r.ISP, ""),
+		ServerName: derefOr(r.ServerName, ""),
+	}
+}
+
+// validate checks the query against the dimensions the results can be
+// grouped by
+func (q StatsQuery) validate(dimensions ...string) error {
+	if !q.End.After(q.Start) {
+		return fmt.Errorf("%w: end_time must be after start_time", ErrInvalidStats)
+	}
+	if q.Bucket < 0 {
+		return fmt.Errorf("%w: bucket must be positive", ErrInvalidStats)
+	}
+	if q.Bucket > 0 && q.End.Sub(q.Start)/q.Bucket > maxStatsBuckets {
+		return fmt.Errorf("%w: more than %d buckets, use a wider bucket or a shorter range", ErrInvalidStats, maxStatsBuckets)
+	}
+	for _, g := range q.GroupBy {
+		if !slices.Contains(dimensions, g) {
+			return fmt.Errorf("%w: cannot group by %q, use one of %s", ErrInvalidStats, g, strings.Join(dimensions, ", "))
+		}
+	}
+	return nil
+}
+
+func (q StatsQuery) groups(dimension string) bool {
+	return slices.Contains(q.GroupBy, dimension)
+}
+
+// bucketExpr truncates a timestamp column to the start of its bucket,
+// counting buckets from the Unix epoch
+func bucketExpr(column string, bucket time.Duration) string {
+	seconds := int64(bucket / time.Second)
+	return fmt.Sprintf("to_timestamp(floor(extract(epoch from %s) / %d) * %d)", column, seconds, seconds)
+}
+
+// distributionExpr builds a JSON object with the distribution of a column,
+// optionally over the rows matching filter only
+func distributionExpr(column, filter string) string {
+	agg := func(expr string) string {
+		if filter != "" {
+			expr += " FILTER (WHERE " + filter + ")"
+		}
+		return expr
+	}
+	percentile := func(fraction string) string {
+		return agg(fmt.Sprintf("percentile_cont(%s) WITHIN GROUP (ORDER BY %s)", fraction, column))
+	}
+
+	return "json_build_object(" +
+		"'min', " + agg("MIN("+column+")") + ", " +
+		"'avg', " + agg("AVG("+column+")") + ", " +
+		"'p50', " + percentile("0.5") + ", " +
+		"'p90', " + percentile("0.9") + ", " +
+		"'p95', " + percentile("0.95") + ", " +
+		"'p99', " + percentile("0.99") + ", " +
+		"'max', " + agg("MAX("+column+")") + ")"
+}
+
+// metricsExpr builds a JSON object of named distributions
+func metricsExpr(s *sql.Selector, filter string, columns ...string) string {
+	parts := make([]string, len(columns))
+	for i, c := range columns {
+		parts[i] = "'" + c + "', " + distributionExpr(s.C(c), filter)
+	}
+	return "json_build_object(" + strings.Join(parts, ", ") + ")"
+}
+
+// SpeedTestStats aggregates speed test results. Failure rates come from the
+// run history, which records daemons but not ISPs or servers, so they are
+// only reported when grouping by daemon or not at all.
+func (s *StatsService) SpeedTestStats(ctx context.Context, q StatsQuery) ([]StatsSeries, error) {
+	if err := q.validate(GroupByDaemon, GroupByISP, GroupByServer); err != nil {
+		return nil, err
+	}
+
+	query := s.client.SpeedTest.
+		Query().
+		Where(
+			speedtest.QuarantinedEQ(false),
+			speedtest.TimestampGTE(q.Start),
+			speedtest.TimestampLT(q.End),
+		)
+	if q.DaemonID != "" {
+		query.Where(speedtest.DaemonIDEQ(q.DaemonID))
+	}
+
+	var rows []statsRow
+	err := query.Modify(func(sel *sql.Selector) {
+		columns, groupBy := q.bucketColumns(sel, speedtest.FieldTimestamp)
+		if q.groups(GroupByDaemon) {
+			columns = append(columns, sql.As(sel.C(speedtest.FieldDaemonID), "daemon_id"))
+			groupBy = append(groupBy, sel.C(speedtest.FieldDaemonID))
+		}
+		if q.groups(GroupByISP) {
+			columns = append(columns, sql.As(sel.C(speedtest.FieldIsp), "isp"))
+			groupBy = append(groupBy, sel.C(speedtest.FieldIsp))
+		}
+		if q.groups(GroupByServer) {
+			columns = append(columns, sql.As(sel.C(speedtest.FieldServerName), "server_name"))
+			groupBy = append(groupBy, sel.C(speedtest.FieldServerName))
+		}
+		columns = append(columns,
+			sql.As(sql.Count("*"), "count"),
+			sql.As(metricsExpr(sel, "", speedtest.FieldDownloadMbps, speedtest.FieldUploadMbps, speedtest.FieldPingMs), "metrics"),
+		)
+
+		sel.Select(columns...)
+		if len(groupBy) > 0 {
+			sel.GroupBy(groupBy...)
+		}
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate speed tests: %w", err)
+	}
+
+	if !q.groups(GroupByISP) && !q.groups(GroupByServer) {
+		runs, err := s.speedTestRuns(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		rows = mergeRuns(q, rows, runs)
+	}
+
+	return collectSeries(q, rows), nil
+}
+
+// speedTestRuns counts speed test attempts and failures from the run
+// history. Skipped and aborted runs never measured anything and are left
+// out; spooled runs measured a result that is still on its way and count as
+// attempts that did not fail.
+func (s *StatsService) speedTestRuns(ctx context.Context, q StatsQuery) ([]statsRow, error) {
+	query := s.client.TestRun.
+		Query().
+		Where(
+			testrun.TypeEQ(testrun.TypeSpeedtest),
+			testrun.OutcomeIn(testrun.OutcomeSuccess, testrun.OutcomeSpooled, testrun.OutcomeFailed, testrun.OutcomeTimeout),
+			testrun.StartedAtGTE(q.Start),
+			testrun.StartedAtLT(q.End),
+		)
+	if q.DaemonID != "" {
+		query.Where(testrun.DaemonIDEQ(q.DaemonID))
+	}
+
+	var rows []statsRow
+	err := query.Modify(func(sel *sql.Selector) {
+		columns, groupBy := q.bucketColumns(sel, testrun.FieldStartedAt)
+		if q.groups(GroupByDaemon) {
+			columns = append(columns, sql.As(sel.C(testrun.FieldDaemonID), "daemon_id"))
+			groupBy = append(groupBy, sel.C(testrun.FieldDaemonID))
+		}
+		columns = append(columns,
+			sql.As(sql.Count("*"), "attempts"),
+			sql.As(fmt.Sprintf("COUNT(*) FILTER (WHERE %s IN ('%s', '%s'))", sel.C(testrun.FieldOutcome), testrun.OutcomeFailed, testrun.OutcomeTimeout), "failures"),
+		)
+
+		sel.Select(columns...)
+		if len(groupBy) > 0 {
+			sel.GroupBy(groupBy...)
+		}
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate speed test runs: %w", err)
+	}
+
+	return rows, nil
+}
+
+// IperfStats aggregates iperf results. Throughput and RTT cover successful
+// tests, and the failure rate is the share of tests that failed. Tests
+// blocked by an upstream failure never ran and are left out.
+func (s *StatsService) IperfStats(ctx context.Context, q StatsQuery) ([]StatsSeries, error) {
+	if err := q.validate(GroupByDaemon, GroupByHost, GroupByHostType); err != nil {
+		return nil, err
+	}
+
+	query := s.client.IperfTest.
+		Query().
+		Where(
+			iperftest.QuarantinedEQ(false),
+			iperftest.BlockedByIsNil(),
+			iperftest.TimestampGTE(q.Start),
+			iperftest.TimestampLT(q.End),
+		)
+	if q.DaemonID != "" {
+		query.Where(iperftest.DaemonIDEQ(q.DaemonID))
+	}
+
+	var rows []statsRow
+	err := query.Modify(func(sel *sql.Selector) {
+		hosts := sql.Table(host.Table)
+		if q.groups(GroupByHost) || q.groups(GroupByHostType) {
+			sel.LeftJoin(hosts).On(sel.C(iperftest.HostColumn), hosts.C(host.FieldID))
+		}
+
+		columns, groupBy := q.bucketColumns(sel, iperftest.FieldTimestamp)
+		if q.groups(GroupByDaemon) {
+			columns = append(columns, sql.As(sel.C(iperftest.FieldDaemonID), "daemon_id"))
+			groupBy = append(groupBy, sel.C(iperftest.FieldDaemonID))
+		}
+		if q.groups(GroupByHost) {
+			columns = append(columns,
+				sql.As(sel.C(iperftest.HostColumn), "host_id"),
+				sql.As(hosts.C(host.FieldName), "host_name"),
+			)
+			groupBy = append(groupBy, sel.C(iperftest.HostColumn), hosts.C(host.FieldName))
+		}
+		if q.groups(GroupByHostType) {
+			columns = append(columns, sql.As(hosts.C(host.FieldType), "host_type"))
+			groupBy = append(groupBy, hosts.C(host.FieldType))
+		}
+
+		success := sel.C(iperftest.FieldSuccess)
+		columns = append(columns,
+			sql.As(sql.Count("*"), "count"),
+			sql.As(sql.Count("*"), "attempts"),
+			sql.As("COUNT(*) FILTER (WHERE NOT "+success+")", "failures"),
+			sql.As(metricsExpr(sel, success, iperftest.FieldSentMbps, iperftest.FieldReceivedMbps, iperftest.FieldMeanRttMs), "metrics"),
+		)
+
+		sel.Select(columns...)
+		if len(groupBy) > 0 {
+			sel.GroupBy(groupBy...)
+		}
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate iperf tests: %w", err)
+	}
+
+	return collectSeries(q, rows), nil
+}
+
+// bucketColumns starts the select and group-by lists with the bucket of a
+// timestamp column, when the query is bucketed
+func (q StatsQuery) bucketColumns(sel *sql.Selector, column string) ([]string, []string) {
+	if q.Bucket <= 0 {
+		return nil, nil
+	}
+	return []string{sql.As(bucketExpr(sel.C(column), q.Bucket), "bucket")}, []string{"bucket"}
+}
+
+// statsKey identifies the group and bucket of a row
+type statsKey struct {
+	group StatsGroup
+	start time.Time
+}
+
+func (q StatsQuery) key(r statsRow) statsKey {
+	start := q.Start
+	if r.Bucket != nil {
+		start = r.Bucket.UTC()
+	}
+	return statsKey{group: r.group(), start: start}
+}
+
+// mergeRuns adds run counts to the result rows of the same group and
+// bucket. Buckets with runs but no results become rows without metrics.
+func mergeRuns(q StatsQuery, rows, runs []statsRow) []statsRow {
+	index := make(map[statsKey]int, len(rows))
+	for i, r := range rows {
+		index[q.key(r)] = i
+	}
+
+	for _, run := range runs {
+		if i, ok := index[q.key(run)]; ok {
+			rows[i].Attempts = run.Attempts
+			rows[i].Failures = run.Failures
+			continue
+		}
+		rows = append(rows, run)
+	}
+
+	return rows
+}
+
+// collectSeries splits rows into one series per group, with points oldest
+// first and series in a stable order
+func collectSeries(q StatsQuery, rows []statsRow) []StatsSeries {
+	index := map[StatsGroup]int{}
+	series := []StatsSeries{}
+	for _, r := range rows {
+		// An ungrouped, unbucketed aggregate returns a row even when nothing
+		// matched
+		if r.Count == 0 && r.Attempts == 0 {
+			continue
+		}
+
+		k := q.key(r)
+		i, ok := index[k.group]
+		if !ok {
+			i = len(series)
+			index[k.group] = i
+			series = append(series, StatsSeries{Group: k.group})
+		}
+
+		point := StatsPoint{
+			Start:   k.start,
+			Count:   r.Count,
+			Metrics: r.Metrics,
+		}
+		if r.Attempts > 0 {
+			rate := float64(r.Failures) / float64(r.Attempts)
+			point.FailureRate = &rate
+		}
+		series[i].Points = append(series[i].Points, point)
+	}
+
+	for i := range series {
+		slices.SortFunc(series[i].Points, func(a, b StatsPoint) int {
+			return a.Start.Compare(b.Start)
+		})
+	}
+	slices.SortFunc(series, func(a, b StatsSeries) int {
+		return compareGroups(a.Group, b.Group)
+	})
+
+	return series
+}
+
+func compareGroups(a, b StatsGroup) int {
+	if c := strings.Compare(a.DaemonID, b.DaemonID); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.HostName, b.HostName); c != 0 {
+		return c
+	}
+	if c := a.HostID - b.HostID; c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.HostType, b.HostType); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.ISP, b.ISP); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ServerName, b.ServerName)
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bfirestone/speed-checker/ent"
+	"github.com/bfirestone/speed-checker/ent/testrun"
+	"github.com/bfirestone/speed-checker/internal/api"
+)
+
+// ErrInvalidRun is returned when a run submission fails validation
+var ErrInvalidRun = errors.New("invalid run")
+
+// TestRunService records every test a daemon attempts, including runs that
+// were skipped or failed before producing a result
+type TestRunService struct {
+	client *ent.Client
+}
+
+func NewTestRunService(client *ent.Client) *TestRunService {
+	return &TestRunService{
+		client: client,
+	}
+}
+
+// CreateFromSubmission records a run reported by a daemon. When a run with
+// the same submission ID already exists it is returned instead and created is
+// false, so retried submissions are safe.
+func (s *TestRunService) CreateFromSubmission(ctx context.Context, submission api.TestRunSubmission) (run *ent.TestRun, created bool, err error) {
+	if err := testrun.TypeValidator(testrun.Type(submission.Type)); err != nil {
+		return nil, false, fmt.Errorf("%w: %v", ErrInvalidRun, err)
+	}
+	if err := testrun.OutcomeValidator(testrun.Outcome(submission.Outcome)); err != nil {
+		return nil, false, fmt.Errorf("%w: %v", ErrInvalidRun, err)
+	}
+	if submission.SubmissionId != nil {
+		existing, err := s.findBySubmissionID(ctx, *submission.SubmissionId)
+		if err != nil || existing != nil {
+			return existing, false, err
+		}
+	}
+
+	builder := s.client.TestRun.
+		Create().
+		SetDaemonID(submission.DaemonId).
+		SetType(testrun.Type(submission.Type)).
+		SetOutcome(testrun.Outcome(submission.Outcome)).
+		SetStartedAt(submission.StartedAt).
+		SetFinishedAt(submission.FinishedAt)
+
+	if submission.SubmissionId != nil {
+		builder.SetSubmissionID(*submission.SubmissionId)
+	}
+	if submission.Trigger != nil {
+		builder.SetTrigger(testrun.Trigger(*submission.Trigger))
+	}
+	if submission.ErrorMessage != nil {
+		builder.SetErrorMessage(*submission.ErrorMessage)
+	}
+	if submission.HostId != nil {
+		builder.SetHostID(*submission.HostId)
+	}
+	if submission.SpeedTestId != nil {
+		builder.SetSpeedTestID(*submission.SpeedTestId)
+	}
+	if submission.IperfTestId != nil {
+		builder.SetIperfTestID(*submission.IperfTestId)
+	}
+	if submission.MeshTestId != nil {
+		builder.SetMeshTestID(*submission.MeshTestId)
+	}
+	if submission.TargetDaemonId != nil {
+		builder.SetTargetDaemonID(*submission.TargetDaemonId)
+	}
+
+	saved, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// A concurrent retry stored the same submission first
+			if submission.SubmissionId != nil {
+				if existing, findErr := s.findBySubmissionID(ctx, *submission.SubmissionId); findErr == nil && existing != nil {
+					return existing, false, nil
+				}
+			}
+			return nil, false, fmt.Errorf("%w: referenced host or result not found", ErrInvalidRun)
+		}
+		return nil, false, fmt.Errorf("failed to record run: %w", err)
+	}
+
+	run, err = s.client.TestRun.Query().Where(testrun.ID(saved.ID)).WithHost().Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return run, true, nil
+}
+
+// findBySubmissionID returns the run stored for a submission ID, or nil when
+// there is none
+func (s *TestRunService) findBySubmissionID(ctx context.Context, submissionID uuid.UUID) (*ent.TestRun, error) {
+	run, err := s.client.TestRun.Query().
+		Where(testrun.SubmissionID(submissionID)).
+		WithHost().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up run submission: %w", err)
+	}
+	return run, nil
+}
+
+// GetRuns returns the most recent runs matching the given filters
+func (s *TestRunService) GetRuns(ctx context.Context, params api.GetRunsParams, limit int) ([]*ent.TestRun, error) {
+	query := s.client.TestRun.Query().WithHost()
+
+	if params.DaemonId != nil {
+		query.Where(testrun.DaemonIDEQ(*params.DaemonId))
+	}
+	if params.Type != nil {
+		query.Where(testrun.TypeEQ(testrun.Type(*params.Type)))
+	}
+	if params.Trigger != nil {
+		query.Where(testrun.TriggerEQ(testrun.Trigger(*params.Trigger)))
+	}
+	if params.Outcome != nil {
+		query.Where(testrun.OutcomeEQ(testrun.Outcome(*params.Outcome)))
+	}
+	if params.HostId != nil {
+		query.Where(testrun.HostIDEQ(*params.HostId))
+	}
+	if params.StartTime != nil {
+		query.Where(testrun.StartedAtGTE(*params.StartTime))
+	}
+	if params.EndTime != nil {
+		query.Where(testrun.StartedAtLTE(*params.EndTime))
+	}
+
+	return query.
+		Order(ent.Desc(testrun.FieldStartedAt)).
+		Limit(limit).
+		All(ctx)
+}
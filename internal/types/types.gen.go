@@ -5,12 +5,51 @@ package types
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 )
 
+// Defines values for AlertKind.
+const (
+	AlertKindIperfFailed       AlertKind = "iperf_failed"
+	AlertKindResultQuarantined AlertKind = "result_quarantined"
+)
+
+// Defines values for CampaignStatus.
+const (
+	CampaignStatusCompleted CampaignStatus = "completed"
+	CampaignStatusRunning   CampaignStatus = "running"
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+)
+
+// Defines values for DaemonStatus.
+const (
+	Dead   DaemonStatus = "dead"
+	Online DaemonStatus = "online"
+	Stale  DaemonStatus = "stale"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeAlert           EventType = "alert"
+	EventTypeDaemonHeartbeat EventType = "daemon_heartbeat"
+	EventTypeHostCreated     EventType = "host_created"
+	EventTypeHostDeleted     EventType = "host_deleted"
+	EventTypeHostUpdated     EventType = "host_updated"
+	EventTypeIperfResult     EventType = "iperf_result"
+	EventTypeSpeedtestResult EventType = "speedtest_result"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv    ExportFormat = "csv"
+	ExportFormatNdjson ExportFormat = "ndjson"
+)
+
 // Defines values for HostType.
 const (
 	Lan    HostType = "lan"
@@ -18,23 +57,465 @@ const (
 	Vpn    HostType = "vpn"
 )
 
+// Defines values for IperfStatsGroupBy.
+const (
+	IperfStatsGroupByDaemon   IperfStatsGroupBy = "daemon"
+	IperfStatsGroupByHost     IperfStatsGroupBy = "host"
+	IperfStatsGroupByHostType IperfStatsGroupBy = "host_type"
+)
+
 // Defines values for IperfTestResultProtocol.
 const (
 	IperfTestResultProtocolTCP IperfTestResultProtocol = "TCP"
 	IperfTestResultProtocolUDP IperfTestResultProtocol = "UDP"
 )
 
+// Defines values for IperfTestSort.
+const (
+	IperfTestSortDurationSeconds     IperfTestSort = "duration_seconds"
+	IperfTestSortDurationSecondsDesc IperfTestSort = "-duration_seconds"
+	IperfTestSortMeanRttMs           IperfTestSort = "mean_rtt_ms"
+	IperfTestSortMeanRttMsDesc       IperfTestSort = "-mean_rtt_ms"
+	IperfTestSortReceivedMbps        IperfTestSort = "received_mbps"
+	IperfTestSortReceivedMbpsDesc    IperfTestSort = "-received_mbps"
+	IperfTestSortRetransmits         IperfTestSort = "retransmits"
+	IperfTestSortRetransmitsDesc     IperfTestSort = "-retransmits"
+	IperfTestSortSentMbps            IperfTestSort = "sent_mbps"
+	IperfTestSortSentMbpsDesc        IperfTestSort = "-sent_mbps"
+	IperfTestSortTimestamp           IperfTestSort = "timestamp"
+	IperfTestSortTimestampDesc       IperfTestSort = "-timestamp"
+)
+
 // Defines values for IperfTestSubmissionProtocol.
 const (
 	IperfTestSubmissionProtocolTCP IperfTestSubmissionProtocol = "TCP"
 	IperfTestSubmissionProtocolUDP IperfTestSubmissionProtocol = "UDP"
 )
 
+// Defines values for JobStatus.
+const (
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusLeased    JobStatus = "leased"
+	JobStatusPending   JobStatus = "pending"
+)
+
+// Defines values for JobType.
+const (
+	Iperf     JobType = "iperf"
+	Mesh      JobType = "mesh"
+	Speedtest JobType = "speedtest"
+)
+
+// Defines values for ResultBatchItemStatus.
+const (
+	Created   ResultBatchItemStatus = "created"
+	Duplicate ResultBatchItemStatus = "duplicate"
+	Invalid   ResultBatchItemStatus = "invalid"
+)
+
+// Defines values for RunOutcome.
+const (
+	RunOutcomeAborted RunOutcome = "aborted"
+	RunOutcomeFailed  RunOutcome = "failed"
+	RunOutcomeSkipped RunOutcome = "skipped"
+	RunOutcomeSpooled RunOutcome = "spooled"
+	RunOutcomeSuccess RunOutcome = "success"
+	RunOutcomeTimeout RunOutcome = "timeout"
+)
+
+// Defines values for SpeedTestSort.
+const (
+	SpeedTestSortDownloadMbps     SpeedTestSort = "download_mbps"
+	SpeedTestSortDownloadMbpsDesc SpeedTestSort = "-download_mbps"
+	SpeedTestSortJitterMs         SpeedTestSort = "jitter_ms"
+	SpeedTestSortJitterMsDesc     SpeedTestSort = "-jitter_ms"
+	SpeedTestSortPingMs           SpeedTestSort = "ping_ms"
+	SpeedTestSortPingMsDesc       SpeedTestSort = "-ping_ms"
+	SpeedTestSortTimestamp        SpeedTestSort = "timestamp"
+	SpeedTestSortTimestampDesc    SpeedTestSort = "-timestamp"
+	SpeedTestSortUploadMbps       SpeedTestSort = "upload_mbps"
+	SpeedTestSortUploadMbpsDesc   SpeedTestSort = "-upload_mbps"
+)
+
+// Defines values for SpeedTestStatsGroupBy.
+const (
+	SpeedTestStatsGroupByDaemon SpeedTestStatsGroupBy = "daemon"
+	SpeedTestStatsGroupByIsp    SpeedTestStatsGroupBy = "isp"
+	SpeedTestStatsGroupByServer SpeedTestStatsGroupBy = "server"
+)
+
+// Defines values for StatsBucket.
+const (
+	StatsBucket1d StatsBucket = "1d"
+	StatsBucket1h StatsBucket = "1h"
+	StatsBucket5m StatsBucket = "5m"
+)
+
+// Defines values for TestTrigger.
+const (
+	Adaptive  TestTrigger = "adaptive"
+	Manual    TestTrigger = "manual"
+	Scheduled TestTrigger = "scheduled"
+)
+
+// Alert defines model for Alert.
+type Alert struct {
+	// Kind iperf_failed: an iperf test ran and failed (tests blocked by an
+	// upstream failure are not alerted on). result_quarantined: a result
+	// was quarantined for clock skew.
+	Kind    AlertKind `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// AlertKind iperf_failed: an iperf test ran and failed (tests blocked by an
+// upstream failure are not alerted on). result_quarantined: a result
+// was quarantined for clock skew.
+type AlertKind string
+
+// Baseline defines model for Baseline.
+type Baseline struct {
+	// DownloadMbps Median download (iperf received) throughput in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// PingMs Median latency (iperf mean RTT) in milliseconds
+	PingMs *float64 `json:"ping_ms,omitempty"`
+
+	// Samples Number of results the baseline was computed from
+	Samples int `json:"samples"`
+
+	// UploadMbps Median upload (iperf sent) throughput in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// Campaign defines model for Campaign.
+type Campaign struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DaemonIds Daemons that take part regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Daemons carrying all of these labels take part. Without a selector
+	// or daemon_ids every registered daemon that is not dead takes part.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
+	// Daemons Daemons the campaign's jobs were enqueued for
+	Daemons []string `json:"daemons"`
+
+	// Description Why the campaign was run
+	Description *string `json:"description,omitempty"`
+
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostIds Hosts every daemon runs an iperf test against, in order
+	HostIds *[]int            `json:"host_ids,omitempty"`
+	Id      int               `json:"id"`
+	Jobs    CampaignJobCounts `json:"jobs"`
+
+	// Name Human-friendly campaign name
+	Name string `json:"name"`
+
+	// ScheduledAt When the daemons start the tests (defaults to now)
+	ScheduledAt time.Time `json:"scheduled_at"`
+
+	// Speedtest Run a speed test on every daemon
+	Speedtest *bool `json:"speedtest,omitempty"`
+
+	// Status scheduled until scheduled_at, running while any of its jobs is
+	// pending or leased, completed once every job finished or failed
+	Status CampaignStatus `json:"status"`
+}
+
+// CampaignCreation defines model for CampaignCreation.
+type CampaignCreation struct {
+	// DaemonIds Daemons that take part regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Daemons carrying all of these labels take part. Without a selector
+	// or daemon_ids every registered daemon that is not dead takes part.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
+	// Description Why the campaign was run
+	Description *string `json:"description,omitempty"`
+
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostIds Hosts every daemon runs an iperf test against, in order
+	HostIds *[]int `json:"host_ids,omitempty"`
+
+	// Name Human-friendly campaign name
+	Name string `json:"name"`
+
+	// ScheduledAt When the daemons start the tests (defaults to now)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+
+	// Speedtest Run a speed test on every daemon
+	Speedtest *bool `json:"speedtest,omitempty"`
+}
+
+// CampaignDaemonReport defines model for CampaignDaemonReport.
+type CampaignDaemonReport struct {
+	DaemonId   string                   `json:"daemon_id"`
+	DaemonName *string                  `json:"daemon_name,omitempty"`
+	Iperf      []CampaignIperfResult    `json:"iperf"`
+	Speedtest  *CampaignSpeedTestResult `json:"speedtest,omitempty"`
+
+	// SpeedtestError Error reported by the speed test job
+	SpeedtestError *string `json:"speedtest_error,omitempty"`
+
+	// SpeedtestJobStatus Lifecycle state of a job
+	SpeedtestJobStatus *JobStatus `json:"speedtest_job_status,omitempty"`
+}
+
+// CampaignIperfResult defines model for CampaignIperfResult.
+type CampaignIperfResult struct {
+	// ErrorMessage Error reported by the job or the test
+	ErrorMessage *string `json:"error_message,omitempty"`
+	HostId       int     `json:"host_id"`
+	HostName     *string `json:"host_name,omitempty"`
+
+	// Id ID of the iperf result; unset until it was submitted
+	Id *int `json:"id,omitempty"`
+
+	// JobStatus Lifecycle state of a job
+	JobStatus    JobStatus  `json:"job_status"`
+	MeanRttMs    *float64   `json:"mean_rtt_ms,omitempty"`
+	Quarantined  *bool      `json:"quarantined,omitempty"`
+	ReceivedMbps *float64   `json:"received_mbps,omitempty"`
+	SentMbps     *float64   `json:"sent_mbps,omitempty"`
+	Success      *bool      `json:"success,omitempty"`
+	Timestamp    *time.Time `json:"timestamp,omitempty"`
+}
+
+// CampaignIperfSummary defines model for CampaignIperfSummary.
+type CampaignIperfSummary struct {
+	HostId       int            `json:"host_id"`
+	HostName     *string        `json:"host_name,omitempty"`
+	MeanRttMs    *MetricSummary `json:"mean_rtt_ms,omitempty"`
+	ReceivedMbps MetricSummary  `json:"received_mbps"`
+	SentMbps     MetricSummary  `json:"sent_mbps"`
+}
+
+// CampaignJobCounts defines model for CampaignJobCounts.
+type CampaignJobCounts struct {
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+	Leased    int `json:"leased"`
+	Pending   int `json:"pending"`
+}
+
+// CampaignReport defines model for CampaignReport.
+type CampaignReport struct {
+	Campaign Campaign `json:"campaign"`
+
+	// Daemons One row per daemon, sorted by daemon ID
+	Daemons []CampaignDaemonReport `json:"daemons"`
+
+	// IperfSummary One summary per host over successful results
+	IperfSummary     []CampaignIperfSummary    `json:"iperf_summary"`
+	SpeedtestSummary *CampaignSpeedTestSummary `json:"speedtest_summary,omitempty"`
+}
+
+// CampaignSpeedTestResult defines model for CampaignSpeedTestResult.
+type CampaignSpeedTestResult struct {
+	DownloadMbps float64   `json:"download_mbps"`
+	Id           int       `json:"id"`
+	PingMs       float64   `json:"ping_ms"`
+	Quarantined  bool      `json:"quarantined"`
+	ServerName   *string   `json:"server_name,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+	UploadMbps   float64   `json:"upload_mbps"`
+}
+
+// CampaignSpeedTestSummary defines model for CampaignSpeedTestSummary.
+type CampaignSpeedTestSummary struct {
+	DownloadMbps MetricSummary `json:"download_mbps"`
+	PingMs       MetricSummary `json:"ping_ms"`
+	UploadMbps   MetricSummary `json:"upload_mbps"`
+}
+
+// CampaignStatus scheduled until scheduled_at, running while any of its jobs is
+// pending or leased, completed once every job finished or failed
+type CampaignStatus string
+
+// Daemon defines model for Daemon.
+type Daemon struct {
+	// Arch CPU architecture
+	Arch         *string             `json:"arch,omitempty"`
+	Capabilities *DaemonCapabilities `json:"capabilities,omitempty"`
+
+	// ConfigVersion Version of the daemon's effective configuration, returned on
+	// registration and heartbeat. Daemons refetch their
+	// configuration when it changes.
+	ConfigVersion *string `json:"config_version,omitempty"`
+
+	// Hostname Hostname of the machine running the daemon
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Id Daemon identifier, used as daemon_id on results
+	Id string `json:"id"`
+
+	// Labels Free-form labels describing the daemon
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// LastSeenAt Last registration or heartbeat
+	LastSeenAt time.Time `json:"last_seen_at"`
+
+	// MeshAddress host:port of the iperf3 server the daemon runs for mesh tests
+	// (port 5201 when omitted). Daemons without one are not tested.
+	MeshAddress *string `json:"mesh_address,omitempty"`
+
+	// Name Human-friendly name for the daemon
+	Name *string `json:"name,omitempty"`
+
+	// Os Operating system
+	Os *string `json:"os,omitempty"`
+
+	// RegisteredAt When the daemon first registered
+	RegisteredAt time.Time `json:"registered_at"`
+
+	// SpoolDepth Results waiting in the daemon's offline spool at the last heartbeat
+	SpoolDepth *int `json:"spool_depth,omitempty"`
+
+	// Status Liveness of a daemon based on its last heartbeat
+	Status DaemonStatus `json:"status"`
+
+	// Version speed-checker version the daemon runs
+	Version *string `json:"version,omitempty"`
+}
+
+// DaemonCapabilities defines model for DaemonCapabilities.
+type DaemonCapabilities struct {
+	// Iperf3 Whether iperf3 is installed
+	Iperf3 *bool `json:"iperf3,omitempty"`
+
+	// Speedtest Whether the Ookla speedtest CLI is installed
+	Speedtest *bool `json:"speedtest,omitempty"`
+}
+
+// DaemonConfig defines model for DaemonConfig.
+type DaemonConfig struct {
+	// CreatedAt When the config was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// DaemonId Apply only to this daemon
+	DaemonId *string `json:"daemon_id,omitempty"`
+
+	// Id Unique identifier for the config
+	Id int `json:"id"`
+
+	// MatchLabels Apply to daemons carrying all of these labels
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+
+	// Name Human-friendly name for the config
+	Name string `json:"name"`
+
+	// Priority Order among configs of the same kind; higher priority wins
+	Priority *int `json:"priority,omitempty"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings DaemonSettings `json:"settings"`
+
+	// UpdatedAt When the config was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DaemonConfigCreation defines model for DaemonConfigCreation.
+type DaemonConfigCreation struct {
+	// DaemonId Apply only to this daemon
+	DaemonId *string `json:"daemon_id,omitempty"`
+
+	// MatchLabels Apply to daemons carrying all of these labels
+	MatchLabels *map[string]string `json:"match_labels,omitempty"`
+
+	// Name Human-friendly name for the config
+	Name string `json:"name"`
+
+	// Priority Order among configs of the same kind; higher priority wins
+	Priority *int `json:"priority,omitempty"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings *DaemonSettings `json:"settings,omitempty"`
+}
+
+// DaemonHeartbeat defines model for DaemonHeartbeat.
+type DaemonHeartbeat struct {
+	// SpoolDepth Results waiting in the daemon's offline spool
+	SpoolDepth *int `json:"spool_depth,omitempty"`
+}
+
+// DaemonRegistration defines model for DaemonRegistration.
+type DaemonRegistration struct {
+	// Arch CPU architecture
+	Arch         *string             `json:"arch,omitempty"`
+	Capabilities *DaemonCapabilities `json:"capabilities,omitempty"`
+
+	// Hostname Hostname of the machine running the daemon
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Id Daemon identifier, used as daemon_id on results
+	Id string `json:"id"`
+
+	// Labels Free-form labels describing the daemon
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// MeshAddress host:port of the iperf3 server the daemon runs for mesh tests
+	// (port 5201 when omitted). Daemons without one are not tested.
+	MeshAddress *string `json:"mesh_address,omitempty"`
+
+	// Name Human-friendly name for the daemon
+	Name *string `json:"name,omitempty"`
+
+	// Os Operating system
+	Os *string `json:"os,omitempty"`
+
+	// Version speed-checker version the daemon runs
+	Version *string `json:"version,omitempty"`
+}
+
+// DaemonSettings Testing settings applied by daemons. Unset settings keep the daemon's
+// local configuration (testing.*).
+type DaemonSettings struct {
+	// AdaptiveEnabled Whether degraded results raise the test frequency
+	AdaptiveEnabled *bool `json:"adaptive_enabled,omitempty"`
+
+	// HostIds Only test these hosts
+	HostIds *[]int `json:"host_ids,omitempty"`
+
+	// HostTypes Only test hosts of these types
+	HostTypes *[]HostType `json:"host_types,omitempty"`
+
+	// IperfDurationSeconds Duration of each iperf test
+	IperfDurationSeconds *int `json:"iperf_duration_seconds,omitempty"`
+
+	// IperfEnabled Whether scheduled iperf tests run
+	IperfEnabled *bool `json:"iperf_enabled,omitempty"`
+
+	// IperfIntervalSeconds Interval between scheduled iperf tests
+	IperfIntervalSeconds *int `json:"iperf_interval_seconds,omitempty"`
+
+	// SpeedtestEnabled Whether scheduled speed tests run
+	SpeedtestEnabled *bool `json:"speedtest_enabled,omitempty"`
+
+	// SpeedtestIntervalSeconds Interval between scheduled speed tests
+	SpeedtestIntervalSeconds *int `json:"speedtest_interval_seconds,omitempty"`
+}
+
+// DaemonStatus Liveness of a daemon based on its last heartbeat
+type DaemonStatus string
+
 // DashboardData defines model for DashboardData.
 type DashboardData struct {
 	// ActiveHosts List of active hosts available for testing
 	ActiveHosts []Host `json:"active_hosts"`
 
+	// Daemons Registered daemons and their status
+	Daemons *[]Daemon `json:"daemons,omitempty"`
+
 	// RecentIperfTests Recent iperf test results
 	RecentIperfTests []IperfTestResult `json:"recent_iperf_tests"`
 
@@ -50,6 +531,12 @@ type DashboardData struct {
 		// AvgUploadMbps Average upload speed over last 24h
 		AvgUploadMbps *float64 `json:"avg_upload_mbps,omitempty"`
 
+		// BlockedIperfTests Iperf tests skipped over last 24h because an upstream host type failed
+		BlockedIperfTests *int `json:"blocked_iperf_tests,omitempty"`
+
+		// FailedIperfTests Iperf failures over last 24h, excluding tests blocked by an upstream failure
+		FailedIperfTests *int `json:"failed_iperf_tests,omitempty"`
+
 		// TotalIperfTests Total number of iperf tests
 		TotalIperfTests *int `json:"total_iperf_tests,omitempty"`
 
@@ -58,6 +545,33 @@ type DashboardData struct {
 	} `json:"statistics"`
 }
 
+// Distribution Distribution of a metric over a bucket. Values are absent when nothing was measured.
+type Distribution struct {
+	Avg *float64 `json:"avg,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	Min *float64 `json:"min,omitempty"`
+	P50 *float64 `json:"p50,omitempty"`
+	P90 *float64 `json:"p90,omitempty"`
+	P95 *float64 `json:"p95,omitempty"`
+	P99 *float64 `json:"p99,omitempty"`
+}
+
+// EffectiveDaemonConfig defines model for EffectiveDaemonConfig.
+type EffectiveDaemonConfig struct {
+	// Applied IDs of the daemon configs merged, in the order applied
+	Applied []int `json:"applied"`
+
+	// DaemonId Daemon the configuration was resolved for
+	DaemonId string `json:"daemon_id"`
+
+	// Settings Testing settings applied by daemons. Unset settings keep the daemon's
+	// local configuration (testing.*).
+	Settings DaemonSettings `json:"settings"`
+
+	// Version Version of the resolved settings, changes whenever they do
+	Version string `json:"version"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Details Additional error details
@@ -70,6 +584,12 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// EventType What a streamed event reports
+type EventType string
+
+// ExportFormat Export encoding, CSV with a header row or NDJSON with one object per line
+type ExportFormat string
+
 // Host defines model for Host.
 type Host struct {
 	// Active Whether the host is active for testing
@@ -78,6 +598,13 @@ type Host struct {
 	// CreatedAt When the host was created
 	CreatedAt time.Time `json:"created_at"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -105,6 +632,13 @@ type HostCreation struct {
 	// Active Whether the host is active for testing
 	Active *bool `json:"active,omitempty"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -129,6 +663,13 @@ type HostUpdate struct {
 	// Active Whether the host is active for testing
 	Active *bool `json:"active,omitempty"`
 
+	// DaemonIds Daemons that test the host regardless of their labels
+	DaemonIds *[]string `json:"daemon_ids,omitempty"`
+
+	// DaemonSelector Only daemons carrying all of these labels test the host. Hosts
+	// without a selector or daemon_ids are tested by every daemon.
+	DaemonSelector *map[string]string `json:"daemon_selector,omitempty"`
+
 	// Description Optional description of the host
 	Description *string `json:"description,omitempty"`
 
@@ -145,10 +686,26 @@ type HostUpdate struct {
 	Type HostType `json:"type"`
 }
 
+// IperfStatsGroupBy Dimension iperf statistics can be grouped by
+type IperfStatsGroupBy string
+
 // IperfTestResult defines model for IperfTestResult.
 type IperfTestResult struct {
-	// CreatedAt When the result was stored in the system
+	// BlockedBy Type of host for categorizing network tests
+	BlockedBy *HostType `json:"blocked_by,omitempty"`
+
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// CreatedAt When the result was stored in the system; the measurement timestamp for results stored before received_at was recorded
 	CreatedAt time.Time `json:"created_at"`
+	Daemon    *Daemon   `json:"daemon,omitempty"`
 
 	// DaemonId Identifier of the daemon that performed the test
 	DaemonId string `json:"daemon_id"`
@@ -172,6 +729,12 @@ type IperfTestResult struct {
 	// Protocol Protocol used for the test
 	Protocol IperfTestResultProtocol `json:"protocol"`
 
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of listings and baselines
+	Quarantined *bool `json:"quarantined,omitempty"`
+
+	// ReceivedAt Server time the result was received, unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+
 	// ReceivedMbps Received throughput in Mbps
 	ReceivedMbps float64 `json:"received_mbps"`
 
@@ -181,18 +744,40 @@ type IperfTestResult struct {
 	// SentMbps Sent throughput in Mbps
 	SentMbps float64 `json:"sent_mbps"`
 
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
 	// Success Whether the test was successful
 	Success *bool `json:"success,omitempty"`
 
 	// Timestamp When the test was performed (RFC3339)
 	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
 // IperfTestResultProtocol Protocol used for the test
 type IperfTestResultProtocol string
 
+// IperfTestSort Iperf test column to sort by, prefixed with - for descending order. Ties are broken by ID.
+type IperfTestSort string
+
 // IperfTestSubmission defines model for IperfTestSubmission.
 type IperfTestSubmission struct {
+	// BlockedBy Type of host for categorizing network tests
+	BlockedBy *HostType `json:"blocked_by,omitempty"`
+
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
 	// DaemonId Identifier of the daemon that performed the test
 	DaemonId string `json:"daemon_id"`
 
@@ -217,98 +802,782 @@ type IperfTestSubmission struct {
 	// SentMbps Sent throughput in Mbps
 	SentMbps float64 `json:"sent_mbps"`
 
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
 	// Timestamp When the test was performed (RFC3339)
 	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
 }
 
 // IperfTestSubmissionProtocol Protocol used for the test
 type IperfTestSubmissionProtocol string
 
-// SpeedTestResult defines model for SpeedTestResult.
-type SpeedTestResult struct {
-	// CreatedAt When the result was stored in the system
+// Job defines model for Job.
+type Job struct {
+	// Attempts Number of times the job has been leased
+	Attempts int `json:"attempts"`
+
+	// CampaignId Campaign the job belongs to
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// CompletedAt When the job reached a terminal state
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// CreatedAt When the job was enqueued
 	CreatedAt time.Time `json:"created_at"`
 
-	// DaemonId Identifier of the daemon that performed the test
-	DaemonId string `json:"daemon_id"`
+	// DaemonId Pin the job to a daemon; any daemon may lease it when omitted (required when type is mesh)
+	DaemonId *string `json:"daemon_id,omitempty"`
 
-	// DownloadMbps Download speed in Mbps
-	DownloadMbps float64 `json:"download_mbps"`
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
 
-	// ExternalIp External IP address used for the test
-	ExternalIp *string `json:"external_ip,omitempty"`
+	// ErrorMessage Error reported by the last failed attempt
+	ErrorMessage *string `json:"error_message,omitempty"`
+	Host         *Host   `json:"host,omitempty"`
 
-	// Id Unique identifier for the test result
+	// HostId Target host for iperf jobs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
+
+	// Id Unique identifier for the job
 	Id int `json:"id"`
 
-	// Isp Internet Service Provider
-	Isp *string `json:"isp,omitempty"`
+	// LeaseExpiresAt When the current lease expires
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
 
-	// JitterMs Jitter in milliseconds
-	JitterMs *float64 `json:"jitter_ms,omitempty"`
+	// LeasedBy Daemon currently holding the lease
+	LeasedBy *string `json:"leased_by,omitempty"`
 
-	// PingMs Ping latency in milliseconds
-	PingMs float64 `json:"ping_ms"`
+	// MaxAttempts Maximum number of leases before the job is marked failed
+	MaxAttempts int `json:"max_attempts"`
 
-	// ResultUrl URL to full test results
-	ResultUrl *string `json:"result_url,omitempty"`
+	// Priority Higher priority jobs are leased first
+	Priority *int `json:"priority,omitempty"`
 
-	// ServerId Speed test server ID
-	ServerId *string `json:"server_id,omitempty"`
+	// ResultId ID of the speed or iperf result produced by the job
+	ResultId *int `json:"result_id,omitempty"`
 
-	// ServerName Speed test server name
-	ServerName *string `json:"server_name,omitempty"`
+	// ScheduledAt Earliest time the job may be leased (defaults to now)
+	ScheduledAt time.Time `json:"scheduled_at"`
 
-	// Timestamp When the test was performed (RFC3339)
-	Timestamp time.Time `json:"timestamp"`
+	// Status Lifecycle state of a job
+	Status JobStatus `json:"status"`
 
-	// UploadMbps Upload speed in Mbps
-	UploadMbps float64 `json:"upload_mbps"`
+	// TargetAddress host:port of the target daemon's iperf3 server for mesh jobs
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh job tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-// SpeedTestSubmission defines model for SpeedTestSubmission.
-type SpeedTestSubmission struct {
-	// DaemonId Identifier of the daemon that performed the test
+// JobCompletion defines model for JobCompletion.
+type JobCompletion struct {
+	// DaemonId Daemon reporting the outcome; must hold the lease
 	DaemonId string `json:"daemon_id"`
 
-	// DownloadMbps Download speed in Mbps
-	DownloadMbps float64 `json:"download_mbps"`
+	// ErrorMessage Error message if the job failed
+	ErrorMessage *string `json:"error_message,omitempty"`
 
-	// ExternalIp External IP address used for the test
-	ExternalIp *string `json:"external_ip,omitempty"`
+	// ResultId ID of the submitted speed or iperf result
+	ResultId *int `json:"result_id,omitempty"`
 
-	// Isp Internet Service Provider
-	Isp *string `json:"isp,omitempty"`
+	// Success Whether the job ran successfully
+	Success bool `json:"success"`
+}
 
-	// JitterMs Jitter in milliseconds
-	JitterMs *float64 `json:"jitter_ms,omitempty"`
+// JobCreation defines model for JobCreation.
+type JobCreation struct {
+	// DaemonId Pin the job to a daemon; any daemon may lease it when omitted (required when type is mesh)
+	DaemonId *string `json:"daemon_id,omitempty"`
 
-	// PingMs Ping latency in milliseconds
-	PingMs float64 `json:"ping_ms"`
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
 
-	// ResultUrl URL to full test results
-	ResultUrl *string `json:"result_url,omitempty"`
+	// HostId Target host for iperf jobs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
 
-	// ServerId Speed test server ID
-	ServerId *string `json:"server_id,omitempty"`
+	// MaxAttempts Maximum number of leases before the job is marked failed
+	MaxAttempts *int `json:"max_attempts,omitempty"`
 
-	// ServerName Speed test server name
-	ServerName *string `json:"server_name,omitempty"`
+	// Priority Higher priority jobs are leased first
+	Priority *int `json:"priority,omitempty"`
 
-	// Timestamp When the test was performed (RFC3339)
-	Timestamp time.Time `json:"timestamp"`
+	// ScheduledAt Earliest time the job may be leased (defaults to now)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
 
-	// UploadMbps Upload speed in Mbps
-	UploadMbps float64 `json:"upload_mbps"`
+	// TargetDaemonId Daemon whose iperf3 server a mesh job tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
 }
 
-// GetHostsParams defines parameters for GetHosts.
+// JobLeaseRequest defines model for JobLeaseRequest.
+type JobLeaseRequest struct {
+	// LeaseSeconds Lease duration in seconds (server default when omitted)
+	LeaseSeconds *int `json:"lease_seconds,omitempty"`
+
+	// MaxJobs Maximum number of jobs to lease
+	MaxJobs *int `json:"max_jobs,omitempty"`
+
+	// PinnedOnly Only lease jobs pinned to this daemon (e.g. on-demand runs)
+	PinnedOnly *bool `json:"pinned_only,omitempty"`
+
+	// WaitSeconds Long-poll for up to this many seconds when no job is due
+	WaitSeconds *int `json:"wait_seconds,omitempty"`
+}
+
+// JobStatus Lifecycle state of a job
+type JobStatus string
+
+// JobType Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+// is pinned to against the iperf3 server of target_daemon_id.
+type JobType string
+
+// MeshMatrix defines model for MeshMatrix.
+type MeshMatrix struct {
+	// Cells One cell per tested source and target pair, sorted by source then target
+	Cells []MeshMatrixCell `json:"cells"`
+
+	// Daemons IDs of the daemons taking part in the mesh or tested within the window, sorted
+	Daemons     []string  `json:"daemons"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Window Window the matrix covers
+	Window string `json:"window"`
+}
+
+// MeshMatrixCell defines model for MeshMatrixCell.
+type MeshMatrixCell struct {
+	// Failures Failed tests of the pair within the window
+	Failures int         `json:"failures"`
+	Latest   MeshSample  `json:"latest"`
+	Median   *MeshSample `json:"median,omitempty"`
+
+	// Samples Tests of the pair within the window
+	Samples        int    `json:"samples"`
+	SourceDaemonId string `json:"source_daemon_id"`
+	TargetDaemonId string `json:"target_daemon_id"`
+}
+
+// MeshSample defines model for MeshSample.
+type MeshSample struct {
+	// MeanRttMs Unset when no test reported an RTT
+	MeanRttMs    *float64 `json:"mean_rtt_ms,omitempty"`
+	ReceivedMbps float64  `json:"received_mbps"`
+	SentMbps     float64  `json:"sent_mbps"`
+
+	// Success Whether the test was successful; unset for medians
+	Success *bool `json:"success,omitempty"`
+
+	// Timestamp When the test was performed; unset for medians
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// MeshTestResult defines model for MeshTestResult.
+type MeshTestResult struct {
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DurationSeconds Test duration in seconds
+	DurationSeconds int `json:"duration_seconds"`
+
+	// ErrorMessage Why the test failed; a submission with an error is stored as unsuccessful
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// Id Unique identifier for the test result
+	Id int `json:"id"`
+
+	// MeanRttMs Mean round-trip time in milliseconds
+	MeanRttMs *float64 `json:"mean_rtt_ms,omitempty"`
+
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of the matrix
+	Quarantined bool `json:"quarantined"`
+
+	// ReceivedAt Server time the result was received
+	ReceivedAt time.Time `json:"received_at"`
+
+	// ReceivedMbps Received throughput in Mbps
+	ReceivedMbps float64 `json:"received_mbps"`
+
+	// Retransmits Number of retransmitted packets
+	Retransmits *float64 `json:"retransmits,omitempty"`
+
+	// SentMbps Sent throughput in Mbps
+	SentMbps float64 `json:"sent_mbps"`
+
+	// SourceDaemonId Daemon that ran the iperf3 client
+	SourceDaemonId string `json:"source_daemon_id"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Success Whether the test was successful
+	Success bool `json:"success"`
+
+	// TargetAddress host:port of the target's iperf3 server
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server was tested
+	TargetDaemonId string `json:"target_daemon_id"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+}
+
+// MeshTestSubmission defines model for MeshTestSubmission.
+type MeshTestSubmission struct {
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DurationSeconds Test duration in seconds
+	DurationSeconds int `json:"duration_seconds"`
+
+	// ErrorMessage Why the test failed; a submission with an error is stored as unsuccessful
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// MeanRttMs Mean round-trip time in milliseconds
+	MeanRttMs *float64 `json:"mean_rtt_ms,omitempty"`
+
+	// ReceivedMbps Received throughput in Mbps
+	ReceivedMbps float64 `json:"received_mbps"`
+
+	// Retransmits Number of retransmitted packets
+	Retransmits *float64 `json:"retransmits,omitempty"`
+
+	// SentMbps Sent throughput in Mbps
+	SentMbps float64 `json:"sent_mbps"`
+
+	// SourceDaemonId Daemon that ran the iperf3 client
+	SourceDaemonId string `json:"source_daemon_id"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetAddress host:port of the target's iperf3 server
+	TargetAddress *string `json:"target_address,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server was tested
+	TargetDaemonId string `json:"target_daemon_id"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+}
+
+// MetricSummary defines model for MetricSummary.
+type MetricSummary struct {
+	Max    float64 `json:"max"`
+	Median float64 `json:"median"`
+	Min    float64 `json:"min"`
+
+	// Samples Number of results the summary covers
+	Samples int `json:"samples"`
+}
+
+// ResultBatch defines model for ResultBatch.
+type ResultBatch struct {
+	Items []ResultBatchItem `json:"items"`
+}
+
+// ResultBatchItem A single result in a batch. Set the submission matching type.
+type ResultBatchItem struct {
+	Iperf     *IperfTestSubmission `json:"iperf,omitempty"`
+	Speedtest *SpeedTestSubmission `json:"speedtest,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// ResultBatchItemResult defines model for ResultBatchItemResult.
+type ResultBatchItemResult struct {
+	// Error Why an invalid item was rejected
+	Error *string `json:"error,omitempty"`
+
+	// Id ID of the stored result, set unless the item is invalid
+	Id *int `json:"id,omitempty"`
+
+	// Index Position of the item in the submitted batch
+	Index int `json:"index"`
+
+	// Status Outcome of a batch item. duplicate means a result with the same
+	// submission_id was already stored; invalid items, including those
+	// rejected for clock skew, were not stored.
+	Status ResultBatchItemStatus `json:"status"`
+}
+
+// ResultBatchItemStatus Outcome of a batch item. duplicate means a result with the same
+// submission_id was already stored; invalid items, including those
+// rejected for clock skew, were not stored.
+type ResultBatchItemStatus string
+
+// ResultBatchResponse defines model for ResultBatchResponse.
+type ResultBatchResponse struct {
+	Results []ResultBatchItemResult `json:"results"`
+}
+
+// RunOutcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+type RunOutcome string
+
+// RunRequest defines model for RunRequest.
+type RunRequest struct {
+	// DurationSeconds iperf test duration in seconds
+	DurationSeconds *int `json:"duration_seconds,omitempty"`
+
+	// HostId Target host for iperf runs (required when type is iperf)
+	HostId *int `json:"host_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tests (required when type is mesh)
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// SpeedTestResult defines model for SpeedTestResult.
+type SpeedTestResult struct {
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// CreatedAt When the result was stored in the system; the measurement timestamp for results stored before received_at was recorded
+	CreatedAt time.Time `json:"created_at"`
+	Daemon    *Daemon   `json:"daemon,omitempty"`
+
+	// DaemonId Identifier of the daemon that performed the test
+	DaemonId string `json:"daemon_id"`
+
+	// DownloadMbps Download speed in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// ExternalIp External IP address used for the test
+	ExternalIp *string `json:"external_ip,omitempty"`
+
+	// Id Unique identifier for the test result
+	Id int `json:"id"`
+
+	// Isp Internet Service Provider
+	Isp *string `json:"isp,omitempty"`
+
+	// JitterMs Jitter in milliseconds
+	JitterMs *float64 `json:"jitter_ms,omitempty"`
+
+	// PingMs Ping latency in milliseconds
+	PingMs float64 `json:"ping_ms"`
+
+	// Quarantined The timestamp was outside the server's clock skew window, so the result is kept out of listings and baselines
+	Quarantined *bool `json:"quarantined,omitempty"`
+
+	// ReceivedAt Server time the result was received, unset for results stored before it was recorded
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+
+	// ResultUrl URL to full test results
+	ResultUrl *string `json:"result_url,omitempty"`
+
+	// ServerId Speed test server ID
+	ServerId *string `json:"server_id,omitempty"`
+
+	// ServerName Speed test server name
+	ServerName *string `json:"server_name,omitempty"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// UploadMbps Upload speed in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// SpeedTestSort Speed test column to sort by, prefixed with - for descending order. Ties are broken by ID.
+type SpeedTestSort string
+
+// SpeedTestStatsGroupBy Dimension speed test statistics can be grouped by
+type SpeedTestStatsGroupBy string
+
+// SpeedTestSubmission defines model for SpeedTestSubmission.
+type SpeedTestSubmission struct {
+	// CampaignId Campaign the result was collected for, from the job that ran it
+	CampaignId *int `json:"campaign_id,omitempty"`
+
+	// ClockCorrected Whether the daemon already adjusted timestamp by clock_offset_ms
+	ClockCorrected *bool `json:"clock_corrected,omitempty"`
+
+	// ClockOffsetMs Server clock minus daemon clock in milliseconds, as measured by the daemon from the X-Server-Time header
+	ClockOffsetMs *int64 `json:"clock_offset_ms,omitempty"`
+
+	// DaemonId Identifier of the daemon that performed the test
+	DaemonId string `json:"daemon_id"`
+
+	// DownloadMbps Download speed in Mbps
+	DownloadMbps float64 `json:"download_mbps"`
+
+	// ExternalIp External IP address used for the test
+	ExternalIp *string `json:"external_ip,omitempty"`
+
+	// Isp Internet Service Provider
+	Isp *string `json:"isp,omitempty"`
+
+	// JitterMs Jitter in milliseconds
+	JitterMs *float64 `json:"jitter_ms,omitempty"`
+
+	// PingMs Ping latency in milliseconds
+	PingMs float64 `json:"ping_ms"`
+
+	// ResultUrl URL to full test results
+	ResultUrl *string `json:"result_url,omitempty"`
+
+	// ServerId Speed test server ID
+	ServerId *string `json:"server_id,omitempty"`
+
+	// ServerName Speed test server name
+	ServerName *string `json:"server_name,omitempty"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// Timestamp When the test was performed (RFC3339)
+	Timestamp time.Time `json:"timestamp"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// UploadMbps Upload speed in Mbps
+	UploadMbps float64 `json:"upload_mbps"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+	// Bucket Width of a statistics bucket. Buckets are aligned to the Unix epoch, in UTC.
+	Bucket    *StatsBucket  `json:"bucket,omitempty"`
+	EndTime   time.Time     `json:"end_time"`
+	GroupBy   []string      `json:"group_by"`
+	Series    []StatsSeries `json:"series"`
+	StartTime time.Time     `json:"start_time"`
+}
+
+// StatsBucket Width of a statistics bucket. Buckets are aligned to the Unix epoch, in UTC.
+type StatsBucket string
+
+// StatsGroup Values of the grouped dimensions of a series; dimensions not grouped by are absent
+type StatsGroup struct {
+	DaemonId *string `json:"daemon_id,omitempty"`
+	HostId   *int    `json:"host_id,omitempty"`
+	HostName *string `json:"host_name,omitempty"`
+
+	// HostType Type of host for categorizing network tests
+	HostType   *HostType `json:"host_type,omitempty"`
+	Isp        *string   `json:"isp,omitempty"`
+	ServerName *string   `json:"server_name,omitempty"`
+}
+
+// StatsPoint defines model for StatsPoint.
+type StatsPoint struct {
+	// Count Number of results in the bucket
+	Count int `json:"count"`
+
+	// FailureRate Share of attempts that failed, between 0 and 1
+	FailureRate *float64 `json:"failure_rate,omitempty"`
+
+	// Metrics Distribution per metric name
+	Metrics map[string]Distribution `json:"metrics"`
+
+	// Start Start of the bucket, or of the range when not bucketed
+	Start time.Time `json:"start"`
+}
+
+// StatsSeries defines model for StatsSeries.
+type StatsSeries struct {
+	// Group Values of the grouped dimensions of a series; dimensions not grouped by are absent
+	Group StatsGroup `json:"group"`
+
+	// Points Buckets with results or attempts, oldest first
+	Points []StatsPoint `json:"points"`
+}
+
+// StreamEvent Data of a streamed event. speedtest is set for speedtest_result,
+// iperf for iperf_result, host for host_created and host_updated,
+// daemon for daemon_heartbeat and alert for alert, along with the
+// result that raised it. host_deleted only carries host_id.
+type StreamEvent struct {
+	Alert    *Alert  `json:"alert,omitempty"`
+	Daemon   *Daemon `json:"daemon,omitempty"`
+	DaemonId *string `json:"daemon_id,omitempty"`
+	Host     *Host   `json:"host,omitempty"`
+	HostId   *int    `json:"host_id,omitempty"`
+
+	// Id Sequence number of the event, increasing for the life of the server
+	Id        int64            `json:"id"`
+	Iperf     *IperfTestResult `json:"iperf,omitempty"`
+	Speedtest *SpeedTestResult `json:"speedtest,omitempty"`
+	Time      time.Time        `json:"time"`
+
+	// Type What a streamed event reports
+	Type EventType `json:"type"`
+}
+
+// TestRun defines model for TestRun.
+type TestRun struct {
+	// DaemonId Daemon that attempted the run
+	DaemonId string `json:"daemon_id"`
+
+	// ErrorMessage Why the run failed, timed out or was skipped
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// FinishedAt When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at"`
+	Host       *Host     `json:"host,omitempty"`
+
+	// HostId Target host for iperf runs
+	HostId *int `json:"host_id,omitempty"`
+
+	// Id Unique identifier for the run
+	Id int `json:"id"`
+
+	// IperfTestId ID of the iperf test result produced by the run
+	IperfTestId *int `json:"iperf_test_id,omitempty"`
+
+	// MeshTestId ID of the mesh test result produced by the run
+	MeshTestId *int `json:"mesh_test_id,omitempty"`
+
+	// Outcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+	Outcome RunOutcome `json:"outcome"`
+
+	// SpeedTestId ID of the speed test result produced by the run
+	SpeedTestId *int `json:"speed_test_id,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt time.Time `json:"started_at"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tested
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// TestRunSubmission defines model for TestRunSubmission.
+type TestRunSubmission struct {
+	// DaemonId Daemon that attempted the run
+	DaemonId string `json:"daemon_id"`
+
+	// ErrorMessage Why the run failed, timed out or was skipped
+	ErrorMessage *string `json:"error_message,omitempty"`
+
+	// FinishedAt When the run finished or was abandoned
+	FinishedAt time.Time `json:"finished_at"`
+
+	// HostId Target host for iperf runs
+	HostId *int `json:"host_id,omitempty"`
+
+	// IperfTestId ID of the iperf test result produced by the run
+	IperfTestId *int `json:"iperf_test_id,omitempty"`
+
+	// MeshTestId ID of the mesh test result produced by the run
+	MeshTestId *int `json:"mesh_test_id,omitempty"`
+
+	// Outcome How a test run ended; spooled runs measured a result that waits in the daemon's spool until the API is reachable
+	Outcome RunOutcome `json:"outcome"`
+
+	// SpeedTestId ID of the speed test result produced by the run
+	SpeedTestId *int `json:"speed_test_id,omitempty"`
+
+	// StartedAt When the run started
+	StartedAt time.Time `json:"started_at"`
+
+	// SubmissionId Client-generated ID that makes retries idempotent. Resubmitting the same ID returns the stored record instead of creating a duplicate.
+	SubmissionId *openapi_types.UUID `json:"submission_id,omitempty"`
+
+	// TargetDaemonId Daemon whose iperf3 server a mesh run tested
+	TargetDaemonId *string `json:"target_daemon_id,omitempty"`
+
+	// Trigger What caused a test to run. Adaptive runs are extra tests scheduled
+	// while a target performs below its baseline.
+	Trigger *TestTrigger `json:"trigger,omitempty"`
+
+	// Type Kind of test a job runs. Mesh jobs run iperf3 from the daemon the job
+	// is pinned to against the iperf3 server of target_daemon_id.
+	Type JobType `json:"type"`
+}
+
+// TestTrigger What caused a test to run. Adaptive runs are extra tests scheduled
+// while a target performs below its baseline.
+type TestTrigger string
+
+// StatsDaemonId defines model for StatsDaemonId.
+type StatsDaemonId = string
+
+// StatsEndTime defines model for StatsEndTime.
+type StatsEndTime = time.Time
+
+// StatsStartTime defines model for StatsStartTime.
+type StatsStartTime = time.Time
+
+// GetCampaignsParams defines parameters for GetCampaigns.
+type GetCampaignsParams struct {
+	// Limit Maximum number of campaigns to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDaemonsParams defines parameters for GetDaemons.
+type GetDaemonsParams struct {
+	// Status Filter by daemon status
+	Status *DaemonStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// Type Only stream these event types
+	Type *[]EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DaemonId Only stream events about this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostId Only stream events about this host
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+}
+
+// ExportIperfTestsParams defines parameters for ExportIperfTests.
+type ExportIperfTestsParams struct {
+	// Format Output format. Without it the Accept header picks, defaulting to CSV.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostId Filter by host ID
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// HostName Filter by host name (partial match)
+	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+
+	// HostType Filter by host type
+	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order. Defaults to timestamp, oldest first.
+	Sort *IperfTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Quarantined Export quarantined results instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+
+	// Limit Maximum number of results to export; all matching results when absent
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ExportSpeedTestsParams defines parameters for ExportSpeedTests.
+type ExportSpeedTestsParams struct {
+	// Format Output format. Without it the Accept header picks, defaulting to CSV.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartTime Filter results after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter results before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// ServerName Filter by server name (partial match)
+	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order. Defaults to timestamp, oldest first.
+	Sort *SpeedTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Quarantined Export quarantined results instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+
+	// Limit Maximum number of results to export; all matching results when absent
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetHostsParams defines parameters for GetHosts.
 type GetHostsParams struct {
 	// Type Filter by host type
 	Type *HostType `form:"type,omitempty" json:"type,omitempty"`
 
 	// Active Filter by active status
 	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// DaemonId Only return hosts this daemon may test, based on each host's
+	// daemon_selector and daemon_ids and the labels the daemon
+	// registered with
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Limit Maximum number of hosts to return; all hosts without it
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue the listing after the page whose X-Next-Cursor header returned this cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetIperfBaselineParams defines parameters for GetIperfBaseline.
+type GetIperfBaselineParams struct {
+	// HostId Host the baseline is computed for
+	HostId int `form:"host_id" json:"host_id"`
+
+	// DaemonId Restrict the baseline to results from this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Samples Number of recent results to include
+	Samples *int `form:"samples,omitempty" json:"samples,omitempty"`
 }
 
 // GetIperfTestsParams defines parameters for GetIperfTests.
@@ -316,9 +1585,14 @@ type GetIperfTestsParams struct {
 	// Limit Maximum number of results to return
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Offset Number of results to skip
+	// Offset Number of results to skip. Ignored when a cursor is given.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
+	// Cursor Continue the listing after the page that returned this
+	// next_cursor. Unlike offset, cursors do not skip or repeat
+	// results while new ones arrive. Keep the same filters and sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// StartTime Filter results after this timestamp (RFC3339)
 	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
 
@@ -330,6 +1604,84 @@ type GetIperfTestsParams struct {
 
 	// DaemonId Filter by daemon ID
 	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// HostName Filter by host name (partial match)
+	HostName *string `form:"host_name,omitempty" json:"host_name,omitempty"`
+
+	// HostType Filter by host type
+	HostType *HostType `form:"host_type,omitempty" json:"host_type,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order
+	Sort *IperfTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Slowest Sort by slowest received speed first, the same as sort=received_mbps. Ignored when sort is given.
+	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+
+	// Quarantined List quarantined results, whose timestamps were outside the clock skew window, instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+}
+
+// GetJobsParams defines parameters for GetJobs.
+type GetJobsParams struct {
+	// Status Filter by job status
+	Status *JobStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Type Filter by job type
+	Type *JobType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DaemonId Filter by pinned or leasing daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Limit Maximum number of jobs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetJobParams defines parameters for GetJob.
+type GetJobParams struct {
+	// WaitSeconds Long-poll for up to this many seconds until the job finishes
+	WaitSeconds *int `form:"wait_seconds,omitempty" json:"wait_seconds,omitempty"`
+}
+
+// GetMeshMatrixParams defines parameters for GetMeshMatrix.
+type GetMeshMatrixParams struct {
+	// Window How far back to look, as a Go duration (e.g. 24h, 168h)
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetRunsParams defines parameters for GetRuns.
+type GetRunsParams struct {
+	// DaemonId Filter by daemon ID
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Type Filter by test type
+	Type *JobType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Trigger Filter by what caused the run
+	Trigger *TestTrigger `form:"trigger,omitempty" json:"trigger,omitempty"`
+
+	// Outcome Filter by run outcome
+	Outcome *RunOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// HostId Filter by target host
+	HostId *int `form:"host_id,omitempty" json:"host_id,omitempty"`
+
+	// StartTime Filter runs started after this timestamp (RFC3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Filter runs started before this timestamp (RFC3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSpeedTestBaselineParams defines parameters for GetSpeedTestBaseline.
+type GetSpeedTestBaselineParams struct {
+	// DaemonId Restrict the baseline to results from this daemon
+	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// Samples Number of recent results to include
+	Samples *int `form:"samples,omitempty" json:"samples,omitempty"`
 }
 
 // GetSpeedTestsParams defines parameters for GetSpeedTests.
@@ -337,9 +1689,14 @@ type GetSpeedTestsParams struct {
 	// Limit Maximum number of results to return
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Offset Number of results to skip
+	// Offset Number of results to skip. Ignored when a cursor is given.
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
+	// Cursor Continue the listing after the page that returned this
+	// next_cursor. Unlike offset, cursors do not skip or repeat
+	// results while new ones arrive. Keep the same filters and sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// StartTime Filter results after this timestamp (RFC3339)
 	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
 
@@ -348,8 +1705,77 @@ type GetSpeedTestsParams struct {
 
 	// DaemonId Filter by daemon ID
 	DaemonId *string `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+
+	// ServerName Filter by server name (partial match)
+	ServerName *string `form:"server_name,omitempty" json:"server_name,omitempty"`
+
+	// Sort Column to sort by, prefixed with - for descending order
+	Sort *SpeedTestSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Slowest Sort by slowest download first, the same as sort=download_mbps. Ignored when sort is given.
+	Slowest *bool `form:"slowest,omitempty" json:"slowest,omitempty"`
+
+	// Quarantined List quarantined results, whose timestamps were outside the clock skew window, instead of the regular ones
+	Quarantined *bool `form:"quarantined,omitempty" json:"quarantined,omitempty"`
+}
+
+// GetIperfStatsParams defines parameters for GetIperfStats.
+type GetIperfStatsParams struct {
+	// StartTime Start of the range (RFC3339), defaults to 24 hours before end_time
+	StartTime *StatsStartTime `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime End of the range (RFC3339), exclusive, defaults to now
+	EndTime *StatsEndTime `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Bucket Width of each point. Without a bucket the whole range is one point.
+	Bucket *StatsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy Split the results into one series per value of these dimensions
+	GroupBy *[]IperfStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DaemonId Only aggregate results from this daemon
+	DaemonId *StatsDaemonId `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
+}
+
+// GetSpeedTestStatsParams defines parameters for GetSpeedTestStats.
+type GetSpeedTestStatsParams struct {
+	// StartTime Start of the range (RFC3339), defaults to 24 hours before end_time
+	StartTime *StatsStartTime `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime End of the range (RFC3339), exclusive, defaults to now
+	EndTime *StatsEndTime `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Bucket Width of each point. Without a bucket the whole range is one point.
+	Bucket *StatsBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// GroupBy Split the results into one series per value of these dimensions
+	GroupBy *[]SpeedTestStatsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// DaemonId Only aggregate results from this daemon
+	DaemonId *StatsDaemonId `form:"daemon_id,omitempty" json:"daemon_id,omitempty"`
 }
 
+// CreateCampaignJSONRequestBody defines body for CreateCampaign for application/json ContentType.
+type CreateCampaignJSONRequestBody = CampaignCreation
+
+// CreateDaemonConfigJSONRequestBody defines body for CreateDaemonConfig for application/json ContentType.
+type CreateDaemonConfigJSONRequestBody = DaemonConfigCreation
+
+// UpdateDaemonConfigJSONRequestBody defines body for UpdateDaemonConfig for application/json ContentType.
+type UpdateDaemonConfigJSONRequestBody = DaemonConfigCreation
+
+// RegisterDaemonJSONRequestBody defines body for RegisterDaemon for application/json ContentType.
+type RegisterDaemonJSONRequestBody = DaemonRegistration
+
+// HeartbeatDaemonJSONRequestBody defines body for HeartbeatDaemon for application/json ContentType.
+type HeartbeatDaemonJSONRequestBody = DaemonHeartbeat
+
+// LeaseJobsJSONRequestBody defines body for LeaseJobs for application/json ContentType.
+type LeaseJobsJSONRequestBody = JobLeaseRequest
+
+// RunOnDaemonJSONRequestBody defines body for RunOnDaemon for application/json ContentType.
+type RunOnDaemonJSONRequestBody = RunRequest
+
 // AddHostJSONRequestBody defines body for AddHost for application/json ContentType.
 type AddHostJSONRequestBody = HostCreation
 
@@ -359,5 +1785,20 @@ type UpdateHostJSONRequestBody = HostUpdate
 // SubmitIperfTestJSONRequestBody defines body for SubmitIperfTest for application/json ContentType.
 type SubmitIperfTestJSONRequestBody = IperfTestSubmission
 
+// CreateJobJSONRequestBody defines body for CreateJob for application/json ContentType.
+type CreateJobJSONRequestBody = JobCreation
+
+// CompleteJobJSONRequestBody defines body for CompleteJob for application/json ContentType.
+type CompleteJobJSONRequestBody = JobCompletion
+
+// SubmitMeshTestJSONRequestBody defines body for SubmitMeshTest for application/json ContentType.
+type SubmitMeshTestJSONRequestBody = MeshTestSubmission
+
+// SubmitResultBatchJSONRequestBody defines body for SubmitResultBatch for application/json ContentType.
+type SubmitResultBatchJSONRequestBody = ResultBatch
+
+// SubmitRunJSONRequestBody defines body for SubmitRun for application/json ContentType.
+type SubmitRunJSONRequestBody = TestRunSubmission
+
 // SubmitSpeedTestJSONRequestBody defines body for SubmitSpeedTest for application/json ContentType.
 type SubmitSpeedTestJSONRequestBody = SpeedTestSubmission